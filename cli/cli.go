@@ -70,6 +70,7 @@ func init() {
 		logCmd,
 
 		sqlShellCmd,
+		dumpCmd,
 		kvCmd,
 		userCmd,
 		rangeCmd,
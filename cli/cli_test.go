@@ -414,6 +414,7 @@ Available Commands:
   log         make log files human-readable
 
   sql         open a sql shell
+  dump        dump sql tables
   kv          get, put, conditional put, increment, delete, scan, and reverse scan key/value pairs
   user        get, set, list and remove users
   range       list, split and merge ranges
@@ -0,0 +1,264 @@
+// Copyright 2015 The Cockroach Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+// implied. See the License for the specific language governing
+// permissions and limitations under the License. See the AUTHORS file
+// for names of contributors.
+
+package cli
+
+import (
+	"database/sql"
+	"fmt"
+	"io"
+	"strings"
+	"time"
+
+	"github.com/spf13/cobra"
+
+	"github.com/cockroachdb/cockroach/sql/driver"
+	"github.com/cockroachdb/cockroach/sql/parser"
+	"github.com/cockroachdb/cockroach/util/log"
+)
+
+// dumpBatchSize is the maximum number of rows inserted by each INSERT
+// statement of a dump.
+const dumpBatchSize = 100
+
+// dumpCmd dumps the schema and the data of sql tables.
+var dumpCmd = &cobra.Command{
+	Use:   "dump [options] <database> [<table>...]",
+	Short: "dump sql tables",
+	Long: `
+Dump the specified tables of <database>, or all of its tables if none are
+specified, as CREATE TABLE statements followed by INSERT statements. The
+tables are read at a consistent timestamp.
+`,
+	Run: runDump,
+}
+
+func runDump(cmd *cobra.Command, args []string) {
+	if len(args) < 1 {
+		mustUsage(cmd)
+		return
+	}
+
+	db := makeSQLClient()
+	if err := dumpTables(osStdout, db, args[0], args[1:]); err != nil {
+		log.Error(err)
+		osExit(1)
+	}
+}
+
+// dumpTables writes the schema and the data of the specified tables of the
+// database to w. All the tables are dumped if none are specified. The tables
+// are read within a single transaction so that the dump reflects the state of
+// the database at the timestamp of the transaction.
+func dumpTables(w io.Writer, db *sql.DB, dbName string, tables []string) error {
+	tx, err := db.Begin()
+	if err != nil {
+		return err
+	}
+	// The snapshot transaction is read-only, so it is rolled back rather than
+	// committed in all cases.
+	defer func() {
+		_ = tx.Rollback()
+	}()
+	if _, err := tx.Exec(`SET TRANSACTION ISOLATION LEVEL SNAPSHOT`); err != nil {
+		return err
+	}
+
+	if len(tables) == 0 {
+		rows, err := tx.Query(fmt.Sprintf(`SHOW TABLES FROM %s`, parser.Name(dbName)))
+		if err != nil {
+			return err
+		}
+		for rows.Next() {
+			var table string
+			if err := rows.Scan(&table); err != nil {
+				_ = rows.Close()
+				return err
+			}
+			tables = append(tables, table)
+		}
+		if err := rows.Close(); err != nil {
+			return err
+		}
+	}
+
+	for i, table := range tables {
+		if i > 0 {
+			fmt.Fprintln(w)
+		}
+		if err := dumpTable(w, tx, dbName, table); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// dumpTable writes the CREATE TABLE statement of the table followed by
+// INSERT statements for its rows.
+func dumpTable(w io.Writer, tx *sql.Tx, dbName, table string) error {
+	qname := fmt.Sprintf("%s.%s", parser.Name(dbName), parser.Name(table))
+
+	var name, create string
+	if err := tx.QueryRow(fmt.Sprintf(`SHOW CREATE TABLE %s`, qname)).Scan(&name, &create); err != nil {
+		return err
+	}
+	fmt.Fprintf(w, "%s;\n", create)
+
+	// The values of some types are returned as strings by the driver. The
+	// type of their column determines how they are written.
+	var types []string
+	rows, err := tx.Query(fmt.Sprintf(`SHOW COLUMNS FROM %s`, qname))
+	if err != nil {
+		return err
+	}
+	for rows.Next() {
+		var field, typ, null string
+		var def interface{}
+		if err := rows.Scan(&field, &typ, &null, &def); err != nil {
+			_ = rows.Close()
+			return err
+		}
+		types = append(types, typ)
+	}
+	if err := rows.Close(); err != nil {
+		return err
+	}
+
+	rows, err = tx.Query(fmt.Sprintf(`SELECT * FROM %s`, qname))
+	if err != nil {
+		return err
+	}
+	defer rows.Close()
+	cols, err := rows.Columns()
+	if err != nil {
+		return err
+	}
+	if len(cols) != len(types) {
+		return fmt.Errorf("expected %d columns, but found %d", len(types), len(cols))
+	}
+
+	insert := fmt.Sprintf("INSERT INTO %s (%s) VALUES", parser.Name(table), parser.NameList(cols))
+	vals := make([]interface{}, len(cols))
+	for i := range vals {
+		vals[i] = new(interface{})
+	}
+	var batch []string
+	flush := func() {
+		if len(batch) > 0 {
+			fmt.Fprintf(w, "\n%s\n\t%s;\n", insert, strings.Join(batch, ",\n\t"))
+			batch = batch[:0]
+		}
+	}
+	for rows.Next() {
+		if err := rows.Scan(vals...); err != nil {
+			return err
+		}
+		strs := make([]string, len(vals))
+		for i, v := range vals {
+			s, err := dumpValue(*v.(*interface{}), types[i])
+			if err != nil {
+				return err
+			}
+			strs[i] = s
+		}
+		batch = append(batch, fmt.Sprintf("(%s)", strings.Join(strs, ", ")))
+		if len(batch) == dumpBatchSize {
+			flush()
+		}
+	}
+	if err := rows.Err(); err != nil {
+		return err
+	}
+	flush()
+	return nil
+}
+
+// dumpValue returns the SQL literal for a value returned by the driver for a
+// column of the given type.
+func dumpValue(val interface{}, typ string) (string, error) {
+	if s, ok := val.(string); ok {
+		switch {
+		case typ == "UUID":
+			return fmt.Sprintf("%s::UUID", parser.DString(s)), nil
+		case typ == "JSON":
+			return fmt.Sprintf("%s::JSON", parser.DString(s)), nil
+		case strings.HasSuffix(typ, "[]"):
+			return dumpArray(s, typ)
+		}
+	}
+	switch t := val.(type) {
+	case nil:
+		return "NULL", nil
+	case bool:
+		return parser.DBool(t).String(), nil
+	case int64:
+		return parser.DInt(t).String(), nil
+	case float64:
+		return parser.DFloat(t).String(), nil
+	case string:
+		return parser.DString(t).String(), nil
+	case []byte:
+		return parser.DBytes(t).String(), nil
+	case driver.Date:
+		return fmt.Sprintf("%s::DATE", parser.DString(t.String())), nil
+	case time.Time:
+		return fmt.Sprintf("%s::TIMESTAMP", parser.DString(parser.DTimestamp{Time: t}.String())), nil
+	case time.Duration:
+		return fmt.Sprintf("%s::INTERVAL", parser.DString(t.String())), nil
+	}
+	return "", fmt.Errorf("unsupported value type %T", val)
+}
+
+// dumpArray returns the ARRAY literal for the text of an array of the given
+// type, such as {1,2,NULL} for INT[].
+func dumpArray(s, typ string) (string, error) {
+	expr, err := parser.ParseExpr(fmt.Sprintf("NULL::%s", typ), parser.Traditional)
+	if err != nil {
+		return "", err
+	}
+	cast, ok := expr.(*parser.CastExpr)
+	if !ok {
+		return "", fmt.Errorf("unsupported type %s", typ)
+	}
+	arrayType, ok := cast.Type.(*parser.ArrayType)
+	if !ok {
+		return "", fmt.Errorf("unsupported type %s", typ)
+	}
+	d, err := parser.ParseDArray(parser.EvalContext{}, s, arrayType.ElemType)
+	if err != nil {
+		return "", err
+	}
+	elems := d.(parser.DArray).Elems
+	strs := make([]string, len(elems))
+	typed := false
+	for i, elem := range elems {
+		switch t := elem.(type) {
+		case parser.DDate:
+			strs[i] = fmt.Sprintf("%s::DATE", parser.DString(t.String()))
+		case parser.DTimestamp:
+			strs[i] = fmt.Sprintf("%s::TIMESTAMP", parser.DString(t.String()))
+		case parser.DInterval:
+			strs[i] = fmt.Sprintf("%s::INTERVAL", parser.DString(t.String()))
+		default:
+			strs[i] = elem.String()
+		}
+		typed = typed || elem != parser.DNull
+	}
+	if !typed {
+		// The elements do not determine the type of the array.
+		return fmt.Sprintf("ARRAY[%s]::%s", strings.Join(strs, ", "), typ), nil
+	}
+	return fmt.Sprintf("ARRAY[%s]", strings.Join(strs, ", ")), nil
+}
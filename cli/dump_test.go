@@ -0,0 +1,122 @@
+// Copyright 2015 The Cockroach Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+// implied. See the License for the specific language governing
+// permissions and limitations under the License. See the AUTHORS file
+// for names of contributors.
+
+package cli
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+
+	"github.com/cockroachdb/cockroach/server"
+	"github.com/cockroachdb/cockroach/util/leaktest"
+)
+
+func TestDumpTables(t *testing.T) {
+	defer leaktest.AfterTest(t)
+	s := server.StartTestServer(nil)
+	db := makeTestDBClient(t, s)
+
+	defer db.Close()
+	defer s.Stop()
+
+	if _, err := db.Exec(`
+CREATE DATABASE d;
+CREATE TABLE d.t (
+	i INT PRIMARY KEY,
+	b BOOL,
+	f FLOAT,
+	s STRING(10) DEFAULT 'x',
+	y BYTES,
+	d DATE,
+	ts TIMESTAMP,
+	iv INTERVAL,
+	INDEX (s) STORING (f)
+);
+INSERT INTO d.t VALUES
+	(1, true, 1.5, 'it''s', b'ab', '2016-03-26'::date, '2016-03-26 12:34:56.5'::timestamp, '1h2m'::interval),
+	(2, NULL, NULL, NULL, NULL, NULL, NULL, NULL);
+CREATE TABLE d.u (k INT PRIMARY KEY);
+CREATE TABLE d.v (k INT PRIMARY KEY, id UUID, j JSON, a INT[], s STRING[], ds DATE[]);
+INSERT INTO d.v VALUES
+	(1, '5ca47e42-0c48-4dd1-8c1d-7b7b5ec5d2e1'::uuid, '{"b": [1, "x"], "a": null}'::json,
+		ARRAY[1, NULL], ARRAY['it''s', 'b c'], ARRAY['2016-03-26'::date]),
+	(2, NULL, NULL, ARRAY[], '{NULL}'::STRING[], NULL);
+`); err != nil {
+		t.Fatal(err)
+	}
+
+	var b bytes.Buffer
+	if err := dumpTables(&b, db, "d", nil); err != nil {
+		t.Fatal(err)
+	}
+	dump := b.String()
+	expected := `CREATE TABLE t (
+	i INT,
+	b BOOL,
+	f FLOAT,
+	s STRING(10) DEFAULT 'x',
+	y BYTES,
+	d DATE,
+	ts TIMESTAMP,
+	iv INTERVAL,
+	CONSTRAINT "primary" PRIMARY KEY (i),
+	INDEX t_s_idx (s) STORING (f),
+	FAMILY "primary" (b, f, s, y, d, ts, iv)
+);
+
+INSERT INTO t (i, b, f, s, y, d, ts, iv) VALUES
+	(1, true, 1.5, e'it\'s', b'ab', '2016-03-26'::DATE, '2016-03-26 12:34:56.5+00:00'::TIMESTAMP, '1h2m0s'::INTERVAL),
+	(2, NULL, NULL, NULL, NULL, NULL, NULL, NULL);
+
+CREATE TABLE u (
+	k INT,
+	CONSTRAINT "primary" PRIMARY KEY (k)
+);
+
+CREATE TABLE v (
+	k INT,
+	id UUID,
+	j JSON,
+	a INT[],
+	s STRING[],
+	ds DATE[],
+	CONSTRAINT "primary" PRIMARY KEY (k),
+	FAMILY "primary" (id, j, a, s, ds)
+);
+
+INSERT INTO v (k, id, j, a, s, ds) VALUES
+	(1, '5ca47e42-0c48-4dd1-8c1d-7b7b5ec5d2e1'::UUID, '{"a":null,"b":[1,"x"]}'::JSON, ARRAY[1, NULL], ARRAY[e'it\'s', 'b c'], ARRAY['2016-03-26'::DATE]),
+	(2, NULL, NULL, ARRAY[]::INT[], ARRAY[NULL]::STRING[], NULL);
+`
+	if dump != expected {
+		t.Fatalf("expected:\n%s\ngot:\n%s", expected, dump)
+	}
+
+	// Restoring the dump into another database recreates the tables and their
+	// rows.
+	if _, err := db.Exec(`CREATE DATABASE e; SET DATABASE = e; ` + dump); err != nil {
+		t.Fatal(err)
+	}
+	b.Reset()
+	if err := dumpTables(&b, db, "e", []string{"t", "v"}); err != nil {
+		t.Fatal(err)
+	}
+	e := expected[:strings.Index(expected, "\n\nCREATE TABLE u")] +
+		expected[strings.Index(expected, "\n\nCREATE TABLE v"):]
+	if b.String() != e {
+		t.Fatalf("expected:\n%s\ngot:\n%s", e, b.String())
+	}
+}
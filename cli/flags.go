@@ -70,6 +70,11 @@ var flagUsage = map[string]string{
 	"certs": `
         Directory containing RSA key and x509 certs. This flag is required if
         --insecure=false.
+`,
+	"external-io-dir": `
+        The directory in which IMPORT reads and BACKUP and RESTORE write and
+        read their files. The file names in these statements are relative to
+        it. If it is not set, the statements are disabled.
 `,
 	"gossip": `
         A comma-separated list of gossip addresses or resolvers for gossip
@@ -126,6 +131,22 @@ var flagUsage = map[string]string{
 		Adjusts the timeout for stores.  If there's been no gossiped updated
 		from a store after this time, the store is considered unavailable.
         Replicas on an unavailable store will be moved to available ones.
+`,
+	"slow-query-threshold": `
+        SQL statements which take longer than this duration to execute are
+        written to the log along with their query plan. A value of 0 disables
+        the slow query log.
+`,
+	"sql-memory-budget": `
+        The number of bytes which the SQL operators buffering rows, such as
+        sorts, aggregations, DISTINCT and subqueries, may use across all the
+        sessions of the node. Queries exceeding it fail. A value of 0 is
+        unlimited.
+`,
+	"sql-session-memory-budget": `
+        The number of bytes which the SQL operators buffering rows may use
+        within a single session. Queries exceeding it fail. A value of 0 is
+        unlimited.
 `,
 	"stores": `
         A comma-separated list of stores, specified by a colon-separated list
@@ -194,6 +215,12 @@ func initFlags(ctx *server.Context) {
 		// KV flags.
 		f.BoolVar(&ctx.Linearizable, "linearizable", ctx.Linearizable, flagUsage["linearizable"])
 
+		// SQL flags.
+		f.DurationVar(&ctx.SlowQueryThreshold, "slow-query-threshold", ctx.SlowQueryThreshold, flagUsage["slow-query-threshold"])
+		f.Int64Var(&ctx.SQLMemoryBudget, "sql-memory-budget", ctx.SQLMemoryBudget, flagUsage["sql-memory-budget"])
+		f.Int64Var(&ctx.SQLSessionMemoryBudget, "sql-session-memory-budget", ctx.SQLSessionMemoryBudget, flagUsage["sql-session-memory-budget"])
+		f.StringVar(&ctx.ExternalIODir, "external-io-dir", ctx.ExternalIODir, flagUsage["external-io-dir"])
+
 		// Engine flags.
 		f.Int64Var(&ctx.CacheSize, "cache-size", ctx.CacheSize, flagUsage["cache-size"])
 		f.DurationVar(&ctx.ScanInterval, "scan-interval", ctx.ScanInterval, flagUsage["scan-interval"])
@@ -229,7 +256,7 @@ func initFlags(ctx *server.Context) {
 	}
 
 	clientCmds := []*cobra.Command{
-		sqlShellCmd, kvCmd, rangeCmd,
+		sqlShellCmd, dumpCmd, kvCmd, rangeCmd,
 		userCmd, zoneCmd,
 		exterminateCmd, quitCmd, /* startCmd is covered above */
 	}
@@ -152,11 +152,23 @@ func (b *Batch) fillResults(br *roachpb.BatchResponse, pErr *roachpb.Error) erro
 						dst.Value = &src.Value
 					}
 				}
+			case *roachpb.FilteredScanRequest:
+				if result.Err == nil {
+					t := reply.(*roachpb.FilteredScanResponse)
+					result.Rows = make([]KeyValue, len(t.Rows))
+					for j := range t.Rows {
+						src := &t.Rows[j]
+						dst := &result.Rows[j]
+						dst.Key = src.Key
+						dst.Value = &src.Value
+					}
+				}
 			case *roachpb.DeleteRequest:
 				row := &result.Rows[k]
 				row.Key = []byte(args.(*roachpb.DeleteRequest).Key)
 
 			case *roachpb.DeleteRangeRequest:
+			case *roachpb.ClearRangeRequest:
 			case *roachpb.BeginTransactionRequest:
 			case *roachpb.EndTransactionRequest:
 			case *roachpb.AdminMergeRequest:
@@ -336,6 +348,29 @@ func (b *Batch) ReverseScan(s, e interface{}, maxRows int64) {
 	b.scan(s, e, maxRows, true)
 }
 
+// FilteredScan retrieves the rows between begin (inclusive) and end
+// (exclusive) in ascending order which pass the supplied filter. The filter is
+// evaluated by the ranges holding the rows; see storage.ScanFilterFunc.
+//
+// A new result will be appended to the batch which will contain up to maxRows
+// of the matching rows and Result.Err will indicate success or failure.
+//
+// key can be either a byte slice or a string.
+func (b *Batch) FilteredScan(s, e interface{}, maxRows int64, filter []byte) {
+	begin, err := marshalKey(s)
+	if err != nil {
+		b.initResult(0, 0, err)
+		return
+	}
+	end, err := marshalKey(e)
+	if err != nil {
+		b.initResult(0, 0, err)
+		return
+	}
+	b.reqs = append(b.reqs, roachpb.NewFilteredScan(roachpb.Key(begin), roachpb.Key(end), maxRows, filter))
+	b.initResult(1, 0, nil)
+}
+
 // Del deletes one or more keys.
 //
 // A new result will be appended to the batch and each key will have a
@@ -377,6 +412,30 @@ func (b *Batch) DelRange(s, e interface{}) {
 	b.initResult(1, 0, nil)
 }
 
+// ClearRange removes all versions of the rows between begin (inclusive) and
+// end (exclusive) without leaving MVCC tombstones behind. The rows are gone at
+// every timestamp, so this must only be used on data which will never be read
+// again. ClearRange cannot be used within a transaction.
+//
+// A new result will be appended to the batch which will contain 0 rows and
+// Result.Err will indicate success or failure.
+//
+// key can be either a byte slice or a string.
+func (b *Batch) ClearRange(s, e interface{}) {
+	begin, err := marshalKey(s)
+	if err != nil {
+		b.initResult(0, 0, err)
+		return
+	}
+	end, err := marshalKey(e)
+	if err != nil {
+		b.initResult(0, 0, err)
+		return
+	}
+	b.reqs = append(b.reqs, roachpb.NewClearRange(roachpb.Key(begin), roachpb.Key(end)))
+	b.initResult(1, 0, nil)
+}
+
 // adminMerge is only exported on DB. It is here for symmetry with the
 // other operations.
 func (b *Batch) adminMerge(key interface{}) {
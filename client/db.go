@@ -383,6 +383,18 @@ func (db *DB) DelRange(begin, end interface{}) error {
 	return err
 }
 
+// ClearRange removes all versions of the rows between begin (inclusive) and
+// end (exclusive) without leaving MVCC tombstones behind. See
+// Batch.ClearRange.
+//
+// key can be either a byte slice or a string.
+func (db *DB) ClearRange(begin, end interface{}) error {
+	b := db.NewBatch()
+	b.ClearRange(begin, end)
+	_, err := runOneResult(db, b)
+	return err
+}
+
 // AdminMerge merges the range containing key and the subsequent
 // range. After the merge operation is complete, the range containing
 // key will contain all of the key/value pairs of the subsequent range
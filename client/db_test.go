@@ -352,6 +352,14 @@ func TestCommonMethods(t *testing.T) {
 		key{dbType, "GetProto"}:  {},
 		key{txnType, "GetProto"}: {},
 
+		// FilteredScan is only issued in batches by the SQL layer, which
+		// constructs the filters.
+		key{batchType, "FilteredScan"}: {},
+
+		// ClearRange cannot be part of a transaction.
+		key{batchType, "ClearRange"}: {},
+		key{dbType, "ClearRange"}:    {},
+
 		key{batchType, "InternalAddRequest"}:      {},
 		key{dbType, "AdminMerge"}:                 {},
 		key{dbType, "AdminSplit"}:                 {},
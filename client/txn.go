@@ -309,8 +309,10 @@ func (txn *Txn) Cleanup(err error) {
 }
 
 // CommitNoCleanup is the same as Commit but will not attempt to clean
-// up on failure. It is exposed only for use in txn_correctness_test.go
-// because those tests manipulate transaction state at a low level.
+// up on failure. This allows the caller to restart the transaction after a
+// retryable error, as done by SQL's RELEASE SAVEPOINT. It is also used by
+// txn_correctness_test.go, which manipulates transaction state at a low
+// level.
 func (txn *Txn) CommitNoCleanup() error {
 	return txn.commit()
 }
@@ -74,6 +74,11 @@ func marshalValue(v interface{}) (roachpb.Value, error) {
 		r.SetTime(t)
 		return r, nil
 
+	case roachpb.Value:
+		// Values are passed through as is, which allows copying existing KVs
+		// without knowing their type.
+		return t, nil
+
 	case proto.Message:
 		err := r.SetProto(t)
 		return r, err
@@ -28,6 +28,7 @@ import (
 
 	"github.com/cockroachdb/cockroach/client"
 	"github.com/cockroachdb/cockroach/gossip"
+	"github.com/cockroachdb/cockroach/keys"
 	"github.com/cockroachdb/cockroach/roachpb"
 	"github.com/cockroachdb/cockroach/rpc"
 	"github.com/cockroachdb/cockroach/util"
@@ -415,6 +416,37 @@ func (ds *DistSender) getDescriptors(rs rSpan, options lookupOptions) (*roachpb.
 	return desc, needAnother(desc, options.useReverseScan), evict, nil
 }
 
+// A RangeLeader pairs the descriptor of a range with the replica believed
+// to hold its leader lease. Leader is the zero ReplicaDescriptor if the
+// leader is not known.
+type RangeLeader struct {
+	Desc   roachpb.RangeDescriptor
+	Leader roachpb.ReplicaDescriptor
+}
+
+// LookupRangeLeaders returns the ranges overlapping the span [key, endKey) in
+// key order, along with their leaders as last seen by this DistSender. The
+// descriptors are looked up through the range descriptor cache and might be
+// stale.
+func (ds *DistSender) LookupRangeLeaders(key, endKey roachpb.Key) ([]RangeLeader, error) {
+	rs := rSpan{key: keys.Addr(key), endKey: keys.Addr(endKey)}
+	var ranges []RangeLeader
+	for {
+		desc, needAnother, _, pErr := ds.getDescriptors(rs, lookupOptions{})
+		if pErr != nil {
+			return nil, pErr.GoError()
+		}
+		ranges = append(ranges, RangeLeader{
+			Desc:   *desc,
+			Leader: ds.leaderCache.Lookup(desc.RangeID),
+		})
+		if !needAnother {
+			return ranges, nil
+		}
+		rs.key = desc.EndKey
+	}
+}
+
 // sendAttempt gathers and rearranges the replicas, and makes an RPC call.
 func (ds *DistSender) sendAttempt(trace *tracer.Trace, ba roachpb.BatchRequest, desc *roachpb.RangeDescriptor) (*roachpb.BatchResponse, *roachpb.Error) {
 	defer trace.Epoch("sending RPC")()
@@ -516,9 +548,11 @@ func (ds *DistSender) sendChunk(ctx context.Context, ba roachpb.BatchRequest) (*
 
 			// If there's no transaction and op spans ranges, possibly
 			// re-run as part of a transaction for consistency. The
-			// case where we don't need to re-run is if the read
-			// consistency is not required.
-			if needAnother && ba.Txn == nil && ba.ReadConsistency != roachpb.INCONSISTENT {
+			// cases where we don't need to re-run are if the read
+			// consistency is not required or if the op must not be
+			// part of a transaction.
+			if needAnother && ba.Txn == nil && ba.ReadConsistency != roachpb.INCONSISTENT &&
+				!ba.IsNonTransactional() {
 				return nil, roachpb.NewError(&roachpb.OpRequiresTxnError{})
 			}
 
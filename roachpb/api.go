@@ -67,6 +67,7 @@ const (
 	isRange                // range commands may span multiple keys
 	isReverse              // reverse commands traverse ranges in descending direction
 	isAlone                // requests which must be alone in a batch
+	isNonTxn               // requests which must not be part of a transaction
 )
 
 // IsReadOnly returns true iff the request is read-only.
@@ -158,6 +159,30 @@ func (sr *ReverseScanResponse) Combine(c Response) error {
 	return nil
 }
 
+// Combine implements the Combinable interface.
+func (sr *FilteredScanResponse) Combine(c Response) error {
+	otherSR := c.(*FilteredScanResponse)
+	if sr != nil {
+		sr.Rows = append(sr.Rows, otherSR.Rows...)
+		if err := sr.Header().Combine(otherSR.Header()); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Combine implements the Combinable interface.
+func (cr *ClearRangeResponse) Combine(c Response) error {
+	otherCR := c.(*ClearRangeResponse)
+	if cr != nil {
+		cr.NumCleared += otherCR.NumCleared
+		if err := cr.Header().Combine(otherCR.Header()); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
 // Combine implements the Combinable interface.
 func (dr *DeleteRangeResponse) Combine(c Response) error {
 	otherDR := c.(*DeleteRangeResponse)
@@ -226,6 +251,16 @@ func (sr *ReverseScanResponse) Verify(req Request) error {
 	return nil
 }
 
+// Verify verifies the integrity of every value returned in the filtered scan.
+func (sr *FilteredScanResponse) Verify(req Request) error {
+	for _, kv := range sr.Rows {
+		if err := kv.Value.Verify(kv.Key); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
 // GetInner returns the Request contained in the union.
 func (ru RequestUnion) GetInner() Request {
 	return ru.GetValue().(Request)
@@ -263,6 +298,16 @@ func (sr *ReverseScanRequest) SetBound(bound int64) {
 	sr.MaxResults = bound
 }
 
+// GetBound returns the MaxResults field in FilteredScanRequest.
+func (sr *FilteredScanRequest) GetBound() int64 {
+	return sr.MaxResults
+}
+
+// SetBound sets the MaxResults field in FilteredScanRequest.
+func (sr *FilteredScanRequest) SetBound(bound int64) {
+	sr.MaxResults = bound
+}
+
 // Countable is implemented by response types which have a number of
 // result rows, such as Scan.
 type Countable interface {
@@ -279,6 +324,11 @@ func (sr *ReverseScanResponse) Count() int64 {
 	return int64(len(sr.Rows))
 }
 
+// Count returns the number of rows in FilteredScanResponse.
+func (sr *FilteredScanResponse) Count() int64 {
+	return int64(len(sr.Rows))
+}
+
 // Method implements the Request interface.
 func (*GetRequest) Method() Method { return Get }
 
@@ -297,12 +347,18 @@ func (*DeleteRequest) Method() Method { return Delete }
 // Method implements the Request interface.
 func (*DeleteRangeRequest) Method() Method { return DeleteRange }
 
+// Method implements the Request interface.
+func (*ClearRangeRequest) Method() Method { return ClearRange }
+
 // Method implements the Request interface.
 func (*ScanRequest) Method() Method { return Scan }
 
 // Method implements the Request interface.
 func (*ReverseScanRequest) Method() Method { return ReverseScan }
 
+// Method implements the Request interface.
+func (*FilteredScanRequest) Method() Method { return FilteredScan }
+
 // Method implements the Request interface.
 func (*BeginTransactionRequest) Method() Method { return BeginTransaction }
 
@@ -363,12 +419,18 @@ func (*DeleteRequest) CreateReply() Response { return &DeleteResponse{} }
 // CreateReply implements the Request interface.
 func (*DeleteRangeRequest) CreateReply() Response { return &DeleteRangeResponse{} }
 
+// CreateReply implements the Request interface.
+func (*ClearRangeRequest) CreateReply() Response { return &ClearRangeResponse{} }
+
 // CreateReply implements the Request interface.
 func (*ScanRequest) CreateReply() Response { return &ScanResponse{} }
 
 // CreateReply implements the Request interface.
 func (*ReverseScanRequest) CreateReply() Response { return &ReverseScanResponse{} }
 
+// CreateReply implements the Request interface.
+func (*FilteredScanRequest) CreateReply() Response { return &FilteredScanResponse{} }
+
 // CreateReply implements the Request interface.
 func (*BeginTransactionRequest) CreateReply() Response { return &BeginTransactionResponse{} }
 
@@ -482,6 +544,17 @@ func NewDeleteRange(startKey, endKey Key) Request {
 	}
 }
 
+// NewClearRange returns a Request initialized to clear the values in the
+// given key range (excluding the endpoint).
+func NewClearRange(startKey, endKey Key) Request {
+	return &ClearRangeRequest{
+		Span: Span{
+			Key:    startKey,
+			EndKey: endKey,
+		},
+	}
+}
+
 // NewScan returns a Request initialized to scan from start to end keys
 // with max results.
 func NewScan(key, endKey Key, maxResults int64) Request {
@@ -506,14 +579,29 @@ func NewReverseScan(key, endKey Key, maxResults int64) Request {
 	}
 }
 
+// NewFilteredScan returns a Request initialized to scan from start to end
+// keys with max results, returning only the rows which pass the given filter.
+func NewFilteredScan(key, endKey Key, maxResults int64, filter []byte) Request {
+	return &FilteredScanRequest{
+		Span: Span{
+			Key:    key,
+			EndKey: endKey,
+		},
+		Filter:     filter,
+		MaxResults: maxResults,
+	}
+}
+
 func (*GetRequest) flags() int                { return isRead }
 func (*PutRequest) flags() int                { return isWrite | isTxnWrite }
 func (*ConditionalPutRequest) flags() int     { return isRead | isWrite | isTxnWrite }
 func (*IncrementRequest) flags() int          { return isRead | isWrite | isTxnWrite }
 func (*DeleteRequest) flags() int             { return isWrite | isTxnWrite }
 func (*DeleteRangeRequest) flags() int        { return isWrite | isTxnWrite | isRange }
+func (*ClearRangeRequest) flags() int         { return isWrite | isRange | isAlone | isNonTxn }
 func (*ScanRequest) flags() int               { return isRead | isRange }
 func (*ReverseScanRequest) flags() int        { return isRead | isRange | isReverse }
+func (*FilteredScanRequest) flags() int       { return isRead | isRange }
 func (*BeginTransactionRequest) flags() int   { return isWrite }
 func (*EndTransactionRequest) flags() int     { return isWrite | isAlone }
 func (*AdminSplitRequest) flags() int         { return isAdmin | isAlone }
@@ -55,6 +55,12 @@ func (ba *BatchRequest) IsRange() bool {
 	return (ba.flags() & isRange) != 0
 }
 
+// IsNonTransactional returns true iff the BatchRequest contains a request
+// which must not be part of a transaction.
+func (ba *BatchRequest) IsNonTransactional() bool {
+	return (ba.flags() & isNonTxn) != 0
+}
+
 // GetArg returns the first request of the given type, if possible.
 func (ba *BatchRequest) GetArg(method Method) (Request, bool) {
 	// TODO(tschottdorf): when looking for EndTransaction, just look at the
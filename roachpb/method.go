@@ -106,4 +106,13 @@ const (
 	// Batch implements batch processing of commands. This is a
 	// superset of the Batch method.
 	Batch
+	// FilteredScan fetches the values for all keys which fall between
+	// args.RequestHeader.Key and args.RequestHeader.EndKey, with
+	// the latter endpoint excluded, and returns only those rows which
+	// pass the filter supplied with the request.
+	FilteredScan
+	// ClearRange removes all versions of the keys which fall between
+	// args.RequestHeader.Key and args.RequestHeader.EndKey, with the
+	// latter endpoint excluded, without writing MVCC tombstones.
+	ClearRange
 )
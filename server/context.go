@@ -45,6 +45,9 @@ const (
 	defaultMetricsFrequency   = 10 * time.Second
 	defaultTimeUntilStoreDead = 5 * time.Minute
 	defaultAllowRebalancing   = false
+
+	defaultSQLMemoryBudget        = 1 << 30   // 1 GB
+	defaultSQLSessionMemoryBudget = 256 << 20 // 256 MB
 )
 
 // Context holds parameters needed to setup a server.
@@ -129,6 +132,22 @@ type Context struct {
 	// TimeUntilStoreDead is the time after which if there is no new gossiped
 	// information about a store, it is considered dead.
 	TimeUntilStoreDead time.Duration
+
+	// SlowQueryThreshold is the latency above which SQL statements are
+	// logged along with their plan. Zero disables the slow query log.
+	SlowQueryThreshold time.Duration
+
+	// SQLMemoryBudget is the number of bytes the SQL operators which buffer
+	// rows (sorts, aggregations, DISTINCT and subqueries) may use across all
+	// sessions, and SQLSessionMemoryBudget the number a single session may
+	// use. Zero is unlimited.
+	SQLMemoryBudget        int64
+	SQLSessionMemoryBudget int64
+
+	// ExternalIODir is the directory to which IMPORT, BACKUP and RESTORE are
+	// confined: the files they read and write are named relative to it. The
+	// statements are disabled if it is empty.
+	ExternalIODir string
 }
 
 // NewContext returns a Context with default values.
@@ -143,6 +162,9 @@ func NewContext() *Context {
 		MetricsFrequency:   defaultMetricsFrequency,
 		TimeUntilStoreDead: defaultTimeUntilStoreDead,
 		AllowRebalancing:   defaultAllowRebalancing,
+
+		SQLMemoryBudget:        defaultSQLMemoryBudget,
+		SQLSessionMemoryBudget: defaultSQLSessionMemoryBudget,
 	}
 	// Initializes base context defaults.
 	ctx.InitDefaults()
@@ -139,6 +139,12 @@ func NewServer(ctx *Context, stopper *stop.Stopper) (*Server, error) {
 	}
 
 	s.sqlServer = sql.MakeHTTPServer(&s.ctx.Context, *s.db, s.gossip, s.clock)
+	s.sqlServer.SetSlowQueryThreshold(s.ctx.SlowQueryThreshold)
+	s.sqlServer.SetMemoryBudgets(s.ctx.SQLMemoryBudget, s.ctx.SQLSessionMemoryBudget)
+	s.sqlServer.SetExternalIODir(s.ctx.ExternalIODir)
+	if err := s.sqlServer.EnableDistSQL(s.rpc, rpcContext, ds, s.gossip, s.clock); err != nil {
+		return nil, err
+	}
 
 	// TODO(bdarnell): make StoreConfig configurable.
 	nCtx := storage.StoreContext{
@@ -151,13 +157,14 @@ func NewServer(ctx *Context, stopper *stop.Stopper) (*Server, error) {
 		EventFeed:       feed,
 		Tracer:          tracer,
 		StorePool:       s.storePool,
+		ScanFilter:      sql.RunScanFilter,
 		RebalancingOptions: storage.RebalancingOptions{
 			AllowRebalance: s.ctx.AllowRebalancing,
 		},
 	}
 	s.node = NewNode(nCtx)
 	s.admin = newAdminServer(s.db, s.stopper)
-	s.status = newStatusServer(s.db, s.gossip, ctx)
+	s.status = newStatusServer(s.db, s.gossip, s.sqlServer.Executor, ctx)
 	s.tsDB = ts.NewDB(s.db)
 	s.tsServer = ts.NewServer(s.tsDB)
 
@@ -192,12 +199,14 @@ func (s *Server) Start(selfBootstrap bool) error {
 
 	// Begin recording time series data collected by the status monitor.
 	s.recorder = status.NewNodeStatusRecorder(s.node.status, s.clock)
+	s.recorder.SetSQLMemoryReporter(s.sqlServer.Executor)
 	s.tsDB.PollSource(s.recorder, s.ctx.MetricsFrequency, ts.Resolution10s, s.stopper)
 
 	// Begin recording status summaries.
 	s.startWriteSummaries()
 
 	s.sqlServer.SetNodeID(s.node.Descriptor.NodeID)
+	s.sqlServer.StartTableGC(s.stopper)
 
 	log.Infof("starting %s server at %s", s.ctx.HTTPRequestScheme(), s.rpc.Addr())
 	s.initHTTP()
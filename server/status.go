@@ -32,6 +32,7 @@ import (
 	"github.com/cockroachdb/cockroach/keys"
 	"github.com/cockroachdb/cockroach/roachpb"
 	"github.com/cockroachdb/cockroach/server/status"
+	"github.com/cockroachdb/cockroach/sql"
 	"github.com/cockroachdb/cockroach/storage"
 	"github.com/cockroachdb/cockroach/util"
 	"github.com/cockroachdb/cockroach/util/log"
@@ -51,6 +52,8 @@ const (
 		/_status/logs/:node_id           - log entries from a specific node
 		/_status/stacks/:node_id		 - exposes stack traces of running
 										   goroutines
+		/_status/statements/:node_id     - per-fingerprint SQL statement
+										   statistics
 		/_status/nodes				     - all nodes' status
 		/_status/nodes/:node_id		     - a specific node's status
 		/_status/stores                  - all stores' status
@@ -81,6 +84,9 @@ const (
 	// stackTraceApproxSize is the approximate size of a goroutine stack trace.
 	stackTraceApproxSize = 1024
 
+	// statusStatementsPattern exposes the SQL statement statistics of a node.
+	statusStatementsPattern = "/_status/statements/:node_id"
+
 	// statusNodesPrefix exposes status for all nodes in the cluster.
 	statusNodesPrefix = "/_status/nodes/"
 	// statusNodePattern exposes status for a single node.
@@ -99,13 +105,14 @@ var localRE = regexp.MustCompile(`(?i)local`)
 type statusServer struct {
 	db          *client.DB
 	gossip      *gossip.Gossip
+	sqlExecutor *sql.Executor
 	router      *httprouter.Router
 	ctx         *Context
 	proxyClient *http.Client
 }
 
 // newStatusServer allocates and returns a statusServer.
-func newStatusServer(db *client.DB, gossip *gossip.Gossip, ctx *Context) *statusServer {
+func newStatusServer(db *client.DB, gossip *gossip.Gossip, sqlExecutor *sql.Executor, ctx *Context) *statusServer {
 	// Create an http client with a timeout
 	tlsConfig, err := ctx.GetClientTLSConfig()
 	if err != nil {
@@ -120,6 +127,7 @@ func newStatusServer(db *client.DB, gossip *gossip.Gossip, ctx *Context) *status
 	server := &statusServer{
 		db:          db,
 		gossip:      gossip,
+		sqlExecutor: sqlExecutor,
 		router:      httprouter.New(),
 		ctx:         ctx,
 		proxyClient: httpClient,
@@ -131,6 +139,7 @@ func newStatusServer(db *client.DB, gossip *gossip.Gossip, ctx *Context) *status
 	server.router.GET(statusLogFilePattern, server.handleLogFile)
 	server.router.GET(statusLogsPattern, server.handleLogs)
 	server.router.GET(statusStacksPattern, server.handleStacks)
+	server.router.GET(statusStatementsPattern, server.handleStatements)
 	server.router.GET(statusNodesPrefix, server.handleNodesStatus)
 	server.router.GET(statusNodePattern, server.handleNodeStatus)
 	server.router.GET(statusStoresPrefix, server.handleStoresStatus)
@@ -498,6 +507,26 @@ func (s *statusServer) handleStacks(w http.ResponseWriter, r *http.Request, ps h
 	}
 }
 
+// handleStatementsLocal handles local requests for SQL statement statistics.
+func (s *statusServer) handleStatementsLocal(w http.ResponseWriter, r *http.Request, _ httprouter.Params) {
+	respondAsJSON(w, r, s.sqlExecutor.StatementStatistics())
+}
+
+// handleStatements handles GET requests for SQL statement statistics.
+func (s *statusServer) handleStatements(w http.ResponseWriter, r *http.Request, ps httprouter.Params) {
+	nodeID, local, err := s.extractNodeID(ps)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	if local {
+		s.handleStatementsLocal(w, r, ps)
+	} else {
+		s.proxyRequest(nodeID, w, r)
+	}
+}
+
 // handleNodesStatus handles GET requests for all node statuses.
 func (s *statusServer) handleNodesStatus(w http.ResponseWriter, r *http.Request, _ httprouter.Params) {
 	startKey := keys.StatusNodePrefix
@@ -50,6 +50,15 @@ const (
 	runtimeStatTimeSeriesNameFmt = "cr.node.sys.%s"
 )
 
+// SQLMemoryReporter reports the memory used by the SQL operators of a node
+// which buffer rows.
+type SQLMemoryReporter interface {
+	// MemoryUsage returns the number of bytes currently in use.
+	MemoryUsage() int64
+	// MaxMemoryUsage returns the most bytes ever in use at once.
+	MaxMemoryUsage() int64
+}
+
 // NodeStatusRecorder is used to periodically persist the status of a node as a
 // set of time series data.
 type NodeStatusRecorder struct {
@@ -58,6 +67,7 @@ type NodeStatusRecorder struct {
 	source           string // Source string used when storing time series data for this node.
 	lastDataCount    int
 	lastSummaryCount int
+	sqlMemory        SQLMemoryReporter
 }
 
 // NewNodeStatusRecorder instantiates a recorder for the supplied monitor.
@@ -68,6 +78,12 @@ func NewNodeStatusRecorder(monitor *NodeStatusMonitor, clock *hlc.Clock) *NodeSt
 	}
 }
 
+// SetSQLMemoryReporter sets the source of the SQL memory usage recorded with
+// the node stats.
+func (nsr *NodeStatusRecorder) SetSQLMemoryReporter(r SQLMemoryReporter) {
+	nsr.sqlMemory = r
+}
+
 // recordInt records a single int64 value from the NodeStatusMonitor as a
 // ts.TimeSeriesData object.
 func (nsr *NodeStatusRecorder) recordInt(timestampNanos int64, name string,
@@ -107,6 +123,10 @@ func (nsr *NodeStatusRecorder) GetTimeSeriesData() []ts.TimeSeriesData {
 	now := nsr.clock.PhysicalNow()
 	data = append(data, nsr.recordInt(now, "calls.success", atomic.LoadInt64(&nsr.callCount)))
 	data = append(data, nsr.recordInt(now, "calls.error", atomic.LoadInt64(&nsr.callErrors)))
+	if nsr.sqlMemory != nil {
+		data = append(data, nsr.recordInt(now, "sql.mem.current", nsr.sqlMemory.MemoryUsage()))
+		data = append(data, nsr.recordInt(now, "sql.mem.max", nsr.sqlMemory.MaxMemoryUsage()))
+	}
 
 	// Record per store stats.
 	nsr.visitStoreMonitors(func(ssm *StoreStatusMonitor) {
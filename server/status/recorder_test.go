@@ -74,6 +74,14 @@ func (a byStoreDescID) Less(i, j int) bool {
 
 var _ sort.Interface = byStoreDescID{}
 
+// fakeSQLMemoryReporter reports a fixed SQL memory usage.
+type fakeSQLMemoryReporter struct {
+	current, max int64
+}
+
+func (r fakeSQLMemoryReporter) MemoryUsage() int64    { return r.current }
+func (r fakeSQLMemoryReporter) MaxMemoryUsage() int64 { return r.max }
+
 // TestNodeStatusRecorder verifies that the time series data generated by a
 // recorder matches the data added to the monitor.
 func TestNodeStatusRecorder(t *testing.T) {
@@ -123,6 +131,7 @@ func TestNodeStatusRecorder(t *testing.T) {
 	monitor := NewNodeStatusMonitor()
 	manual := hlc.NewManualClock(100)
 	recorder := NewNodeStatusRecorder(monitor, hlc.NewClock(manual.UnixNano))
+	recorder.SetSQLMemoryReporter(fakeSQLMemoryReporter{current: 1024, max: 4096})
 
 	// Initialization events.
 	monitor.OnStartNode(&StartNodeEvent{
@@ -278,6 +287,8 @@ func TestNodeStatusRecorder(t *testing.T) {
 		// Node stats.
 		generateNodeData(1, "calls.success", 100, 2),
 		generateNodeData(1, "calls.error", 100, 1),
+		generateNodeData(1, "sql.mem.current", 100, 1024),
+		generateNodeData(1, "sql.mem.max", 100, 4096),
 	}
 
 	actual := recorder.GetTimeSeriesData()
@@ -34,7 +34,10 @@ import (
 
 	"github.com/cockroachdb/cockroach/gossip"
 	"github.com/cockroachdb/cockroach/roachpb"
+	"github.com/cockroachdb/cockroach/security"
 	"github.com/cockroachdb/cockroach/server/status"
+	"github.com/cockroachdb/cockroach/sql"
+	"github.com/cockroachdb/cockroach/sql/driver"
 	"github.com/cockroachdb/cockroach/storage"
 	"github.com/cockroachdb/cockroach/ts"
 	"github.com/cockroachdb/cockroach/util"
@@ -70,6 +73,44 @@ func TestStatusLocalStacks(t *testing.T) {
 	}
 }
 
+// TestStatusStatements verifies that SQL statement statistics are available
+// via the /_status/statements/local endpoint and that statements differing
+// only in their constants are aggregated.
+func TestStatusStatements(t *testing.T) {
+	defer leaktest.AfterTest(t)
+	s := StartTestServer(t)
+	defer s.Stop()
+
+	for _, stmt := range []string{`SELECT 1`, `SELECT 2`, `SELECT 3 + 4`} {
+		resp, _, err := s.sqlServer.Execute(driver.Request{User: security.RootUser, Sql: stmt})
+		if err != nil {
+			t.Fatal(err)
+		}
+		if resp.Results[0].Error != nil {
+			t.Fatal(*resp.Results[0].Error)
+		}
+	}
+
+	type statementsWrapper struct {
+		Data []sql.StatementStatistics `json:"d"`
+	}
+	var stmtStats statementsWrapper
+	if err := json.Unmarshal(getRequest(t, *s, "/_status/statements/local"), &stmtStats); err != nil {
+		t.Fatal(err)
+	}
+	counts := map[string]int64{}
+	for _, ss := range stmtStats.Data {
+		counts[ss.Fingerprint] = ss.Count
+		if ss.Rows != ss.Count {
+			t.Errorf("%s: expected %d rows, got %d", ss.Fingerprint, ss.Count, ss.Rows)
+		}
+	}
+	expected := map[string]int64{`SELECT _`: 2, `SELECT _ + _`: 1}
+	if !reflect.DeepEqual(expected, counts) {
+		t.Errorf("expected %v, got %v", expected, counts)
+	}
+}
+
 // TestStatusJson verifies that status endpoints return expected Json results.
 // The content type of the responses is always util.JSONContentType.
 func TestStatusJson(t *testing.T) {
@@ -72,6 +72,11 @@ func (p *planner) AlterTable(n *parser.AlterTable) (planNode, error) {
 		switch t := cmd.(type) {
 		case *parser.AlterTableAddColumn:
 			d := t.ColumnDef
+			if d.ComputedExpr != nil {
+				// The existing rows would need to be backfilled with the computed
+				// values.
+				return nil, fmt.Errorf("computed column %q cannot be added to an existing table", d.Name)
+			}
 			col, idx, err := makeColumnDefDescs(d)
 			if err != nil {
 				return nil, err
@@ -89,9 +94,13 @@ func (p *planner) AlterTable(n *parser.AlterTable) (planNode, error) {
 				idx := IndexDescriptor{
 					Name:             string(d.Name),
 					Unique:           true,
-					ColumnNames:      d.Columns,
 					StoreColumnNames: d.Storing,
 				}
+				idx.fillColumns(d.Columns)
+				idx.setPredicate(d.Predicate)
+				if err := p.validateIndexPredicate(newTableDesc, &idx); err != nil {
+					return nil, err
+				}
 				if err := newTableDesc.AddIndex(idx, d.PrimaryKey); err != nil {
 					return nil, err
 				}
@@ -113,11 +122,62 @@ func (p *planner) AlterTable(n *parser.AlterTable) (planNode, error) {
 			if newTableDesc.PrimaryIndex.containsColumnID(col.ID) {
 				return nil, fmt.Errorf("column %q is referenced by the primary key", col.Name)
 			}
+			for _, other := range newTableDesc.Columns {
+				if other.ComputedExpr == nil {
+					continue
+				}
+				_, qvals, err := p.resolveComputedExpr(newTableDesc, other)
+				if err != nil {
+					return nil, err
+				}
+				if _, ok := qvals[col.ID]; ok {
+					return nil, fmt.Errorf("column %q is referenced by computed column %q", col.Name, other.Name)
+				}
+			}
+			// The non-unique indexes which reference the column, either as an
+			// indexed column or in their predicate, are dropped with it. Dropping a
+			// unique index would silently drop its UNIQUE constraint, so it must
+			// be dropped explicitly first. The indexes storing the column are
+			// rebuilt without it.
+			indexes := newTableDesc.Indexes[:0]
 			for _, idx := range newTableDesc.Indexes {
-				if idx.containsColumnID(col.ID) {
-					return nil, fmt.Errorf("column %q is referenced by existing index %q", col.Name, idx.Name)
+				// The stored columns are among the implicit columns of the index,
+				// so only its explicit columns are checked.
+				referenced := false
+				for _, id := range idx.ColumnIDs {
+					if id == col.ID {
+						referenced = true
+					}
+				}
+				if !referenced && idx.Predicate != nil {
+					_, qvals, err := p.resolveTableExpr(newTableDesc, *idx.Predicate)
+					if err != nil {
+						return nil, err
+					}
+					_, referenced = qvals[col.ID]
+				}
+				if referenced {
+					if idx.Unique {
+						return nil, fmt.Errorf("column %q is referenced by unique index %q", col.Name, idx.Name)
+					}
+					continue
+				}
+				var storing []string
+				for _, name := range idx.StoreColumnNames {
+					if normalizeName(name) != normalizeName(col.Name) {
+						storing = append(storing, name)
+					}
+				}
+				if len(storing) != len(idx.StoreColumnNames) {
+					// The stored columns are encoded in the index entries: the index
+					// is given a new ID, which drops the existing entries and
+					// backfills the index.
+					idx.StoreColumnNames = storing
+					idx.ID = 0
 				}
+				indexes = append(indexes, idx)
 			}
+			newTableDesc.Indexes = indexes
 
 			newTableDesc.Columns = append(newTableDesc.Columns[:i], newTableDesc.Columns[i+1:]...)
 
@@ -132,6 +192,71 @@ func (p *planner) AlterTable(n *parser.AlterTable) (planNode, error) {
 			}
 			newTableDesc.Indexes = append(newTableDesc.Indexes[:i], newTableDesc.Indexes[i+1:]...)
 
+		case *parser.AlterTableSetDefault:
+			i, err := newTableDesc.FindColumnByName(t.Column)
+			if err != nil {
+				return nil, err
+			}
+			col := &newTableDesc.Columns[i]
+			if col.ComputedExpr != nil {
+				return nil, fmt.Errorf("computed column %q cannot have a default expression", col.Name)
+			}
+			if t.Default == nil {
+				col.DefaultExpr = nil
+				continue
+			}
+			colDatumType, err := makeValType(col.Type)
+			if err != nil {
+				return nil, err
+			}
+			if err := col.setDefaultExpr(t.Default, colDatumType); err != nil {
+				return nil, err
+			}
+
+		case *parser.AlterTableSetNotNull:
+			i, err := newTableDesc.FindColumnByName(t.Column)
+			if err != nil {
+				return nil, err
+			}
+			col := &newTableDesc.Columns[i]
+			if !col.Nullable {
+				continue
+			}
+			if err := p.validateNotNull(n.Table, tableDesc, col.Name); err != nil {
+				return nil, err
+			}
+			col.Nullable = false
+
+		case *parser.AlterTableDropNotNull:
+			i, err := newTableDesc.FindColumnByName(t.Column)
+			if err != nil {
+				return nil, err
+			}
+			col := &newTableDesc.Columns[i]
+			if newTableDesc.PrimaryIndex.containsColumnID(col.ID) {
+				return nil, fmt.Errorf("column %q is in a primary index", col.Name)
+			}
+			col.Nullable = true
+
+		case *parser.AlterTableAlterColumnType:
+			i, err := newTableDesc.FindColumnByName(t.Column)
+			if err != nil {
+				return nil, err
+			}
+			col := &newTableDesc.Columns[i]
+			typeCol, _, err := makeColumnDefDescs(&parser.ColumnTableDef{
+				Name: parser.Name(col.Name),
+				Type: t.Type,
+			})
+			if err != nil {
+				return nil, err
+			}
+			if !col.Type.canWidenTo(typeCol.Type) {
+				return nil, fmt.Errorf("cannot change type of column %q from %s to %s",
+					col.Name, col.Type.SQLString(), typeCol.Type.SQLString())
+			}
+			col.Type = typeCol.Type
+
 		default:
 			return nil, util.Errorf("unsupported alter cmd: %T", cmd)
 		}
@@ -157,3 +282,21 @@ func (p *planner) AlterTable(n *parser.AlterTable) (planNode, error) {
 
 	return &valuesNode{}, nil
 }
+
+// validateNotNull returns an error if the table contains a row for which the
+// column is NULL. Columns which were added by the ALTER TABLE statement are
+// NULL for every existing row.
+func (p *planner) validateNotNull(table *parser.QualifiedName, tableDesc *TableDescriptor, colName string) error {
+	sql := fmt.Sprintf("SELECT COUNT(*) FROM %s", table)
+	if _, err := tableDesc.FindColumnByName(colName); err == nil {
+		sql += fmt.Sprintf(" WHERE %s IS NULL", parser.Name(colName))
+	}
+	row, err := p.queryRow(sql)
+	if err != nil {
+		return err
+	}
+	if count := row[0].(parser.DInt); count > 0 {
+		return fmt.Errorf("column %q contains %d null values", colName, count)
+	}
+	return nil
+}
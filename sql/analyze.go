@@ -167,6 +167,10 @@ func simplifyNotExpr(n *parser.NotExpr) (parser.Expr, bool) {
 			op = parser.NotSimilarTo
 		case parser.NotSimilarTo:
 			op = parser.SimilarTo
+		case parser.Is:
+			op = parser.IsNot
+		case parser.IsNot:
+			op = parser.Is
 		default:
 			return parser.DBool(true), false
 		}
@@ -269,6 +269,8 @@ func TestSimplifyNotExpr(t *testing.T) {
 		{`NOT a <= 1`, `a > 1`, true, true},
 		{`NOT a IN (1, 2)`, `a NOT IN (1, 2)`, true, true},
 		{`NOT a NOT IN (1, 2)`, `a IN (1, 2)`, true, true},
+		{`NOT a IS NULL`, `a IS NOT NULL`, true, true},
+		{`NOT a IS NOT NULL`, `a IS NULL`, true, true},
 		{`NOT i LIKE 'foo'`, `true`, false, false},
 		{`NOT i NOT LIKE 'foo'`, `i = 'foo'`, false, false},
 		{`NOT i SIMILAR TO 'foo'`, `true`, false, false},
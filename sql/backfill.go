@@ -91,10 +91,10 @@ func (p *planner) backfillBatch(b *client.Batch, tableName *parser.QualifiedName
 
 		// Run `UPDATE <table> SET col1 = NULL, col2 = NULL, ...` to clear
 		// the data stored in the columns being dropped.
-		if _, err := p.Update(&parser.Update{
+		if _, err := p.update(&parser.Update{
 			Table: table,
 			Exprs: updateExprs,
-		}); err != nil {
+		}, true); err != nil {
 			return err
 		}
 	}
@@ -132,7 +132,6 @@ func (p *planner) backfillBatch(b *client.Batch, tableName *parser.QualifiedName
 	if len(newIndexDescs) > 0 {
 		// Get all the rows affected.
 		// TODO(vivek): Avoid going through Select.
-		// TODO(tamird): Support partial indexes?
 		rows, err := p.Select(&parser.Select{
 			Exprs: parser.SelectExprs{parser.StarSelectExpr()},
 			From:  parser.TableExprs{table},
@@ -148,6 +147,13 @@ func (p *planner) backfillBatch(b *client.Batch, tableName *parser.QualifiedName
 			return err
 		}
 
+		// Partial indexes only get entries for the rows matching their
+		// predicates.
+		predicates, err := p.makeIndexPredicates(newTableDesc)
+		if err != nil {
+			return err
+		}
+
 		// TODO(tamird): This will fall down in production use. We need to do
 		// something better (see #2036). In particular, this implementation
 		// has the following problems:
@@ -162,7 +168,11 @@ func (p *planner) backfillBatch(b *client.Batch, tableName *parser.QualifiedName
 		for rows.Next() {
 			rowVals := rows.Values()
 
-			for _, newIndexDesc := range newIndexDescs {
+			indexes, err := predicates.filter(p.evalCtx, newIndexDescs, colIDtoRowIndex, rowVals)
+			if err != nil {
+				return err
+			}
+			for _, newIndexDesc := range indexes {
 				secondaryIndexEntries, err := encodeSecondaryIndexes(
 					oldTableDesc.ID, []IndexDescriptor{newIndexDesc}, colIDtoRowIndex, rowVals)
 				if err != nil {
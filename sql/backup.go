@@ -0,0 +1,677 @@
+// Copyright 2015 The Cockroach Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+// implied. See the License for the specific language governing
+// permissions and limitations under the License. See the AUTHORS file
+// for names of contributors.
+
+package sql
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/binary"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"sort"
+
+	"github.com/cockroachdb/cockroach/client"
+	"github.com/cockroachdb/cockroach/keys"
+	"github.com/cockroachdb/cockroach/roachpb"
+	"github.com/cockroachdb/cockroach/security"
+	"github.com/cockroachdb/cockroach/sql/parser"
+	"github.com/cockroachdb/cockroach/sql/privilege"
+	"github.com/cockroachdb/cockroach/util"
+	"github.com/cockroachdb/cockroach/util/encoding"
+	"github.com/gogo/protobuf/proto"
+)
+
+// A backup is a directory holding a BACKUP file, which contains the
+// BackupDescriptor, and a data file, which contains the backed up KVs as a
+// sequence of length prefixed BackupKeyValues. The BACKUP file is written
+// last so that its presence marks a complete backup.
+const (
+	backupDescriptorName = "BACKUP"
+	backupDataName       = "data"
+
+	// backupScanBatchSize is the number of KVs read at a time while backing
+	// up a table.
+	backupScanBatchSize = 10000
+)
+
+// Backup writes the descriptors and KVs of the targeted databases and tables
+// to a directory. All of the KVs are read in the statement's transaction and
+// thus at a single MVCC timestamp. An incremental backup only contains the
+// KVs written after the timestamp of the backup it is incremental to, along
+// with deletion markers for the KVs removed since then, and the ranges only
+// send the values of those KVs.
+// Privileges: "root" user.
+func (p *planner) Backup(n *parser.Backup) (planNode, error) {
+	if p.user != security.RootUser {
+		return nil, fmt.Errorf("only %s is allowed to back up", security.RootUser)
+	}
+	dir, err := p.externalPath(n.To)
+	if err != nil {
+		return nil, err
+	}
+	if _, err := os.Stat(filepath.Join(dir, backupDescriptorName)); err == nil {
+		return nil, fmt.Errorf("%s already contains a backup", n.To)
+	}
+
+	desc := BackupDescriptor{}
+	if err := p.backupTargets(n.Targets, &desc); err != nil {
+		return nil, err
+	}
+	tableIDs := map[ID]struct{}{}
+	for _, table := range desc.Tables {
+		tableIDs[table.ID] = struct{}{}
+	}
+
+	if len(n.IncrementalFrom) > 0 {
+		chain, err := p.readBackupChain(n.IncrementalFrom)
+		if err != nil {
+			return nil, err
+		}
+		desc.StartTime = chain[len(chain)-1].EndTime
+	}
+	// prevKeys iterates over the keys which existed at the time of the
+	// previous backup, in key order. It is empty for full backups.
+	prevKeys, err := p.newBackupChainIterator(n.IncrementalFrom, tableIDs)
+	if err != nil {
+		return nil, err
+	}
+	defer prevKeys.close()
+
+	if err := os.MkdirAll(dir, 0755); err != nil {
+		return nil, err
+	}
+	f, err := os.Create(filepath.Join(dir, backupDataName))
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+	w := bufio.NewWriter(f)
+
+	incremental := desc.StartTime != roachpb.ZeroTimestamp
+	// The ranges only return the values which changed since the previous
+	// backup. The keys of the unchanged KVs are still needed to find the ones
+	// which were deleted.
+	var filter []byte
+	if incremental {
+		if filter, err = proto.Marshal(&ScanFilter{ChangedSince: desc.StartTime}); err != nil {
+			return nil, err
+		}
+	}
+	writeDeleted := func(until roachpb.Key) error {
+		for prevKeys.key != nil && (until == nil || bytes.Compare(prevKeys.key, until) < 0) {
+			if err := writeBackupKeyValue(w, &BackupKeyValue{Key: prevKeys.key, Deleted: true}); err != nil {
+				return err
+			}
+			if err := prevKeys.next(); err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+
+	for _, table := range desc.Tables {
+		start := roachpb.Key(keys.MakeTablePrefix(uint32(table.ID)))
+		end := start.PrefixEnd()
+		for {
+			b := client.Batch{}
+			if incremental {
+				b.FilteredScan(start, end, backupScanBatchSize, filter)
+			} else {
+				b.Scan(start, end, backupScanBatchSize)
+			}
+			if err := p.txn.Run(&b); err != nil {
+				return nil, err
+			}
+			kvs := b.Results[0].Rows
+			for _, kv := range kvs {
+				if err := writeDeleted(kv.Key); err != nil {
+					return nil, err
+				}
+				if prevKeys.key.Equal(kv.Key) {
+					if err := prevKeys.next(); err != nil {
+						return nil, err
+					}
+				}
+				ts := kv.Value.Timestamp
+				if incremental && ts != nil && !desc.StartTime.Less(*ts) {
+					// Unchanged since the previous backup.
+					continue
+				}
+				// The checksum covers the key, which is rewritten on restore, and
+				// the timestamp is assigned when the value is written again.
+				value := *kv.Value
+				value.Checksum = nil
+				value.Timestamp = nil
+				if err := writeBackupKeyValue(w, &BackupKeyValue{Key: kv.Key, Value: value}); err != nil {
+					return nil, err
+				}
+			}
+			if len(kvs) < backupScanBatchSize {
+				break
+			}
+			start = roachpb.Key(kvs[len(kvs)-1].Key).Next()
+		}
+	}
+	if err := writeDeleted(nil); err != nil {
+		return nil, err
+	}
+	if err := w.Flush(); err != nil {
+		return nil, err
+	}
+
+	// The reads above were all performed at the transaction's original
+	// timestamp.
+	desc.EndTime = p.txn.Proto.OrigTimestamp
+	buf, err := proto.Marshal(&desc)
+	if err != nil {
+		return nil, err
+	}
+	if err := ioutil.WriteFile(filepath.Join(dir, backupDescriptorName), buf, 0644); err != nil {
+		return nil, err
+	}
+	return &valuesNode{}, nil
+}
+
+// backupTargets adds the descriptors of the targeted tables, and of the
+// databases containing them, to the backup descriptor. The tables are sorted
+// by ID, which is also the order of their KVs.
+func (p *planner) backupTargets(targets parser.TargetList, desc *BackupDescriptor) error {
+	var tableNames parser.QualifiedNames
+	if targets.Databases != nil {
+		for _, database := range targets.Databases {
+			dbDesc, err := p.getDatabaseDesc(string(database))
+			if err != nil {
+				return err
+			}
+			names, err := p.getTableNames(dbDesc)
+			if err != nil {
+				return err
+			}
+			tableNames = append(tableNames, names...)
+			// Databases are backed up even when they contain no tables.
+			desc.Databases = append(desc.Databases, *dbDesc)
+		}
+	} else {
+		tableNames = targets.Tables
+	}
+
+	seen := map[ID]struct{}{}
+	for _, db := range desc.Databases {
+		seen[db.ID] = struct{}{}
+	}
+	for _, tableName := range tableNames {
+		tableDesc, err := p.getTableDesc(tableName)
+		if err != nil {
+			return err
+		}
+		if err := p.checkPrivilege(tableDesc, privilege.SELECT); err != nil {
+			return err
+		}
+		if tableDesc.PrimaryIndex.isInterleaved() {
+			// The KVs of interleaved tables are stored in the key span of their
+			// outermost ancestor, so the key span of each table does not contain
+			// exactly its own KVs.
+			return fmt.Errorf("table %q is interleaved and cannot be backed up", tableDesc.Name)
+		}
+		if _, ok := seen[tableDesc.ID]; ok {
+			continue
+		}
+		seen[tableDesc.ID] = struct{}{}
+		desc.Tables = append(desc.Tables, *tableDesc)
+
+		if _, ok := seen[tableDesc.ParentID]; !ok {
+			dbDesc, err := p.getDatabaseDesc(tableName.Database())
+			if err != nil {
+				return err
+			}
+			seen[dbDesc.ID] = struct{}{}
+			desc.Databases = append(desc.Databases, *dbDesc)
+		}
+	}
+	sort.Sort(tableDescriptorsByID(desc.Tables))
+	return nil
+}
+
+type tableDescriptorsByID []TableDescriptor
+
+func (t tableDescriptorsByID) Len() int           { return len(t) }
+func (t tableDescriptorsByID) Swap(i, j int)      { t[i], t[j] = t[j], t[i] }
+func (t tableDescriptorsByID) Less(i, j int) bool { return t[i].ID < t[j].ID }
+
+// Restore recreates the targeted databases and tables from a full backup and
+// any number of incremental backups on top of it. The restored tables are
+// given new IDs and the keys of their KVs are rewritten accordingly. The
+// descriptors are taken from the last backup.
+//
+// Like IMPORT, the tables are created OFFLINE and their KVs are written in
+// transactions of their own, so that the size of a restore is not limited
+// by the size of a transaction. The tables are made public once all of the
+// KVs have been written. A failed restore removes the tables and the
+// databases it created.
+// Privileges: "root" user.
+func (p *planner) Restore(n *parser.Restore) (planNode, error) {
+	if p.user != security.RootUser {
+		return nil, fmt.Errorf("only %s is allowed to restore", security.RootUser)
+	}
+	if !p.implicitTxn {
+		return nil, fmt.Errorf("RESTORE cannot be used inside a transaction")
+	}
+	chain, err := p.readBackupChain(n.From)
+	if err != nil {
+		return nil, err
+	}
+	tables, databases, err := p.restoreTargets(n.Targets, &chain[len(chain)-1])
+	if err != nil {
+		return nil, p.removeRestoredDatabases(databases, err)
+	}
+
+	var offline []*offlineTable
+	fail := func(err error) error {
+		for _, t := range offline {
+			err = t.remove(err)
+		}
+		return p.removeRestoredDatabases(databases, err)
+	}
+
+	// Create the tables, mapping their IDs in the backup to the new ones.
+	newIDs := map[ID]ID{}
+	for i := range tables {
+		table := &tables[i]
+		if table.PrimaryIndex.isInterleaved() {
+			// The IDs of the interleaved ancestors and descendants of the table
+			// would also have to be rewritten.
+			return nil, fail(fmt.Errorf("table %q is interleaved and cannot be restored", table.Name))
+		}
+		oldID := table.ID
+		t, err := p.createOfflineTable(tableKey{table.ParentID, table.Name}, table)
+		if err != nil {
+			return nil, fail(err)
+		}
+		offline = append(offline, t)
+		newIDs[oldID] = table.ID
+	}
+
+	// flush writes the buffered KVs in a transaction of their own.
+	var kvs []BackupKeyValue
+	flush := func() error {
+		if len(kvs) == 0 {
+			return nil
+		}
+		if err := p.db.Txn(func(txn *client.Txn) error {
+			// A batch cannot be run again once it failed, so each attempt uses
+			// a new one.
+			b := txn.NewBatch()
+			for i := range kvs {
+				if kvs[i].Deleted {
+					b.Del(kvs[i].Key)
+				} else {
+					b.Put(kvs[i].Key, kvs[i].Value)
+				}
+			}
+			return txn.CommitInBatch(b)
+		}); err != nil {
+			return err
+		}
+		kvs = kvs[:0]
+		for _, t := range offline {
+			if err := t.heartbeat(); err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+	for _, location := range n.From {
+		// Each backup is written in its own batches: a key appears at most once
+		// within a backup but may be overwritten or deleted by a later one.
+		if err := p.readBackupData(location, func(kv *BackupKeyValue) error {
+			oldID, suffix, err := decodeTableKeyPrefix(kv.Key)
+			if err != nil {
+				return err
+			}
+			newID, ok := newIDs[oldID]
+			if !ok {
+				return nil
+			}
+			kv.Key = roachpb.Key(append(keys.MakeTablePrefix(uint32(newID)), suffix...))
+			kvs = append(kvs, *kv)
+			if len(kvs) >= importBatchSize {
+				return flush()
+			}
+			return nil
+		}); err != nil {
+			return nil, fail(err)
+		}
+		if err := flush(); err != nil {
+			return nil, fail(err)
+		}
+	}
+
+	if err := p.publishOfflineTables(offline...); err != nil {
+		return nil, fail(err)
+	}
+	return &valuesNode{}, nil
+}
+
+// restoreTargets creates the targeted databases and returns the descriptors
+// of the tables to restore, with their parent IDs set to the databases they
+// are restored into, along with the descriptors of the databases it created.
+// Each database is created in a transaction of its own, so the databases are
+// returned even if an error occurs.
+func (p *planner) restoreTargets(
+	targets parser.TargetList, desc *BackupDescriptor,
+) ([]TableDescriptor, []DatabaseDescriptor, error) {
+	var tables []TableDescriptor
+	var databases []DatabaseDescriptor
+	if targets.Databases != nil {
+		for _, database := range targets.Databases {
+			backupDB := findBackupDatabase(desc, string(database))
+			if backupDB == nil {
+				return nil, databases, fmt.Errorf("database %q not found in backup", database)
+			}
+			dbDesc := DatabaseDescriptor{
+				Name:       string(database),
+				Privileges: backupDB.Privileges,
+			}
+			if err := p.commitDescriptor(databaseKey{dbDesc.Name}, &dbDesc); err != nil {
+				return nil, databases, err
+			}
+			databases = append(databases, dbDesc)
+			for _, table := range desc.Tables {
+				if table.ParentID == backupDB.ID {
+					table.ParentID = dbDesc.ID
+					tables = append(tables, table)
+				}
+			}
+		}
+		return tables, databases, nil
+	}
+
+	for _, tableName := range targets.Tables {
+		if err := tableName.NormalizeTableName(p.session.Database); err != nil {
+			return nil, nil, err
+		}
+		var table *TableDescriptor
+		if backupDB := findBackupDatabase(desc, tableName.Database()); backupDB != nil {
+			for i := range desc.Tables {
+				t := &desc.Tables[i]
+				if t.ParentID == backupDB.ID && equalName(t.Name, tableName.Table()) {
+					table = t
+					break
+				}
+			}
+		}
+		if table == nil {
+			return nil, nil, fmt.Errorf("table %q not found in backup", tableName)
+		}
+		dbDesc, err := p.getDatabaseDesc(tableName.Database())
+		if err != nil {
+			return nil, nil, err
+		}
+		if err := p.checkPrivilege(dbDesc, privilege.CREATE); err != nil {
+			return nil, nil, err
+		}
+		restored := *table
+		restored.ParentID = dbDesc.ID
+		tables = append(tables, restored)
+	}
+	return tables, nil, nil
+}
+
+// removeRestoredDatabases removes the databases created by a RESTORE which
+// failed with the given error. It returns that error, along with the error
+// removing the databases if that failed as well.
+func (p *planner) removeRestoredDatabases(databases []DatabaseDescriptor, cause error) error {
+	if len(databases) == 0 {
+		return cause
+	}
+	if err := p.db.Txn(func(txn *client.Txn) error {
+		b := txn.NewBatch()
+		for _, db := range databases {
+			b.Del(databaseKey{db.Name}.Key())
+			b.Del(MakeDescMetadataKey(db.ID))
+		}
+		txn.SetSystemDBTrigger()
+		return txn.CommitInBatch(b)
+	}); err != nil {
+		return fmt.Errorf("%v; unable to remove the restored databases: %v", cause, err)
+	}
+	return cause
+}
+
+func findBackupDatabase(desc *BackupDescriptor, name string) *DatabaseDescriptor {
+	for i := range desc.Databases {
+		if equalName(desc.Databases[i].Name, name) {
+			return &desc.Databases[i]
+		}
+	}
+	return nil
+}
+
+// readBackupChain reads the descriptors of a full backup followed by
+// incremental backups and verifies that each backup is incremental to the
+// one before it.
+func (p *planner) readBackupChain(locations []string) ([]BackupDescriptor, error) {
+	chain := make([]BackupDescriptor, len(locations))
+	for i, location := range locations {
+		path, err := p.externalPath(location)
+		if err != nil {
+			return nil, err
+		}
+		buf, err := ioutil.ReadFile(filepath.Join(path, backupDescriptorName))
+		if err != nil {
+			return nil, err
+		}
+		if err := proto.Unmarshal(buf, &chain[i]); err != nil {
+			return nil, err
+		}
+		if i == 0 {
+			if chain[i].StartTime != roachpb.ZeroTimestamp {
+				return nil, fmt.Errorf("%s is an incremental backup, expected a full backup", location)
+			}
+		} else if !chain[i].StartTime.Equal(chain[i-1].EndTime) {
+			return nil, fmt.Errorf("%s is not incremental to %s", location, locations[i-1])
+		}
+	}
+	return chain, nil
+}
+
+// backupChainIterator iterates in key order over the keys of the specified
+// tables which existed as of the last of a chain of backups. The KVs in the
+// data file of a backup are sorted by key, so the data files are merged as
+// they are read rather than loaded into memory.
+type backupChainIterator struct {
+	readers  []*backupDataReader
+	tableIDs map[ID]struct{}
+	// The next KV of each reader, or nil once the reader is exhausted.
+	heads []*BackupKeyValue
+	// The current key, or nil once the iterator is exhausted.
+	key roachpb.Key
+}
+
+func (p *planner) newBackupChainIterator(
+	locations []string, tableIDs map[ID]struct{},
+) (*backupChainIterator, error) {
+	it := &backupChainIterator{tableIDs: tableIDs}
+	for _, location := range locations {
+		r, err := p.openBackupData(location)
+		if err != nil {
+			it.close()
+			return nil, err
+		}
+		it.readers = append(it.readers, r)
+		kv, err := r.next()
+		if err != nil {
+			it.close()
+			return nil, err
+		}
+		it.heads = append(it.heads, kv)
+	}
+	if err := it.next(); err != nil {
+		it.close()
+		return nil, err
+	}
+	return it, nil
+}
+
+// next advances the iterator to the next key.
+func (it *backupChainIterator) next() error {
+	for {
+		// Find the smallest key and the last backup containing it, whose entry
+		// supersedes the entries of the backups before it.
+		var key roachpb.Key
+		latest := -1
+		for i, kv := range it.heads {
+			if kv == nil {
+				continue
+			}
+			if c := bytes.Compare(kv.Key, key); latest == -1 || c < 0 {
+				key, latest = kv.Key, i
+			} else if c == 0 {
+				latest = i
+			}
+		}
+		if latest == -1 {
+			it.key = nil
+			return nil
+		}
+		deleted := it.heads[latest].Deleted
+		for i, kv := range it.heads {
+			if kv != nil && kv.Key.Equal(key) {
+				var err error
+				if it.heads[i], err = it.readers[i].next(); err != nil {
+					return err
+				}
+			}
+		}
+		if deleted {
+			continue
+		}
+		id, _, err := decodeTableKeyPrefix(key)
+		if err != nil {
+			return err
+		}
+		if _, ok := it.tableIDs[id]; !ok {
+			continue
+		}
+		it.key = key
+		return nil
+	}
+}
+
+func (it *backupChainIterator) close() {
+	for _, r := range it.readers {
+		r.close()
+	}
+	it.readers = nil
+}
+
+func writeBackupKeyValue(w io.Writer, kv *BackupKeyValue) error {
+	buf, err := proto.Marshal(kv)
+	if err != nil {
+		return err
+	}
+	var lenBuf [binary.MaxVarintLen64]byte
+	if _, err := w.Write(lenBuf[:binary.PutUvarint(lenBuf[:], uint64(len(buf)))]); err != nil {
+		return err
+	}
+	_, err = w.Write(buf)
+	return err
+}
+
+// readBackupData invokes fn for each of the KVs in the data file of a
+// backup.
+func (p *planner) readBackupData(location string, fn func(*BackupKeyValue) error) error {
+	r, err := p.openBackupData(location)
+	if err != nil {
+		return err
+	}
+	defer r.close()
+	for {
+		kv, err := r.next()
+		if err != nil {
+			return err
+		}
+		if kv == nil {
+			return nil
+		}
+		if err := fn(kv); err != nil {
+			return err
+		}
+	}
+}
+
+// backupDataReader reads the KVs in the data file of a backup one at a time.
+type backupDataReader struct {
+	location string
+	f        *os.File
+	r        *bufio.Reader
+	buf      []byte
+}
+
+func (p *planner) openBackupData(location string) (*backupDataReader, error) {
+	path, err := p.externalPath(location)
+	if err != nil {
+		return nil, err
+	}
+	f, err := os.Open(filepath.Join(path, backupDataName))
+	if err != nil {
+		return nil, err
+	}
+	return &backupDataReader{location: location, f: f, r: bufio.NewReader(f)}, nil
+}
+
+// next returns the next KV, or nil at the end of the data file.
+func (r *backupDataReader) next() (*BackupKeyValue, error) {
+	n, err := binary.ReadUvarint(r.r)
+	if err == io.EOF {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	if uint64(cap(r.buf)) < n {
+		r.buf = make([]byte, n)
+	}
+	r.buf = r.buf[:n]
+	if _, err := io.ReadFull(r.r, r.buf); err != nil {
+		return nil, util.Errorf("%s: corrupt backup data: %s", r.location, err)
+	}
+	kv := &BackupKeyValue{}
+	if err := proto.Unmarshal(r.buf, kv); err != nil {
+		return nil, err
+	}
+	return kv, nil
+}
+
+func (r *backupDataReader) close() {
+	_ = r.f.Close()
+}
+
+// decodeTableKeyPrefix returns the table ID of a table data key along with
+// the remainder of the key.
+func decodeTableKeyPrefix(key roachpb.Key) (ID, []byte, error) {
+	if !bytes.HasPrefix(key, keys.TableDataPrefix) {
+		return 0, nil, util.Errorf("invalid table data key: %q", key)
+	}
+	rest, id, err := encoding.DecodeUvarint(bytes.TrimPrefix(key, keys.TableDataPrefix))
+	if err != nil {
+		return 0, nil, err
+	}
+	return ID(id), rest, nil
+}
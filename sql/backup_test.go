@@ -0,0 +1,216 @@
+// Copyright 2015 The Cockroach Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+// implied. See the License for the specific language governing
+// permissions and limitations under the License. See the AUTHORS file
+// for names of contributors.
+
+package sql_test
+
+import (
+	"database/sql"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"sort"
+	"testing"
+
+	"github.com/cockroachdb/cockroach/server"
+	"github.com/cockroachdb/cockroach/testutils"
+	"github.com/cockroachdb/cockroach/util/leaktest"
+)
+
+func TestBackupRestore(t *testing.T) {
+	defer leaktest.AfterTest(t)
+	dir, err := ioutil.TempDir("", "TestBackupRestore")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer func() {
+		if err := os.RemoveAll(dir); err != nil {
+			t.Fatal(err)
+		}
+	}()
+
+	ctx := server.NewTestContext()
+	ctx.ExternalIODir = dir
+	s, sqlDB, _ := setupWithContext(t, ctx)
+	defer cleanup(s, sqlDB)
+
+	full := "full"
+	inc1 := "file://inc1"
+	inc2 := "backups/inc2"
+
+	exec := func(sql string) {
+		if _, err := sqlDB.Exec(sql); err != nil {
+			t.Fatalf("%s: %v", sql, err)
+		}
+	}
+	// checkRows verifies the contents of the kv table, reading it through both
+	// the primary and the secondary index. The rows are sorted by key.
+	checkRows := func(table, expected string) {
+		for _, index := range []string{"primary", "kv_v_idx"} {
+			rows, err := sqlDB.Query(fmt.Sprintf(`SELECT k, v FROM %s@%s`, table, index))
+			if err != nil {
+				t.Fatal(err)
+			}
+			var results []string
+			for rows.Next() {
+				var k, v string
+				if err := rows.Scan(&k, &v); err != nil {
+					t.Fatal(err)
+				}
+				results = append(results, k+"="+v)
+			}
+			if err := rows.Err(); err != nil {
+				t.Fatal(err)
+			}
+			sort.Strings(results)
+			if s := fmt.Sprint(results); s != expected {
+				t.Errorf("%s@%s: expected %s, but found %s", table, index, expected, s)
+			}
+		}
+	}
+
+	exec(`CREATE DATABASE d`)
+	exec(`CREATE TABLE d.kv (k STRING PRIMARY KEY, v STRING, INDEX kv_v_idx (v))`)
+	exec(`CREATE TABLE d.other (k INT PRIMARY KEY)`)
+	exec(`INSERT INTO d.kv VALUES ('a', '1'), ('b', '2'), ('c', '3')`)
+	exec(`INSERT INTO d.other VALUES (1)`)
+	exec(fmt.Sprintf(`BACKUP DATABASE d TO '%s'`, full))
+
+	exec(`UPDATE d.kv SET v = '4' WHERE k = 'a'`)
+	exec(`DELETE FROM d.kv WHERE k = 'b'`)
+	exec(`INSERT INTO d.kv VALUES ('d', '5')`)
+	exec(fmt.Sprintf(`BACKUP DATABASE d TO '%s' INCREMENTAL FROM '%s'`, inc1, full))
+
+	exec(`INSERT INTO d.kv VALUES ('b', '6')`)
+	exec(fmt.Sprintf(`BACKUP TABLE d.kv TO '%s' INCREMENTAL FROM '%s', '%s'`, inc2, full, inc1))
+
+	// The incremental backups only contain the changes.
+	fullInfo, err := os.Stat(filepath.Join(dir, full, "data"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	incInfo, err := os.Stat(filepath.Join(dir, inc2, "data"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if incInfo.Size() >= fullInfo.Size() {
+		t.Errorf("expected incremental backup to be smaller than %d bytes, but found %d",
+			fullInfo.Size(), incInfo.Size())
+	}
+
+	exec(`DROP DATABASE d`)
+
+	exec(fmt.Sprintf(`RESTORE DATABASE d FROM '%s'`, full))
+	checkRows("d.kv", "[a=1 b=2 c=3]")
+	var count int
+	if err := sqlDB.QueryRow(`SELECT COUNT(*) FROM d.other`).Scan(&count); err != nil {
+		t.Fatal(err)
+	} else if count != 1 {
+		t.Errorf("expected 1 row in d.other, but found %d", count)
+	}
+	exec(`DROP DATABASE d`)
+
+	exec(fmt.Sprintf(`RESTORE DATABASE d FROM '%s', '%s'`, full, inc1))
+	checkRows("d.kv", "[a=4 c=3 d=5]")
+
+	// Restore a single table into the existing database.
+	exec(`DROP TABLE d.kv`)
+	exec(fmt.Sprintf(`RESTORE TABLE d.kv FROM '%s', '%s', '%s'`, full, inc1, inc2))
+	checkRows("d.kv", "[a=4 b=6 c=3 d=5]")
+
+	// Writes to the restored table use the new table ID.
+	exec(`INSERT INTO d.kv VALUES ('e', '7')`)
+	checkRows("d.kv", "[a=4 b=6 c=3 d=5 e=7]")
+
+	exec(`CREATE TABLE d.p (a INT PRIMARY KEY)`)
+	exec(`CREATE TABLE d.c (a INT, b INT, PRIMARY KEY (a, b)) INTERLEAVE IN PARENT d.p (a)`)
+
+	testCases := []struct {
+		sql         string
+		expectedErr string
+	}{
+		{fmt.Sprintf(`BACKUP DATABASE d TO '%s'`, full), `already contains a backup`},
+		{`BACKUP TABLE d.c TO 'interleaved'`, `table "c" is interleaved and cannot be backed up`},
+		{`BACKUP DATABASE d TO 'interleaved'`, `is interleaved and cannot be backed up`},
+		{fmt.Sprintf(`RESTORE DATABASE d FROM '%s'`, full), `database "d" already exists`},
+		{fmt.Sprintf(`RESTORE TABLE d.kv FROM '%s'`, full), `table "kv" already exists`},
+		{fmt.Sprintf(`RESTORE TABLE d.missing FROM '%s'`, full), `table "d.missing" not found in backup`},
+		{fmt.Sprintf(`RESTORE DATABASE missing FROM '%s'`, full), `database "missing" not found in backup`},
+		{fmt.Sprintf(`RESTORE DATABASE e FROM '%s'`, inc1), `is an incremental backup, expected a full backup`},
+		{fmt.Sprintf(`RESTORE DATABASE e FROM '%s', '%s'`, full, inc2), `is not incremental to`},
+		{`BACKUP DATABASE d TO 'http://example.com/backup'`, `unsupported location`},
+		{fmt.Sprintf(`BACKUP DATABASE d TO '%s'`, filepath.Join(filepath.Dir(dir), "abs")), `is outside of the external IO directory`},
+		{`BACKUP DATABASE d TO '../escape'`, `must not refer to a parent directory`},
+		{`RESTORE DATABASE e FROM 'full/../../full'`, `must not refer to a parent directory`},
+	}
+	for _, tc := range testCases {
+		if _, err := sqlDB.Exec(tc.sql); !testutils.IsError(err, tc.expectedErr) {
+			t.Errorf("%s: expected %q, but found %v", tc.sql, tc.expectedErr, err)
+		}
+	}
+
+	// A failed restore removes the databases and tables it created.
+	if err := os.MkdirAll(filepath.Join(dir, "corrupt"), 0755); err != nil {
+		t.Fatal(err)
+	}
+	buf, err := ioutil.ReadFile(filepath.Join(dir, full, "BACKUP"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := ioutil.WriteFile(filepath.Join(dir, "corrupt", "BACKUP"), buf, 0644); err != nil {
+		t.Fatal(err)
+	}
+	if err := ioutil.WriteFile(filepath.Join(dir, "corrupt", "data"), []byte("\x10abc"), 0644); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := sqlDB.Exec(`RESTORE DATABASE e FROM 'corrupt'`); !testutils.IsError(err, `corrupt backup data`) {
+		t.Fatalf("expected the restore to fail, but found %v", err)
+	}
+	if _, err := sqlDB.Exec(`CREATE TABLE e.kv (k INT PRIMARY KEY)`); !testutils.IsError(err, `database "e" does not exist`) {
+		t.Fatalf("expected the restored database to be removed, but found %v", err)
+	}
+
+	// The KVs are restored in transactions of their own, so RESTORE cannot be
+	// part of a larger transaction.
+	tx, err := sqlDB.Begin()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := tx.Exec(fmt.Sprintf(`RESTORE DATABASE e FROM '%s'`, full)); !testutils.IsError(err, `RESTORE cannot be used inside a transaction`) {
+		t.Fatalf("expected RESTORE to be rejected in a transaction, but found %v", err)
+	}
+	if err := tx.Rollback(); err != nil {
+		t.Fatal(err)
+	}
+
+	// BACKUP and RESTORE access files on the server, which only root may do.
+	userDB, err := sql.Open("cockroach", fmt.Sprintf("https://%s@%s?certs=test_certs",
+		server.TestUser, s.ServingAddr()))
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer userDB.Close()
+	for _, tc := range []struct {
+		sql         string
+		expectedErr string
+	}{
+		{`BACKUP TABLE d.kv TO 'user'`, `only root is allowed to back up`},
+		{fmt.Sprintf(`RESTORE TABLE d.kv FROM '%s'`, full), `only root is allowed to restore`},
+	} {
+		if _, err := userDB.Exec(tc.sql); !testutils.IsError(err, tc.expectedErr) {
+			t.Errorf("%s: expected %q, but found %v", tc.sql, tc.expectedErr, err)
+		}
+	}
+}
@@ -0,0 +1,214 @@
+// Copyright 2015 The Cockroach Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+// implied. See the License for the specific language governing
+// permissions and limitations under the License. See the AUTHORS file
+// for names of contributors.
+
+package sql
+
+import (
+	"fmt"
+
+	"github.com/cockroachdb/cockroach/sql/parser"
+)
+
+// computedColumns holds the expressions of the computed columns of a table
+// which need to be evaluated when writing a row.
+type computedColumns struct {
+	cols  []ColumnDescriptor
+	exprs []parser.Expr
+	// The columns referenced by each expression. The qvalues are bound to the
+	// values of the row being written before the expression is evaluated.
+	qvals []qvalMap
+}
+
+// makeComputedColumns returns the computed columns of the table. If colIDSet
+// is not nil, only the computed columns which refer to one of the columns in
+// the set are returned. The result is nil if there are no such columns.
+func (p *planner) makeComputedColumns(
+	tableDesc *TableDescriptor, colIDSet map[ColumnID]struct{},
+) (*computedColumns, error) {
+	var c *computedColumns
+	for _, col := range tableDesc.Columns {
+		if col.ComputedExpr == nil {
+			continue
+		}
+		expr, qvals, err := p.resolveComputedExpr(tableDesc, col)
+		if err != nil {
+			return nil, err
+		}
+		if colIDSet != nil {
+			dependent := false
+			for id := range qvals {
+				if _, ok := colIDSet[id]; ok {
+					dependent = true
+					break
+				}
+			}
+			if !dependent {
+				continue
+			}
+		}
+		if _, err := expr.TypeCheck(); err != nil {
+			return nil, err
+		}
+		if expr, err = p.evalCtx.NormalizeExpr(expr); err != nil {
+			return nil, err
+		}
+		if c == nil {
+			c = &computedColumns{}
+		}
+		c.cols = append(c.cols, col)
+		c.exprs = append(c.exprs, expr)
+		c.qvals = append(c.qvals, qvals)
+	}
+	return c, nil
+}
+
+// resolveComputedExpr parses the expression of a computed column and
+// resolves the column names it contains, returning the expression and the
+// qvalues of the columns it refers to.
+func (p *planner) resolveComputedExpr(
+	tableDesc *TableDescriptor, col ColumnDescriptor,
+) (parser.Expr, qvalMap, error) {
+	return p.resolveTableExpr(tableDesc, *col.ComputedExpr)
+}
+
+// resolveTableExpr parses an expression stored in a table descriptor and
+// resolves the column names it contains, returning the expression and the
+// qvalues of the columns it refers to.
+func (p *planner) resolveTableExpr(
+	tableDesc *TableDescriptor, s string,
+) (parser.Expr, qvalMap, error) {
+	expr, err := parser.ParseExpr(s, parser.Traditional)
+	if err != nil {
+		return nil, nil, err
+	}
+	// The column names may be qualified with the name of the table.
+	desc := *tableDesc
+	desc.Alias = desc.Name
+	scan := &scanNode{planner: p, desc: &desc, visibleCols: desc.Columns}
+	if expr, err = scan.resolveQNames(expr); err != nil {
+		return nil, nil, err
+	}
+	return expr, scan.qvals, nil
+}
+
+// eval computes the values of the computed columns for a row. The values of
+// the columns referenced by the expressions are looked up in rowVals using
+// colIDtoRowIndex; columns missing from the row are NULL.
+func (c *computedColumns) eval(
+	ctx parser.EvalContext, colIDtoRowIndex map[ColumnID]int, rowVals parser.DTuple,
+) (parser.DTuple, error) {
+	vals := make(parser.DTuple, len(c.exprs))
+	for i, expr := range c.exprs {
+		for id, qval := range c.qvals[i] {
+			qval.datum = parser.DNull
+			if j, ok := colIDtoRowIndex[id]; ok {
+				qval.datum = rowVals[j]
+			}
+		}
+		d, err := expr.Eval(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("computed column %q: %v", c.cols[i].Name, err)
+		}
+		vals[i] = d
+	}
+	return vals, nil
+}
+
+// checkComputedWrites returns an error if any of the columns being written
+// by a statement is a computed column.
+func checkComputedWrites(cols []ColumnDescriptor) error {
+	for _, col := range cols {
+		if col.ComputedExpr != nil {
+			return fmt.Errorf("cannot write directly to computed column %q", col.Name)
+		}
+	}
+	return nil
+}
+
+// validateComputedColumns checks the expressions of the computed columns of a
+// new table. An expression may only refer to columns of the table which are
+// not themselves computed, may not contain aggregates, placeholders or impure
+// functions, and must have the type of its column. The column references in
+// the stored expressions are stripped of the table name, so that the table
+// can be renamed.
+func (p *planner) validateComputedColumns(desc *TableDescriptor) error {
+	for i := range desc.Columns {
+		col := &desc.Columns[i]
+		if col.ComputedExpr == nil {
+			continue
+		}
+		expr, qvals, err := p.resolveComputedExpr(desc, *col)
+		if err != nil {
+			return err
+		}
+		resolved := expr.String()
+		for _, qval := range qvals {
+			if qval.col.ComputedExpr != nil {
+				return fmt.Errorf("computed column %q cannot refer to computed column %q",
+					col.Name, qval.col.Name)
+			}
+		}
+		if _, funcs, err := extractAggregateFuncs(expr); err != nil {
+			return err
+		} else if len(funcs) > 0 {
+			return fmt.Errorf("computed column %q cannot contain aggregate functions", col.Name)
+		}
+		typ, err := expr.TypeCheck()
+		if err != nil {
+			return err
+		}
+		v := computedExprVisitor{}
+		parser.WalkExpr(&v, expr)
+		if v.found != "" {
+			return fmt.Errorf("computed column %q cannot contain %s", col.Name, v.found)
+		}
+		colDatumType, err := makeValType(col.Type)
+		if err != nil {
+			return err
+		}
+		if !(typ == parser.DNull || parser.SameType(colDatumType, typ)) {
+			return fmt.Errorf("incompatible column type and computed expression: %s vs %s",
+				col.Type.Kind, typ.Type())
+		}
+		col.ComputedExpr = &resolved
+	}
+	return nil
+}
+
+// computedExprVisitor checks an expression for placeholders and impure
+// functions, whose values would not be the same each time the expression is
+// evaluated for a row.
+type computedExprVisitor struct {
+	found string
+}
+
+var _ parser.Visitor = &computedExprVisitor{}
+
+func (v *computedExprVisitor) Visit(expr parser.Expr, pre bool) (parser.Visitor, parser.Expr) {
+	if !pre || v.found != "" {
+		return nil, expr
+	}
+	switch t := expr.(type) {
+	case parser.ValArg:
+		v.found = "placeholders"
+		return nil, expr
+	case *parser.FuncExpr:
+		if t.IsImpure() {
+			v.found = fmt.Sprintf("impure function %s()", t.Name)
+			return nil, expr
+		}
+	}
+	return v, expr
+}
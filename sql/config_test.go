@@ -217,3 +217,70 @@ func TestGetZoneConfig(t *testing.T) {
 		}
 	}
 }
+
+// TestConfigureZone verifies that the zone configs written by CONFIGURE ZONE
+// are used by config.GetZoneConfig.
+func TestConfigureZone(t *testing.T) {
+	defer leaktest.AfterTest(t)
+	config.TestingDisableTableSplits = true
+	defer func() { config.TestingDisableTableSplits = false }()
+	s, sqlDB, _ := setup(t)
+	defer cleanup(s, sqlDB)
+
+	db1 := uint32(keys.MaxReservedDescID + 1)
+	tb11 := db1 + 1
+	tb12 := db1 + 2
+	for _, stmt := range []string{
+		`CREATE DATABASE db1`,
+		`CREATE TABLE db1.tb1 (k INT PRIMARY KEY, v INT)`,
+		`CREATE TABLE db1.tb2 (k INT PRIMARY KEY, v INT)`,
+		`ALTER DATABASE db1 CONFIGURE ZONE 'replicas: [{attrs: [db1]}]
+range_max_bytes: 1048576'`,
+		`ALTER TABLE db1.tb1 CONFIGURE ZONE 'replicas: [{attrs: [db1.tb1]}]
+range_max_bytes: 1048576'`,
+	} {
+		if _, err := sqlDB.Exec(stmt); err != nil {
+			t.Fatalf("%s: %s", stmt, err)
+		}
+	}
+
+	db1Cfg := config.ZoneConfig{
+		ReplicaAttrs:  []roachpb.Attributes{{Attrs: []string{"db1"}}},
+		RangeMaxBytes: 1 << 20,
+	}
+	tb11Cfg := config.ZoneConfig{
+		ReplicaAttrs:  []roachpb.Attributes{{Attrs: []string{"db1.tb1"}}},
+		RangeMaxBytes: 1 << 20,
+	}
+	checkZoneConfigs := func(expected map[uint32]config.ZoneConfig) {
+		cfg, err := forceNewConfig(t, s)
+		if err != nil {
+			t.Fatalf("failed to get latest system config: %s", err)
+		}
+		for id, zoneCfg := range expected {
+			found, err := cfg.GetZoneConfigForKey(keys.MakeTablePrefix(id))
+			if err != nil {
+				t.Fatalf("%d: err=%s", id, err)
+			}
+			if !reflect.DeepEqual(*found, zoneCfg) {
+				t.Errorf("%d: bad zone config.\nexpected: %+v\ngot: %+v", id, zoneCfg, found)
+			}
+		}
+	}
+	checkZoneConfigs(map[uint32]config.ZoneConfig{
+		db1:  db1Cfg,
+		tb11: tb11Cfg,
+		tb12: db1Cfg,
+	})
+
+	// Removing the zone config of a table makes it inherit the zone config of
+	// its database.
+	if _, err := sqlDB.Exec(`ALTER TABLE db1.tb1 CONFIGURE ZONE NULL`); err != nil {
+		t.Fatal(err)
+	}
+	checkZoneConfigs(map[uint32]config.ZoneConfig{
+		db1:  db1Cfg,
+		tb11: db1Cfg,
+		tb12: db1Cfg,
+	})
+}
@@ -73,9 +73,13 @@ func (p *planner) CreateIndex(n *parser.CreateIndex) (planNode, error) {
 	indexDesc := IndexDescriptor{
 		Name:             string(n.Name),
 		Unique:           n.Unique,
-		ColumnNames:      n.Columns,
 		StoreColumnNames: n.Storing,
 	}
+	indexDesc.fillColumns(n.Columns)
+	indexDesc.setPredicate(n.Predicate)
+	if err := p.validateIndexPredicate(tableDesc, &indexDesc); err != nil {
+		return nil, err
+	}
 
 	newTableDesc := proto.Clone(tableDesc).(*TableDescriptor)
 
@@ -109,32 +113,75 @@ func (p *planner) CreateIndex(n *parser.CreateIndex) (planNode, error) {
 // Privileges: CREATE on database.
 //   Notes: postgres/mysql require CREATE on database.
 func (p *planner) CreateTable(n *parser.CreateTable) (planNode, error) {
-	if err := n.Table.NormalizeTableName(p.session.Database); err != nil {
+	dbDesc, desc, err := p.makeCreateTableDesc(n)
+	if err != nil {
+		return nil, err
+	}
+
+	var parentDesc *TableDescriptor
+	if n.Interleave != nil {
+		if parentDesc, err = p.interleaveTable(&desc, n.Interleave); err != nil {
+			return nil, err
+		}
+	}
+
+	if err := p.createDescriptor(tableKey{dbDesc.ID, n.Table.Table()}, &desc, n.IfNotExists); err != nil {
 		return nil, err
 	}
 
+	if parentDesc != nil && desc.ID != 0 {
+		// Record the new table in the descriptor of its parent, whose rows now
+		// share their key span with the rows of the table.
+		parentIndex := &parentDesc.PrimaryIndex
+		parentIndex.InterleavedBy = append(parentIndex.InterleavedBy, desc.ID)
+		// TODO(pmattis): This is a hack. Remove when schema change operations work
+		// properly.
+		p.hackNoteSchemaChange(parentDesc)
+		if err := p.txn.Put(MakeDescMetadataKey(parentDesc.ID), wrapDescriptor(parentDesc)); err != nil {
+			return nil, err
+		}
+	}
+	return &valuesNode{}, nil
+}
+
+// makeCreateTableDesc checks that the user may create the table defined by
+// the CREATE TABLE statement and returns the descriptor of the database and
+// the validated descriptor of the new table, which has no ID yet.
+func (p *planner) makeCreateTableDesc(n *parser.CreateTable) (*DatabaseDescriptor, TableDescriptor, error) {
+	if err := n.Table.NormalizeTableName(p.session.Database); err != nil {
+		return nil, TableDescriptor{}, err
+	}
+
 	dbDesc, err := p.getDatabaseDesc(n.Table.Database())
 	if err != nil {
-		return nil, err
+		return nil, TableDescriptor{}, err
 	}
 
 	if err := p.checkPrivilege(dbDesc, privilege.CREATE); err != nil {
-		return nil, err
+		return nil, TableDescriptor{}, err
 	}
 
 	desc, err := makeTableDesc(n, dbDesc.ID)
 	if err != nil {
-		return nil, err
+		return nil, TableDescriptor{}, err
 	}
 	// Inherit permissions from the database descriptor.
 	desc.Privileges = dbDesc.GetPrivileges()
+	// New tables store the non-primary key columns of a row in one key-value
+	// pair per column family.
+	desc.FormatVersion = FamilyFormatVersion
 
 	if err := desc.AllocateIDs(); err != nil {
-		return nil, err
+		return nil, TableDescriptor{}, err
 	}
 
-	if err := p.createDescriptor(tableKey{dbDesc.ID, n.Table.Table()}, &desc, n.IfNotExists); err != nil {
-		return nil, err
+	if err := p.validateComputedColumns(&desc); err != nil {
+		return nil, TableDescriptor{}, err
 	}
-	return &valuesNode{}, nil
+	for i := range desc.Indexes {
+		if err := p.validateIndexPredicate(&desc, &desc.Indexes[i]); err != nil {
+			return nil, TableDescriptor{}, err
+		}
+	}
+	return dbDesc, desc, nil
 }
@@ -57,8 +57,12 @@ func (p *planner) Delete(n *parser.Delete) (planNode, error) {
 		return nil, err
 	}
 
-	primaryIndex := tableDesc.PrimaryIndex
-	primaryIndexKeyPrefix := MakeIndexKeyPrefix(tableDesc.ID, primaryIndex.ID)
+	// The partial indexes only have entries for the rows matching their
+	// predicates.
+	predicates, err := p.makeIndexPredicates(tableDesc)
+	if err != nil {
+		return nil, err
+	}
 
 	b := client.Batch{}
 	result := &valuesNode{}
@@ -66,15 +70,18 @@ func (p *planner) Delete(n *parser.Delete) (planNode, error) {
 		rowVals := rows.Values()
 		result.rows = append(result.rows, parser.DTuple(nil))
 
-		primaryIndexKey, _, err := encodeIndexKey(
-			primaryIndex.ColumnIDs, colIDtoRowIndex, rowVals, primaryIndexKeyPrefix)
+		primaryIndexKey, err := encodePrimaryIndexKey(tableDesc, colIDtoRowIndex, rowVals)
 		if err != nil {
 			return nil, err
 		}
 
 		// Delete the secondary indexes.
+		indexes, err := predicates.filter(p.evalCtx, tableDesc.Indexes, colIDtoRowIndex, rowVals)
+		if err != nil {
+			return nil, err
+		}
 		secondaryIndexEntries, err := encodeSecondaryIndexes(
-			tableDesc.ID, tableDesc.Indexes, colIDtoRowIndex, rowVals)
+			tableDesc.ID, indexes, colIDtoRowIndex, rowVals)
 		if err != nil {
 			return nil, err
 		}
@@ -89,6 +96,16 @@ func (p *planner) Delete(n *parser.Delete) (planNode, error) {
 		// Delete the row.
 		rowStartKey := roachpb.Key(primaryIndexKey)
 		rowEndKey := rowStartKey.PrefixEnd()
+		if len(tableDesc.PrimaryIndex.InterleavedBy) > 0 {
+			// The rows of the interleaved tables are stored between the key of
+			// the row and the keys of its data, and are left in place.
+			interleavedKey := roachpb.Key(encodeInterleavedSentinel(primaryIndexKey))
+			if log.V(2) {
+				log.Infof("DelRange %s - %s", prettyKey(rowStartKey, 0), prettyKey(interleavedKey, 0))
+			}
+			b.DelRange(rowStartKey, interleavedKey)
+			rowStartKey = interleavedKey.PrefixEnd()
+		}
 		if log.V(2) {
 			log.Infof("DelRange %s - %s", prettyKey(rowStartKey, 0), prettyKey(rowEndKey, 0))
 		}
@@ -114,6 +114,16 @@ func (p *planner) createDescriptor(plainKey descriptorKey, descriptor descriptor
 	return p.txn.Run(&b)
 }
 
+// commitDescriptor creates the descriptor like createDescriptor, but in a
+// transaction of its own which is committed before it returns.
+func (p *planner) commitDescriptor(plainKey descriptorKey, descriptor descriptorProto) error {
+	return p.db.Txn(func(txn *client.Txn) error {
+		txn.SetSystemDBTrigger()
+		descPlanner := planner{txn: txn, user: p.user}
+		return descPlanner.createDescriptor(plainKey, descriptor, false)
+	})
+}
+
 // getDescriptor looks up the descriptor for `key`, validates it,
 // and unmarshals it into `descriptor`.
 func (p *planner) getDescriptor(plainKey descriptorKey, descriptor descriptorProto) error {
@@ -25,17 +25,17 @@ import (
 )
 
 // distinct constructs a distinctNode.
-func (*planner) distinct(n *parser.Select, p planNode) planNode {
+func (p *planner) distinct(n *parser.Select, plan planNode) planNode {
 	if !n.Distinct {
-		return p
+		return plan
 	}
 	d := &distinctNode{
-		planNode:   p,
-		suffixSeen: make(map[string]struct{}),
+		planNode:   plan,
+		suffixSeen: newSpillableSet(p.newMemoryAccount()),
 	}
-	ordering, prefix := p.Ordering()
+	ordering, prefix := plan.Ordering()
 	if len(ordering) != 0 {
-		d.columnsInOrder = make([]bool, len(p.Columns()))
+		d.columnsInOrder = make([]bool, len(plan.Columns()))
 	}
 	for _, p := range ordering {
 		if p == 0 {
@@ -69,8 +69,9 @@ type distinctNode struct {
 	// encoding of the columnsInOrder columns for the previous row.
 	prefixSeen []byte
 	// encoding of the non-columnInOrder columns for rows sharing the same
-	// prefixSeen value.
-	suffixSeen map[string]struct{}
+	// prefixSeen value. The set is spilled to disk when it exceeds the memory
+	// budget.
+	suffixSeen *spillableSet
 	err        error
 }
 
@@ -91,26 +92,34 @@ func (n *distinctNode) Next() bool {
 				// duplicate
 				continue
 			}
-			sKey := string(suffix)
-			if _, ok := n.suffixSeen[sKey]; ok {
+			var seen bool
+			if seen, n.err = n.suffixSeen.contains(suffix); n.err != nil {
+				return false
+			} else if seen {
 				// duplicate
 				continue
 			}
-			n.suffixSeen[sKey] = struct{}{}
+			if n.err = n.suffixSeen.add(suffix); n.err != nil {
+				return false
+			}
 		} else {
 			// The prefix of the row which is ordered differs from the last row;
 			// reset our seen set.
-			if len(n.suffixSeen) > 0 {
-				n.suffixSeen = make(map[string]struct{})
+			if n.err = n.suffixSeen.reset(); n.err != nil {
+				return false
 			}
 			n.prefixSeen = prefix
 			if suffix != nil {
-				n.suffixSeen[string(suffix)] = struct{}{}
+				if n.err = n.suffixSeen.add(suffix); n.err != nil {
+					return false
+				}
 			}
 		}
 		return true
 	}
 	n.err = n.planNode.Err()
+	// The seen suffixes are no longer needed.
+	n.suffixSeen.close()
 	return false
 }
 
@@ -0,0 +1,625 @@
+// Copyright 2015 The Cockroach Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+// implied. See the License for the specific language governing
+// permissions and limitations under the License. See the AUTHORS file
+// for names of contributors.
+
+package sql
+
+import (
+	"fmt"
+	"net"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/cockroachdb/cockroach/client"
+	"github.com/cockroachdb/cockroach/gossip"
+	"github.com/cockroachdb/cockroach/kv"
+	"github.com/cockroachdb/cockroach/roachpb"
+	"github.com/cockroachdb/cockroach/rpc"
+	"github.com/cockroachdb/cockroach/security"
+	"github.com/cockroachdb/cockroach/sql/parser"
+	"github.com/cockroachdb/cockroach/util"
+	"github.com/cockroachdb/cockroach/util/encoding"
+	"github.com/cockroachdb/cockroach/util/hlc"
+	"github.com/cockroachdb/cockroach/util/log"
+	"github.com/cockroachdb/cockroach/util/uuid"
+	"github.com/gogo/protobuf/proto"
+	"golang.org/x/net/context"
+)
+
+const (
+	// flowMethod is the RPC method which runs the flows sent by the gateways.
+	flowMethod = "DistSQL.RunFlow"
+	// cancelFlowMethod is the RPC method which stops a flow the gateway no
+	// longer waits for.
+	cancelFlowMethod = "DistSQL.CancelFlow"
+)
+
+// TestingSendLocalFlows makes the gateways send the flows for the ranges
+// they lead through RPC, like the flows for other nodes, instead of running
+// them locally. It allows single node tests to exercise remote flows.
+var TestingSendLocalFlows = false
+
+// TestingFlowAddr, if set, returns the address the flows for a node are sent
+// to. It allows tests to send flows to unreachable nodes.
+var TestingFlowAddr func(roachpb.NodeID) net.Addr
+
+const (
+	// The RPC options of the flows, as used by kv.DistSender.
+	flowSendNextTimeout = 10 * time.Second
+	flowRPCTimeout      = 5 * time.Second
+	// flowTimeout bounds the time the gateway waits for the partial results
+	// of a remote flow. The gateway cancels the flows which failed to reply
+	// in time and runs them itself.
+	flowTimeout = 1 * time.Minute
+)
+
+// flowRPCOptions are the options of the RPCs sent to run and cancel flows.
+var flowRPCOptions = rpc.Options{
+	N:               1,
+	Ordering:        rpc.OrderStable,
+	SendNextTimeout: flowSendNextTimeout,
+	Timeout:         flowRPCTimeout,
+}
+
+// RangeLeaderLookup looks up the ranges overlapping a span of keys along with
+// their leaders. It is implemented by kv.DistSender.
+type RangeLeaderLookup interface {
+	LookupRangeLeaders(key, endKey roachpb.Key) ([]kv.RangeLeader, error)
+}
+
+// distSQLContext holds what the planner needs to send flows to other nodes.
+type distSQLContext struct {
+	ranges     RangeLeaderLookup
+	gossip     *gossip.Gossip
+	rpcContext *rpc.Context
+	clock      *hlc.Clock
+
+	mu sync.Mutex
+	// The flows running on this node, by flow ID. The channel of a flow is
+	// closed when the gateway cancels it.
+	running map[string]chan struct{}
+}
+
+// EnableDistSQL registers the RPC method which runs flows on this node and
+// allows the statements executed by this Executor to distribute the
+// computation of aggregate functions to the nodes holding the leaders of the
+// scanned ranges.
+func (e *Executor) EnableDistSQL(rpcServer *rpc.Server, rpcContext *rpc.Context,
+	ranges RangeLeaderLookup, gossip *gossip.Gossip, clock *hlc.Clock) error {
+	e.distSQL = &distSQLContext{
+		ranges:     ranges,
+		gossip:     gossip,
+		rpcContext: rpcContext,
+		clock:      clock,
+		running:    map[string]chan struct{}{},
+	}
+	if err := rpcServer.Register(flowMethod, e.runFlow, &FlowRequest{}); err != nil {
+		return err
+	}
+	return rpcServer.Register(cancelFlowMethod, e.cancelFlow, &CancelFlowRequest{})
+}
+
+func (e *Executor) runFlow(args proto.Message) (proto.Message, error) {
+	req := args.(*FlowRequest)
+	resp := &FlowResponse{}
+	c := e.distSQL
+	cancel := make(chan struct{})
+	id := string(req.FlowID)
+	c.mu.Lock()
+	c.running[id] = cancel
+	c.mu.Unlock()
+	defer func() {
+		c.mu.Lock()
+		if c.running[id] == cancel {
+			delete(c.running, id)
+		}
+		c.mu.Unlock()
+	}()
+
+	db := client.NewDB(&flowSender{wrapped: e.db.GetSender(), txn: req.Txn, cancel: cancel})
+	partials, err := runFlow(db, req)
+	if err != nil {
+		resp.Error = roachpb.NewError(err)
+		return resp, nil
+	}
+	resp.Partials = partials
+	return resp, nil
+}
+
+func (e *Executor) cancelFlow(args proto.Message) (proto.Message, error) {
+	req := args.(*CancelFlowRequest)
+	c := e.distSQL
+	id := string(req.FlowID)
+	c.mu.Lock()
+	if cancel, ok := c.running[id]; ok {
+		close(cancel)
+		delete(c.running, id)
+	}
+	c.mu.Unlock()
+	return &CancelFlowResponse{}, nil
+}
+
+// flowSender sends the batches of a flow sent by a gateway as reads of the
+// transaction of the gateway, at its timestamp. The flow sees the writes of
+// the transaction but doesn't update it: every batch carries a copy of the
+// transaction as sent by the gateway, and the transaction returned with the
+// responses is dropped. Retryable errors are returned to the gateway, which
+// restarts its transaction. Once the flow is cancelled, its batches fail
+// without being sent.
+type flowSender struct {
+	wrapped client.Sender
+	txn     roachpb.Transaction
+	cancel  <-chan struct{}
+}
+
+func (s *flowSender) Send(ctx context.Context, ba roachpb.BatchRequest) (*roachpb.BatchResponse, *roachpb.Error) {
+	if !ba.IsReadOnly() {
+		return nil, roachpb.NewError(util.Errorf("flows can only read: %s", ba))
+	}
+	select {
+	case <-s.cancel:
+		return nil, roachpb.NewError(util.Errorf("flow cancelled by the gateway"))
+	default:
+	}
+	ba.Txn = proto.Clone(&s.txn).(*roachpb.Transaction)
+	br, pErr := s.wrapped.Send(ctx, ba)
+	if br != nil {
+		br.Txn = nil
+	}
+	return br, pErr
+}
+
+// runFlow scans the spans of the request with the given runner and returns
+// the encoded partial result of each aggregate function.
+func runFlow(runner client.Runner, req *FlowRequest) ([][]byte, error) {
+	n, err := makeScanFilterNode(&req.Scan)
+	if err != nil {
+		return nil, err
+	}
+	n.txn = runner
+	for _, s := range req.Spans {
+		n.spans = append(n.spans, span{start: s.Key, end: s.EndKey})
+	}
+
+	funcs := make([]aggregateImpl, len(req.Aggregates))
+	for i, a := range req.Aggregates {
+		impl, ok := aggregates[a.Func]
+		if !ok {
+			return nil, fmt.Errorf("unknown aggregate function %s", a.Func)
+		}
+		funcs[i] = impl.New()
+		arg, err := n.parseExpr(a.Arg)
+		if err != nil {
+			return nil, err
+		}
+		n.render = append(n.render, arg)
+	}
+
+	for n.Next() {
+		for i, d := range n.Values() {
+			if err := funcs[i].Add(d); err != nil {
+				return nil, err
+			}
+		}
+	}
+	if err := n.Err(); err != nil {
+		return nil, err
+	}
+
+	partials := make([][]byte, len(funcs))
+	for i, f := range funcs {
+		if partials[i], err = encodeDatum(nil, f.(partialAggregate).Partial()); err != nil {
+			return nil, err
+		}
+	}
+	return partials, nil
+}
+
+var _ security.RequestWithUser = &FlowRequest{}
+
+// GetUser implements security.RequestWithUser.
+// Flows are always sent by the node user.
+func (*FlowRequest) GetUser() string {
+	return security.NodeUser
+}
+
+var _ security.RequestWithUser = &CancelFlowRequest{}
+
+// GetUser implements security.RequestWithUser.
+// Flows are always cancelled by the node user.
+func (*CancelFlowRequest) GetUser() string {
+	return security.NodeUser
+}
+
+// A flow is the request sent to a node for the spans of the ranges it leads.
+type flow struct {
+	nodeID roachpb.NodeID
+	req    FlowRequest
+}
+
+// flowNode replaces the scanNode below a groupNode when the aggregate
+// functions are computed by flows running on the nodes holding the rows. It
+// doesn't return any rows: the groupNode merges the partial results of the
+// flows instead.
+type flowNode struct {
+	planner *planner
+	scan    *scanNode
+	// The flow run by the gateway itself, if any, for the ranges it leads and
+	// those whose leader isn't known.
+	local *flow
+	// The flows sent to other nodes.
+	flows     []*flow
+	numRanges int
+	err       error
+}
+
+func (n *flowNode) Columns() []string {
+	return n.scan.columns
+}
+
+func (n *flowNode) Ordering() ([]int, int) {
+	return nil, 0
+}
+
+func (n *flowNode) Values() parser.DTuple {
+	return nil
+}
+
+func (n *flowNode) Next() bool {
+	return false
+}
+
+func (n *flowNode) Err() error {
+	return n.err
+}
+
+func (n *flowNode) ExplainPlan() (name, description string, children []planNode) {
+	numNodes := len(n.flows)
+	if n.local != nil {
+		numNodes++
+	}
+	return "flows", fmt.Sprintf("%d ranges on %d nodes", n.numRanges, numNodes),
+		[]planNode{n.scan}
+}
+
+// mergeInto runs the flows and merges their partial results into the
+// aggregate functions, which must be the ones the flows were planned for. The
+// remote flows run while the gateway runs its own.
+func (n *flowNode) mergeInto(funcs []*aggregateFunc) error {
+	p := n.planner
+	if len(p.txn.Proto.ID) == 0 {
+		// The remote flows read at the timestamp of the transaction, which is
+		// picked by its first request. Start the transaction with a point read
+		// before sending them.
+		first := n.local
+		if first == nil {
+			first = n.flows[0]
+		}
+		if _, err := p.txn.Get(first.req.Spans[0].Key); err != nil {
+			return err
+		}
+	}
+
+	remote := n.flows
+	resps := make([]*FlowResponse, len(remote))
+	errs := make([]error, len(remote))
+	var wg sync.WaitGroup
+	for i, f := range remote {
+		f.req.Txn = p.txn.Proto
+		f.req.FlowID = uuid.NewUUID4()
+		wg.Add(1)
+		go func(i int, f *flow) {
+			defer wg.Done()
+			resps[i], errs[i] = p.distSQL.sendFlow(f)
+		}(i, f)
+	}
+
+	if n.local != nil {
+		// The local flow reads as part of the transaction, like the other
+		// statements of the gateway.
+		partials, err := runFlow(p.txn, &n.local.req)
+		if err == nil {
+			err = mergePartials(funcs, n.local.nodeID, partials)
+		}
+		if err != nil {
+			wg.Wait()
+			return err
+		}
+	}
+	wg.Wait()
+
+	for i, resp := range resps {
+		if errs[i] != nil {
+			// The node couldn't be reached or didn't reply in time, and its
+			// flow was cancelled. The gateway reads the spans itself, which
+			// finds the leaders like any other read.
+			if log.V(1) {
+				log.Warningf("running the flow for node %d locally: %s", remote[i].nodeID, errs[i])
+			}
+			partials, err := runFlow(p.txn, &remote[i].req)
+			if err != nil {
+				return err
+			}
+			if err := mergePartials(funcs, remote[i].nodeID, partials); err != nil {
+				return err
+			}
+			continue
+		}
+		if err := resp.Error.GoError(); err != nil {
+			if txnErr, ok := err.(roachpb.TransactionRestartError); ok {
+				if txn := txnErr.Transaction(); txn != nil {
+					// Restart the transaction above the timestamp observed by
+					// the flow. The rest of the transaction record of the
+					// remote node is not merged into the transaction.
+					p.txn.Proto.Restart(roachpb.Default_Header_UserPriority, txn.Priority, txn.Timestamp)
+				}
+			}
+			return err
+		}
+		if err := mergePartials(funcs, remote[i].nodeID, resp.Partials); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// mergePartials merges the partial results of the flow run by the given node
+// into the aggregate functions.
+func mergePartials(funcs []*aggregateFunc, nodeID roachpb.NodeID, partials [][]byte) error {
+	if len(partials) != len(funcs) {
+		return fmt.Errorf("expected %d partial results from node %d, but found %d",
+			len(funcs), nodeID, len(partials))
+	}
+	for j, f := range funcs {
+		if err := mergePartial(f, partials[j]); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func mergePartial(f *aggregateFunc, b []byte) error {
+	argType, err := f.val.expr.Exprs[0].TypeCheck()
+	if err != nil {
+		return err
+	}
+	impl := f.impl.(partialAggregate)
+	types := impl.PartialTypes(argType)
+	partial := make(parser.DTuple, len(types))
+	for i, t := range types {
+		if partial[i], b, err = decodeTableKey(t, b, encoding.Ascending); err != nil {
+			return err
+		}
+	}
+	return impl.Merge(partial)
+}
+
+// sendFlow sends a flow to its node and waits for the partial results. An
+// error is returned if the node cannot be reached or doesn't reply within
+// flowTimeout, in which case the flow is cancelled.
+func (c *distSQLContext) sendFlow(f *flow) (*FlowResponse, error) {
+	var addr net.Addr
+	if TestingFlowAddr != nil {
+		addr = TestingFlowAddr(f.nodeID)
+	} else {
+		var err error
+		if addr, err = c.gossip.GetNodeIDAddress(f.nodeID); err != nil {
+			return nil, err
+		}
+	}
+	getArgs := func(addr net.Addr) proto.Message {
+		return &f.req
+	}
+	getReply := func() proto.Message {
+		return &FlowResponse{}
+	}
+
+	type result struct {
+		replies []proto.Message
+		err     error
+	}
+	// The channel is buffered so that the send doesn't block once the flow
+	// has timed out.
+	resultC := make(chan result, 1)
+	go func() {
+		replies, err := rpc.Send(flowRPCOptions, flowMethod, []net.Addr{addr}, getArgs, getReply, c.rpcContext)
+		resultC <- result{replies, err}
+	}()
+	select {
+	case r := <-resultC:
+		if r.err != nil {
+			return nil, r.err
+		}
+		return r.replies[0].(*FlowResponse), nil
+	case <-time.After(flowTimeout):
+		// The node is slow or partitioned from the gateway. Cancel the flow so
+		// that it stops reading, without waiting for the node to acknowledge.
+		go c.cancelFlow(addr, f)
+		return nil, util.Errorf("flow for node %d timed out after %s", f.nodeID, flowTimeout)
+	}
+}
+
+// cancelFlow asks the node at the given address to stop running the flow.
+func (c *distSQLContext) cancelFlow(addr net.Addr, f *flow) {
+	getArgs := func(addr net.Addr) proto.Message {
+		return &CancelFlowRequest{FlowID: f.req.FlowID}
+	}
+	getReply := func() proto.Message {
+		return &CancelFlowResponse{}
+	}
+	if _, err := rpc.Send(flowRPCOptions, cancelFlowMethod, []net.Addr{addr}, getArgs, getReply, c.rpcContext); err != nil {
+		if log.V(1) {
+			log.Warningf("cancelling the flow for node %d: %s", f.nodeID, err)
+		}
+	}
+}
+
+// planDistributedAggregation returns a flowNode which computes the aggregate
+// functions of the groupNode on the nodes holding the leaders of the ranges
+// scanned by the scanNode. It returns nil if the aggregation should be
+// computed on the gateway: when the aggregate functions or the filter can't
+// be evaluated elsewhere, or when the scan doesn't span several ranges.
+func (p *planner) planDistributedAggregation(group *groupNode, scan *scanNode) (*flowNode, error) {
+	if p.distSQL == nil || scan.desc == nil || isVirtualDescriptor(scan.desc) ||
+		scan.index.isInterleaved() || scan.reverse || scan.explain == explainDebug {
+		return nil, nil
+	}
+	for _, s := range scan.spans {
+		if s.count != 0 {
+			return nil, nil
+		}
+	}
+
+	v := scanFilterVisitor{ok: true, colIDs: map[ColumnID]struct{}{}}
+	var aggs []FlowAggregate
+	for _, f := range group.funcs {
+		if f.seen != nil {
+			// DISTINCT requires seeing all the values at once.
+			return nil, nil
+		}
+		if _, ok := f.impl.(partialAggregate); !ok {
+			return nil, nil
+		}
+		arg := f.val.expr.Exprs[0]
+		_ = parser.WalkExpr(&v, arg)
+		argType, err := arg.TypeCheck()
+		if err != nil {
+			return nil, err
+		}
+		if argType == parser.DNull {
+			return nil, nil
+		}
+		for _, t := range f.impl.(partialAggregate).PartialTypes(argType) {
+			if _, err := encodeTableKey(nil, t, encoding.Ascending); err != nil {
+				return nil, nil
+			}
+		}
+		aggs = append(aggs, FlowAggregate{
+			Func: strings.ToLower(string(f.val.expr.Name.Base)),
+			Arg:  arg.String(),
+		})
+	}
+	var expr string
+	if scan.filter != nil {
+		_ = parser.WalkExpr(&v, scan.filter)
+		expr = scan.filter.String()
+	}
+	if !v.ok {
+		return nil, nil
+	}
+
+	sf := scan.makeScanFilter(expr, v.colIDs)
+	// Verify that the flows will evaluate the same expressions.
+	f, err := makeScanFilterNode(&sf)
+	if err != nil || (f.filter != nil && f.filter.String() != expr) {
+		return nil, nil
+	}
+	for _, a := range aggs {
+		if e, err := f.parseExpr(a.Arg); err != nil || e.String() != a.Arg {
+			return nil, nil
+		}
+	}
+
+	spans := scan.spans
+	if len(spans) == 0 {
+		start := roachpb.Key(MakeIndexKeyPrefix(scan.desc.ID, scan.index.ID))
+		spans = []span{{start: start, end: start.PrefixEnd()}}
+	}
+
+	localID := p.distSQL.gossip.GetNodeID()
+	flows := map[roachpb.NodeID]*flow{}
+	numRanges := 0
+	for _, s := range spans {
+		ranges, err := p.distSQL.ranges.LookupRangeLeaders(s.start, s.end)
+		if err != nil {
+			return nil, err
+		}
+		start := s.start
+		for i, r := range ranges {
+			end := s.end
+			if i < len(ranges)-1 {
+				if end, err = scan.rowStart(roachpb.Key(r.Desc.EndKey)); err != nil {
+					// The range boundary isn't a key of the index.
+					return nil, nil
+				}
+			}
+			if end.Compare(start) <= 0 {
+				continue
+			}
+			nodeID := r.Leader.NodeID
+			if nodeID == 0 {
+				// The leader isn't known. The gateway reads the range itself,
+				// which finds the leader like any other read.
+				nodeID = localID
+			}
+			fl, ok := flows[nodeID]
+			if !ok {
+				fl = &flow{
+					nodeID: nodeID,
+					req:    FlowRequest{Scan: sf, Aggregates: aggs},
+				}
+				flows[nodeID] = fl
+			}
+			fl.req.Spans = append(fl.req.Spans, roachpb.Span{Key: start, EndKey: end})
+			numRanges++
+			start = end
+		}
+	}
+	if numRanges < 2 {
+		return nil, nil
+	}
+
+	n := &flowNode{planner: p, scan: scan, numRanges: numRanges}
+	for _, fl := range flows {
+		if fl.nodeID == localID && !TestingSendLocalFlows {
+			n.local = fl
+			continue
+		}
+		n.flows = append(n.flows, fl)
+	}
+	sort.Sort(flowsByNodeID(n.flows))
+	if log.V(2) {
+		log.Infof("distributing %d aggregates over %d ranges on %d nodes",
+			len(aggs), numRanges, len(n.flows))
+	}
+	return n, nil
+}
+
+// rowStart returns the first key of the row containing the given index key.
+// The key-value pairs of a row of the primary index must be read by the same
+// flow.
+func (n *scanNode) rowStart(key roachpb.Key) (roachpb.Key, error) {
+	if n.isSecondaryIndex {
+		// Every row of a secondary index is stored in a single key-value pair.
+		return key, nil
+	}
+	valTypes, err := makeKeyVals(n.desc, n.index.ColumnIDs)
+	if err != nil {
+		return nil, err
+	}
+	vals := make([]parser.Datum, len(valTypes))
+	remaining, _, err := decodeIndexKey(n.desc, *n.index, valTypes, vals, key)
+	if err != nil {
+		return nil, err
+	}
+	return key[:len(key)-len(remaining)], nil
+}
+
+type flowsByNodeID []*flow
+
+func (f flowsByNodeID) Len() int           { return len(f) }
+func (f flowsByNodeID) Swap(i, j int)      { f[i], f[j] = f[j], f[i] }
+func (f flowsByNodeID) Less(i, j int) bool { return f[i].nodeID < f[j].nodeID }
@@ -0,0 +1,318 @@
+// Copyright 2015 The Cockroach Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+// implied. See the License for the specific language governing
+// permissions and limitations under the License. See the AUTHORS file
+// for names of contributors.
+
+package sql_test
+
+import (
+	"bytes"
+	"fmt"
+	"net"
+	"strings"
+	"sync/atomic"
+	"testing"
+
+	"github.com/cockroachdb/cockroach/keys"
+	"github.com/cockroachdb/cockroach/roachpb"
+	"github.com/cockroachdb/cockroach/sql"
+	"github.com/cockroachdb/cockroach/storage"
+	"github.com/cockroachdb/cockroach/util/encoding"
+	"github.com/cockroachdb/cockroach/util/leaktest"
+)
+
+// TestDistributedAggregation verifies that aggregations over tables spanning
+// several ranges are computed by flows and merged correctly.
+func TestDistributedAggregation(t *testing.T) {
+	defer leaktest.AfterTest(t)
+	s, sqlDB, kvDB := setup(t)
+	defer cleanup(s, sqlDB)
+
+	if _, err := sqlDB.Exec(`
+CREATE DATABASE t;
+CREATE TABLE t.kv (k INT PRIMARY KEY, v INT, w STRING);
+`); err != nil {
+		t.Fatal(err)
+	}
+	for i := 1; i <= 20; i++ {
+		if _, err := sqlDB.Exec(`INSERT INTO t.kv VALUES ($1, $1, $2)`, i, fmt.Sprint(i%7)); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	gr, err := kvDB.Get(sql.MakeNameMetadataKey(keys.MaxReservedDescID+1, "kv"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	desc := &sql.Descriptor{}
+	if err := kvDB.GetProto(sql.MakeDescMetadataKey(sql.ID(gr.ValueInt())), desc); err != nil {
+		t.Fatal(err)
+	}
+	tableDesc := desc.GetTable()
+	prefix := sql.MakeIndexKeyPrefix(tableDesc.ID, tableDesc.PrimaryIndex.ID)
+
+	// Split the table into four ranges.
+	for _, k := range []int64{6, 11, 16} {
+		key := roachpb.Key(encoding.EncodeVarint(append([]byte(nil), prefix...), k))
+		if err := s.DB().AdminSplit(key); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	rows, err := sqlDB.Query(`EXPLAIN SELECT COUNT(*) FROM t.kv`)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var plan []string
+	for rows.Next() {
+		var level int
+		var typ, desc string
+		if err := rows.Scan(&level, &typ, &desc); err != nil {
+			t.Fatal(err)
+		}
+		plan = append(plan, fmt.Sprintf("%s %s", typ, desc))
+	}
+	if err := rows.Err(); err != nil {
+		t.Fatal(err)
+	}
+	// The number of ranges depends on the range descriptors cached by the
+	// gateway, which might not reflect all the splits yet.
+	if len(plan) != 3 || !strings.HasPrefix(plan[1], "flows ") {
+		t.Fatalf("expected a distributed aggregation, but found %s", plan)
+	}
+
+	testCases := []struct {
+		query    string
+		expected string
+	}{
+		{`SELECT COUNT(*), SUM(v), MIN(v), MAX(v), AVG(v) FROM t.kv`, `20 210 1 20 10.5`},
+		{`SELECT COUNT(w), MIN(w), MAX(w) FROM t.kv WHERE v % 2 = 0`, `10 0 6`},
+		{`SELECT COUNT(*), SUM(v), MIN(v), MAX(v), AVG(v) FROM t.kv WHERE k > 4 AND k < 13`, `8 68 5 12 8.5`},
+		{`SELECT COUNT(*), SUM(v), MIN(v), MAX(v), AVG(v) FROM t.kv WHERE v > 100`, `0 <nil> <nil> <nil> <nil>`},
+		{`SELECT VARIANCE(v), STDDEV(v), XOR_AGG(v), BOOL_AND(v > 0), BOOL_OR(v > 19) FROM t.kv`, `35 5.916079783099616 20 true true`},
+		{`SELECT VARIANCE(v), XOR_AGG(v), BOOL_AND(v > 0) FROM t.kv WHERE v > 100`, `<nil> <nil> <nil>`},
+	}
+	for _, tc := range testCases {
+		rows, err := sqlDB.Query(tc.query)
+		if err != nil {
+			t.Fatalf("%s: %v", tc.query, err)
+		}
+		cols, err := rows.Columns()
+		if err != nil {
+			t.Fatal(err)
+		}
+		if !rows.Next() {
+			t.Fatalf("%s: no rows: %v", tc.query, rows.Err())
+		}
+		vals := make([]interface{}, len(cols))
+		for i := range vals {
+			vals[i] = new(interface{})
+		}
+		if err := rows.Scan(vals...); err != nil {
+			t.Fatal(err)
+		}
+		if err := rows.Close(); err != nil {
+			t.Fatal(err)
+		}
+		var results []string
+		for _, v := range vals {
+			switch d := (*v.(*interface{})).(type) {
+			case []byte:
+				results = append(results, string(d))
+			default:
+				results = append(results, fmt.Sprint(d))
+			}
+		}
+		if s := strings.Join(results, " "); s != tc.expected {
+			t.Errorf("%s: expected %s, but found %s", tc.query, tc.expected, s)
+		}
+	}
+}
+
+// TestDistributedAggregationRetry verifies that the flows of a transaction
+// which is restarted read at the timestamp of the restarted transaction and
+// that the aggregations are still correct. The flows are sent through RPC even
+// though the test has a single node.
+func TestDistributedAggregationRetry(t *testing.T) {
+	defer leaktest.AfterTest(t)
+	sql.TestingSendLocalFlows = true
+	defer func() { sql.TestingSendLocalFlows = false }()
+	s, sqlDB, _ := setup(t)
+	defer cleanup(s, sqlDB)
+
+	if _, err := sqlDB.Exec(`
+CREATE DATABASE t;
+CREATE TABLE t.kv (k INT PRIMARY KEY, v INT);
+`); err != nil {
+		t.Fatal(err)
+	}
+	for i := 1; i <= 20; i++ {
+		if _, err := sqlDB.Exec(`INSERT INTO t.kv VALUES ($1, $1)`, i); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	// Fail the next scan of the table with a retryable error once inject is
+	// set.
+	var inject, injected int32
+	prefix := roachpb.Key(keys.MakeTablePrefix(keys.MaxReservedDescID + 2))
+	filter := storage.TestingCommandFilter
+	storage.TestingCommandFilter = func(args roachpb.Request, h roachpb.Header) error {
+		switch args.(type) {
+		case *roachpb.ScanRequest, *roachpb.FilteredScanRequest:
+			if h.Txn != nil && bytes.HasPrefix(args.Header().Key, prefix) &&
+				atomic.CompareAndSwapInt32(&inject, 1, 0) {
+				atomic.AddInt32(&injected, 1)
+				return &roachpb.TransactionRetryError{Txn: *h.Txn}
+			}
+		}
+		if filter != nil {
+			return filter(args, h)
+		}
+		return nil
+	}
+	defer func() { storage.TestingCommandFilter = filter }()
+
+	const query = `SELECT COUNT(*), SUM(v), MIN(v), MAX(v) FROM t.kv WHERE v > 0`
+	const expected = `20 210 1 20`
+	checkResults := func(row interface {
+		Scan(...interface{}) error
+	}) error {
+		var count, sum, min, max int64
+		if err := row.Scan(&count, &sum, &min, &max); err != nil {
+			return err
+		}
+		if s := fmt.Sprintf("%d %d %d %d", count, sum, min, max); s != expected {
+			t.Errorf("%s: expected %s, but found %s", query, expected, s)
+		}
+		return nil
+	}
+
+	// The implicit transaction is restarted by the gateway. Its first attempt
+	// starts the transaction with a local flow, the second one sends the flow.
+	atomic.StoreInt32(&inject, 1)
+	if err := checkResults(sqlDB.QueryRow(query)); err != nil {
+		t.Fatal(err)
+	}
+	if atomic.LoadInt32(&injected) != 1 {
+		t.Fatalf("expected the retryable error to be injected")
+	}
+
+	// The flow of a transaction started by a previous statement is sent to
+	// the node, which returns the retryable error to the gateway.
+	tx, err := sqlDB.Begin()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := tx.Exec(`SAVEPOINT cockroach_restart`); err != nil {
+		t.Fatal(err)
+	}
+	var v int64
+	if err := tx.QueryRow(`SELECT v FROM t.kv WHERE k = 1`).Scan(&v); err != nil {
+		t.Fatal(err)
+	}
+	atomic.StoreInt32(&inject, 1)
+	if err := checkResults(tx.QueryRow(query)); err == nil {
+		t.Fatalf("expected a retryable error")
+	}
+	if atomic.LoadInt32(&injected) != 2 {
+		t.Fatalf("expected the retryable error to be injected")
+	}
+	if _, err := tx.Exec(`ROLLBACK TO SAVEPOINT cockroach_restart`); err != nil {
+		t.Fatal(err)
+	}
+	if err := checkResults(tx.QueryRow(query)); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := tx.Exec(`RELEASE SAVEPOINT cockroach_restart`); err != nil {
+		t.Fatal(err)
+	}
+	if err := tx.Commit(); err != nil {
+		t.Fatal(err)
+	}
+}
+
+// TestDistributedAggregationUnreachableNode verifies that the gateway reads
+// the spans of a flow itself when the node of the flow cannot be reached.
+func TestDistributedAggregationUnreachableNode(t *testing.T) {
+	defer leaktest.AfterTest(t)
+	sql.TestingSendLocalFlows = true
+	defer func() { sql.TestingSendLocalFlows = false }()
+	s, sqlDB, _ := setup(t)
+	defer cleanup(s, sqlDB)
+
+	// The flows are sent to a listener which accepts connections but never
+	// replies, like a partitioned node.
+	ln, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer ln.Close()
+	go func() {
+		var conns []net.Conn
+		defer func() {
+			for _, c := range conns {
+				_ = c.Close()
+			}
+		}()
+		for {
+			c, err := ln.Accept()
+			if err != nil {
+				return
+			}
+			conns = append(conns, c)
+		}
+	}()
+	sql.TestingFlowAddr = func(roachpb.NodeID) net.Addr { return ln.Addr() }
+	defer func() { sql.TestingFlowAddr = nil }()
+
+	if _, err := sqlDB.Exec(`
+CREATE DATABASE t;
+CREATE TABLE t.kv (k INT PRIMARY KEY, v INT);
+`); err != nil {
+		t.Fatal(err)
+	}
+	for i := 1; i <= 20; i++ {
+		if _, err := sqlDB.Exec(`INSERT INTO t.kv VALUES ($1, $1)`, i); err != nil {
+			t.Fatal(err)
+		}
+	}
+	prefix := sql.MakeIndexKeyPrefix(keys.MaxReservedDescID+2, 1)
+	for _, k := range []int64{6, 11, 16} {
+		key := roachpb.Key(encoding.EncodeVarint(append([]byte(nil), prefix...), k))
+		if err := s.DB().AdminSplit(key); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	// The first flow of a transaction is run by the gateway, so the flow is
+	// only sent once a previous statement has started the transaction.
+	tx, err := sqlDB.Begin()
+	if err != nil {
+		t.Fatal(err)
+	}
+	var v int64
+	if err := tx.QueryRow(`SELECT v FROM t.kv WHERE k = 1`).Scan(&v); err != nil {
+		t.Fatal(err)
+	}
+	var count, sum int64
+	if err := tx.QueryRow(`SELECT COUNT(*), SUM(v) FROM t.kv`).Scan(&count, &sum); err != nil {
+		t.Fatal(err)
+	}
+	if count != 20 || sum != 210 {
+		t.Fatalf("expected 20 rows summing to 210, but found %d rows summing to %d", count, sum)
+	}
+	if err := tx.Commit(); err != nil {
+		t.Fatal(err)
+	}
+}
@@ -128,6 +128,21 @@ primary index key:
 As an optimization, columns that are part of the primary key are not stored
 separately as their data can be decoded from the sentinel value.
 
+The layout above stores one key per column and is used by the system tables
+and by tables created before column families. Tables created since instead
+group their non-primary key columns into column families and store each family
+at a single key:
+
+  /TableID/PrimaryIndexID/parentID/name/FamilyID -> Value
+
+The value is the list of the non-NULL columns of the family, each encoded as
+the column ID followed by the length-prefixed encoding of the column value.
+Unless specified otherwise with FAMILY clauses in CREATE TABLE, all of the
+columns are part of family 0, so that a row is stored in a single key-value
+pair. The key for family 0 is written even if all of its columns are NULL and
+takes the place of the sentinel key. Which layout a table uses is recorded in
+the FormatVersion field of its descriptor.
+
 Secondary Indexes
 
 Despite not being a formal part of SQL, secondary indexes are one of its most
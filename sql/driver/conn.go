@@ -144,6 +144,9 @@ func (c *conn) send(stmt string, dArgs []Datum) (*Response_Result, error) {
 	// a single error.
 	for _, result := range resp.Results {
 		if result.Error != nil {
+			if result.Retryable {
+				return nil, &RetryableError{msg: *result.Error}
+			}
 			return nil, errors.New(*result.Error)
 		}
 	}
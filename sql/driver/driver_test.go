@@ -508,3 +508,60 @@ func TestConcurrentIncrements(t *testing.T) {
 		concurrentIncrements(db, t)
 	}
 }
+
+// TestExecuteTx verifies that ExecuteTx restarts transactions which
+// encounter retryable errors until they commit.
+func TestExecuteTx(t *testing.T) {
+	defer leaktest.AfterTest(t)
+	s, db := setup(t, time.Local)
+	defer cleanup(s, db)
+
+	if _, err := db.Exec(`CREATE DATABASE t; CREATE TABLE t.kv (k INT PRIMARY KEY, v INT)`); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := db.Exec(`INSERT INTO t.kv (k, v) VALUES (0,0),(1,0)`); err != nil {
+		t.Fatal(err)
+	}
+	// Each transaction reads the row written by the other one, so one of them
+	// has to be restarted.
+	var wgStartWrite, wgEnd sync.WaitGroup
+	wgEnd.Add(2)
+	wgStartWrite.Add(2)
+	var attempts [2]int
+	errs := make(chan error, 2)
+	for i := 0; i < 2; i++ {
+		go func(i int) {
+			defer wgEnd.Done()
+			errs <- driver.ExecuteTx(db, func(txn *sql.Tx) error {
+				attempts[i]++
+				var value int64
+				if err := txn.QueryRow(`SELECT v FROM t.kv WHERE k = $1`, (i+1)%2).Scan(&value); err != nil {
+					return err
+				}
+				if attempts[i] == 1 {
+					wgStartWrite.Done()
+					wgStartWrite.Wait()
+				}
+				_, err := txn.Exec(`UPDATE t.kv SET v = $2 WHERE k = $1`, i, value+1)
+				return err
+			})
+		}(i)
+	}
+	wgEnd.Wait()
+	close(errs)
+	for err := range errs {
+		if err != nil {
+			t.Fatal(err)
+		}
+	}
+	if attempts[0]+attempts[1] < 3 {
+		t.Errorf("expected a transaction to be restarted, but found %d attempts", attempts)
+	}
+	var min, max int64
+	if err := db.QueryRow(`SELECT MIN(v), MAX(v) FROM t.kv`).Scan(&min, &max); err != nil {
+		t.Fatal(err)
+	}
+	if min != 1 || max != 2 {
+		t.Errorf("unexpected min/max: %d/%d", min, max)
+	}
+}
@@ -0,0 +1,65 @@
+// Copyright 2015 The Cockroach Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+// implied. See the License for the specific language governing
+// permissions and limitations under the License. See the AUTHORS file
+// for names of contributors.
+
+package driver
+
+import "database/sql"
+
+// RetryableError is returned for statements which failed with a retryable
+// error inside a transaction started with SAVEPOINT cockroach_restart. The
+// transaction is still open and can be restarted with ROLLBACK TO SAVEPOINT
+// cockroach_restart.
+type RetryableError struct {
+	msg string
+}
+
+func (e *RetryableError) Error() string {
+	return e.msg
+}
+
+// ExecuteTx runs fn in a transaction and commits it. On retryable errors,
+// the transaction is rolled back to SAVEPOINT cockroach_restart and fn is
+// run again; any other error is returned after rolling back the transaction.
+// fn must not have side effects other than the statements it executes in the
+// transaction since it can be called multiple times. The restarted
+// transactions keep the record and priority of the first attempt.
+func ExecuteTx(db *sql.DB, fn func(*sql.Tx) error) error {
+	tx, err := db.Begin()
+	if err != nil {
+		return err
+	}
+	if _, err := tx.Exec("SAVEPOINT cockroach_restart"); err != nil {
+		_ = tx.Rollback()
+		return err
+	}
+	for {
+		err := fn(tx)
+		if err == nil {
+			// RELEASE commits the transaction and returns a retryable error if
+			// the commit failed but the transaction can be restarted.
+			if _, err = tx.Exec("RELEASE SAVEPOINT cockroach_restart"); err == nil {
+				return tx.Commit()
+			}
+		}
+		if _, ok := err.(*RetryableError); !ok {
+			_ = tx.Rollback()
+			return err
+		}
+		if _, err := tx.Exec("ROLLBACK TO SAVEPOINT cockroach_restart"); err != nil {
+			_ = tx.Rollback()
+			return err
+		}
+	}
+}
@@ -18,7 +18,10 @@
 package driver
 
 import (
+	"bytes"
 	"database/sql/driver"
+	"fmt"
+	"strings"
 	"time"
 
 	"github.com/cockroachdb/cockroach/util"
@@ -107,6 +110,8 @@ func (d Datum) Value() (driver.Value, error) {
 		val = t.TimeVal.GoTime().UTC()
 	case *Datum_IntervalVal:
 		val = time.Duration(t.IntervalVal)
+	case *Datum_ArrayVal:
+		return t.ArrayVal.text()
 	default:
 		return nil, util.Errorf("unsupported type %T", t)
 	}
@@ -114,6 +119,47 @@ func (d Datum) Value() (driver.Value, error) {
 	return val, nil
 }
 
+// text returns the text representation of the array used by Postgres, such
+// as {1,2,NULL}. Elements are double-quoted if they are empty, would be
+// mistaken for NULL or contain special characters.
+func (a *Datum_Array) text() (string, error) {
+	var buf bytes.Buffer
+	buf.WriteByte('{')
+	for i, elem := range a.Elems {
+		if i > 0 {
+			buf.WriteByte(',')
+		}
+		v, err := elem.Value()
+		if err != nil {
+			return "", err
+		}
+		var s string
+		switch t := v.(type) {
+		case nil:
+			buf.WriteString("NULL")
+			continue
+		case []byte:
+			s = string(t)
+		default:
+			s = fmt.Sprint(t)
+		}
+		if s == "" || strings.EqualFold(s, "NULL") || strings.ContainsAny(s, "{},\"\\ \t\n") {
+			buf.WriteByte('"')
+			for _, c := range []byte(s) {
+				if c == '"' || c == '\\' {
+					buf.WriteByte('\\')
+				}
+				buf.WriteByte(c)
+			}
+			buf.WriteByte('"')
+			continue
+		}
+		buf.WriteString(s)
+	}
+	buf.WriteByte('}')
+	return buf.String(), nil
+}
+
 // GoTime returns the receiver as a time.Time.
 func (t Datum_Timestamp) GoTime() time.Time {
 	return time.Unix(t.Sec, int64(t.Nsec))
@@ -22,6 +22,7 @@ import (
 
 	"github.com/cockroachdb/cockroach/client"
 	"github.com/cockroachdb/cockroach/keys"
+	"github.com/cockroachdb/cockroach/roachpb"
 	"github.com/cockroachdb/cockroach/sql/parser"
 	"github.com/cockroachdb/cockroach/sql/privilege"
 	"github.com/cockroachdb/cockroach/util"
@@ -145,14 +146,17 @@ func (p *planner) DropIndex(n *parser.DropIndex) (planNode, error) {
 	return &valuesNode{}, nil
 }
 
-// DropTable drops a table.
+// DropTable drops a table. The table loses its name right away, but its
+// descriptor and data are only removed by the table GC once the GC TTL of the
+// table's zone has elapsed.
 // Privileges: DROP on table.
 //   Notes: postgres allows only the table owner to DROP a table.
 //          mysql requires the DROP privilege on the table.
 func (p *planner) DropTable(n *parser.DropTable) (planNode, error) {
-	// TODO(XisiHuang): should do truncate and delete descriptor in
-	// the same txn
-	for i, tableQualifiedName := range n.Names {
+	var names parser.QualifiedNames
+	var nameKeys []roachpb.Key
+	var tableDescs []*TableDescriptor
+	for _, tableQualifiedName := range n.Names {
 		if err := tableQualifiedName.NormalizeTableName(p.session.Database); err != nil {
 			return nil, err
 		}
@@ -195,20 +199,48 @@ func (p *planner) DropTable(n *parser.DropTable) (planNode, error) {
 			return nil, err
 		}
 
-		if _, err := p.Truncate(&parser.Truncate{Tables: n.Names[i : i+1]}); err != nil {
-			return nil, err
-		}
+		names = append(names, tableQualifiedName)
+		nameKeys = append(nameKeys, nameKey)
+		tableDescs = append(tableDescs, tableDesc)
+	}
 
-		// Delete table descriptor
-		b := &client.Batch{}
-		b.Del(descKey)
-		b.Del(nameKey)
-		// Delete the zone config entry for this table.
-		b.Del(MakeZoneKey(tableDesc.ID))
+	// Tables interleaved with each other can be dropped together.
+	dropped := make(map[ID]struct{}, len(tableDescs))
+	for _, tableDesc := range tableDescs {
+		dropped[tableDesc.ID] = struct{}{}
+	}
 
-		if err := p.txn.Run(b); err != nil {
+	b := client.Batch{}
+	parents := make(map[ID]*TableDescriptor)
+	for i, tableDesc := range tableDescs {
+		if err := p.dropInterleavedTable(names[i], tableDesc, dropped, parents); err != nil {
 			return nil, err
 		}
+		b.Del(nameKeys[i])
+		p.dropTableDesc(&b, tableDesc)
+	}
+	for _, parentDesc := range parents {
+		// TODO(pmattis): This is a hack. Remove when schema change operations
+		// work properly.
+		p.hackNoteSchemaChange(parentDesc)
+		b.Put(MakeDescMetadataKey(parentDesc.ID), wrapDescriptor(parentDesc))
+	}
+
+	if err := p.txn.Run(&b); err != nil {
+		return nil, err
 	}
 	return &valuesNode{}, nil
 }
+
+// dropTableDesc adds to the batch the write of the table descriptor marked as
+// dropped. The zone config of the table is kept so that the table GC can
+// determine when to clear the data of the table.
+func (p *planner) dropTableDesc(b *client.Batch, tableDesc *TableDescriptor) {
+	// TODO(pmattis): This is a hack. Remove when schema change operations work
+	// properly.
+	p.hackNoteSchemaChange(tableDesc)
+
+	tableDesc.State = TableDescriptor_DROP
+	tableDesc.DropTime = p.evalCtx.StmtTimestamp.UnixNano()
+	b.Put(MakeDescMetadataKey(tableDesc.ID), wrapDescriptor(tableDesc))
+}
@@ -19,16 +19,72 @@ package sql_test
 
 import (
 	"testing"
+	"time"
 
+	"github.com/cockroachdb/cockroach/client"
 	"github.com/cockroachdb/cockroach/config"
 	"github.com/cockroachdb/cockroach/keys"
 	"github.com/cockroachdb/cockroach/roachpb"
 	"github.com/cockroachdb/cockroach/sql"
+	"github.com/cockroachdb/cockroach/testutils"
+	"github.com/cockroachdb/cockroach/util"
 	"github.com/cockroachdb/cockroach/util/encoding"
 	"github.com/cockroachdb/cockroach/util/leaktest"
 	"github.com/gogo/protobuf/proto"
 )
 
+// setZoneGCTTL sets the zone config for the given ID to the default zone
+// config using the given GC TTL.
+func setZoneGCTTL(t *testing.T, kvDB *client.DB, id sql.ID, ttlSeconds int32) {
+	zone := *config.DefaultZoneConfig
+	zone.GC = &config.GCPolicy{TTLSeconds: ttlSeconds}
+	if err := kvDB.Txn(func(txn *client.Txn) error {
+		b := &client.Batch{}
+		b.Put(sql.MakeZoneKey(id), &zone)
+		txn.SetSystemDBTrigger()
+		return txn.CommitInBatch(b)
+	}); err != nil {
+		t.Fatal(err)
+	}
+}
+
+// checkTableDropped verifies that the table descriptor at descKey is marked as
+// dropped and that the data of the table has not been cleared yet.
+func checkTableDropped(t *testing.T, kvDB *client.DB, descKey, tableStartKey roachpb.Key, numKVs int) {
+	desc := &sql.Descriptor{}
+	if err := kvDB.GetProto(descKey, desc); err != nil {
+		t.Fatal(err)
+	}
+	if !desc.GetTable().Dropped() {
+		t.Fatalf("table descriptor is not marked as dropped: %+v", desc.GetTable())
+	}
+	if kvs, err := kvDB.Scan(tableStartKey, tableStartKey.PrefixEnd(), 0); err != nil {
+		t.Fatal(err)
+	} else if len(kvs) != numKVs {
+		t.Fatalf("expected %d key value pairs before the GC TTL, but got %d", numKVs, len(kvs))
+	}
+}
+
+// waitForTableGC waits for the table GC to clear the data, descriptor and
+// zone config of the dropped table.
+func waitForTableGC(t *testing.T, kvDB *client.DB, descKey, zoneKey, tableStartKey roachpb.Key) {
+	util.SucceedsWithin(t, 10*time.Second, func() error {
+		if kvs, err := kvDB.Scan(tableStartKey, tableStartKey.PrefixEnd(), 0); err != nil {
+			return err
+		} else if len(kvs) != 0 {
+			return util.Errorf("expected 0 key value pairs, but got %d", len(kvs))
+		}
+		for _, key := range []roachpb.Key{descKey, zoneKey} {
+			if gr, err := kvDB.Get(key); err != nil {
+				return err
+			} else if gr.Exists() {
+				return util.Errorf("%s still exists after the table GC", key)
+			}
+		}
+		return nil
+	})
+}
+
 func TestDropDatabase(t *testing.T) {
 	defer leaktest.AfterTest(t)
 	s, sqlDB, kvDB := setup(t)
@@ -104,7 +160,7 @@ INSERT INTO t.kv VALUES ('c', 'e'), ('a', 'c'), ('b', 'd');
 	tableEndKey := tableStartKey.PrefixEnd()
 	if kvs, err := kvDB.Scan(tableStartKey, tableEndKey, 0); err != nil {
 		t.Fatal(err)
-	} else if l := 6; len(kvs) != l {
+	} else if l := 3; len(kvs) != l {
 		t.Fatalf("expected %d key value pairs, but got %d", l, len(kvs))
 	}
 
@@ -112,17 +168,8 @@ INSERT INTO t.kv VALUES ('c', 'e'), ('a', 'c'), ('b', 'd');
 		t.Fatal(err)
 	}
 
-	if kvs, err := kvDB.Scan(tableStartKey, tableEndKey, 0); err != nil {
-		t.Fatal(err)
-	} else if l := 0; len(kvs) != l {
-		t.Fatalf("expected %d key value pairs, but got %d", l, len(kvs))
-	}
-
-	if gr, err := kvDB.Get(tbDescKey); err != nil {
-		t.Fatal(err)
-	} else if gr.Exists() {
-		t.Fatalf("table descriptor still exists after database is dropped")
-	}
+	// The table data is kept until the GC TTL of the table has elapsed.
+	checkTableDropped(t, kvDB, tbDescKey, tableStartKey, 3)
 
 	if gr, err := kvDB.Get(tbNameKey); err != nil {
 		t.Fatal(err)
@@ -142,17 +189,14 @@ INSERT INTO t.kv VALUES ('c', 'e'), ('a', 'c'), ('b', 'd');
 		t.Fatalf("database descriptor key still exists after database is dropped")
 	}
 
-	if gr, err := kvDB.Get(tbZoneKey); err != nil {
-		t.Fatal(err)
-	} else if gr.Exists() {
-		t.Fatalf("table zone config entry still exists after the database is dropped")
-	}
-
 	if gr, err := kvDB.Get(dbZoneKey); err != nil {
 		t.Fatal(err)
 	} else if gr.Exists() {
 		t.Fatalf("database zone config entry still exists after the database is dropped")
 	}
+
+	setZoneGCTTL(t, s.DB(), tbDesc.ID, 0)
+	waitForTableGC(t, kvDB, tbDescKey, tbZoneKey, tableStartKey)
 }
 
 func TestDropIndex(t *testing.T) {
@@ -276,7 +320,7 @@ INSERT INTO t.kv VALUES ('c', 'e'), ('a', 'c'), ('b', 'd');
 	tableEndKey := tableStartKey.PrefixEnd()
 	if kvs, err := kvDB.Scan(tableStartKey, tableEndKey, 0); err != nil {
 		t.Fatal(err)
-	} else if l := 6; len(kvs) != l {
+	} else if l := 3; len(kvs) != l {
 		t.Fatalf("expected %d key value pairs, but got %d", l, len(kvs))
 	}
 
@@ -284,27 +328,82 @@ INSERT INTO t.kv VALUES ('c', 'e'), ('a', 'c'), ('b', 'd');
 		t.Fatal(err)
 	}
 
-	if kvs, err := kvDB.Scan(tableStartKey, tableEndKey, 0); err != nil {
+	if gr, err := kvDB.Get(nameKey); err != nil {
 		t.Fatal(err)
-	} else if l := 0; len(kvs) != l {
-		t.Fatalf("expected %d key value pairs, but got %d", l, len(kvs))
+	} else if gr.Exists() {
+		t.Fatalf("table namekey still exists after the table is dropped")
 	}
 
-	if gr, err := kvDB.Get(descKey); err != nil {
-		t.Fatal(err)
-	} else if gr.Exists() {
-		t.Fatalf("table descriptor still exists after the table is dropped")
+	// The table data is kept until the GC TTL of the table has elapsed.
+	checkTableDropped(t, kvDB, descKey, tableStartKey, 3)
+	if _, err := sqlDB.Exec(`SELECT * FROM t.kv`); !testutils.IsError(err, `table "kv" does not exist`) {
+		t.Fatalf("expected the dropped table to be gone, but found %v", err)
 	}
 
-	if gr, err := kvDB.Get(nameKey); err != nil {
+	setZoneGCTTL(t, s.DB(), tableDesc.ID, 0)
+	waitForTableGC(t, kvDB, descKey, zoneKey, tableStartKey)
+}
+
+// TestOfflineTableGC verifies that the table GC drops an OFFLINE table, as
+// left behind by an IMPORT or RESTORE on a node which died, once the table
+// stops making progress.
+func TestOfflineTableGC(t *testing.T) {
+	defer leaktest.AfterTest(t)
+	s, sqlDB, kvDB := setup(t)
+	defer cleanup(s, sqlDB)
+
+	if _, err := sqlDB.Exec(`
+CREATE DATABASE t;
+CREATE TABLE t.kv (k CHAR PRIMARY KEY, v CHAR);
+INSERT INTO t.kv VALUES ('c', 'e'), ('a', 'c'), ('b', 'd');
+`); err != nil {
 		t.Fatal(err)
-	} else if gr.Exists() {
-		t.Fatalf("table namekey still exists after the table is dropped")
 	}
 
-	if gr, err := kvDB.Get(zoneKey); err != nil {
+	nameKey := sql.MakeNameMetadataKey(keys.MaxReservedDescID+1, "kv")
+	gr, err := kvDB.Get(nameKey)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !gr.Exists() {
+		t.Fatalf("Name entry %q does not exist", nameKey)
+	}
+	id := sql.ID(gr.ValueInt())
+	descKey := sql.MakeDescMetadataKey(id)
+	zoneKey := sql.MakeZoneKey(id)
+	tableStartKey := roachpb.Key(keys.MakeTablePrefix(uint32(id)))
+
+	setOffline := func(heartbeat int64) {
+		if err := kvDB.Txn(func(txn *client.Txn) error {
+			txn.SetSystemDBTrigger()
+			desc := &sql.Descriptor{}
+			if err := txn.GetProto(descKey, desc); err != nil {
+				return err
+			}
+			desc.GetTable().State = sql.TableDescriptor_OFFLINE
+			desc.GetTable().OfflineHeartbeat = heartbeat
+			return txn.Put(descKey, desc)
+		}); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	// An OFFLINE table which is making progress can't be used.
+	setOffline(s.Clock().Now().WallTime)
+	if _, err := sqlDB.Exec(`SELECT * FROM t.kv`); !testutils.IsError(err, `is being imported or restored`) {
+		t.Fatalf("expected the offline table to be unusable, but found %v", err)
+	}
+	if _, err := sqlDB.Exec(`CREATE TABLE t.kv (k INT PRIMARY KEY)`); !testutils.IsError(err, `table "kv" already exists`) {
+		t.Fatalf("expected the name of the offline table to be taken, but found %v", err)
+	}
+
+	// Once it stops making progress, it is dropped and cleared.
+	setZoneGCTTL(t, s.DB(), id, 0)
+	setOffline(0)
+	waitForTableGC(t, kvDB, descKey, zoneKey, tableStartKey)
+	if gr, err := kvDB.Get(nameKey); err != nil {
 		t.Fatal(err)
 	} else if gr.Exists() {
-		t.Fatalf("zone config entry still exists after the table is dropped")
+		t.Fatalf("table namekey still exists after the offline table is dropped")
 	}
 }
@@ -58,7 +58,12 @@ func convertBatchError(tableDesc *TableDescriptor, b client.Batch, err error) er
 	result := b.Results[index]
 	if _, ok := err.(*roachpb.ConditionFailedError); ok {
 		for _, row := range result.Rows {
-			indexID, key, err := decodeIndexKeyPrefix(tableDesc, row.Key)
+			indexID, _, err := decodeIndexKeyPrefix(tableDesc, row.Key)
+			if err != nil && len(tableDesc.PrimaryIndex.Interleave) > 0 {
+				// The keys of an interleaved primary index start with the prefix
+				// of its outermost ancestor.
+				indexID, err = tableDesc.PrimaryIndex.ID, nil
+			}
 			if err != nil {
 				return err
 			}
@@ -71,7 +76,7 @@ func convertBatchError(tableDesc *TableDescriptor, b client.Batch, err error) er
 				return err
 			}
 			vals := make([]parser.Datum, len(valTypes))
-			if _, err := decodeKeyVals(valTypes, vals, key); err != nil {
+			if _, _, err := decodeIndexKey(tableDesc, *index, valTypes, vals, row.Key); err != nil {
 				return err
 			}
 
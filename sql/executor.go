@@ -32,32 +32,64 @@ import (
 	"github.com/cockroachdb/cockroach/sql/driver"
 	"github.com/cockroachdb/cockroach/sql/parser"
 	"github.com/cockroachdb/cockroach/util/hlc"
+	"github.com/cockroachdb/cockroach/util/log"
+	"github.com/cockroachdb/cockroach/util/uuid"
 	"github.com/gogo/protobuf/proto"
 )
 
 var errNoTransactionInProgress = errors.New("there is no transaction in progress")
 var errTransactionAborted = errors.New("current transaction is aborted, commands ignored until end of transaction block")
 var errTransactionInProgress = errors.New("there is already a transaction in progress")
+var errTransactionRestartWait = errors.New("current transaction is aborted, commands ignored until ROLLBACK TO SAVEPOINT " + restartSavepointName)
+var errTransactionCommitted = errors.New("current transaction is committed, commands ignored until end of transaction block")
 
 // An Executor executes SQL statements.
 type Executor struct {
 	db       client.DB
+	clock    *hlc.Clock
 	nodeID   uint32
 	reCache  *parser.RegexpCache
 	leaseMgr *LeaseManager
 
+	// Per-fingerprint statement statistics, and the latency above which
+	// statements are written to the slow query log (disabled if zero).
+	stmtStats          *stmtStatsRegistry
+	slowQueryThreshold time.Duration
+
+	// Set if aggregations can be distributed to the nodes holding the rows.
+	distSQL *distSQLContext
+
+	// The monitor of the memory used by the buffering operators of all the
+	// sessions, and the monitors of the sessions.
+	memMonitor  *memoryMonitor
+	sessionMons *sessionMonitors
+
+	// The directory IMPORT, BACKUP and RESTORE are confined to.
+	externalIODir string
+
 	// System Config and mutex.
 	systemConfig   *config.SystemConfig
 	systemConfigMu sync.RWMutex
+	// Signaled whenever the system config changes, which might make some
+	// dropped tables eligible for the table GC.
+	systemConfigChanged chan struct{}
 }
 
 // newExecutor creates an Executor and registers a callback on the
 // system config.
 func newExecutor(db client.DB, gossip *gossip.Gossip, clock *hlc.Clock) *Executor {
+	memMonitor := newMemoryMonitor("node", 0, nil)
 	exec := &Executor{
-		db:       db,
-		reCache:  parser.NewRegexpCache(512),
-		leaseMgr: NewLeaseManager(0, db, clock),
+		db:        db,
+		clock:     clock,
+		reCache:   parser.NewRegexpCache(512),
+		leaseMgr:  NewLeaseManager(0, db, clock),
+		stmtStats: newStmtStatsRegistry(),
+
+		memMonitor:  memMonitor,
+		sessionMons: newSessionMonitors(memMonitor, clock),
+
+		systemConfigChanged: make(chan struct{}, 1),
 	}
 	gossip.RegisterSystemConfigCallback(exec.updateSystemConfig)
 	return exec
@@ -70,11 +102,53 @@ func (e *Executor) SetNodeID(nodeID roachpb.NodeID) {
 	e.leaseMgr.nodeID = e.nodeID
 }
 
+// SetSlowQueryThreshold sets the latency above which statements are logged
+// along with their plan. A zero threshold disables the slow query log.
+func (e *Executor) SetSlowQueryThreshold(threshold time.Duration) {
+	e.slowQueryThreshold = threshold
+}
+
+// SetMemoryBudgets sets the budgets of the memory used by the buffering
+// operators (sorts, aggregations, DISTINCT and subqueries) of all the
+// sessions of the node and of each session. A zero budget is unlimited.
+func (e *Executor) SetMemoryBudgets(node, session int64) {
+	e.memMonitor.setBudget(node)
+	e.sessionMons.setBudget(session)
+}
+
+// SetExternalIODir sets the directory in which IMPORT, BACKUP and RESTORE
+// read and write their files. An empty directory disables the statements.
+func (e *Executor) SetExternalIODir(dir string) {
+	e.externalIODir = dir
+}
+
+// MemoryUsage returns the number of bytes currently used by the buffering
+// operators of all the sessions of the node.
+func (e *Executor) MemoryUsage() int64 {
+	return e.memMonitor.currentUsage()
+}
+
+// MaxMemoryUsage returns the most bytes ever used at once by the buffering
+// operators of all the sessions of the node.
+func (e *Executor) MaxMemoryUsage() int64 {
+	return e.memMonitor.maxUsage()
+}
+
+// StatementStatistics returns the statistics collected for each statement
+// fingerprint executed by this Executor, sorted by fingerprint.
+func (e *Executor) StatementStatistics() []StatementStatistics {
+	return e.stmtStats.snapshot()
+}
+
 // updateSystemConfig is called whenever the system config gossip entry is updated.
 func (e *Executor) updateSystemConfig(cfg *config.SystemConfig) {
 	e.systemConfigMu.Lock()
 	defer e.systemConfigMu.Unlock()
 	e.systemConfig = cfg
+	select {
+	case e.systemConfigChanged <- struct{}{}:
+	default:
+	}
 }
 
 // getSystemConfig returns a pointer to the latest system config. May be nil,
@@ -89,6 +163,7 @@ func (e *Executor) getSystemConfig() *config.SystemConfig {
 // On error, the returned integer is an HTTP error code.
 func (e *Executor) Execute(args driver.Request) (driver.Response, int, error) {
 	planMaker := &planner{
+		db:   &e.db,
 		user: args.GetUser(),
 		evalCtx: parser.EvalContext{
 			NodeID:  e.nodeID,
@@ -96,12 +171,23 @@ func (e *Executor) Execute(args driver.Request) (driver.Response, int, error) {
 		},
 		leaseMgr:     e.leaseMgr,
 		systemConfig: e.getSystemConfig(),
+		stmtStats:    e.stmtStats,
+		distSQL:      e.distSQL,
+
+		externalIODir: e.externalIODir,
 	}
 
 	// Pick up current session state.
 	if err := proto.Unmarshal(args.Session, &planMaker.session); err != nil {
 		return args.CreateReply(), http.StatusBadRequest, err
 	}
+	// The operators of the statements reserve memory from the monitor of the
+	// session, which lasts across its requests.
+	if len(planMaker.session.ID) == 0 {
+		planMaker.session.ID = uuid.NewUUID4()
+	}
+	planMaker.mon = e.sessionMons.acquire(planMaker.session.ID)
+	defer e.sessionMons.release(planMaker.session.ID)
 	// Resume a pending transaction if present.
 	if planMaker.session.Txn != nil {
 		txn := client.NewTxn(e.db)
@@ -110,6 +196,8 @@ func (e *Executor) Execute(args driver.Request) (driver.Response, int, error) {
 			txn.SetSystemDBTrigger()
 		}
 		planMaker.setTxn(txn, planMaker.session.Txn.Timestamp.GoTime())
+		planMaker.retryIntent = planMaker.session.Txn.RetryIntent
+		planMaker.restartWait = planMaker.session.Txn.RestartWait
 	}
 	planMaker.evalCtx.GetLocation = planMaker.session.getLocation
 
@@ -120,7 +208,12 @@ func (e *Executor) Execute(args driver.Request) (driver.Response, int, error) {
 	// Send back the session state even if there were application-level errors.
 	// Add transaction to session state.
 	if planMaker.txn != nil {
-		planMaker.session.Txn = &Session_Transaction{Txn: planMaker.txn.Proto, Timestamp: driver.Timestamp(planMaker.evalCtx.TxnTimestamp.Time)}
+		planMaker.session.Txn = &Session_Transaction{
+			Txn:         planMaker.txn.Proto,
+			Timestamp:   driver.Timestamp(planMaker.evalCtx.TxnTimestamp.Time),
+			RetryIntent: planMaker.retryIntent,
+			RestartWait: planMaker.restartWait,
+		}
 		planMaker.session.MutatesSystemDB = planMaker.txn.SystemDBTrigger()
 	} else {
 		planMaker.session.Txn = nil
@@ -147,7 +240,14 @@ func (e *Executor) execStmts(sql string, params parameters, planMaker *planner)
 		return resp
 	}
 	for _, stmt := range stmts {
-		result, err := e.execStmt(stmt, params, planMaker)
+		// The fingerprint is computed before the placeholders in the statement
+		// are bound to their values.
+		exec := stmtExecution{
+			fingerprint: parser.FingerprintStatement(stmt),
+			start:       time.Now(),
+		}
+		result, err := e.execStmt(stmt, params, planMaker, &exec)
+		e.recordStmt(stmt, &exec, result, err)
 		if err != nil {
 			result = makeResultFromError(planMaker, err)
 		}
@@ -158,11 +258,47 @@ func (e *Executor) execStmts(sql string, params parameters, planMaker *planner)
 		// TODO(pmattis): Need to record the leases used by a transaction within
 		// the transaction state and restore it when the transaction is restored.
 		planMaker.releaseLeases(e.db)
+		planMaker.releaseMemory()
 	}
 	return resp
 }
 
-func (e *Executor) execStmt(stmt parser.Statement, params parameters, planMaker *planner) (driver.Response_Result, error) {
+// stmtExecution tracks a single statement execution for the purposes of
+// statement statistics and the slow query log.
+type stmtExecution struct {
+	fingerprint string
+	start       time.Time
+	// attempts is the number of times the statement was planned and run;
+	// anything beyond the first is a retry.
+	attempts int
+	// plan is the plan of the last attempt.
+	plan planNode
+}
+
+// recordStmt updates the statement statistics with the outcome of a
+// statement and logs the statement if it exceeded the slow query threshold.
+func (e *Executor) recordStmt(stmt parser.Statement, exec *stmtExecution, result driver.Response_Result, err error) {
+	latency := time.Since(exec.start)
+	var rows int
+	switch t := result.Union.(type) {
+	case *driver.Response_Result_RowsAffected:
+		rows = int(t.RowsAffected)
+	case *driver.Response_Result_Rows_:
+		rows = len(t.Rows.Rows)
+	}
+	retries := 0
+	if exec.attempts > 1 {
+		retries = exec.attempts - 1
+	}
+	e.stmtStats.record(exec.fingerprint, latency, rows, retries, err)
+
+	if e.slowQueryThreshold > 0 && latency >= e.slowQueryThreshold {
+		log.Warningf("slow query (%s, %d rows, %d retries): %s\n%s",
+			latency, rows, retries, stmt, explainPlanString(exec.plan))
+	}
+}
+
+func (e *Executor) execStmt(stmt parser.Statement, params parameters, planMaker *planner, exec *stmtExecution) (driver.Response_Result, error) {
 	var result driver.Response_Result
 	switch stmt.(type) {
 	case *parser.BeginTransaction:
@@ -180,14 +316,28 @@ func (e *Executor) execStmt(stmt parser.Statement, params parameters, planMaker
 			// Reset to allow starting a new transaction.
 			planMaker.resetTxn()
 			return result, nil
+		} else if planMaker.txn.Proto.Status == roachpb.COMMITTED {
+			// The transaction was committed by RELEASE SAVEPOINT.
+			planMaker.resetTxn()
+			return result, nil
+		} else if planMaker.restartWait {
+			// The transaction wasn't restarted. Abort it.
+			planMaker.txn.Cleanup(errTransactionRestartWait)
+			planMaker.resetTxn()
+			return result, nil
 		}
-	case *parser.SetTransaction:
+	case *parser.SetTransaction, *parser.Savepoint, *parser.ReleaseSavepoint, *parser.RollbackToSavepoint:
 		if planMaker.txn == nil {
 			return result, errNoTransactionInProgress
 		}
+		if _, ok := stmt.(*parser.RollbackToSavepoint); !ok {
+			if err := checkTxnState(planMaker); err != nil {
+				return result, err
+			}
+		}
 	default:
-		if planMaker.txn != nil && planMaker.txn.Proto.Status == roachpb.ABORTED {
-			return result, errTransactionAborted
+		if err := checkTxnState(planMaker); err != nil {
+			return result, err
 		}
 	}
 
@@ -204,10 +354,14 @@ func (e *Executor) execStmt(stmt parser.Statement, params parameters, planMaker
 	// only the body of this closure.
 	f := func(timestamp time.Time) error {
 		planMaker.evalCtx.StmtTimestamp = parser.DTimestamp{Time: timestamp}
+		exec.attempts++
+		// The memory used by a previous attempt is no longer needed.
+		planMaker.releaseMemory()
 		plan, err := planMaker.makePlan(stmt)
 		if err != nil {
 			return err
 		}
+		exec.plan = plan
 
 		switch stmt.StatementType() {
 		case parser.DDL:
@@ -231,50 +385,11 @@ func (e *Executor) execStmt(stmt parser.Statement, params parameters, planMaker
 				values := plan.Values()
 				row := driver.Response_Result_Rows_Row{Values: make([]driver.Datum, 0, len(values))}
 				for _, val := range values {
-					if val == parser.DNull {
-						row.Values = append(row.Values, driver.Datum{})
-						continue
-					}
-
-					switch vt := val.(type) {
-					case parser.DBool:
-						row.Values = append(row.Values, driver.Datum{
-							Payload: &driver.Datum_BoolVal{BoolVal: bool(vt)},
-						})
-					case parser.DInt:
-						row.Values = append(row.Values, driver.Datum{
-							Payload: &driver.Datum_IntVal{IntVal: int64(vt)},
-						})
-					case parser.DFloat:
-						row.Values = append(row.Values, driver.Datum{
-							Payload: &driver.Datum_FloatVal{FloatVal: float64(vt)},
-						})
-					case parser.DBytes:
-						row.Values = append(row.Values, driver.Datum{
-							Payload: &driver.Datum_BytesVal{BytesVal: []byte(vt)},
-						})
-					case parser.DString:
-						row.Values = append(row.Values, driver.Datum{
-							Payload: &driver.Datum_StringVal{StringVal: string(vt)},
-						})
-					case parser.DDate:
-						row.Values = append(row.Values, driver.Datum{
-							Payload: &driver.Datum_DateVal{DateVal: int64(vt)},
-						})
-					case parser.DTimestamp:
-						wireTimestamp := driver.Timestamp(vt.Time)
-						row.Values = append(row.Values, driver.Datum{
-							Payload: &driver.Datum_TimeVal{
-								TimeVal: &wireTimestamp,
-							},
-						})
-					case parser.DInterval:
-						row.Values = append(row.Values, driver.Datum{
-							Payload: &driver.Datum_IntervalVal{IntervalVal: vt.Nanoseconds()},
-						})
-					default:
-						return fmt.Errorf("unsupported result type: %s", val.Type())
+					wireVal, err := makeDriverDatum(val)
+					if err != nil {
+						return err
 					}
+					row.Values = append(row.Values, wireVal)
 				}
 				resultRows.Rows = append(resultRows.Rows, row)
 			}
@@ -294,6 +409,7 @@ func (e *Executor) execStmt(stmt parser.Statement, params parameters, planMaker
 	err := e.db.Txn(func(txn *client.Txn) error {
 		timestamp := time.Now()
 		planMaker.setTxn(txn, timestamp)
+		planMaker.implicitTxn = true
 		err := f(timestamp)
 		planMaker.resetTxn()
 		return err
@@ -301,17 +417,43 @@ func (e *Executor) execStmt(stmt parser.Statement, params parameters, planMaker
 	return result, err
 }
 
+// checkTxnState returns an error if the pending transaction, if any, only
+// accepts statements which end or restart it.
+func checkTxnState(planMaker *planner) error {
+	if planMaker.txn == nil {
+		return nil
+	}
+	switch {
+	case planMaker.txn.Proto.Status == roachpb.ABORTED:
+		return errTransactionAborted
+	case planMaker.txn.Proto.Status == roachpb.COMMITTED:
+		return errTransactionCommitted
+	case planMaker.restartWait:
+		return errTransactionRestartWait
+	}
+	return nil
+}
+
 // If we hit an error and there is a pending transaction, rollback
 // the transaction before returning. The client does not have to
-// deal with cleaning up transaction state.
+// deal with cleaning up transaction state. If the client declared its
+// intention to retry the transaction with SAVEPOINT cockroach_restart and
+// the error is retryable, the transaction is kept so that it can be
+// restarted.
 func makeResultFromError(planMaker *planner, err error) driver.Response_Result {
+	var result driver.Response_Result
 	if planMaker.txn != nil {
-		if err != errTransactionAborted {
+		if planMaker.retryIntent && isRetryableTxnError(err) {
+			planMaker.restartWait = true
+			result.Retryable = true
+		} else if err != errTransactionAborted && err != errTransactionRestartWait &&
+			err != errTransactionCommitted {
 			planMaker.txn.Cleanup(err)
 		}
 	}
 	errString := err.Error()
-	return driver.Response_Result{Error: &errString}
+	result.Error = &errString
+	return result
 }
 
 // parameters implements the parser.Args interface.
@@ -336,27 +478,108 @@ func (p parameters) Arg(name string) (parser.Datum, bool) {
 	if i < 1 || int(i) > len(p) {
 		return nil, false
 	}
-	arg := p[i-1].Payload
+	return makeDatumFromDriver(p[i-1]), true
+}
+
+// makeDriverDatum converts a datum to its wire representation.
+func makeDriverDatum(datum parser.Datum) (driver.Datum, error) {
+	if datum == parser.DNull {
+		return driver.Datum{}, nil
+	}
+
+	switch vt := datum.(type) {
+	case parser.DBool:
+		return driver.Datum{
+			Payload: &driver.Datum_BoolVal{BoolVal: bool(vt)},
+		}, nil
+	case parser.DInt:
+		return driver.Datum{
+			Payload: &driver.Datum_IntVal{IntVal: int64(vt)},
+		}, nil
+	case parser.DFloat:
+		return driver.Datum{
+			Payload: &driver.Datum_FloatVal{FloatVal: float64(vt)},
+		}, nil
+	case parser.DBytes:
+		return driver.Datum{
+			Payload: &driver.Datum_BytesVal{BytesVal: []byte(vt)},
+		}, nil
+	case parser.DString:
+		return driver.Datum{
+			Payload: &driver.Datum_StringVal{StringVal: string(vt)},
+		}, nil
+	case parser.DDate:
+		return driver.Datum{
+			Payload: &driver.Datum_DateVal{DateVal: int64(vt)},
+		}, nil
+	case parser.DTimestamp:
+		wireTimestamp := driver.Timestamp(vt.Time)
+		return driver.Datum{
+			Payload: &driver.Datum_TimeVal{
+				TimeVal: &wireTimestamp,
+			},
+		}, nil
+	case parser.DInterval:
+		return driver.Datum{
+			Payload: &driver.Datum_IntervalVal{IntervalVal: vt.Nanoseconds()},
+		}, nil
+	case parser.DJSON:
+		// JSON values are sent as their text.
+		return driver.Datum{
+			Payload: &driver.Datum_StringVal{StringVal: string(vt)},
+		}, nil
+	case parser.DUUID:
+		// UUID values are sent in their standard text form.
+		return driver.Datum{
+			Payload: &driver.Datum_StringVal{StringVal: vt.UUID().String()},
+		}, nil
+	case parser.DArray:
+		wireArray := &driver.Datum_Array{Elems: make([]driver.Datum, 0, len(vt.Elems))}
+		for _, elem := range vt.Elems {
+			wireElem, err := makeDriverDatum(elem)
+			if err != nil {
+				return driver.Datum{}, err
+			}
+			wireArray.Elems = append(wireArray.Elems, wireElem)
+		}
+		return driver.Datum{
+			Payload: &driver.Datum_ArrayVal{ArrayVal: wireArray},
+		}, nil
+	default:
+		return driver.Datum{}, fmt.Errorf("unsupported result type: %s", datum.Type())
+	}
+}
+
+// makeDatumFromDriver converts the wire representation of a datum to a
+// datum.
+func makeDatumFromDriver(datum driver.Datum) parser.Datum {
+	arg := datum.Payload
 	if arg == nil {
-		return parser.DNull, true
+		return parser.DNull
 	}
 	switch t := arg.(type) {
 	case *driver.Datum_BoolVal:
-		return parser.DBool(t.BoolVal), true
+		return parser.DBool(t.BoolVal)
 	case *driver.Datum_IntVal:
-		return parser.DInt(t.IntVal), true
+		return parser.DInt(t.IntVal)
 	case *driver.Datum_FloatVal:
-		return parser.DFloat(t.FloatVal), true
+		return parser.DFloat(t.FloatVal)
 	case *driver.Datum_BytesVal:
-		return parser.DBytes(t.BytesVal), true
+		return parser.DBytes(t.BytesVal)
 	case *driver.Datum_StringVal:
-		return parser.DString(t.StringVal), true
+		return parser.DString(t.StringVal)
 	case *driver.Datum_DateVal:
-		return parser.DDate(t.DateVal), true
+		return parser.DDate(t.DateVal)
 	case *driver.Datum_TimeVal:
-		return parser.DTimestamp{Time: t.TimeVal.GoTime()}, true
+		return parser.DTimestamp{Time: t.TimeVal.GoTime()}
 	case *driver.Datum_IntervalVal:
-		return parser.DInterval{Duration: time.Duration(t.IntervalVal)}, true
+		return parser.DInterval{Duration: time.Duration(t.IntervalVal)}
+	case *driver.Datum_ArrayVal:
+		elems := make(parser.DTuple, 0, len(t.ArrayVal.Elems))
+		for _, elem := range t.ArrayVal.Elems {
+			elems = append(elems, makeDatumFromDriver(elem))
+		}
+		return parser.NewDArray(elems)
 	default:
 		panic(fmt.Sprintf("unexpected type %T", t))
 	}
@@ -18,6 +18,7 @@
 package sql
 
 import (
+	"bytes"
 	"fmt"
 	"strings"
 
@@ -48,6 +49,10 @@ func (p *planner) Explain(n *parser.Explain) (planNode, error) {
 		return nil, fmt.Errorf("unsupported EXPLAIN options: %s", n)
 	}
 
+	if mode == explainPlan {
+		defer func(planOnly bool) { p.planOnly = planOnly }(p.planOnly)
+		p.planOnly = true
+	}
 	plan, err := p.makePlan(n.Statement)
 	if err != nil {
 		return nil, err
@@ -103,3 +108,22 @@ func populateExplain(v *valuesNode, plan planNode, level int) {
 		populateExplain(v, child, level+1)
 	}
 }
+
+// explainPlanString returns a textual representation of the plan, one node
+// per line, indented according to its depth in the plan.
+func explainPlanString(plan planNode) string {
+	if plan == nil {
+		return "<no plan>"
+	}
+	var buf bytes.Buffer
+	writeExplain(&buf, plan, 0)
+	return buf.String()
+}
+
+func writeExplain(buf *bytes.Buffer, plan planNode, level int) {
+	name, description, children := plan.ExplainPlan()
+	fmt.Fprintf(buf, "%s%s %s\n", strings.Repeat("  ", level), name, description)
+	for _, child := range children {
+		writeExplain(buf, child, level+1)
+	}
+}
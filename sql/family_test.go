@@ -0,0 +1,124 @@
+// Copyright 2015 The Cockroach Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+// implied. See the License for the specific language governing
+// permissions and limitations under the License. See the AUTHORS file
+// for names of contributors.
+
+package sql_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/cockroachdb/cockroach/client"
+	"github.com/cockroachdb/cockroach/keys"
+	"github.com/cockroachdb/cockroach/roachpb"
+	"github.com/cockroachdb/cockroach/sql"
+	"github.com/cockroachdb/cockroach/util/encoding"
+	"github.com/cockroachdb/cockroach/util/leaktest"
+)
+
+// TestBaseFormatVersion verifies that tables created before column families,
+// which store one key-value pair per column, can still be read and written.
+func TestBaseFormatVersion(t *testing.T) {
+	defer leaktest.AfterTest(t)
+	s, sqlDB, kvDB := setup(t)
+	defer cleanup(s, sqlDB)
+
+	if _, err := sqlDB.Exec(`
+CREATE DATABASE t;
+CREATE TABLE t.kv (k INT PRIMARY KEY, v INT, w STRING);
+`); err != nil {
+		t.Fatal(err)
+	}
+
+	gr, err := kvDB.Get(sql.MakeNameMetadataKey(keys.MaxReservedDescID+1, "kv"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !gr.Exists() {
+		t.Fatalf(`table "kv" does not exist`)
+	}
+	descKey := sql.MakeDescMetadataKey(sql.ID(gr.ValueInt()))
+	desc := &sql.Descriptor{}
+	if err := kvDB.GetProto(descKey, desc); err != nil {
+		t.Fatal(err)
+	}
+	tableDesc := desc.GetTable()
+	if tableDesc.FormatVersion != sql.FamilyFormatVersion {
+		t.Fatalf("expected format version %d, but found %d",
+			sql.FamilyFormatVersion, tableDesc.FormatVersion)
+	}
+
+	// Rewrite the descriptor as it was written before column families.
+	tableDesc.FormatVersion = sql.BaseFormatVersion
+	tableDesc.Families = nil
+	tableDesc.NextFamilyID = 0
+	if err := tableDesc.Validate(); err != nil {
+		t.Fatal(err)
+	}
+	// This needs to be done in a transaction with the system trigger set.
+	if err := s.DB().Txn(func(txn *client.Txn) error {
+		txn.SetSystemDBTrigger()
+		return txn.Put(descKey, desc)
+	}); err != nil {
+		t.Fatal(err)
+	}
+
+	for _, stmt := range []string{
+		`INSERT INTO t.kv VALUES (1, 2, 'a'), (3, NULL, 'b'), (4, NULL, NULL)`,
+		`UPDATE t.kv SET v = 5, w = NULL WHERE k = 1`,
+		`DELETE FROM t.kv WHERE k = 3`,
+	} {
+		if _, err := sqlDB.Exec(stmt); err != nil {
+			t.Fatalf("%s: %v", stmt, err)
+		}
+	}
+
+	rows, err := sqlDB.Query(`SELECT k, v, w FROM t.kv`)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var results []string
+	for rows.Next() {
+		var k int
+		var v *int
+		var w *string
+		if err := rows.Scan(&k, &v, &w); err != nil {
+			t.Fatal(err)
+		}
+		vStr, wStr := "NULL", "NULL"
+		if v != nil {
+			vStr = fmt.Sprint(*v)
+		}
+		if w != nil {
+			wStr = *w
+		}
+		results = append(results, fmt.Sprintf("%d|%s|%s", k, vStr, wStr))
+	}
+	if err := rows.Err(); err != nil {
+		t.Fatal(err)
+	}
+	if s, expected := fmt.Sprint(results), "[1|5|NULL 4|NULL|NULL]"; s != expected {
+		t.Fatalf("expected %s, but found %s", expected, s)
+	}
+
+	// The rows are stored as a sentinel key plus one key per non-NULL column.
+	tablePrefix := encoding.EncodeUvarint(append([]byte(nil), keys.TableDataPrefix...),
+		uint64(tableDesc.ID))
+	tableStartKey := roachpb.Key(tablePrefix)
+	if kvs, err := kvDB.Scan(tableStartKey, tableStartKey.PrefixEnd(), 0); err != nil {
+		t.Fatal(err)
+	} else if l := 3; len(kvs) != l {
+		t.Fatalf("expected %d key value pairs, but got %d", l, len(kvs))
+	}
+}
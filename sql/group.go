@@ -19,18 +19,27 @@ package sql
 
 import (
 	"fmt"
+	"math"
 	"strings"
 
 	"github.com/cockroachdb/cockroach/sql/parser"
+	"github.com/cockroachdb/cockroach/util/encoding"
 	"github.com/cockroachdb/cockroach/util/log"
 )
 
 var aggregates = map[string]aggregateImpl{
-	"avg":   &avgAggregate{},
-	"count": &countAggregate{},
-	"max":   &maxAggregate{},
-	"min":   &minAggregate{},
-	"sum":   &sumAggregate{},
+	"array_agg":  &arrayAggregate{},
+	"avg":        &avgAggregate{},
+	"bool_and":   &boolAndAggregate{},
+	"bool_or":    &boolOrAggregate{},
+	"count":      &countAggregate{},
+	"max":        &maxAggregate{},
+	"min":        &minAggregate{},
+	"stddev":     &stddevAggregate{},
+	"string_agg": &stringAggregate{},
+	"sum":        &sumAggregate{},
+	"variance":   &varianceAggregate{},
+	"xor_agg":    &xorAggregate{},
 }
 
 func (p *planner) groupBy(n *parser.Select, s *scanNode) (*groupNode, error) {
@@ -83,11 +92,19 @@ func (p *planner) groupBy(n *parser.Select, s *scanNode) (*groupNode, error) {
 	s.columns = make([]string, 0, len(funcs))
 	s.render = make([]parser.Expr, 0, len(funcs))
 	for _, f := range funcs {
-		if len(f.val.expr.Exprs) != 1 {
-			panic(fmt.Sprintf("%s has %d arguments (expected 1)", f.val.expr.Name, len(f.val.expr.Exprs)))
+		var arg parser.Expr
+		switch len(f.val.expr.Exprs) {
+		case 0:
+			panic(fmt.Sprintf("%s has no arguments", f.val.expr.Name))
+		case 1:
+			arg = f.val.expr.Exprs[0]
+		default:
+			// The arguments of aggregate functions taking several arguments are
+			// passed as a tuple.
+			arg = parser.Tuple(f.val.expr.Exprs)
 		}
 		s.columns = append(s.columns, f.val.String())
-		s.render = append(s.render, f.val.expr.Exprs[0])
+		s.render = append(s.render, arg)
 	}
 
 	group.desiredOrdering = desiredAggregateOrdering(group.funcs)
@@ -124,6 +141,25 @@ func (n *groupNode) Next() bool {
 	}
 	n.needGroup = false
 
+	if flows, ok := n.plan.(*flowNode); ok {
+		// The flows compute the partial results of the aggregate functions
+		// on the nodes holding the rows.
+		if n.err = flows.mergeInto(n.funcs); n.err != nil {
+			return false
+		}
+	}
+
+	// The aggregate functions which buffer their arguments charge them to the
+	// memory account. Each DISTINCT aggregate function has an account of its
+	// own, which holds the values it spilled to disk.
+	acc := n.planner.newMemoryAccount()
+	for _, f := range n.funcs {
+		f.acc = acc
+		if f.seen != nil {
+			f.seen = newSpillableSet(n.planner.newMemoryAccount())
+		}
+	}
+
 	// Loop over the rows passing the values into the corresponding aggregation
 	// functions.
 	for n.plan.Next() {
@@ -139,6 +175,12 @@ func (n *groupNode) Next() bool {
 	if n.err != nil {
 		return false
 	}
+	for _, f := range n.funcs {
+		if f.seen != nil {
+			// The values seen are no longer needed.
+			f.seen.close()
+		}
+	}
 
 	// Fill in the aggregate function result value.
 	for _, f := range n.funcs {
@@ -267,7 +309,7 @@ func (v *extractAggregatesVisitor) Visit(expr parser.Expr, pre bool) (parser.Vis
 				impl: impl.New(),
 			}
 			if t.Distinct {
-				f.seen = make(map[string]struct{})
+				f.seen = newSpillableSet(&memoryAccount{})
 			}
 			v.funcs = append(v.funcs, f)
 			return nil, &f.val
@@ -337,7 +379,12 @@ func (av *aggregateValue) Eval(ctx parser.EvalContext) (parser.Datum, error) {
 type aggregateFunc struct {
 	val  aggregateValue
 	impl aggregateImpl
-	seen map[string]struct{}
+	// The values seen by a DISTINCT aggregate function, which are spilled to
+	// disk when they exceed the memory budget.
+	seen *spillableSet
+	// The memory used by the aggregate functions which buffer their
+	// arguments. May be nil.
+	acc *memoryAccount
 }
 
 func (a *aggregateFunc) Add(d parser.Datum) error {
@@ -346,16 +393,32 @@ func (a *aggregateFunc) Add(d parser.Datum) error {
 		if err != nil {
 			return err
 		}
-		e := string(encoded)
-		if _, ok := a.seen[e]; ok {
+		if seen, err := a.seen.contains(encoded); err != nil {
+			return err
+		} else if seen {
 			// skip
 			return nil
 		}
-		a.seen[e] = struct{}{}
+		if err := a.seen.add(encoded); err != nil {
+			return err
+		}
+	}
+	switch a.impl.(type) {
+	case *arrayAggregate, *stringAggregate:
+		if err := a.grow(datumSize(d)); err != nil {
+			return err
+		}
 	}
 	return a.impl.Add(d)
 }
 
+func (a *aggregateFunc) grow(n int64) error {
+	if a.acc == nil {
+		return nil
+	}
+	return a.acc.grow(n)
+}
+
 func encodeDatum(b []byte, d parser.Datum) ([]byte, error) {
 	if values, ok := d.(parser.DTuple); ok {
 		for _, val := range values {
@@ -367,7 +430,7 @@ func encodeDatum(b []byte, d parser.Datum) ([]byte, error) {
 		}
 		return b, nil
 	}
-	return encodeTableKey(b, d)
+	return encodeTableKey(b, d, encoding.Ascending)
 }
 
 type aggregateImpl interface {
@@ -376,11 +439,64 @@ type aggregateImpl interface {
 	Result() (parser.Datum, error)
 }
 
+var _ aggregateImpl = &arrayAggregate{}
 var _ aggregateImpl = &avgAggregate{}
+var _ aggregateImpl = &boolAndAggregate{}
+var _ aggregateImpl = &boolOrAggregate{}
 var _ aggregateImpl = &countAggregate{}
 var _ aggregateImpl = &maxAggregate{}
 var _ aggregateImpl = &minAggregate{}
+var _ aggregateImpl = &stddevAggregate{}
+var _ aggregateImpl = &stringAggregate{}
 var _ aggregateImpl = &sumAggregate{}
+var _ aggregateImpl = &varianceAggregate{}
+var _ aggregateImpl = &xorAggregate{}
+
+// partialAggregate is implemented by the aggregate functions which can be
+// computed in parts, over disjoint sets of rows, before merging the partial
+// results. This allows them to be computed by flows running on the nodes
+// which hold the rows.
+type partialAggregate interface {
+	// PartialTypes returns the types of the values of a partial result, given
+	// the type of the argument of the aggregate function.
+	PartialTypes(arg parser.Datum) parser.DTuple
+	// Partial returns the partial result of the rows added so far.
+	Partial() parser.DTuple
+	// Merge merges a partial result returned by Partial.
+	Merge(parser.DTuple) error
+}
+
+var _ partialAggregate = &avgAggregate{}
+var _ partialAggregate = &boolAndAggregate{}
+var _ partialAggregate = &boolOrAggregate{}
+var _ partialAggregate = &countAggregate{}
+var _ partialAggregate = &maxAggregate{}
+var _ partialAggregate = &minAggregate{}
+var _ partialAggregate = &stddevAggregate{}
+var _ partialAggregate = &sumAggregate{}
+var _ partialAggregate = &varianceAggregate{}
+var _ partialAggregate = &xorAggregate{}
+
+type arrayAggregate struct {
+	elems parser.DTuple
+}
+
+func (a *arrayAggregate) New() aggregateImpl {
+	return &arrayAggregate{}
+}
+
+func (a *arrayAggregate) Add(datum parser.Datum) error {
+	// Unlike the other aggregates, NULL values are included in the result.
+	a.elems = append(a.elems, datum)
+	return nil
+}
+
+func (a *arrayAggregate) Result() (parser.Datum, error) {
+	if a.elems == nil {
+		return parser.DNull, nil
+	}
+	return parser.NewDArray(a.elems), nil
+}
 
 type avgAggregate struct {
 	sumAggregate
@@ -420,6 +536,101 @@ func (a *avgAggregate) Result() (parser.Datum, error) {
 	}
 }
 
+func (a *avgAggregate) PartialTypes(arg parser.Datum) parser.DTuple {
+	return parser.DTuple{arg, parser.DummyInt}
+}
+
+func (a *avgAggregate) Partial() parser.DTuple {
+	return parser.DTuple{a.sumAggregate.Partial()[0], parser.DInt(a.count)}
+}
+
+func (a *avgAggregate) Merge(partial parser.DTuple) error {
+	if err := a.sumAggregate.Merge(partial[:1]); err != nil {
+		return err
+	}
+	a.count += int(partial[1].(parser.DInt))
+	return nil
+}
+
+type boolAndAggregate struct {
+	sawNonNull bool
+	result     bool
+}
+
+func (a *boolAndAggregate) New() aggregateImpl {
+	return &boolAndAggregate{}
+}
+
+func (a *boolAndAggregate) Add(datum parser.Datum) error {
+	if datum == parser.DNull {
+		return nil
+	}
+	if !a.sawNonNull {
+		a.sawNonNull = true
+		a.result = true
+	}
+	a.result = a.result && bool(datum.(parser.DBool))
+	return nil
+}
+
+func (a *boolAndAggregate) Result() (parser.Datum, error) {
+	if !a.sawNonNull {
+		return parser.DNull, nil
+	}
+	return parser.DBool(a.result), nil
+}
+
+func (a *boolAndAggregate) PartialTypes(parser.Datum) parser.DTuple {
+	return parser.DTuple{parser.DummyBool}
+}
+
+func (a *boolAndAggregate) Partial() parser.DTuple {
+	d, _ := a.Result()
+	return parser.DTuple{d}
+}
+
+func (a *boolAndAggregate) Merge(partial parser.DTuple) error {
+	return a.Add(partial[0])
+}
+
+type boolOrAggregate struct {
+	sawNonNull bool
+	result     bool
+}
+
+func (a *boolOrAggregate) New() aggregateImpl {
+	return &boolOrAggregate{}
+}
+
+func (a *boolOrAggregate) Add(datum parser.Datum) error {
+	if datum == parser.DNull {
+		return nil
+	}
+	a.sawNonNull = true
+	a.result = a.result || bool(datum.(parser.DBool))
+	return nil
+}
+
+func (a *boolOrAggregate) Result() (parser.Datum, error) {
+	if !a.sawNonNull {
+		return parser.DNull, nil
+	}
+	return parser.DBool(a.result), nil
+}
+
+func (a *boolOrAggregate) PartialTypes(parser.Datum) parser.DTuple {
+	return parser.DTuple{parser.DummyBool}
+}
+
+func (a *boolOrAggregate) Partial() parser.DTuple {
+	d, _ := a.Result()
+	return parser.DTuple{d}
+}
+
+func (a *boolOrAggregate) Merge(partial parser.DTuple) error {
+	return a.Add(partial[0])
+}
+
 type countAggregate struct {
 	count int
 }
@@ -450,6 +661,19 @@ func (a *countAggregate) Result() (parser.Datum, error) {
 	return parser.DInt(a.count), nil
 }
 
+func (a *countAggregate) PartialTypes(parser.Datum) parser.DTuple {
+	return parser.DTuple{parser.DummyInt}
+}
+
+func (a *countAggregate) Partial() parser.DTuple {
+	return parser.DTuple{parser.DInt(a.count)}
+}
+
+func (a *countAggregate) Merge(partial parser.DTuple) error {
+	a.count += int(partial[0].(parser.DInt))
+	return nil
+}
+
 type maxAggregate struct {
 	max parser.Datum
 }
@@ -480,6 +704,21 @@ func (a *maxAggregate) Result() (parser.Datum, error) {
 	return a.max, nil
 }
 
+func (a *maxAggregate) PartialTypes(arg parser.Datum) parser.DTuple {
+	return parser.DTuple{arg}
+}
+
+func (a *maxAggregate) Partial() parser.DTuple {
+	if a.max == nil {
+		return parser.DTuple{parser.DNull}
+	}
+	return parser.DTuple{a.max}
+}
+
+func (a *maxAggregate) Merge(partial parser.DTuple) error {
+	return a.Add(partial[0])
+}
+
 type minAggregate struct {
 	min parser.Datum
 }
@@ -510,6 +749,21 @@ func (a *minAggregate) Result() (parser.Datum, error) {
 	return a.min, nil
 }
 
+func (a *minAggregate) PartialTypes(arg parser.Datum) parser.DTuple {
+	return parser.DTuple{arg}
+}
+
+func (a *minAggregate) Partial() parser.DTuple {
+	if a.min == nil {
+		return parser.DTuple{parser.DNull}
+	}
+	return parser.DTuple{a.min}
+}
+
+func (a *minAggregate) Merge(partial parser.DTuple) error {
+	return a.Add(partial[0])
+}
+
 type sumAggregate struct {
 	sum parser.Datum
 }
@@ -550,3 +804,209 @@ func (a *sumAggregate) Result() (parser.Datum, error) {
 	}
 	return a.sum, nil
 }
+
+func (a *sumAggregate) PartialTypes(arg parser.Datum) parser.DTuple {
+	return parser.DTuple{arg}
+}
+
+func (a *sumAggregate) Partial() parser.DTuple {
+	if a.sum == nil {
+		return parser.DTuple{parser.DNull}
+	}
+	return parser.DTuple{a.sum}
+}
+
+func (a *sumAggregate) Merge(partial parser.DTuple) error {
+	return a.Add(partial[0])
+}
+
+type stddevAggregate struct {
+	varianceAggregate
+}
+
+func (a *stddevAggregate) New() aggregateImpl {
+	return &stddevAggregate{}
+}
+
+func (a *stddevAggregate) Result() (parser.Datum, error) {
+	variance, err := a.varianceAggregate.Result()
+	if err != nil || variance == parser.DNull {
+		return variance, err
+	}
+	return parser.DFloat(math.Sqrt(float64(variance.(parser.DFloat)))), nil
+}
+
+// stringAggregate concatenates the non-NULL values of its first argument,
+// separated by the value of its second argument.
+type stringAggregate struct {
+	result []byte
+	bytes  bool
+	seen   bool
+}
+
+func (a *stringAggregate) New() aggregateImpl {
+	return &stringAggregate{}
+}
+
+func (a *stringAggregate) Add(datum parser.Datum) error {
+	args := datum.(parser.DTuple)
+	var value, sep string
+	switch t := args[0].(type) {
+	case parser.DString:
+		value = string(t)
+	case parser.DBytes:
+		value = string(t)
+		a.bytes = true
+	default:
+		return nil
+	}
+	switch t := args[1].(type) {
+	case parser.DString:
+		sep = string(t)
+	case parser.DBytes:
+		sep = string(t)
+	}
+	if a.seen {
+		a.result = append(a.result, sep...)
+	}
+	a.result = append(a.result, value...)
+	a.seen = true
+	return nil
+}
+
+func (a *stringAggregate) Result() (parser.Datum, error) {
+	if !a.seen {
+		return parser.DNull, nil
+	}
+	if a.bytes {
+		return parser.DBytes(a.result), nil
+	}
+	return parser.DString(a.result), nil
+}
+
+// varianceAggregate computes the sample variance of its argument using
+// Welford's algorithm, which is numerically stable.
+type varianceAggregate struct {
+	count int
+	mean  float64
+	// The sum of the squared differences from the mean.
+	sqrDiff float64
+}
+
+func (a *varianceAggregate) New() aggregateImpl {
+	return &varianceAggregate{}
+}
+
+func (a *varianceAggregate) Add(datum parser.Datum) error {
+	if datum == parser.DNull {
+		return nil
+	}
+	var f float64
+	switch t := datum.(type) {
+	case parser.DInt:
+		f = float64(t)
+	case parser.DFloat:
+		f = float64(t)
+	default:
+		return fmt.Errorf("unexpected VARIANCE argument type: %s", datum.Type())
+	}
+	a.count++
+	delta := f - a.mean
+	a.mean += delta / float64(a.count)
+	a.sqrDiff += delta * (f - a.mean)
+	return nil
+}
+
+func (a *varianceAggregate) Result() (parser.Datum, error) {
+	if a.count < 2 {
+		return parser.DNull, nil
+	}
+	return parser.DFloat(a.sqrDiff / float64(a.count-1)), nil
+}
+
+func (a *varianceAggregate) PartialTypes(parser.Datum) parser.DTuple {
+	return parser.DTuple{parser.DummyInt, parser.DummyFloat, parser.DummyFloat}
+}
+
+func (a *varianceAggregate) Partial() parser.DTuple {
+	return parser.DTuple{parser.DInt(a.count), parser.DFloat(a.mean), parser.DFloat(a.sqrDiff)}
+}
+
+func (a *varianceAggregate) Merge(partial parser.DTuple) error {
+	count := int(partial[0].(parser.DInt))
+	if count == 0 {
+		return nil
+	}
+	mean := float64(partial[1].(parser.DFloat))
+	sqrDiff := float64(partial[2].(parser.DFloat))
+	total := a.count + count
+	delta := mean - a.mean
+	a.sqrDiff += sqrDiff + delta*delta*float64(a.count)*float64(count)/float64(total)
+	a.mean += delta * float64(count) / float64(total)
+	a.count = total
+	return nil
+}
+
+// xorAggregate computes the bitwise XOR of its int or bytes argument.
+type xorAggregate struct {
+	result parser.Datum
+}
+
+func (a *xorAggregate) New() aggregateImpl {
+	return &xorAggregate{}
+}
+
+func (a *xorAggregate) Add(datum parser.Datum) error {
+	if datum == parser.DNull {
+		return nil
+	}
+	if a.result == nil {
+		a.result = datum
+		return nil
+	}
+
+	switch t := datum.(type) {
+	case parser.DInt:
+		if v, ok := a.result.(parser.DInt); ok {
+			a.result = v ^ t
+			return nil
+		}
+
+	case parser.DBytes:
+		if v, ok := a.result.(parser.DBytes); ok {
+			if len(v) != len(t) {
+				return fmt.Errorf("arguments to XOR_AGG must all be the same length: %d vs %d", len(v), len(t))
+			}
+			b := []byte(v)
+			for i := range b {
+				b[i] ^= t[i]
+			}
+			a.result = parser.DBytes(b)
+			return nil
+		}
+	}
+
+	return fmt.Errorf("unexpected XOR_AGG argument type: %s", datum.Type())
+}
+
+func (a *xorAggregate) Result() (parser.Datum, error) {
+	if a.result == nil {
+		return parser.DNull, nil
+	}
+	return a.result, nil
+}
+
+func (a *xorAggregate) PartialTypes(arg parser.Datum) parser.DTuple {
+	return parser.DTuple{arg}
+}
+
+func (a *xorAggregate) Partial() parser.DTuple {
+	if a.result == nil {
+		return parser.DTuple{parser.DNull}
+	}
+	return parser.DTuple{a.result}
+}
+
+func (a *xorAggregate) Merge(partial parser.DTuple) error {
+	return a.Add(partial[0])
+}
@@ -0,0 +1,513 @@
+// Copyright 2015 The Cockroach Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+// implied. See the License for the specific language governing
+// permissions and limitations under the License. See the AUTHORS file
+// for names of contributors.
+
+package sql
+
+import (
+	"encoding/csv"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net/url"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/cockroachdb/cockroach/client"
+	"github.com/cockroachdb/cockroach/keys"
+	"github.com/cockroachdb/cockroach/roachpb"
+	"github.com/cockroachdb/cockroach/security"
+	"github.com/cockroachdb/cockroach/sql/parser"
+	"github.com/cockroachdb/cockroach/util/log"
+)
+
+// importBatchSize is the number of KVs buffered before they are written to
+// the KV store during an IMPORT.
+const importBatchSize = 10000
+
+// Import creates a table using the CREATE TABLE statement stored in a file
+// and loads it with the rows from one or more CSV files. The KVs for the
+// primary and secondary indexes are encoded directly and written in large
+// batches, bypassing the planning and per-statement overhead of INSERT.
+//
+// Each batch is committed in a transaction of its own, so that the size of
+// an import is not limited by the size of a transaction. The table is first
+// created OFFLINE, which reserves its name but keeps it from being used, and
+// is made public once all of the rows have been written. A failed import
+// removes the table and its rows; if the node dies in the middle of the
+// import, the table GC removes them instead.
+// Privileges: "root" user.
+func (p *planner) Import(n *parser.Import) (planNode, error) {
+	if p.user != security.RootUser {
+		return nil, fmt.Errorf("only %s is allowed to import tables", security.RootUser)
+	}
+	if !p.implicitTxn {
+		return nil, fmt.Errorf("IMPORT cannot be used inside a transaction")
+	}
+	schema, err := p.readExternalFile(n.CreateFile)
+	if err != nil {
+		return nil, err
+	}
+	stmts, err := parser.ParseTraditional(string(schema))
+	if err != nil {
+		return nil, err
+	}
+	if len(stmts) != 1 {
+		return nil, fmt.Errorf("expected a single CREATE TABLE statement in %s, found %d statements",
+			n.CreateFile, len(stmts))
+	}
+	create, ok := stmts[0].(*parser.CreateTable)
+	if !ok {
+		return nil, fmt.Errorf("expected a CREATE TABLE statement in %s, found %s",
+			n.CreateFile, stmts[0])
+	}
+	if create.Interleave != nil {
+		// The rows of an interleaved table are stored in the key span of its
+		// parent, from which the rows of a failed import could not be removed.
+		return nil, fmt.Errorf("IMPORT does not support interleaved tables")
+	}
+
+	// The table is always created with the name specified by the IMPORT
+	// statement, regardless of the name in the schema file.
+	create.Table = n.Table
+	dbDesc, desc, err := p.makeCreateTableDesc(create)
+	if err != nil {
+		return nil, err
+	}
+	tableDesc := &desc
+
+	// The CSV fields are converted to the column types by casting them from
+	// strings. The cast target is the type from the schema file, which is more
+	// precise than the type recorded in the column descriptor.
+	colTypes := map[string]parser.ColumnType{}
+	for _, def := range create.Defs {
+		if d, ok := def.(*parser.ColumnTableDef); ok {
+			colTypes[string(d.Name)] = d.Type
+		}
+	}
+
+	// The CSV records hold the values of the columns which are not computed.
+	// The computed columns follow them in each row.
+	cols, err := p.processColumns(tableDesc, nil)
+	if err != nil {
+		return nil, err
+	}
+	numInputCols := len(cols)
+	defaultExprs, err := p.makeDefaultExprs(cols)
+	if err != nil {
+		return nil, err
+	}
+	computed, err := p.makeComputedColumns(tableDesc, nil)
+	if err != nil {
+		return nil, err
+	}
+	if computed != nil {
+		cols = append(cols, computed.cols...)
+	}
+	predicates, err := p.makeIndexPredicates(tableDesc)
+	if err != nil {
+		return nil, err
+	}
+	colIDtoRowIndex := map[ColumnID]int{}
+	for i, c := range cols {
+		colIDtoRowIndex[c.ID] = i
+	}
+
+	table, err := p.createOfflineTable(tableKey{dbDesc.ID, n.Table.Table()}, tableDesc)
+	if err != nil {
+		return nil, err
+	}
+	imp := importer{
+		p:               p,
+		table:           table,
+		tableDesc:       tableDesc,
+		cols:            cols,
+		numInputCols:    numInputCols,
+		colTypes:        colTypes,
+		colIDtoRowIndex: colIDtoRowIndex,
+		defaultExprs:    defaultExprs,
+		computed:        computed,
+		predicates:      predicates,
+	}
+	if err := imp.importFiles(n.Files); err != nil {
+		return nil, table.remove(err)
+	}
+	if err := p.publishOfflineTables(table); err != nil {
+		return nil, table.remove(err)
+	}
+	return &rowCountNode{count: imp.rows}, nil
+}
+
+// importer holds the state for loading CSV rows into a table.
+type importer struct {
+	p               *planner
+	table           *offlineTable
+	tableDesc       *TableDescriptor
+	cols            []ColumnDescriptor
+	numInputCols    int
+	colTypes        map[string]parser.ColumnType
+	colIDtoRowIndex map[ColumnID]int
+	defaultExprs    []parser.Expr
+	computed        *computedColumns
+	predicates      *indexPredicates
+
+	// The KVs which have not been written yet, and the number of rows
+	// imported.
+	kvs  []indexEntry
+	rows int
+}
+
+func (imp *importer) importFiles(locations []string) error {
+	for _, location := range locations {
+		if err := imp.importFile(location); err != nil {
+			return err
+		}
+	}
+	return imp.flush()
+}
+
+func (imp *importer) importFile(location string) error {
+	path, err := imp.p.externalPath(location)
+	if err != nil {
+		return err
+	}
+	f, err := os.Open(path)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+
+	r := csv.NewReader(f)
+	r.FieldsPerRecord = imp.numInputCols
+	for line := 1; ; line++ {
+		record, err := r.Read()
+		if err == io.EOF {
+			return nil
+		}
+		if err != nil {
+			return fmt.Errorf("%s: %v", location, err)
+		}
+		if err := imp.importRow(record); err != nil {
+			return fmt.Errorf("%s:%d: %v", location, line, err)
+		}
+	}
+}
+
+func (imp *importer) importRow(record []string) error {
+	p := imp.p
+	rowVals := make(parser.DTuple, len(record))
+	for i, field := range record {
+		col := imp.cols[i]
+		if field == "" {
+			// Empty fields are NULL unless the column has a DEFAULT expression.
+			rowVals[i] = parser.DNull
+			if imp.defaultExprs != nil {
+				d, err := imp.defaultExprs[i].Eval(p.evalCtx)
+				if err != nil {
+					return err
+				}
+				rowVals[i] = d
+			}
+			continue
+		}
+		cast := &parser.CastExpr{Expr: parser.DString(field), Type: imp.colTypes[col.Name]}
+		d, err := cast.Eval(p.evalCtx)
+		if err != nil {
+			return fmt.Errorf("column %q: %v", col.Name, err)
+		}
+		rowVals[i] = d
+	}
+
+	if imp.computed != nil {
+		vals, err := imp.computed.eval(p.evalCtx, imp.colIDtoRowIndex, rowVals)
+		if err != nil {
+			return err
+		}
+		rowVals = append(rowVals, vals...)
+	}
+
+	// Check to see if NULL is being inserted into any non-nullable column.
+	for i, col := range imp.cols {
+		if !col.Nullable && rowVals[i] == parser.DNull {
+			return fmt.Errorf("null value in column %q violates not-null constraint", col.Name)
+		}
+	}
+
+	// Check that the value types match the column types.
+	for i, val := range rowVals {
+		if _, err := marshalColumnValue(imp.cols[i], val); err != nil {
+			return err
+		}
+	}
+
+	primaryIndexKey, err := encodePrimaryIndexKey(imp.tableDesc, imp.colIDtoRowIndex, rowVals)
+	if err != nil {
+		return err
+	}
+
+	indexes, err := imp.predicates.filter(
+		p.evalCtx, imp.tableDesc.Indexes, imp.colIDtoRowIndex, rowVals)
+	if err != nil {
+		return err
+	}
+	secondaryIndexEntries, err := encodeSecondaryIndexes(
+		imp.tableDesc.ID, indexes, imp.colIDtoRowIndex, rowVals)
+	if err != nil {
+		return err
+	}
+
+	// Conditional puts are used so that duplicate keys in the imported data
+	// are reported as uniqueness violations.
+	imp.kvs = append(imp.kvs, secondaryIndexEntries...)
+
+	// Write the column families. Tables created by IMPORT always use
+	// FamilyFormatVersion.
+	familyEntries, err := encodeFamilies(
+		imp.tableDesc.Families, primaryIndexKey, imp.colIDtoRowIndex, rowVals)
+	if err != nil {
+		return err
+	}
+	for _, familyEntry := range familyEntries {
+		if familyEntry.value != nil {
+			imp.kvs = append(imp.kvs, familyEntry)
+		}
+	}
+	imp.rows++
+
+	if len(imp.kvs) >= importBatchSize {
+		return imp.flush()
+	}
+	return nil
+}
+
+// flush writes the buffered KVs in a transaction of their own.
+func (imp *importer) flush() error {
+	if len(imp.kvs) == 0 {
+		return nil
+	}
+	if log.V(2) {
+		log.Infof("import: writing %d KVs to table %q", len(imp.kvs), imp.tableDesc.Name)
+	}
+	var b *client.Batch
+	if err := imp.p.db.Txn(func(txn *client.Txn) error {
+		// A batch cannot be run again once it failed, so each attempt uses a
+		// new one.
+		b = txn.NewBatch()
+		for _, kv := range imp.kvs {
+			b.CPut(kv.key, kv.value, nil)
+		}
+		return txn.CommitInBatch(b)
+	}); err != nil {
+		if b == nil {
+			return err
+		}
+		return convertBatchError(imp.tableDesc, *b, err)
+	}
+	imp.kvs = imp.kvs[:0]
+	return imp.table.heartbeat()
+}
+
+// offlineTableHeartbeatInterval is the interval at which an IMPORT or RESTORE
+// records the progress it makes populating an OFFLINE table.
+const offlineTableHeartbeatInterval = offlineTableTimeout / 4
+
+// offlineTable is an OFFLINE table being populated by an IMPORT or RESTORE.
+type offlineTable struct {
+	p             *planner
+	desc          *TableDescriptor
+	lastHeartbeat int64
+}
+
+// createOfflineTable creates the table in the OFFLINE state, in a transaction
+// of its own so that the table GC finds the table if the node populating it
+// dies. The rows of the table are written in transactions of their own as
+// well, after which the table is made public by publishOfflineTables or, if
+// populating the table failed, removed by remove.
+func (p *planner) createOfflineTable(key tableKey, desc *TableDescriptor) (*offlineTable, error) {
+	desc.State = TableDescriptor_OFFLINE
+	desc.OfflineHeartbeat = p.leaseMgr.clock.Now().WallTime
+	if err := p.commitDescriptor(key, desc); err != nil {
+		return nil, err
+	}
+	return &offlineTable{p: p, desc: desc, lastHeartbeat: desc.OfflineHeartbeat}, nil
+}
+
+// heartbeat records that the table is making progress, so that the table GC
+// doesn't drop it. It only writes the descriptor every
+// offlineTableHeartbeatInterval.
+func (t *offlineTable) heartbeat() error {
+	now := t.p.leaseMgr.clock.Now().WallTime
+	if now < t.lastHeartbeat+int64(offlineTableHeartbeatInterval) {
+		return nil
+	}
+	if err := t.p.db.Txn(func(txn *client.Txn) error {
+		desc, err := t.getDesc(txn)
+		if err != nil {
+			return err
+		}
+		desc.GetTable().OfflineHeartbeat = now
+		b := txn.NewBatch()
+		b.Put(MakeDescMetadataKey(t.desc.ID), desc)
+		txn.SetSystemDBTrigger()
+		return txn.CommitInBatch(b)
+	}); err != nil {
+		return err
+	}
+	t.lastHeartbeat = now
+	return nil
+}
+
+// getDesc reads the descriptor of the table in the transaction. It fails if
+// the table is no longer OFFLINE, which means that the table GC has dropped
+// it.
+func (t *offlineTable) getDesc(txn *client.Txn) (*Descriptor, error) {
+	desc := &Descriptor{}
+	if err := txn.GetProto(MakeDescMetadataKey(t.desc.ID), desc); err != nil {
+		return nil, err
+	}
+	if table := desc.GetTable(); table == nil || !table.Offline() {
+		return nil, fmt.Errorf("table %q was dropped while it was being populated", t.desc.Name)
+	}
+	return desc, nil
+}
+
+// publishOfflineTables makes the tables public, all in one transaction.
+func (p *planner) publishOfflineTables(tables ...*offlineTable) error {
+	return p.db.Txn(func(txn *client.Txn) error {
+		b := txn.NewBatch()
+		for _, t := range tables {
+			desc, err := t.getDesc(txn)
+			if err != nil {
+				return err
+			}
+			table := desc.GetTable()
+			table.State = TableDescriptor_PUBLIC
+			table.OfflineHeartbeat = 0
+			b.Put(MakeDescMetadataKey(t.desc.ID), desc)
+		}
+		txn.SetSystemDBTrigger()
+		return txn.CommitInBatch(b)
+	})
+}
+
+// remove clears the data of the table and removes it, after populating it
+// failed with the given error. It returns that error, along with the error
+// removing the table if that failed as well, in which case the table GC
+// eventually removes the table.
+func (t *offlineTable) remove(cause error) error {
+	tablePrefix := roachpb.Key(keys.MakeTablePrefix(uint32(t.desc.ID)))
+	err := t.p.db.ClearRange(tablePrefix, tablePrefix.PrefixEnd())
+	if err == nil {
+		err = t.p.db.Txn(func(txn *client.Txn) error {
+			descKey := MakeDescMetadataKey(t.desc.ID)
+			desc := &Descriptor{}
+			if err := txn.GetProto(descKey, desc); err != nil {
+				return err
+			}
+			if table := desc.GetTable(); table == nil || !table.Offline() {
+				// The table GC has dropped the table and clears its data.
+				return nil
+			}
+			b := txn.NewBatch()
+			b.Del(tableKey{t.desc.ParentID, t.desc.Name}.Key())
+			b.Del(descKey)
+			txn.SetSystemDBTrigger()
+			return txn.CommitInBatch(b)
+		})
+	}
+	if err != nil {
+		return fmt.Errorf("%v; unable to remove table %q: %v", cause, t.desc.Name, err)
+	}
+	return cause
+}
+
+// rowCountNode produces as many empty rows as there were rows affected by a
+// statement which has already been executed, without holding on to them.
+type rowCountNode struct {
+	count int
+}
+
+func (n *rowCountNode) Columns() []string      { return nil }
+func (n *rowCountNode) Ordering() ([]int, int) { return nil, 0 }
+func (n *rowCountNode) Values() parser.DTuple  { return nil }
+func (n *rowCountNode) Err() error             { return nil }
+
+func (n *rowCountNode) Next() bool {
+	if n.count == 0 {
+		return false
+	}
+	n.count--
+	return true
+}
+
+func (n *rowCountNode) ExplainPlan() (name, description string, children []planNode) {
+	return "row count", fmt.Sprintf("%d", n.count), nil
+}
+
+// localPath returns the local file system path for a file location given in
+// a statement, which is either a file:// URL or a plain path.
+func localPath(location string) (string, error) {
+	u, err := url.Parse(location)
+	if err != nil {
+		return "", err
+	}
+	switch u.Scheme {
+	case "file":
+		return strings.TrimPrefix(location, "file://"), nil
+	case "":
+		return location, nil
+	default:
+		return "", fmt.Errorf("unsupported location %q: only local files are supported", location)
+	}
+}
+
+// externalPath returns the local file system path of a file location given
+// in an IMPORT, BACKUP or RESTORE statement. Relative paths are relative to
+// the external IO directory, and absolute paths, such as those of file:///
+// URLs, must be inside of it. Paths outside of that directory are rejected.
+func (p *planner) externalPath(location string) (string, error) {
+	if p.externalIODir == "" {
+		return "", fmt.Errorf("external IO is disabled: the node was started without --external-io-dir")
+	}
+	path, err := localPath(location)
+	if err != nil {
+		return "", err
+	}
+	if filepath.IsAbs(path) {
+		dir, err := filepath.Abs(p.externalIODir)
+		if err != nil {
+			return "", err
+		}
+		rel, err := filepath.Rel(dir, filepath.Clean(path))
+		if err != nil || rel == ".." || strings.HasPrefix(rel, ".."+string(filepath.Separator)) {
+			return "", fmt.Errorf("location %q is outside of the external IO directory %s", location, dir)
+		}
+		return filepath.Join(dir, rel), nil
+	}
+	for _, elem := range strings.Split(filepath.ToSlash(path), "/") {
+		if elem == ".." {
+			return "", fmt.Errorf("location %q must not refer to a parent directory", location)
+		}
+	}
+	return filepath.Join(p.externalIODir, path), nil
+}
+
+func (p *planner) readExternalFile(location string) ([]byte, error) {
+	path, err := p.externalPath(location)
+	if err != nil {
+		return nil, err
+	}
+	return ioutil.ReadFile(path)
+}
@@ -0,0 +1,219 @@
+// Copyright 2015 The Cockroach Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+// implied. See the License for the specific language governing
+// permissions and limitations under the License. See the AUTHORS file
+// for names of contributors.
+
+package sql_test
+
+import (
+	"database/sql"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/cockroachdb/cockroach/server"
+	"github.com/cockroachdb/cockroach/testutils"
+	"github.com/cockroachdb/cockroach/util/leaktest"
+)
+
+func TestImport(t *testing.T) {
+	defer leaktest.AfterTest(t)
+	dir, err := ioutil.TempDir("", "TestImport")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer func() {
+		if err := os.RemoveAll(dir); err != nil {
+			t.Fatal(err)
+		}
+	}()
+
+	ctx := server.NewTestContext()
+	ctx.ExternalIODir = dir
+	s, sqlDB, _ := setupWithContext(t, ctx)
+	defer cleanup(s, sqlDB)
+
+	files := map[string]string{
+		"kv.sql": `CREATE TABLE ignored (
+  k INT PRIMARY KEY,
+  v STRING,
+  d TIMESTAMP,
+  n INT NOT NULL DEFAULT 7,
+  UNIQUE INDEX (v)
+)`,
+		"kv1.csv":      "1,one,2015-08-30 10:00:00,1\n2,\"two, too\",,\n",
+		"kv2.csv":      "3,,2015-08-31 11:00:00,3\n",
+		"dup.csv":      "1,a,,1\n2,a,,2\n",
+		"badint.csv":   "x,a,,1\n",
+		"short.csv":    "1,a\n",
+		"notnull.sql":  `CREATE TABLE ignored (k INT PRIMARY KEY, n INT NOT NULL)`,
+		"empty.csv":    "1,\n",
+		"multi.sql":    `CREATE TABLE a (k INT PRIMARY KEY); CREATE TABLE b (k INT PRIMARY KEY)`,
+		"computed.sql": `CREATE TABLE ignored (k INT PRIMARY KEY, v INT, w INT AS (k * v) STORED)`,
+		"computed.csv": "2,3\n",
+	}
+	for name, contents := range files {
+		if err := ioutil.WriteFile(filepath.Join(dir, name), []byte(contents), 0644); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if _, err := sqlDB.Exec(`CREATE DATABASE t`); err != nil {
+		t.Fatal(err)
+	}
+
+	res, err := sqlDB.Exec(`IMPORT TABLE t.kv CREATE USING 'file://kv.sql' CSV DATA ('kv1.csv', 'file://kv2.csv')`)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if n, err := res.RowsAffected(); err != nil {
+		t.Fatal(err)
+	} else if n != 3 {
+		t.Fatalf("expected 3 rows imported, but found %d", n)
+	}
+
+	rows, err := sqlDB.Query(`SELECT k, v, d, n FROM t.kv`)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var results []string
+	for rows.Next() {
+		var k, n int
+		var v *string
+		var d *time.Time
+		if err := rows.Scan(&k, &v, &d, &n); err != nil {
+			t.Fatal(err)
+		}
+		vStr, dStr := "NULL", "NULL"
+		if v != nil {
+			vStr = *v
+		}
+		if d != nil {
+			dStr = d.UTC().Format("2006-01-02 15:04")
+		}
+		results = append(results, fmt.Sprintf("%d|%s|%s|%d", k, vStr, dStr, n))
+	}
+	if err := rows.Err(); err != nil {
+		t.Fatal(err)
+	}
+	expected := fmt.Sprint([]string{
+		"1|one|2015-08-30 10:00|1",
+		"2|two, too|NULL|7",
+		"3|NULL|2015-08-31 11:00|3",
+	})
+	if s := fmt.Sprint(results); s != expected {
+		t.Fatalf("expected %s, but found %s", expected, s)
+	}
+
+	// The secondary index was populated.
+	var k int
+	if err := sqlDB.QueryRow(`SELECT k FROM t.kv@kv_v_key WHERE v = 'two, too'`).Scan(&k); err != nil {
+		t.Fatal(err)
+	} else if k != 2 {
+		t.Fatalf("expected 2, but found %d", k)
+	}
+
+	// The CSV data does not contain the computed columns, which are computed
+	// from the imported columns.
+	if _, err := sqlDB.Exec(`IMPORT TABLE t.computed CREATE USING 'computed.sql' CSV DATA ('computed.csv')`); err != nil {
+		t.Fatal(err)
+	}
+	var w int
+	if err := sqlDB.QueryRow(`SELECT w FROM t.computed`).Scan(&w); err != nil {
+		t.Fatal(err)
+	} else if w != 6 {
+		t.Fatalf("expected 6, but found %d", w)
+	}
+
+	// Absolute paths and file:/// URLs can refer to files in the external IO
+	// directory.
+	if _, err := sqlDB.Exec(fmt.Sprintf(`IMPORT TABLE t.abs CREATE USING 'file://%s' CSV DATA ('%s')`,
+		filepath.Join(dir, "kv.sql"), filepath.Join(dir, "kv1.csv"))); err != nil {
+		t.Fatal(err)
+	}
+	var count int
+	if err := sqlDB.QueryRow(`SELECT COUNT(*) FROM t.abs`).Scan(&count); err != nil {
+		t.Fatal(err)
+	} else if count != 2 {
+		t.Fatalf("expected 2 rows, but found %d", count)
+	}
+
+	testCases := []struct {
+		table, schema, data string
+		expectedErr         string
+	}{
+		{"kv", "kv.sql", "kv1.csv", `table "kv" already exists`},
+		{"dup", "kv.sql", "dup.csv", `duplicate key value \(v\)=\('a'\) violates unique constraint "dup_v_key"`},
+		{"badint", "kv.sql", "badint.csv", `badint.csv:1: column "k": .*invalid syntax`},
+		{"short", "kv.sql", "short.csv", `short.csv:.*wrong number of fields`},
+		{"notnull", "notnull.sql", "empty.csv", `empty.csv:1: null value in column "n" violates not-null constraint`},
+		{"multi", "multi.sql", "empty.csv", `expected a single CREATE TABLE statement`},
+		{"missing", "missing.sql", "empty.csv", `no such file or directory`},
+		{"remote", "kv.sql", "http://example.com/kv.csv", `unsupported location`},
+		{"absolute", "kv.sql", filepath.Join(filepath.Dir(dir), "kv1.csv"), `is outside of the external IO directory`},
+		{"absoluteurl", "kv.sql", "file://" + filepath.Join(filepath.Dir(dir), "kv1.csv"), `is outside of the external IO directory`},
+		{"parent", "kv.sql", "../kv1.csv", `must not refer to a parent directory`},
+		{"parent2", "kv.sql", "a/../../kv1.csv", `must not refer to a parent directory`},
+	}
+	for _, tc := range testCases {
+		_, err := sqlDB.Exec(fmt.Sprintf(`IMPORT TABLE t.%s CREATE USING '%s' CSV DATA ('%s')`,
+			tc.table, tc.schema, tc.data))
+		if !testutils.IsError(err, tc.expectedErr) {
+			t.Errorf("%s: expected %q, but found %v", tc.table, tc.expectedErr, err)
+		}
+	}
+
+	// A failed import does not leave the table behind.
+	if _, err := sqlDB.Exec(`SELECT * FROM t.dup`); !testutils.IsError(err, `table "dup" does not exist`) {
+		t.Fatalf("expected table to not exist, but found %v", err)
+	}
+
+	// The rows imported in separate transactions are committed in chunks, so
+	// IMPORT cannot be part of a larger transaction.
+	tx, err := sqlDB.Begin()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := tx.Exec(`IMPORT TABLE t.txn CREATE USING 'kv.sql' CSV DATA ('kv1.csv')`); !testutils.IsError(err, `IMPORT cannot be used inside a transaction`) {
+		t.Fatalf("expected IMPORT to be rejected in a transaction, but found %v", err)
+	}
+	if err := tx.Rollback(); err != nil {
+		t.Fatal(err)
+	}
+
+	// IMPORT reads files on the server, which only root may do.
+	userDB, err := sql.Open("cockroach", fmt.Sprintf("https://%s@%s?certs=test_certs",
+		server.TestUser, s.ServingAddr()))
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer userDB.Close()
+	if _, err := userDB.Exec(`IMPORT TABLE t.user CREATE USING 'kv.sql' CSV DATA ('kv1.csv')`); !testutils.IsError(err, `only root is allowed to import tables`) {
+		t.Fatalf("expected IMPORT to require root, but found %v", err)
+	}
+}
+
+func TestImportDisabled(t *testing.T) {
+	defer leaktest.AfterTest(t)
+	s, sqlDB, _ := setup(t)
+	defer cleanup(s, sqlDB)
+
+	if _, err := sqlDB.Exec(`CREATE DATABASE t`); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := sqlDB.Exec(`IMPORT TABLE t.kv CREATE USING 'kv.sql' CSV DATA ('kv.csv')`); !testutils.IsError(err, `external IO is disabled`) {
+		t.Fatalf("expected IMPORT to be disabled, but found %v", err)
+	}
+}
@@ -51,6 +51,9 @@ func (p *planner) Insert(n *parser.Insert) (planNode, error) {
 	if err != nil {
 		return nil, err
 	}
+	if err := checkComputedWrites(cols); err != nil {
+		return nil, err
+	}
 
 	// Construct a map from column ID to the index the value appears at within a
 	// row.
@@ -70,6 +73,27 @@ func (p *planner) Insert(n *parser.Insert) (planNode, error) {
 		}
 	}
 
+	// Add the computed columns, whose values are computed from the other
+	// columns of each row.
+	numInputCols := len(cols)
+	computed, err := p.makeComputedColumns(tableDesc, nil)
+	if err != nil {
+		return nil, err
+	}
+	if computed != nil {
+		for _, col := range computed.cols {
+			colIDtoRowIndex[col.ID] = len(cols)
+			cols = append(cols, col)
+		}
+	}
+
+	// The partial indexes only have entries for the rows matching their
+	// predicates.
+	predicates, err := p.makeIndexPredicates(tableDesc)
+	if err != nil {
+		return nil, err
+	}
+
 	// Verify we have at least the columns that are part of the primary key.
 	primaryKeyCols := map[ColumnID]struct{}{}
 	for i, id := range tableDesc.PrimaryIndex.ColumnIDs {
@@ -81,13 +105,13 @@ func (p *planner) Insert(n *parser.Insert) (planNode, error) {
 
 	// Construct the default expressions. The returned slice will be nil if no
 	// column in the table has a default expression.
-	defaultExprs, err := p.makeDefaultExprs(cols)
+	defaultExprs, err := p.makeDefaultExprs(cols[:numInputCols])
 	if err != nil {
 		return nil, err
 	}
 
 	// Replace any DEFAULT markers with the corresponding default expressions.
-	if n.Rows, err = p.fillDefaults(defaultExprs, cols, n.Rows); err != nil {
+	if n.Rows, err = p.fillDefaults(defaultExprs, cols[:numInputCols], n.Rows); err != nil {
 		return nil, err
 	}
 
@@ -98,9 +122,6 @@ func (p *planner) Insert(n *parser.Insert) (planNode, error) {
 		return nil, err
 	}
 
-	primaryIndex := tableDesc.PrimaryIndex
-	primaryIndexKeyPrefix := MakeIndexKeyPrefix(tableDesc.ID, primaryIndex.ID)
-
 	marshalled := make([]interface{}, len(cols))
 
 	b := client.Batch{}
@@ -112,7 +133,11 @@ func (p *planner) Insert(n *parser.Insert) (planNode, error) {
 		// The values for the row may be shorter than the number of columns being
 		// inserted into. Generate default values for those columns using the
 		// default expressions.
-		for i := len(rowVals); i < len(cols); i++ {
+		if len(rowVals) > numInputCols {
+			return nil, fmt.Errorf("INSERT has more expressions than target columns: %d vs %d",
+				len(rowVals), numInputCols)
+		}
+		for i := len(rowVals); i < numInputCols; i++ {
 			if defaultExprs == nil {
 				rowVals = append(rowVals, parser.DNull)
 				continue
@@ -124,6 +149,15 @@ func (p *planner) Insert(n *parser.Insert) (planNode, error) {
 			rowVals = append(rowVals, d)
 		}
 
+		// Compute the values of the computed columns.
+		if computed != nil {
+			vals, err := computed.eval(p.evalCtx, colIDtoRowIndex, rowVals)
+			if err != nil {
+				return nil, err
+			}
+			rowVals = append(rowVals, vals...)
+		}
+
 		// Check to see if NULL is being inserted into any non-nullable column.
 		for _, col := range tableDesc.Columns {
 			if !col.Nullable {
@@ -144,15 +178,18 @@ func (p *planner) Insert(n *parser.Insert) (planNode, error) {
 			}
 		}
 
-		primaryIndexKey, _, err := encodeIndexKey(
-			primaryIndex.ColumnIDs, colIDtoRowIndex, rowVals, primaryIndexKeyPrefix)
+		primaryIndexKey, err := encodePrimaryIndexKey(tableDesc, colIDtoRowIndex, rowVals)
 		if err != nil {
 			return nil, err
 		}
 
 		// Write the secondary indexes.
+		indexes, err := predicates.filter(p.evalCtx, tableDesc.Indexes, colIDtoRowIndex, rowVals)
+		if err != nil {
+			return nil, err
+		}
 		secondaryIndexEntries, err := encodeSecondaryIndexes(
-			tableDesc.ID, tableDesc.Indexes, colIDtoRowIndex, rowVals)
+			tableDesc.ID, indexes, colIDtoRowIndex, rowVals)
 		if err != nil {
 			return nil, err
 		}
@@ -165,6 +202,26 @@ func (p *planner) Insert(n *parser.Insert) (planNode, error) {
 			b.CPut(secondaryIndexEntry.key, secondaryIndexEntry.value, nil)
 		}
 
+		if tableDesc.FormatVersion == FamilyFormatVersion {
+			// Write the column families. Family 0 is always written and marks the
+			// existence of the row.
+			familyEntries, err := encodeFamilies(
+				tableDesc.Families, primaryIndexKey, colIDtoRowIndex, rowVals)
+			if err != nil {
+				return nil, err
+			}
+			for _, familyEntry := range familyEntries {
+				if familyEntry.value == nil {
+					continue
+				}
+				if log.V(2) {
+					log.Infof("CPut %s -> %v", prettyKey(familyEntry.key, 0), familyEntry.value)
+				}
+				b.CPut(familyEntry.key, familyEntry.value, nil)
+			}
+			continue
+		}
+
 		// Write the row sentinel.
 		if log.V(2) {
 			log.Infof("CPut %s -> NULL", prettyKey(primaryIndexKey, 0))
@@ -213,7 +270,15 @@ func (p *planner) Insert(n *parser.Insert) (planNode, error) {
 func (p *planner) processColumns(tableDesc *TableDescriptor,
 	node parser.QualifiedNames) ([]ColumnDescriptor, error) {
 	if node == nil {
-		return tableDesc.Columns, nil
+		// Computed columns cannot be written, so they are not part of the
+		// implicit column list.
+		cols := make([]ColumnDescriptor, 0, len(tableDesc.Columns))
+		for _, col := range tableDesc.Columns {
+			if col.ComputedExpr == nil {
+				cols = append(cols, col)
+			}
+		}
+		return cols, nil
 	}
 
 	cols := make([]ColumnDescriptor, len(node))
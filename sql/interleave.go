@@ -0,0 +1,210 @@
+// Copyright 2015 The Cockroach Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+// implied. See the License for the specific language governing
+// permissions and limitations under the License. See the AUTHORS file
+// for names of contributors.
+
+package sql
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/cockroachdb/cockroach/sql/parser"
+	"github.com/cockroachdb/cockroach/sql/privilege"
+	"github.com/cockroachdb/cockroach/util"
+)
+
+// interleaveTable interleaves the primary index of a new table in the primary
+// index of the parent table named by the INTERLEAVE IN PARENT clause. The
+// interleaved columns must be the leading columns of the primary key of the
+// table and match the columns of the primary key of the parent. The parent
+// descriptor is returned so that the table can be recorded in it once the
+// table has been assigned an ID.
+func (p *planner) interleaveTable(
+	desc *TableDescriptor, def *parser.InterleaveDef,
+) (*TableDescriptor, error) {
+	parentDesc, err := p.getTableDesc(def.Parent)
+	if err != nil {
+		return nil, err
+	}
+	if err := p.checkPrivilege(parentDesc, privilege.CREATE); err != nil {
+		return nil, err
+	}
+
+	index := &desc.PrimaryIndex
+	parentIndex := &parentDesc.PrimaryIndex
+	if len(def.Fields) != len(parentIndex.ColumnIDs) {
+		return nil, fmt.Errorf("declared interleaved columns (%s) must match the parent's primary index (%s)",
+			def.Fields, strings.Join(parentIndex.ColumnNames, ", "))
+	}
+	if len(def.Fields) > len(index.ColumnIDs) {
+		return nil, fmt.Errorf("declared interleaved columns (%s) must be a prefix of the primary key columns (%s)",
+			def.Fields, strings.Join(index.ColumnNames, ", "))
+	}
+	for i, name := range def.Fields {
+		if !equalName(string(name), index.ColumnNames[i]) {
+			return nil, fmt.Errorf("declared interleaved columns (%s) must be a prefix of the primary key columns (%s)",
+				def.Fields, strings.Join(index.ColumnNames, ", "))
+		}
+		col, err := desc.FindColumnByID(index.ColumnIDs[i])
+		if err != nil {
+			return nil, err
+		}
+		parentCol, err := parentDesc.FindColumnByID(parentIndex.ColumnIDs[i])
+		if err != nil {
+			return nil, err
+		}
+		if col.Type.Kind != parentCol.Type.Kind {
+			return nil, fmt.Errorf("interleaved column %q of type %s does not match column %q of type %s",
+				col.Name, col.Type.SQLString(), parentCol.Name, parentCol.Type.SQLString())
+		}
+		if encodingDirection(index.ColumnDirections, i) != encodingDirection(parentIndex.ColumnDirections, i) {
+			return nil, fmt.Errorf("interleaved column %q must have the same direction as column %q",
+				col.Name, parentCol.Name)
+		}
+	}
+
+	// The parent's own ancestors share a prefix of its primary key columns.
+	sharedPrefixLen := len(parentIndex.ColumnIDs)
+	for _, ancestor := range parentIndex.Interleave {
+		sharedPrefixLen -= int(ancestor.SharedPrefixLen)
+	}
+	index.Interleave = append(append([]InterleaveAncestor(nil), parentIndex.Interleave...),
+		InterleaveAncestor{
+			TableID:         parentDesc.ID,
+			IndexID:         parentIndex.ID,
+			SharedPrefixLen: uint32(sharedPrefixLen),
+		})
+	return parentDesc, nil
+}
+
+// getTableDescByID looks up the descriptor of the table with the specified
+// ID.
+func (p *planner) getTableDescByID(id ID) (*TableDescriptor, error) {
+	desc := &Descriptor{}
+	if err := p.txn.GetProto(MakeDescMetadataKey(id), desc); err != nil {
+		return nil, err
+	}
+	tableDesc := desc.GetTable()
+	if tableDesc == nil {
+		return nil, util.Errorf("descriptor %d is not a table", id)
+	}
+	return tableDesc, nil
+}
+
+// interleaveDef returns the INTERLEAVE IN PARENT clause which recreates the
+// interleaving of the table, or nil if the table is not interleaved.
+func (p *planner) interleaveDef(desc *TableDescriptor) (*parser.InterleaveDef, error) {
+	index := &desc.PrimaryIndex
+	if len(index.Interleave) == 0 {
+		return nil, nil
+	}
+	parentDesc, err := p.getTableDescByID(index.Interleave[len(index.Interleave)-1].TableID)
+	if err != nil {
+		return nil, err
+	}
+	parent := &parser.QualifiedName{Base: parser.Name(parentDesc.Name)}
+	if parentDesc.ParentID != desc.ParentID {
+		d := &Descriptor{}
+		if err := p.txn.GetProto(MakeDescMetadataKey(parentDesc.ParentID), d); err != nil {
+			return nil, err
+		}
+		dbDesc := d.GetDatabase()
+		if dbDesc == nil {
+			return nil, util.Errorf("descriptor %d is not a database", parentDesc.ParentID)
+		}
+		parent = &parser.QualifiedName{
+			Base:     parser.Name(dbDesc.Name),
+			Indirect: parser.Indirection{parser.NameIndirection(parentDesc.Name)},
+		}
+	}
+	sharedPrefixLen := 0
+	for _, ancestor := range index.Interleave {
+		sharedPrefixLen += int(ancestor.SharedPrefixLen)
+	}
+	return &parser.InterleaveDef{
+		Parent: parent,
+		Fields: parser.NameList(index.ColumnNames[:sharedPrefixLen]),
+	}, nil
+}
+
+// errInterleavedBy returns the error for a schema change which requires that
+// the table has no interleaved tables.
+func (p *planner) errInterleavedBy(tableDesc *TableDescriptor) error {
+	childDesc, err := p.getTableDescByID(tableDesc.PrimaryIndex.InterleavedBy[0])
+	if err != nil {
+		return err
+	}
+	return fmt.Errorf("table %q is interleaved by table %q", tableDesc.Name, childDesc.Name)
+}
+
+// deleteInterleavedRows deletes the rows of an interleaved table. The rows are
+// stored in the key span of its outermost ancestor, which is not cleared when
+// the table is dropped.
+func (p *planner) deleteInterleavedRows(tableName *parser.QualifiedName) error {
+	// Run `DELETE FROM <table>` to delete the rows and their index entries.
+	_, err := p.Delete(&parser.Delete{
+		Table: &parser.AliasedTableExpr{Expr: tableName},
+	})
+	return err
+}
+
+// dropInterleavedTable drops the table from the interleaved hierarchy it is
+// part of. The tables interleaved in the table must be dropped along with it,
+// which is the case for the tables whose IDs are in dropped. The rows of an
+// interleaved table are deleted unless its outermost ancestor is dropped, and
+// the table is removed from the descriptor of its parent. The modified parent
+// descriptors are accumulated in parents, so that sibling tables dropped
+// together update the same descriptor, and are written by the caller.
+func (p *planner) dropInterleavedTable(
+	tableName *parser.QualifiedName, tableDesc *TableDescriptor,
+	dropped map[ID]struct{}, parents map[ID]*TableDescriptor,
+) error {
+	index := &tableDesc.PrimaryIndex
+	for _, id := range index.InterleavedBy {
+		if _, ok := dropped[id]; !ok {
+			return p.errInterleavedBy(tableDesc)
+		}
+	}
+	if len(index.Interleave) == 0 {
+		return nil
+	}
+
+	if _, ok := dropped[index.Interleave[0].TableID]; !ok {
+		if err := p.deleteInterleavedRows(tableName); err != nil {
+			return err
+		}
+	}
+
+	parentID := index.Interleave[len(index.Interleave)-1].TableID
+	if _, ok := dropped[parentID]; ok {
+		return nil
+	}
+	parentDesc, ok := parents[parentID]
+	if !ok {
+		var err error
+		if parentDesc, err = p.getTableDescByID(parentID); err != nil {
+			return err
+		}
+		parents[parentID] = parentDesc
+	}
+	parentIndex := &parentDesc.PrimaryIndex
+	for i, id := range parentIndex.InterleavedBy {
+		if id == tableDesc.ID {
+			parentIndex.InterleavedBy = append(parentIndex.InterleavedBy[:i],
+				parentIndex.InterleavedBy[i+1:]...)
+			break
+		}
+	}
+	return nil
+}
@@ -31,11 +31,10 @@ const joinBatchSize = 100
 // joinBatchSize rows from the index and use the primary key to construct spans
 // that are looked up in the table.
 type indexJoinNode struct {
-	index            *scanNode
-	table            *scanNode
-	primaryKeyPrefix roachpb.Key
-	colIDtoRowIndex  map[ColumnID]int
-	err              error
+	index           *scanNode
+	table           *scanNode
+	colIDtoRowIndex map[ColumnID]int
+	err             error
 }
 
 func makeIndexJoin(indexScan *scanNode, exactPrefix int) (*indexJoinNode, error) {
@@ -56,6 +55,9 @@ func makeIndexJoin(indexScan *scanNode, exactPrefix int) (*indexJoinNode, error)
 	// be to remove any expression that refers to a column that is not part of
 	// the index.
 	indexScan.filter = nil
+	// The rows are locked by the table scan, which reads the key-value pairs
+	// of the primary index.
+	indexScan.lockRows = false
 
 	// We want to the index scan to keep the same render target indexes for
 	// columns which are part of the primary key or part of the index. This
@@ -98,13 +100,10 @@ func makeIndexJoin(indexScan *scanNode, exactPrefix int) (*indexJoinNode, error)
 
 	indexScan.initOrdering(exactPrefix)
 
-	primaryKeyPrefix := roachpb.Key(MakeIndexKeyPrefix(table.desc.ID, table.index.ID))
-
 	return &indexJoinNode{
-		index:            indexScan,
-		table:            table,
-		primaryKeyPrefix: primaryKeyPrefix,
-		colIDtoRowIndex:  colIDtoRowIndex,
+		index:           indexScan,
+		table:           table,
+		colIDtoRowIndex: colIDtoRowIndex,
 	}, nil
 }
 
@@ -155,8 +154,7 @@ func (n *indexJoinNode) Next() bool {
 
 			vals := n.index.Values()
 			var primaryIndexKey []byte
-			primaryIndexKey, _, n.err = encodeIndexKey(
-				n.table.index.ColumnIDs, n.colIDtoRowIndex, vals, n.primaryKeyPrefix)
+			primaryIndexKey, n.err = encodePrimaryIndexKey(n.table.desc, n.colIDtoRowIndex, vals)
 			if n.err != nil {
 				return false
 			}
@@ -168,7 +166,7 @@ func (n *indexJoinNode) Next() bool {
 		}
 
 		if log.V(3) {
-			log.Infof("table scan: %s", prettySpans(n.table.spans, 0))
+			log.Infof("table scan: %s", prettySpans(n.table.spans, n.table.index.ColumnDirections, 0))
 		}
 	}
 	return false
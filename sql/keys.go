@@ -106,6 +106,13 @@ func MakeColumnKey(colID ColumnID, primaryKey []byte) roachpb.Key {
 	return encoding.EncodeUvarint(key, uint64(colID))
 }
 
+// MakeFamilyKey returns the key for the column family in the given row.
+func MakeFamilyKey(familyID FamilyID, primaryKey []byte) roachpb.Key {
+	var key []byte
+	key = append(key, primaryKey...)
+	return encoding.EncodeUvarint(key, uint64(familyID))
+}
+
 // MakeIndexKeyPrefix returns the key prefix used for the index's data.
 func MakeIndexKeyPrefix(tableID ID, indexID IndexID) []byte {
 	var key []byte
@@ -114,3 +121,28 @@ func MakeIndexKeyPrefix(tableID ID, indexID IndexID) []byte {
 	key = encoding.EncodeUvarint(key, uint64(indexID))
 	return key
 }
+
+// indexKeyPrefix returns the prefix of the keys of the index's data. The rows
+// of an interleaved index are stored in the key span of its outermost
+// ancestor and share its prefix.
+func indexKeyPrefix(tableID ID, index *IndexDescriptor) []byte {
+	if len(index.Interleave) > 0 {
+		return MakeIndexKeyPrefix(index.Interleave[0].TableID, index.Interleave[0].IndexID)
+	}
+	return MakeIndexKeyPrefix(tableID, index.ID)
+}
+
+// encodeInterleavedSentinel appends the marker separating the key of a row
+// from the remainder of the key of a row interleaved in it. The marker is the
+// not-NULL marker, which sorts before the encoded column and column family
+// IDs following the key of a row and never starts an encoded column value.
+func encodeInterleavedSentinel(key []byte) []byte {
+	return encoding.EncodeNotNull(key)
+}
+
+// decodeInterleavedSentinel removes the marker appended by
+// encodeInterleavedSentinel from the start of the key. The second result is
+// false if the key does not start with the marker.
+func decodeInterleavedSentinel(key []byte) ([]byte, bool) {
+	return encoding.DecodeIfNotNull(key)
+}
@@ -46,6 +46,8 @@ const (
 
 var (
 	errLeaseVersionChanged = errors.New("lease version changed")
+	errTableDropped        = errors.New("table is being dropped")
+	errTableOffline        = errors.New("table is being imported or restored")
 )
 
 // LeaseState holds the state for a lease. Exported only for testing.
@@ -115,6 +117,12 @@ func (s LeaseStore) Acquire(txn *client.Txn, tableID ID, minVersion uint32) (*Le
 	if err := lease.Validate(); err != nil {
 		return nil, err
 	}
+	if lease.Dropped() {
+		return nil, errTableDropped
+	}
+	if lease.Offline() {
+		return nil, errTableOffline
+	}
 	if lease.Version < minVersion {
 		return nil, util.Errorf("version %d of table %d does not exist yet", minVersion, tableID)
 	}
@@ -367,6 +375,9 @@ type tableState struct {
 	// nil if there is no lease acquisition in progress for the table. If
 	// non-nil, the channel will be closed when lease acquisition completes.
 	acquiring chan struct{}
+	// Set once the table is known to be dropped. No new leases are handed
+	// out and the active leases are released as soon as they are unused.
+	dropped bool
 }
 
 func (t *tableState) acquire(txn *client.Txn, version uint32, store LeaseStore) (*LeaseState, error) {
@@ -374,6 +385,9 @@ func (t *tableState) acquire(txn *client.Txn, version uint32, store LeaseStore)
 	defer t.mu.Unlock()
 
 	for {
+		if t.dropped {
+			return nil, errTableDropped
+		}
 		s := t.active.findNewest(version)
 		if s != nil {
 			if version != 0 && s != t.active.findNewest(0) {
@@ -415,6 +429,10 @@ func (t *tableState) acquire(txn *client.Txn, version uint32, store LeaseStore)
 			s, err := t.acquireNodeLease(txn, version, store)
 			close(t.acquiring)
 			t.acquiring = nil
+			if err == errTableDropped {
+				t.dropped = true
+				t.releaseUnusedLeases(store)
+			}
 			if err != nil {
 				return nil, err
 			}
@@ -455,7 +473,7 @@ func (t *tableState) release(lease *LeaseState, store LeaseStore) error {
 	}
 	if s.refcount == 0 {
 		n := t.active.findNewest(0)
-		if s != n {
+		if s != n || t.dropped {
 			if s.Version < n.Version {
 				// TODO(pmattis): If an active transaction is releasing the lease for
 				// an older version, hold on to it for a few seconds in anticipation of
@@ -470,6 +488,18 @@ func (t *tableState) release(lease *LeaseState, store LeaseStore) error {
 	return nil
 }
 
+// releaseUnusedLeases releases all the active leases which are not in use.
+func (t *tableState) releaseUnusedLeases(store LeaseStore) {
+	for _, s := range append([]*LeaseState(nil), t.active.data...) {
+		if s.refcount == 0 {
+			t.active.remove(s)
+			if err := t.releaseNodeLease(s, store); err != nil {
+				log.Warning(err)
+			}
+		}
+	}
+}
+
 func (t *tableState) releaseNodeLease(lease *LeaseState, store LeaseStore) error {
 	// We're called with mu locked, but need to unlock it while releasing the
 	// lease.
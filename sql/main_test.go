@@ -94,7 +94,7 @@ func setup(t *testing.T) (*server.TestServer, *sql.DB, *client.DB) {
 }
 
 func setupWithContext(t *testing.T, ctx *server.Context) (*server.TestServer, *sql.DB, *client.DB) {
-	s := setupTestServer(t)
+	s := setupTestServerWithContext(t, ctx)
 	// SQL requests use "root" which has ALL permissions on everything.
 	sqlDB, err := sql.Open("cockroach", fmt.Sprintf("https://%s@%s?certs=test_certs",
 		security.RootUser, s.ServingAddr()))
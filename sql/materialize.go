@@ -0,0 +1,176 @@
+// Copyright 2015 The Cockroach Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+// implied. See the License for the specific language governing
+// permissions and limitations under the License. See the AUTHORS file
+// for names of contributors.
+
+package sql
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/cockroachdb/cockroach/sql/parser"
+)
+
+// rowNumColumnName is the name of the hidden column numbering the rows of a
+// materialized table. It is the primary key of the table.
+const rowNumColumnName = "rownum"
+
+// materializedTable is a virtual table holding rows computed while planning a
+// statement, such as the rows of a common table expression or of a
+// set-returning function used in the FROM clause. For example, the query of a
+// CTE is run once, when the statement containing the WITH clause is planned,
+// and every reference to the CTE scans the resulting rows.
+type materializedTable struct {
+	desc TableDescriptor
+	rows []parser.DTuple
+}
+
+// makeMaterializedTable builds a virtual table holding the given rows. The
+// type of each column is that of its first non-NULL value; columns containing
+// only NULLs are typed as STRING.
+func makeMaterializedTable(name string, columns []string, rows []parser.DTuple) *materializedTable {
+	m := &materializedTable{
+		desc: TableDescriptor{
+			Name:     name,
+			ParentID: virtualDatabaseID,
+		},
+	}
+	for i, name := range columns {
+		typ := ColumnType{Kind: ColumnType_STRING}
+		for _, row := range rows {
+			if t, ok := datumColumnType(row[i]); ok {
+				typ = t
+				break
+			}
+		}
+		m.desc.Columns = append(m.desc.Columns, ColumnDescriptor{
+			Name:     name,
+			ID:       ColumnID(i + 1),
+			Type:     typ,
+			Nullable: true,
+		})
+	}
+	rowID := ColumnID(len(columns) + 1)
+	m.desc.Columns = append(m.desc.Columns, ColumnDescriptor{
+		Name: rowNumColumnName,
+		ID:   rowID,
+		Type: ColumnType{Kind: ColumnType_INT},
+	})
+	m.desc.PrimaryIndex = IndexDescriptor{
+		Name:        PrimaryKeyIndexName,
+		ID:          1,
+		Unique:      true,
+		ColumnNames: []string{rowNumColumnName},
+		ColumnIDs:   []ColumnID{rowID},
+	}
+	m.rows = numberRows(rows, 0)
+	return m
+}
+
+// numberRows appends the hidden row number column to the rows, numbering
+// them starting after offset.
+func numberRows(rows []parser.DTuple, offset int) []parser.DTuple {
+	for i := range rows {
+		rows[i] = append(rows[i], parser.DInt(offset+i+1))
+	}
+	return rows
+}
+
+// datumColumnType returns the column type able to hold the datum. The
+// boolean is false for NULL and for arrays whose element type is unknown.
+func datumColumnType(d parser.Datum) (ColumnType, bool) {
+	switch t := d.(type) {
+	case parser.DBool:
+		return ColumnType{Kind: ColumnType_BOOL}, true
+	case parser.DInt:
+		return ColumnType{Kind: ColumnType_INT}, true
+	case parser.DFloat:
+		return ColumnType{Kind: ColumnType_FLOAT}, true
+	case parser.DString:
+		return ColumnType{Kind: ColumnType_STRING}, true
+	case parser.DBytes:
+		return ColumnType{Kind: ColumnType_BYTES}, true
+	case parser.DDate:
+		return ColumnType{Kind: ColumnType_DATE}, true
+	case parser.DTimestamp:
+		return ColumnType{Kind: ColumnType_TIMESTAMP}, true
+	case parser.DInterval:
+		return ColumnType{Kind: ColumnType_INTERVAL}, true
+	case parser.DJSON:
+		return ColumnType{Kind: ColumnType_JSON}, true
+	case parser.DUUID:
+		return ColumnType{Kind: ColumnType_UUID}, true
+	case parser.DArray:
+		if elem, ok := datumColumnType(t.ElemType); ok {
+			return ColumnType{Kind: ColumnType_ARRAY, ArrayContents: &elem.Kind}, true
+		}
+	}
+	return ColumnType{Kind: ColumnType_STRING}, false
+}
+
+// initMaterialized initializes the scan of a materialized table.
+func (n *scanNode) initMaterialized(ate *parser.AliasedTableExpr, m *materializedTable) {
+	desc := m.desc
+	if ate.As != "" {
+		desc.Alias = string(ate.As)
+	} else {
+		desc.Alias = desc.Name
+	}
+	n.desc = &desc
+	n.materialized = m
+	n.index = &n.desc.PrimaryIndex
+	// The row number column is hidden.
+	n.visibleCols = n.desc.Columns[:len(n.desc.Columns)-1]
+}
+
+// maxGeneratedRows is the maximum number of rows produced by a function used
+// as a table in the FROM clause.
+const maxGeneratedRows = 1 << 20
+
+// initGenerator initializes the scan of the rows produced by a function used
+// as a table in the FROM clause, such as generate_series. The function is
+// evaluated once, when the statement is planned, and its rows are charged to
+// the memory monitor of the session. Only the first row, which determines the
+// type of the column, is produced when planning the statement of an EXPLAIN.
+// The single column of the table is named after the function unless the
+// table is aliased.
+func (n *scanNode) initGenerator(ate *parser.AliasedTableExpr, fn *parser.FuncExpr) error {
+	limit := maxGeneratedRows + 1
+	if n.planner.planOnly {
+		limit = 1
+	}
+	var values parser.DTuple
+	if values, n.err = fn.EvalGenerator(n.planner.evalCtx, limit); n.err != nil {
+		return n.err
+	}
+	if len(values) > maxGeneratedRows {
+		n.err = fmt.Errorf("%s: more than %d rows", fn.Name, maxGeneratedRows)
+		return n.err
+	}
+	name := strings.ToLower(string(fn.Name.Base))
+	column := name
+	if ate.As != "" {
+		column = string(ate.As)
+	}
+	acc := n.planner.newMemoryAccount()
+	rows := make([]parser.DTuple, len(values))
+	for i, v := range values {
+		rows[i] = parser.DTuple{v}
+		if n.err = acc.grow(rowSize(rows[i])); n.err != nil {
+			return n.err
+		}
+	}
+	n.initMaterialized(ate, makeMaterializedTable(name, []string{column}, rows))
+	return nil
+}
@@ -0,0 +1,333 @@
+// Copyright 2015 The Cockroach Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+// implied. See the License for the specific language governing
+// permissions and limitations under the License. See the AUTHORS file
+// for names of contributors.
+
+package sql
+
+import (
+	"fmt"
+	"sync"
+	"time"
+	"unsafe"
+
+	"github.com/cockroachdb/cockroach/sql/parser"
+	"github.com/cockroachdb/cockroach/util/hlc"
+)
+
+// memoryMonitor tracks the memory used by the SQL operators which buffer rows
+// (sortNode, groupNode, distinctNode and the subquery results) against a
+// budget. The monitor of a session, which lasts across the requests of the
+// session, reserves the memory it hands out from the monitor of the node, so
+// that a query fails cleanly instead of running the node out of memory.
+type memoryMonitor struct {
+	name   string
+	parent *memoryMonitor
+
+	mu struct {
+		sync.Mutex
+		// The budget in bytes. Zero means unlimited.
+		budget int64
+		// The bytes currently reserved, and the most ever reserved at once.
+		used    int64
+		maxUsed int64
+	}
+}
+
+func newMemoryMonitor(name string, budget int64, parent *memoryMonitor) *memoryMonitor {
+	m := &memoryMonitor{name: name, parent: parent}
+	m.mu.budget = budget
+	return m
+}
+
+// setBudget changes the budget of the monitor. Reservations already made
+// are not affected.
+func (m *memoryMonitor) setBudget(budget int64) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.mu.budget = budget
+}
+
+// reserve reserves n bytes of the budget, returning a
+// *memoryBudgetExceededError if this or an ancestor monitor does not have
+// enough budget left.
+func (m *memoryMonitor) reserve(n int64) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if m.mu.budget > 0 && m.mu.used+n > m.mu.budget {
+		return &memoryBudgetExceededError{
+			monitor:   m.name,
+			requested: n,
+			used:      m.mu.used,
+			budget:    m.mu.budget,
+		}
+	}
+	if m.parent != nil {
+		if err := m.parent.reserve(n); err != nil {
+			return err
+		}
+	}
+	m.mu.used += n
+	if m.mu.used > m.mu.maxUsed {
+		m.mu.maxUsed = m.mu.used
+	}
+	return nil
+}
+
+// release returns n bytes to the budget.
+func (m *memoryMonitor) release(n int64) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if n > m.mu.used {
+		panic(fmt.Sprintf("%s memory monitor: releasing %d bytes, only %d in use",
+			m.name, n, m.mu.used))
+	}
+	m.mu.used -= n
+	if m.parent != nil {
+		m.parent.release(n)
+	}
+}
+
+// currentUsage returns the number of bytes currently reserved.
+func (m *memoryMonitor) currentUsage() int64 {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	return m.mu.used
+}
+
+// maxUsage returns the most bytes ever reserved at once.
+func (m *memoryMonitor) maxUsage() int64 {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	return m.mu.maxUsed
+}
+
+// memoryBudgetExceededError is returned when a memory reservation would take
+// a monitor over its budget.
+type memoryBudgetExceededError struct {
+	monitor   string
+	requested int64
+	used      int64
+	budget    int64
+}
+
+func (e *memoryBudgetExceededError) Error() string {
+	return fmt.Sprintf("%s memory budget exceeded: %d bytes requested, %d bytes in use, %d bytes budget",
+		e.monitor, e.requested, e.used, e.budget)
+}
+
+// sessionMonitorIdleTimeout is the time after which the monitor of a session
+// which hasn't executed any request is dropped.
+const sessionMonitorIdleTimeout = 10 * time.Minute
+
+// sessionMonitors holds the memory monitors of the sessions of the node. The
+// state of a session is carried by the requests of its client, and the
+// session ends without notice, so the monitor of a session is found with the
+// ID stored in its state and is dropped once the session has been idle for
+// sessionMonitorIdleTimeout.
+type sessionMonitors struct {
+	node  *memoryMonitor
+	clock *hlc.Clock
+
+	mu struct {
+		sync.Mutex
+		// The budget of the monitor of each session.
+		budget   int64
+		sessions map[string]*sessionMonitor
+		// The time at which the idle monitors were last dropped.
+		lastSweep int64
+	}
+}
+
+type sessionMonitor struct {
+	mon *memoryMonitor
+	// The number of requests of the session being executed, and the time at
+	// which the last one finished.
+	active   int
+	lastUsed int64
+}
+
+func newSessionMonitors(node *memoryMonitor, clock *hlc.Clock) *sessionMonitors {
+	s := &sessionMonitors{node: node, clock: clock}
+	s.mu.sessions = map[string]*sessionMonitor{}
+	return s
+}
+
+// setBudget changes the budget of the monitor of each session.
+func (s *sessionMonitors) setBudget(budget int64) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.mu.budget = budget
+	for _, sm := range s.mu.sessions {
+		sm.mon.setBudget(budget)
+	}
+}
+
+// acquire returns the monitor of the session with the given ID, creating it
+// if the session has none, for the execution of a request of the session.
+// release must be called once the request has been executed.
+func (s *sessionMonitors) acquire(id []byte) *memoryMonitor {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	now := s.clock.PhysicalNow()
+	if now-s.mu.lastSweep > sessionMonitorIdleTimeout.Nanoseconds() {
+		for k, sm := range s.mu.sessions {
+			if sm.active == 0 && now-sm.lastUsed > sessionMonitorIdleTimeout.Nanoseconds() {
+				delete(s.mu.sessions, k)
+			}
+		}
+		s.mu.lastSweep = now
+	}
+	sm, ok := s.mu.sessions[string(id)]
+	if !ok {
+		sm = &sessionMonitor{mon: newMemoryMonitor("session", s.mu.budget, s.node)}
+		s.mu.sessions[string(id)] = sm
+	}
+	sm.active++
+	return sm.mon
+}
+
+// release marks the end of the execution of a request of the session with
+// the given ID.
+func (s *sessionMonitors) release(id []byte) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	sm := s.mu.sessions[string(id)]
+	sm.active--
+	sm.lastUsed = s.clock.PhysicalNow()
+}
+
+// memoryAccount tracks the memory reserved from a monitor by a single
+// operator. An account with a nil monitor does no accounting. The operators
+// which can spill rows to disk when they exceed the budget do so to the
+// temporary engine of their account.
+type memoryAccount struct {
+	mon   *memoryMonitor
+	used  int64
+	spill *tempEngine
+	// Called before the temporary engine is closed by the operator reading
+	// from it, which releases its iterators over the engine.
+	releaseSpill func()
+}
+
+// grow reserves n more bytes for the account.
+func (a *memoryAccount) grow(n int64) error {
+	if a.mon == nil {
+		return nil
+	}
+	if err := a.mon.reserve(n); err != nil {
+		return err
+	}
+	a.used += n
+	return nil
+}
+
+// clear releases all of the memory reserved by the account. The account can
+// be used again afterwards.
+func (a *memoryAccount) clear() {
+	if a.mon == nil || a.used == 0 {
+		return
+	}
+	a.mon.release(a.used)
+	a.used = 0
+}
+
+// spillEngine returns the temporary engine of the account, creating it on
+// first use.
+func (a *memoryAccount) spillEngine() (*tempEngine, error) {
+	if a.spill == nil {
+		var err error
+		if a.spill, err = newTempEngine(); err != nil {
+			return nil, err
+		}
+	}
+	return a.spill, nil
+}
+
+// close clears the account and removes its temporary engine, if any.
+func (a *memoryAccount) close() {
+	a.clear()
+	if a.releaseSpill != nil {
+		a.releaseSpill()
+		a.releaseSpill = nil
+	}
+	if a.spill != nil {
+		a.spill.close()
+		a.spill = nil
+	}
+}
+
+// newMemoryAccount returns an account which reserves memory from the monitor
+// of the session. The account is cleared once the statement has been
+// executed, which also removes the rows the operator spilled to disk.
+func (p *planner) newMemoryAccount() *memoryAccount {
+	acc := &memoryAccount{mon: p.mon}
+	p.memAccounts = append(p.memAccounts, acc)
+	return acc
+}
+
+// releaseMemory closes the memory accounts of the statement being executed.
+func (p *planner) releaseMemory() {
+	p.releaseMemorySince(0)
+}
+
+// releaseMemorySince closes the memory accounts created after the first n
+// accounts of the statement being executed.
+func (p *planner) releaseMemorySince(n int) {
+	for _, acc := range p.memAccounts[n:] {
+		acc.close()
+	}
+	p.memAccounts = p.memAccounts[:n]
+}
+
+const (
+	sizeOfDatum  = int64(unsafe.Sizeof(parser.Datum(nil)))
+	sizeOfDTuple = int64(unsafe.Sizeof(parser.DTuple(nil)))
+	// The string header of a map key plus an estimate of the bucket overhead.
+	sizeOfMapEntry = int64(unsafe.Sizeof("")) + 16
+)
+
+// datumSize returns an estimate of the memory used by a datum, including the
+// interface header referring to it.
+func datumSize(d parser.Datum) int64 {
+	switch t := d.(type) {
+	case parser.DString:
+		return sizeOfDatum + int64(unsafe.Sizeof(t)) + int64(len(t))
+	case parser.DBytes:
+		return sizeOfDatum + int64(unsafe.Sizeof(t)) + int64(len(t))
+	case parser.DJSON:
+		return sizeOfDatum + int64(unsafe.Sizeof(t)) + int64(len(t))
+	case parser.DTuple:
+		return sizeOfDatum + rowSize(t)
+	case parser.DArray:
+		return sizeOfDatum + datumSize(t.ElemType) + rowSize(t.Elems)
+	case parser.DTimestamp:
+		return sizeOfDatum + int64(unsafe.Sizeof(t))
+	case parser.DInterval:
+		return sizeOfDatum + int64(unsafe.Sizeof(t))
+	case parser.DUUID:
+		return sizeOfDatum + int64(unsafe.Sizeof(t))
+	default:
+		// The remaining datums are at most 8 bytes.
+		return sizeOfDatum + 8
+	}
+}
+
+// rowSize returns an estimate of the memory used by a row of datums.
+func rowSize(row parser.DTuple) int64 {
+	n := sizeOfDTuple
+	for _, d := range row {
+		n += datumSize(d)
+	}
+	return n
+}
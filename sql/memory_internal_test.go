@@ -0,0 +1,181 @@
+// Copyright 2015 The Cockroach Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+// implied. See the License for the specific language governing
+// permissions and limitations under the License. See the AUTHORS file
+// for names of contributors.
+
+package sql
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/cockroachdb/cockroach/roachpb"
+	"github.com/cockroachdb/cockroach/util/hlc"
+	"github.com/cockroachdb/cockroach/util/leaktest"
+)
+
+func TestMemoryMonitor(t *testing.T) {
+	defer leaktest.AfterTest(t)
+
+	node := newMemoryMonitor("node", 100, nil)
+	s1 := newMemoryMonitor("session", 60, node)
+	s2 := newMemoryMonitor("session", 60, node)
+
+	a1 := &memoryAccount{mon: s1}
+	if err := a1.grow(50); err != nil {
+		t.Fatal(err)
+	}
+	if err := a1.grow(20); err == nil {
+		t.Fatal("expected the session budget to be exceeded")
+	} else if e := "session memory budget exceeded: 20 bytes requested, 50 bytes in use, 60 bytes budget"; err.Error() != e {
+		t.Fatalf("expected %q, but found %q", e, err)
+	}
+
+	a2 := &memoryAccount{mon: s2}
+	if err := a2.grow(40); err != nil {
+		t.Fatal(err)
+	}
+	if err := a2.grow(20); err == nil {
+		t.Fatal("expected the node budget to be exceeded")
+	} else if e := "node memory budget exceeded: 20 bytes requested, 90 bytes in use, 100 bytes budget"; err.Error() != e {
+		t.Fatalf("expected %q, but found %q", e, err)
+	}
+	// A failed reservation reserves nothing.
+	if u := s2.currentUsage(); u != 40 {
+		t.Fatalf("expected 40 bytes in use by the session, but found %d", u)
+	}
+
+	a1.clear()
+	if err := a2.grow(20); err != nil {
+		t.Fatal(err)
+	}
+	if u := node.currentUsage(); u != 60 {
+		t.Fatalf("expected 60 bytes in use by the node, but found %d", u)
+	}
+	a2.clear()
+	if u := node.currentUsage(); u != 0 {
+		t.Fatalf("expected no bytes in use by the node, but found %d", u)
+	}
+	if u := node.maxUsage(); u != 90 {
+		t.Fatalf("expected at most 90 bytes in use by the node, but found %d", u)
+	}
+
+	// Accounts without a monitor do no accounting.
+	var a3 memoryAccount
+	if err := a3.grow(1 << 40); err != nil {
+		t.Fatal(err)
+	}
+	a3.clear()
+}
+
+// TestSessionMonitors verifies that the requests of a session share its
+// monitor, and that the monitor is dropped once the session is idle.
+func TestSessionMonitors(t *testing.T) {
+	defer leaktest.AfterTest(t)
+
+	manual := hlc.NewManualClock(1)
+	clock := hlc.NewClock(manual.UnixNano)
+	node := newMemoryMonitor("node", 0, nil)
+	mons := newSessionMonitors(node, clock)
+	mons.setBudget(60)
+
+	id1, id2 := []byte("session1"), []byte("session2")
+	m1 := mons.acquire(id1)
+	a1 := &memoryAccount{mon: m1}
+	if err := a1.grow(50); err != nil {
+		t.Fatal(err)
+	}
+	// A concurrent request of the same session shares its budget.
+	if m := mons.acquire(id1); m != m1 {
+		t.Fatal("expected the requests of a session to share its monitor")
+	}
+	if err := (&memoryAccount{mon: m1}).grow(20); !isMemoryBudgetExceeded(err) {
+		t.Fatalf("expected the session budget to be exceeded, but found %v", err)
+	}
+	mons.release(id1)
+	// Other sessions have their own budget.
+	m2 := mons.acquire(id2)
+	if err := (&memoryAccount{mon: m2}).grow(20); err != nil {
+		t.Fatal(err)
+	}
+	m2.release(20)
+	mons.release(id2)
+	a1.clear()
+	mons.release(id1)
+
+	// The monitor lasts across the requests of the session, until the session
+	// has been idle for sessionMonitorIdleTimeout.
+	if m := mons.acquire(id1); m != m1 {
+		t.Fatal("expected the session monitor to last across requests")
+	}
+	mons.release(id1)
+	manual.Increment(sessionMonitorIdleTimeout.Nanoseconds() + 1)
+	if m := mons.acquire(id1); m == m1 {
+		t.Fatal("expected the monitor of the idle session to be dropped")
+	}
+	mons.release(id1)
+	if l := len(mons.mu.sessions); l != 1 {
+		t.Fatalf("expected 1 session monitor, but found %d", l)
+	}
+}
+
+func TestSpillableSet(t *testing.T) {
+	defer leaktest.AfterTest(t)
+
+	mon := newMemoryMonitor("request", 4*sizeOfMapEntry, nil)
+	s := newSpillableSet(&memoryAccount{mon: mon})
+	defer s.close()
+
+	for i := 0; i < 10; i++ {
+		if err := s.add([]byte(fmt.Sprintf("%d", i))); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if !s.spilled {
+		t.Fatal("expected the set to be spilled")
+	}
+	for i := 0; i < 10; i++ {
+		if ok, err := s.contains([]byte(fmt.Sprintf("%d", i))); err != nil {
+			t.Fatal(err)
+		} else if !ok {
+			t.Fatalf("expected %d to be in the set", i)
+		}
+	}
+
+	// Resetting the set clears the values of its generation from the engine.
+	if err := s.reset(); err != nil {
+		t.Fatal(err)
+	}
+	if ok, err := s.contains([]byte("0")); err != nil {
+		t.Fatal(err)
+	} else if ok {
+		t.Fatal("expected the set to be empty")
+	}
+	eng, err := s.acc.spillEngine()
+	if err != nil {
+		t.Fatal(err)
+	}
+	var keys int
+	if err := eng.Iterate(roachpb.EncodedKey(nil), roachpb.EncodedKey(roachpb.KeyMax), func(roachpb.RawKeyValue) (bool, error) {
+		keys++
+		return false, nil
+	}); err != nil {
+		t.Fatal(err)
+	}
+	if keys != 0 {
+		t.Fatalf("expected no spilled values, but found %d", keys)
+	}
+	if u := mon.currentUsage(); u != 0 {
+		t.Fatalf("expected no bytes in use, but found %d", u)
+	}
+}
@@ -0,0 +1,173 @@
+// Copyright 2015 The Cockroach Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+// implied. See the License for the specific language governing
+// permissions and limitations under the License. See the AUTHORS file
+// for names of contributors.
+
+package sql_test
+
+import (
+	"bytes"
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/cockroachdb/cockroach/server"
+	"github.com/cockroachdb/cockroach/testutils"
+	"github.com/cockroachdb/cockroach/util/leaktest"
+)
+
+func TestSessionMemoryBudget(t *testing.T) {
+	defer leaktest.AfterTest(t)
+	ctx := server.NewTestContext()
+	ctx.SQLSessionMemoryBudget = 64 << 10
+	s, sqlDB, _ := setupWithContext(t, ctx)
+	defer cleanup(s, sqlDB)
+
+	if _, err := sqlDB.Exec(`
+CREATE DATABASE t;
+CREATE TABLE t.kv (k INT PRIMARY KEY, v STRING);
+`); err != nil {
+		t.Fatal(err)
+	}
+
+	// Insert about 100KB of values, which is more than the budget.
+	const numRows = 1000
+	var buf bytes.Buffer
+	buf.WriteString(`INSERT INTO t.kv VALUES `)
+	for i := 0; i < numRows; i++ {
+		if i > 0 {
+			buf.WriteString(", ")
+		}
+		fmt.Fprintf(&buf, "(%d, '%03d%s')", i, numRows-i, strings.Repeat("x", 97))
+	}
+	if _, err := sqlDB.Exec(buf.String()); err != nil {
+		t.Fatal(err)
+	}
+
+	for _, query := range []string{
+		`SELECT array_agg(v) FROM t.kv`,
+		`SELECT count(*) FROM t.kv WHERE v IN (SELECT v FROM t.kv)`,
+		`WITH a AS (SELECT v FROM t.kv) SELECT count(*) FROM a`,
+	} {
+		if _, err := sqlDB.Exec(query); !testutils.IsError(err, "session memory budget exceeded") {
+			t.Errorf("%s: expected the session memory budget to be exceeded, but found %v", query, err)
+		}
+	}
+
+	// The memory used by the failed queries was released, and the queries
+	// which do not buffer all of the rows or spill them to disk succeed.
+	for _, query := range []string{
+		`SELECT count(*) FROM t.kv`,
+		`SELECT * FROM t.kv ORDER BY k`,
+		`SELECT DISTINCT k / 100 FROM t.kv`,
+		`SELECT * FROM t.kv WHERE k < 100 ORDER BY v`,
+	} {
+		if _, err := sqlDB.Exec(query); err != nil {
+			t.Errorf("%s: %s", query, err)
+		}
+	}
+	// Sorts and DISTINCT spill the rows to disk instead of exceeding the
+	// budget.
+	for _, tc := range []struct {
+		query string
+		desc  bool
+	}{
+		{`SELECT k, v FROM t.kv ORDER BY v`, false},
+		{`SELECT k, v FROM t.kv ORDER BY v DESC`, true},
+	} {
+		rows, err := sqlDB.Query(tc.query)
+		if err != nil {
+			t.Fatalf("%s: %s", tc.query, err)
+		}
+		var count int
+		var last string
+		for rows.Next() {
+			var k int
+			var v string
+			if err := rows.Scan(&k, &v); err != nil {
+				t.Fatal(err)
+			}
+			if count > 0 && (v < last) != tc.desc {
+				t.Fatalf("%s: %q returned after %q", tc.query, v, last)
+			}
+			last = v
+			count++
+		}
+		if err := rows.Err(); err != nil {
+			t.Fatalf("%s: %s", tc.query, err)
+		}
+		if count != numRows {
+			t.Fatalf("%s: expected %d rows, but found %d", tc.query, numRows, count)
+		}
+	}
+
+	// The rows spilled by a sort whose result is not consumed entirely are
+	// released when the statement has been executed.
+	var k int
+	if err := sqlDB.QueryRow(`SELECT k FROM t.kv ORDER BY v LIMIT 1`).Scan(&k); err != nil {
+		t.Fatal(err)
+	} else if k != numRows-1 {
+		t.Fatalf("expected %d, but found %d", numRows-1, k)
+	}
+	if _, err := sqlDB.Exec(`SELECT (k, v) FROM t.kv ORDER BY v`); !testutils.IsError(err,
+		"values of type tuple cannot be spilled to disk") {
+		t.Fatalf("expected the tuples not to be spilled, but found %v", err)
+	}
+
+	// Each value of t.dup is inserted twice, and the distinct values take
+	// more than the budget.
+	if _, err := sqlDB.Exec(`CREATE TABLE t.dup (k INT PRIMARY KEY, v STRING)`); err != nil {
+		t.Fatal(err)
+	}
+	const numDistinct = numRows / 2
+	buf.Reset()
+	buf.WriteString(`INSERT INTO t.dup VALUES `)
+	for i := 0; i < numRows; i++ {
+		if i > 0 {
+			buf.WriteString(", ")
+		}
+		fmt.Fprintf(&buf, "(%d, '%03d%s')", i, i%numDistinct, strings.Repeat("x", 197))
+	}
+	if _, err := sqlDB.Exec(buf.String()); err != nil {
+		t.Fatal(err)
+	}
+	rows, err := sqlDB.Query(`SELECT DISTINCT v FROM t.dup`)
+	if err != nil {
+		t.Fatal(err)
+	}
+	seen := make(map[string]bool)
+	for rows.Next() {
+		var v string
+		if err := rows.Scan(&v); err != nil {
+			t.Fatal(err)
+		}
+		if seen[v] {
+			t.Fatalf("%q returned twice", v)
+		}
+		seen[v] = true
+	}
+	if err := rows.Err(); err != nil {
+		t.Fatal(err)
+	}
+	if len(seen) != numDistinct {
+		t.Fatalf("expected %d distinct values, but found %d", numDistinct, len(seen))
+	}
+
+	// DISTINCT aggregate functions spill the values they have seen as well.
+	var count int
+	if err := sqlDB.QueryRow(`SELECT count(DISTINCT v) FROM t.dup`).Scan(&count); err != nil {
+		t.Fatal(err)
+	} else if count != numDistinct {
+		t.Fatalf("expected %d distinct values, but found %d", numDistinct, count)
+	}
+}
@@ -59,10 +59,14 @@ type AlterTableCmd interface {
 	alterTableCmd()
 }
 
-func (*AlterTableAddColumn) alterTableCmd()      {}
-func (*AlterTableAddConstraint) alterTableCmd()  {}
-func (*AlterTableDropColumn) alterTableCmd()     {}
-func (*AlterTableDropConstraint) alterTableCmd() {}
+func (*AlterTableAddColumn) alterTableCmd()       {}
+func (*AlterTableAddConstraint) alterTableCmd()   {}
+func (*AlterTableAlterColumnType) alterTableCmd() {}
+func (*AlterTableDropColumn) alterTableCmd()      {}
+func (*AlterTableDropConstraint) alterTableCmd()  {}
+func (*AlterTableDropNotNull) alterTableCmd()     {}
+func (*AlterTableSetDefault) alterTableCmd()      {}
+func (*AlterTableSetNotNull) alterTableCmd()      {}
 
 // AlterTableAddColumn represents an ADD COLUMN command.
 type AlterTableAddColumn struct {
@@ -122,3 +126,76 @@ type AlterTableDropConstraint struct {
 func (node *AlterTableDropConstraint) String() string {
 	return fmt.Sprintf("DROP CONSTRAINT %s", node.Constraint)
 }
+
+// AlterTableSetDefault represents an ALTER COLUMN SET DEFAULT
+// or DROP DEFAULT command.
+type AlterTableSetDefault struct {
+	columnKeyword bool
+	Column        string
+	// Default is nil for DROP DEFAULT.
+	Default Expr
+}
+
+func (node *AlterTableSetDefault) String() string {
+	var buf bytes.Buffer
+	buf.WriteString("ALTER")
+	if node.columnKeyword {
+		buf.WriteString(" COLUMN")
+	}
+	fmt.Fprintf(&buf, " %s", node.Column)
+	if node.Default == nil {
+		buf.WriteString(" DROP DEFAULT")
+	} else {
+		fmt.Fprintf(&buf, " SET DEFAULT %s", node.Default)
+	}
+	return buf.String()
+}
+
+// AlterTableSetNotNull represents an ALTER COLUMN SET NOT NULL command.
+type AlterTableSetNotNull struct {
+	columnKeyword bool
+	Column        string
+}
+
+func (node *AlterTableSetNotNull) String() string {
+	var buf bytes.Buffer
+	buf.WriteString("ALTER")
+	if node.columnKeyword {
+		buf.WriteString(" COLUMN")
+	}
+	fmt.Fprintf(&buf, " %s SET NOT NULL", node.Column)
+	return buf.String()
+}
+
+// AlterTableDropNotNull represents an ALTER COLUMN DROP NOT NULL command.
+type AlterTableDropNotNull struct {
+	columnKeyword bool
+	Column        string
+}
+
+func (node *AlterTableDropNotNull) String() string {
+	var buf bytes.Buffer
+	buf.WriteString("ALTER")
+	if node.columnKeyword {
+		buf.WriteString(" COLUMN")
+	}
+	fmt.Fprintf(&buf, " %s DROP NOT NULL", node.Column)
+	return buf.String()
+}
+
+// AlterTableAlterColumnType represents an ALTER COLUMN TYPE command.
+type AlterTableAlterColumnType struct {
+	columnKeyword bool
+	Column        string
+	Type          ColumnType
+}
+
+func (node *AlterTableAlterColumnType) String() string {
+	var buf bytes.Buffer
+	buf.WriteString("ALTER")
+	if node.columnKeyword {
+		buf.WriteString(" COLUMN")
+	}
+	fmt.Fprintf(&buf, " %s TYPE %s", node.Column, node.Type)
+	return buf.String()
+}
@@ -0,0 +1,99 @@
+// Copyright 2015 The Cockroach Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+// implied. See the License for the specific language governing
+// permissions and limitations under the License. See the AUTHORS file
+// for names of contributors.
+
+package parser
+
+import (
+	"bytes"
+	"fmt"
+	"strings"
+)
+
+// ParseDArray parses the text representation of an array, such as
+// {1,2,NULL} or {"a b","c"}, into an array whose elements have the given
+// type. Elements are separated by commas and may be double-quoted, in which
+// case backslash escapes the next character. An unquoted NULL is the NULL
+// element.
+func ParseDArray(ctx EvalContext, s string, elemType ColumnType) (Datum, error) {
+	t := &ArrayType{ElemType: elemType}
+	dummy, err := t.elemDummy()
+	if err != nil {
+		return DNull, err
+	}
+	invalid := func() (Datum, error) {
+		return DNull, fmt.Errorf("malformed array literal: %q", s)
+	}
+
+	str := strings.TrimSpace(s)
+	if len(str) < 2 || str[0] != '{' || str[len(str)-1] != '}' {
+		return invalid()
+	}
+	str = str[1 : len(str)-1]
+
+	a := DArray{ElemType: dummy, Elems: DTuple{}}
+	if strings.TrimSpace(str) == "" {
+		return a, nil
+	}
+	for {
+		str = strings.TrimLeft(str, " ")
+		var elem bytes.Buffer
+		quoted := len(str) > 0 && str[0] == '"'
+		if quoted {
+			i := 1
+			for ; i < len(str) && str[i] != '"'; i++ {
+				if str[i] == '\\' {
+					i++
+					if i == len(str) {
+						return invalid()
+					}
+				}
+				elem.WriteByte(str[i])
+			}
+			if i == len(str) {
+				return invalid()
+			}
+			str = strings.TrimLeft(str[i+1:], " ")
+		} else {
+			i := strings.IndexAny(str, ",{}\"")
+			if i < 0 {
+				i = len(str)
+			}
+			elem.WriteString(strings.TrimRight(str[:i], " "))
+			str = str[i:]
+			if elem.Len() == 0 {
+				return invalid()
+			}
+		}
+
+		if !quoted && strings.EqualFold(elem.String(), "NULL") {
+			a.Elems = append(a.Elems, DNull)
+		} else {
+			cast := &CastExpr{Expr: DString(elem.String()), Type: elemType}
+			d, err := cast.Eval(ctx)
+			if err != nil {
+				return DNull, err
+			}
+			a.Elems = append(a.Elems, d)
+		}
+
+		if len(str) == 0 {
+			return a, nil
+		}
+		if str[0] != ',' {
+			return invalid()
+		}
+		str = str[1:]
+	}
+}
@@ -0,0 +1,66 @@
+// Copyright 2015 The Cockroach Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+// implied. See the License for the specific language governing
+// permissions and limitations under the License. See the AUTHORS file
+// for names of contributors.
+
+package parser
+
+import (
+	"bytes"
+	"fmt"
+)
+
+// Backup represents a BACKUP statement.
+type Backup struct {
+	Targets TargetList
+	// To is the directory the backup is written to.
+	To string
+	// IncrementalFrom lists the directories of the backups this backup is
+	// incremental to, oldest first. It is empty for a full backup.
+	IncrementalFrom []string
+}
+
+func (node *Backup) String() string {
+	var buf bytes.Buffer
+	fmt.Fprintf(&buf, "BACKUP %s TO %s", node.Targets, encodeSQLString(node.To))
+	if node.IncrementalFrom != nil {
+		buf.WriteString(" INCREMENTAL FROM ")
+		writeStringList(&buf, node.IncrementalFrom)
+	}
+	return buf.String()
+}
+
+// Restore represents a RESTORE statement.
+type Restore struct {
+	Targets TargetList
+	// From lists the directories of a full backup followed by any incremental
+	// backups on top of it.
+	From []string
+}
+
+func (node *Restore) String() string {
+	var buf bytes.Buffer
+	fmt.Fprintf(&buf, "RESTORE %s FROM ", node.Targets)
+	writeStringList(&buf, node.From)
+	return buf.String()
+}
+
+// writeStringList writes a comma separated list of SQL string literals.
+func writeStringList(buf *bytes.Buffer, strs []string) {
+	for i, s := range strs {
+		if i > 0 {
+			buf.WriteString(", ")
+		}
+		buf.WriteString(encodeSQLString(s))
+	}
+}
@@ -437,6 +437,17 @@ var builtins = map[string][]builtin{
 		},
 	},
 
+	"gen_random_uuid": {
+		builtin{
+			types:      typeList{},
+			returnType: DummyUUID,
+			impure:     true,
+			fn: func(_ EvalContext, args DTuple) (Datum, error) {
+				return MakeDUUID(uuid.NewUUID4()), nil
+			},
+		},
+	},
+
 	"greatest": {
 		builtin{
 			types: nil,
@@ -509,67 +520,164 @@ var builtins = map[string][]builtin{
 		},
 	},
 
-	"extract": {
+	"extract":   extractImpls,
+	"date_part": extractImpls,
+
+	"date_trunc": {
 		builtin{
 			types:      typeList{stringType, timestampType},
-			returnType: DummyInt,
+			returnType: DummyTimestamp,
 			fn: func(_ EvalContext, args DTuple) (Datum, error) {
-				// extract timeSpan fromTime.
-				fromTime := args[1].(DTimestamp)
-				timeSpan := strings.ToLower(string(args[0].(DString)))
-				switch timeSpan {
-				case "year":
-					return DInt(fromTime.Year()), nil
-
-				case "quarter":
-					return DInt(fromTime.Month()/4 + 1), nil
-
-				case "month":
-					return DInt(fromTime.Month()), nil
-
-				case "week":
-					_, week := fromTime.ISOWeek()
-					return DInt(week), nil
-
-				case "day":
-					return DInt(fromTime.Day()), nil
-
-				case "dayofweek", "dow":
-					return DInt(fromTime.Weekday()), nil
-
-				case "dayofyear", "doy":
-					return DInt(fromTime.YearDay()), nil
-
-				case "hour":
-					return DInt(fromTime.Hour()), nil
+				return truncateTimestamp(string(args[0].(DString)), args[1].(DTimestamp).Time)
+			},
+		},
+		builtin{
+			types:      typeList{stringType, dateType},
+			returnType: DummyTimestamp,
+			fn: func(ctx EvalContext, args DTuple) (Datum, error) {
+				t, err := ctx.makeDTimestamp(args[1].(DDate))
+				if err != nil {
+					return nil, err
+				}
+				return truncateTimestamp(string(args[0].(DString)), t.Time)
+			},
+		},
+	},
 
-				case "minute":
-					return DInt(fromTime.Minute()), nil
+	"to_char": {
+		builtin{
+			types:      typeList{timestampType, stringType},
+			returnType: DummyString,
+			fn: func(_ EvalContext, args DTuple) (Datum, error) {
+				return DString(formatTimestamp(args[0].(DTimestamp).Time, string(args[1].(DString)))), nil
+			},
+		},
+		builtin{
+			types:      typeList{dateType, stringType},
+			returnType: DummyString,
+			fn: func(ctx EvalContext, args DTuple) (Datum, error) {
+				t, err := ctx.makeDTimestamp(args[0].(DDate))
+				if err != nil {
+					return nil, err
+				}
+				return DString(formatTimestamp(t.Time, string(args[1].(DString)))), nil
+			},
+		},
+	},
 
-				case "second":
-					return DInt(fromTime.Second()), nil
+	"to_timestamp": {
+		builtin{
+			types:      typeList{stringType, stringType},
+			returnType: DummyTimestamp,
+			fn: func(ctx EvalContext, args DTuple) (Datum, error) {
+				loc, err := ctx.GetLocation()
+				if err != nil {
+					return nil, err
+				}
+				t, err := parseTimestamp(string(args[0].(DString)), string(args[1].(DString)), loc)
+				if err != nil {
+					return nil, err
+				}
+				return DTimestamp{Time: t}, nil
+			},
+		},
+		builtin{
+			types:      typeList{floatType},
+			returnType: DummyTimestamp,
+			fn: func(_ EvalContext, args DTuple) (Datum, error) {
+				secs := float64(args[0].(DFloat))
+				return DTimestamp{Time: time.Unix(0, int64(secs*float64(time.Second))).UTC()}, nil
+			},
+		},
+		builtin{
+			types:      typeList{intType},
+			returnType: DummyTimestamp,
+			fn: func(_ EvalContext, args DTuple) (Datum, error) {
+				return DTimestamp{Time: time.Unix(int64(args[0].(DInt)), 0).UTC()}, nil
+			},
+		},
+	},
 
-				case "millisecond":
-					return DInt(fromTime.Nanosecond() / int(time.Millisecond)), nil
+	// Array functions.
 
-				case "microsecond":
-					return DInt(fromTime.Nanosecond() / int(time.Microsecond)), nil
+	"array_length": {
+		builtin{
+			types:      typeList{arrayType, intType},
+			returnType: DummyInt,
+			fn: func(_ EvalContext, args DTuple) (Datum, error) {
+				// Only one-dimensional arrays are supported, and the length of an
+				// empty array is NULL, as in Postgres.
+				a := args[0].(DArray)
+				if args[1].(DInt) != 1 || len(a.Elems) == 0 {
+					return DNull, nil
+				}
+				return DInt(len(a.Elems)), nil
+			},
+		},
+	},
 
-				case "nanosecond":
-					return DInt(fromTime.Nanosecond()), nil
+	// JSON functions.
 
-				case "epoch":
-					return DInt(fromTime.Unix()), nil
+	"json_build_object": {
+		builtin{
+			returnType: DummyJSON,
+			fn: func(_ EvalContext, args DTuple) (Datum, error) {
+				if len(args)%2 != 0 {
+					return DNull, fmt.Errorf("argument list must have an even number of elements")
+				}
+				obj := make(map[string]interface{}, len(args)/2)
+				for i := 0; i < len(args); i += 2 {
+					if args[i] == DNull {
+						return DNull, fmt.Errorf("argument %d cannot be NULL", i+1)
+					}
+					key, err := datumToJSON(args[i])
+					if err != nil {
+						return DNull, err
+					}
+					k, ok := key.(string)
+					if !ok {
+						d, err := makeDJSON(key)
+						if err != nil {
+							return DNull, err
+						}
+						k = string(d)
+					}
+					if obj[k], err = datumToJSON(args[i+1]); err != nil {
+						return DNull, err
+					}
+				}
+				return makeDJSON(obj)
+			},
+		},
+	},
 
-				default:
-					return DNull, fmt.Errorf("unsupported timespan: %s", timeSpan)
+	"json_extract_path": {
+		builtin{
+			returnType: DummyJSON,
+			fn: func(_ EvalContext, args DTuple) (Datum, error) {
+				if len(args) == 0 {
+					return DNull, fmt.Errorf("unknown signature for json_extract_path: json_extract_path()")
 				}
+				if args[0] == DNull {
+					return DNull, nil
+				}
+				j, ok := args[0].(DJSON)
+				if !ok {
+					return DNull, fmt.Errorf("unknown signature for json_extract_path: json_extract_path(%s, ...)", args[0].Type())
+				}
+				v, ok, err := jsonFetchPath(j.decode(), args[1:])
+				if err != nil || !ok {
+					return DNull, err
+				}
+				return makeDJSON(v)
 			},
 		},
 	},
 
 	// Aggregate functions.
 
+	"array_agg": arrayAggregateImpls(),
+
 	"avg": {
 		builtin{
 			types:      typeList{intType},
@@ -591,11 +699,36 @@ var builtins = map[string][]builtin{
 		},
 	},
 
+	"bool_and": aggregateImpls(boolType),
+	"bool_or":  aggregateImpls(boolType),
+
 	"count": countImpls(),
 
-	"max": aggregateImpls(boolType, intType, floatType, stringType, bytesType, dateType, timestampType, intervalType),
-	"min": aggregateImpls(boolType, intType, floatType, stringType, bytesType, dateType, timestampType, intervalType),
-	"sum": aggregateImpls(intType, floatType),
+	"max": aggregateImpls(boolType, intType, floatType, stringType, bytesType, dateType, timestampType, intervalType, uuidType),
+	"min": aggregateImpls(boolType, intType, floatType, stringType, bytesType, dateType, timestampType, intervalType, uuidType),
+
+	"stddev": floatAggregateImpls(),
+
+	"string_agg": {
+		builtin{
+			types:      typeList{stringType, stringType},
+			returnType: DummyString,
+			fn: func(_ EvalContext, args DTuple) (Datum, error) {
+				return args[0], nil
+			},
+		},
+		builtin{
+			types:      typeList{bytesType, bytesType},
+			returnType: DummyBytes,
+			fn: func(_ EvalContext, args DTuple) (Datum, error) {
+				return args[0], nil
+			},
+		},
+	},
+
+	"sum":      aggregateImpls(intType, floatType),
+	"variance": floatAggregateImpls(),
+	"xor_agg":  aggregateImpls(intType, bytesType),
 
 	// Math functions
 
@@ -815,9 +948,53 @@ func aggregateImpls(types ...reflect.Type) []builtin {
 	return r
 }
 
+// floatAggregateImpls returns the implementations of the statistical
+// aggregate functions, which return a float when given an int or a float.
+func floatAggregateImpls() []builtin {
+	return []builtin{
+		{
+			types:      typeList{intType},
+			returnType: DummyFloat,
+			fn: func(_ EvalContext, args DTuple) (Datum, error) {
+				if args[0] == DNull {
+					return args[0], nil
+				}
+				return DFloat(args[0].(DInt)), nil
+			},
+		},
+		{
+			types:      typeList{floatType},
+			returnType: DummyFloat,
+			fn: func(_ EvalContext, args DTuple) (Datum, error) {
+				return args[0], nil
+			},
+		},
+	}
+}
+
+// arrayAggregateImpls returns the implementations of array_agg, which
+// returns an array of the type of its argument.
+func arrayAggregateImpls() []builtin {
+	var r []builtin
+	for _, d := range []Datum{DummyBool, DummyInt, DummyFloat, DummyString, DummyBytes, DummyDate, DummyTimestamp, DummyInterval, DummyUUID} {
+		elemType := d
+		r = append(r, builtin{
+			types:      typeList{reflect.TypeOf(elemType)},
+			returnType: DArray{ElemType: elemType},
+			fn: func(_ EvalContext, args DTuple) (Datum, error) {
+				if args[0] == DNull {
+					return DNull, nil
+				}
+				return DArray{ElemType: elemType, Elems: DTuple{args[0]}}, nil
+			},
+		})
+	}
+	return r
+}
+
 func countImpls() []builtin {
 	var r []builtin
-	types := typeList{boolType, intType, floatType, stringType, bytesType, dateType, timestampType, intervalType, tupleType}
+	types := typeList{boolType, intType, floatType, stringType, bytesType, dateType, timestampType, intervalType, uuidType, tupleType}
 	for _, t := range types {
 		r = append(r, builtin{
 			types:      typeList{t},
@@ -887,6 +1064,29 @@ var ceilImpl = floatBuiltin1(func(x float64) (Datum, error) {
 	return DFloat(math.Ceil(x)), nil
 })
 
+// extractImpls implements EXTRACT(field FROM t) and its date_part(field, t)
+// equivalent.
+var extractImpls = []builtin{
+	{
+		types:      typeList{stringType, timestampType},
+		returnType: DummyInt,
+		fn: func(_ EvalContext, args DTuple) (Datum, error) {
+			return extractTimeSpan(string(args[0].(DString)), args[1].(DTimestamp).Time)
+		},
+	},
+	{
+		types:      typeList{stringType, dateType},
+		returnType: DummyInt,
+		fn: func(ctx EvalContext, args DTuple) (Datum, error) {
+			t, err := ctx.makeDTimestamp(args[1].(DDate))
+			if err != nil {
+				return nil, err
+			}
+			return extractTimeSpan(string(args[0].(DString)), t.Time)
+		},
+	},
+}
+
 var nowImpl = builtin{
 	types:      typeList{},
 	returnType: DummyTimestamp,
@@ -50,8 +50,10 @@ type CreateIndex struct {
 	Table       *QualifiedName
 	Unique      bool
 	IfNotExists bool
-	Columns     NameList
+	Columns     IndexElemList
 	Storing     NameList
+	// Predicate is the WHERE clause of a partial index, or nil.
+	Predicate Expr
 }
 
 func (node *CreateIndex) String() string {
@@ -71,6 +73,37 @@ func (node *CreateIndex) String() string {
 	if node.Storing != nil {
 		fmt.Fprintf(&buf, " STORING (%s)", node.Storing)
 	}
+	if node.Predicate != nil {
+		fmt.Fprintf(&buf, " WHERE %s", node.Predicate)
+	}
+	return buf.String()
+}
+
+// IndexElem represents a column of an index along with the direction in
+// which its values are ordered within the index.
+type IndexElem struct {
+	Column    Name
+	Direction Direction
+}
+
+func (node IndexElem) String() string {
+	if node.Direction == DefaultDirection {
+		return node.Column.String()
+	}
+	return fmt.Sprintf("%s %s", node.Column, node.Direction)
+}
+
+// IndexElemList is list of IndexElem.
+type IndexElemList []IndexElem
+
+func (l IndexElemList) String() string {
+	var buf bytes.Buffer
+	for i, e := range l {
+		if i > 0 {
+			buf.WriteString(", ")
+		}
+		buf.WriteString(e.String())
+	}
 	return buf.String()
 }
 
@@ -85,6 +118,7 @@ type TableDef interface {
 
 func (*ColumnTableDef) tableDef() {}
 func (*IndexTableDef) tableDef()  {}
+func (*FamilyTableDef) tableDef() {}
 
 // TableDefs represents a list of table definitions.
 type TableDefs []TableDef
@@ -113,12 +147,13 @@ const (
 // ColumnTableDef represents a column definition within a CREATE TABLE
 // statement.
 type ColumnTableDef struct {
-	Name        Name
-	Type        ColumnType
-	Nullable    Nullability
-	PrimaryKey  bool
-	Unique      bool
-	DefaultExpr Expr
+	Name         Name
+	Type         ColumnType
+	Nullable     Nullability
+	PrimaryKey   bool
+	Unique       bool
+	DefaultExpr  Expr
+	ComputedExpr Expr
 }
 
 func newColumnTableDef(name Name, typ ColumnType,
@@ -132,6 +167,8 @@ func newColumnTableDef(name Name, typ ColumnType,
 		switch t := c.(type) {
 		case *ColumnDefault:
 			d.DefaultExpr = t.Expr
+		case *ColumnComputedDef:
+			d.ComputedExpr = t.Expr
 		case NotNullConstraint:
 			d.Nullable = NotNull
 		case NullConstraint:
@@ -168,6 +205,9 @@ func (node *ColumnTableDef) String() string {
 	if node.DefaultExpr != nil {
 		fmt.Fprintf(&buf, " DEFAULT %s", node.DefaultExpr)
 	}
+	if node.ComputedExpr != nil {
+		fmt.Fprintf(&buf, " AS (%s) STORED", node.ComputedExpr)
+	}
 	return buf.String()
 }
 
@@ -177,6 +217,7 @@ type ColumnQualification interface {
 }
 
 func (*ColumnDefault) columnQualification()       {}
+func (*ColumnComputedDef) columnQualification()   {}
 func (NotNullConstraint) columnQualification()    {}
 func (NullConstraint) columnQualification()       {}
 func (PrimaryKeyConstraint) columnQualification() {}
@@ -187,6 +228,12 @@ type ColumnDefault struct {
 	Expr Expr
 }
 
+// ColumnComputedDef represents an AS (expr) STORED clause for a computed
+// column.
+type ColumnComputedDef struct {
+	Expr Expr
+}
+
 // NotNullConstraint represents NOT NULL on a column.
 type NotNullConstraint struct{}
 
@@ -203,8 +250,10 @@ type UniqueConstraint struct{}
 // statement.
 type IndexTableDef struct {
 	Name    Name
-	Columns NameList
+	Columns IndexElemList
 	Storing NameList
+	// Predicate is the WHERE clause of a partial index, or nil.
+	Predicate Expr
 }
 
 func (node *IndexTableDef) setName(name Name) {
@@ -221,6 +270,30 @@ func (node *IndexTableDef) String() string {
 	if node.Storing != nil {
 		fmt.Fprintf(&buf, " STORING (%s)", node.Storing)
 	}
+	if node.Predicate != nil {
+		fmt.Fprintf(&buf, " WHERE %s", node.Predicate)
+	}
+	return buf.String()
+}
+
+// FamilyTableDef represents a column family definition within a CREATE TABLE
+// statement.
+type FamilyTableDef struct {
+	Name    Name
+	Columns NameList
+}
+
+func (node *FamilyTableDef) setName(name Name) {
+	node.Name = name
+}
+
+func (node *FamilyTableDef) String() string {
+	var buf bytes.Buffer
+	buf.WriteString("FAMILY ")
+	if node.Name != "" {
+		fmt.Fprintf(&buf, "%s ", node.Name)
+	}
+	fmt.Fprintf(&buf, "(%s)", node.Columns)
 	return buf.String()
 }
 
@@ -256,14 +329,29 @@ func (node *UniqueConstraintTableDef) String() string {
 	if node.Storing != nil {
 		fmt.Fprintf(&buf, " STORING (%s)", node.Storing)
 	}
+	if node.Predicate != nil {
+		fmt.Fprintf(&buf, " WHERE %s", node.Predicate)
+	}
 	return buf.String()
 }
 
+// InterleaveDef represents an INTERLEAVE IN PARENT clause within a CREATE
+// TABLE statement.
+type InterleaveDef struct {
+	Parent *QualifiedName
+	Fields NameList
+}
+
+func (node *InterleaveDef) String() string {
+	return fmt.Sprintf("INTERLEAVE IN PARENT %s (%s)", node.Parent, node.Fields)
+}
+
 // CreateTable represents a CREATE TABLE statement.
 type CreateTable struct {
 	IfNotExists bool
 	Table       *QualifiedName
 	Defs        TableDefs
+	Interleave  *InterleaveDef
 }
 
 func (node *CreateTable) String() string {
@@ -273,5 +361,8 @@ func (node *CreateTable) String() string {
 		buf.WriteString(" IF NOT EXISTS")
 	}
 	fmt.Fprintf(&buf, " %s (%s)", node.Table, node.Defs)
+	if node.Interleave != nil {
+		fmt.Fprintf(&buf, " %s", node.Interleave)
+	}
 	return buf.String()
 }
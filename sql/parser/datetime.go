@@ -0,0 +1,487 @@
+// Copyright 2015 The Cockroach Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+// implied. See the License for the specific language governing
+// permissions and limitations under the License. See the AUTHORS file
+// for names of contributors.
+
+package parser
+
+import (
+	"bytes"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+	"unicode"
+	"unicode/utf8"
+)
+
+// extractTimeSpan returns the field of the time named by timeSpan.
+func extractTimeSpan(timeSpan string, fromTime time.Time) (Datum, error) {
+	switch strings.ToLower(timeSpan) {
+	case "millennium":
+		return DInt((fromTime.Year()-1)/1000 + 1), nil
+
+	case "century":
+		return DInt((fromTime.Year()-1)/100 + 1), nil
+
+	case "decade":
+		return DInt(fromTime.Year() / 10), nil
+
+	case "year":
+		return DInt(fromTime.Year()), nil
+
+	case "isoyear":
+		year, _ := fromTime.ISOWeek()
+		return DInt(year), nil
+
+	case "quarter":
+		return DInt((fromTime.Month()-1)/3 + 1), nil
+
+	case "month":
+		return DInt(fromTime.Month()), nil
+
+	case "week":
+		_, week := fromTime.ISOWeek()
+		return DInt(week), nil
+
+	case "day":
+		return DInt(fromTime.Day()), nil
+
+	case "dayofweek", "dow":
+		return DInt(fromTime.Weekday()), nil
+
+	case "isodow":
+		return DInt(isoWeekday(fromTime)), nil
+
+	case "dayofyear", "doy":
+		return DInt(fromTime.YearDay()), nil
+
+	case "hour":
+		return DInt(fromTime.Hour()), nil
+
+	case "minute":
+		return DInt(fromTime.Minute()), nil
+
+	case "second":
+		return DInt(fromTime.Second()), nil
+
+	case "millisecond":
+		return DInt(fromTime.Nanosecond() / int(time.Millisecond)), nil
+
+	case "microsecond":
+		return DInt(fromTime.Nanosecond() / int(time.Microsecond)), nil
+
+	case "nanosecond":
+		return DInt(fromTime.Nanosecond()), nil
+
+	case "epoch":
+		return DInt(fromTime.Unix()), nil
+
+	default:
+		return DNull, fmt.Errorf("unsupported timespan: %s", timeSpan)
+	}
+}
+
+// isoWeekday returns the ISO 8601 day of the week, from 1 for Monday to 7 for
+// Sunday.
+func isoWeekday(t time.Time) int {
+	if t.Weekday() == time.Sunday {
+		return 7
+	}
+	return int(t.Weekday())
+}
+
+// truncateTimestamp truncates the time to the precision named by timeSpan:
+// all the fields less significant than timeSpan are set to their minimum.
+// Weeks start on Monday.
+func truncateTimestamp(timeSpan string, fromTime time.Time) (Datum, error) {
+	year, month, day := fromTime.Date()
+	hour, min, sec := fromTime.Clock()
+	nsec := fromTime.Nanosecond()
+	loc := fromTime.Location()
+
+	switch strings.ToLower(timeSpan) {
+	case "millennium":
+		year = (year-1)/1000*1000 + 1
+		month, day, hour, min, sec, nsec = time.January, 1, 0, 0, 0, 0
+
+	case "century":
+		year = (year-1)/100*100 + 1
+		month, day, hour, min, sec, nsec = time.January, 1, 0, 0, 0, 0
+
+	case "decade":
+		year = year / 10 * 10
+		month, day, hour, min, sec, nsec = time.January, 1, 0, 0, 0, 0
+
+	case "year":
+		month, day, hour, min, sec, nsec = time.January, 1, 0, 0, 0, 0
+
+	case "quarter":
+		month = (month-1)/3*3 + 1
+		day, hour, min, sec, nsec = 1, 0, 0, 0, 0
+
+	case "month":
+		day, hour, min, sec, nsec = 1, 0, 0, 0, 0
+
+	case "week":
+		day -= isoWeekday(fromTime) - 1
+		hour, min, sec, nsec = 0, 0, 0, 0
+
+	case "day":
+		hour, min, sec, nsec = 0, 0, 0, 0
+
+	case "hour":
+		min, sec, nsec = 0, 0, 0
+
+	case "minute":
+		sec, nsec = 0, 0
+
+	case "second":
+		nsec = 0
+
+	case "millisecond", "milliseconds":
+		nsec = nsec / int(time.Millisecond) * int(time.Millisecond)
+
+	case "microsecond", "microseconds":
+		nsec = nsec / int(time.Microsecond) * int(time.Microsecond)
+
+	default:
+		return DNull, fmt.Errorf("unsupported timespan: %s", timeSpan)
+	}
+	return DTimestamp{Time: time.Date(year, month, day, hour, min, sec, nsec, loc)}, nil
+}
+
+// timeFormatPatterns are the template patterns of to_char and to_timestamp,
+// in the order in which they are matched. The patterns producing text are
+// matched case-sensitively as their case determines the case of the text;
+// the others are matched case-insensitively.
+var timeFormatPatterns = []struct {
+	pattern   string
+	matchCase bool
+}{
+	{"HH24", false},
+	{"HH12", false},
+	{"HH", false},
+	{"MI", false},
+	{"SS", false},
+	{"MS", false},
+	{"US", false},
+	{"AM", true},
+	{"PM", true},
+	{"am", true},
+	{"pm", true},
+	{"IYYY", false},
+	{"YYYY", false},
+	{"YY", false},
+	{"MONTH", true},
+	{"Month", true},
+	{"month", true},
+	{"MON", true},
+	{"Mon", true},
+	{"mon", true},
+	{"MM", false},
+	{"DAY", true},
+	{"Day", true},
+	{"day", true},
+	{"DY", true},
+	{"Dy", true},
+	{"dy", true},
+	{"DDD", false},
+	{"DD", false},
+	{"ID", false},
+	{"IW", false},
+	{"D", false},
+	{"Q", false},
+	{"TZ", true},
+	{"tz", true},
+}
+
+// timeFormatToken is an element of a to_char or to_timestamp template: either
+// a pattern, possibly with the FM (fill mode) prefix suppressing padding, or
+// literal text.
+type timeFormatToken struct {
+	pattern string
+	fill    bool
+	literal string
+}
+
+// parseTimeFormat splits a to_char or to_timestamp template into tokens.
+// Double-quoted text is literal text.
+func parseTimeFormat(format string) []timeFormatToken {
+	var tokens []timeFormatToken
+	for len(format) > 0 {
+		if format[0] == '"' {
+			end := strings.IndexByte(format[1:], '"')
+			if end < 0 {
+				// An unterminated quote extends to the end of the template.
+				tokens = append(tokens, timeFormatToken{literal: format[1:]})
+				break
+			}
+			tokens = append(tokens, timeFormatToken{literal: format[1 : end+1]})
+			format = format[end+2:]
+			continue
+		}
+		fill := false
+		rest := format
+		if len(rest) >= 2 && strings.EqualFold(rest[:2], "FM") {
+			fill = true
+			rest = rest[2:]
+		}
+		matched := false
+		for _, p := range timeFormatPatterns {
+			if len(rest) < len(p.pattern) {
+				continue
+			}
+			prefix := rest[:len(p.pattern)]
+			if prefix == p.pattern || (!p.matchCase && strings.EqualFold(prefix, p.pattern)) {
+				tokens = append(tokens, timeFormatToken{pattern: p.pattern, fill: fill})
+				format = rest[len(p.pattern):]
+				matched = true
+				break
+			}
+		}
+		if !matched {
+			_, size := utf8.DecodeRuneInString(format)
+			tokens = append(tokens, timeFormatToken{literal: format[:size]})
+			format = format[size:]
+		}
+	}
+	return tokens
+}
+
+// formatTimestamp implements to_char, formatting the time according to a
+// template of Postgres template patterns such as "YYYY-MM-DD HH24:MI:SS".
+func formatTimestamp(t time.Time, format string) string {
+	var buf bytes.Buffer
+	for _, token := range parseTimeFormat(format) {
+		if token.pattern == "" {
+			buf.WriteString(token.literal)
+			continue
+		}
+		num := func(v, width int) {
+			if token.fill {
+				width = 0
+			}
+			fmt.Fprintf(&buf, "%0*d", width, v)
+		}
+		text := func(s string, width int) {
+			if token.fill {
+				width = 0
+			}
+			fmt.Fprintf(&buf, "%-*s", width, s)
+		}
+		switch strings.ToUpper(token.pattern) {
+		case "HH24":
+			num(t.Hour(), 2)
+		case "HH12", "HH":
+			hour := t.Hour() % 12
+			if hour == 0 {
+				hour = 12
+			}
+			num(hour, 2)
+		case "MI":
+			num(t.Minute(), 2)
+		case "SS":
+			num(t.Second(), 2)
+		case "MS":
+			num(t.Nanosecond()/int(time.Millisecond), 3)
+		case "US":
+			num(t.Nanosecond()/int(time.Microsecond), 6)
+		case "AM", "PM":
+			meridiem := "AM"
+			if t.Hour() >= 12 {
+				meridiem = "PM"
+			}
+			if token.pattern[0] == 'a' || token.pattern[0] == 'p' {
+				meridiem = strings.ToLower(meridiem)
+			}
+			buf.WriteString(meridiem)
+		case "IYYY":
+			year, _ := t.ISOWeek()
+			num(year, 4)
+		case "YYYY":
+			num(t.Year(), 4)
+		case "YY":
+			num(t.Year()%100, 2)
+		case "MONTH":
+			text(matchCase(t.Month().String(), token.pattern), 9)
+		case "MON":
+			buf.WriteString(matchCase(t.Month().String()[:3], token.pattern))
+		case "MM":
+			num(int(t.Month()), 2)
+		case "DAY":
+			text(matchCase(t.Weekday().String(), token.pattern), 9)
+		case "DY":
+			buf.WriteString(matchCase(t.Weekday().String()[:3], token.pattern))
+		case "DDD":
+			num(t.YearDay(), 3)
+		case "DD":
+			num(t.Day(), 2)
+		case "ID":
+			num(isoWeekday(t), 1)
+		case "IW":
+			_, week := t.ISOWeek()
+			num(week, 2)
+		case "D":
+			num(int(t.Weekday())+1, 1)
+		case "Q":
+			num(int(t.Month()-1)/3+1, 1)
+		case "TZ":
+			zone, _ := t.Zone()
+			buf.WriteString(matchCase(zone, token.pattern))
+		}
+	}
+	return buf.String()
+}
+
+// matchCase returns s in the case of the pattern: upper case, lower case or
+// capitalized.
+func matchCase(s, pattern string) string {
+	switch {
+	case pattern == strings.ToUpper(pattern):
+		return strings.ToUpper(s)
+	case pattern == strings.ToLower(pattern):
+		return strings.ToLower(s)
+	}
+	return s
+}
+
+// parseTimestamp implements to_timestamp, parsing the string according to a
+// template of the patterns supported by to_char. Fields missing from the
+// template default to their minimum. The time is interpreted in the given
+// location.
+func parseTimestamp(s, format string, loc *time.Location) (time.Time, error) {
+	year, month, day := 1, time.January, 1
+	hour, min, sec, nsec := 0, 0, 0, 0
+	pm := false
+	hour12 := false
+
+	input := s
+	readNum := func(pattern string, width int) (int, error) {
+		input = strings.TrimLeftFunc(input, unicode.IsSpace)
+		n := 0
+		for n < len(input) && n < width && ((input[n] >= '0' && input[n] <= '9') || (n == 0 && input[n] == '-')) {
+			n++
+		}
+		v, err := strconv.Atoi(input[:n])
+		if err != nil {
+			return 0, fmt.Errorf("invalid value %q for %s", input, pattern)
+		}
+		input = input[n:]
+		return v, nil
+	}
+	readName := func(pattern string, names []string, abbrev bool) (int, error) {
+		input = strings.TrimLeftFunc(input, unicode.IsSpace)
+		for i, name := range names {
+			if abbrev {
+				name = name[:3]
+			}
+			if len(input) >= len(name) && strings.EqualFold(input[:len(name)], name) {
+				input = input[len(name):]
+				return i, nil
+			}
+		}
+		return 0, fmt.Errorf("invalid value %q for %s", input, pattern)
+	}
+
+	for _, token := range parseTimeFormat(format) {
+		if token.pattern == "" {
+			// Each character of literal text matches any character, as in
+			// Postgres.
+			for range token.literal {
+				_, size := utf8.DecodeRuneInString(input)
+				input = input[size:]
+			}
+			continue
+		}
+		var err error
+		switch strings.ToUpper(token.pattern) {
+		case "HH24":
+			hour, err = readNum(token.pattern, 2)
+		case "HH12", "HH":
+			hour, err = readNum(token.pattern, 2)
+			hour12 = true
+		case "MI":
+			min, err = readNum(token.pattern, 2)
+		case "SS":
+			sec, err = readNum(token.pattern, 2)
+		case "MS":
+			var ms int
+			ms, err = readNum(token.pattern, 3)
+			nsec = ms * int(time.Millisecond)
+		case "US":
+			var us int
+			us, err = readNum(token.pattern, 6)
+			nsec = us * int(time.Microsecond)
+		case "AM", "PM":
+			var i int
+			i, err = readName(token.pattern, []string{"AM", "PM"}, false)
+			pm = i == 1
+		case "YYYY", "IYYY":
+			year, err = readNum(token.pattern, 4)
+		case "YY":
+			year, err = readNum(token.pattern, 2)
+			// As in Postgres, two digit years are the years closest to 2020.
+			if year < 70 {
+				year += 2000
+			} else {
+				year += 1900
+			}
+		case "MONTH", "MON":
+			var i int
+			i, err = readName(token.pattern, monthNames, token.pattern[len(token.pattern)-1] != 'H' && token.pattern[len(token.pattern)-1] != 'h')
+			month = time.Month(i + 1)
+		case "MM":
+			var m int
+			m, err = readNum(token.pattern, 2)
+			month = time.Month(m)
+		case "DAY", "DY":
+			// The day of the week is ignored.
+			_, err = readName(token.pattern, weekdayNames, len(token.pattern) == 2)
+		case "DD":
+			day, err = readNum(token.pattern, 2)
+		case "DDD":
+			var doy int
+			if doy, err = readNum(token.pattern, 3); err == nil {
+				month, day = time.January, doy
+			}
+		default:
+			err = fmt.Errorf("pattern %s is not supported by to_timestamp", token.pattern)
+		}
+		if err != nil {
+			return time.Time{}, err
+		}
+	}
+	if hour12 {
+		if hour < 1 || hour > 12 {
+			return time.Time{}, fmt.Errorf("hour %d is invalid for the 12-hour clock", hour)
+		}
+		hour %= 12
+		if pm {
+			hour += 12
+		}
+	}
+	if month < time.January || month > time.December || day < 1 || hour > 23 || min > 59 || sec > 59 {
+		return time.Time{}, fmt.Errorf("date/time field value out of range: %q", s)
+	}
+	return time.Date(year, month, day, hour, min, sec, nsec, loc), nil
+}
+
+var monthNames = []string{
+	"January", "February", "March", "April", "May", "June", "July",
+	"August", "September", "October", "November", "December",
+}
+
+var weekdayNames = []string{
+	"Sunday", "Monday", "Tuesday", "Wednesday", "Thursday", "Friday", "Saturday",
+}
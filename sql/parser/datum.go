@@ -24,9 +24,11 @@ import (
 	"reflect"
 	"sort"
 	"strconv"
+	"strings"
 	"time"
 
 	"github.com/cockroachdb/cockroach/roachpb"
+	"github.com/cockroachdb/cockroach/util/uuid"
 )
 
 var (
@@ -46,8 +48,14 @@ var (
 	DummyTimestamp = DTimestamp{}
 	// DummyInterval is a placeholder DInterval value.
 	DummyInterval = DInterval{}
+	// DummyJSON is a placeholder DJSON value.
+	DummyJSON = DJSON("null")
+	// DummyUUID is a placeholder DUUID value.
+	DummyUUID = DUUID{}
 	// dummyTuple is a placeholder DTuple value.
 	dummyTuple = DTuple{}
+	// dummyArray is a placeholder DArray value.
+	dummyArray = DArray{ElemType: DNull}
 
 	// DNull is the NULL Datum.
 	DNull = dNull{}
@@ -60,7 +68,10 @@ var (
 	_ Datum = DummyDate
 	_ Datum = DummyTimestamp
 	_ Datum = DummyInterval
+	_ Datum = DummyJSON
+	_ Datum = DummyUUID
 	_ Datum = dummyTuple
+	_ Datum = dummyArray
 	_ Datum = DNull
 
 	boolType      = reflect.TypeOf(DummyBool)
@@ -71,7 +82,10 @@ var (
 	dateType      = reflect.TypeOf(DummyDate)
 	timestampType = reflect.TypeOf(DummyTimestamp)
 	intervalType  = reflect.TypeOf(DummyInterval)
+	jsonType      = reflect.TypeOf(DummyJSON)
+	uuidType      = reflect.TypeOf(DummyUUID)
 	tupleType     = reflect.TypeOf(dummyTuple)
+	arrayType     = reflect.TypeOf(dummyArray)
 )
 
 // A Datum holds either a bool, int64, float64, string or []Datum.
@@ -488,6 +502,128 @@ func (d DInterval) IsMin() bool {
 	return d.Duration == math.MinInt64
 }
 
+// DJSON is the JSON Datum. It holds the canonical text of a JSON value (see
+// ParseDJSON), which allows JSON values to be compared and encoded like
+// strings.
+type DJSON string
+
+// Type implements the Datum interface.
+func (d DJSON) Type() string {
+	return "json"
+}
+
+// Compare implements the Datum interface.
+func (d DJSON) Compare(other Datum) int {
+	if other == DNull {
+		// NULL is less than any non-NULL value.
+		return 1
+	}
+	v, ok := other.(DJSON)
+	if !ok {
+		panic(fmt.Sprintf("unsupported comparison: %s to %s", d.Type(), other.Type()))
+	}
+	if d < v {
+		return -1
+	}
+	if d > v {
+		return 1
+	}
+	return 0
+}
+
+// Next implements the Datum interface.
+func (d DJSON) Next() Datum {
+	return DJSON(roachpb.Key(d).Next())
+}
+
+// IsMax implements the Datum interface.
+func (d DJSON) IsMax() bool {
+	return false
+}
+
+// IsMin implements the Datum interface.
+func (d DJSON) IsMin() bool {
+	return len(d) == 0
+}
+
+func (d DJSON) String() string {
+	return fmt.Sprintf("%s::JSON", encodeSQLString(string(d)))
+}
+
+// DUUID is the UUID Datum.
+type DUUID [uuid.UUIDSize]byte
+
+// ParseDUUID parses the text form of a UUID (see uuid.FromString).
+func ParseDUUID(s string) (DUUID, error) {
+	u, err := uuid.FromString(s)
+	if err != nil {
+		return DUUID{}, err
+	}
+	return MakeDUUID(u), nil
+}
+
+// MakeDUUID returns the DUUID holding a UUID.
+func MakeDUUID(u uuid.UUID) DUUID {
+	var d DUUID
+	copy(d[:], u)
+	return d
+}
+
+// UUID returns the UUID held by the datum.
+func (d DUUID) UUID() uuid.UUID {
+	return uuid.UUID(append([]byte(nil), d[:]...))
+}
+
+// Type implements the Datum interface.
+func (d DUUID) Type() string {
+	return "uuid"
+}
+
+// Compare implements the Datum interface.
+func (d DUUID) Compare(other Datum) int {
+	if other == DNull {
+		// NULL is less than any non-NULL value.
+		return 1
+	}
+	v, ok := other.(DUUID)
+	if !ok {
+		panic(fmt.Sprintf("unsupported comparison: %s to %s", d.Type(), other.Type()))
+	}
+	return bytes.Compare(d[:], v[:])
+}
+
+// Next implements the Datum interface.
+func (d DUUID) Next() Datum {
+	// Increment the UUID as a 128-bit integer. The maximum UUID has no next
+	// value, which callers avoid by checking IsMax.
+	for i := len(d) - 1; i >= 0; i-- {
+		d[i]++
+		if d[i] != 0 {
+			break
+		}
+	}
+	return d
+}
+
+// IsMax implements the Datum interface.
+func (d DUUID) IsMax() bool {
+	for _, b := range d {
+		if b != 0xff {
+			return false
+		}
+	}
+	return true
+}
+
+// IsMin implements the Datum interface.
+func (d DUUID) IsMin() bool {
+	return d == DUUID{}
+}
+
+func (d DUUID) String() string {
+	return fmt.Sprintf("%s::UUID", encodeSQLString(d.UUID().String()))
+}
+
 // DTuple is the tuple Datum.
 type DTuple []Datum
 
@@ -594,6 +730,82 @@ func (d *DTuple) makeUnique() {
 	*d = (*d)[:n]
 }
 
+// DArray is the array Datum. All the elements of an array have the same type,
+// though any of them may be NULL.
+type DArray struct {
+	// ElemType is a placeholder value of the type of the elements, which types
+	// arrays that are empty or contain only NULLs. It is DNull if the type of
+	// the elements is unknown, as for ARRAY[].
+	ElemType Datum
+	Elems    DTuple
+}
+
+// NewDArray returns an array of the given elements. The type of the elements
+// is that of the first non-NULL element.
+func NewDArray(elems DTuple) DArray {
+	a := DArray{ElemType: DNull, Elems: elems}
+	for _, e := range elems {
+		if e != DNull {
+			a.ElemType, _ = e.TypeCheck()
+			break
+		}
+	}
+	return a
+}
+
+// Type implements the Datum interface.
+func (d DArray) Type() string {
+	return d.ElemType.Type() + "[]"
+}
+
+// Compare implements the Datum interface. Arrays are compared element by
+// element, as tuples are.
+func (d DArray) Compare(other Datum) int {
+	if other == DNull {
+		// NULL is less than any non-NULL value.
+		return 1
+	}
+	v, ok := other.(DArray)
+	if !ok {
+		panic(fmt.Sprintf("unsupported comparison: %s to %s", d.Type(), other.Type()))
+	}
+	return d.Elems.Compare(v.Elems)
+}
+
+// Next implements the Datum interface.
+func (d DArray) Next() Datum {
+	return DArray{ElemType: d.ElemType, Elems: append(append(DTuple(nil), d.Elems...), DNull)}
+}
+
+// IsMax implements the Datum interface.
+func (d DArray) IsMax() bool {
+	return false
+}
+
+// IsMin implements the Datum interface.
+func (d DArray) IsMin() bool {
+	return len(d.Elems) == 0
+}
+
+func (d DArray) String() string {
+	var buf bytes.Buffer
+	buf.WriteString("ARRAY[")
+	typed := false
+	for i, v := range d.Elems {
+		if i > 0 {
+			buf.WriteString(", ")
+		}
+		buf.WriteString(v.String())
+		typed = typed || v != DNull
+	}
+	buf.WriteString("]")
+	if !typed && d.ElemType != DNull {
+		// The elements do not determine the type of the array.
+		fmt.Fprintf(&buf, "::%s[]", strings.ToUpper(d.ElemType.Type()))
+	}
+	return buf.String()
+}
+
 type dNull struct{}
 
 // Type implements the Datum interface.
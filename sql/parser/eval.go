@@ -30,6 +30,7 @@ import (
 	"unicode/utf8"
 
 	"github.com/cockroachdb/cockroach/util"
+	"github.com/cockroachdb/cockroach/util/uuid"
 )
 
 var (
@@ -148,6 +149,26 @@ var binOps = map[binArgs]binOp{
 			return DDate(left.(DInt)) + right.(DDate), nil
 		},
 	},
+	binArgs{Plus, dateType, intervalType}: {
+		returnType: DummyTimestamp,
+		fn: func(ctx EvalContext, left Datum, right Datum) (Datum, error) {
+			t, err := ctx.makeDTimestamp(left.(DDate))
+			if err != nil {
+				return nil, err
+			}
+			return DTimestamp{Time: t.Add(right.(DInterval).Duration)}, nil
+		},
+	},
+	binArgs{Plus, intervalType, dateType}: {
+		returnType: DummyTimestamp,
+		fn: func(ctx EvalContext, left Datum, right Datum) (Datum, error) {
+			t, err := ctx.makeDTimestamp(right.(DDate))
+			if err != nil {
+				return nil, err
+			}
+			return DTimestamp{Time: t.Add(left.(DInterval).Duration)}, nil
+		},
+	},
 	binArgs{Plus, timestampType, intervalType}: {
 		returnType: DummyTimestamp,
 		fn: func(_ EvalContext, left Datum, right Datum) (Datum, error) {
@@ -196,6 +217,16 @@ var binOps = map[binArgs]binOp{
 			return DInterval{Duration: left.(DTimestamp).Sub(right.(DTimestamp).Time)}, nil
 		},
 	},
+	binArgs{Minus, dateType, intervalType}: {
+		returnType: DummyTimestamp,
+		fn: func(ctx EvalContext, left Datum, right Datum) (Datum, error) {
+			t, err := ctx.makeDTimestamp(left.(DDate))
+			if err != nil {
+				return nil, err
+			}
+			return DTimestamp{Time: t.Add(-right.(DInterval).Duration)}, nil
+		},
+	},
 	binArgs{Minus, timestampType, intervalType}: {
 		returnType: DummyTimestamp,
 		fn: func(_ EvalContext, left Datum, right Datum) (Datum, error) {
@@ -233,6 +264,18 @@ var binOps = map[binArgs]binOp{
 			return DInterval{Duration: left.(DInterval).Duration * time.Duration(right.(DInt))}, nil
 		},
 	},
+	binArgs{Mult, floatType, intervalType}: {
+		returnType: DummyInterval,
+		fn: func(_ EvalContext, left Datum, right Datum) (Datum, error) {
+			return DInterval{Duration: time.Duration(float64(left.(DFloat)) * float64(right.(DInterval).Duration))}, nil
+		},
+	},
+	binArgs{Mult, intervalType, floatType}: {
+		returnType: DummyInterval,
+		fn: func(_ EvalContext, left Datum, right Datum) (Datum, error) {
+			return DInterval{Duration: time.Duration(float64(left.(DInterval).Duration) * float64(right.(DFloat)))}, nil
+		},
+	},
 
 	binArgs{Div, intType, intType}: {
 		returnType: DummyFloat,
@@ -260,6 +303,16 @@ var binOps = map[binArgs]binOp{
 			return DInterval{Duration: left.(DInterval).Duration / time.Duration(rInt)}, nil
 		},
 	},
+	binArgs{Div, intervalType, floatType}: {
+		returnType: DummyInterval,
+		fn: func(_ EvalContext, left Datum, right Datum) (Datum, error) {
+			rFloat := right.(DFloat)
+			if rFloat == 0 {
+				return nil, errDivByZero
+			}
+			return DInterval{Duration: time.Duration(float64(left.(DInterval).Duration) / float64(rFloat))}, nil
+		},
+	},
 
 	binArgs{Mod, intType, intType}: {
 		returnType: DummyInt,
@@ -291,6 +344,23 @@ var binOps = map[binArgs]binOp{
 		},
 	},
 
+	binArgs{FetchVal, jsonType, stringType}: {
+		returnType: DummyJSON,
+		fn:         evalFetchVal,
+	},
+	binArgs{FetchVal, jsonType, intType}: {
+		returnType: DummyJSON,
+		fn:         evalFetchVal,
+	},
+	binArgs{FetchText, jsonType, stringType}: {
+		returnType: DummyString,
+		fn:         evalFetchText,
+	},
+	binArgs{FetchText, jsonType, intType}: {
+		returnType: DummyString,
+		fn:         evalFetchText,
+	},
+
 	// TODO(pmattis): Check that the shift is valid.
 	binArgs{LShift, intType, intType}: {
 		returnType: DummyInt,
@@ -361,6 +431,16 @@ var cmpOps = map[cmpArgs]cmpOp{
 			return DBool(left.(DInterval) == right.(DInterval)), nil
 		},
 	},
+	cmpArgs{EQ, jsonType, jsonType}: {
+		fn: func(_ EvalContext, left Datum, right Datum) (DBool, error) {
+			return DBool(left.(DJSON) == right.(DJSON)), nil
+		},
+	},
+	cmpArgs{EQ, uuidType, uuidType}: {
+		fn: func(_ EvalContext, left Datum, right Datum) (DBool, error) {
+			return DBool(left.(DUUID) == right.(DUUID)), nil
+		},
+	},
 
 	cmpArgs{LT, stringType, stringType}: {
 		fn: func(_ EvalContext, left Datum, right Datum) (DBool, error) {
@@ -402,6 +482,16 @@ var cmpOps = map[cmpArgs]cmpOp{
 			return DBool(left.(DInterval).Duration < right.(DInterval).Duration), nil
 		},
 	},
+	cmpArgs{LT, jsonType, jsonType}: {
+		fn: func(_ EvalContext, left Datum, right Datum) (DBool, error) {
+			return DBool(left.(DJSON) < right.(DJSON)), nil
+		},
+	},
+	cmpArgs{LT, uuidType, uuidType}: {
+		fn: func(_ EvalContext, left Datum, right Datum) (DBool, error) {
+			return DBool(left.Compare(right) < 0), nil
+		},
+	},
 
 	cmpArgs{LE, stringType, stringType}: {
 		fn: func(_ EvalContext, left Datum, right Datum) (DBool, error) {
@@ -443,6 +533,16 @@ var cmpOps = map[cmpArgs]cmpOp{
 			return DBool(left.(DInterval).Duration <= right.(DInterval).Duration), nil
 		},
 	},
+	cmpArgs{LE, jsonType, jsonType}: {
+		fn: func(_ EvalContext, left Datum, right Datum) (DBool, error) {
+			return DBool(left.(DJSON) <= right.(DJSON)), nil
+		},
+	},
+	cmpArgs{LE, uuidType, uuidType}: {
+		fn: func(_ EvalContext, left Datum, right Datum) (DBool, error) {
+			return DBool(left.Compare(right) <= 0), nil
+		},
+	},
 
 	cmpArgs{Like, stringType, stringType}: {
 		fn: func(ctx EvalContext, left Datum, right Datum) (DBool, error) {
@@ -465,6 +565,23 @@ var cmpOps = map[cmpArgs]cmpOp{
 			return DBool(re.MatchString(string(left.(DString)))), nil
 		},
 	},
+
+	cmpArgs{Contains, jsonType, jsonType}: {
+		fn: func(_ EvalContext, left Datum, right Datum) (DBool, error) {
+			return DBool(jsonContains(left.(DJSON).decode(), right.(DJSON).decode())), nil
+		},
+	},
+	// A string on the right side of @> is parsed as JSON, so that
+	// "attrs @> '{"a": 1}'" does not require a cast.
+	cmpArgs{Contains, jsonType, stringType}: {
+		fn: func(_ EvalContext, left Datum, right Datum) (DBool, error) {
+			v, err := ParseDJSON(string(right.(DString)))
+			if err != nil {
+				return DBool(false), err
+			}
+			return DBool(jsonContains(left.(DJSON).decode(), v.decode())), nil
+		},
+	},
 }
 
 var evalTupleEQ = cmpOp{
@@ -517,6 +634,22 @@ func init() {
 	cmpOps[cmpArgs{In, timestampType, tupleType}] = evalTupleIN
 	cmpOps[cmpArgs{In, intervalType, tupleType}] = evalTupleIN
 	cmpOps[cmpArgs{In, tupleType, tupleType}] = evalTupleIN
+
+	cmpOps[cmpArgs{EQ, arrayType, arrayType}] = cmpOp{
+		fn: func(_ EvalContext, left Datum, right Datum) (DBool, error) {
+			return DBool(left.Compare(right) == 0), nil
+		},
+	}
+	cmpOps[cmpArgs{LT, arrayType, arrayType}] = cmpOp{
+		fn: func(_ EvalContext, left Datum, right Datum) (DBool, error) {
+			return DBool(left.Compare(right) < 0), nil
+		},
+	}
+	cmpOps[cmpArgs{LE, arrayType, arrayType}] = cmpOp{
+		fn: func(_ EvalContext, left Datum, right Datum) (DBool, error) {
+			return DBool(left.Compare(right) <= 0), nil
+		},
+	}
 }
 
 // EvalContext defines the context in which to evaluate an expression, allowing
@@ -546,6 +679,17 @@ func (ctx EvalContext) makeDDate(t time.Time) (DDate, error) {
 	return DDate(secs / secondsInDay), nil
 }
 
+// makeDTimestamp returns the timestamp of midnight of the date in the time
+// zone of the session.
+func (ctx EvalContext) makeDTimestamp(d DDate) (DTimestamp, error) {
+	loc, err := ctx.GetLocation()
+	if err != nil {
+		return DummyTimestamp, err
+	}
+	year, month, day := time.Unix(int64(d)*secondsInDay, 0).UTC().Date()
+	return DTimestamp{Time: time.Date(year, month, day, 0, 0, 0, 0, loc)}, nil
+}
+
 // Eval implements the Expr interface.
 func (expr *AndExpr) Eval(ctx EvalContext) (Datum, error) {
 	left, err := expr.Left.Eval(ctx)
@@ -653,7 +797,7 @@ func (expr *CastExpr) Eval(ctx EvalContext) (Datum, error) {
 		return DNull, err
 	}
 
-	switch expr.Type.(type) {
+	switch t := expr.Type.(type) {
 	case *BoolType:
 		switch v := d.(type) {
 		case DBool:
@@ -717,6 +861,10 @@ func (expr *CastExpr) Eval(ctx EvalContext) (Datum, error) {
 			s = DString(d.String())
 		case DString:
 			s = t
+		case DJSON:
+			s = DString(t)
+		case DUUID:
+			s = DString(t.UUID().String())
 		case DBytes:
 			if !utf8.ValidString(string(t)) {
 				return nil, fmt.Errorf("invalid utf8: %q", string(t))
@@ -738,6 +886,8 @@ func (expr *CastExpr) Eval(ctx EvalContext) (Datum, error) {
 			return DBytes(t), nil
 		case DBytes:
 			return d, nil
+		case DUUID:
+			return DBytes(t[:]), nil
 		}
 
 	case *DateType:
@@ -753,12 +903,7 @@ func (expr *CastExpr) Eval(ctx EvalContext) (Datum, error) {
 		case DString:
 			return ctx.ParseTimestamp(d)
 		case DDate:
-			loc, err := ctx.GetLocation()
-			if err != nil {
-				return DNull, err
-			}
-			year, month, day := time.Unix(int64(d)*secondsInDay, 0).UTC().Date()
-			return DTimestamp{Time: time.Date(year, month, day, 0, 0, 0, 0, loc)}, nil
+			return ctx.makeDTimestamp(d)
 		}
 
 	case *IntervalType:
@@ -773,6 +918,54 @@ func (expr *CastExpr) Eval(ctx EvalContext) (Datum, error) {
 			// An integer duration represents a duration in nanoseconds.
 			return DInterval{Duration: time.Duration(d.(DInt))}, nil
 		}
+
+	case *JSONType:
+		switch d := d.(type) {
+		case DString:
+			return ParseDJSON(string(d))
+		case DJSON:
+			return d, nil
+		}
+
+	case *UUIDType:
+		switch d := d.(type) {
+		case DString:
+			return ParseDUUID(string(d))
+		case DBytes:
+			u, err := uuid.FromBytes([]byte(d))
+			if err != nil {
+				return DNull, err
+			}
+			return MakeDUUID(u), nil
+		case DUUID:
+			return d, nil
+		}
+
+	case *ArrayType:
+		elemType, err := t.elemDummy()
+		if err != nil {
+			return DNull, err
+		}
+		switch d := d.(type) {
+		case dNull:
+			return d, nil
+		case DString:
+			return ParseDArray(ctx, string(d), t.ElemType)
+		case DArray:
+			a := DArray{ElemType: elemType, Elems: make(DTuple, len(d.Elems))}
+			for i, elem := range d.Elems {
+				if elem == DNull {
+					a.Elems[i] = DNull
+					continue
+				}
+				cast := &CastExpr{Expr: elem, Type: t.ElemType}
+				if a.Elems[i], err = cast.Eval(ctx); err != nil {
+					return DNull, err
+				}
+			}
+			return a, nil
+		}
+
 		// TODO(pmattis): unimplemented.
 		// case *DecimalType:
 	}
@@ -794,6 +987,62 @@ func (expr *CoalesceExpr) Eval(ctx EvalContext) (Datum, error) {
 	return DNull, nil
 }
 
+// Eval implements the Expr interface.
+func (expr *AnyExpr) Eval(ctx EvalContext) (Datum, error) {
+	left, err := expr.Left.Eval(ctx)
+	if err != nil {
+		return DNull, err
+	}
+	right, err := expr.Right.Eval(ctx)
+	if err != nil {
+		return DNull, err
+	}
+	if left == DNull || right == DNull {
+		return DNull, nil
+	}
+	array, ok := right.(DArray)
+	if !ok {
+		return DNull, fmt.Errorf("op %s (array) requires an array on the right side, not %s",
+			expr.Type, right.Type())
+	}
+
+	// ANY is true if the comparison is true for one of the elements, and ALL
+	// is false if the comparison is false for one of the elements. Otherwise
+	// the result is NULL if one of the elements is NULL.
+	all := expr.Type == "ALL"
+	sawNull := false
+	for _, elem := range array.Elems {
+		if elem == DNull {
+			sawNull = true
+			continue
+		}
+		// Make sure the expression's cmpOp function is memoized. The type of the
+		// elements of the array may not be known before evaluation.
+		if expr.fn.fn == nil {
+			if _, expr.fn, err = typeCheckComparisonOp(expr.Operator, left, elem); err != nil {
+				return DNull, err
+			}
+		}
+		_, newLeft, newRight, not := foldComparisonExpr(expr.Operator, left, elem)
+		d, err := expr.fn.fn(ctx, newLeft, newRight)
+		if err != nil {
+			return DNull, err
+		}
+		if bool(d) == not {
+			// The comparison is false.
+			if all {
+				return DBool(false), nil
+			}
+		} else if !all {
+			return DBool(true), nil
+		}
+	}
+	if sawNull {
+		return DNull, nil
+	}
+	return DBool(all), nil
+}
+
 // Eval implements the Expr interface.
 func (expr *ComparisonExpr) Eval(ctx EvalContext) (Datum, error) {
 	left, err := expr.Left.Eval(ctx)
@@ -962,6 +1211,20 @@ func (expr *IsOfTypeExpr) Eval(ctx EvalContext) (Datum, error) {
 				return result, nil
 			}
 		}
+
+	case DJSON:
+		for _, t := range expr.Types {
+			if _, ok := t.(*JSONType); ok {
+				return result, nil
+			}
+		}
+
+	case DUUID:
+		for _, t := range expr.Types {
+			if _, ok := t.(*UUIDType); ok {
+				return result, nil
+			}
+		}
 	}
 
 	return !result, nil
@@ -1071,8 +1334,83 @@ func (expr *UnaryExpr) Eval(ctx EvalContext) (Datum, error) {
 }
 
 // Eval implements the Expr interface.
-func (t Array) Eval(_ EvalContext) (Datum, error) {
-	return nil, util.Errorf("unhandled type %T", t)
+func (expr *IndirectionExpr) Eval(ctx EvalContext) (Datum, error) {
+	d, err := expr.Expr.Eval(ctx)
+	if err != nil {
+		return DNull, err
+	}
+	begin, err := expr.Indirection.Begin.Eval(ctx)
+	if err != nil {
+		return DNull, err
+	}
+	end := begin
+	if expr.Indirection.End != nil {
+		if end, err = expr.Indirection.End.Eval(ctx); err != nil {
+			return DNull, err
+		}
+	}
+	if d == DNull || begin == DNull || end == DNull {
+		return DNull, nil
+	}
+	array, ok := d.(DArray)
+	if !ok {
+		return DNull, fmt.Errorf("cannot subscript type %s because it is not an array", d.Type())
+	}
+	// Array subscripts start at 1. As in Postgres, a subscript out of the
+	// bounds of the array yields NULL while a slice is clamped to the bounds of
+	// the array.
+	i, ok := begin.(DInt)
+	if !ok {
+		return DNull, fmt.Errorf("array subscript must have type int, not %s", begin.Type())
+	}
+	j, ok := end.(DInt)
+	if !ok {
+		return DNull, fmt.Errorf("array subscript must have type int, not %s", end.Type())
+	}
+	if expr.Indirection.End == nil {
+		if i < 1 || int(i) > len(array.Elems) {
+			return DNull, nil
+		}
+		return array.Elems[i-1], nil
+	}
+	if i < 1 {
+		i = 1
+	}
+	if int(j) > len(array.Elems) {
+		j = DInt(len(array.Elems))
+	}
+	slice := DArray{ElemType: array.ElemType}
+	if i <= j {
+		slice.Elems = append(DTuple(nil), array.Elems[i-1:j]...)
+	}
+	return slice, nil
+}
+
+// Eval implements the Expr interface.
+func (t Array) Eval(ctx EvalContext) (Datum, error) {
+	dummy, err := t.TypeCheck()
+	if err != nil {
+		return DNull, err
+	}
+	a := DArray{ElemType: dummy.(DArray).ElemType, Elems: make(DTuple, 0, len(t))}
+	for _, e := range t {
+		d, err := e.Eval(ctx)
+		if err != nil {
+			return DNull, err
+		}
+		if d != DNull {
+			elemType, _ := d.TypeCheck()
+			if a.ElemType == DNull {
+				// The type of the element is unknown until evaluation, as for a
+				// placeholder.
+				a.ElemType = elemType
+			} else if !SameType(a.ElemType, elemType) {
+				return DNull, fmt.Errorf("ARRAY types %s and %s cannot be matched", a.ElemType.Type(), d.Type())
+			}
+		}
+		a.Elems = append(a.Elems, d)
+	}
+	return a, nil
 }
 
 // Eval implements the Expr interface.
@@ -1120,6 +1458,11 @@ func (t ValArg) Eval(_ EvalContext) (Datum, error) {
 	return nil, util.Errorf("unhandled type %T", t)
 }
 
+// Eval implements the Expr interface.
+func (t DArray) Eval(_ EvalContext) (Datum, error) {
+	return t, nil
+}
+
 // Eval implements the Expr interface.
 func (t DBool) Eval(_ EvalContext) (Datum, error) {
 	return t, nil
@@ -1150,6 +1493,16 @@ func (t DInterval) Eval(_ EvalContext) (Datum, error) {
 	return t, nil
 }
 
+// Eval implements the Expr interface.
+func (t DJSON) Eval(_ EvalContext) (Datum, error) {
+	return t, nil
+}
+
+// Eval implements the Expr interface.
+func (t DUUID) Eval(_ EvalContext) (Datum, error) {
+	return t, nil
+}
+
 // Eval implements the Expr interface.
 func (t dNull) Eval(_ EvalContext) (Datum, error) {
 	return t, nil
@@ -297,6 +297,22 @@ func TestEval(t *testing.T) {
 		{`'1h'::interval - '12h2m1s23ms'::interval`, `-11h2m1.023s`},
 		{`3 * '1h2m'::interval * 3`, `9h18m0s`},
 		{`'3h'::interval / 2`, `1h30m0s`},
+		{`'2010-09-28'::date + '12h'::interval`, `2010-09-28 12:00:00+00:00`},
+		{`'2010-09-28'::date - '12h'::interval`, `2010-09-27 12:00:00+00:00`},
+		{`1.5 * '1h'::interval`, `1h30m0s`},
+		{`'3h'::interval / 2.0`, `1h30m0s`},
+		{`' { "b" : [1, 2], "a" : "x" } '::json`, `'{"a":"x","b":[1,2]}'::JSON`},
+		{`'{"a": "<b> & \\u003c"}'::json`, `e'{"a":"<b> & \\\\u003c"}'::JSON`},
+		{`'{"a": [1, {"b": 2}]}'::json -> 'a' -> 1`, `'{"b":2}'::JSON`},
+		{`'{"a": [1, {"b": 2}]}'::json -> 'a' ->> 0`, `'1'`},
+		{`'{"a": "x"}'::json ->> 'a'`, `'x'`},
+		{`'{"a": "x"}'::json -> 'b'`, `NULL`},
+		{`'{"a": 1, "b": 2}'::json @> '{"b": 2}'`, `true`},
+		{`'{"a": 1, "b": 2}'::json @> '{"b": 1}'`, `false`},
+		{`'63616665-6630-3064-6465-616462656566'::uuid`, `'63616665-6630-3064-6465-616462656566'::UUID`},
+		{`'{63616665-6630-3064-6465-616462656566}'::uuid::string`, `'63616665-6630-3064-6465-616462656566'`},
+		{`'63616665663030646465616462656566'::uuid = b'cafef00ddeadbeef'::uuid`, `true`},
+		{`'00000000-0000-0000-0000-000000000001'::uuid < '00000000-0000-0000-0000-000000000002'::uuid`, `true`},
 		// Conditional expressions.
 		{`IF(true, 1, 2/0)`, `1`},
 		{`IF(false, 1/0, 2)`, `2`},
@@ -327,6 +343,9 @@ func TestEval(t *testing.T) {
 		{`'NaN'::float(4)`, `NaN`},
 		{`'NaN'::real`, `NaN`},
 		{`'NaN'::double precision`, `NaN`},
+		// Each character of the literal text of a template matches a character.
+		{`to_timestamp('2001年04月10日', 'YYYY"年"MM"月"DD"日"')`, `2001-04-10 00:00:00+00:00`},
+		{`to_timestamp('2001-04-10', 'YYYY年MM月DD')`, `2001-04-10 00:00:00+00:00`},
 	}
 	for _, d := range testData {
 		q, err := ParseTraditional("SELECT " + d.expr)
@@ -111,6 +111,7 @@ const (
 	IsNotDistinctFrom
 	Is
 	IsNot
+	Contains
 )
 
 var comparisonOpName = [...]string{
@@ -130,6 +131,7 @@ var comparisonOpName = [...]string{
 	IsNotDistinctFrom: "IS NOT DISTINCT FROM",
 	Is:                "IS",
 	IsNot:             "IS NOT",
+	Contains:          "@>",
 }
 
 func (i ComparisonOp) String() string {
@@ -150,6 +152,20 @@ func (node *ComparisonExpr) String() string {
 	return fmt.Sprintf("%s %s %s", node.Left, node.Operator, node.Right)
 }
 
+// AnyExpr represents the comparison of a value against the elements of an
+// array: "<left> <op> ANY (<array>)", with SOME a synonym for ANY, or "<left>
+// <op> ALL (<array>)".
+type AnyExpr struct {
+	Operator    ComparisonOp
+	Type        string
+	Left, Right Expr
+	fn          cmpOp
+}
+
+func (node *AnyExpr) String() string {
+	return fmt.Sprintf("%s %s %s (%s)", node.Left, node.Operator, node.Type, node.Right)
+}
+
 // RangeCond represents a BETWEEN or a NOT BETWEEN expression.
 type RangeCond struct {
 	Not      bool
@@ -465,6 +481,18 @@ func (n *QualifiedName) Column() string {
 	return string(n.Indirect[0].(NameIndirection))
 }
 
+// ArraySubscript returns the array subscript of a column name of the form
+// table.column[array-indirection], or nil if there is none.
+func (n *QualifiedName) ArraySubscript() *ArrayIndirection {
+	if n.normalized != columnName {
+		panic(fmt.Sprintf("%s is not a column name", n))
+	}
+	if len(n.Indirect) != 2 {
+		return nil
+	}
+	return n.Indirect[1].(*ArrayIndirection)
+}
+
 // IsStar returns true iff the qualified name contains matches "".* or table.*.
 func (n *QualifiedName) IsStar() bool {
 	if n.normalized != columnName {
@@ -517,6 +545,17 @@ func (node Row) String() string {
 	return fmt.Sprintf("ROW(%s)", Exprs(node))
 }
 
+// IndirectionExpr represents the subscript of an array: "<expr>[<index>]" or
+// "<expr>[<begin>:<end>]".
+type IndirectionExpr struct {
+	Expr        Expr
+	Indirection *ArrayIndirection
+}
+
+func (node *IndirectionExpr) String() string {
+	return fmt.Sprintf("%s%s", node.Expr, node.Indirection)
+}
+
 // Array represents an array constructor.
 type Array Exprs
 
@@ -563,20 +602,24 @@ const (
 	Concat
 	LShift
 	RShift
+	FetchVal
+	FetchText
 )
 
 var binaryOpName = [...]string{
-	Bitand: "&",
-	Bitor:  "|",
-	Bitxor: "^",
-	Plus:   "+",
-	Minus:  "-",
-	Mult:   "*",
-	Div:    "/",
-	Mod:    "%",
-	Concat: "||",
-	LShift: "<<",
-	RShift: ">>",
+	Bitand:    "&",
+	Bitor:     "|",
+	Bitxor:    "^",
+	Plus:      "+",
+	Minus:     "-",
+	Mult:      "*",
+	Div:       "/",
+	Mod:       "%",
+	Concat:    "||",
+	LShift:    "<<",
+	RShift:    ">>",
+	FetchVal:  "->",
+	FetchText: "->>",
 }
 
 func (i BinaryOp) String() string {
@@ -650,6 +693,13 @@ func (node *FuncExpr) String() string {
 	return fmt.Sprintf("%s(%s%s)", node.Name, distinct, node.Exprs)
 }
 
+// IsImpure returns true if the function potentially returns a different
+// value when called in the same statement with the same parameters. The
+// expression must have been type checked.
+func (node *FuncExpr) IsImpure() bool {
+	return node.fn.impure
+}
+
 // CaseExpr represents a CASE expression.
 type CaseExpr struct {
 	Expr  Expr
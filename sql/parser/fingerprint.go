@@ -0,0 +1,71 @@
+// Copyright 2015 The Cockroach Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+// implied. See the License for the specific language governing
+// permissions and limitations under the License. See the AUTHORS file
+// for names of contributors.
+
+package parser
+
+import "fmt"
+
+// constPlaceholder replaces constants when computing a statement
+// fingerprint. It is never evaluated.
+type constPlaceholder struct{}
+
+func (constPlaceholder) String() string {
+	return "_"
+}
+
+func (constPlaceholder) Walk(_ Visitor) {}
+
+func (constPlaceholder) TypeCheck() (Datum, error) {
+	return nil, fmt.Errorf("cannot type check fingerprint placeholder")
+}
+
+func (constPlaceholder) Eval(_ EvalContext) (Datum, error) {
+	return nil, fmt.Errorf("cannot evaluate fingerprint placeholder")
+}
+
+type fingerprintVisitor struct{}
+
+var _ Visitor = fingerprintVisitor{}
+
+func (v fingerprintVisitor) Visit(expr Expr, pre bool) (Visitor, Expr) {
+	if !pre {
+		return v, expr
+	}
+	switch t := expr.(type) {
+	case IntVal, NumVal, ValArg:
+		return nil, constPlaceholder{}
+	case Datum:
+		if t == DNull {
+			return nil, expr
+		}
+		return nil, constPlaceholder{}
+	}
+	return v, expr
+}
+
+// FingerprintStatement returns the fingerprint of a statement: its textual
+// representation with all constants and placeholders replaced by "_", so that
+// statements which differ only in their constants share a fingerprint. The
+// supplied statement is not modified.
+func FingerprintStatement(stmt Statement) string {
+	// Walking a statement modifies it in place, so work on a freshly parsed
+	// copy.
+	stmts, err := ParseTraditional(stmt.String())
+	if err != nil || len(stmts) != 1 {
+		return stmt.String()
+	}
+	WalkStmt(fingerprintVisitor{}, stmts[0])
+	return stmts[0].String()
+}
@@ -0,0 +1,49 @@
+// Copyright 2015 The Cockroach Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+// implied. See the License for the specific language governing
+// permissions and limitations under the License. See the AUTHORS file
+// for names of contributors.
+
+package parser
+
+import "testing"
+
+func TestFingerprintStatement(t *testing.T) {
+	testData := []struct {
+		sql      string
+		expected string
+	}{
+		{`SELECT 1`, `SELECT _`},
+		{`SELECT a FROM t WHERE b = 'foo' AND c > 1.5`,
+			`SELECT a FROM t WHERE b = _ AND c > _`},
+		{`SELECT a FROM t WHERE b IS NULL LIMIT 10`,
+			`SELECT a FROM t WHERE b IS NULL LIMIT _`},
+		{`SELECT a FROM t WHERE b IN (1, 2, 3)`, `SELECT a FROM t WHERE b IN (_, _, _)`},
+		{`INSERT INTO t VALUES (1, 'a'), ($1, $2)`, `INSERT INTO t VALUES (_, _), (_, _)`},
+		{`UPDATE t SET a = a + 1 WHERE b = true`, `UPDATE t SET a = a + _ WHERE b = _`},
+		{`DELETE FROM t WHERE a < DATE '2015-01-01'`, `DELETE FROM t WHERE a < CAST(_ AS DATE)`},
+		{`CREATE TABLE t (a INT PRIMARY KEY)`, `CREATE TABLE t (a INT PRIMARY KEY)`},
+	}
+	for _, d := range testData {
+		stmts, err := ParseTraditional(d.sql)
+		if err != nil {
+			t.Fatalf("%s: %v", d.sql, err)
+		}
+		before := stmts.String()
+		if s := FingerprintStatement(stmts[0]); d.expected != s {
+			t.Errorf("%s: expected %s, but found %s", d.sql, d.expected, s)
+		}
+		if after := stmts.String(); before != after {
+			t.Errorf("%s: statement was modified: %s", d.sql, after)
+		}
+	}
+}
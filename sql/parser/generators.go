@@ -0,0 +1,165 @@
+// Copyright 2015 The Cockroach Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+// implied. See the License for the specific language governing
+// permissions and limitations under the License. See the AUTHORS file
+// for names of contributors.
+
+package parser
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"reflect"
+	"strings"
+)
+
+var errZeroStep = errors.New("step size cannot equal zero")
+
+// A generator is a set-returning function. It returns a DTuple holding the
+// generated values, one per row, but stops after limit values so that the
+// caller bounds the memory used by the values.
+type generator struct {
+	types typeList
+	fn    func(args DTuple, limit int) (DTuple, error)
+}
+
+func (g generator) match(types typeList) bool {
+	return builtin{types: g.types}.match(types)
+}
+
+// generators is a map from name to slice of generators for the set-returning
+// functions, which can only be used as tables in the FROM clause.
+var generators = map[string][]generator{
+	"generate_series": {
+		generator{
+			types: typeList{intType, intType},
+			fn: func(args DTuple, limit int) (DTuple, error) {
+				return generateIntSeries(args[0].(DInt), args[1].(DInt), 1, limit)
+			},
+		},
+		generator{
+			types: typeList{intType, intType, intType},
+			fn: func(args DTuple, limit int) (DTuple, error) {
+				return generateIntSeries(args[0].(DInt), args[1].(DInt), args[2].(DInt), limit)
+			},
+		},
+		generator{
+			types: typeList{timestampType, timestampType, intervalType},
+			fn: func(args DTuple, limit int) (DTuple, error) {
+				start := args[0].(DTimestamp).Time
+				stop := args[1].(DTimestamp).Time
+				step := args[2].(DInterval).Duration
+				if step == 0 {
+					return nil, errZeroStep
+				}
+				var series DTuple
+				for t := start; len(series) < limit &&
+					((step > 0 && !t.After(stop)) || (step < 0 && !t.Before(stop))); t = t.Add(step) {
+					series = append(series, DTimestamp{Time: t})
+				}
+				return series, nil
+			},
+		},
+	},
+
+	"unnest": {
+		generator{
+			types: typeList{arrayType},
+			fn: func(args DTuple, limit int) (DTuple, error) {
+				elems := args[0].(DArray).Elems
+				if len(elems) > limit {
+					elems = elems[:limit]
+				}
+				return elems, nil
+			},
+		},
+		generator{
+			types: typeList{tupleType},
+			fn: func(args DTuple, limit int) (DTuple, error) {
+				tuple := args[0].(DTuple)
+				if len(tuple) > limit {
+					tuple = tuple[:limit]
+				}
+				return tuple, nil
+			},
+		},
+	},
+}
+
+func generateIntSeries(start, stop, step DInt, limit int) (DTuple, error) {
+	if step == 0 {
+		return nil, errZeroStep
+	}
+	var series DTuple
+	for i := start; len(series) < limit && ((step > 0 && i <= stop) || (step < 0 && i >= stop)); {
+		series = append(series, i)
+		// Stop before the next value overflows.
+		if (step > 0 && i > math.MaxInt64-step) || (step < 0 && i < math.MinInt64-step) {
+			break
+		}
+		i += step
+	}
+	return series, nil
+}
+
+// EvalGenerator evaluates a function used as a table in the FROM clause and
+// returns the values of the rows of the table, at most limit of them.
+// Set-returning functions produce a row for each of their values, while other
+// functions produce a single row. A set-returning function produces no rows
+// if one of its arguments is NULL.
+func (expr *FuncExpr) EvalGenerator(ctx EvalContext, limit int) (DTuple, error) {
+	name := strings.ToLower(string(expr.Name.Base))
+	candidates, ok := generators[name]
+	if !ok || len(expr.Name.Indirect) > 0 {
+		if _, err := expr.TypeCheck(); err != nil {
+			return nil, err
+		}
+		d, err := expr.Eval(ctx)
+		if err != nil {
+			return nil, err
+		}
+		return DTuple{d}, nil
+	}
+
+	args := make(DTuple, 0, len(expr.Exprs))
+	types := make(typeList, 0, len(expr.Exprs))
+	for _, e := range expr.Exprs {
+		if _, err := e.TypeCheck(); err != nil {
+			return nil, err
+		}
+		arg, err := e.Eval(ctx)
+		if err != nil {
+			return nil, err
+		}
+		if arg == DNull {
+			return nil, nil
+		}
+		args = append(args, arg)
+		types = append(types, reflect.TypeOf(arg))
+	}
+	for _, candidate := range candidates {
+		if candidate.match(types) {
+			res, err := candidate.fn(args, limit)
+			if err != nil {
+				return nil, fmt.Errorf("%s: %v", expr.Name, err)
+			}
+			return res, nil
+		}
+	}
+	typeNames := make([]string, 0, len(args))
+	for _, arg := range args {
+		typeNames = append(typeNames, arg.Type())
+	}
+	return nil, fmt.Errorf("unknown signature for %s: %s(%s)",
+		expr.Name, expr.Name, strings.Join(typeNames, ", "))
+}
@@ -0,0 +1,40 @@
+// Copyright 2015 The Cockroach Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+// implied. See the License for the specific language governing
+// permissions and limitations under the License. See the AUTHORS file
+// for names of contributors.
+
+package parser
+
+import (
+	"bytes"
+	"fmt"
+)
+
+// Import represents an IMPORT statement.
+type Import struct {
+	Table *QualifiedName
+	// CreateFile is the location of a file holding the CREATE TABLE statement
+	// for the imported table.
+	CreateFile string
+	// Files are the locations of the CSV files holding the table data.
+	Files []string
+}
+
+func (node *Import) String() string {
+	var buf bytes.Buffer
+	fmt.Fprintf(&buf, "IMPORT TABLE %s CREATE USING %s CSV DATA (",
+		node.Table, encodeSQLString(node.CreateFile))
+	writeStringList(&buf, node.Files)
+	buf.WriteString(")")
+	return buf.String()
+}
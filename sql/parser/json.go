@@ -0,0 +1,321 @@
+// Copyright 2015 The Cockroach Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+// implied. See the License for the specific language governing
+// permissions and limitations under the License. See the AUTHORS file
+// for names of contributors.
+
+package parser
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"math"
+	"strconv"
+	"strings"
+)
+
+// ParseDJSON parses and validates the text of a JSON value, returning the
+// value in canonical form: object keys are sorted and insignificant
+// whitespace is removed. Two JSON values which differ only in these respects
+// are equal.
+func ParseDJSON(s string) (DJSON, error) {
+	v, err := decodeJSON(s)
+	if err != nil {
+		return "", fmt.Errorf("invalid JSON %q: %v", s, err)
+	}
+	return makeDJSON(v)
+}
+
+// decodeJSON decodes the text of a JSON value. Numbers are decoded as
+// json.Number so that they are reproduced exactly when re-encoded.
+func decodeJSON(s string) (interface{}, error) {
+	dec := json.NewDecoder(strings.NewReader(s))
+	dec.UseNumber()
+	var v interface{}
+	if err := dec.Decode(&v); err != nil {
+		if err == io.EOF {
+			err = io.ErrUnexpectedEOF
+		}
+		return nil, err
+	}
+	if _, err := dec.Token(); err != io.EOF {
+		return nil, fmt.Errorf("unexpected data after the value")
+	}
+	return v, nil
+}
+
+// makeDJSON encodes a decoded JSON value in canonical form.
+func makeDJSON(v interface{}) (DJSON, error) {
+	b, err := json.Marshal(v)
+	if err != nil {
+		return "", err
+	}
+	return DJSON(unescapeHTML(b)), nil
+}
+
+// unescapeHTML undoes the escaping of <, > and & performed by json.Marshal,
+// which keeps the canonical text of a JSON value the same as its input.
+func unescapeHTML(b []byte) []byte {
+	if !bytes.Contains(b, []byte(`\u00`)) {
+		return b
+	}
+	var buf bytes.Buffer
+	for i := 0; i < len(b); i++ {
+		if b[i] != '\\' || i+1 == len(b) {
+			buf.WriteByte(b[i])
+			continue
+		}
+		if b[i+1] == 'u' && i+6 <= len(b) {
+			switch string(b[i+2 : i+6]) {
+			case "003c":
+				buf.WriteByte('<')
+				i += 5
+				continue
+			case "003e":
+				buf.WriteByte('>')
+				i += 5
+				continue
+			case "0026":
+				buf.WriteByte('&')
+				i += 5
+				continue
+			}
+		}
+		// Copy the other escape sequences, including an escaped backslash,
+		// unchanged.
+		buf.WriteByte(b[i])
+		buf.WriteByte(b[i+1])
+		i++
+	}
+	return buf.Bytes()
+}
+
+// decode returns the decoded JSON value. The text of a DJSON has always been
+// validated, so decoding cannot fail.
+func (d DJSON) decode() interface{} {
+	v, err := decodeJSON(string(d))
+	if err != nil {
+		panic(fmt.Sprintf("invalid JSON datum %q: %v", string(d), err))
+	}
+	return v
+}
+
+// jsonFetch returns the field of a JSON object named by a string key, or the
+// element of a JSON array at an integer index. Negative indexes count from
+// the end of the array. The second return value is false if there is no such
+// field or element.
+func jsonFetch(v interface{}, key Datum) (interface{}, bool) {
+	switch t := v.(type) {
+	case map[string]interface{}:
+		if k, ok := key.(DString); ok {
+			elem, ok := t[string(k)]
+			return elem, ok
+		}
+	case []interface{}:
+		if i, ok := key.(DInt); ok {
+			if i < 0 {
+				i += DInt(len(t))
+			}
+			if i >= 0 && i < DInt(len(t)) {
+				return t[i], true
+			}
+		}
+	}
+	return nil, false
+}
+
+// jsonFetchPath follows a path of keys from a JSON value, as for
+// json_extract_path. The keys are text: a key selects the element of an
+// array if it is an integer.
+func jsonFetchPath(v interface{}, path DTuple) (interface{}, bool, error) {
+	for _, key := range path {
+		if key == DNull {
+			return nil, false, nil
+		}
+		k, ok := key.(DString)
+		if !ok {
+			return nil, false, fmt.Errorf("path element must have type string, not %s", key.Type())
+		}
+		var d Datum = k
+		if _, ok := v.([]interface{}); ok {
+			i, err := strconv.Atoi(string(k))
+			if err != nil {
+				return nil, false, nil
+			}
+			d = DInt(i)
+		}
+		if v, ok = jsonFetch(v, d); !ok {
+			return nil, false, nil
+		}
+	}
+	return v, true, nil
+}
+
+// evalFetchVal implements the -> operator, returning the field or element of
+// a JSON value (see jsonFetch) as JSON, or NULL if there is none.
+func evalFetchVal(_ EvalContext, left Datum, right Datum) (Datum, error) {
+	v, ok := jsonFetch(left.(DJSON).decode(), right)
+	if !ok {
+		return DNull, nil
+	}
+	return makeDJSON(v)
+}
+
+// evalFetchText implements the ->> operator, which is like -> but returns the
+// field or element as text (see jsonText).
+func evalFetchText(_ EvalContext, left Datum, right Datum) (Datum, error) {
+	v, ok := jsonFetch(left.(DJSON).decode(), right)
+	if !ok {
+		return DNull, nil
+	}
+	return jsonText(v)
+}
+
+// jsonText returns the text of a JSON value as used by the ->> operator:
+// strings are returned unquoted and JSON null is returned as NULL.
+func jsonText(v interface{}) (Datum, error) {
+	switch t := v.(type) {
+	case nil:
+		return DNull, nil
+	case string:
+		return DString(t), nil
+	}
+	d, err := makeDJSON(v)
+	return DString(d), err
+}
+
+// jsonContains returns whether the JSON value a contains the JSON value b,
+// as for the @> operator. An object contains another object if it has each
+// of its fields and each field value contains the other's. An array contains
+// another array if each element of the other array is contained by some
+// element of the array. As a special case, an array contains a scalar which
+// is one of its elements.
+func jsonContains(a, b interface{}) bool {
+	if arr, ok := a.([]interface{}); ok && isJSONScalar(b) {
+		for _, elem := range arr {
+			if jsonEqual(elem, b) {
+				return true
+			}
+		}
+	}
+	return jsonContainsValue(a, b)
+}
+
+func jsonContainsValue(a, b interface{}) bool {
+	switch t := b.(type) {
+	case map[string]interface{}:
+		obj, ok := a.(map[string]interface{})
+		if !ok {
+			return false
+		}
+		for k, v := range t {
+			elem, ok := obj[k]
+			if !ok || !jsonContainsValue(elem, v) {
+				return false
+			}
+		}
+		return true
+	case []interface{}:
+		arr, ok := a.([]interface{})
+		if !ok {
+			return false
+		}
+		for _, v := range t {
+			found := false
+			for _, elem := range arr {
+				if jsonContainsValue(elem, v) {
+					found = true
+					break
+				}
+			}
+			if !found {
+				return false
+			}
+		}
+		return true
+	}
+	return jsonEqual(a, b)
+}
+
+func isJSONScalar(v interface{}) bool {
+	switch v.(type) {
+	case map[string]interface{}, []interface{}:
+		return false
+	}
+	return true
+}
+
+// jsonEqual returns whether two JSON scalars are equal. Numbers are compared
+// by value, so that 1 and 1.0 are equal.
+func jsonEqual(a, b interface{}) bool {
+	switch t := a.(type) {
+	case json.Number:
+		u, ok := b.(json.Number)
+		if !ok {
+			return false
+		}
+		if t == u {
+			return true
+		}
+		x, err1 := t.Float64()
+		y, err2 := u.Float64()
+		return err1 == nil && err2 == nil && x == y
+	case string:
+		u, ok := b.(string)
+		return ok && t == u
+	case bool:
+		u, ok := b.(bool)
+		return ok && t == u
+	case nil:
+		return b == nil
+	}
+	return false
+}
+
+// datumToJSON converts a datum to a JSON value, as for the arguments of
+// json_build_object. Values without a JSON counterpart are converted to
+// their text.
+func datumToJSON(d Datum) (interface{}, error) {
+	switch t := d.(type) {
+	case dNull:
+		return nil, nil
+	case DBool:
+		return bool(t), nil
+	case DInt:
+		return json.Number(t.String()), nil
+	case DFloat:
+		if math.IsNaN(float64(t)) || math.IsInf(float64(t), 0) {
+			return nil, fmt.Errorf("cannot convert %s to JSON", t)
+		}
+		return json.Number(t.String()), nil
+	case DString:
+		return string(t), nil
+	case DBytes:
+		return string(t), nil
+	case DJSON:
+		return t.decode(), nil
+	case DArray:
+		elems := make([]interface{}, len(t.Elems))
+		for i, e := range t.Elems {
+			var err error
+			if elems[i], err = datumToJSON(e); err != nil {
+				return nil, err
+			}
+		}
+		return elems, nil
+	case DDate, DTimestamp, DInterval:
+		return d.String(), nil
+	}
+	return nil, fmt.Errorf("cannot convert %s to JSON", d.Type())
+}
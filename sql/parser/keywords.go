@@ -16,6 +16,7 @@ var keywords = map[string]int{
 	"ASC":               ASC,
 	"ASYMMETRIC":        ASYMMETRIC,
 	"AT":                AT,
+	"BACKUP":            BACKUP,
 	"BEGIN":             BEGIN,
 	"BETWEEN":           BETWEEN,
 	"BIGINT":            BIGINT,
@@ -39,11 +40,14 @@ var keywords = map[string]int{
 	"COLUMNS":           COLUMNS,
 	"COMMIT":            COMMIT,
 	"COMMITTED":         COMMITTED,
+	"CONFIGURATION":     CONFIGURATION,
+	"CONFIGURE":         CONFIGURE,
 	"CONFLICT":          CONFLICT,
 	"CONSTRAINT":        CONSTRAINT,
 	"COVERING":          COVERING,
 	"CREATE":            CREATE,
 	"CROSS":             CROSS,
+	"CSV":               CSV,
 	"CUBE":              CUBE,
 	"CURRENT":           CURRENT,
 	"CURRENT_CATALOG":   CURRENT_CATALOG,
@@ -75,6 +79,7 @@ var keywords = map[string]int{
 	"EXPLAIN":           EXPLAIN,
 	"EXTRACT":           EXTRACT,
 	"FALSE":             FALSE,
+	"FAMILY":            FAMILY,
 	"FETCH":             FETCH,
 	"FILTER":            FILTER,
 	"FIRST":             FIRST,
@@ -93,7 +98,9 @@ var keywords = map[string]int{
 	"HOUR":              HOUR,
 	"IF":                IF,
 	"IFNULL":            IFNULL,
+	"IMPORT":            IMPORT,
 	"IN":                IN,
+	"INCREMENTAL":       INCREMENTAL,
 	"INDEX":             INDEX,
 	"INITIALLY":         INITIALLY,
 	"INNER":             INNER,
@@ -101,12 +108,15 @@ var keywords = map[string]int{
 	"INT":               INT,
 	"INT64":             INT64,
 	"INTEGER":           INTEGER,
+	"INTERLEAVE":        INTERLEAVE,
 	"INTERSECT":         INTERSECT,
 	"INTERVAL":          INTERVAL,
 	"INTO":              INTO,
 	"IS":                IS,
 	"ISOLATION":         ISOLATION,
 	"JOIN":              JOIN,
+	"JSON":              JSON,
+	"JSONB":             JSONB,
 	"KEY":               KEY,
 	"LATERAL":           LATERAL,
 	"LEADING":           LEADING,
@@ -145,6 +155,7 @@ var keywords = map[string]int{
 	"OVER":              OVER,
 	"OVERLAPS":          OVERLAPS,
 	"OVERLAY":           OVERLAY,
+	"PARENT":            PARENT,
 	"PARTIAL":           PARTIAL,
 	"PARTITION":         PARTITION,
 	"PLACING":           PLACING,
@@ -158,8 +169,10 @@ var keywords = map[string]int{
 	"RECURSIVE":         RECURSIVE,
 	"REF":               REF,
 	"REFERENCES":        REFERENCES,
+	"RELEASE":           RELEASE,
 	"RENAME":            RENAME,
 	"REPEATABLE":        REPEATABLE,
+	"RESTORE":           RESTORE,
 	"RESTRICT":          RESTRICT,
 	"RETURNING":         RETURNING,
 	"REVOKE":            REVOKE,
@@ -168,6 +181,7 @@ var keywords = map[string]int{
 	"ROLLUP":            ROLLUP,
 	"ROW":               ROW,
 	"ROWS":              ROWS,
+	"SAVEPOINT":         SAVEPOINT,
 	"SEARCH":            SEARCH,
 	"SECOND":            SECOND,
 	"SELECT":            SELECT,
@@ -182,6 +196,7 @@ var keywords = map[string]int{
 	"SNAPSHOT":          SNAPSHOT,
 	"SOME":              SOME,
 	"SQL":               SQL,
+	"STORED":            STORED,
 	"STORING":           STORING,
 	"STRICT":            STRICT,
 	"STRING":            STRING,
@@ -209,6 +224,7 @@ var keywords = map[string]int{
 	"UPDATE":            UPDATE,
 	"USER":              USER,
 	"USING":             USING,
+	"UUID":              UUID,
 	"VALID":             VALID,
 	"VALIDATE":          VALIDATE,
 	"VALUE":             VALUE,
@@ -389,7 +389,7 @@ func (v *isConstVisitor) Visit(expr Expr, pre bool) (Visitor, Expr) {
 		}
 
 		switch t := expr.(type) {
-		case *Subquery:
+		case *Subquery, *ExistsExpr:
 			v.isConst = false
 			return nil, expr
 		case *FuncExpr:
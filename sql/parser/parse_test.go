@@ -39,6 +39,9 @@ func TestParse(t *testing.T) {
 		{`BEGIN TRANSACTION ISOLATION LEVEL SERIALIZABLE`},
 		{`COMMIT TRANSACTION`},
 		{`ROLLBACK TRANSACTION`},
+		{`SAVEPOINT cockroach_restart`},
+		{`RELEASE SAVEPOINT cockroach_restart`},
+		{`ROLLBACK TRANSACTION TO SAVEPOINT cockroach_restart`},
 
 		{`CREATE DATABASE a`},
 		{`CREATE DATABASE IF NOT EXISTS a`},
@@ -50,10 +53,19 @@ func TestParse(t *testing.T) {
 		{`CREATE UNIQUE INDEX a ON b (c)`},
 		{`CREATE UNIQUE INDEX a ON b (c) STORING (d)`},
 		{`CREATE UNIQUE INDEX a ON b.c (d)`},
+		{`CREATE INDEX ON a (b ASC, c DESC)`},
+		{`CREATE UNIQUE INDEX a ON b (c DESC) STORING (d)`},
+		{`CREATE INDEX a ON b (c) WHERE d IS NULL`},
+		{`CREATE UNIQUE INDEX a ON b (c) STORING (d) WHERE e > 1 AND f = 'x'`},
+		{`CREATE INDEX IF NOT EXISTS a ON b (c) WHERE d`},
 
 		{`CREATE TABLE a ()`},
 		{`CREATE TABLE a (b INT)`},
 		{`CREATE TABLE a (b INT, c INT)`},
+		{`CREATE TABLE a (b INT[], c STRING[])`},
+		{`CREATE TABLE a (b JSON, c JSONB)`},
+		{`CREATE TABLE a (b UUID DEFAULT gen_random_uuid())`},
+		{`CREATE TABLE a (b INT(8), c STRING(10))`},
 		{`CREATE TABLE a (b CHAR)`},
 		{`CREATE TABLE a (b CHAR(3))`},
 		{`CREATE TABLE a (b FLOAT)`},
@@ -64,17 +76,28 @@ func TestParse(t *testing.T) {
 		{`CREATE TABLE a (b INT NULL PRIMARY KEY)`},
 		{`CREATE TABLE a (b INT DEFAULT 1)`},
 		{`CREATE TABLE a (b INT DEFAULT now())`},
+		{`CREATE TABLE a (b INT, c INT AS (b + 1) STORED)`},
+		{`CREATE TABLE a (b STRING, c STRING NOT NULL UNIQUE AS (lower(b)) STORED)`},
 		// "0" lost quotes previously.
 		{`CREATE TABLE a (b INT, c TEXT, PRIMARY KEY (b, c, "0"))`},
 		{`CREATE TABLE a (b INT, c TEXT, INDEX (b, c))`},
 		{`CREATE TABLE a (b INT, c TEXT, INDEX d (b, c))`},
+		{`CREATE TABLE a (b INT, c TEXT, INDEX d (b DESC, c))`},
+		{`CREATE TABLE a (b INT, c TEXT, PRIMARY KEY (b DESC, c ASC))`},
+		{`CREATE TABLE a (b INT, c TEXT, CONSTRAINT d UNIQUE (b DESC))`},
+		{`CREATE TABLE a (b INT, c TEXT, INDEX d (b) WHERE c IS NOT NULL)`},
+		{`CREATE TABLE a (b INT, c TEXT, CONSTRAINT d UNIQUE (b) STORING (c) WHERE c != 'x')`},
 		{`CREATE TABLE a (b INT, c TEXT, CONSTRAINT d UNIQUE (b, c))`},
 		{`CREATE TABLE a (b INT, UNIQUE (b))`},
 		{`CREATE TABLE a (b INT, UNIQUE (b) STORING (c))`},
 		{`CREATE TABLE a (b INT, INDEX (b))`},
 		{`CREATE TABLE a (b INT, INDEX (b) STORING (c))`},
+		{`CREATE TABLE a (b INT, c INT, FAMILY (b, c))`},
+		{`CREATE TABLE a (b INT, c INT, d INT, FAMILY fam1 (b), FAMILY fam2 (c, d))`},
 		{`CREATE TABLE a.b (b INT)`},
 		{`CREATE TABLE IF NOT EXISTS a (b INT)`},
+		{`CREATE TABLE a (b INT, c INT, PRIMARY KEY (b, c)) INTERLEAVE IN PARENT d (b)`},
+		{`CREATE TABLE IF NOT EXISTS a (b INT PRIMARY KEY) INTERLEAVE IN PARENT db.c (b)`},
 
 		{`DELETE FROM a`},
 		{`DELETE FROM a.b`},
@@ -100,11 +123,15 @@ func TestParse(t *testing.T) {
 		{`SHOW DATABASES`},
 		{`SHOW TABLES`},
 		{`SHOW TABLES FROM a`},
+		{`SHOW ZONE CONFIGURATION FOR DATABASE a`},
+		{`SHOW ZONE CONFIGURATION FOR TABLE a.b`},
 		{`SHOW TABLES FROM a.b.c`},
 		{`SHOW COLUMNS FROM a`},
 		{`SHOW COLUMNS FROM a.b.c`},
 		{`SHOW INDEX FROM a`},
 		{`SHOW INDEX FROM a.b.c`},
+		{`SHOW CREATE TABLE a`},
+		{`SHOW CREATE TABLE a.b.c`},
 		{`SHOW TABLES FROM a; SHOW COLUMNS FROM b`},
 
 		// Tables are the default, but can also be specified with
@@ -137,6 +164,15 @@ func TestParse(t *testing.T) {
 		{`REVOKE SELECT, INSERT ON DATABASE bar FROM foo, bar, baz`},
 		{`REVOKE SELECT, INSERT ON DATABASE db1, db2 FROM foo, bar, baz`},
 
+		{`BACKUP DATABASE a TO 'bak'`},
+		{`BACKUP DATABASE a, b TO 'bak2' INCREMENTAL FROM 'bak', 'bak1'`},
+		{`BACKUP a, b.c TO 'bak'`},
+		{`RESTORE DATABASE a FROM 'bak'`},
+		{`RESTORE a, b.c FROM 'bak', 'bak1'`},
+
+		{`IMPORT TABLE a CREATE USING 'a.sql' CSV DATA ('a.csv')`},
+		{`IMPORT TABLE a.b CREATE USING 'file:///a.sql' CSV DATA ('file:///a1.csv', 'file:///a2.csv')`},
+
 		{`INSERT INTO a VALUES (1)`},
 		{`INSERT INTO a.b VALUES (1)`},
 		{`INSERT INTO a VALUES (1, 2)`},
@@ -188,6 +224,8 @@ func TestParse(t *testing.T) {
 		{`SELECT a.b.* FROM t`},
 		{`SELECT a.b[1] FROM t`},
 		{`SELECT a.b[1 + 1:4][3] FROM t`},
+		{`SELECT (ARRAY[1, 2])[1] FROM t`},
+		{`SELECT CAST(a AS INT[]) FROM t`},
 		{`SELECT 'a' FROM t`},
 		{`SELECT 'a' FROM t@bar`},
 
@@ -231,6 +269,11 @@ func TestParse(t *testing.T) {
 		{`SELECT FROM t WHERE a NOT IN (b, c)`},
 		{`SELECT FROM t WHERE a LIKE b`},
 		{`SELECT FROM t WHERE a NOT LIKE b`},
+		{`SELECT FROM t WHERE a = ANY (b)`},
+		{`SELECT FROM t WHERE a < ALL (b)`},
+		{`SELECT FROM t WHERE a LIKE SOME (b)`},
+		{`SELECT FROM t WHERE a @> b`},
+		{`SELECT a -> 'b' -> 1, a ->> 'c' FROM t`},
 		{`SELECT FROM t WHERE a SIMILAR TO b`},
 		{`SELECT FROM t WHERE a NOT SIMILAR TO b`},
 		{`SELECT FROM t WHERE a BETWEEN b AND c`},
@@ -307,6 +350,13 @@ func TestParse(t *testing.T) {
 		{`SELECT FROM t LIMIT a`},
 		{`SELECT FROM t OFFSET b`},
 		{`SELECT FROM t LIMIT a OFFSET b`},
+		{`SELECT a FROM t FOR UPDATE`},
+		{`SELECT a FROM t WHERE a = 1 ORDER BY a LIMIT 1 FOR UPDATE`},
+
+		{`WITH a AS (SELECT 1) SELECT * FROM a`},
+		{`WITH a (x, y) AS (SELECT 1, 2), b AS (SELECT * FROM a) SELECT * FROM b ORDER BY x LIMIT 1`},
+		{`WITH RECURSIVE t (n) AS (SELECT 1 UNION ALL SELECT n + 1 FROM t WHERE n < 10) SELECT SUM(n) FROM t`},
+		{`SELECT (WITH a AS (SELECT 1) SELECT * FROM a)`},
 		{`SELECT DISTINCT * FROM t`},
 		{`SELECT DISTINCT a, b FROM t`},
 		{`SET a = 3`},
@@ -341,6 +391,9 @@ func TestParse(t *testing.T) {
 		{`SELECT * FROM "0" JOIN "0" USING (id, "0")`}, // last "0" lost its quotes.
 
 		{`ALTER DATABASE a RENAME TO b`},
+		{`ALTER DATABASE a CONFIGURE ZONE 'replicas: [{attrs: [ssd]}]'`},
+		{`ALTER DATABASE a CONFIGURE ZONE NULL`},
+		{`ALTER TABLE a.b CONFIGURE ZONE 'range_max_bytes: 1000'`},
 		{`ALTER TABLE a RENAME TO b`},
 		{`ALTER TABLE IF EXISTS a RENAME TO b`},
 		{`ALTER INDEX a RENAME TO b`},
@@ -365,6 +418,16 @@ func TestParse(t *testing.T) {
 		{`ALTER TABLE a DROP COLUMN IF EXISTS b, DROP CONSTRAINT a_idx`},
 		{`ALTER TABLE IF EXISTS a DROP COLUMN b, DROP CONSTRAINT a_idx`},
 		{`ALTER TABLE IF EXISTS a DROP COLUMN IF EXISTS b, DROP CONSTRAINT a_idx`},
+
+		{`ALTER TABLE a ALTER b SET DEFAULT 42`},
+		{`ALTER TABLE a ALTER COLUMN b SET DEFAULT NOW()`},
+		{`ALTER TABLE a ALTER b DROP DEFAULT`},
+		{`ALTER TABLE a ALTER COLUMN b DROP DEFAULT`},
+		{`ALTER TABLE a ALTER b SET NOT NULL`},
+		{`ALTER TABLE a ALTER COLUMN b SET NOT NULL, ALTER c DROP NOT NULL`},
+		{`ALTER TABLE a ALTER b DROP NOT NULL`},
+		{`ALTER TABLE a ALTER b TYPE VARCHAR(100)`},
+		{`ALTER TABLE a ALTER COLUMN b TYPE STRING`},
 	}
 	for _, d := range testData {
 		stmts, err := ParseTraditional(d.sql)
@@ -386,11 +449,17 @@ func TestParse2(t *testing.T) {
 		sql      string
 		expected string
 	}{
-		{`CREATE INDEX ON a (b ASC, c DESC)`, `CREATE INDEX ON a (b, c)`},
 		{`CREATE TABLE a (b INT, UNIQUE INDEX foo (b))`,
 			`CREATE TABLE a (b INT, CONSTRAINT foo UNIQUE (b))`},
 		{`CREATE INDEX ON a (b) COVERING (c)`, `CREATE INDEX ON a (b) STORING (c)`},
 
+		{`ALTER TABLE a ALTER b SET DATA TYPE VARCHAR(100)`, `ALTER TABLE a ALTER b TYPE VARCHAR(100)`},
+
+		{`RELEASE foo`, `RELEASE SAVEPOINT foo`},
+		{`ROLLBACK TO foo`, `ROLLBACK TRANSACTION TO SAVEPOINT foo`},
+		{`ROLLBACK TO SAVEPOINT foo`, `ROLLBACK TRANSACTION TO SAVEPOINT foo`},
+		{`RELEASE savepoint`, `RELEASE SAVEPOINT savepoint`},
+
 		{`SELECT BOOL 'foo'`, `SELECT CAST('foo' AS BOOL)`},
 		{`SELECT INT 'foo'`, `SELECT CAST('foo' AS INT)`},
 		{`SELECT REAL 'foo'`, `SELECT CAST('foo' AS REAL)`},
@@ -399,6 +468,9 @@ func TestParse2(t *testing.T) {
 		{`SELECT TIMESTAMP 'foo'`, `SELECT CAST('foo' AS TIMESTAMP)`},
 		{`SELECT INTERVAL 'foo'`, `SELECT CAST('foo' AS INTERVAL)`},
 		{`SELECT CHAR 'foo'`, `SELECT CAST('foo' AS CHAR)`},
+		{`CREATE TABLE a (b INT ARRAY)`, `CREATE TABLE a (b INT[])`},
+		{`CREATE TABLE a (b INT[3])`, `CREATE TABLE a (b INT[])`},
+		{`SELECT a->'b'->>1 FROM t`, `SELECT a -> 'b' ->> 1 FROM t`},
 
 		{`SELECT FROM t WHERE a IS UNKNOWN`, `SELECT FROM t WHERE a IS NULL`},
 		{`SELECT FROM t WHERE a IS NOT UNKNOWN`, `SELECT FROM t WHERE a IS NOT NULL`},
@@ -460,6 +532,7 @@ func TestParse2(t *testing.T) {
 			`SELECT + y[ARRAY[]]`},
 		{`SELECT(0)FROM y[array[]]`,
 			`SELECT (0) FROM y[ARRAY[]]`},
+		{`WITH a AS (SELECT 1) (SELECT * FROM a)`, `WITH a AS (SELECT 1) SELECT * FROM a`},
 		{`SELECT FROM t UNION DISTINCT SELECT 1 FROM t`,
 			`SELECT FROM t UNION SELECT 1 FROM t`},
 		{`SELECT FROM t EXCEPT DISTINCT SELECT 1 FROM t`,
@@ -599,6 +672,55 @@ CREATE TABLE a (b INT DEFAULT c)
 			`default expression contains a subquery at or near ")"
 CREATE TABLE a (b INT DEFAULT (SELECT 1))
                                         ^
+`,
+		},
+		{
+			`CREATE TABLE a (b INT AS ((SELECT 1)) STORED)`,
+			`computed column expression contains a subquery at or near "STORED"
+CREATE TABLE a (b INT AS ((SELECT 1)) STORED)
+                                      ^
+`,
+		},
+		{
+			`WITH a AS (SELECT 1) SELECT 1 UNION SELECT 2`,
+			`WITH is only supported with SELECT at or near "EOF"
+WITH a AS (SELECT 1) SELECT 1 UNION SELECT 2
+                                            ^
+`,
+		},
+		{
+			`WITH a AS (SELECT 1) VALUES (1)`,
+			`WITH is only supported with SELECT at or near "EOF"
+WITH a AS (SELECT 1) VALUES (1)
+                               ^
+`,
+		},
+		{
+			`WITH a AS (DELETE FROM t) SELECT 1`,
+			`WITH only supports SELECT in common table expressions at or near ")"
+WITH a AS (DELETE FROM t) SELECT 1
+                        ^
+`,
+		},
+		{
+			`WITH a AS (SELECT 1) INSERT INTO t VALUES (1)`,
+			`WITH is only supported with SELECT at or near "INSERT"
+WITH a AS (SELECT 1) INSERT INTO t VALUES (1)
+                     ^
+`,
+		},
+		{
+			`SELECT * FROM CAST(1 AS INT)`,
+			`syntax error at or near "EOF"
+SELECT * FROM CAST(1 AS INT)
+                            ^
+`,
+		},
+		{
+			`SELECT * FROM COALESCE(1, 2)`,
+			`syntax error at or near "EOF"
+SELECT * FROM COALESCE(1, 2)
+                            ^
 `,
 		},
 	}
@@ -255,6 +255,29 @@ func (s *scanner) scan(lval *sqlSymType) {
 		}
 		return
 
+	case '-':
+		switch s.peek() {
+		case '>':
+			if s.peekN(1) == '>' { // ->>
+				s.pos += 2
+				lval.id = FETCHTEXT
+				return
+			}
+			s.pos++ // ->
+			lval.id = FETCHVAL
+			return
+		}
+		return
+
+	case '@':
+		switch s.peek() {
+		case '>': // @>
+			s.pos++
+			lval.id = CONTAINS
+			return
+		}
+		return
+
 	case '!':
 		switch s.peek() {
 		case '=': // !=
@@ -57,11 +57,16 @@ func TestScanner(t *testing.T) {
 		{`;`, []int{';'}},
 		{`+`, []int{'+'}},
 		{`-`, []int{'-'}},
+		{`->`, []int{FETCHVAL}},
+		{`->>`, []int{FETCHTEXT}},
+		{`- >`, []int{'-', '>'}},
 		{`*`, []int{'*'}},
 		{`/`, []int{'/'}},
 		{`%`, []int{'%'}},
 		{`^`, []int{'^'}},
 		{`$`, []int{'$'}},
+		{`@`, []int{'@'}},
+		{`@>`, []int{CONTAINS}},
 		{`&`, []int{'&'}},
 		{`|`, []int{'|'}},
 		{`||`, []int{CONCAT}},
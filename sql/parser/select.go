@@ -50,6 +50,7 @@ func (node *ParenSelect) String() string {
 
 // Select represents a SELECT statement.
 type Select struct {
+	With        *With
 	Distinct    bool
 	Exprs       SelectExprs
 	From        TableExprs
@@ -58,7 +59,7 @@ type Select struct {
 	Having      *Where
 	OrderBy     OrderBy
 	Limit       *Limit
-	Lock        string
+	ForUpdate   bool
 	tableSelect bool
 }
 
@@ -66,15 +67,59 @@ func (node *Select) String() string {
 	if node.tableSelect && len(node.From) == 1 {
 		return fmt.Sprintf("TABLE %s", node.From[0])
 	}
-	var distinct string
+	var with, distinct, forUpdate string
+	if node.With != nil {
+		with = fmt.Sprintf("%s ", node.With)
+	}
 	if node.Distinct {
 		distinct = " DISTINCT"
 	}
-	return fmt.Sprintf("SELECT%s%s%s%s%s%s%s%s%s",
-		distinct, node.Exprs,
+	if node.ForUpdate {
+		forUpdate = " FOR UPDATE"
+	}
+	return fmt.Sprintf("%sSELECT%s%s%s%s%s%s%s%s%s",
+		with, distinct, node.Exprs,
 		node.From, node.Where,
 		node.GroupBy, node.Having, node.OrderBy,
-		node.Limit, node.Lock)
+		node.Limit, forUpdate)
+}
+
+// With represents a WITH clause.
+type With struct {
+	Recursive bool
+	CTEs      []*CTE
+}
+
+func (node *With) String() string {
+	var buf bytes.Buffer
+	buf.WriteString("WITH")
+	if node.Recursive {
+		buf.WriteString(" RECURSIVE")
+	}
+	for i, cte := range node.CTEs {
+		if i > 0 {
+			buf.WriteString(",")
+		}
+		fmt.Fprintf(&buf, " %s", cte)
+	}
+	return buf.String()
+}
+
+// CTE represents a common table expression within a WITH clause.
+type CTE struct {
+	Name    Name
+	Columns NameList
+	Stmt    SelectStatement
+}
+
+func (node *CTE) String() string {
+	var buf bytes.Buffer
+	buf.WriteString(node.Name.String())
+	if len(node.Columns) > 0 {
+		fmt.Fprintf(&buf, " (%s)", node.Columns)
+	}
+	fmt.Fprintf(&buf, " AS (%s)", node.Stmt)
+	return buf.String()
 }
 
 // SelectExprs represents SELECT expressions.
@@ -161,6 +206,7 @@ type SimpleTableExpr interface {
 
 func (QualifiedName) simpleTableExpr() {}
 func (*Subquery) simpleTableExpr()     {}
+func (*FuncExpr) simpleTableExpr()     {}
 
 // ParenTableExpr represents a parenthesized TableExpr.
 type ParenTableExpr struct {
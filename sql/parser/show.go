@@ -49,6 +49,15 @@ func (node *ShowColumns) String() string {
 	return buf.String()
 }
 
+// ShowCreateTable represents a SHOW CREATE TABLE statement.
+type ShowCreateTable struct {
+	Table *QualifiedName
+}
+
+func (node *ShowCreateTable) String() string {
+	return fmt.Sprintf("SHOW CREATE TABLE %s", node.Table)
+}
+
 // ShowDatabases represents a SHOW DATABASES statement.
 type ShowDatabases struct {
 }
@@ -11,7 +11,20 @@ func unimplemented() {
 	panic("TODO(pmattis): unimplemented")
 }
 
-//line sql.y:30
+// setWith attaches a WITH clause to a SELECT statement. It returns nil if the
+// statement is a set operation or VALUES, which do not support WITH clauses.
+func setWith(with *With, stmt SelectStatement) SelectStatement {
+	switch t := stmt.(type) {
+	case *Select:
+		t.With = with
+		return t
+	case *ParenSelect:
+		return setWith(with, t.Select)
+	}
+	return nil
+}
+
+//line sql.y:43
 type sqlSymType struct {
 	yys            int
 	id             int
@@ -19,6 +32,7 @@ type sqlSymType struct {
 	empty          struct{}
 	ival           int64
 	boolVal        bool
+	comparisonOp   ComparisonOp
 	str            string
 	strs           []string
 	qname          *QualifiedName
@@ -57,9 +71,15 @@ type sqlSymType struct {
 	order          *Order
 	groupBy        GroupBy
 	dir            Direction
+	idxElem        IndexElem
+	idxElems       IndexElemList
 	alterTableCmd  AlterTableCmd
 	alterTableCmds AlterTableCmds
 	isoLevel       IsolationLevel
+	with           *With
+	cte            *CTE
+	ctes           []*CTE
+	interleave     *InterleaveDef
 }
 
 const IDENT = 57346
@@ -87,222 +107,241 @@ const AS = 57367
 const ASC = 57368
 const ASYMMETRIC = 57369
 const AT = 57370
-const BEGIN = 57371
-const BETWEEN = 57372
-const BIGINT = 57373
-const BIT = 57374
-const BLOB = 57375
-const BOOL = 57376
-const BOOLEAN = 57377
-const BOTH = 57378
-const BY = 57379
-const BYTES = 57380
-const CASCADE = 57381
-const CASE = 57382
-const CAST = 57383
-const CHAR = 57384
-const CHARACTER = 57385
-const CHECK = 57386
-const COALESCE = 57387
-const COLLATE = 57388
-const COLLATION = 57389
-const COLUMN = 57390
-const COLUMNS = 57391
-const COMMIT = 57392
-const COMMITTED = 57393
-const CONCAT = 57394
-const CONFLICT = 57395
-const CONSTRAINT = 57396
-const COVERING = 57397
-const CREATE = 57398
-const CROSS = 57399
-const CUBE = 57400
-const CURRENT = 57401
-const CURRENT_CATALOG = 57402
-const CURRENT_DATE = 57403
-const CURRENT_ROLE = 57404
-const CURRENT_TIME = 57405
-const CURRENT_TIMESTAMP = 57406
-const CURRENT_USER = 57407
-const CYCLE = 57408
-const DATA = 57409
-const DATABASE = 57410
-const DATABASES = 57411
-const DATE = 57412
-const DAY = 57413
-const DEC = 57414
-const DECIMAL = 57415
-const DEFAULT = 57416
-const DEFERRABLE = 57417
-const DELETE = 57418
-const DESC = 57419
-const DISTINCT = 57420
-const DO = 57421
-const DOUBLE = 57422
-const DROP = 57423
-const ELSE = 57424
-const END = 57425
-const ESCAPE = 57426
-const EXCEPT = 57427
-const EXISTS = 57428
-const EXPLAIN = 57429
-const EXTRACT = 57430
-const FALSE = 57431
-const FETCH = 57432
-const FILTER = 57433
-const FIRST = 57434
-const FLOAT = 57435
-const FOLLOWING = 57436
-const FOR = 57437
-const FOREIGN = 57438
-const FROM = 57439
-const FULL = 57440
-const GRANT = 57441
-const GRANTS = 57442
-const GREATEST = 57443
-const GROUP = 57444
-const GROUPING = 57445
-const HAVING = 57446
-const HOUR = 57447
-const IF = 57448
-const IFNULL = 57449
-const IN = 57450
-const INDEX = 57451
-const INITIALLY = 57452
-const INNER = 57453
-const INSERT = 57454
-const INT = 57455
-const INT64 = 57456
-const INTEGER = 57457
-const INTERSECT = 57458
-const INTERVAL = 57459
-const INTO = 57460
-const IS = 57461
-const ISOLATION = 57462
-const JOIN = 57463
-const KEY = 57464
-const LATERAL = 57465
-const LEADING = 57466
-const LEAST = 57467
-const LEFT = 57468
-const LEVEL = 57469
-const LIKE = 57470
-const LIMIT = 57471
-const LOCAL = 57472
-const LOCALTIME = 57473
-const LOCALTIMESTAMP = 57474
-const LSHIFT = 57475
-const MATCH = 57476
-const MINUTE = 57477
-const MONTH = 57478
-const NAME = 57479
-const NAMES = 57480
-const NATURAL = 57481
-const NEXT = 57482
-const NO = 57483
-const NOT = 57484
-const NOTHING = 57485
-const NULL = 57486
-const NULLIF = 57487
-const NULLS = 57488
-const NUMERIC = 57489
-const OF = 57490
-const OFF = 57491
-const OFFSET = 57492
-const ON = 57493
-const ONLY = 57494
-const OR = 57495
-const ORDER = 57496
-const ORDINALITY = 57497
-const OUT = 57498
-const OUTER = 57499
-const OVER = 57500
-const OVERLAPS = 57501
-const OVERLAY = 57502
-const PARTIAL = 57503
-const PARTITION = 57504
-const PLACING = 57505
-const POSITION = 57506
-const PRECEDING = 57507
-const PRECISION = 57508
-const PRIMARY = 57509
-const RANGE = 57510
-const READ = 57511
-const REAL = 57512
-const RECURSIVE = 57513
-const REF = 57514
-const REFERENCES = 57515
-const RENAME = 57516
-const REPEATABLE = 57517
-const RESTRICT = 57518
-const RETURNING = 57519
-const REVOKE = 57520
-const RIGHT = 57521
-const ROLLBACK = 57522
-const ROLLUP = 57523
-const ROW = 57524
-const ROWS = 57525
-const RSHIFT = 57526
-const SEARCH = 57527
-const SECOND = 57528
-const SELECT = 57529
-const SERIALIZABLE = 57530
-const SESSION = 57531
-const SESSION_USER = 57532
-const SET = 57533
-const SHOW = 57534
-const SIMILAR = 57535
-const SIMPLE = 57536
-const SMALLINT = 57537
-const SNAPSHOT = 57538
-const SOME = 57539
-const SQL = 57540
-const STRICT = 57541
-const STRING = 57542
-const STORING = 57543
-const SUBSTRING = 57544
-const SYMMETRIC = 57545
-const TABLE = 57546
-const TABLES = 57547
-const TEXT = 57548
-const THEN = 57549
-const TIME = 57550
-const TIMESTAMP = 57551
-const TO = 57552
-const TRAILING = 57553
-const TRANSACTION = 57554
-const TREAT = 57555
-const TRIM = 57556
-const TRUE = 57557
-const TRUNCATE = 57558
-const TYPE = 57559
-const UNBOUNDED = 57560
-const UNCOMMITTED = 57561
-const UNION = 57562
-const UNIQUE = 57563
-const UNKNOWN = 57564
-const UPDATE = 57565
-const USER = 57566
-const USING = 57567
-const VALID = 57568
-const VALIDATE = 57569
-const VALUE = 57570
-const VALUES = 57571
-const VARCHAR = 57572
-const VARIADIC = 57573
-const VARYING = 57574
-const WHEN = 57575
-const WHERE = 57576
-const WINDOW = 57577
-const WITH = 57578
-const WITHIN = 57579
-const WITHOUT = 57580
-const YEAR = 57581
-const ZONE = 57582
-const NOT_LA = 57583
-const WITH_LA = 57584
-const POSTFIXOP = 57585
-const UMINUS = 57586
+const BACKUP = 57371
+const BEGIN = 57372
+const BETWEEN = 57373
+const BIGINT = 57374
+const BIT = 57375
+const BLOB = 57376
+const BOOL = 57377
+const BOOLEAN = 57378
+const BOTH = 57379
+const BY = 57380
+const BYTES = 57381
+const CASCADE = 57382
+const CASE = 57383
+const CAST = 57384
+const CHAR = 57385
+const CHARACTER = 57386
+const CHECK = 57387
+const COALESCE = 57388
+const COLLATE = 57389
+const COLLATION = 57390
+const COLUMN = 57391
+const COLUMNS = 57392
+const COMMIT = 57393
+const COMMITTED = 57394
+const CONCAT = 57395
+const CONFIGURATION = 57396
+const CONFIGURE = 57397
+const CONFLICT = 57398
+const CONSTRAINT = 57399
+const CONTAINS = 57400
+const COVERING = 57401
+const CREATE = 57402
+const CSV = 57403
+const CROSS = 57404
+const CUBE = 57405
+const CURRENT = 57406
+const CURRENT_CATALOG = 57407
+const CURRENT_DATE = 57408
+const CURRENT_ROLE = 57409
+const CURRENT_TIME = 57410
+const CURRENT_TIMESTAMP = 57411
+const CURRENT_USER = 57412
+const CYCLE = 57413
+const DATA = 57414
+const DATABASE = 57415
+const DATABASES = 57416
+const DATE = 57417
+const DAY = 57418
+const DEC = 57419
+const DECIMAL = 57420
+const DEFAULT = 57421
+const DEFERRABLE = 57422
+const DELETE = 57423
+const DESC = 57424
+const DISTINCT = 57425
+const DO = 57426
+const DOUBLE = 57427
+const DROP = 57428
+const ELSE = 57429
+const END = 57430
+const ESCAPE = 57431
+const EXCEPT = 57432
+const EXISTS = 57433
+const EXPLAIN = 57434
+const EXTRACT = 57435
+const FALSE = 57436
+const FAMILY = 57437
+const FETCH = 57438
+const FETCHTEXT = 57439
+const FETCHVAL = 57440
+const FILTER = 57441
+const FIRST = 57442
+const FLOAT = 57443
+const FOLLOWING = 57444
+const FOR = 57445
+const FOREIGN = 57446
+const FROM = 57447
+const FULL = 57448
+const GRANT = 57449
+const GRANTS = 57450
+const GREATEST = 57451
+const GROUP = 57452
+const GROUPING = 57453
+const HAVING = 57454
+const HOUR = 57455
+const IF = 57456
+const IFNULL = 57457
+const IMPORT = 57458
+const IN = 57459
+const INCREMENTAL = 57460
+const INDEX = 57461
+const INITIALLY = 57462
+const INNER = 57463
+const INSERT = 57464
+const INT = 57465
+const INT64 = 57466
+const INTEGER = 57467
+const INTERLEAVE = 57468
+const INTERSECT = 57469
+const INTERVAL = 57470
+const INTO = 57471
+const IS = 57472
+const ISOLATION = 57473
+const JOIN = 57474
+const JSON = 57475
+const JSONB = 57476
+const KEY = 57477
+const LATERAL = 57478
+const LEADING = 57479
+const LEAST = 57480
+const LEFT = 57481
+const LEVEL = 57482
+const LIKE = 57483
+const LIMIT = 57484
+const LOCAL = 57485
+const LOCALTIME = 57486
+const LOCALTIMESTAMP = 57487
+const LSHIFT = 57488
+const MATCH = 57489
+const MINUTE = 57490
+const MONTH = 57491
+const NAME = 57492
+const NAMES = 57493
+const NATURAL = 57494
+const NEXT = 57495
+const NO = 57496
+const NOT = 57497
+const NOTHING = 57498
+const NULL = 57499
+const NULLIF = 57500
+const NULLS = 57501
+const NUMERIC = 57502
+const OF = 57503
+const OFF = 57504
+const OFFSET = 57505
+const ON = 57506
+const ONLY = 57507
+const OR = 57508
+const ORDER = 57509
+const ORDINALITY = 57510
+const OUT = 57511
+const OUTER = 57512
+const OVER = 57513
+const OVERLAPS = 57514
+const OVERLAY = 57515
+const PARENT = 57516
+const PARTIAL = 57517
+const PARTITION = 57518
+const PLACING = 57519
+const POSITION = 57520
+const PRECEDING = 57521
+const PRECISION = 57522
+const PRIMARY = 57523
+const RANGE = 57524
+const READ = 57525
+const REAL = 57526
+const RECURSIVE = 57527
+const REF = 57528
+const REFERENCES = 57529
+const RELEASE = 57530
+const RENAME = 57531
+const REPEATABLE = 57532
+const RESTORE = 57533
+const RESTRICT = 57534
+const RETURNING = 57535
+const REVOKE = 57536
+const RIGHT = 57537
+const ROLLBACK = 57538
+const ROLLUP = 57539
+const ROW = 57540
+const ROWS = 57541
+const RSHIFT = 57542
+const SAVEPOINT = 57543
+const SEARCH = 57544
+const SECOND = 57545
+const SELECT = 57546
+const SERIALIZABLE = 57547
+const SESSION = 57548
+const SESSION_USER = 57549
+const SET = 57550
+const SHOW = 57551
+const SIMILAR = 57552
+const SIMPLE = 57553
+const SMALLINT = 57554
+const SNAPSHOT = 57555
+const SOME = 57556
+const SQL = 57557
+const STORED = 57558
+const STRICT = 57559
+const STRING = 57560
+const STORING = 57561
+const SUBSTRING = 57562
+const SYMMETRIC = 57563
+const TABLE = 57564
+const TABLES = 57565
+const TEXT = 57566
+const THEN = 57567
+const TIME = 57568
+const TIMESTAMP = 57569
+const TO = 57570
+const TRAILING = 57571
+const TRANSACTION = 57572
+const TREAT = 57573
+const TRIM = 57574
+const TRUE = 57575
+const TRUNCATE = 57576
+const TYPE = 57577
+const UNBOUNDED = 57578
+const UNCOMMITTED = 57579
+const UNION = 57580
+const UNIQUE = 57581
+const UNKNOWN = 57582
+const UPDATE = 57583
+const USER = 57584
+const USING = 57585
+const UUID = 57586
+const VALID = 57587
+const VALIDATE = 57588
+const VALUE = 57589
+const VALUES = 57590
+const VARCHAR = 57591
+const VARIADIC = 57592
+const VARYING = 57593
+const WHEN = 57594
+const WHERE = 57595
+const WINDOW = 57596
+const WITH = 57597
+const WITHIN = 57598
+const WITHOUT = 57599
+const YEAR = 57600
+const ZONE = 57601
+const NOT_LA = 57602
+const WITH_LA = 57603
+const POSTFIXOP = 57604
+const UMINUS = 57605
 
 var sqlToknames = [...]string{
 	"$end",
@@ -333,6 +372,7 @@ var sqlToknames = [...]string{
 	"ASC",
 	"ASYMMETRIC",
 	"AT",
+	"BACKUP",
 	"BEGIN",
 	"BETWEEN",
 	"BIGINT",
@@ -357,10 +397,14 @@ var sqlToknames = [...]string{
 	"COMMIT",
 	"COMMITTED",
 	"CONCAT",
+	"CONFIGURATION",
+	"CONFIGURE",
 	"CONFLICT",
 	"CONSTRAINT",
+	"CONTAINS",
 	"COVERING",
 	"CREATE",
+	"CSV",
 	"CROSS",
 	"CUBE",
 	"CURRENT",
@@ -394,7 +438,10 @@ var sqlToknames = [...]string{
 	"EXPLAIN",
 	"EXTRACT",
 	"FALSE",
+	"FAMILY",
 	"FETCH",
+	"FETCHTEXT",
+	"FETCHVAL",
 	"FILTER",
 	"FIRST",
 	"FLOAT",
@@ -412,7 +459,9 @@ var sqlToknames = [...]string{
 	"HOUR",
 	"IF",
 	"IFNULL",
+	"IMPORT",
 	"IN",
+	"INCREMENTAL",
 	"INDEX",
 	"INITIALLY",
 	"INNER",
@@ -420,12 +469,15 @@ var sqlToknames = [...]string{
 	"INT",
 	"INT64",
 	"INTEGER",
+	"INTERLEAVE",
 	"INTERSECT",
 	"INTERVAL",
 	"INTO",
 	"IS",
 	"ISOLATION",
 	"JOIN",
+	"JSON",
+	"JSONB",
 	"KEY",
 	"LATERAL",
 	"LEADING",
@@ -465,6 +517,7 @@ var sqlToknames = [...]string{
 	"OVER",
 	"OVERLAPS",
 	"OVERLAY",
+	"PARENT",
 	"PARTIAL",
 	"PARTITION",
 	"PLACING",
@@ -478,8 +531,10 @@ var sqlToknames = [...]string{
 	"RECURSIVE",
 	"REF",
 	"REFERENCES",
+	"RELEASE",
 	"RENAME",
 	"REPEATABLE",
+	"RESTORE",
 	"RESTRICT",
 	"RETURNING",
 	"REVOKE",
@@ -489,6 +544,7 @@ var sqlToknames = [...]string{
 	"ROW",
 	"ROWS",
 	"RSHIFT",
+	"SAVEPOINT",
 	"SEARCH",
 	"SECOND",
 	"SELECT",
@@ -503,6 +559,7 @@ var sqlToknames = [...]string{
 	"SNAPSHOT",
 	"SOME",
 	"SQL",
+	"STORED",
 	"STRICT",
 	"STRING",
 	"STORING",
@@ -530,6 +587,7 @@ var sqlToknames = [...]string{
 	"UPDATE",
 	"USER",
 	"USING",
+	"UUID",
 	"VALID",
 	"VALIDATE",
 	"VALUE",
@@ -578,2164 +636,2444 @@ const sqlEofCode = 1
 const sqlErrCode = 2
 const sqlMaxDepth = 200
 
-//line sql.y:3701
+//line sql.y:4096
 
 //line yacctab:1
 var sqlExca = [...]int{
 	-1, 0,
-	1, 19,
-	263, 19,
-	-2, 289,
+	1, 23,
+	282, 23,
+	-2, 318,
 	-1, 1,
 	1, -1,
 	-2, 0,
-	-1, 29,
-	1, 260,
-	151, 260,
-	261, 260,
-	263, 260,
-	-2, 270,
-	-1, 38,
-	1, 263,
-	151, 263,
-	261, 263,
-	263, 263,
-	-2, 269,
+	-1, 36,
+	1, 286,
+	164, 286,
+	280, 286,
+	282, 286,
+	-2, 298,
 	-1, 47,
-	1, 19,
-	263, 19,
-	-2, 289,
-	-1, 83,
-	1, 127,
-	263, 127,
-	-2, 738,
-	-1, 234,
-	129, 299,
-	150, 299,
-	-2, 266,
-	-1, 237,
-	129, 298,
-	150, 298,
-	-2, 264,
-	-1, 339,
-	129, 298,
-	150, 298,
-	-2, 267,
-	-1, 396,
-	260, 688,
-	-2, 683,
-	-1, 397,
-	260, 689,
-	-2, 684,
-	-1, 403,
-	6, 417,
-	260, 417,
-	-2, 811,
-	-1, 425,
-	6, 387,
-	-2, 790,
-	-1, 426,
-	6, 414,
-	260, 414,
-	-2, 791,
-	-1, 427,
-	6, 395,
-	-2, 792,
-	-1, 428,
-	6, 394,
-	-2, 793,
-	-1, 429,
-	6, 414,
-	260, 414,
-	-2, 795,
-	-1, 430,
-	6, 414,
-	260, 414,
-	-2, 796,
-	-1, 431,
-	6, 415,
-	-2, 798,
-	-1, 432,
-	6, 382,
-	-2, 799,
-	-1, 433,
-	6, 382,
-	-2, 800,
-	-1, 434,
-	6, 397,
+	1, 289,
+	164, 289,
+	280, 289,
+	282, 289,
+	-2, 297,
+	-1, 56,
+	1, 23,
+	282, 23,
+	-2, 318,
+	-1, 247,
+	1, 135,
+	282, 135,
 	-2, 803,
-	-1, 435,
-	6, 383,
-	-2, 808,
-	-1, 436,
-	6, 384,
-	-2, 809,
-	-1, 437,
-	6, 385,
-	-2, 810,
-	-1, 438,
-	6, 382,
-	-2, 814,
-	-1, 439,
-	6, 388,
-	-2, 819,
-	-1, 440,
-	6, 386,
-	-2, 821,
-	-1, 441,
+	-1, 276,
+	142, 328,
+	163, 328,
+	-2, 294,
+	-1, 279,
+	103, 327,
+	142, 327,
+	163, 327,
+	-2, 290,
+	-1, 392,
+	142, 327,
+	163, 327,
+	-2, 295,
+	-1, 451,
+	279, 744,
+	-2, 739,
+	-1, 452,
+	279, 745,
+	-2, 740,
+	-1, 458,
+	6, 452,
+	279, 452,
+	-2, 882,
+	-1, 480,
+	6, 422,
+	-2, 861,
+	-1, 481,
+	6, 449,
+	279, 449,
+	-2, 862,
+	-1, 482,
+	6, 430,
+	-2, 863,
+	-1, 483,
+	6, 429,
+	-2, 864,
+	-1, 484,
+	6, 449,
+	279, 449,
+	-2, 866,
+	-1, 485,
+	6, 449,
+	279, 449,
+	-2, 867,
+	-1, 486,
+	6, 450,
+	-2, 869,
+	-1, 487,
 	6, 416,
-	-2, 825,
-	-1, 442,
-	6, 412,
-	260, 412,
-	-2, 829,
-	-1, 683,
-	85, 270,
-	116, 270,
-	129, 270,
-	150, 270,
-	154, 270,
-	220, 270,
-	-2, 519,
-	-1, 691,
-	260, 668,
-	-2, 662,
-	-1, 876,
+	-2, 870,
+	-1, 488,
+	6, 416,
+	-2, 871,
+	-1, 489,
+	6, 432,
+	-2, 874,
+	-1, 490,
+	6, 417,
+	-2, 879,
+	-1, 491,
+	6, 419,
+	-2, 880,
+	-1, 492,
+	6, 420,
+	-2, 881,
+	-1, 493,
+	6, 416,
+	-2, 885,
+	-1, 494,
+	6, 423,
+	-2, 890,
+	-1, 495,
+	6, 421,
+	-2, 892,
+	-1, 496,
+	6, 451,
+	-2, 896,
+	-1, 497,
+	6, 447,
+	279, 447,
+	-2, 900,
+	-1, 751,
+	90, 298,
+	103, 298,
+	127, 298,
+	142, 298,
+	163, 298,
+	167, 298,
+	238, 298,
+	-2, 562,
+	-1, 759,
+	279, 724,
+	-2, 716,
+	-1, 957,
 	12, 0,
 	13, 0,
 	14, 0,
-	243, 0,
-	244, 0,
-	245, 0,
-	-2, 450,
-	-1, 877,
+	262, 0,
+	263, 0,
+	264, 0,
+	-2, 485,
+	-1, 958,
 	12, 0,
 	13, 0,
 	14, 0,
-	243, 0,
-	244, 0,
-	245, 0,
-	-2, 451,
-	-1, 878,
+	262, 0,
+	263, 0,
+	264, 0,
+	-2, 486,
+	-1, 959,
 	12, 0,
 	13, 0,
 	14, 0,
-	243, 0,
-	244, 0,
-	245, 0,
-	-2, 452,
-	-1, 882,
+	262, 0,
+	263, 0,
+	264, 0,
+	-2, 487,
+	-1, 966,
 	12, 0,
 	13, 0,
 	14, 0,
-	243, 0,
-	244, 0,
-	245, 0,
-	-2, 456,
-	-1, 883,
+	262, 0,
+	263, 0,
+	264, 0,
+	-2, 494,
+	-1, 967,
 	12, 0,
 	13, 0,
 	14, 0,
-	243, 0,
-	244, 0,
-	245, 0,
-	-2, 457,
-	-1, 884,
+	262, 0,
+	263, 0,
+	264, 0,
+	-2, 495,
+	-1, 968,
 	12, 0,
 	13, 0,
 	14, 0,
-	243, 0,
-	244, 0,
-	245, 0,
-	-2, 458,
-	-1, 887,
-	30, 0,
-	108, 0,
-	128, 0,
-	193, 0,
-	241, 0,
-	-2, 463,
-	-1, 918,
-	159, 589,
-	-2, 592,
-	-1, 1064,
-	85, 270,
-	116, 270,
-	129, 270,
-	150, 270,
-	154, 270,
-	220, 270,
-	-2, 340,
-	-1, 1072,
-	30, 0,
-	108, 0,
-	128, 0,
-	193, 0,
-	241, 0,
-	-2, 464,
-	-1, 1077,
-	30, 0,
-	108, 0,
-	128, 0,
-	193, 0,
-	241, 0,
-	-2, 465,
-	-1, 1096,
-	159, 588,
-	-2, 591,
-	-1, 1233,
-	30, 0,
-	108, 0,
-	128, 0,
-	193, 0,
-	241, 0,
-	-2, 466,
-	-1, 1238,
-	119, 0,
-	-2, 476,
-	-1, 1247,
-	159, 590,
-	-2, 593,
-	-1, 1287,
+	262, 0,
+	263, 0,
+	264, 0,
+	-2, 496,
+	-1, 971,
+	31, 0,
+	117, 0,
+	141, 0,
+	210, 0,
+	260, 0,
+	-2, 501,
+	-1, 1006,
+	172, 632,
+	-2, 635,
+	-1, 1162,
+	90, 298,
+	103, 298,
+	127, 298,
+	142, 298,
+	163, 298,
+	167, 298,
+	238, 298,
+	-2, 370,
+	-1, 1172,
+	31, 0,
+	117, 0,
+	141, 0,
+	210, 0,
+	260, 0,
+	-2, 502,
+	-1, 1177,
+	31, 0,
+	117, 0,
+	141, 0,
+	210, 0,
+	260, 0,
+	-2, 503,
+	-1, 1198,
+	172, 631,
+	-2, 634,
+	-1, 1350,
+	31, 0,
+	117, 0,
+	141, 0,
+	210, 0,
+	260, 0,
+	-2, 504,
+	-1, 1356,
+	130, 0,
+	-2, 515,
+	-1, 1365,
+	172, 633,
+	-2, 636,
+	-1, 1405,
 	12, 0,
 	13, 0,
 	14, 0,
-	243, 0,
-	244, 0,
-	245, 0,
-	-2, 500,
-	-1, 1288,
+	262, 0,
+	263, 0,
+	264, 0,
+	-2, 539,
+	-1, 1406,
 	12, 0,
 	13, 0,
 	14, 0,
-	243, 0,
-	244, 0,
-	245, 0,
-	-2, 501,
-	-1, 1289,
+	262, 0,
+	263, 0,
+	264, 0,
+	-2, 540,
+	-1, 1407,
 	12, 0,
 	13, 0,
 	14, 0,
-	243, 0,
-	244, 0,
-	245, 0,
-	-2, 502,
-	-1, 1293,
+	262, 0,
+	263, 0,
+	264, 0,
+	-2, 541,
+	-1, 1414,
 	12, 0,
 	13, 0,
 	14, 0,
-	243, 0,
-	244, 0,
-	245, 0,
-	-2, 506,
-	-1, 1294,
+	262, 0,
+	263, 0,
+	264, 0,
+	-2, 548,
+	-1, 1415,
 	12, 0,
 	13, 0,
 	14, 0,
-	243, 0,
-	244, 0,
-	245, 0,
-	-2, 507,
-	-1, 1295,
+	262, 0,
+	263, 0,
+	264, 0,
+	-2, 549,
+	-1, 1416,
 	12, 0,
 	13, 0,
 	14, 0,
-	243, 0,
-	244, 0,
-	245, 0,
-	-2, 508,
-	-1, 1387,
-	119, 0,
-	-2, 477,
-	-1, 1391,
-	30, 0,
-	108, 0,
-	128, 0,
-	193, 0,
-	241, 0,
-	-2, 480,
-	-1, 1392,
-	30, 0,
-	108, 0,
-	128, 0,
-	193, 0,
-	241, 0,
-	-2, 482,
-	-1, 1471,
-	30, 0,
-	108, 0,
-	128, 0,
-	193, 0,
-	241, 0,
-	-2, 481,
-	-1, 1472,
-	30, 0,
-	108, 0,
-	128, 0,
-	193, 0,
-	241, 0,
-	-2, 483,
-	-1, 1480,
-	119, 0,
-	-2, 509,
+	262, 0,
+	263, 0,
+	264, 0,
+	-2, 550,
+	-1, 1513,
+	130, 0,
+	-2, 516,
 	-1, 1517,
-	119, 0,
-	-2, 510,
-	-1, 1562,
-	30, 0,
-	128, 0,
-	193, 0,
-	241, 0,
-	-2, 789,
+	31, 0,
+	117, 0,
+	141, 0,
+	210, 0,
+	260, 0,
+	-2, 519,
+	-1, 1518,
+	31, 0,
+	117, 0,
+	141, 0,
+	210, 0,
+	260, 0,
+	-2, 521,
+	-1, 1600,
+	31, 0,
+	117, 0,
+	141, 0,
+	210, 0,
+	260, 0,
+	-2, 520,
+	-1, 1601,
+	31, 0,
+	117, 0,
+	141, 0,
+	210, 0,
+	260, 0,
+	-2, 522,
+	-1, 1609,
+	130, 0,
+	-2, 551,
+	-1, 1646,
+	130, 0,
+	-2, 552,
+	-1, 1696,
+	31, 0,
+	141, 0,
+	210, 0,
+	260, 0,
+	-2, 860,
 }
 
-const sqlNprod = 921
+const sqlNprod = 993
 const sqlPrivate = 57344
 
 var sqlTokenNames []string
 var sqlStates []string
 
-const sqlLast = 18232
+const sqlLast = 21037
 
 var sqlAct = [...]int{
 
-	915, 1544, 1561, 1582, 370, 1522, 1546, 1428, 1545, 1560,
-	817, 1488, 769, 1267, 1358, 1453, 395, 1325, 394, 265,
-	387, 1461, 1373, 1359, 686, 238, 1060, 1213, 762, 801,
-	1153, 1239, 1154, 243, 28, 688, 1052, 481, 1222, 455,
-	1099, 803, 84, 931, 460, 739, 770, 1367, 748, 621,
-	262, 1048, 804, 262, 935, 271, 903, 828, 262, 28,
-	281, 970, 900, 1063, 13, 717, 499, 245, 37, 637,
-	925, 798, 463, 721, 643, 1240, 465, 369, 18, 10,
-	237, 28, 248, 6, 360, 57, 88, 510, 826, 641,
-	59, 286, 526, 37, 806, 342, 282, 973, 343, 284,
-	490, 825, 38, 341, 61, 60, 497, 501, 397, 62,
-	66, 1455, 275, 443, 39, 37, 242, 483, 458, 458,
-	81, 353, 456, 456, 483, 457, 457, 491, 1558, 767,
-	763, 1452, 928, 1552, 261, 1551, 821, 268, 821, 87,
-	235, 1543, 276, 242, 1390, 290, 287, 644, 1092, 644,
-	87, 87, 234, 1538, 87, 1510, 821, 87, 87, 87,
-	1021, 1300, 87, 87, 87, 87, 929, 289, 1519, 1246,
-	1513, 1390, 291, 821, 1501, 1498, 389, 821, 1452, 1473,
-	1032, 1468, 1390, 279, 821, 87, 87, 1451, 1448, 1433,
-	1452, 821, 821, 737, 1050, 1432, 930, 927, 821, 1413,
-	1393, 1389, 1092, 1092, 1390, 1335, 1243, 1204, 821, 1092,
-	482, 1034, 821, 1200, 43, 956, 482, 1171, 1169, 1168,
-	1172, 1092, 1092, 482, 1167, 1096, 1098, 1092, 1092, 1094,
-	1093, 45, 486, 262, 1095, 1092, 822, 736, 488, 821,
-	735, 489, 43, 911, 816, 792, 645, 932, 354, 307,
-	260, 47, 525, 1092, 484, 321, 46, 361, 361, 45,
-	340, 484, 1559, 41, 1557, 1514, 1450, 461, 452, 42,
-	1418, 1414, 1406, 334, 1405, 339, 1400, 43, 262, 476,
-	450, 1399, 1398, 1397, 46, 1384, 43, 40, 1352, 454,
-	1315, 41, 1310, 1126, 45, 1309, 1308, 42, 1250, 1228,
-	926, 1212, 1174, 45, 1173, 1161, 1152, 1125, 1122, 1120,
-	1109, 281, 1103, 281, 1021, 766, 645, 333, 1036, 46,
-	1033, 1070, 1489, 458, 445, 985, 41, 456, 46, 281,
-	457, 942, 42, 941, 444, 908, 694, 87, 353, 87,
-	352, 87, 482, 1269, 1509, 629, 631, 1490, 235, 618,
-	58, 1482, 638, 1464, 1458, 1447, 87, 1425, 1411, 40,
-	234, 1378, 276, 1356, 1382, 677, 678, 679, 680, 681,
-	617, 1237, 87, 1227, 684, 1210, 1209, 1207, 1186, 1185,
-	1151, 1117, 87, 87, 1116, 87, 474, 1108, 1089, 1085,
-	905, 722, 725, 646, 697, 999, 998, 1351, 980, 1126,
-	290, 290, 940, 820, 727, 715, 714, 691, 529, 495,
-	713, 648, 712, 909, 711, 87, 1140, 87, 494, 710,
-	709, 514, 289, 289, 521, 613, 708, 291, 291, 647,
-	528, 87, 646, 87, 87, 530, 87, 639, 707, 627,
-	706, 626, 705, 610, 625, 87, 614, 704, 615, 235,
-	648, 703, 235, 235, 702, 646, 701, 692, 729, 734,
-	690, 633, 40, 87, 634, 635, 87, 1141, 647, 999,
-	619, 685, 266, 648, 402, 262, 357, 1470, 761, 1469,
-	689, 1230, 773, 1229, 359, 451, 1354, 777, 1022, 1071,
-	281, 647, 328, 520, 730, 719, 720, 281, 316, 346,
-	699, 1368, 763, 1270, 936, 646, 718, 723, 1018, 28,
-	1528, 246, 726, 753, 755, 1571, 1343, 1497, 225, 742,
-	1112, 1028, 28, 648, 728, 1441, 1440, 1198, 1178, 779,
-	284, 1132, 1133, 1134, 1127, 1128, 1129, 1130, 1131, 1177,
-	765, 647, 311, 529, 529, 466, 1107, 467, 232, 731,
-	733, 363, 1106, 59, 255, 662, 37, 1105, 1126, 758,
-	1104, 745, 87, 1126, 1073, 528, 528, 61, 60, 315,
-	530, 530, 62, 1572, 781, 87, 290, 287, 662, 87,
-	892, 782, 87, 785, 780, 783, 87, 760, 87, 87,
-	695, 87, 759, 866, 87, 87, 87, 1496, 289, 741,
-	229, 87, 87, 291, 1530, 1197, 663, 902, 1188, 468,
-	741, 51, 529, 778, 447, 1381, 740, 446, 797, 466,
-	784, 467, 466, 262, 467, 477, 932, 1259, 662, 663,
-	814, 815, 1430, 399, 528, 1579, 651, 652, 653, 530,
-	1127, 1128, 1129, 1130, 1131, 902, 361, 52, 1013, 262,
-	867, 868, 869, 870, 871, 872, 873, 874, 875, 876,
-	877, 878, 879, 880, 881, 882, 883, 884, 885, 886,
-	887, 936, 823, 649, 650, 651, 652, 653, 932, 663,
-	472, 1140, 313, 468, 230, 471, 468, 890, 1491, 1027,
-	865, 1585, 657, 654, 655, 656, 649, 650, 651, 652,
-	653, 233, 1010, 54, 943, 928, 954, 800, 964, 966,
-	971, 974, 975, 976, 749, 1029, 646, 314, 1189, 1478,
-	1571, 87, 831, 1540, 1578, 916, 730, 87, 87, 946,
-	830, 730, 1141, 483, 648, 716, 461, 682, 1541, 929,
-	349, 350, 449, 990, 55, 656, 649, 650, 651, 652,
-	653, 1126, 647, 87, 1115, 469, 87, 984, 53, 355,
-	907, 1195, 529, 1223, 1014, 891, 752, 331, 906, 930,
-	927, 281, 738, 646, 242, 1547, 464, 49, 1431, 281,
-	988, 345, 1548, 1075, 528, 888, 1435, 912, 917, 530,
-	920, 648, 1570, 1583, 1568, 1577, 949, 994, 1134, 1127,
-	1128, 1129, 1130, 1131, 1366, 965, 1129, 1130, 1131, 647,
-	1016, 977, 978, 979, 989, 1038, 1024, 638, 50, 788,
-	932, 901, 996, 837, 810, 789, 324, 308, 1584, 469,
-	950, 1009, 469, 306, 262, 1409, 1434, 751, 791, 56,
-	241, 1423, 1339, 1586, 1020, 1549, 790, 87, 87, 87,
-	889, 1256, 1037, 87, 28, 1025, 87, 1035, 1026, 1030,
-	951, 948, 87, 87, 87, 87, 87, 1031, 87, 87,
-	484, 240, 1592, 926, 1140, 87, 1180, 87, 1550, 290,
-	1296, 1257, 64, 87, 993, 1043, 1041, 1065, 37, 1072,
-	1059, 750, 87, 1077, 1017, 87, 662, 632, 811, 1045,
-	1044, 289, 1023, 624, 1046, 1410, 291, 620, 1069, 242,
-	1338, 952, 1091, 48, 1255, 1523, 87, 344, 87, 87,
-	67, 87, 1100, 837, 723, 1141, 726, 616, 720, 719,
-	87, 1066, 932, 496, 344, 87, 87, 1113, 87, 1424,
-	72, 1118, 1591, 1001, 1297, 68, 1097, 663, 1076, 1342,
-	1298, 1000, 1376, 1074, 1055, 345, 1341, 649, 650, 651,
-	652, 653, 684, 69, 947, 1218, 67, 1058, 971, 971,
-	971, 856, 1217, 312, 898, 239, 71, 1221, 1051, 329,
-	274, 855, 1056, 240, 336, 896, 72, 1214, 1176, 1111,
-	1049, 68, 1127, 1128, 1129, 1130, 1131, 939, 1481, 1183,
-	1408, 1155, 1236, 1088, 1121, 1084, 786, 1090, 644, 69,
-	327, 654, 655, 656, 649, 650, 651, 652, 653, 1055,
-	1101, 1102, 71, 461, 1340, 325, 773, 322, 273, 1158,
-	1159, 1160, 1058, 1156, 700, 1057, 1175, 612, 894, 938,
-	893, 1322, 1053, 1192, 899, 1194, 1182, 1056, 1193, 1201,
-	1191, 70, 1179, 1082, 1039, 262, 1196, 812, 262, 1150,
-	1054, 1264, 809, 487, 1080, 485, 480, 1203, 1202, 473,
-	1163, 856, 1232, 470, 1233, 1442, 1206, 347, 258, 1572,
-	516, 855, 741, 818, 741, 1238, 1208, 73, 756, 1184,
-	754, 1220, 318, 1248, 75, 1224, 1225, 70, 1444, 1248,
-	1057, 757, 1199, 646, 1455, 3, 646, 1493, 87, 1516,
-	1215, 895, 351, 1265, 1511, 768, 1086, 1087, 897, 1078,
-	640, 836, 1274, 1083, 648, 1276, 1252, 1253, 1254, 1068,
-	87, 1249, 1589, 73, 819, 1590, 63, 348, 259, 647,
-	1126, 87, 647, 87, 224, 87, 646, 1383, 87, 1258,
-	1260, 1261, 1216, 267, 1275, 1219, 1305, 1306, 1271, 87,
-	319, 1126, 87, 382, 74, 1312, 1313, 1314, 1316, 1262,
-	87, 309, 310, 87, 1147, 1148, 1149, 793, 226, 227,
-	794, 1231, 1170, 983, 982, 1304, 1303, 1244, 981, 933,
-	1079, 795, 1395, 1263, 85, 1273, 796, 1081, 693, 228,
-	1346, 1429, 1277, 1317, 65, 249, 249, 611, 323, 264,
-	1402, 1539, 264, 270, 264, 1369, 1114, 264, 277, 264,
-	85, 836, 262, 262, 87, 1477, 262, 1364, 1460, 1363,
-	937, 1365, 1321, 1307, 28, 698, 23, 1387, 1361, 1357,
-	85, 85, 1391, 1392, 375, 1323, 1181, 1394, 805, 1301,
-	531, 517, 1396, 506, 398, 1380, 326, 500, 957, 509,
-	1311, 858, 837, 945, 857, 1353, 448, 1401, 400, 1388,
-	834, 1404, 401, 835, 724, 1336, 1337, 388, 832, 285,
-	833, 771, 934, 1110, 1140, 696, 87, 87, 87, 374,
-	1234, 1235, 380, 379, 87, 87, 837, 1355, 913, 371,
-	87, 1412, 87, 837, 87, 87, 87, 87, 1407, 79,
-	80, 1015, 1350, 1370, 764, 813, 87, 1379, 87, 1371,
-	1372, 628, 1190, 1377, 231, 1123, 87, 87, 963, 955,
-	87, 953, 332, 459, 837, 1141, 87, 87, 1427, 772,
-	358, 320, 1436, 1278, 1279, 1280, 1281, 1282, 1283, 1284,
-	1285, 1286, 1287, 1288, 1289, 1290, 1291, 1292, 1293, 1294,
-	1295, 858, 1299, 1457, 857, 1419, 1443, 944, 1364, 1422,
-	1363, 1459, 1365, 824, 1445, 1420, 1465, 1456, 87, 1067,
-	833, 262, 1438, 1439, 356, 1454, 1471, 1472, 636, 257,
-	256, 802, 264, 317, 85, 1463, 337, 787, 1135, 1132,
-	1133, 1134, 1127, 1128, 1129, 1130, 1131, 475, 330, 1492,
-	856, 249, 1527, 1187, 837, 44, 1485, 17, 16, 1437,
-	855, 15, 1476, 14, 12, 11, 1487, 264, 1042, 9,
-	8, 87, 7, 87, 22, 87, 21, 264, 264, 1486,
-	478, 20, 87, 1483, 856, 5, 1449, 4, 461, 2,
-	1, 856, 957, 957, 855, 0, 1500, 0, 0, 1502,
-	0, 855, 0, 0, 1504, 87, 1474, 1506, 1467, 1364,
-	264, 1363, 264, 1365, 0, 87, 1503, 87, 1466, 0,
-	0, 0, 856, 0, 0, 87, 85, 87, 264, 85,
-	0, 85, 855, 0, 730, 0, 1526, 0, 1505, 0,
-	623, 0, 0, 1515, 0, 0, 0, 0, 0, 1532,
-	957, 957, 957, 0, 1518, 0, 0, 0, 249, 0,
-	837, 642, 0, 1533, 1535, 1537, 1364, 1531, 1363, 1554,
-	1365, 0, 1536, 0, 1534, 773, 1529, 0, 1553, 0,
-	0, 1565, 1565, 1555, 1556, 0, 0, 1331, 1426, 87,
-	87, 1566, 1567, 87, 1512, 1569, 1508, 0, 0, 1573,
-	836, 0, 856, 1565, 87, 1576, 1575, 837, 0, 0,
-	0, 0, 855, 87, 0, 1588, 1587, 1332, 0, 1524,
-	1525, 0, 0, 0, 1574, 0, 0, 0, 837, 0,
-	1565, 0, 1593, 0, 836, 0, 0, 0, 87, 87,
-	87, 836, 87, 519, 507, 518, 0, 512, 0, 0,
-	1331, 0, 1326, 0, 1542, 0, 0, 264, 0, 87,
-	1324, 0, 0, 0, 1480, 0, 957, 957, 0, 0,
-	746, 0, 836, 0, 264, 0, 0, 264, 0, 87,
-	1332, 264, 0, 775, 776, 1327, 264, 1328, 0, 264,
-	85, 85, 0, 0, 0, 0, 264, 642, 0, 837,
-	0, 0, 0, 1051, 0, 0, 0, 0, 856, 0,
-	1330, 0, 0, 522, 0, 0, 1333, 0, 855, 957,
-	957, 957, 957, 957, 957, 957, 957, 957, 957, 957,
-	957, 957, 957, 957, 957, 957, 957, 1517, 957, 0,
-	858, 0, 0, 857, 1055, 0, 0, 0, 1327, 0,
-	1328, 0, 836, 0, 0, 856, 524, 1058, 0, 833,
-	0, 0, 0, 0, 1329, 855, 0, 1053, 0, 523,
-	0, 0, 1056, 1330, 858, 0, 856, 857, 0, 1333,
-	0, 858, 0, 0, 857, 1054, 855, 0, 0, 0,
-	0, 0, 0, 833, 376, 29, 0, 1375, 0, 0,
-	833, 0, 0, 0, 214, 0, 0, 0, 0, 0,
-	0, 0, 858, 0, 0, 857, 799, 0, 223, 0,
-	29, 0, 264, 746, 0, 1057, 0, 1329, 0, 0,
-	0, 833, 236, 0, 0, 244, 0, 0, 0, 0,
-	0, 0, 29, 0, 0, 0, 0, 856, 264, 216,
-	0, 85, 0, 244, 0, 0, 0, 855, 836, 0,
-	1126, 0, 1142, 1143, 1144, 0, 0, 0, 215, 217,
-	0, 1374, 1386, 0, 0, 0, 646, 0, 664, 665,
-	666, 0, 0, 0, 0, 0, 0, 0, 667, 0,
-	513, 508, 858, 0, 648, 857, 673, 0, 0, 0,
-	218, 0, 1139, 0, 0, 836, 0, 0, 0, 219,
-	0, 833, 647, 0, 0, 0, 0, 0, 661, 0,
-	0, 0, 0, 0, 957, 0, 836, 0, 0, 0,
+	1003, 1678, 1695, 1717, 1652, 833, 1679, 840, 1555, 1680,
+	1694, 1385, 1618, 1589, 892, 1289, 902, 1318, 1357, 635,
+	1445, 1496, 1290, 1490, 301, 313, 1458, 877, 874, 1259,
+	1201, 17, 1061, 1157, 754, 1328, 248, 1258, 1358, 899,
+	756, 1149, 285, 35, 316, 876, 624, 510, 1337, 450,
+	684, 280, 841, 812, 1145, 1058, 1019, 803, 1023, 991,
+	785, 988, 221, 789, 1013, 1160, 903, 641, 702, 868,
+	513, 287, 46, 35, 707, 1098, 22, 515, 13, 279,
+	8, 424, 415, 530, 900, 290, 880, 396, 47, 395,
+	397, 643, 60, 228, 394, 639, 322, 414, 48, 35,
+	315, 245, 46, 288, 625, 401, 652, 223, 219, 222,
+	332, 224, 61, 328, 1016, 68, 1460, 834, 319, 625,
+	319, 1228, 317, 408, 317, 318, 237, 318, 46, 1709,
+	1692, 284, 528, 1457, 449, 277, 1194, 1686, 1685, 298,
+	528, 528, 304, 1676, 284, 276, 1516, 1114, 708, 1639,
+	1671, 1017, 498, 528, 1663, 292, 442, 855, 1648, 1642,
+	1635, 1516, 528, 1457, 1241, 1630, 855, 1602, 1457, 1244,
+	1516, 1596, 838, 1586, 528, 312, 1457, 444, 1584, 1582,
+	1565, 528, 1457, 528, 1421, 1564, 1018, 1015, 1457, 1539,
+	1519, 1515, 1194, 1194, 1516, 52, 1456, 1361, 1315, 1457,
+	1194, 311, 1311, 1276, 1274, 311, 1277, 1194, 1243, 1242,
+	1364, 1273, 1272, 54, 1194, 1194, 1198, 1196, 1195, 1194,
+	708, 896, 1197, 1194, 528, 800, 630, 1127, 799, 631,
+	801, 52, 1147, 1131, 628, 999, 891, 862, 709, 55,
+	409, 1020, 528, 311, 354, 297, 50, 1200, 56, 54,
+	710, 667, 51, 370, 52, 330, 626, 1245, 1693, 500,
+	1194, 1643, 1597, 1585, 1544, 1540, 1532, 1531, 712, 1526,
+	49, 626, 54, 710, 1525, 55, 1524, 1523, 1228, 1509,
+	452, 1508, 50, 1436, 1479, 1431, 1430, 711, 51, 1429,
+	1368, 712, 1343, 1327, 416, 416, 1014, 52, 55, 1280,
+	323, 1279, 1278, 511, 1266, 67, 220, 392, 1257, 387,
+	711, 1246, 1227, 1224, 67, 54, 1222, 1169, 67, 1211,
+	616, 499, 505, 67, 67, 1205, 1114, 333, 393, 49,
+	326, 67, 67, 1130, 1074, 67, 336, 1030, 67, 67,
+	67, 55, 1133, 67, 67, 1029, 319, 311, 50, 996,
+	317, 762, 408, 318, 51, 386, 407, 1619, 1228, 1387,
+	529, 1664, 681, 1638, 1621, 1611, 1592, 1580, 1579, 710,
+	1553, 1551, 837, 337, 1537, 1506, 277, 1237, 1234, 1235,
+	1236, 1229, 1230, 1231, 1232, 1233, 276, 712, 404, 405,
+	757, 1501, 504, 410, 521, 1485, 1355, 1342, 709, 1478,
+	694, 696, 615, 1325, 1324, 323, 711, 703, 1323, 729,
+	1321, 1295, 1294, 1256, 1245, 1219, 1218, 617, 1228, 1210,
+	745, 746, 747, 748, 749, 710, 1190, 1186, 1178, 752,
+	997, 993, 680, 534, 534, 1228, 790, 793, 706, 794,
+	1170, 1165, 457, 712, 1086, 1085, 632, 1068, 633, 765,
+	637, 1028, 895, 796, 668, 783, 782, 663, 781, 656,
+	780, 753, 711, 730, 779, 778, 777, 673, 1246, 776,
+	535, 535, 759, 669, 670, 775, 774, 674, 336, 336,
+	676, 773, 772, 771, 689, 691, 534, 690, 688, 770,
+	277, 710, 769, 277, 277, 704, 67, 67, 67, 67,
+	698, 335, 1086, 699, 700, 729, 760, 758, 49, 712,
+	713, 714, 715, 716, 717, 337, 337, 67, 682, 1346,
+	302, 67, 67, 535, 412, 1599, 1598, 1345, 711, 721,
+	718, 719, 720, 713, 714, 715, 716, 717, 1229, 1230,
+	1231, 1232, 1233, 662, 806, 787, 788, 506, 1481, 67,
+	1115, 67, 791, 67, 1245, 67, 1171, 795, 824, 730,
+	1228, 729, 360, 823, 379, 365, 767, 1491, 834, 1388,
+	67, 1245, 1024, 786, 1111, 817, 819, 1659, 854, 797,
+	1214, 67, 1629, 1705, 692, 364, 1706, 1470, 516, 516,
+	517, 517, 67, 264, 1573, 372, 239, 1572, 533, 533,
+	1123, 67, 67, 809, 67, 822, 1307, 213, 1246, 805,
+	1283, 506, 1282, 1209, 1208, 730, 274, 1306, 1229, 1230,
+	1231, 1232, 1233, 1228, 763, 1246, 718, 719, 720, 713,
+	714, 715, 716, 717, 67, 516, 947, 517, 67, 1207,
+	1206, 1173, 976, 335, 335, 1505, 853, 826, 214, 825,
+	836, 533, 67, 67, 1677, 67, 67, 518, 518, 67,
+	383, 35, 221, 805, 67, 849, 330, 1628, 502, 310,
+	67, 804, 870, 35, 1237, 1234, 1235, 1236, 1229, 1230,
+	1231, 1232, 1233, 990, 720, 713, 714, 715, 716, 717,
+	67, 271, 236, 67, 1236, 1229, 1230, 1231, 1232, 1233,
+	990, 1557, 46, 1661, 518, 501, 216, 223, 362, 222,
+	416, 224, 620, 897, 948, 949, 950, 951, 952, 953,
+	954, 955, 956, 957, 958, 959, 960, 961, 962, 963,
+	964, 965, 966, 967, 968, 969, 970, 971, 333, 851,
+	848, 58, 852, 850, 363, 1024, 1705, 336, 946, 909,
+	1297, 867, 217, 715, 716, 717, 1020, 1377, 906, 1245,
+	1097, 514, 1034, 1714, 858, 272, 873, 215, 889, 890,
+	1374, 859, 1031, 625, 1042, 1104, 1052, 1054, 1059, 1062,
+	1063, 1064, 275, 534, 337, 1122, 861, 59, 1304, 67,
+	1000, 1005, 908, 1008, 860, 418, 524, 67, 1004, 529,
+	511, 67, 980, 1073, 529, 1375, 67, 978, 1053, 67,
+	1623, 710, 1020, 1246, 1065, 1066, 1067, 519, 519, 813,
+	535, 871, 1231, 1232, 1233, 1016, 382, 994, 1673, 712,
+	454, 522, 995, 1106, 1037, 1107, 1081, 358, 359, 986,
+	784, 1124, 802, 905, 750, 1674, 1607, 1075, 711, 1083,
+	984, 1682, 1713, 1581, 1217, 218, 1338, 284, 1681, 534,
+	1020, 1558, 1017, 1704, 519, 907, 1702, 1489, 1109, 885,
+	1720, 1038, 1298, 374, 1044, 398, 357, 1175, 816, 1076,
+	1234, 1235, 1236, 1229, 1230, 1231, 1232, 1233, 915, 353,
+	57, 400, 703, 1096, 989, 1117, 535, 1018, 1015, 1567,
+	1101, 67, 1099, 67, 67, 1183, 1039, 1036, 67, 67,
+	67, 982, 335, 981, 399, 1113, 1181, 987, 1566, 1549,
+	1683, 1135, 1285, 1118, 399, 626, 1121, 1141, 1535, 1712,
+	1125, 1126, 1132, 1728, 1134, 400, 1080, 1373, 35, 661,
+	649, 660, 1466, 654, 1164, 400, 886, 729, 533, 1417,
+	1110, 687, 1020, 67, 906, 815, 1684, 683, 1116, 67,
+	1139, 1040, 67, 67, 1148, 523, 1156, 46, 1161, 399,
+	937, 1163, 1143, 1172, 1142, 283, 1144, 1177, 336, 1179,
+	1168, 974, 1653, 1184, 1718, 1499, 1469, 677, 915, 983,
+	638, 1550, 67, 1468, 1088, 791, 985, 795, 979, 1087,
+	1193, 730, 1333, 1332, 788, 787, 1536, 1014, 1152, 361,
+	1202, 1727, 282, 814, 664, 337, 1035, 380, 1465, 321,
+	282, 1418, 1719, 1155, 533, 1215, 1189, 1419, 389, 1220,
+	1191, 1319, 936, 1192, 1150, 1176, 1174, 829, 1721, 905,
+	1483, 1153, 1329, 1203, 1204, 1610, 226, 1146, 1027, 1534,
+	752, 1260, 284, 1100, 1151, 1180, 1059, 1059, 1059, 1354,
+	1199, 907, 1182, 666, 1223, 1185, 1498, 975, 1105, 1467,
+	937, 713, 714, 715, 716, 717, 856, 708, 665, 378,
+	375, 1213, 1255, 67, 67, 67, 1288, 371, 229, 67,
+	356, 972, 67, 1268, 320, 1261, 768, 1154, 67, 67,
+	67, 67, 67, 1292, 398, 675, 67, 67, 672, 234,
+	1026, 1263, 1264, 1265, 230, 1442, 511, 1302, 67, 1312,
+	67, 1300, 1284, 281, 1137, 1308, 67, 887, 1281, 884,
+	629, 627, 936, 623, 67, 231, 906, 67, 1287, 1301,
+	525, 1303, 520, 335, 1382, 1497, 1574, 402, 1706, 295,
+	233, 67, 67, 914, 893, 1305, 367, 1482, 658, 1316,
+	973, 634, 67, 1314, 67, 67, 67, 1313, 67, 1148,
+	1576, 821, 1349, 3, 1350, 1310, 376, 1320, 229, 1353,
+	1625, 67, 67, 67, 1322, 710, 1356, 1331, 1460, 710,
+	1334, 1645, 263, 1335, 1330, 1366, 697, 906, 1344, 234,
+	906, 1366, 1339, 1340, 230, 655, 650, 712, 406, 805,
+	894, 805, 403, 1152, 296, 1383, 1152, 820, 1640, 818,
+	839, 905, 711, 1362, 1392, 231, 711, 1394, 1155, 368,
+	303, 1155, 232, 265, 266, 705, 1167, 529, 1367, 1150,
+	233, 225, 1336, 907, 1725, 1726, 1153, 1228, 710, 1153,
+	1389, 1507, 1437, 914, 1370, 1371, 1372, 1380, 1348, 1151,
+	1391, 1426, 1427, 1393, 1376, 1378, 1379, 1395, 1347, 235,
+	1433, 1434, 1435, 1275, 863, 1072, 238, 864, 1071, 1070,
+	1069, 1021, 905, 865, 636, 905, 1521, 1381, 1424, 1422,
+	1120, 1119, 866, 761, 1462, 526, 270, 1425, 1556, 227,
+	1432, 1428, 1154, 671, 907, 1154, 373, 907, 1528, 1672,
+	1463, 1292, 1441, 1216, 1606, 1588, 1025, 1438, 766, 1461,
+	28, 1464, 232, 430, 1443, 1286, 1459, 879, 878, 536,
+	1492, 659, 648, 453, 377, 642, 651, 1484, 1292, 1033,
+	503, 1488, 1292, 1480, 906, 455, 912, 456, 913, 1486,
+	792, 443, 910, 1487, 35, 1513, 331, 842, 977, 235,
+	1517, 1518, 1022, 1493, 915, 1520, 1212, 764, 1502, 429,
+	1522, 906, 1494, 1495, 1514, 906, 1500, 1503, 435, 939,
+	434, 1001, 906, 906, 426, 1527, 906, 243, 244, 1530,
+	437, 1108, 1477, 835, 888, 67, 1504, 693, 1299, 915,
+	273, 1225, 1051, 1043, 1041, 385, 915, 509, 67, 843,
+	413, 369, 67, 1032, 67, 65, 938, 898, 67, 1166,
+	828, 411, 1538, 1533, 65, 701, 294, 293, 249, 905,
+	875, 366, 857, 267, 269, 618, 739, 381, 1624, 67,
+	915, 291, 291, 67, 1658, 65, 937, 1296, 65, 307,
+	65, 907, 53, 65, 314, 21, 905, 20, 19, 18,
+	905, 16, 1545, 15, 14, 1140, 425, 905, 905, 1568,
+	1546, 905, 12, 1560, 1292, 11, 1562, 10, 907, 939,
+	1559, 937, 907, 1548, 9, 27, 26, 25, 937, 907,
+	907, 64, 7, 907, 1575, 1292, 6, 1561, 67, 1593,
+	64, 1583, 1292, 5, 529, 4, 1577, 906, 936, 1569,
+	2, 1600, 1601, 1, 0, 0, 938, 1591, 1570, 1571,
+	0, 299, 937, 0, 306, 0, 308, 1595, 906, 64,
+	0, 0, 0, 915, 0, 906, 0, 0, 0, 1594,
+	0, 911, 1614, 936, 0, 0, 0, 0, 0, 906,
+	936, 0, 1616, 0, 1617, 1603, 1612, 0, 0, 0,
+	0, 1620, 0, 67, 67, 67, 0, 0, 1615, 0,
+	1045, 0, 0, 0, 1605, 0, 67, 0, 0, 0,
+	511, 67, 0, 67, 936, 67, 67, 67, 67, 0,
+	1622, 1634, 905, 0, 0, 1637, 0, 0, 0, 0,
+	1632, 0, 0, 1636, 67, 67, 65, 324, 65, 249,
+	0, 0, 0, 905, 907, 937, 0, 0, 1641, 1453,
+	905, 0, 0, 67, 67, 0, 0, 65, 0, 914,
+	1647, 249, 249, 0, 905, 907, 1662, 1656, 0, 1451,
+	1665, 911, 907, 1667, 1666, 1654, 0, 1655, 915, 0,
+	0, 0, 1670, 1669, 1668, 1292, 907, 0, 1644, 384,
+	1688, 65, 1660, 249, 914, 390, 0, 0, 67, 1689,
+	1687, 914, 1691, 1452, 1699, 1699, 0, 936, 1675, 0,
+	291, 0, 299, 0, 64, 1700, 251, 1703, 906, 1701,
+	1690, 65, 1707, 0, 915, 1708, 0, 1699, 0, 1711,
+	262, 1710, 65, 355, 0, 914, 0, 0, 0, 1723,
+	1722, 65, 65, 0, 621, 915, 1724, 0, 0, 0,
+	0, 0, 0, 67, 0, 67, 1699, 67, 1729, 0,
+	937, 0, 253, 0, 0, 67, 0, 299, 0, 0,
+	0, 67, 254, 0, 65, 0, 0, 0, 65, 1447,
+	0, 1448, 0, 0, 0, 252, 255, 0, 0, 0,
+	0, 0, 249, 249, 0, 65, 249, 507, 0, 249,
+	67, 0, 67, 905, 679, 1450, 937, 0, 527, 0,
+	686, 1454, 67, 0, 1045, 1045, 0, 299, 619, 915,
+	256, 0, 936, 0, 0, 907, 0, 937, 914, 0,
+	291, 257, 0, 314, 0, 0, 0, 1453, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	64, 0, 0, 0, 64, 0, 0, 1451, 0, 1446,
+	0, 0, 0, 1449, 0, 0, 0, 0, 936, 1444,
+	0, 64, 1045, 1045, 1045, 0, 0, 0, 0, 0,
+	0, 0, 0, 67, 67, 939, 0, 67, 0, 936,
+	0, 1452, 67, 1187, 1188, 0, 0, 0, 0, 0,
+	0, 937, 0, 67, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	939, 0, 938, 0, 0, 0, 0, 939, 0, 65,
+	67, 0, 67, 0, 67, 259, 67, 810, 260, 0,
+	0, 65, 261, 914, 0, 0, 65, 0, 0, 830,
+	67, 1252, 1253, 1254, 0, 0, 0, 938, 0, 0,
+	0, 939, 0, 936, 938, 0, 0, 1447, 0, 1448,
+	0, 258, 67, 0, 0, 67, 0, 0, 0, 0,
+	0, 431, 36, 0, 0, 0, 0, 0, 0, 914,
+	0, 0, 0, 1450, 0, 0, 0, 0, 938, 1454,
+	1045, 1045, 0, 0, 0, 798, 0, 0, 0, 0,
+	914, 0, 36, 0, 0, 0, 0, 299, 0, 0,
+	0, 0, 827, 0, 0, 0, 0, 0, 278, 0,
+	0, 286, 0, 0, 0, 0, 0, 0, 36, 0,
+	0, 65, 0, 846, 847, 0, 0, 911, 65, 249,
+	249, 1449, 0, 0, 939, 1045, 1045, 1045, 1045, 1045,
+	1045, 1045, 1045, 1045, 1045, 1045, 1045, 1045, 1045, 1045,
+	1045, 1045, 1045, 1045, 1045, 1045, 0, 1045, 0, 1351,
+	1352, 0, 911, 0, 914, 0, 0, 0, 0, 911,
+	0, 938, 0, 869, 0, 0, 0, 0, 0, 872,
+	0, 0, 65, 810, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 844, 0, 0,
+	0, 0, 0, 911, 64, 0, 0, 0, 0, 0,
+	0, 0, 249, 0, 1396, 1397, 1398, 1399, 1400, 1401,
+	1402, 1403, 1404, 1405, 1406, 1407, 1408, 1409, 1410, 1411,
+	1412, 1413, 1414, 1415, 1416, 0, 1420, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 939,
+	0, 0, 0, 0, 0, 0, 0, 0, 299, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 264, 991, 992, 0, 0, 0, 746, 0,
-	0, 997, 0, 0, 0, 0, 0, 1002, 1003, 1005,
-	1007, 1008, 0, 1011, 1012, 0, 0, 0, 0, 1145,
-	264, 0, 1019, 0, 674, 0, 0, 0, 264, 0,
-	0, 0, 0, 1140, 0, 672, 0, 799, 0, 0,
-	799, 0, 0, 0, 669, 0, 0, 836, 858, 662,
-	957, 857, 0, 0, 0, 220, 0, 0, 221, 0,
-	19, 623, 222, 85, 264, 0, 1040, 833, 0, 668,
-	32, 0, 0, 0, 0, 1047, 0, 0, 0, 0,
-	1062, 1062, 0, 264, 1141, 646, 0, 0, 0, 0,
-	236, 33, 0, 0, 0, 858, 0, 36, 857, 0,
-	663, 0, 0, 648, 1126, 673, 1142, 1143, 1144, 671,
-	0, 0, 0, 0, 833, 0, 858, 0, 0, 857,
-	0, 647, 24, 957, 0, 0, 0, 661, 25, 0,
-	0, 0, 0, 0, 0, 833, 0, 0, 0, 0,
-	26, 0, 0, 1136, 1137, 1138, 1139, 1135, 1132, 1133,
-	1134, 1127, 1128, 1129, 1130, 1131, 0, 670, 0, 658,
-	659, 660, 0, 657, 654, 655, 656, 649, 650, 651,
-	652, 653, 0, 0, 0, 986, 0, 0, 0, 0,
-	0, 0, 987, 674, 0, 0, 0, 858, 0, 0,
-	857, 236, 0, 0, 236, 236, 0, 0, 0, 0,
-	0, 0, 1146, 669, 0, 0, 833, 0, 662, 0,
-	0, 0, 0, 1145, 0, 0, 0, 0, 683, 27,
-	0, 34, 687, 0, 0, 0, 0, 1140, 43, 0,
-	0, 0, 30, 31, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 45, 0, 0, 0, 0,
-	0, 0, 0, 642, 0, 0, 0, 35, 0, 663,
-	0, 646, 0, 664, 665, 666, 0, 0, 671, 0,
-	46, 0, 0, 667, 0, 264, 0, 41, 1141, 648,
-	0, 673, 0, 42, 0, 0, 1205, 0, 746, 0,
-	623, 0, 0, 1211, 0, 0, 0, 647, 0, 0,
-	0, 40, 0, 661, 264, 0, 0, 264, 0, 0,
-	0, 0, 0, 0, 0, 1226, 670, 0, 1062, 0,
-	29, 0, 657, 654, 655, 656, 649, 650, 651, 652,
-	653, 0, 0, 29, 0, 0, 0, 1136, 1137, 1138,
-	0, 1135, 1132, 1133, 1134, 1127, 1128, 1129, 1130, 1131,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 674,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 1268,
-	672, 0, 0, 0, 0, 0, 0, 0, 0, 669,
-	0, 0, 0, 0, 662, 0, 0, 0, 0, 0,
+	0, 0, 286, 0, 0, 299, 938, 0, 0, 0,
+	0, 0, 0, 0, 0, 939, 911, 0, 0, 0,
+	0, 0, 0, 65, 1078, 1079, 0, 0, 0, 810,
+	0, 0, 1084, 0, 0, 0, 939, 0, 1089, 1090,
+	1092, 1094, 1095, 0, 0, 0, 1102, 1103, 0, 0,
+	0, 0, 938, 23, 0, 0, 0, 0, 65, 0,
+	1112, 0, 0, 24, 39, 0, 65, 0, 1228, 278,
+	1247, 1248, 1249, 938, 869, 0, 0, 869, 1045, 0,
+	1511, 0, 0, 0, 0, 40, 0, 0, 0, 0,
+	0, 1128, 65, 0, 45, 0, 0, 0, 0, 1077,
+	0, 0, 686, 0, 686, 249, 65, 0, 1138, 0,
+	939, 1241, 0, 0, 0, 0, 1244, 0, 0, 0,
+	29, 1159, 1159, 1159, 0, 0, 30, 0, 0, 0,
+	0, 911, 0, 0, 64, 0, 0, 0, 0, 0,
+	0, 31, 64, 0, 0, 0, 0, 938, 0, 0,
+	32, 0, 0, 0, 0, 1243, 1242, 1552, 0, 0,
+	1045, 0, 0, 0, 0, 0, 0, 0, 1129, 0,
+	0, 0, 0, 0, 0, 0, 0, 911, 0, 0,
+	0, 0, 1136, 278, 0, 0, 278, 278, 1250, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 911, 0,
+	0, 299, 0, 710, 1245, 731, 732, 733, 0, 0,
+	751, 0, 0, 0, 755, 734, 0, 0, 0, 0,
+	0, 712, 43, 0, 741, 33, 0, 0, 34, 0,
+	41, 0, 0, 0, 0, 42, 1045, 0, 52, 1609,
+	711, 0, 37, 38, 0, 0, 725, 0, 0, 0,
+	0, 728, 0, 0, 0, 0, 54, 0, 1246, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 44, 0,
+	0, 0, 911, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 55, 0, 0, 0, 0, 0, 0, 50,
+	727, 726, 0, 0, 0, 51, 0, 1228, 0, 1247,
+	1248, 1249, 0, 0, 0, 0, 0, 1291, 0, 1510,
+	742, 0, 0, 49, 0, 1646, 0, 0, 0, 0,
+	1238, 1239, 1240, 740, 1237, 1234, 1235, 1236, 1229, 1230,
+	1231, 1232, 1233, 0, 736, 65, 0, 0, 0, 729,
+	1241, 0, 0, 0, 0, 1244, 0, 0, 1317, 0,
+	0, 0, 810, 1228, 686, 1247, 1248, 1249, 1326, 735,
+	0, 0, 0, 0, 0, 1360, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 1341,
+	0, 0, 0, 1159, 1243, 1242, 0, 0, 0, 0,
+	0, 0, 0, 730, 0, 0, 1241, 0, 0, 0,
+	36, 1244, 0, 738, 0, 0, 0, 0, 0, 0,
+	0, 844, 36, 0, 0, 0, 0, 1250, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 668, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 646, 0,
-	664, 665, 666, 0, 0, 0, 0, 0, 0, 0,
-	667, 1319, 1320, 746, 0, 663, 648, 0, 673, 642,
-	642, 646, 0, 0, 671, 1344, 0, 1345, 0, 264,
-	1347, 1348, 1349, 0, 647, 0, 0, 0, 0, 648,
-	661, 642, 0, 746, 1360, 0, 0, 0, 0, 0,
-	0, 264, 264, 0, 0, 264, 0, 647, 0, 0,
-	0, 642, 1062, 661, 0, 0, 0, 0, 0, 827,
-	0, 0, 670, 0, 658, 659, 660, 0, 657, 654,
-	655, 656, 649, 650, 651, 652, 653, 0, 0, 0,
-	0, 0, 0, 0, 1126, 1415, 674, 0, 0, 904,
-	0, 0, 0, 1403, 0, 0, 0, 672, 0, 0,
-	0, 0, 0, 0, 0, 0, 669, 0, 0, 0,
-	0, 662, 0, 0, 0, 0, 0, 0, 646, 0,
-	664, 665, 666, 0, 0, 0, 1139, 0, 0, 0,
-	0, 668, 0, 0, 662, 0, 648, 0, 673, 0,
-	0, 0, 0, 0, 0, 0, 746, 0, 1421, 0,
-	85, 0, 0, 0, 647, 0, 0, 264, 0, 0,
-	661, 0, 663, 0, 0, 0, 0, 0, 0, 0,
-	0, 671, 0, 0, 0, 1360, 0, 0, 0, 0,
-	642, 244, 0, 0, 0, 663, 0, 0, 0, 0,
-	264, 0, 1462, 0, 0, 0, 0, 0, 0, 0,
-	264, 0, 642, 0, 0, 0, 0, 1140, 0, 0,
-	0, 0, 0, 0, 0, 0, 674, 0, 0, 670,
-	0, 658, 659, 660, 0, 657, 654, 655, 656, 649,
-	650, 651, 652, 653, 0, 29, 669, 0, 0, 0,
-	0, 662, 1166, 0, 1064, 0, 0, 0, 657, 654,
-	655, 656, 649, 650, 651, 652, 653, 0, 1141, 0,
-	0, 0, 0, 0, 1494, 1495, 0, 0, 1499, 0,
-	0, 0, 0, 0, 0, 0, 1360, 0, 0, 85,
-	0, 0, 0, 0, 0, 0, 0, 0, 642, 0,
-	0, 0, 663, 0, 0, 0, 0, 0, 0, 0,
-	0, 671, 0, 0, 0, 0, 904, 0, 0, 0,
-	0, 0, 0, 642, 642, 264, 0, 85, 0, 0,
-	683, 1135, 1132, 1133, 1134, 1127, 1128, 1129, 1130, 1131,
-	0, 0, 0, 1360, 1462, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 670,
-	0, 658, 659, 660, 264, 657, 654, 655, 656, 649,
-	650, 651, 652, 653, 0, 0, 0, 0, 0, 0,
-	396, 384, 385, 386, 383, 372, 683, 0, 0, 0,
-	0, 0, 89, 90, 922, 91, 0, 0, 0, 0,
-	378, 0, 0, 0, 92, 93, 174, 425, 426, 94,
-	427, 428, 0, 95, 179, 96, 393, 411, 429, 430,
-	0, 421, 0, 404, 0, 97, 98, 99, 0, 100,
-	0, 101, 0, 294, 102, 103, 0, 405, 407, 0,
-	406, 408, 104, 105, 106, 107, 431, 108, 432, 433,
-	0, 0, 109, 0, 923, 0, 424, 111, 0, 0,
-	0, 0, 377, 112, 412, 391, 0, 113, 114, 434,
-	115, 0, 0, 0, 295, 827, 116, 422, 827, 190,
-	0, 117, 418, 420, 0, 0, 0, 296, 118, 435,
-	436, 437, 0, 403, 0, 297, 119, 298, 120, 0,
-	0, 423, 299, 121, 300, 0, 250, 0, 0, 0,
-	122, 123, 124, 125, 251, 301, 126, 127, 367, 128,
-	392, 419, 129, 438, 130, 131, 0, 0, 0, 0,
-	0, 132, 200, 302, 133, 303, 413, 134, 135, 0,
-	414, 136, 203, 0, 137, 138, 439, 139, 140, 0,
-	141, 142, 143, 0, 144, 304, 145, 146, 381, 147,
-	0, 148, 149, 0, 150, 252, 409, 151, 152, 305,
-	153, 440, 154, 0, 155, 157, 207, 156, 415, 0,
-	0, 158, 159, 0, 254, 441, 0, 0, 253, 416,
-	417, 390, 160, 161, 162, 163, 0, 0, 164, 165,
-	410, 0, 166, 167, 168, 212, 442, 921, 169, 0,
-	0, 0, 0, 170, 171, 172, 173, 368, 0, 0,
-	0, 0, 0, 0, 0, 29, 0, 364, 365, 924,
-	0, 0, 0, 366, 0, 0, 373, 919, 0, 0,
-	0, 0, 827, 827, 0, 0, 827, 0, 0, 0,
+	0, 0, 0, 1245, 0, 0, 0, 0, 1386, 0,
+	1243, 1242, 0, 0, 299, 0, 0, 299, 0, 0,
+	0, 0, 0, 737, 0, 722, 723, 724, 0, 721,
+	718, 719, 720, 713, 714, 715, 716, 717, 0, 0,
+	0, 831, 0, 1250, 0, 0, 0, 0, 832, 0,
+	0, 0, 0, 0, 0, 0, 0, 1246, 0, 1245,
+	901, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 1439, 1440, 810, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 1291, 314, 0, 0, 0,
+	0, 1471, 0, 1472, 992, 65, 1474, 1475, 1476, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 1291, 1246, 314, 810, 1291, 0, 0, 1238,
+	1239, 1240, 0, 1237, 1234, 1235, 1236, 1229, 1230, 1231,
+	1232, 1233, 0, 314, 1159, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 1473, 0, 0, 0, 1238, 1239, 1240, 1529, 1237,
+	1234, 1235, 1236, 1229, 1230, 1231, 1232, 1233, 0, 286,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 299,
+	299, 0, 0, 299, 0, 0, 710, 0, 731, 732,
+	733, 0, 0, 0, 0, 0, 0, 0, 734, 0,
+	0, 0, 0, 0, 712, 0, 0, 741, 0, 0,
+	0, 0, 0, 810, 0, 1547, 0, 249, 0, 0,
+	0, 0, 0, 711, 0, 65, 0, 36, 1291, 725,
+	0, 249, 0, 0, 728, 0, 1162, 0, 0, 1228,
+	0, 1247, 1248, 1249, 0, 0, 0, 0, 0, 1291,
+	0, 1359, 0, 0, 0, 0, 1291, 0, 0, 0,
+	65, 1228, 1590, 1247, 1248, 1249, 0, 0, 0, 0,
+	0, 0, 314, 727, 726, 0, 0, 0, 0, 0,
+	0, 0, 1241, 0, 0, 0, 0, 1244, 0, 0,
+	0, 0, 0, 742, 0, 0, 0, 0, 0, 0,
+	0, 1554, 0, 0, 1241, 0, 740, 992, 0, 1244,
+	0, 0, 0, 0, 0, 0, 0, 736, 0, 0,
+	0, 0, 729, 0, 0, 751, 1243, 1242, 0, 0,
+	0, 0, 0, 0, 0, 0, 1587, 0, 0, 0,
+	0, 0, 735, 1626, 1627, 0, 299, 1631, 1243, 1242,
+	0, 0, 65, 0, 0, 0, 0, 0, 0, 1250,
+	0, 0, 0, 314, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 1245, 730, 0, 0, 0,
+	0, 751, 0, 0, 0, 0, 738, 0, 0, 0,
+	314, 0, 314, 0, 65, 0, 249, 1245, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 1291,
+	1590, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 1633, 1246,
+	0, 0, 65, 0, 0, 314, 737, 0, 722, 723,
+	724, 0, 721, 718, 719, 720, 713, 714, 715, 716,
+	717, 1246, 0, 0, 0, 0, 0, 0, 0, 1541,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	1657, 0, 0, 0, 0, 0, 0, 0, 0, 901,
+	0, 0, 901, 0, 0, 0, 0, 0, 0, 0,
+	0, 1238, 1239, 1240, 0, 1237, 1234, 1235, 1236, 1229,
+	1230, 1231, 1232, 1233, 0, 0, 0, 0, 844, 0,
+	0, 0, 0, 1238, 1239, 1240, 0, 1237, 1234, 1235,
+	1236, 1229, 1230, 1231, 1232, 1233, 0, 451, 439, 440,
+	441, 438, 427, 0, 0, 0, 0, 0, 0, 69,
+	70, 0, 71, 0, 0, 0, 0, 433, 0, 0,
+	0, 72, 73, 74, 0, 480, 481, 75, 482, 483,
+	0, 76, 177, 77, 448, 466, 484, 485, 0, 476,
+	0, 459, 0, 78, 79, 80, 0, 81, 82, 83,
+	0, 0, 84, 0, 85, 340, 86, 1698, 0, 460,
+	462, 0, 461, 463, 88, 89, 250, 90, 486, 91,
+	487, 488, 0, 0, 92, 0, 0, 0, 479, 94,
+	0, 0, 0, 0, 432, 95, 467, 446, 341, 0,
+	0, 0, 96, 97, 489, 98, 0, 0, 0, 342,
+	0, 99, 477, 0, 188, 0, 100, 473, 475, 101,
+	0, 102, 0, 36, 343, 103, 490, 491, 492, 104,
+	0, 458, 0, 0, 105, 345, 106, 107, 108, 0,
+	0, 478, 346, 109, 901, 901, 110, 0, 901, 0,
+	111, 112, 113, 114, 115, 348, 116, 117, 422, 118,
+	447, 474, 119, 493, 120, 121, 0, 0, 0, 0,
+	0, 122, 198, 349, 123, 350, 468, 124, 125, 126,
+	0, 469, 127, 201, 0, 128, 129, 494, 130, 131,
+	0, 132, 133, 134, 135, 136, 0, 137, 351, 138,
+	139, 436, 140, 0, 141, 142, 143, 0, 144, 145,
+	464, 146, 147, 0, 148, 495, 149, 0, 150, 151,
+	153, 205, 152, 470, 0, 0, 154, 155, 0, 207,
+	496, 0, 0, 156, 471, 472, 445, 157, 158, 1697,
+	160, 0, 0, 161, 162, 465, 0, 163, 164, 165,
+	166, 211, 497, 0, 167, 0, 0, 0, 0, 168,
+	169, 170, 171, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 419, 420, 0, 0, 0, 0, 421,
+	0, 0, 428, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 1578, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 532, 0, 0, 0,
+	0, 901, 0, 0, 0, 0, 0, 0, 69, 70,
+	537, 71, 538, 539, 540, 541, 542, 543, 544, 545,
+	72, 73, 74, 172, 173, 174, 75, 175, 176, 546,
+	76, 177, 77, 547, 548, 178, 179, 549, 180, 550,
+	339, 551, 78, 79, 80, 0, 81, 82, 83, 552,
+	0, 84, 553, 85, 340, 86, 87, 554, 555, 556,
+	557, 558, 559, 88, 89, 250, 90, 181, 91, 182,
+	183, 560, 561, 92, 562, 563, 564, 93, 94, 565,
+	566, 751, 567, 184, 95, 185, 568, 341, 569, 0,
+	0, 96, 97, 186, 98, 570, 571, 572, 342, 573,
+	99, 187, 574, 188, 575, 100, 189, 190, 101, 576,
+	102, 577, 578, 343, 103, 191, 192, 193, 104, 579,
+	194, 580, 344, 105, 345, 106, 107, 108, 581, 582,
+	195, 346, 109, 347, 583, 110, 584, 585, 0, 111,
+	112, 113, 114, 115, 348, 116, 117, 586, 118, 587,
+	196, 119, 197, 120, 121, 588, 589, 590, 591, 592,
+	122, 198, 349, 123, 350, 199, 124, 125, 126, 593,
+	200, 127, 201, 594, 128, 129, 202, 130, 131, 595,
+	132, 133, 134, 135, 136, 596, 137, 351, 138, 139,
+	203, 140, 0, 141, 142, 143, 597, 144, 145, 598,
+	146, 147, 352, 148, 204, 149, 599, 150, 151, 153,
+	205, 152, 206, 600, 601, 154, 155, 602, 207, 208,
+	603, 604, 156, 209, 210, 605, 157, 158, 159, 160,
+	606, 607, 161, 162, 608, 609, 163, 164, 165, 166,
+	211, 212, 610, 167, 611, 612, 613, 614, 168, 169,
+	170, 171, 0, 532, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 531, 69, 70, 537, 71, 538,
+	539, 540, 541, 542, 543, 544, 545, 72, 73, 74,
+	172, 173, 174, 75, 175, 176, 546, 76, 177, 77,
+	547, 548, 178, 179, 549, 180, 550, 339, 551, 78,
+	79, 80, 0, 81, 82, 83, 552, 0, 84, 553,
+	85, 340, 86, 87, 554, 555, 556, 557, 558, 559,
+	88, 89, 250, 90, 181, 91, 182, 183, 560, 561,
+	92, 562, 563, 564, 93, 94, 565, 566, 0, 567,
+	184, 95, 185, 568, 341, 569, 0, 0, 96, 97,
+	186, 98, 570, 571, 572, 342, 573, 99, 187, 574,
+	188, 575, 100, 189, 190, 101, 576, 102, 577, 578,
+	343, 103, 191, 192, 193, 104, 579, 194, 580, 344,
+	105, 345, 106, 107, 108, 581, 582, 195, 346, 109,
+	347, 583, 110, 584, 585, 0, 111, 112, 113, 114,
+	115, 348, 116, 117, 586, 118, 587, 196, 119, 197,
+	120, 121, 588, 589, 590, 591, 592, 122, 198, 349,
+	123, 350, 199, 124, 125, 126, 593, 200, 127, 201,
+	594, 128, 129, 202, 130, 131, 595, 132, 133, 134,
+	135, 136, 596, 137, 351, 138, 139, 203, 140, 0,
+	141, 142, 143, 597, 144, 145, 598, 146, 147, 352,
+	148, 204, 149, 599, 150, 151, 153, 205, 152, 206,
+	600, 601, 154, 155, 602, 207, 208, 603, 604, 156,
+	209, 210, 605, 157, 158, 159, 160, 606, 607, 161,
+	162, 608, 609, 163, 164, 165, 166, 211, 212, 610,
+	167, 611, 612, 613, 614, 168, 169, 170, 171, 451,
+	439, 440, 441, 438, 427, 0, 0, 0, 0, 0,
+	0, 69, 70, 1010, 71, 0, 0, 0, 0, 433,
+	0, 0, 0, 72, 73, 74, 172, 480, 481, 75,
+	482, 483, 0, 76, 177, 77, 448, 466, 484, 485,
+	0, 476, 0, 459, 0, 78, 79, 80, 0, 81,
+	82, 83, 0, 0, 84, 0, 85, 340, 86, 87,
+	0, 460, 462, 0, 461, 463, 88, 89, 250, 90,
+	486, 91, 487, 488, 0, 0, 92, 0, 1011, 0,
+	479, 94, 0, 0, 0, 0, 432, 95, 467, 446,
+	341, 0, 0, 0, 96, 97, 489, 98, 0, 0,
+	0, 342, 0, 99, 477, 0, 188, 0, 100, 473,
+	475, 101, 0, 102, 0, 0, 343, 103, 490, 491,
+	492, 104, 0, 458, 0, 344, 105, 345, 106, 107,
+	108, 0, 0, 478, 346, 109, 347, 0, 110, 0,
+	0, 0, 111, 112, 113, 114, 115, 348, 116, 117,
+	422, 118, 447, 474, 119, 493, 120, 121, 0, 0,
+	0, 0, 0, 122, 198, 349, 123, 350, 468, 124,
+	125, 126, 0, 469, 127, 201, 0, 128, 129, 494,
+	130, 131, 0, 132, 133, 134, 135, 136, 0, 137,
+	351, 138, 139, 436, 140, 0, 141, 142, 143, 0,
+	144, 145, 464, 146, 147, 352, 148, 495, 149, 0,
+	150, 151, 153, 205, 152, 470, 0, 0, 154, 155,
+	0, 207, 496, 0, 0, 156, 471, 472, 445, 157,
+	158, 159, 160, 0, 0, 161, 162, 465, 0, 163,
+	164, 165, 166, 211, 497, 1009, 167, 0, 0, 0,
+	0, 168, 169, 170, 171, 423, 0, 451, 439, 440,
+	441, 438, 427, 0, 0, 419, 420, 1012, 0, 69,
+	70, 421, 71, 0, 428, 1007, 0, 433, 0, 0,
+	0, 72, 73, 74, 172, 480, 481, 75, 482, 483,
+	0, 76, 177, 77, 448, 466, 484, 485, 0, 476,
+	0, 459, 0, 78, 79, 80, 0, 81, 82, 83,
+	0, 0, 84, 0, 85, 340, 86, 87, 0, 460,
+	462, 0, 461, 463, 88, 89, 250, 90, 486, 91,
+	487, 488, 512, 0, 92, 0, 0, 0, 479, 94,
+	0, 0, 0, 0, 432, 95, 467, 446, 341, 0,
+	0, 0, 96, 97, 489, 98, 0, 0, 0, 342,
+	0, 99, 477, 0, 188, 0, 100, 473, 475, 101,
+	0, 102, 0, 0, 343, 103, 490, 491, 492, 104,
+	0, 458, 0, 344, 105, 345, 106, 107, 108, 0,
+	0, 478, 346, 109, 347, 0, 110, 0, 0, 0,
+	111, 112, 113, 114, 115, 348, 116, 117, 422, 118,
+	447, 474, 119, 493, 120, 121, 0, 0, 0, 0,
+	0, 122, 198, 349, 123, 350, 468, 124, 125, 126,
+	0, 469, 127, 201, 0, 128, 129, 494, 130, 131,
+	0, 132, 133, 134, 135, 136, 0, 137, 351, 138,
+	139, 436, 140, 0, 141, 142, 143, 52, 144, 145,
+	464, 146, 147, 352, 148, 495, 149, 0, 150, 151,
+	153, 205, 152, 470, 0, 54, 154, 155, 0, 207,
+	496, 0, 0, 156, 471, 472, 445, 157, 158, 159,
+	160, 0, 0, 161, 162, 465, 0, 163, 164, 165,
+	166, 338, 497, 0, 167, 0, 0, 0, 50, 168,
+	169, 170, 171, 423, 51, 451, 439, 440, 441, 438,
+	427, 0, 0, 419, 420, 0, 0, 69, 70, 421,
+	71, 0, 428, 0, 0, 433, 0, 0, 0, 72,
+	73, 74, 172, 480, 481, 75, 482, 483, 0, 76,
+	177, 77, 448, 466, 484, 485, 0, 476, 0, 459,
+	0, 78, 79, 80, 0, 81, 82, 83, 0, 0,
+	84, 0, 85, 340, 86, 87, 0, 460, 462, 0,
+	461, 463, 88, 89, 250, 90, 486, 91, 487, 488,
+	0, 0, 92, 0, 0, 0, 479, 94, 0, 0,
+	0, 0, 432, 95, 467, 446, 341, 0, 0, 0,
+	96, 97, 489, 98, 0, 0, 0, 342, 0, 99,
+	477, 0, 188, 0, 100, 473, 475, 101, 0, 102,
+	0, 0, 343, 103, 490, 491, 492, 104, 0, 458,
+	0, 344, 105, 345, 106, 107, 108, 0, 0, 478,
+	346, 109, 347, 0, 110, 0, 0, 0, 111, 112,
+	113, 114, 115, 348, 116, 117, 422, 118, 447, 474,
+	119, 493, 120, 121, 0, 0, 0, 0, 0, 122,
+	198, 349, 123, 350, 468, 124, 125, 126, 0, 469,
+	127, 201, 0, 128, 129, 494, 130, 131, 0, 132,
+	133, 134, 135, 136, 0, 137, 351, 138, 139, 436,
+	140, 0, 141, 142, 143, 52, 144, 145, 464, 146,
+	147, 352, 148, 495, 149, 0, 150, 151, 153, 205,
+	152, 470, 0, 54, 154, 155, 0, 207, 496, 0,
+	0, 156, 471, 472, 445, 157, 158, 159, 160, 0,
+	0, 161, 162, 465, 0, 163, 164, 165, 166, 338,
+	497, 0, 167, 0, 0, 0, 50, 168, 169, 170,
+	171, 423, 51, 451, 439, 440, 441, 438, 427, 0,
+	0, 419, 420, 0, 0, 69, 70, 421, 71, 0,
+	428, 0, 0, 433, 0, 0, 0, 72, 73, 74,
+	172, 480, 481, 75, 482, 483, 1055, 76, 177, 77,
+	448, 466, 484, 485, 0, 476, 0, 459, 0, 78,
+	79, 80, 0, 81, 82, 83, 0, 0, 84, 0,
+	85, 340, 86, 87, 0, 460, 462, 0, 461, 463,
+	88, 89, 250, 90, 486, 91, 487, 488, 0, 0,
+	92, 0, 0, 0, 479, 94, 0, 0, 0, 0,
+	432, 95, 467, 446, 341, 0, 0, 0, 96, 97,
+	489, 98, 0, 0, 1060, 342, 0, 99, 477, 0,
+	188, 0, 100, 473, 475, 101, 0, 102, 0, 0,
+	343, 103, 490, 491, 492, 104, 0, 458, 0, 344,
+	105, 345, 106, 107, 108, 0, 1056, 478, 346, 109,
+	347, 0, 110, 0, 0, 0, 111, 112, 113, 114,
+	115, 348, 116, 117, 422, 118, 447, 474, 119, 493,
+	120, 121, 0, 0, 0, 0, 0, 122, 198, 349,
+	123, 350, 468, 124, 125, 126, 0, 469, 127, 201,
+	0, 128, 129, 494, 130, 131, 0, 132, 133, 134,
+	135, 136, 0, 137, 351, 138, 139, 436, 140, 0,
+	141, 142, 143, 0, 144, 145, 464, 146, 147, 352,
+	148, 495, 149, 0, 150, 151, 153, 205, 152, 470,
+	0, 0, 154, 155, 0, 207, 496, 0, 1057, 156,
+	471, 472, 445, 157, 158, 159, 160, 0, 0, 161,
+	162, 465, 0, 163, 164, 165, 166, 211, 497, 0,
+	167, 0, 0, 0, 0, 168, 169, 170, 171, 423,
+	0, 451, 439, 440, 441, 438, 427, 0, 0, 419,
+	420, 0, 0, 69, 70, 421, 71, 0, 428, 0,
+	0, 433, 0, 0, 0, 72, 73, 74, 172, 480,
+	481, 75, 482, 483, 0, 76, 177, 77, 448, 466,
+	484, 485, 0, 476, 0, 459, 0, 78, 79, 80,
+	0, 81, 82, 83, 0, 0, 84, 0, 85, 340,
+	86, 87, 0, 460, 462, 0, 461, 463, 88, 89,
+	250, 90, 486, 91, 487, 488, 0, 0, 92, 0,
+	0, 0, 479, 94, 0, 0, 0, 0, 432, 95,
+	467, 446, 341, 0, 0, 0, 96, 97, 489, 98,
+	0, 0, 0, 342, 0, 99, 477, 0, 188, 0,
+	100, 473, 475, 101, 0, 102, 0, 0, 343, 103,
+	490, 491, 492, 104, 0, 458, 0, 344, 105, 345,
+	106, 107, 108, 0, 0, 478, 346, 109, 347, 0,
+	110, 0, 0, 0, 111, 112, 113, 114, 115, 348,
+	116, 117, 422, 118, 447, 474, 119, 493, 120, 121,
+	0, 0, 0, 0, 0, 122, 198, 349, 123, 350,
+	468, 124, 125, 126, 0, 469, 127, 201, 0, 128,
+	129, 494, 130, 131, 0, 132, 133, 134, 135, 136,
+	0, 137, 351, 138, 139, 436, 140, 0, 141, 142,
+	143, 0, 144, 145, 464, 146, 147, 352, 148, 495,
+	149, 0, 150, 151, 153, 205, 152, 470, 0, 0,
+	154, 155, 0, 207, 496, 0, 0, 156, 471, 472,
+	445, 157, 158, 159, 160, 0, 0, 161, 162, 465,
+	0, 163, 164, 165, 166, 211, 497, 0, 167, 0,
+	0, 0, 0, 168, 169, 170, 171, 423, 0, 451,
+	439, 440, 441, 438, 427, 0, 0, 419, 420, 0,
+	0, 69, 70, 421, 71, 0, 428, 1423, 0, 433,
+	0, 0, 0, 72, 73, 74, 172, 480, 481, 75,
+	482, 483, 0, 76, 177, 77, 448, 466, 484, 485,
+	0, 476, 0, 459, 0, 78, 79, 80, 0, 81,
+	82, 83, 0, 0, 84, 0, 85, 340, 86, 87,
+	0, 460, 462, 0, 461, 463, 88, 89, 250, 90,
+	486, 91, 487, 488, 0, 0, 92, 0, 0, 0,
+	479, 94, 0, 0, 0, 0, 432, 95, 467, 446,
+	341, 0, 0, 0, 96, 97, 489, 98, 0, 0,
+	0, 342, 0, 99, 477, 0, 188, 0, 100, 473,
+	475, 101, 0, 102, 0, 0, 343, 103, 490, 491,
+	492, 104, 0, 458, 0, 344, 105, 345, 106, 107,
+	108, 0, 0, 478, 346, 109, 347, 0, 110, 0,
+	0, 0, 111, 112, 113, 114, 115, 348, 116, 117,
+	422, 118, 447, 474, 119, 493, 120, 121, 0, 0,
+	0, 0, 0, 122, 198, 349, 123, 350, 468, 124,
+	125, 126, 0, 469, 127, 201, 0, 128, 129, 494,
+	130, 131, 0, 132, 133, 134, 135, 136, 0, 137,
+	351, 138, 139, 436, 140, 0, 141, 142, 143, 0,
+	144, 145, 464, 146, 147, 352, 148, 495, 149, 0,
+	150, 151, 153, 205, 152, 470, 0, 0, 154, 155,
+	0, 207, 496, 0, 0, 156, 471, 472, 445, 157,
+	158, 159, 160, 0, 0, 161, 162, 465, 0, 163,
+	164, 165, 166, 211, 497, 0, 167, 0, 0, 0,
+	0, 168, 169, 170, 171, 423, 0, 451, 439, 440,
+	441, 438, 427, 0, 0, 419, 420, 0, 0, 69,
+	70, 421, 71, 0, 428, 1363, 0, 433, 0, 0,
+	0, 72, 73, 74, 172, 480, 481, 75, 482, 483,
+	0, 76, 177, 77, 448, 466, 484, 485, 0, 476,
+	0, 459, 0, 78, 79, 80, 0, 81, 82, 83,
+	0, 0, 84, 0, 85, 340, 86, 87, 0, 460,
+	462, 0, 461, 463, 88, 89, 250, 90, 486, 91,
+	487, 488, 0, 0, 92, 0, 0, 0, 479, 94,
+	0, 0, 0, 0, 432, 95, 467, 446, 341, 0,
+	0, 0, 96, 97, 489, 98, 0, 0, 0, 342,
+	0, 99, 477, 0, 188, 0, 100, 473, 475, 101,
+	0, 102, 0, 0, 343, 103, 490, 491, 492, 104,
+	0, 458, 0, 344, 105, 345, 106, 107, 108, 0,
+	0, 478, 346, 109, 347, 0, 110, 0, 0, 0,
+	111, 112, 113, 114, 115, 348, 116, 117, 422, 118,
+	447, 474, 119, 493, 120, 121, 0, 0, 0, 0,
+	0, 122, 198, 349, 123, 350, 468, 124, 125, 126,
+	0, 469, 127, 201, 0, 128, 129, 494, 130, 131,
+	0, 132, 133, 134, 135, 136, 0, 137, 351, 138,
+	139, 436, 140, 0, 141, 142, 143, 0, 144, 145,
+	464, 146, 147, 352, 148, 495, 149, 0, 150, 151,
+	153, 205, 152, 470, 0, 0, 154, 155, 0, 207,
+	496, 0, 0, 156, 471, 472, 445, 157, 158, 159,
+	160, 0, 0, 161, 162, 465, 0, 163, 164, 165,
+	166, 211, 497, 0, 167, 0, 0, 0, 0, 168,
+	169, 170, 171, 423, 0, 451, 439, 440, 441, 438,
+	427, 0, 0, 419, 420, 0, 0, 69, 70, 421,
+	71, 0, 428, 1006, 0, 433, 0, 0, 0, 72,
+	73, 74, 172, 480, 481, 75, 482, 483, 0, 76,
+	177, 77, 448, 466, 484, 485, 0, 476, 0, 459,
+	0, 78, 79, 80, 0, 81, 82, 83, 0, 0,
+	84, 0, 85, 340, 86, 87, 0, 460, 462, 0,
+	461, 463, 88, 89, 250, 90, 486, 91, 487, 488,
+	0, 0, 92, 0, 0, 0, 479, 94, 0, 0,
+	0, 0, 432, 95, 467, 446, 341, 0, 0, 0,
+	96, 97, 489, 98, 0, 0, 0, 342, 0, 99,
+	477, 0, 188, 0, 100, 473, 475, 101, 0, 102,
+	0, 0, 343, 103, 490, 491, 492, 104, 0, 458,
+	0, 344, 105, 345, 106, 107, 108, 0, 0, 478,
+	346, 109, 347, 0, 110, 0, 0, 0, 111, 112,
+	113, 114, 115, 348, 116, 117, 422, 118, 447, 474,
+	119, 493, 120, 121, 0, 0, 0, 0, 0, 122,
+	198, 349, 123, 350, 468, 124, 125, 126, 0, 469,
+	127, 201, 0, 128, 129, 494, 130, 131, 0, 132,
+	133, 134, 135, 136, 0, 137, 351, 138, 139, 436,
+	140, 0, 141, 142, 143, 0, 144, 145, 464, 146,
+	147, 352, 148, 495, 149, 0, 150, 151, 153, 205,
+	152, 470, 0, 0, 154, 155, 0, 207, 496, 0,
+	0, 156, 471, 472, 445, 157, 158, 159, 160, 0,
+	0, 161, 162, 465, 0, 163, 164, 165, 166, 211,
+	497, 0, 167, 0, 0, 0, 0, 168, 169, 170,
+	171, 423, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 419, 420, 0, 0, 0, 0, 421, 757, 1002,
+	428, 451, 439, 440, 441, 438, 427, 0, 0, 0,
+	0, 0, 0, 69, 70, 0, 71, 0, 0, 0,
+	0, 433, 0, 0, 0, 72, 73, 74, 172, 480,
+	481, 75, 482, 483, 0, 76, 177, 77, 448, 466,
+	484, 485, 0, 476, 0, 459, 0, 78, 79, 80,
+	0, 81, 82, 83, 0, 0, 84, 0, 85, 340,
+	86, 87, 0, 460, 462, 0, 461, 463, 88, 89,
+	250, 90, 486, 91, 487, 488, 0, 0, 92, 0,
+	0, 0, 479, 94, 0, 0, 0, 0, 432, 95,
+	467, 446, 341, 0, 0, 0, 96, 97, 489, 98,
+	0, 0, 0, 342, 0, 99, 477, 0, 188, 0,
+	100, 473, 475, 101, 0, 102, 0, 0, 343, 103,
+	490, 491, 492, 104, 0, 458, 0, 344, 105, 345,
+	106, 107, 108, 0, 0, 478, 346, 109, 347, 0,
+	110, 0, 0, 0, 111, 112, 113, 114, 115, 348,
+	116, 117, 422, 118, 447, 474, 119, 493, 120, 121,
+	0, 0, 0, 0, 0, 122, 198, 349, 123, 350,
+	468, 124, 125, 126, 0, 469, 127, 201, 0, 128,
+	129, 494, 130, 131, 0, 132, 133, 134, 135, 136,
+	0, 137, 351, 138, 139, 436, 140, 0, 141, 142,
+	143, 0, 144, 145, 464, 146, 147, 352, 148, 495,
+	149, 0, 150, 151, 153, 205, 152, 470, 0, 0,
+	154, 155, 0, 207, 496, 0, 0, 156, 471, 472,
+	445, 157, 158, 159, 160, 0, 0, 161, 162, 465,
+	0, 163, 164, 165, 166, 211, 497, 1369, 167, 0,
+	0, 0, 0, 168, 169, 170, 171, 423, 0, 451,
+	439, 440, 441, 438, 427, 0, 0, 419, 420, 0,
+	0, 69, 70, 421, 71, 0, 428, 0, 0, 433,
+	0, 0, 0, 72, 73, 74, 172, 480, 481, 75,
+	482, 483, 0, 76, 177, 77, 448, 466, 484, 485,
+	0, 476, 0, 459, 0, 78, 79, 80, 0, 81,
+	82, 83, 0, 0, 84, 0, 85, 340, 86, 87,
+	0, 460, 462, 0, 461, 463, 88, 89, 250, 90,
+	486, 91, 487, 488, 512, 0, 92, 0, 0, 0,
+	479, 94, 0, 0, 0, 0, 432, 95, 467, 446,
+	341, 0, 0, 0, 96, 97, 489, 98, 0, 0,
+	0, 342, 0, 99, 477, 0, 188, 0, 100, 473,
+	475, 101, 0, 102, 0, 0, 343, 103, 490, 491,
+	492, 104, 0, 458, 0, 344, 105, 345, 106, 107,
+	108, 0, 0, 478, 346, 109, 347, 0, 110, 0,
+	0, 0, 111, 112, 113, 114, 115, 348, 116, 117,
+	422, 118, 447, 474, 119, 493, 120, 121, 0, 0,
+	0, 0, 0, 122, 198, 349, 123, 350, 468, 124,
+	125, 126, 0, 469, 127, 201, 0, 128, 129, 494,
+	130, 131, 0, 132, 133, 134, 135, 136, 0, 137,
+	351, 138, 139, 436, 140, 0, 141, 142, 143, 0,
+	144, 145, 464, 146, 147, 352, 148, 495, 149, 0,
+	150, 151, 153, 205, 152, 470, 0, 0, 154, 155,
+	0, 207, 496, 0, 0, 156, 471, 472, 445, 157,
+	158, 159, 160, 0, 0, 161, 162, 465, 0, 163,
+	164, 165, 166, 211, 497, 0, 167, 0, 0, 0,
+	0, 168, 169, 170, 171, 423, 0, 451, 439, 440,
+	441, 438, 427, 0, 0, 419, 420, 0, 0, 69,
+	70, 421, 71, 0, 428, 0, 0, 433, 0, 0,
+	0, 72, 73, 74, 172, 480, 481, 75, 482, 483,
+	0, 76, 177, 77, 448, 466, 484, 485, 0, 476,
+	0, 459, 0, 78, 79, 80, 0, 81, 82, 83,
+	0, 0, 84, 0, 85, 340, 86, 87, 0, 460,
+	462, 0, 461, 463, 88, 89, 250, 90, 486, 91,
+	487, 488, 0, 0, 92, 0, 0, 0, 479, 94,
+	0, 0, 0, 0, 432, 95, 467, 446, 341, 0,
+	0, 0, 96, 97, 489, 98, 0, 0, 1060, 342,
+	0, 99, 477, 0, 188, 0, 100, 473, 475, 101,
+	0, 102, 0, 0, 343, 103, 490, 491, 492, 104,
+	0, 458, 0, 344, 105, 345, 106, 107, 108, 0,
+	0, 478, 346, 109, 347, 0, 110, 0, 0, 0,
+	111, 112, 113, 114, 115, 348, 116, 117, 422, 118,
+	447, 474, 119, 493, 120, 121, 0, 0, 0, 0,
+	0, 122, 198, 349, 123, 350, 468, 124, 125, 126,
+	0, 469, 127, 201, 0, 128, 129, 494, 130, 131,
+	0, 132, 133, 134, 135, 136, 0, 137, 351, 138,
+	139, 436, 140, 0, 141, 142, 143, 0, 144, 145,
+	464, 146, 147, 352, 148, 495, 149, 0, 150, 151,
+	153, 205, 152, 470, 0, 0, 154, 155, 0, 207,
+	496, 0, 0, 156, 471, 472, 445, 157, 158, 159,
+	160, 0, 0, 161, 162, 465, 0, 163, 164, 165,
+	166, 211, 497, 0, 167, 0, 0, 0, 0, 168,
+	169, 170, 171, 423, 0, 451, 439, 440, 441, 438,
+	427, 0, 0, 419, 420, 0, 0, 69, 70, 421,
+	71, 0, 428, 0, 0, 433, 0, 0, 0, 72,
+	73, 74, 172, 480, 481, 75, 482, 483, 0, 76,
+	177, 77, 448, 466, 484, 485, 0, 476, 0, 459,
+	0, 78, 79, 80, 0, 81, 82, 83, 0, 0,
+	84, 0, 85, 340, 86, 87, 0, 460, 462, 0,
+	461, 463, 88, 89, 250, 90, 486, 91, 487, 488,
+	0, 0, 92, 0, 0, 0, 479, 94, 0, 0,
+	0, 0, 432, 95, 467, 446, 341, 0, 0, 0,
+	96, 97, 489, 98, 0, 0, 0, 342, 0, 99,
+	477, 0, 188, 0, 100, 473, 475, 101, 0, 102,
+	0, 0, 343, 103, 490, 491, 492, 104, 0, 458,
+	0, 344, 105, 345, 106, 107, 108, 0, 0, 478,
+	346, 109, 347, 0, 110, 0, 0, 0, 111, 112,
+	113, 114, 115, 348, 116, 117, 422, 118, 447, 474,
+	119, 493, 120, 121, 0, 0, 0, 0, 0, 122,
+	198, 349, 123, 350, 468, 124, 125, 126, 0, 469,
+	127, 201, 0, 128, 129, 494, 130, 131, 0, 132,
+	133, 134, 135, 136, 0, 137, 351, 138, 139, 436,
+	140, 0, 141, 142, 143, 0, 144, 145, 464, 146,
+	147, 352, 148, 495, 149, 0, 150, 151, 153, 205,
+	152, 470, 0, 0, 154, 155, 0, 207, 496, 0,
+	0, 156, 471, 472, 445, 157, 158, 159, 160, 0,
+	0, 161, 162, 465, 0, 163, 164, 165, 166, 211,
+	497, 0, 167, 0, 0, 0, 0, 168, 169, 170,
+	171, 423, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 419, 420, 417, 0, 0, 0, 421, 0, 0,
+	428, 451, 439, 440, 441, 438, 427, 0, 0, 0,
+	0, 0, 0, 69, 70, 695, 71, 0, 0, 0,
+	0, 433, 0, 0, 0, 72, 73, 74, 172, 480,
+	481, 75, 482, 483, 0, 76, 177, 77, 448, 466,
+	484, 485, 0, 476, 0, 459, 0, 78, 79, 80,
+	0, 81, 82, 83, 0, 0, 84, 0, 85, 340,
+	86, 87, 0, 460, 462, 0, 461, 463, 88, 89,
+	250, 90, 486, 91, 487, 488, 0, 0, 92, 0,
+	0, 0, 479, 94, 0, 0, 0, 0, 432, 95,
+	467, 446, 341, 0, 0, 0, 96, 97, 489, 98,
+	0, 0, 0, 342, 0, 99, 477, 0, 188, 0,
+	100, 473, 475, 101, 0, 102, 0, 0, 343, 103,
+	490, 491, 492, 104, 0, 458, 0, 344, 105, 345,
+	106, 107, 108, 0, 0, 478, 346, 109, 347, 0,
+	110, 0, 0, 0, 111, 112, 113, 114, 115, 348,
+	116, 117, 422, 118, 447, 474, 119, 493, 120, 121,
+	0, 0, 0, 0, 0, 122, 198, 349, 123, 350,
+	468, 124, 125, 126, 0, 469, 127, 201, 0, 128,
+	129, 494, 130, 131, 0, 132, 133, 134, 135, 136,
+	0, 137, 351, 138, 139, 436, 140, 0, 141, 142,
+	143, 0, 144, 145, 464, 146, 147, 352, 148, 495,
+	149, 0, 150, 151, 153, 205, 152, 470, 0, 0,
+	154, 155, 0, 207, 496, 0, 0, 156, 471, 472,
+	445, 157, 158, 159, 160, 0, 0, 161, 162, 465,
+	0, 163, 164, 165, 166, 211, 497, 0, 167, 0,
+	0, 0, 0, 168, 169, 170, 171, 423, 0, 451,
+	439, 440, 441, 438, 427, 0, 0, 419, 420, 0,
+	0, 69, 70, 421, 71, 0, 428, 0, 0, 433,
+	0, 0, 0, 72, 73, 74, 172, 480, 481, 75,
+	482, 483, 0, 76, 177, 77, 448, 466, 484, 485,
+	0, 476, 0, 459, 0, 78, 79, 80, 0, 81,
+	82, 83, 0, 0, 84, 0, 85, 340, 86, 1698,
+	0, 460, 462, 0, 461, 463, 88, 89, 250, 90,
+	486, 91, 487, 488, 0, 0, 92, 0, 0, 0,
+	479, 94, 0, 0, 0, 0, 432, 95, 467, 446,
+	341, 0, 0, 0, 96, 97, 489, 98, 0, 0,
+	0, 342, 0, 99, 477, 0, 188, 0, 100, 473,
+	475, 101, 0, 102, 0, 0, 343, 103, 490, 491,
+	492, 104, 0, 458, 0, 344, 105, 345, 106, 107,
+	108, 0, 0, 478, 346, 109, 347, 0, 110, 0,
+	0, 0, 111, 112, 113, 114, 115, 348, 116, 117,
+	422, 118, 447, 474, 119, 493, 120, 121, 0, 0,
+	0, 0, 0, 122, 198, 349, 123, 350, 468, 124,
+	125, 126, 0, 469, 127, 201, 0, 128, 129, 494,
+	130, 131, 0, 132, 133, 134, 135, 136, 0, 137,
+	351, 138, 139, 436, 140, 0, 141, 142, 143, 0,
+	144, 145, 464, 146, 147, 352, 148, 495, 149, 0,
+	150, 151, 153, 205, 152, 470, 0, 0, 154, 155,
+	0, 207, 496, 0, 0, 156, 471, 472, 445, 157,
+	158, 1697, 160, 0, 0, 161, 162, 465, 0, 163,
+	164, 165, 166, 211, 497, 0, 167, 0, 0, 0,
+	0, 168, 169, 170, 171, 423, 0, 451, 439, 440,
+	441, 438, 427, 0, 0, 419, 420, 0, 0, 69,
+	70, 421, 71, 0, 428, 0, 0, 433, 0, 0,
+	0, 72, 73, 74, 1696, 480, 481, 75, 482, 483,
+	0, 76, 177, 77, 448, 466, 484, 485, 0, 476,
+	0, 459, 0, 78, 79, 80, 0, 81, 82, 83,
+	0, 0, 84, 0, 85, 340, 86, 1698, 0, 460,
+	462, 0, 461, 463, 88, 89, 250, 90, 486, 91,
+	487, 488, 0, 0, 92, 0, 0, 0, 479, 94,
+	0, 0, 0, 0, 432, 95, 467, 446, 341, 0,
+	0, 0, 96, 97, 489, 98, 0, 0, 0, 342,
+	0, 99, 477, 0, 188, 0, 100, 473, 475, 101,
+	0, 102, 0, 0, 343, 103, 490, 491, 492, 104,
+	0, 458, 0, 344, 105, 345, 106, 107, 108, 0,
+	0, 478, 346, 109, 347, 0, 110, 0, 0, 0,
+	111, 112, 113, 114, 115, 348, 116, 117, 422, 118,
+	447, 474, 119, 493, 120, 121, 0, 0, 0, 0,
+	0, 122, 198, 349, 123, 350, 468, 124, 125, 126,
+	0, 469, 127, 201, 0, 128, 129, 494, 130, 131,
+	0, 132, 133, 134, 135, 136, 0, 137, 351, 138,
+	139, 436, 140, 0, 141, 142, 143, 0, 144, 145,
+	464, 146, 147, 352, 148, 495, 149, 0, 150, 151,
+	153, 205, 152, 470, 0, 0, 154, 155, 0, 207,
+	496, 0, 0, 156, 471, 472, 445, 157, 158, 1697,
+	160, 0, 0, 161, 162, 465, 0, 163, 164, 165,
+	166, 211, 497, 0, 167, 0, 0, 0, 0, 168,
+	169, 170, 171, 423, 0, 451, 439, 440, 441, 438,
+	427, 0, 0, 419, 420, 0, 0, 69, 70, 421,
+	71, 0, 428, 0, 0, 433, 0, 0, 0, 72,
+	73, 74, 172, 480, 481, 75, 482, 483, 0, 76,
+	177, 77, 448, 466, 484, 485, 0, 476, 0, 459,
+	0, 78, 79, 80, 0, 81, 82, 83, 0, 0,
+	84, 0, 85, 340, 86, 87, 0, 460, 462, 0,
+	461, 463, 88, 89, 250, 90, 486, 91, 487, 488,
+	0, 0, 92, 0, 0, 0, 479, 94, 0, 0,
+	0, 0, 432, 95, 467, 446, 341, 0, 0, 0,
+	96, 97, 489, 98, 0, 0, 0, 342, 0, 99,
+	477, 0, 188, 0, 100, 473, 475, 101, 0, 102,
+	0, 0, 343, 103, 490, 491, 492, 104, 0, 458,
+	0, 344, 105, 345, 106, 107, 108, 0, 0, 478,
+	346, 109, 347, 0, 110, 0, 0, 0, 111, 112,
+	113, 114, 115, 348, 116, 117, 422, 118, 447, 474,
+	119, 493, 120, 121, 0, 0, 0, 0, 0, 122,
+	198, 349, 123, 350, 468, 124, 125, 126, 0, 469,
+	127, 201, 0, 128, 129, 494, 130, 131, 0, 132,
+	133, 134, 135, 136, 0, 137, 351, 138, 139, 436,
+	140, 0, 141, 142, 143, 0, 144, 145, 464, 146,
+	147, 352, 148, 495, 149, 0, 150, 151, 153, 205,
+	152, 470, 0, 0, 154, 155, 0, 207, 496, 0,
+	0, 156, 471, 472, 445, 157, 158, 159, 160, 0,
+	0, 161, 162, 465, 0, 163, 164, 165, 166, 211,
+	497, 0, 167, 0, 0, 0, 0, 168, 169, 170,
+	171, 423, 0, 451, 439, 440, 441, 438, 427, 0,
+	0, 419, 420, 0, 0, 69, 70, 421, 71, 0,
+	428, 0, 0, 433, 0, 0, 0, 72, 73, 74,
+	172, 480, 481, 75, 482, 483, 0, 76, 177, 77,
+	448, 466, 484, 485, 0, 476, 0, 459, 0, 78,
+	79, 80, 0, 81, 82, 83, 0, 0, 84, 0,
+	85, 340, 86, 87, 0, 460, 462, 0, 461, 463,
+	88, 89, 250, 90, 486, 91, 487, 488, 0, 0,
+	92, 0, 0, 0, 479, 94, 0, 0, 0, 0,
+	432, 95, 467, 446, 341, 0, 0, 0, 96, 97,
+	489, 98, 0, 0, 0, 342, 0, 99, 477, 0,
+	188, 0, 100, 473, 475, 101, 0, 102, 0, 0,
+	343, 103, 490, 491, 492, 104, 0, 458, 0, 344,
+	105, 345, 106, 107, 108, 0, 0, 478, 346, 109,
+	347, 0, 110, 0, 0, 0, 111, 112, 113, 114,
+	115, 348, 116, 117, 0, 118, 447, 474, 119, 493,
+	120, 121, 0, 0, 0, 0, 0, 122, 198, 349,
+	123, 350, 468, 124, 125, 126, 0, 469, 127, 201,
+	0, 128, 129, 494, 130, 131, 0, 132, 133, 134,
+	135, 136, 0, 137, 351, 138, 139, 1050, 140, 0,
+	141, 142, 143, 0, 144, 145, 464, 146, 147, 352,
+	148, 495, 149, 0, 150, 151, 153, 205, 152, 470,
+	0, 0, 154, 155, 0, 207, 496, 0, 0, 156,
+	471, 472, 445, 157, 158, 159, 160, 0, 0, 161,
+	162, 465, 0, 163, 164, 165, 166, 211, 497, 0,
+	167, 0, 0, 0, 0, 168, 169, 170, 171, 451,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 1046,
+	1047, 69, 70, 0, 71, 1048, 0, 0, 1049, 0,
+	0, 0, 0, 72, 73, 74, 172, 173, 174, 75,
+	175, 176, 0, 76, 177, 77, 0, 466, 178, 179,
+	0, 476, 0, 459, 0, 78, 79, 80, 0, 81,
+	82, 83, 0, 0, 84, 0, 85, 340, 86, 87,
+	0, 460, 462, 0, 461, 463, 88, 89, 250, 90,
+	181, 91, 182, 183, 0, 0, 92, 0, 0, 0,
+	93, 94, 0, 0, 0, 0, 184, 95, 467, 0,
+	341, 0, 0, 0, 96, 97, 186, 98, 0, 0,
+	0, 342, 0, 99, 477, 0, 188, 0, 100, 473,
+	475, 101, 0, 102, 0, 0, 343, 103, 191, 192,
+	193, 104, 0, 194, 0, 344, 105, 345, 106, 107,
+	108, 0, 0, 478, 346, 109, 347, 0, 110, 0,
+	0, 0, 111, 112, 113, 114, 115, 348, 116, 117,
+	0, 118, 0, 474, 119, 197, 120, 121, 0, 0,
+	300, 0, 0, 122, 198, 349, 123, 350, 468, 124,
+	125, 126, 0, 469, 127, 201, 0, 128, 129, 202,
+	130, 131, 0, 132, 133, 134, 135, 136, 0, 137,
+	351, 138, 139, 203, 140, 0, 141, 142, 143, 52,
+	144, 145, 464, 146, 147, 352, 148, 204, 149, 0,
+	150, 151, 153, 205, 152, 470, 0, 54, 154, 155,
+	0, 207, 208, 0, 0, 156, 471, 472, 0, 157,
+	158, 159, 160, 0, 0, 161, 162, 465, 0, 163,
+	164, 165, 166, 338, 212, 0, 167, 0, 0, 0,
+	50, 168, 169, 170, 171, 451, 51, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 69, 70, 0,
+	71, 0, 0, 0, 904, 0, 0, 0, 0, 72,
+	73, 74, 172, 173, 174, 75, 175, 176, 0, 76,
+	177, 77, 0, 466, 178, 179, 0, 476, 0, 459,
+	0, 78, 79, 80, 0, 81, 82, 83, 0, 0,
+	84, 0, 85, 340, 86, 87, 0, 460, 462, 0,
+	461, 463, 88, 89, 250, 90, 181, 91, 182, 183,
+	0, 0, 92, 0, 0, 0, 93, 94, 0, 0,
+	0, 0, 184, 95, 467, 0, 341, 0, 0, 0,
+	96, 97, 186, 98, 0, 0, 0, 342, 0, 99,
+	477, 0, 188, 0, 100, 473, 475, 101, 0, 102,
+	0, 0, 343, 103, 191, 192, 193, 104, 0, 194,
+	0, 344, 105, 345, 106, 107, 108, 0, 0, 478,
+	346, 109, 347, 0, 110, 0, 0, 0, 111, 112,
+	113, 114, 115, 348, 116, 117, 0, 118, 0, 474,
+	119, 197, 120, 121, 0, 0, 300, 0, 0, 122,
+	198, 349, 123, 350, 468, 124, 125, 126, 0, 469,
+	127, 201, 0, 128, 129, 202, 130, 131, 0, 132,
+	133, 134, 135, 136, 0, 137, 351, 138, 139, 203,
+	140, 0, 141, 142, 143, 0, 144, 145, 464, 146,
+	147, 352, 148, 204, 149, 0, 150, 151, 153, 205,
+	152, 470, 0, 0, 154, 155, 0, 207, 208, 0,
+	0, 156, 471, 472, 0, 157, 158, 159, 160, 0,
+	0, 161, 162, 465, 0, 163, 164, 165, 166, 211,
+	212, 0, 167, 0, 0, 0, 0, 168, 169, 170,
+	171, 451, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 69, 70, 0, 71, 0, 0, 0,
+	904, 0, 0, 0, 0, 72, 73, 74, 172, 173,
+	174, 75, 175, 176, 0, 76, 177, 77, 0, 466,
+	178, 179, 0, 476, 0, 459, 0, 78, 79, 80,
+	0, 81, 82, 83, 0, 0, 84, 0, 85, 340,
+	86, 87, 0, 460, 462, 0, 461, 463, 88, 89,
+	250, 90, 181, 91, 182, 183, 0, 0, 92, 0,
+	0, 0, 93, 94, 0, 0, 0, 0, 184, 95,
+	467, 0, 341, 0, 0, 0, 96, 97, 186, 98,
+	0, 0, 0, 342, 0, 99, 477, 0, 188, 0,
+	100, 473, 475, 101, 0, 102, 0, 0, 343, 103,
+	191, 192, 193, 104, 0, 194, 0, 344, 105, 345,
+	106, 107, 108, 0, 0, 478, 346, 109, 347, 0,
+	110, 0, 0, 0, 111, 112, 113, 114, 115, 348,
+	116, 117, 0, 118, 0, 474, 119, 197, 120, 121,
+	0, 0, 0, 0, 0, 122, 198, 349, 123, 350,
+	468, 124, 125, 126, 0, 469, 127, 201, 0, 128,
+	129, 202, 130, 131, 0, 132, 133, 134, 135, 136,
+	0, 137, 351, 138, 139, 203, 140, 0, 141, 142,
+	143, 0, 144, 145, 464, 146, 147, 352, 148, 204,
+	149, 0, 150, 151, 153, 205, 152, 470, 0, 0,
+	154, 155, 0, 207, 208, 0, 0, 156, 471, 472,
+	0, 157, 158, 159, 160, 0, 0, 161, 162, 465,
+	0, 163, 164, 165, 166, 211, 212, 0, 167, 0,
+	0, 0, 0, 168, 169, 170, 171, 334, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 69,
+	70, 0, 71, 0, 0, 0, 1293, 0, 0, 0,
+	0, 72, 73, 74, 172, 173, 174, 75, 175, 176,
+	0, 76, 177, 77, 0, 0, 178, 179, 0, 180,
+	0, 339, 0, 78, 79, 80, 0, 81, 82, 83,
+	0, 0, 84, 0, 85, 340, 86, 87, 0, 0,
+	0, 0, 0, 0, 88, 89, 250, 90, 181, 91,
+	182, 183, 0, 0, 92, 0, 0, 0, 93, 94,
+	0, 0, 0, 0, 184, 95, 185, 0, 341, 0,
+	0, 0, 96, 97, 186, 98, 0, 0, 0, 342,
+	0, 99, 187, 0, 188, 0, 100, 189, 190, 101,
+	0, 102, 0, 0, 343, 103, 191, 192, 193, 104,
+	0, 194, 0, 344, 105, 345, 106, 107, 108, 0,
+	0, 195, 346, 109, 347, 0, 110, 0, 0, 0,
+	111, 112, 113, 114, 115, 348, 116, 117, 0, 118,
+	0, 196, 119, 197, 120, 121, 0, 0, 0, 0,
+	0, 122, 198, 349, 123, 350, 199, 124, 125, 126,
+	0, 200, 127, 201, 0, 128, 129, 202, 130, 131,
+	0, 132, 133, 134, 135, 136, 0, 137, 351, 138,
+	139, 203, 140, 0, 141, 142, 143, 52, 144, 145,
+	0, 146, 147, 352, 148, 204, 149, 0, 150, 151,
+	153, 205, 152, 206, 0, 54, 154, 155, 0, 207,
+	208, 0, 0, 156, 209, 210, 0, 157, 158, 159,
+	160, 0, 0, 161, 162, 0, 0, 163, 164, 165,
+	166, 338, 212, 0, 167, 0, 0, 0, 50, 168,
+	169, 170, 171, 0, 51, 334, 649, 653, 0, 654,
+	644, 0, 0, 0, 0, 0, 0, 69, 70, 0,
+	71, 0, 49, 0, 0, 0, 0, 0, 0, 72,
+	73, 74, 172, 173, 174, 75, 175, 176, 0, 76,
+	177, 77, 0, 0, 178, 179, 0, 180, 0, 339,
+	0, 78, 79, 80, 0, 81, 82, 83, 0, 0,
+	84, 0, 85, 340, 86, 87, 0, 0, 0, 0,
+	0, 0, 88, 89, 250, 90, 181, 91, 182, 183,
+	657, 0, 92, 0, 0, 0, 93, 94, 0, 0,
+	0, 0, 184, 95, 185, 646, 341, 0, 0, 0,
+	96, 97, 186, 98, 0, 0, 0, 342, 0, 99,
+	187, 0, 188, 0, 100, 189, 190, 101, 0, 102,
+	0, 0, 343, 103, 191, 192, 193, 104, 0, 194,
+	0, 344, 105, 345, 106, 107, 108, 0, 0, 195,
+	346, 109, 347, 0, 110, 0, 0, 0, 111, 112,
+	113, 114, 115, 348, 116, 117, 0, 118, 0, 196,
+	119, 197, 120, 121, 0, 647, 0, 0, 0, 122,
+	198, 349, 123, 350, 199, 124, 125, 126, 0, 200,
+	127, 201, 0, 128, 129, 202, 130, 131, 0, 132,
+	133, 134, 135, 136, 0, 137, 351, 138, 139, 203,
+	140, 0, 141, 142, 143, 0, 144, 145, 0, 146,
+	147, 352, 148, 204, 149, 0, 150, 151, 153, 205,
+	152, 206, 0, 0, 154, 155, 0, 207, 208, 0,
+	0, 156, 209, 210, 645, 157, 158, 159, 160, 0,
+	0, 161, 162, 0, 0, 163, 164, 165, 166, 211,
+	212, 0, 167, 0, 0, 0, 0, 168, 169, 170,
+	171, 334, 649, 653, 0, 654, 644, 0, 0, 0,
+	0, 655, 650, 69, 70, 0, 71, 0, 0, 0,
+	0, 0, 0, 0, 0, 72, 73, 74, 172, 173,
+	174, 75, 175, 176, 0, 76, 177, 77, 0, 0,
+	178, 179, 0, 180, 0, 339, 0, 78, 79, 80,
+	0, 81, 82, 83, 0, 0, 84, 0, 85, 340,
+	86, 87, 0, 0, 0, 0, 0, 0, 88, 89,
+	250, 90, 181, 91, 182, 183, 640, 0, 92, 0,
+	0, 0, 93, 94, 0, 0, 0, 0, 184, 95,
+	185, 646, 341, 0, 0, 0, 96, 97, 186, 98,
+	0, 0, 0, 342, 0, 99, 187, 0, 188, 0,
+	100, 189, 190, 101, 0, 102, 0, 0, 343, 103,
+	191, 192, 193, 104, 0, 194, 0, 344, 105, 345,
+	106, 107, 108, 0, 0, 195, 346, 109, 347, 0,
+	110, 0, 0, 0, 111, 112, 113, 114, 115, 348,
+	116, 117, 0, 118, 0, 196, 119, 197, 120, 121,
+	0, 647, 0, 0, 0, 122, 198, 349, 123, 350,
+	199, 124, 125, 126, 0, 200, 127, 201, 0, 128,
+	129, 202, 130, 131, 0, 132, 133, 134, 135, 136,
+	0, 137, 351, 138, 139, 203, 140, 0, 141, 142,
+	143, 0, 144, 145, 0, 146, 147, 352, 148, 204,
+	149, 0, 150, 151, 153, 205, 152, 206, 0, 0,
+	154, 155, 0, 207, 208, 0, 0, 156, 209, 210,
+	645, 157, 158, 159, 160, 0, 0, 161, 162, 0,
+	0, 163, 164, 165, 166, 211, 212, 0, 167, 0,
+	0, 0, 0, 168, 169, 170, 171, 334, 649, 653,
+	0, 654, 644, 0, 0, 0, 0, 655, 650, 69,
+	70, 0, 71, 0, 0, 0, 0, 0, 0, 0,
+	0, 72, 73, 74, 172, 173, 174, 75, 175, 176,
+	0, 76, 177, 77, 0, 0, 178, 179, 0, 180,
+	0, 339, 0, 78, 79, 80, 0, 81, 82, 83,
+	0, 0, 84, 0, 85, 340, 86, 87, 0, 0,
+	0, 0, 0, 0, 88, 89, 250, 90, 181, 91,
+	182, 183, 0, 0, 92, 0, 0, 0, 93, 94,
+	0, 0, 0, 0, 184, 95, 185, 646, 341, 0,
+	0, 0, 96, 97, 186, 98, 0, 0, 0, 342,
+	0, 99, 187, 0, 188, 0, 100, 189, 190, 101,
+	0, 102, 0, 0, 343, 103, 191, 192, 193, 104,
+	0, 194, 0, 344, 105, 345, 106, 107, 108, 0,
+	0, 195, 346, 109, 347, 0, 110, 0, 0, 0,
+	111, 112, 113, 114, 115, 348, 116, 117, 0, 118,
+	0, 196, 119, 197, 120, 121, 0, 647, 0, 0,
+	0, 122, 198, 349, 123, 350, 199, 124, 125, 126,
+	0, 200, 127, 201, 0, 128, 129, 202, 130, 131,
+	0, 132, 133, 134, 135, 136, 0, 137, 351, 138,
+	139, 203, 140, 0, 141, 142, 143, 0, 144, 145,
+	0, 146, 147, 352, 148, 204, 149, 0, 150, 151,
+	153, 205, 152, 206, 0, 0, 154, 155, 0, 207,
+	208, 0, 0, 156, 209, 210, 645, 157, 158, 159,
+	160, 0, 0, 161, 162, 0, 0, 163, 164, 165,
+	166, 211, 212, 66, 167, 0, 0, 0, 0, 168,
+	169, 170, 171, 0, 0, 69, 70, 0, 71, 0,
+	0, 0, 0, 655, 650, 0, 0, 72, 73, 74,
+	172, 173, 174, 75, 175, 176, 0, 76, 177, 77,
+	0, 0, 178, 179, 0, 180, 0, 0, 0, 78,
+	79, 80, 0, 81, 82, 83, 0, 0, 84, 0,
+	85, 0, 86, 87, 0, 0, 0, 0, 0, 0,
+	88, 89, 250, 90, 181, 91, 182, 183, 0, 0,
+	92, 0, 0, 0, 93, 94, 0, 0, 0, 0,
+	184, 95, 185, 0, 0, 0, 0, 0, 96, 97,
+	186, 98, 0, 0, 0, 0, 0, 99, 187, 0,
+	188, 0, 100, 189, 190, 101, 0, 102, 0, 0,
+	0, 103, 191, 192, 193, 104, 0, 194, 0, 0,
+	105, 0, 106, 107, 108, 0, 0, 195, 0, 109,
+	0, 0, 110, 0, 0, 0, 111, 112, 113, 114,
+	115, 0, 116, 117, 0, 118, 0, 196, 119, 197,
+	120, 121, 0, 0, 0, 0, 0, 122, 198, 0,
+	123, 0, 199, 124, 125, 126, 0, 200, 127, 201,
+	0, 128, 129, 202, 130, 131, 0, 132, 133, 134,
+	135, 136, 0, 137, 0, 138, 139, 203, 140, 0,
+	141, 142, 143, 52, 144, 145, 0, 146, 147, 0,
+	148, 204, 149, 0, 150, 151, 153, 205, 152, 206,
+	0, 54, 154, 155, 0, 207, 208, 0, 0, 156,
+	209, 210, 0, 157, 158, 159, 160, 0, 0, 161,
+	162, 0, 0, 163, 164, 165, 166, 338, 212, 0,
+	167, 0, 0, 0, 50, 168, 169, 170, 171, 66,
+	51, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 69, 70, 0, 71, 0, 0, 0, 49, 0,
+	1158, 0, 0, 72, 73, 74, 172, 173, 174, 75,
+	175, 176, 0, 76, 177, 77, 0, 0, 178, 179,
+	0, 180, 0, 0, 0, 78, 79, 80, 0, 81,
+	82, 83, 0, 0, 84, 0, 85, 0, 86, 87,
+	0, 0, 0, 0, 0, 0, 88, 89, 250, 90,
+	181, 91, 182, 183, 0, 0, 92, 0, 0, 0,
+	93, 94, 0, 0, 0, 0, 184, 95, 185, 0,
+	0, 0, 0, 0, 96, 97, 186, 98, 0, 0,
+	0, 0, 0, 99, 187, 0, 188, 0, 100, 189,
+	190, 101, 0, 102, 0, 0, 0, 103, 191, 192,
+	193, 104, 0, 194, 0, 0, 105, 0, 106, 107,
+	108, 0, 0, 195, 0, 109, 0, 0, 110, 0,
+	0, 0, 111, 112, 113, 114, 115, 0, 116, 117,
+	0, 118, 0, 196, 119, 197, 120, 121, 0, 0,
+	0, 0, 0, 122, 198, 0, 123, 0, 199, 124,
+	125, 126, 0, 200, 127, 201, 0, 128, 129, 202,
+	130, 131, 0, 132, 133, 134, 135, 136, 0, 137,
+	0, 138, 139, 203, 140, 0, 141, 142, 143, 0,
+	144, 145, 0, 146, 147, 0, 148, 204, 149, 0,
+	150, 151, 153, 205, 152, 206, 0, 0, 154, 155,
+	0, 207, 208, 0, 0, 156, 209, 210, 0, 157,
+	158, 159, 160, 0, 0, 161, 162, 0, 0, 163,
+	164, 165, 166, 211, 212, 0, 167, 0, 0, 0,
+	0, 168, 169, 170, 171, 66, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 69, 70, 0,
+	71, 0, 0, 0, 0, 408, 0, 0, 0, 72,
+	73, 74, 172, 173, 174, 75, 175, 176, 0, 76,
+	177, 77, 0, 0, 178, 179, 0, 180, 0, 0,
+	0, 78, 79, 80, 0, 81, 82, 83, 0, 0,
+	84, 0, 85, 0, 86, 87, 0, 0, 0, 0,
+	0, 0, 88, 89, 250, 90, 181, 91, 182, 183,
+	0, 0, 92, 0, 0, 0, 93, 94, 0, 0,
+	0, 0, 184, 95, 185, 0, 0, 0, 0, 0,
+	96, 97, 186, 98, 0, 0, 0, 0, 0, 99,
+	187, 0, 188, 0, 100, 189, 190, 101, 0, 102,
+	0, 0, 0, 103, 191, 192, 193, 104, 0, 194,
+	0, 0, 105, 0, 106, 107, 108, 0, 0, 195,
+	0, 109, 0, 0, 110, 0, 0, 0, 111, 112,
+	113, 114, 115, 0, 116, 117, 0, 118, 0, 196,
+	119, 197, 120, 121, 0, 0, 0, 0, 0, 122,
+	198, 0, 123, 0, 199, 124, 125, 126, 0, 200,
+	127, 201, 0, 128, 129, 202, 130, 131, 0, 132,
+	133, 134, 135, 136, 0, 137, 0, 138, 139, 203,
+	140, 0, 141, 142, 143, 0, 144, 145, 0, 146,
+	147, 0, 148, 204, 149, 0, 150, 151, 153, 205,
+	152, 206, 0, 0, 154, 155, 0, 207, 208, 0,
+	0, 156, 209, 210, 0, 157, 158, 159, 160, 0,
+	0, 161, 162, 0, 0, 163, 164, 165, 166, 211,
+	212, 0, 167, 0, 0, 0, 0, 168, 169, 170,
+	171, 66, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 69, 70, 0, 71, 0, 0, 0,
+	845, 0, 0, 0, 0, 72, 73, 74, 172, 173,
+	174, 75, 175, 176, 0, 76, 177, 77, 0, 0,
+	178, 179, 0, 180, 0, 0, 0, 78, 79, 80,
+	0, 81, 82, 83, 0, 0, 84, 0, 85, 0,
+	86, 87, 0, 0, 0, 0, 0, 0, 88, 89,
+	250, 90, 181, 91, 182, 183, 0, 0, 92, 0,
+	0, 0, 93, 94, 0, 0, 0, 0, 184, 95,
+	185, 0, 0, 0, 0, 0, 96, 97, 186, 98,
+	0, 0, 0, 0, 0, 99, 187, 0, 188, 0,
+	100, 189, 190, 101, 0, 102, 0, 0, 0, 103,
+	191, 192, 193, 104, 0, 194, 0, 0, 105, 0,
+	106, 107, 108, 0, 0, 195, 0, 109, 0, 0,
+	110, 0, 0, 0, 111, 112, 113, 114, 115, 0,
+	116, 117, 0, 118, 0, 196, 119, 197, 120, 121,
+	0, 0, 0, 0, 0, 122, 198, 0, 123, 0,
+	199, 124, 125, 126, 0, 200, 127, 201, 0, 128,
+	129, 202, 130, 131, 0, 132, 133, 134, 135, 136,
+	0, 137, 0, 138, 139, 203, 140, 0, 141, 142,
+	143, 0, 144, 145, 0, 146, 147, 0, 148, 204,
+	149, 0, 150, 151, 153, 205, 152, 206, 0, 0,
+	154, 155, 0, 207, 208, 0, 0, 156, 209, 210,
+	0, 157, 158, 159, 160, 0, 0, 161, 162, 0,
+	0, 163, 164, 165, 166, 211, 212, 0, 167, 0,
+	0, 0, 0, 168, 169, 170, 171, 66, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 69,
+	70, 0, 71, 0, 0, 0, 1387, 0, 0, 0,
+	0, 72, 73, 74, 172, 173, 174, 75, 175, 176,
+	0, 76, 177, 77, 0, 0, 178, 179, 0, 180,
+	0, 0, 0, 78, 79, 80, 0, 81, 82, 83,
+	0, 0, 84, 0, 85, 0, 86, 87, 0, 0,
+	0, 0, 0, 0, 88, 89, 250, 90, 181, 91,
+	182, 183, 0, 0, 92, 0, 0, 0, 93, 94,
+	0, 0, 0, 0, 184, 95, 185, 0, 0, 0,
+	0, 0, 96, 97, 186, 98, 0, 0, 0, 0,
+	0, 99, 187, 0, 188, 0, 100, 189, 190, 101,
+	0, 102, 0, 0, 0, 103, 191, 192, 193, 104,
+	0, 194, 0, 0, 105, 0, 106, 107, 108, 0,
+	0, 195, 0, 109, 0, 0, 110, 0, 0, 0,
+	111, 112, 113, 114, 115, 0, 116, 117, 0, 118,
+	0, 196, 119, 197, 120, 121, 0, 0, 0, 0,
+	0, 122, 198, 0, 123, 0, 199, 124, 125, 126,
+	0, 200, 127, 201, 0, 128, 129, 202, 130, 131,
+	0, 132, 133, 134, 135, 136, 0, 137, 0, 138,
+	139, 203, 140, 0, 141, 142, 143, 0, 144, 145,
+	0, 146, 147, 0, 148, 204, 149, 0, 150, 151,
+	153, 205, 152, 206, 0, 0, 154, 155, 0, 207,
+	208, 0, 0, 156, 209, 210, 0, 157, 158, 159,
+	160, 0, 0, 161, 162, 0, 0, 163, 164, 165,
+	166, 211, 212, 0, 167, 0, 0, 0, 0, 168,
+	169, 170, 171, 334, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 69, 70, 0, 71, 0,
+	0, 0, 508, 0, 0, 0, 0, 72, 73, 74,
+	172, 173, 174, 75, 175, 176, 0, 76, 177, 77,
+	0, 0, 178, 179, 0, 180, 0, 339, 0, 78,
+	79, 80, 0, 81, 82, 83, 0, 0, 84, 0,
+	85, 340, 86, 87, 0, 0, 0, 0, 0, 0,
+	88, 89, 250, 90, 181, 91, 182, 183, 0, 0,
+	92, 0, 0, 0, 93, 94, 0, 0, 0, 0,
+	184, 95, 185, 0, 341, 0, 0, 0, 96, 97,
+	186, 98, 0, 0, 0, 342, 0, 99, 187, 0,
+	188, 0, 100, 189, 190, 101, 0, 102, 0, 0,
+	343, 103, 191, 192, 193, 104, 0, 194, 0, 344,
+	105, 345, 106, 107, 108, 0, 0, 195, 346, 109,
+	347, 0, 110, 0, 0, 0, 111, 112, 113, 114,
+	115, 348, 116, 117, 0, 118, 0, 196, 119, 197,
+	120, 121, 0, 0, 0, 0, 0, 122, 198, 349,
+	123, 350, 199, 124, 125, 126, 0, 200, 127, 201,
+	0, 128, 129, 202, 130, 131, 0, 132, 133, 134,
+	135, 136, 0, 137, 351, 138, 139, 203, 140, 0,
+	141, 142, 143, 0, 144, 145, 0, 146, 147, 352,
+	148, 204, 149, 0, 150, 151, 153, 205, 152, 206,
+	0, 0, 154, 155, 0, 207, 208, 0, 0, 156,
+	209, 210, 0, 157, 158, 159, 160, 0, 0, 161,
+	162, 0, 0, 163, 164, 165, 166, 211, 212, 66,
+	167, 0, 0, 0, 0, 168, 169, 170, 171, 0,
+	0, 69, 70, 0, 71, 0, 0, 0, 0, 0,
+	0, 0, 0, 72, 73, 74, 172, 173, 174, 75,
+	175, 176, 0, 76, 177, 77, 0, 0, 178, 179,
+	813, 180, 0, 0, 0, 78, 79, 80, 0, 81,
+	82, 83, 811, 0, 84, 0, 85, 0, 86, 87,
+	0, 0, 0, 0, 0, 0, 88, 89, 250, 90,
+	181, 91, 182, 183, 0, 0, 92, 0, 0, 0,
+	93, 94, 0, 0, 0, 0, 184, 95, 185, 0,
+	883, 0, 0, 0, 96, 97, 186, 98, 0, 816,
+	0, 0, 0, 99, 187, 0, 188, 0, 100, 189,
+	190, 101, 0, 102, 881, 0, 0, 103, 191, 192,
+	193, 104, 0, 194, 0, 0, 105, 0, 106, 107,
+	108, 0, 0, 195, 0, 109, 0, 0, 110, 0,
+	0, 0, 111, 112, 113, 114, 115, 0, 116, 117,
+	0, 118, 0, 196, 119, 197, 120, 121, 0, 0,
+	0, 0, 0, 122, 198, 0, 123, 0, 199, 124,
+	125, 126, 0, 200, 127, 201, 815, 128, 129, 202,
+	130, 131, 0, 132, 133, 134, 135, 136, 0, 137,
+	0, 138, 139, 203, 140, 0, 141, 142, 143, 0,
+	144, 145, 0, 146, 147, 0, 148, 204, 149, 0,
+	150, 151, 153, 205, 152, 206, 0, 0, 154, 155,
+	0, 207, 208, 0, 0, 156, 209, 210, 0, 157,
+	158, 159, 160, 0, 882, 161, 162, 0, 0, 163,
+	164, 165, 166, 211, 212, 66, 167, 0, 0, 0,
+	0, 168, 169, 170, 171, 0, 0, 69, 70, 0,
+	71, 0, 0, 0, 0, 0, 0, 0, 0, 72,
+	73, 74, 172, 173, 174, 75, 175, 176, 0, 76,
+	177, 77, 0, 0, 178, 179, 813, 180, 0, 0,
+	808, 78, 79, 80, 0, 81, 82, 83, 811, 0,
+	84, 0, 85, 0, 86, 87, 0, 0, 0, 0,
+	0, 0, 88, 89, 250, 90, 181, 91, 182, 183,
+	0, 0, 92, 0, 0, 0, 93, 94, 0, 0,
+	0, 0, 184, 95, 185, 0, 0, 0, 0, 0,
+	96, 97, 186, 98, 0, 816, 0, 0, 0, 99,
+	187, 0, 188, 0, 100, 807, 190, 101, 0, 102,
+	0, 0, 0, 103, 191, 192, 193, 104, 0, 194,
+	0, 0, 105, 0, 106, 107, 108, 0, 0, 195,
+	0, 109, 0, 0, 110, 0, 0, 0, 111, 112,
+	113, 114, 115, 0, 116, 117, 0, 118, 0, 196,
+	119, 197, 120, 121, 0, 0, 0, 0, 0, 122,
+	198, 0, 123, 0, 199, 124, 125, 126, 0, 200,
+	127, 201, 815, 128, 129, 202, 130, 131, 0, 132,
+	133, 134, 135, 136, 0, 137, 0, 138, 139, 203,
+	140, 0, 141, 142, 143, 0, 144, 145, 0, 146,
+	147, 0, 148, 204, 149, 0, 150, 151, 153, 205,
+	152, 206, 0, 0, 154, 155, 0, 207, 208, 0,
+	0, 156, 209, 210, 0, 157, 158, 159, 160, 0,
+	814, 161, 162, 0, 0, 163, 164, 165, 166, 211,
+	212, 66, 167, 0, 0, 0, 0, 168, 169, 170,
+	171, 0, 0, 69, 70, 0, 71, 0, 0, 0,
+	0, 0, 1158, 0, 0, 72, 73, 74, 172, 173,
+	174, 75, 175, 176, 0, 76, 177, 77, 0, 0,
+	178, 179, 0, 180, 0, 0, 0, 78, 79, 80,
+	0, 81, 82, 83, 0, 0, 84, 0, 85, 0,
+	86, 87, 0, 0, 0, 0, 0, 0, 88, 89,
+	250, 90, 181, 91, 182, 183, 0, 0, 92, 0,
+	0, 0, 93, 94, 0, 0, 0, 0, 184, 95,
+	185, 0, 0, 0, 0, 0, 96, 97, 186, 98,
+	0, 0, 0, 0, 0, 99, 187, 0, 188, 0,
+	100, 189, 190, 101, 0, 102, 0, 0, 0, 103,
+	191, 192, 193, 104, 0, 194, 0, 0, 105, 0,
+	106, 107, 108, 0, 0, 195, 0, 109, 0, 0,
+	110, 0, 0, 0, 111, 112, 113, 114, 115, 0,
+	116, 117, 0, 118, 0, 196, 119, 197, 120, 121,
+	0, 0, 0, 0, 0, 122, 198, 0, 123, 0,
+	199, 124, 125, 126, 0, 200, 127, 201, 0, 128,
+	129, 202, 130, 131, 0, 132, 133, 134, 135, 136,
+	0, 137, 0, 138, 139, 203, 140, 0, 141, 142,
+	143, 0, 144, 145, 0, 146, 147, 0, 148, 204,
+	149, 0, 150, 151, 153, 205, 152, 206, 0, 0,
+	154, 155, 0, 207, 208, 0, 0, 156, 209, 210,
+	0, 157, 158, 159, 160, 0, 0, 161, 162, 0,
+	0, 163, 164, 165, 166, 211, 212, 66, 167, 0,
+	0, 0, 0, 168, 169, 170, 171, 0, 0, 69,
+	70, 0, 71, 0, 0, 0, 0, 0, 0, 0,
+	0, 72, 73, 74, 172, 173, 174, 75, 175, 176,
+	0, 76, 177, 77, 0, 0, 178, 179, 0, 180,
+	0, 0, 0, 78, 79, 80, 0, 81, 82, 83,
+	0, 0, 84, 0, 85, 0, 86, 87, 0, 0,
+	0, 0, 0, 0, 88, 89, 250, 90, 181, 91,
+	182, 183, 0, 0, 92, 0, 0, 0, 93, 94,
+	0, 0, 0, 0, 184, 95, 185, 0, 0, 0,
+	0, 0, 96, 97, 186, 98, 0, 0, 0, 0,
+	0, 99, 187, 0, 188, 0, 100, 189, 190, 101,
+	0, 102, 0, 0, 0, 103, 191, 192, 193, 104,
+	0, 194, 0, 0, 105, 0, 106, 107, 108, 0,
+	0, 195, 0, 109, 0, 0, 110, 0, 0, 0,
+	111, 112, 113, 114, 115, 0, 116, 117, 0, 118,
+	0, 196, 119, 197, 120, 121, 0, 0, 300, 0,
+	0, 122, 198, 0, 123, 0, 199, 124, 125, 126,
+	0, 200, 127, 201, 0, 128, 129, 202, 130, 131,
+	0, 132, 133, 134, 135, 136, 0, 137, 0, 138,
+	139, 203, 140, 0, 141, 142, 143, 0, 144, 145,
+	0, 146, 147, 0, 148, 204, 149, 0, 150, 151,
+	153, 205, 152, 206, 0, 0, 154, 155, 0, 207,
+	208, 0, 0, 156, 209, 210, 0, 157, 158, 159,
+	160, 0, 0, 161, 162, 0, 0, 163, 164, 165,
+	166, 211, 212, 66, 167, 0, 0, 0, 0, 168,
+	169, 170, 171, 0, 0, 69, 70, 0, 71, 0,
+	0, 0, 0, 0, 0, 0, 0, 72, 73, 74,
+	172, 173, 174, 75, 175, 176, 0, 76, 177, 77,
+	0, 0, 178, 179, 0, 180, 0, 0, 0, 78,
+	79, 80, 0, 81, 82, 83, 0, 0, 84, 0,
+	85, 0, 86, 87, 0, 0, 0, 0, 0, 0,
+	88, 89, 63, 90, 181, 91, 182, 183, 0, 0,
+	92, 0, 0, 0, 93, 94, 0, 0, 0, 0,
+	184, 95, 185, 0, 0, 0, 0, 0, 96, 97,
+	186, 98, 0, 0, 0, 0, 0, 99, 187, 0,
+	188, 0, 100, 189, 190, 101, 0, 102, 0, 0,
+	0, 103, 191, 192, 193, 104, 0, 194, 0, 0,
+	105, 0, 106, 107, 108, 0, 0, 195, 0, 109,
+	0, 0, 110, 0, 0, 0, 111, 112, 113, 114,
+	115, 0, 116, 117, 0, 118, 0, 196, 119, 197,
+	120, 121, 0, 0, 0, 0, 0, 122, 198, 0,
+	123, 0, 199, 124, 125, 126, 0, 200, 127, 201,
+	0, 128, 129, 202, 130, 131, 0, 132, 133, 134,
+	135, 136, 0, 137, 0, 138, 139, 203, 140, 0,
+	141, 142, 143, 0, 144, 145, 0, 146, 147, 0,
+	148, 204, 149, 0, 150, 151, 153, 205, 152, 206,
+	0, 62, 154, 155, 0, 207, 208, 0, 0, 156,
+	209, 210, 0, 157, 158, 159, 160, 0, 0, 161,
+	162, 0, 0, 163, 164, 165, 166, 211, 212, 66,
+	167, 0, 0, 0, 0, 168, 169, 170, 171, 0,
+	0, 69, 70, 0, 71, 0, 0, 0, 0, 0,
+	0, 0, 0, 72, 73, 74, 172, 173, 174, 75,
+	175, 176, 0, 76, 177, 77, 0, 0, 178, 179,
+	0, 180, 0, 0, 0, 78, 79, 80, 0, 81,
+	82, 83, 0, 0, 84, 0, 85, 0, 86, 87,
+	0, 0, 0, 0, 0, 0, 88, 89, 250, 90,
+	181, 91, 182, 183, 0, 0, 92, 0, 0, 0,
+	93, 94, 0, 0, 0, 0, 184, 95, 185, 0,
+	0, 0, 0, 0, 96, 97, 186, 98, 0, 0,
+	0, 0, 0, 99, 187, 0, 188, 0, 100, 305,
+	190, 101, 0, 102, 0, 0, 0, 103, 191, 192,
+	193, 104, 0, 194, 0, 0, 105, 0, 106, 107,
+	108, 0, 0, 195, 0, 109, 0, 0, 110, 0,
+	0, 0, 111, 112, 113, 114, 115, 0, 116, 117,
+	0, 118, 0, 196, 119, 197, 120, 121, 0, 0,
+	300, 0, 0, 122, 198, 0, 123, 0, 199, 124,
+	125, 126, 0, 200, 127, 201, 0, 128, 129, 202,
+	130, 131, 0, 132, 133, 134, 135, 136, 0, 137,
+	0, 138, 139, 203, 140, 0, 141, 142, 143, 0,
+	144, 145, 0, 146, 147, 0, 148, 204, 149, 0,
+	150, 151, 153, 205, 152, 206, 0, 0, 154, 155,
+	0, 207, 208, 0, 0, 156, 209, 210, 0, 157,
+	158, 159, 160, 0, 0, 161, 162, 0, 0, 163,
+	164, 165, 166, 211, 212, 66, 167, 0, 0, 0,
+	0, 168, 169, 170, 171, 0, 0, 69, 70, 0,
+	71, 0, 0, 0, 0, 0, 0, 0, 0, 72,
+	73, 74, 172, 173, 174, 75, 175, 176, 0, 76,
+	177, 77, 0, 0, 178, 179, 0, 180, 0, 0,
+	0, 78, 79, 80, 0, 81, 82, 83, 0, 0,
+	84, 0, 85, 0, 86, 87, 0, 0, 0, 0,
+	0, 0, 88, 89, 250, 90, 181, 91, 182, 183,
+	0, 0, 92, 0, 0, 0, 93, 94, 0, 0,
+	0, 0, 184, 95, 185, 0, 0, 0, 0, 0,
+	96, 97, 186, 98, 0, 0, 0, 0, 0, 99,
+	187, 0, 188, 0, 100, 189, 190, 101, 0, 102,
+	0, 0, 0, 103, 191, 192, 193, 104, 0, 194,
+	0, 0, 105, 0, 106, 107, 108, 0, 0, 195,
+	0, 109, 0, 0, 110, 0, 0, 0, 111, 112,
+	113, 114, 115, 0, 116, 117, 0, 118, 0, 196,
+	119, 197, 120, 121, 0, 0, 0, 0, 0, 122,
+	198, 0, 123, 0, 199, 124, 125, 126, 0, 200,
+	127, 201, 0, 128, 129, 202, 130, 131, 0, 132,
+	133, 134, 135, 136, 0, 137, 0, 138, 139, 203,
+	140, 0, 141, 142, 143, 0, 144, 145, 0, 146,
+	147, 0, 148, 204, 149, 0, 150, 151, 153, 205,
+	152, 206, 0, 0, 154, 155, 0, 207, 208, 0,
+	0, 156, 209, 210, 0, 157, 158, 159, 160, 0,
+	0, 161, 162, 0, 0, 163, 164, 165, 166, 211,
+	212, 66, 167, 0, 0, 0, 0, 168, 169, 170,
+	171, 0, 0, 69, 70, 0, 71, 0, 0, 0,
+	0, 0, 0, 0, 0, 72, 73, 74, 172, 173,
+	174, 75, 175, 176, 0, 76, 177, 77, 0, 0,
+	178, 179, 0, 180, 0, 0, 0, 78, 79, 80,
+	0, 81, 82, 83, 0, 0, 84, 0, 85, 0,
+	86, 87, 0, 0, 0, 0, 0, 0, 88, 89,
+	250, 90, 181, 91, 182, 183, 0, 0, 92, 0,
+	0, 0, 93, 94, 0, 0, 0, 0, 184, 95,
+	185, 0, 0, 0, 0, 0, 96, 97, 186, 98,
+	0, 0, 0, 0, 0, 99, 187, 0, 188, 0,
+	100, 1093, 190, 101, 0, 102, 0, 0, 0, 103,
+	191, 192, 193, 104, 0, 194, 0, 0, 105, 0,
+	106, 107, 108, 0, 0, 195, 0, 109, 0, 0,
+	110, 0, 0, 0, 111, 112, 113, 114, 115, 0,
+	116, 117, 0, 118, 0, 196, 119, 197, 120, 121,
+	0, 0, 0, 0, 0, 122, 198, 0, 123, 0,
+	199, 124, 125, 126, 0, 200, 127, 201, 0, 128,
+	129, 202, 130, 131, 0, 132, 133, 134, 135, 136,
+	0, 137, 0, 138, 139, 203, 140, 0, 141, 142,
+	143, 0, 144, 145, 0, 146, 147, 0, 148, 204,
+	149, 0, 150, 151, 153, 205, 152, 206, 0, 0,
+	154, 155, 0, 207, 208, 0, 0, 156, 209, 210,
+	0, 157, 158, 159, 160, 0, 0, 161, 162, 0,
+	0, 163, 164, 165, 166, 211, 212, 66, 167, 0,
+	0, 0, 0, 168, 169, 170, 171, 0, 0, 69,
+	70, 0, 71, 0, 0, 0, 0, 0, 0, 0,
+	0, 72, 73, 74, 172, 173, 174, 75, 175, 176,
+	0, 76, 177, 77, 0, 0, 178, 179, 0, 180,
+	0, 0, 0, 78, 79, 80, 0, 81, 82, 83,
+	0, 0, 84, 0, 85, 0, 86, 87, 0, 0,
+	0, 0, 0, 0, 88, 89, 250, 90, 181, 91,
+	182, 183, 0, 0, 92, 0, 0, 0, 93, 94,
+	0, 0, 0, 0, 184, 95, 185, 0, 0, 0,
+	0, 0, 96, 97, 186, 98, 0, 0, 0, 0,
+	0, 99, 187, 0, 188, 0, 100, 1091, 190, 101,
+	0, 102, 0, 0, 0, 103, 191, 192, 193, 104,
+	0, 194, 0, 0, 105, 0, 106, 107, 108, 0,
+	0, 195, 0, 109, 0, 0, 110, 0, 0, 0,
+	111, 112, 113, 114, 115, 0, 116, 117, 0, 118,
+	0, 196, 119, 197, 120, 121, 0, 0, 0, 0,
+	0, 122, 198, 0, 123, 0, 199, 124, 125, 126,
+	0, 200, 127, 201, 0, 128, 129, 202, 130, 131,
+	0, 132, 133, 134, 135, 136, 0, 137, 0, 138,
+	139, 203, 140, 0, 141, 142, 143, 0, 144, 145,
+	0, 146, 147, 0, 148, 204, 149, 0, 150, 151,
+	153, 205, 152, 206, 0, 0, 154, 155, 0, 207,
+	208, 0, 0, 156, 209, 210, 0, 157, 158, 159,
+	160, 0, 0, 161, 162, 0, 0, 163, 164, 165,
+	166, 211, 212, 66, 167, 0, 0, 0, 0, 168,
+	169, 170, 171, 0, 0, 69, 70, 0, 71, 0,
+	0, 0, 0, 0, 0, 0, 0, 72, 73, 74,
+	172, 173, 174, 75, 175, 176, 0, 76, 177, 77,
+	0, 0, 178, 179, 0, 180, 0, 0, 0, 78,
+	79, 80, 0, 81, 82, 83, 0, 0, 84, 0,
+	85, 0, 86, 87, 0, 0, 0, 0, 0, 0,
+	88, 89, 250, 90, 181, 91, 182, 183, 0, 0,
+	92, 0, 0, 0, 93, 94, 0, 0, 0, 0,
+	184, 95, 185, 0, 0, 0, 0, 0, 96, 97,
+	186, 98, 0, 0, 0, 0, 0, 99, 187, 0,
+	188, 0, 100, 1082, 190, 101, 0, 102, 0, 0,
+	0, 103, 191, 192, 193, 104, 0, 194, 0, 0,
+	105, 0, 106, 107, 108, 0, 0, 195, 0, 109,
+	0, 0, 110, 0, 0, 0, 111, 112, 113, 114,
+	115, 0, 116, 117, 0, 118, 0, 196, 119, 197,
+	120, 121, 0, 0, 0, 0, 0, 122, 198, 0,
+	123, 0, 199, 124, 125, 126, 0, 200, 127, 201,
+	0, 128, 129, 202, 130, 131, 0, 132, 133, 134,
+	135, 136, 0, 137, 0, 138, 139, 203, 140, 0,
+	141, 142, 143, 0, 144, 145, 0, 146, 147, 0,
+	148, 204, 149, 0, 150, 151, 153, 205, 152, 206,
+	0, 0, 154, 155, 0, 207, 208, 0, 0, 156,
+	209, 210, 0, 157, 158, 159, 160, 0, 0, 161,
+	162, 0, 0, 163, 164, 165, 166, 211, 212, 66,
+	167, 0, 0, 0, 0, 168, 169, 170, 171, 0,
+	0, 69, 70, 0, 71, 0, 0, 0, 0, 0,
+	0, 0, 0, 72, 73, 74, 172, 173, 174, 75,
+	175, 176, 0, 76, 177, 77, 0, 0, 178, 179,
+	0, 180, 0, 0, 0, 78, 79, 80, 0, 81,
+	82, 83, 0, 0, 84, 0, 85, 0, 86, 87,
+	0, 0, 0, 0, 0, 0, 88, 89, 250, 90,
+	181, 91, 182, 183, 0, 0, 92, 0, 0, 0,
+	93, 94, 0, 0, 0, 0, 184, 95, 185, 0,
+	0, 0, 0, 0, 96, 97, 186, 98, 0, 0,
+	0, 0, 0, 99, 187, 0, 188, 0, 100, 685,
+	190, 101, 0, 102, 0, 0, 0, 103, 191, 192,
+	193, 104, 0, 194, 0, 0, 105, 0, 106, 107,
+	108, 0, 0, 195, 0, 109, 0, 0, 110, 0,
+	0, 0, 111, 112, 113, 114, 115, 0, 116, 117,
+	0, 118, 0, 196, 119, 197, 120, 121, 0, 0,
+	0, 0, 0, 122, 198, 0, 123, 0, 199, 124,
+	125, 126, 0, 200, 127, 201, 0, 128, 129, 202,
+	130, 131, 0, 132, 133, 134, 135, 136, 0, 137,
+	0, 138, 139, 203, 140, 0, 141, 142, 143, 0,
+	144, 145, 0, 146, 147, 0, 148, 204, 149, 0,
+	150, 151, 153, 205, 152, 206, 0, 0, 154, 155,
+	0, 207, 208, 0, 0, 156, 209, 210, 0, 157,
+	158, 159, 160, 0, 0, 161, 162, 0, 0, 163,
+	164, 165, 166, 211, 212, 66, 167, 0, 0, 0,
+	0, 168, 169, 170, 171, 0, 0, 69, 70, 0,
+	71, 0, 0, 0, 0, 0, 0, 0, 0, 72,
+	73, 74, 172, 173, 174, 75, 175, 176, 0, 76,
+	177, 77, 0, 0, 178, 179, 0, 180, 0, 0,
+	0, 78, 79, 80, 0, 81, 82, 83, 0, 0,
+	84, 0, 85, 0, 86, 87, 0, 0, 0, 0,
+	0, 0, 88, 89, 250, 90, 181, 91, 182, 183,
+	0, 0, 92, 0, 0, 0, 93, 94, 0, 0,
+	0, 0, 184, 95, 185, 0, 0, 0, 0, 0,
+	96, 97, 186, 98, 0, 0, 0, 0, 0, 99,
+	187, 0, 188, 0, 100, 189, 190, 101, 0, 102,
+	0, 0, 0, 103, 191, 192, 193, 104, 0, 194,
+	0, 0, 105, 0, 106, 107, 108, 0, 0, 195,
+	0, 109, 0, 0, 110, 0, 0, 0, 111, 112,
+	113, 114, 115, 0, 116, 117, 0, 118, 0, 196,
+	119, 197, 120, 121, 0, 0, 0, 0, 0, 122,
+	198, 0, 123, 0, 199, 124, 125, 126, 0, 200,
+	127, 201, 0, 128, 129, 202, 130, 131, 0, 132,
+	133, 134, 135, 136, 0, 137, 0, 138, 139, 203,
+	140, 0, 678, 142, 143, 0, 144, 145, 0, 146,
+	147, 0, 148, 204, 149, 0, 150, 151, 153, 205,
+	152, 206, 0, 0, 154, 155, 0, 207, 208, 0,
+	0, 156, 209, 210, 0, 157, 158, 159, 160, 0,
+	0, 161, 162, 0, 0, 163, 164, 165, 166, 211,
+	212, 66, 167, 0, 0, 0, 0, 168, 169, 170,
+	171, 0, 0, 69, 70, 0, 71, 0, 0, 0,
+	0, 0, 622, 0, 0, 72, 73, 74, 172, 173,
+	174, 75, 175, 176, 0, 76, 177, 77, 0, 0,
+	178, 179, 0, 180, 0, 0, 0, 78, 79, 80,
+	0, 81, 82, 83, 0, 0, 84, 0, 85, 0,
+	86, 87, 0, 0, 0, 0, 0, 0, 88, 89,
+	250, 90, 181, 91, 182, 183, 0, 0, 92, 0,
+	0, 0, 93, 94, 0, 0, 0, 0, 184, 95,
+	185, 0, 0, 0, 0, 0, 96, 97, 186, 98,
+	0, 0, 0, 0, 0, 99, 187, 0, 188, 0,
+	100, 189, 190, 101, 0, 102, 0, 0, 0, 103,
+	191, 192, 193, 104, 0, 194, 0, 0, 105, 0,
+	106, 107, 108, 0, 0, 195, 0, 109, 0, 0,
+	110, 0, 0, 0, 111, 112, 113, 114, 115, 0,
+	116, 117, 0, 118, 0, 196, 119, 197, 120, 121,
+	0, 0, 0, 0, 0, 122, 198, 0, 123, 0,
+	199, 124, 125, 126, 0, 200, 127, 201, 0, 128,
+	129, 202, 130, 131, 0, 132, 133, 134, 135, 136,
+	0, 137, 0, 138, 139, 203, 140, 0, 141, 142,
+	143, 0, 144, 145, 0, 0, 147, 0, 148, 204,
+	149, 0, 150, 151, 153, 205, 152, 206, 0, 0,
+	154, 155, 0, 207, 208, 0, 0, 156, 209, 210,
+	0, 157, 158, 159, 160, 0, 0, 161, 162, 0,
+	0, 163, 164, 165, 166, 211, 212, 66, 167, 0,
+	0, 0, 0, 168, 169, 170, 171, 0, 0, 69,
+	70, 0, 71, 0, 0, 0, 0, 0, 0, 0,
+	0, 72, 73, 74, 172, 173, 174, 75, 175, 176,
+	0, 76, 177, 77, 0, 0, 178, 179, 0, 180,
+	0, 0, 0, 78, 79, 80, 0, 81, 82, 83,
+	0, 0, 84, 0, 85, 0, 86, 87, 0, 0,
+	0, 0, 0, 0, 88, 89, 250, 90, 181, 91,
+	182, 183, 0, 0, 92, 0, 0, 0, 93, 94,
+	0, 0, 0, 0, 184, 95, 185, 0, 0, 0,
+	0, 0, 96, 97, 186, 98, 0, 0, 0, 0,
+	0, 99, 187, 0, 188, 0, 100, 391, 190, 101,
+	0, 102, 0, 0, 0, 103, 191, 192, 193, 104,
+	0, 194, 0, 0, 105, 0, 106, 107, 108, 0,
+	0, 195, 0, 109, 0, 0, 110, 0, 0, 0,
+	111, 112, 113, 114, 115, 0, 116, 117, 0, 118,
+	0, 196, 119, 197, 120, 121, 0, 0, 0, 0,
+	0, 122, 198, 0, 123, 0, 199, 124, 125, 126,
+	0, 200, 127, 201, 0, 128, 129, 202, 130, 131,
+	0, 132, 133, 134, 135, 136, 0, 137, 0, 138,
+	139, 203, 140, 0, 141, 142, 143, 0, 144, 145,
+	0, 146, 147, 0, 148, 204, 149, 0, 150, 151,
+	153, 205, 152, 206, 0, 0, 154, 155, 0, 207,
+	208, 0, 0, 156, 209, 210, 0, 157, 158, 159,
+	160, 0, 0, 161, 162, 0, 0, 163, 164, 165,
+	166, 211, 212, 66, 167, 0, 0, 0, 0, 168,
+	169, 170, 171, 0, 0, 69, 70, 0, 71, 0,
+	0, 0, 0, 0, 0, 0, 0, 72, 73, 74,
+	172, 173, 174, 75, 175, 176, 0, 76, 177, 77,
+	0, 0, 178, 179, 0, 180, 0, 0, 0, 78,
+	79, 80, 0, 81, 82, 83, 0, 0, 84, 0,
+	85, 0, 86, 87, 0, 0, 0, 0, 0, 0,
+	88, 89, 250, 90, 181, 91, 182, 183, 0, 0,
+	92, 0, 0, 0, 93, 94, 0, 0, 0, 0,
+	184, 95, 185, 0, 0, 0, 0, 0, 96, 97,
+	186, 98, 0, 0, 0, 0, 0, 99, 187, 0,
+	188, 0, 100, 388, 190, 101, 0, 102, 0, 0,
+	0, 103, 191, 192, 193, 104, 0, 194, 0, 0,
+	105, 0, 106, 107, 108, 0, 0, 195, 0, 109,
+	0, 0, 110, 0, 0, 0, 111, 112, 113, 114,
+	115, 0, 116, 117, 0, 118, 0, 196, 119, 197,
+	120, 121, 0, 0, 0, 0, 0, 122, 198, 0,
+	123, 0, 199, 124, 125, 126, 0, 200, 127, 201,
+	0, 128, 129, 202, 130, 131, 0, 132, 133, 134,
+	135, 136, 0, 137, 0, 138, 139, 203, 140, 0,
+	141, 142, 143, 0, 144, 145, 0, 146, 147, 0,
+	148, 204, 149, 0, 150, 151, 153, 205, 152, 206,
+	0, 0, 154, 155, 0, 207, 208, 0, 0, 156,
+	209, 210, 0, 157, 158, 159, 160, 0, 0, 161,
+	162, 0, 0, 163, 164, 165, 166, 211, 212, 66,
+	167, 0, 0, 0, 0, 168, 169, 170, 171, 0,
+	0, 69, 70, 0, 71, 0, 0, 0, 0, 0,
+	0, 0, 0, 72, 73, 74, 172, 173, 174, 75,
+	175, 176, 0, 76, 177, 77, 0, 0, 178, 179,
+	0, 180, 0, 0, 0, 78, 79, 80, 0, 81,
+	82, 83, 0, 0, 84, 0, 85, 0, 86, 87,
+	0, 0, 0, 0, 0, 0, 88, 89, 250, 90,
+	181, 91, 182, 183, 0, 0, 92, 0, 0, 0,
+	93, 94, 0, 0, 0, 0, 184, 95, 185, 0,
+	0, 0, 0, 0, 96, 97, 186, 98, 0, 0,
+	0, 0, 0, 99, 187, 0, 188, 0, 100, 189,
+	190, 101, 0, 102, 0, 0, 0, 103, 191, 192,
+	193, 104, 0, 194, 0, 0, 105, 0, 106, 107,
+	108, 0, 0, 195, 0, 109, 0, 0, 110, 0,
+	0, 0, 111, 112, 113, 114, 247, 0, 116, 117,
+	0, 118, 0, 196, 119, 197, 120, 121, 0, 0,
+	0, 0, 0, 122, 198, 0, 123, 0, 199, 124,
+	125, 126, 0, 200, 127, 201, 0, 128, 129, 202,
+	130, 131, 0, 132, 133, 134, 135, 136, 0, 137,
+	0, 138, 139, 203, 140, 0, 141, 142, 143, 0,
+	144, 145, 0, 146, 147, 0, 148, 204, 149, 0,
+	150, 151, 153, 205, 152, 206, 0, 0, 154, 155,
+	0, 246, 208, 0, 0, 242, 209, 210, 0, 157,
+	158, 159, 160, 0, 0, 161, 162, 0, 0, 163,
+	164, 165, 166, 211, 212, 66, 167, 0, 0, 0,
+	0, 168, 169, 170, 171, 0, 0, 69, 70, 0,
+	71, 0, 0, 0, 0, 0, 0, 0, 0, 72,
+	73, 74, 172, 173, 174, 75, 175, 176, 0, 76,
+	177, 77, 0, 0, 178, 179, 0, 180, 0, 0,
+	0, 78, 79, 80, 0, 81, 82, 83, 0, 0,
+	84, 0, 85, 0, 86, 87, 0, 0, 0, 0,
+	0, 0, 88, 89, 250, 90, 181, 91, 182, 183,
+	0, 0, 92, 0, 0, 0, 93, 94, 0, 0,
+	0, 0, 184, 95, 185, 0, 0, 0, 0, 0,
+	96, 97, 186, 98, 0, 0, 0, 0, 0, 99,
+	187, 0, 188, 0, 100, 329, 190, 101, 0, 102,
+	0, 0, 0, 103, 191, 192, 193, 104, 0, 194,
+	0, 0, 105, 0, 106, 107, 108, 0, 0, 195,
+	0, 109, 0, 0, 110, 0, 0, 0, 111, 112,
+	113, 114, 115, 0, 116, 117, 0, 118, 0, 196,
+	119, 197, 120, 121, 0, 0, 0, 0, 0, 122,
+	198, 0, 123, 0, 199, 124, 125, 126, 0, 200,
+	127, 201, 0, 128, 129, 202, 130, 131, 0, 132,
+	133, 134, 135, 136, 0, 137, 0, 138, 139, 203,
+	140, 0, 141, 142, 143, 0, 144, 145, 0, 146,
+	147, 0, 148, 204, 149, 0, 150, 151, 153, 205,
+	152, 206, 0, 0, 154, 155, 0, 207, 208, 0,
+	0, 156, 209, 210, 0, 157, 158, 159, 160, 0,
+	0, 161, 162, 0, 0, 163, 164, 165, 166, 211,
+	212, 66, 167, 0, 0, 0, 0, 168, 169, 170,
+	171, 0, 0, 69, 70, 0, 71, 0, 0, 0,
+	0, 0, 0, 0, 0, 72, 73, 74, 172, 173,
+	174, 75, 175, 176, 0, 76, 177, 77, 0, 0,
+	178, 179, 0, 180, 0, 0, 0, 78, 79, 80,
+	0, 81, 82, 83, 0, 0, 84, 0, 85, 0,
+	86, 87, 0, 0, 0, 0, 0, 0, 88, 89,
+	250, 90, 181, 91, 182, 183, 0, 0, 92, 0,
+	0, 0, 93, 94, 0, 0, 0, 0, 184, 95,
+	185, 0, 0, 0, 0, 0, 96, 97, 186, 98,
+	0, 0, 0, 0, 0, 99, 187, 0, 188, 0,
+	100, 327, 190, 101, 0, 102, 0, 0, 0, 103,
+	191, 192, 193, 104, 0, 194, 0, 0, 105, 0,
+	106, 107, 108, 0, 0, 195, 0, 109, 0, 0,
+	110, 0, 0, 0, 111, 112, 113, 114, 115, 0,
+	116, 117, 0, 118, 0, 196, 119, 197, 120, 121,
+	0, 0, 0, 0, 0, 122, 198, 0, 123, 0,
+	199, 124, 125, 126, 0, 200, 127, 201, 0, 128,
+	129, 202, 130, 131, 0, 132, 133, 134, 135, 136,
+	0, 137, 0, 138, 139, 203, 140, 0, 141, 142,
+	143, 0, 144, 145, 0, 146, 147, 0, 148, 204,
+	149, 0, 150, 151, 153, 205, 152, 206, 0, 0,
+	154, 155, 0, 207, 208, 0, 0, 156, 209, 210,
+	0, 157, 158, 159, 160, 0, 0, 161, 162, 0,
+	0, 163, 164, 165, 166, 211, 212, 66, 167, 0,
+	0, 0, 0, 168, 169, 170, 171, 0, 0, 69,
+	70, 0, 71, 0, 0, 0, 0, 0, 0, 0,
+	0, 72, 73, 74, 172, 173, 174, 75, 175, 176,
+	0, 76, 177, 77, 0, 0, 178, 179, 0, 180,
+	0, 0, 0, 78, 79, 80, 0, 81, 82, 83,
+	0, 0, 84, 0, 85, 0, 86, 87, 0, 0,
+	0, 0, 0, 0, 88, 89, 250, 90, 181, 91,
+	182, 183, 0, 0, 92, 0, 0, 0, 93, 94,
+	0, 0, 0, 0, 184, 95, 185, 0, 0, 0,
+	0, 0, 96, 97, 186, 98, 0, 0, 0, 0,
+	0, 99, 187, 0, 188, 0, 100, 325, 190, 101,
+	0, 102, 0, 0, 0, 103, 191, 192, 193, 104,
+	0, 194, 0, 0, 105, 0, 106, 107, 108, 0,
+	0, 195, 0, 109, 0, 0, 110, 0, 0, 0,
+	111, 112, 113, 114, 115, 0, 116, 117, 0, 118,
+	0, 196, 119, 197, 120, 121, 0, 0, 0, 0,
+	0, 122, 198, 0, 123, 0, 199, 124, 125, 126,
+	0, 200, 127, 201, 0, 128, 129, 202, 130, 131,
+	0, 132, 133, 134, 135, 136, 0, 137, 0, 138,
+	139, 203, 140, 0, 141, 142, 143, 0, 144, 145,
+	0, 146, 147, 0, 148, 204, 149, 0, 150, 151,
+	153, 205, 152, 206, 0, 0, 154, 155, 0, 207,
+	208, 0, 0, 156, 209, 210, 0, 157, 158, 159,
+	160, 0, 0, 161, 162, 0, 0, 163, 164, 165,
+	166, 211, 212, 66, 167, 0, 0, 0, 0, 168,
+	169, 170, 171, 0, 0, 69, 70, 0, 71, 0,
+	0, 0, 0, 0, 0, 0, 0, 72, 73, 74,
+	172, 173, 174, 75, 175, 176, 0, 76, 177, 77,
+	0, 0, 178, 179, 0, 180, 0, 0, 0, 78,
+	79, 80, 0, 81, 82, 83, 0, 0, 84, 0,
+	85, 0, 86, 87, 0, 0, 0, 0, 0, 0,
+	88, 89, 250, 90, 181, 91, 182, 183, 0, 0,
+	92, 0, 0, 0, 93, 94, 0, 0, 0, 0,
+	184, 95, 185, 0, 0, 0, 0, 0, 96, 97,
+	186, 98, 0, 0, 0, 0, 0, 99, 187, 0,
+	188, 0, 100, 309, 190, 101, 0, 102, 0, 0,
+	0, 103, 191, 192, 193, 104, 0, 194, 0, 0,
+	105, 0, 106, 107, 108, 0, 0, 195, 0, 109,
+	0, 0, 110, 0, 0, 0, 111, 112, 113, 114,
+	115, 0, 116, 117, 0, 118, 0, 196, 119, 197,
+	120, 121, 0, 0, 0, 0, 0, 122, 198, 0,
+	123, 0, 199, 124, 125, 126, 0, 200, 127, 201,
+	0, 128, 129, 202, 130, 131, 0, 132, 133, 134,
+	135, 136, 0, 137, 0, 138, 139, 203, 140, 0,
+	141, 142, 143, 0, 144, 145, 0, 146, 147, 0,
+	148, 204, 149, 0, 150, 151, 153, 205, 152, 206,
+	0, 0, 154, 155, 0, 207, 208, 0, 0, 156,
+	209, 210, 0, 157, 158, 159, 160, 0, 0, 161,
+	162, 0, 0, 163, 164, 165, 166, 211, 212, 66,
+	167, 0, 0, 0, 0, 168, 169, 170, 171, 0,
+	0, 69, 70, 0, 71, 0, 0, 0, 0, 0,
+	0, 0, 0, 72, 73, 74, 172, 173, 174, 75,
+	175, 176, 0, 76, 177, 77, 0, 0, 178, 179,
+	0, 180, 0, 0, 0, 78, 79, 80, 0, 81,
+	82, 83, 0, 0, 84, 0, 85, 0, 86, 87,
+	0, 0, 0, 0, 0, 0, 88, 89, 250, 90,
+	181, 91, 182, 183, 0, 0, 92, 0, 0, 0,
+	93, 94, 0, 0, 0, 0, 184, 95, 185, 0,
+	0, 0, 0, 0, 96, 97, 186, 98, 0, 0,
+	0, 0, 0, 99, 187, 0, 188, 0, 100, 189,
+	190, 101, 0, 102, 0, 0, 0, 103, 191, 192,
+	193, 104, 0, 194, 0, 0, 105, 0, 106, 107,
+	108, 0, 0, 195, 0, 109, 0, 0, 110, 0,
+	0, 0, 111, 112, 113, 114, 115, 0, 116, 117,
+	0, 118, 0, 196, 119, 197, 120, 121, 0, 0,
+	0, 0, 0, 122, 198, 0, 123, 0, 199, 124,
+	125, 126, 0, 200, 127, 201, 0, 128, 129, 202,
+	289, 131, 0, 132, 133, 134, 135, 136, 0, 137,
+	0, 138, 139, 203, 140, 0, 141, 142, 143, 0,
+	144, 145, 0, 146, 147, 0, 148, 204, 149, 0,
+	150, 151, 153, 205, 152, 206, 0, 0, 154, 155,
+	0, 207, 208, 0, 0, 156, 209, 210, 0, 157,
+	158, 159, 160, 0, 0, 161, 162, 0, 0, 163,
+	164, 165, 166, 211, 212, 66, 167, 0, 0, 0,
+	0, 168, 169, 170, 171, 0, 0, 69, 70, 0,
+	71, 0, 0, 0, 0, 0, 0, 0, 0, 72,
+	73, 74, 172, 173, 174, 75, 175, 176, 0, 76,
+	177, 77, 0, 0, 178, 179, 0, 180, 0, 0,
+	0, 78, 79, 80, 0, 81, 82, 83, 0, 0,
+	84, 0, 85, 0, 86, 87, 0, 0, 0, 0,
+	0, 0, 88, 89, 250, 90, 181, 91, 182, 183,
+	0, 0, 92, 0, 0, 0, 93, 94, 0, 0,
+	0, 0, 184, 95, 185, 0, 0, 0, 0, 0,
+	96, 97, 186, 98, 0, 0, 0, 0, 0, 99,
+	187, 0, 188, 0, 100, 189, 190, 101, 0, 102,
+	0, 0, 0, 103, 191, 192, 193, 104, 0, 194,
+	0, 0, 105, 0, 106, 107, 108, 0, 0, 195,
+	0, 109, 0, 0, 110, 0, 0, 0, 111, 112,
+	113, 114, 115, 0, 116, 117, 0, 118, 0, 196,
+	119, 197, 120, 121, 0, 0, 0, 0, 0, 122,
+	198, 0, 123, 0, 199, 124, 125, 126, 0, 200,
+	127, 201, 0, 128, 129, 202, 130, 131, 0, 132,
+	133, 134, 135, 136, 0, 137, 0, 138, 139, 203,
+	140, 0, 268, 142, 143, 0, 144, 145, 0, 146,
+	147, 0, 148, 204, 149, 0, 150, 151, 153, 205,
+	152, 206, 0, 0, 154, 155, 0, 207, 208, 0,
+	0, 156, 209, 210, 0, 157, 158, 159, 160, 0,
+	0, 161, 162, 0, 0, 163, 164, 165, 166, 211,
+	212, 66, 167, 0, 0, 0, 0, 168, 169, 170,
+	171, 0, 0, 69, 70, 0, 71, 0, 0, 0,
+	0, 0, 0, 0, 0, 72, 73, 74, 172, 173,
+	174, 75, 175, 176, 0, 76, 177, 77, 0, 0,
+	178, 179, 0, 180, 0, 0, 0, 78, 79, 80,
+	0, 81, 82, 83, 0, 0, 84, 0, 85, 0,
+	86, 87, 0, 0, 0, 0, 0, 0, 88, 89,
+	250, 90, 181, 91, 182, 183, 0, 0, 92, 0,
+	0, 0, 93, 94, 0, 0, 0, 0, 184, 95,
+	185, 0, 0, 0, 0, 0, 96, 97, 186, 98,
+	0, 0, 0, 0, 0, 99, 187, 0, 188, 0,
+	100, 189, 190, 101, 0, 102, 0, 0, 0, 103,
+	191, 192, 193, 104, 0, 194, 0, 0, 105, 0,
+	106, 107, 108, 0, 0, 195, 0, 109, 0, 0,
+	240, 0, 0, 0, 111, 112, 113, 114, 247, 0,
+	116, 117, 0, 118, 0, 196, 119, 197, 120, 121,
+	0, 0, 0, 0, 0, 122, 198, 0, 123, 0,
+	199, 124, 125, 126, 0, 200, 127, 201, 0, 128,
+	129, 202, 130, 131, 0, 132, 133, 134, 135, 136,
+	0, 137, 0, 138, 139, 203, 140, 0, 141, 142,
+	143, 0, 144, 241, 0, 146, 147, 0, 148, 204,
+	149, 0, 150, 151, 153, 205, 152, 206, 0, 0,
+	154, 155, 0, 246, 208, 0, 0, 242, 209, 210,
+	0, 157, 158, 159, 160, 0, 0, 161, 162, 0,
+	0, 163, 164, 165, 166, 211, 212, 66, 167, 0,
+	0, 0, 0, 168, 169, 170, 171, 0, 0, 69,
+	70, 0, 71, 0, 0, 0, 0, 0, 0, 0,
+	0, 72, 73, 74, 172, 173, 174, 75, 175, 176,
+	0, 76, 177, 77, 0, 0, 178, 179, 0, 180,
+	0, 0, 0, 78, 79, 80, 0, 81, 82, 83,
+	0, 0, 84, 0, 85, 0, 86, 87, 0, 0,
+	0, 0, 0, 0, 88, 89, 250, 90, 181, 91,
+	182, 183, 0, 0, 92, 0, 0, 0, 93, 94,
+	0, 0, 0, 0, 184, 95, 185, 0, 0, 0,
+	0, 0, 96, 97, 186, 98, 0, 0, 0, 0,
+	0, 99, 187, 0, 188, 0, 100, 189, 190, 101,
+	0, 102, 0, 0, 0, 103, 191, 192, 193, 104,
+	0, 194, 0, 0, 105, 0, 106, 107, 108, 0,
+	0, 195, 0, 109, 0, 0, 110, 0, 0, 0,
+	111, 112, 113, 114, 115, 0, 116, 117, 0, 118,
+	0, 196, 119, 197, 120, 121, 0, 0, 0, 0,
+	0, 122, 198, 0, 123, 0, 199, 124, 125, 0,
+	0, 200, 127, 201, 0, 0, 129, 202, 130, 131,
+	0, 132, 133, 134, 135, 136, 0, 137, 0, 138,
+	139, 203, 0, 0, 141, 142, 143, 0, 144, 145,
+	0, 146, 147, 0, 148, 204, 149, 0, 150, 151,
+	153, 205, 152, 206, 0, 0, 154, 155, 0, 207,
+	208, 0, 0, 156, 209, 210, 0, 157, 158, 159,
+	160, 0, 0, 161, 162, 0, 0, 163, 164, 165,
+	166, 211, 212, 710, 167, 731, 732, 733, 0, 168,
+	169, 170, 171, 0, 0, 734, 0, 0, 0, 0,
+	0, 712, 0, 0, 741, 0, 0, 0, 0, 710,
+	0, 731, 732, 733, 0, 0, 0, 0, 0, 0,
+	711, 734, 0, 0, 0, 0, 725, 712, 0, 0,
+	741, 728, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 711, 0, 0, 0,
+	0, 0, 725, 0, 0, 0, 0, 728, 0, 0,
+	0, 0, 0, 0, 710, 0, 731, 732, 733, 0,
+	727, 726, 0, 0, 0, 0, 734, 0, 0, 0,
+	0, 0, 712, 0, 0, 741, 0, 0, 0, 0,
+	742, 0, 0, 0, 0, 0, 727, 726, 0, 0,
+	0, 711, 0, 740, 0, 0, 0, 725, 0, 0,
+	0, 0, 728, 0, 736, 0, 742, 0, 0, 729,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 740,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 735,
+	736, 0, 0, 0, 0, 729, 0, 0, 0, 0,
+	0, 727, 726, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 735, 0, 0, 0, 0,
+	0, 742, 0, 730, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 738, 740, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 736, 0, 0, 0, 730,
+	729, 0, 0, 0, 0, 0, 0, 0, 0, 738,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	735, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 737, 0, 722, 723, 724, 0, 721,
+	718, 719, 720, 713, 714, 715, 716, 717, 0, 0,
+	0, 0, 0, 0, 730, 0, 1271, 0, 0, 737,
+	0, 722, 723, 724, 738, 721, 718, 719, 720, 713,
+	714, 715, 716, 717, 0, 0, 0, 0, 0, 0,
+	0, 0, 1270, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 710, 0, 731, 732, 733, 0, 0, 0,
+	0, 0, 0, 0, 734, 0, 0, 0, 0, 0,
+	712, 0, 0, 741, 737, 0, 722, 723, 724, 0,
+	721, 718, 719, 720, 713, 714, 715, 716, 717, 711,
+	710, 0, 731, 732, 733, 725, 0, 1269, 0, 0,
+	728, 0, 734, 0, 0, 0, 0, 0, 712, 0,
+	0, 741, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 711, 0, 0,
+	0, 0, 0, 725, 0, 0, 0, 0, 728, 727,
+	726, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 742,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 740, 0, 0, 0, 0, 727, 726, 0,
+	0, 0, 0, 736, 0, 0, 0, 0, 729, 0,
+	0, 0, 0, 0, 0, 0, 0, 742, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 735, 0,
+	740, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 736, 0, 0, 0, 0, 729, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 730, 0, 0, 0, 735, 0, 0, 0,
+	0, 710, 738, 731, 732, 733, 0, 0, 0, 0,
+	0, 0, 0, 734, 0, 0, 0, 0, 0, 712,
+	0, 0, 741, 0, 0, 0, 0, 0, 0, 0,
+	730, 0, 0, 0, 0, 0, 0, 0, 711, 0,
+	738, 0, 0, 0, 725, 0, 0, 0, 0, 728,
+	0, 0, 737, 0, 722, 723, 724, 0, 721, 718,
+	719, 720, 713, 714, 715, 716, 717, 0, 0, 0,
+	0, 0, 1651, 0, 0, 0, 0, 1228, 0, 1247,
+	1248, 1249, 0, 0, 0, 0, 0, 0, 727, 726,
+	737, 0, 722, 723, 724, 0, 721, 718, 719, 720,
+	713, 714, 715, 716, 717, 0, 0, 0, 742, 0,
+	1650, 0, 710, 0, 731, 732, 733, 0, 0, 0,
+	1241, 740, 0, 0, 734, 1244, 0, 0, 0, 0,
+	712, 0, 736, 741, 0, 0, 0, 729, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 711,
+	0, 0, 0, 0, 0, 725, 0, 735, 0, 710,
+	728, 731, 732, 733, 1243, 1242, 0, 0, 0, 0,
+	0, 734, 0, 0, 0, 0, 0, 712, 0, 0,
+	741, 0, 0, 0, 1251, 0, 0, 0, 0, 0,
+	0, 730, 0, 0, 0, 0, 711, 1250, 0, 727,
+	726, 738, 725, 0, 0, 0, 0, 728, 0, 0,
+	0, 0, 0, 1245, 0, 0, 0, 0, 0, 742,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 740, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 736, 0, 0, 727, 726, 729, 0,
+	0, 737, 0, 722, 723, 724, 0, 721, 718, 719,
+	720, 713, 714, 715, 716, 717, 742, 1246, 735, 0,
+	710, 1649, 731, 732, 733, 0, 0, 0, 0, 740,
+	0, 0, 734, 0, 0, 0, 0, 0, 712, 0,
+	736, 741, 0, 0, 0, 729, 0, 0, 0, 0,
+	0, 0, 730, 0, 0, 0, 0, 711, 0, 0,
+	0, 0, 738, 725, 0, 735, 0, 0, 728, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 1238,
+	1239, 1240, 0, 1237, 1234, 1235, 1236, 1229, 1230, 1231,
+	1232, 1233, 0, 0, 0, 0, 0, 0, 0, 730,
+	0, 0, 0, 0, 0, 0, 0, 727, 726, 738,
+	0, 0, 737, 0, 722, 723, 724, 0, 721, 718,
+	719, 720, 713, 714, 715, 716, 717, 742, 0, 0,
+	0, 0, 1613, 0, 0, 0, 0, 0, 0, 0,
+	740, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 736, 0, 0, 0, 0, 729, 0, 0, 737,
+	0, 722, 723, 724, 0, 721, 718, 719, 720, 713,
+	714, 715, 716, 717, 0, 0, 735, 0, 710, 1608,
+	731, 732, 733, 0, 0, 0, 0, 0, 0, 0,
+	734, 0, 0, 0, 0, 0, 712, 0, 0, 741,
+	0, 710, 0, 731, 732, 733, 0, 0, 0, 0,
+	730, 0, 0, 734, 0, 711, 0, 0, 0, 712,
+	738, 725, 741, 0, 0, 0, 728, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 711, 0,
+	0, 0, 0, 0, 725, 0, 0, 0, 0, 728,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 727, 726, 0, 0, 0,
+	737, 0, 722, 723, 724, 0, 721, 718, 719, 720,
+	713, 714, 715, 716, 717, 742, 0, 0, 727, 726,
+	1604, 0, 0, 0, 0, 0, 0, 0, 740, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 742, 736,
+	0, 0, 0, 0, 729, 0, 0, 0, 0, 0,
+	0, 740, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 736, 0, 735, 0, 0, 729, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 735, 0, 710,
+	0, 731, 732, 733, 0, 0, 0, 0, 730, 0,
+	0, 734, 0, 0, 0, 0, 0, 712, 738, 0,
+	741, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 730, 0, 0, 0, 0, 711, 0, 0, 0,
+	0, 738, 725, 0, 0, 0, 0, 728, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 1446,
+	0, 0, 0, 0, 0, 0, 0, 0, 737, 0,
+	722, 723, 724, 0, 721, 718, 719, 720, 713, 714,
+	715, 716, 717, 0, 0, 0, 727, 726, 1563, 0,
+	0, 737, 0, 722, 723, 724, 0, 721, 718, 719,
+	720, 713, 714, 715, 716, 717, 742, 0, 0, 0,
+	710, 1543, 731, 732, 733, 0, 0, 0, 0, 740,
+	0, 0, 734, 0, 0, 0, 0, 0, 712, 0,
+	736, 741, 0, 0, 0, 729, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 711, 0, 0,
+	0, 0, 0, 725, 0, 735, 0, 710, 728, 731,
+	732, 733, 0, 0, 0, 0, 0, 0, 0, 734,
+	0, 0, 0, 0, 0, 712, 0, 0, 741, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 730,
+	0, 0, 0, 0, 711, 0, 0, 727, 726, 738,
+	725, 0, 0, 0, 0, 728, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 742, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 527, 0, 0, 0, 0, 0, 0, 0,
-	0, 827, 0, 0, 89, 90, 532, 91, 533, 534,
-	535, 536, 537, 538, 539, 540, 92, 93, 174, 175,
-	176, 94, 177, 178, 541, 95, 179, 96, 542, 543,
-	180, 181, 544, 182, 545, 293, 546, 97, 98, 99,
-	0, 100, 547, 101, 548, 294, 102, 103, 549, 550,
-	551, 552, 553, 554, 104, 105, 106, 107, 183, 108,
-	184, 185, 555, 556, 109, 557, 558, 559, 110, 111,
-	560, 561, 683, 562, 186, 112, 187, 563, 564, 113,
-	114, 188, 115, 565, 566, 567, 295, 568, 116, 189,
-	569, 190, 570, 117, 191, 192, 571, 572, 573, 296,
-	118, 193, 194, 195, 574, 196, 575, 297, 119, 298,
-	120, 576, 577, 197, 299, 121, 300, 578, 250, 579,
-	580, 0, 122, 123, 124, 125, 251, 301, 126, 127,
-	581, 128, 582, 198, 129, 199, 130, 131, 583, 584,
-	585, 586, 587, 132, 200, 302, 133, 303, 201, 134,
-	135, 588, 202, 136, 203, 589, 137, 138, 204, 139,
-	140, 590, 141, 142, 143, 591, 144, 304, 145, 146,
-	205, 147, 0, 148, 149, 592, 150, 252, 593, 151,
-	152, 305, 153, 206, 154, 594, 155, 157, 207, 156,
-	208, 595, 596, 158, 159, 597, 254, 209, 598, 599,
-	253, 210, 211, 600, 160, 161, 162, 163, 601, 602,
-	164, 165, 603, 604, 166, 167, 168, 212, 213, 605,
-	169, 606, 607, 608, 609, 170, 171, 172, 173, 0,
-	527, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 732, 89, 90, 532, 91, 533, 534, 535, 536,
-	537, 538, 539, 540, 92, 93, 174, 175, 176, 94,
-	177, 178, 541, 95, 179, 96, 542, 543, 180, 181,
-	544, 182, 545, 293, 546, 97, 98, 99, 0, 100,
-	547, 101, 548, 294, 102, 103, 549, 550, 551, 552,
-	553, 554, 104, 105, 106, 107, 183, 108, 184, 185,
-	555, 556, 109, 557, 558, 559, 110, 111, 560, 561,
-	0, 562, 186, 112, 187, 563, 564, 113, 114, 188,
-	115, 565, 566, 567, 295, 568, 116, 189, 569, 190,
-	570, 117, 191, 192, 571, 572, 573, 296, 118, 193,
-	194, 195, 574, 196, 575, 297, 119, 298, 120, 576,
-	577, 197, 299, 121, 300, 578, 250, 579, 580, 0,
-	122, 123, 124, 125, 251, 301, 126, 127, 581, 128,
-	582, 198, 129, 199, 130, 131, 583, 584, 585, 586,
-	587, 132, 200, 302, 133, 303, 201, 134, 135, 588,
-	202, 136, 203, 589, 137, 138, 204, 139, 140, 590,
-	141, 142, 143, 591, 144, 304, 145, 146, 205, 147,
-	0, 148, 149, 592, 150, 252, 593, 151, 152, 305,
-	153, 206, 154, 594, 155, 157, 207, 156, 208, 595,
-	596, 158, 159, 597, 254, 209, 598, 599, 253, 210,
-	211, 600, 160, 161, 162, 163, 601, 602, 164, 165,
-	603, 604, 166, 167, 168, 212, 213, 605, 169, 606,
-	607, 608, 609, 170, 171, 172, 173, 396, 384, 385,
-	386, 383, 372, 0, 0, 0, 0, 0, 0, 89,
-	90, 0, 91, 0, 0, 0, 0, 378, 0, 0,
-	0, 92, 93, 174, 425, 426, 94, 427, 428, 0,
-	95, 179, 96, 393, 411, 429, 430, 0, 421, 0,
-	404, 0, 97, 98, 99, 0, 100, 0, 101, 0,
-	294, 102, 103, 0, 405, 407, 0, 406, 408, 104,
-	105, 106, 107, 431, 108, 432, 433, 462, 0, 109,
-	0, 0, 0, 424, 111, 0, 0, 0, 0, 377,
-	112, 412, 391, 0, 113, 114, 434, 115, 0, 0,
-	0, 295, 0, 116, 422, 0, 190, 0, 117, 418,
-	420, 0, 0, 0, 296, 118, 435, 436, 437, 0,
-	403, 0, 297, 119, 298, 120, 0, 0, 423, 299,
-	121, 300, 0, 250, 0, 0, 0, 122, 123, 124,
-	125, 251, 301, 126, 127, 367, 128, 392, 419, 129,
-	438, 130, 131, 0, 0, 0, 0, 0, 132, 200,
-	302, 133, 303, 413, 134, 135, 0, 414, 136, 203,
-	0, 137, 138, 439, 139, 140, 0, 141, 142, 143,
-	0, 144, 304, 145, 146, 381, 147, 0, 148, 149,
-	43, 150, 252, 409, 151, 152, 305, 153, 440, 154,
-	0, 155, 157, 207, 156, 415, 0, 45, 158, 159,
-	0, 254, 441, 0, 0, 253, 416, 417, 390, 160,
-	161, 162, 163, 0, 0, 164, 165, 410, 0, 166,
-	167, 168, 292, 442, 0, 169, 0, 0, 0, 41,
-	170, 171, 172, 173, 368, 42, 396, 384, 385, 386,
-	383, 372, 0, 0, 364, 365, 0, 0, 89, 90,
-	366, 91, 0, 373, 0, 0, 378, 0, 0, 0,
-	92, 93, 174, 425, 426, 94, 427, 428, 0, 95,
-	179, 96, 393, 411, 429, 430, 0, 421, 0, 404,
-	0, 97, 98, 99, 0, 100, 0, 101, 0, 294,
-	102, 103, 0, 405, 407, 0, 406, 408, 104, 105,
-	106, 107, 431, 108, 432, 433, 0, 0, 109, 0,
-	0, 0, 424, 111, 0, 0, 0, 0, 377, 112,
-	412, 391, 0, 113, 114, 434, 115, 0, 0, 0,
-	295, 0, 116, 422, 0, 190, 0, 117, 418, 420,
-	0, 0, 0, 296, 118, 435, 436, 437, 0, 403,
-	0, 297, 119, 298, 120, 0, 0, 423, 299, 121,
-	300, 0, 250, 0, 0, 0, 122, 123, 124, 125,
-	251, 301, 126, 127, 367, 128, 392, 419, 129, 438,
-	130, 131, 0, 0, 0, 0, 0, 132, 200, 302,
-	133, 303, 413, 134, 135, 0, 414, 136, 203, 0,
-	137, 138, 439, 139, 140, 0, 141, 142, 143, 0,
-	144, 304, 145, 146, 381, 147, 0, 148, 149, 43,
-	150, 252, 409, 151, 152, 305, 153, 440, 154, 0,
-	155, 157, 207, 156, 415, 0, 45, 158, 159, 0,
-	254, 441, 0, 0, 253, 416, 417, 390, 160, 161,
-	162, 163, 0, 0, 164, 165, 410, 0, 166, 167,
-	168, 292, 442, 0, 169, 0, 0, 0, 41, 170,
-	171, 172, 173, 368, 42, 396, 384, 385, 386, 383,
-	372, 0, 0, 364, 365, 0, 0, 89, 90, 366,
-	91, 0, 373, 0, 0, 378, 0, 0, 0, 92,
-	93, 174, 425, 426, 94, 427, 428, 967, 95, 179,
-	96, 393, 411, 429, 430, 0, 421, 0, 404, 0,
-	97, 98, 99, 0, 100, 0, 101, 0, 294, 102,
-	103, 0, 405, 407, 0, 406, 408, 104, 105, 106,
-	107, 431, 108, 432, 433, 0, 0, 109, 0, 0,
-	0, 424, 111, 0, 0, 0, 0, 377, 112, 412,
-	391, 0, 113, 114, 434, 115, 0, 0, 972, 295,
-	0, 116, 422, 0, 190, 0, 117, 418, 420, 0,
-	0, 0, 296, 118, 435, 436, 437, 0, 403, 0,
-	297, 119, 298, 120, 0, 968, 423, 299, 121, 300,
-	0, 250, 0, 0, 0, 122, 123, 124, 125, 251,
-	301, 126, 127, 367, 128, 392, 419, 129, 438, 130,
-	131, 0, 0, 0, 0, 0, 132, 200, 302, 133,
-	303, 413, 134, 135, 0, 414, 136, 203, 0, 137,
-	138, 439, 139, 140, 0, 141, 142, 143, 0, 144,
-	304, 145, 146, 381, 147, 0, 148, 149, 0, 150,
-	252, 409, 151, 152, 305, 153, 440, 154, 0, 155,
-	157, 207, 156, 415, 0, 0, 158, 159, 0, 254,
-	441, 0, 969, 253, 416, 417, 390, 160, 161, 162,
-	163, 0, 0, 164, 165, 410, 0, 166, 167, 168,
-	212, 442, 0, 169, 0, 0, 0, 0, 170, 171,
-	172, 173, 368, 0, 396, 384, 385, 386, 383, 372,
-	0, 0, 364, 365, 0, 0, 89, 90, 366, 91,
-	0, 373, 0, 0, 378, 0, 0, 0, 92, 93,
-	174, 425, 426, 94, 427, 428, 0, 95, 179, 96,
-	393, 411, 429, 430, 0, 421, 0, 404, 0, 97,
-	98, 99, 0, 100, 0, 101, 0, 294, 102, 103,
-	0, 405, 407, 0, 406, 408, 104, 105, 106, 107,
-	431, 108, 432, 433, 0, 0, 109, 0, 0, 0,
-	424, 111, 0, 0, 0, 0, 377, 112, 412, 391,
-	0, 113, 114, 434, 115, 0, 0, 0, 295, 0,
-	116, 422, 0, 190, 0, 117, 418, 420, 0, 0,
-	0, 296, 118, 435, 436, 437, 0, 403, 0, 297,
-	119, 298, 120, 0, 0, 423, 299, 121, 300, 0,
-	250, 0, 0, 0, 122, 123, 124, 125, 251, 301,
-	126, 127, 367, 128, 392, 419, 129, 438, 130, 131,
-	0, 0, 0, 0, 0, 132, 200, 302, 133, 303,
-	413, 134, 135, 0, 414, 136, 203, 0, 137, 138,
-	439, 139, 140, 0, 141, 142, 143, 0, 144, 304,
-	145, 146, 381, 147, 0, 148, 149, 0, 150, 252,
-	409, 151, 152, 305, 153, 440, 154, 0, 155, 157,
-	207, 156, 415, 0, 0, 158, 159, 0, 254, 441,
-	0, 0, 253, 416, 417, 390, 160, 161, 162, 163,
-	0, 0, 164, 165, 410, 0, 166, 167, 168, 212,
-	442, 0, 169, 0, 0, 0, 0, 170, 171, 172,
-	173, 368, 0, 396, 384, 385, 386, 383, 372, 0,
-	0, 364, 365, 0, 0, 89, 90, 366, 91, 0,
-	373, 1302, 0, 378, 0, 0, 0, 92, 93, 174,
-	425, 426, 94, 427, 428, 0, 95, 179, 96, 393,
-	411, 429, 430, 0, 421, 0, 404, 0, 97, 98,
-	99, 0, 100, 0, 101, 0, 294, 102, 103, 0,
-	405, 407, 0, 406, 408, 104, 105, 106, 107, 431,
-	108, 432, 433, 0, 0, 109, 0, 0, 0, 424,
-	111, 0, 0, 0, 0, 377, 112, 412, 391, 0,
-	113, 114, 434, 115, 0, 0, 0, 295, 0, 116,
-	422, 0, 190, 0, 117, 418, 420, 0, 0, 0,
-	296, 118, 435, 436, 437, 0, 403, 0, 297, 119,
-	298, 120, 0, 0, 423, 299, 121, 300, 0, 250,
-	0, 0, 0, 122, 123, 124, 125, 251, 301, 126,
-	127, 367, 128, 392, 419, 129, 438, 130, 131, 0,
-	0, 0, 0, 0, 132, 200, 302, 133, 303, 413,
-	134, 135, 0, 414, 136, 203, 0, 137, 138, 439,
-	139, 140, 0, 141, 142, 143, 0, 144, 304, 145,
-	146, 381, 147, 0, 148, 149, 0, 150, 252, 409,
-	151, 152, 305, 153, 440, 154, 0, 155, 157, 207,
-	156, 415, 0, 0, 158, 159, 0, 254, 441, 0,
-	0, 253, 416, 417, 390, 160, 161, 162, 163, 0,
-	0, 164, 165, 410, 0, 166, 167, 168, 212, 442,
-	0, 169, 0, 0, 0, 0, 170, 171, 172, 173,
-	368, 0, 396, 384, 385, 386, 383, 372, 0, 0,
-	364, 365, 0, 0, 89, 90, 366, 91, 0, 373,
-	1245, 0, 378, 0, 0, 0, 92, 93, 174, 425,
-	426, 94, 427, 428, 0, 95, 179, 96, 393, 411,
-	429, 430, 0, 421, 0, 404, 0, 97, 98, 99,
-	0, 100, 0, 101, 0, 294, 102, 103, 0, 405,
-	407, 0, 406, 408, 104, 105, 106, 107, 431, 108,
-	432, 433, 0, 0, 109, 0, 0, 0, 424, 111,
-	0, 0, 0, 0, 377, 112, 412, 391, 0, 113,
-	114, 434, 115, 0, 0, 0, 295, 0, 116, 422,
-	0, 190, 0, 117, 418, 420, 0, 0, 0, 296,
-	118, 435, 436, 437, 0, 403, 0, 297, 119, 298,
-	120, 0, 0, 423, 299, 121, 300, 0, 250, 0,
-	0, 0, 122, 123, 124, 125, 251, 301, 126, 127,
-	367, 128, 392, 419, 129, 438, 130, 131, 0, 0,
-	0, 0, 0, 132, 200, 302, 133, 303, 413, 134,
-	135, 0, 414, 136, 203, 0, 137, 138, 439, 139,
-	140, 0, 141, 142, 143, 0, 144, 304, 145, 146,
-	381, 147, 0, 148, 149, 0, 150, 252, 409, 151,
-	152, 305, 153, 440, 154, 0, 155, 157, 207, 156,
-	415, 0, 0, 158, 159, 0, 254, 441, 0, 0,
-	253, 416, 417, 390, 160, 161, 162, 163, 0, 0,
-	164, 165, 410, 0, 166, 167, 168, 212, 442, 0,
-	169, 0, 0, 0, 0, 170, 171, 172, 173, 368,
-	0, 396, 384, 385, 386, 383, 372, 0, 0, 364,
-	365, 0, 0, 89, 90, 366, 91, 0, 373, 918,
-	0, 378, 0, 0, 0, 92, 93, 174, 425, 426,
-	94, 427, 428, 0, 95, 179, 96, 393, 411, 429,
-	430, 0, 421, 0, 404, 0, 97, 98, 99, 0,
-	100, 0, 101, 0, 294, 102, 103, 0, 405, 407,
-	0, 406, 408, 104, 105, 106, 107, 431, 108, 432,
-	433, 0, 0, 109, 0, 0, 0, 424, 111, 0,
-	0, 0, 0, 377, 112, 412, 391, 0, 113, 114,
-	434, 115, 0, 0, 0, 295, 0, 116, 422, 0,
-	190, 0, 117, 418, 420, 0, 0, 0, 296, 118,
-	435, 436, 437, 0, 403, 0, 297, 119, 298, 120,
-	0, 0, 423, 299, 121, 300, 0, 250, 0, 0,
-	0, 122, 123, 124, 125, 251, 301, 126, 127, 367,
-	128, 392, 419, 129, 438, 130, 131, 0, 0, 0,
-	0, 0, 132, 200, 302, 133, 303, 413, 134, 135,
-	0, 414, 136, 203, 0, 137, 138, 439, 139, 140,
-	0, 141, 142, 143, 0, 144, 304, 145, 146, 381,
-	147, 0, 148, 149, 0, 150, 252, 409, 151, 152,
-	305, 153, 440, 154, 0, 155, 157, 207, 156, 415,
-	0, 0, 158, 159, 0, 254, 441, 0, 0, 253,
-	416, 417, 390, 160, 161, 162, 163, 0, 0, 164,
-	165, 410, 0, 166, 167, 168, 212, 442, 0, 169,
-	0, 0, 0, 0, 170, 171, 172, 173, 368, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 364, 365,
-	0, 0, 0, 0, 366, 689, 914, 373, 396, 384,
-	385, 386, 383, 372, 0, 0, 0, 0, 0, 0,
-	89, 90, 0, 91, 0, 0, 0, 0, 378, 0,
-	0, 0, 92, 93, 174, 425, 426, 94, 427, 428,
-	0, 95, 179, 96, 393, 411, 429, 430, 0, 421,
-	0, 404, 0, 97, 98, 99, 0, 100, 0, 101,
-	0, 294, 102, 103, 0, 405, 407, 0, 406, 408,
-	104, 105, 106, 107, 431, 108, 432, 433, 0, 0,
-	109, 0, 0, 0, 424, 111, 0, 0, 0, 0,
-	377, 112, 412, 391, 0, 113, 114, 434, 115, 0,
-	0, 0, 295, 0, 116, 422, 0, 190, 0, 117,
-	418, 420, 0, 0, 0, 296, 118, 435, 436, 437,
-	0, 403, 0, 297, 119, 298, 120, 0, 0, 423,
-	299, 121, 300, 0, 250, 0, 0, 0, 122, 123,
-	124, 125, 251, 301, 126, 127, 367, 128, 392, 419,
-	129, 438, 130, 131, 0, 0, 0, 0, 0, 132,
-	200, 302, 133, 303, 413, 134, 135, 0, 414, 136,
-	203, 0, 137, 138, 439, 139, 140, 0, 141, 142,
-	143, 0, 144, 304, 145, 146, 381, 147, 0, 148,
-	149, 0, 150, 252, 409, 151, 152, 305, 153, 440,
-	154, 0, 155, 157, 207, 156, 415, 0, 0, 158,
-	159, 0, 254, 441, 0, 0, 253, 416, 417, 390,
-	160, 161, 162, 163, 0, 0, 164, 165, 410, 0,
-	166, 167, 168, 212, 442, 1251, 169, 0, 0, 0,
-	0, 170, 171, 172, 173, 368, 0, 396, 384, 385,
-	386, 383, 372, 0, 0, 364, 365, 0, 0, 89,
-	90, 366, 91, 0, 373, 0, 0, 378, 0, 0,
-	0, 92, 93, 174, 425, 426, 94, 427, 428, 0,
-	95, 179, 96, 393, 411, 429, 430, 0, 421, 0,
-	404, 0, 97, 98, 99, 0, 100, 0, 101, 0,
-	294, 102, 103, 0, 405, 407, 0, 406, 408, 104,
-	105, 106, 107, 431, 108, 432, 433, 462, 0, 109,
-	0, 0, 0, 424, 111, 0, 0, 0, 0, 377,
-	112, 412, 391, 0, 113, 114, 434, 115, 0, 0,
-	0, 295, 0, 116, 422, 0, 190, 0, 117, 418,
-	420, 0, 0, 0, 296, 118, 435, 436, 437, 0,
-	403, 0, 297, 119, 298, 120, 0, 0, 423, 299,
-	121, 300, 0, 250, 0, 0, 0, 122, 123, 124,
-	125, 251, 301, 126, 127, 367, 128, 392, 419, 129,
-	438, 130, 131, 0, 0, 0, 0, 0, 132, 200,
-	302, 133, 303, 413, 134, 135, 0, 414, 136, 203,
-	0, 137, 138, 439, 139, 140, 0, 141, 142, 143,
-	0, 144, 304, 145, 146, 381, 147, 0, 148, 149,
-	0, 150, 252, 409, 151, 152, 305, 153, 440, 154,
-	0, 155, 157, 207, 156, 415, 0, 0, 158, 159,
-	0, 254, 441, 0, 0, 253, 416, 417, 390, 160,
-	161, 162, 163, 0, 0, 164, 165, 410, 0, 166,
-	167, 168, 212, 442, 0, 169, 0, 0, 0, 0,
-	170, 171, 172, 173, 368, 0, 396, 384, 385, 386,
-	383, 372, 0, 0, 364, 365, 0, 0, 89, 90,
-	366, 91, 0, 373, 0, 0, 378, 0, 0, 0,
-	92, 93, 174, 425, 426, 94, 427, 428, 0, 95,
-	179, 96, 393, 411, 429, 430, 0, 421, 0, 404,
-	0, 97, 98, 99, 0, 100, 0, 101, 0, 294,
-	102, 103, 0, 405, 407, 0, 406, 408, 104, 105,
-	106, 107, 431, 108, 432, 433, 0, 0, 109, 0,
-	0, 0, 424, 111, 0, 0, 0, 0, 377, 112,
-	412, 391, 0, 113, 114, 434, 115, 0, 0, 972,
-	295, 0, 116, 422, 0, 190, 0, 117, 418, 420,
-	0, 0, 0, 296, 118, 435, 436, 437, 0, 403,
-	0, 297, 119, 298, 120, 0, 0, 423, 299, 121,
-	300, 0, 250, 0, 0, 0, 122, 123, 124, 125,
-	251, 301, 126, 127, 367, 128, 392, 419, 129, 438,
-	130, 131, 0, 0, 0, 0, 0, 132, 200, 302,
-	133, 303, 413, 134, 135, 0, 414, 136, 203, 0,
-	137, 138, 439, 139, 140, 0, 141, 142, 143, 0,
-	144, 304, 145, 146, 381, 147, 0, 148, 149, 0,
-	150, 252, 409, 151, 152, 305, 153, 440, 154, 0,
-	155, 157, 207, 156, 415, 0, 0, 158, 159, 0,
-	254, 441, 0, 0, 253, 416, 417, 390, 160, 161,
-	162, 163, 0, 0, 164, 165, 410, 0, 166, 167,
-	168, 212, 442, 0, 169, 0, 0, 0, 0, 170,
-	171, 172, 173, 368, 0, 396, 384, 385, 386, 383,
-	372, 0, 0, 364, 365, 0, 0, 89, 90, 366,
-	91, 0, 373, 0, 0, 378, 0, 0, 0, 92,
-	93, 174, 425, 426, 94, 427, 428, 0, 95, 179,
-	96, 393, 411, 429, 430, 0, 421, 0, 404, 0,
-	97, 98, 99, 0, 100, 0, 101, 0, 294, 102,
-	103, 0, 405, 407, 0, 406, 408, 104, 105, 106,
-	107, 431, 108, 432, 433, 0, 0, 109, 0, 0,
-	0, 424, 111, 0, 0, 0, 0, 377, 112, 412,
-	391, 0, 113, 114, 434, 115, 0, 0, 0, 295,
-	0, 116, 422, 0, 190, 0, 117, 418, 420, 0,
-	0, 0, 296, 118, 435, 436, 437, 0, 403, 0,
-	297, 119, 298, 120, 0, 0, 423, 299, 121, 300,
-	0, 250, 0, 0, 0, 122, 123, 124, 125, 251,
-	301, 126, 127, 367, 128, 392, 419, 129, 438, 130,
-	131, 0, 0, 0, 0, 0, 132, 200, 302, 133,
-	303, 413, 134, 135, 0, 414, 136, 203, 0, 137,
-	138, 439, 139, 140, 0, 141, 142, 143, 0, 144,
-	304, 145, 146, 381, 147, 0, 148, 149, 0, 150,
-	252, 409, 151, 152, 305, 153, 440, 154, 0, 155,
-	157, 207, 156, 415, 0, 0, 158, 159, 0, 254,
-	441, 0, 0, 253, 416, 417, 390, 160, 161, 162,
-	163, 0, 0, 164, 165, 410, 0, 166, 167, 168,
-	212, 442, 0, 169, 0, 0, 0, 0, 170, 171,
-	172, 173, 368, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 364, 365, 362, 0, 0, 0, 366, 0,
-	0, 373, 396, 384, 385, 386, 383, 372, 0, 0,
-	0, 0, 0, 0, 89, 90, 630, 91, 0, 0,
-	0, 0, 378, 0, 0, 0, 92, 93, 174, 425,
-	426, 94, 427, 428, 0, 95, 179, 96, 393, 411,
-	429, 430, 0, 421, 0, 404, 0, 97, 98, 99,
-	0, 100, 0, 101, 0, 294, 102, 103, 0, 405,
-	407, 0, 406, 408, 104, 105, 106, 107, 431, 108,
-	432, 433, 0, 0, 109, 0, 0, 0, 424, 111,
-	0, 0, 0, 0, 377, 112, 412, 391, 0, 113,
-	114, 434, 115, 0, 0, 0, 295, 0, 116, 422,
-	0, 190, 0, 117, 418, 420, 0, 0, 0, 296,
-	118, 435, 436, 437, 0, 403, 0, 297, 119, 298,
-	120, 0, 0, 423, 299, 121, 300, 0, 250, 0,
-	0, 0, 122, 123, 124, 125, 251, 301, 126, 127,
-	367, 128, 392, 419, 129, 438, 130, 131, 0, 0,
-	0, 0, 0, 132, 200, 302, 133, 303, 413, 134,
-	135, 0, 414, 136, 203, 0, 137, 138, 439, 139,
-	140, 0, 141, 142, 143, 0, 144, 304, 145, 146,
-	381, 147, 0, 148, 149, 0, 150, 252, 409, 151,
-	152, 305, 153, 440, 154, 0, 155, 157, 207, 156,
-	415, 0, 0, 158, 159, 0, 254, 441, 0, 0,
-	253, 416, 417, 390, 160, 161, 162, 163, 0, 0,
-	164, 165, 410, 0, 166, 167, 168, 212, 442, 0,
-	169, 0, 0, 0, 0, 170, 171, 172, 173, 368,
-	0, 396, 384, 385, 386, 383, 372, 0, 0, 364,
-	365, 0, 0, 89, 90, 366, 91, 0, 373, 0,
-	0, 378, 0, 0, 0, 92, 93, 174, 425, 426,
-	94, 427, 428, 0, 95, 179, 96, 393, 411, 429,
-	430, 0, 421, 0, 404, 0, 97, 98, 99, 0,
-	100, 0, 101, 0, 294, 102, 1564, 0, 405, 407,
-	0, 406, 408, 104, 105, 106, 107, 431, 108, 432,
-	433, 0, 0, 109, 0, 0, 0, 424, 111, 0,
-	0, 0, 0, 377, 112, 412, 391, 0, 113, 114,
-	434, 115, 0, 0, 0, 295, 0, 116, 422, 0,
-	190, 0, 117, 418, 420, 0, 0, 0, 296, 118,
-	435, 436, 437, 0, 403, 0, 297, 119, 298, 120,
-	0, 0, 423, 299, 121, 300, 0, 250, 0, 0,
-	0, 122, 123, 124, 125, 251, 301, 126, 127, 367,
-	128, 392, 419, 129, 438, 130, 131, 0, 0, 0,
-	0, 0, 132, 200, 302, 133, 303, 413, 134, 135,
-	0, 414, 136, 203, 0, 137, 138, 439, 139, 140,
-	0, 141, 142, 143, 0, 144, 304, 145, 146, 381,
-	147, 0, 148, 149, 0, 150, 252, 409, 151, 152,
-	305, 153, 440, 154, 0, 155, 157, 207, 156, 415,
-	0, 0, 158, 159, 0, 254, 441, 0, 0, 253,
-	416, 417, 390, 160, 161, 1563, 163, 0, 0, 164,
-	165, 410, 0, 166, 167, 168, 212, 442, 0, 169,
-	0, 0, 0, 0, 170, 171, 172, 173, 368, 0,
-	396, 384, 385, 386, 383, 372, 0, 0, 364, 365,
-	0, 0, 89, 90, 366, 91, 0, 373, 0, 0,
-	378, 0, 0, 0, 92, 93, 1562, 425, 426, 94,
-	427, 428, 0, 95, 179, 96, 393, 411, 429, 430,
-	0, 421, 0, 404, 0, 97, 98, 99, 0, 100,
-	0, 101, 0, 294, 102, 1564, 0, 405, 407, 0,
-	406, 408, 104, 105, 106, 107, 431, 108, 432, 433,
-	0, 0, 109, 0, 0, 0, 424, 111, 0, 0,
-	0, 0, 377, 112, 412, 391, 0, 113, 114, 434,
-	115, 0, 0, 0, 295, 0, 116, 422, 0, 190,
-	0, 117, 418, 420, 0, 0, 0, 296, 118, 435,
-	436, 437, 0, 403, 0, 297, 119, 298, 120, 0,
-	0, 423, 299, 121, 300, 0, 250, 0, 0, 0,
-	122, 123, 124, 125, 251, 301, 126, 127, 367, 128,
-	392, 419, 129, 438, 130, 131, 0, 0, 0, 0,
-	0, 132, 200, 302, 133, 303, 413, 134, 135, 0,
-	414, 136, 203, 0, 137, 138, 439, 139, 140, 0,
-	141, 142, 143, 0, 144, 304, 145, 146, 381, 147,
-	0, 148, 149, 0, 150, 252, 409, 151, 152, 305,
-	153, 440, 154, 0, 155, 157, 207, 156, 415, 0,
-	0, 158, 159, 0, 254, 441, 0, 0, 253, 416,
-	417, 390, 160, 161, 1563, 163, 0, 0, 164, 165,
-	410, 0, 166, 167, 168, 212, 442, 0, 169, 0,
-	0, 0, 0, 170, 171, 172, 173, 368, 0, 396,
-	384, 385, 386, 383, 372, 0, 0, 364, 365, 0,
-	0, 89, 90, 366, 91, 0, 373, 0, 0, 378,
-	0, 0, 0, 92, 93, 174, 425, 426, 94, 427,
-	428, 0, 95, 179, 96, 393, 411, 429, 430, 0,
-	421, 0, 404, 0, 97, 98, 99, 0, 100, 0,
-	101, 0, 294, 102, 103, 0, 405, 407, 0, 406,
-	408, 104, 105, 106, 107, 431, 108, 432, 433, 0,
-	0, 109, 0, 0, 0, 424, 111, 0, 0, 0,
-	0, 377, 112, 412, 391, 0, 113, 114, 434, 115,
-	0, 0, 0, 295, 0, 116, 422, 0, 190, 0,
-	117, 418, 420, 0, 0, 0, 296, 118, 435, 436,
-	437, 0, 403, 0, 297, 119, 298, 120, 0, 0,
-	423, 299, 121, 300, 0, 250, 0, 0, 0, 122,
-	123, 124, 125, 251, 301, 126, 127, 367, 128, 392,
-	419, 129, 438, 130, 131, 0, 0, 0, 0, 0,
-	132, 200, 302, 133, 303, 413, 134, 135, 0, 414,
-	136, 203, 0, 137, 138, 439, 139, 140, 0, 141,
-	142, 143, 0, 144, 304, 145, 146, 381, 147, 0,
-	148, 149, 0, 150, 252, 409, 151, 152, 305, 153,
-	440, 154, 0, 155, 157, 207, 156, 415, 0, 0,
-	158, 159, 0, 254, 441, 0, 0, 253, 416, 417,
-	390, 160, 161, 162, 163, 0, 0, 164, 165, 410,
-	0, 166, 167, 168, 212, 442, 0, 169, 0, 0,
-	0, 0, 170, 171, 172, 173, 368, 0, 396, 384,
-	385, 386, 383, 372, 0, 0, 364, 365, 0, 0,
-	89, 90, 366, 91, 0, 373, 0, 0, 378, 0,
-	0, 0, 92, 93, 174, 425, 426, 94, 427, 428,
-	0, 95, 179, 96, 393, 411, 429, 430, 0, 421,
-	0, 404, 0, 97, 98, 99, 0, 100, 0, 101,
-	0, 294, 102, 103, 0, 405, 407, 0, 406, 408,
-	104, 105, 106, 107, 431, 108, 432, 433, 0, 0,
-	109, 0, 0, 0, 424, 111, 0, 0, 0, 0,
-	377, 112, 412, 391, 0, 113, 114, 434, 115, 0,
-	0, 0, 295, 0, 116, 422, 0, 190, 0, 117,
-	418, 420, 0, 0, 0, 296, 118, 435, 436, 437,
-	0, 403, 0, 297, 119, 298, 120, 0, 0, 423,
-	299, 121, 300, 0, 250, 0, 0, 0, 122, 123,
-	124, 125, 251, 301, 126, 127, 0, 128, 392, 419,
-	129, 438, 130, 131, 0, 0, 0, 0, 0, 132,
-	200, 302, 133, 303, 413, 134, 135, 0, 414, 136,
-	203, 0, 137, 138, 439, 139, 140, 0, 141, 142,
-	143, 0, 144, 304, 145, 146, 962, 147, 0, 148,
-	149, 0, 150, 252, 409, 151, 152, 305, 153, 440,
-	154, 0, 155, 157, 207, 156, 415, 0, 0, 158,
-	159, 0, 254, 441, 0, 0, 253, 416, 417, 390,
-	160, 161, 162, 163, 0, 0, 164, 165, 410, 0,
-	166, 167, 168, 212, 442, 0, 169, 0, 0, 0,
-	0, 170, 171, 172, 173, 396, 384, 385, 386, 383,
-	372, 0, 0, 0, 0, 958, 959, 89, 90, 0,
-	91, 960, 0, 0, 961, 378, 0, 0, 0, 92,
-	93, 0, 425, 426, 94, 427, 428, 0, 95, 179,
-	96, 393, 411, 429, 430, 0, 421, 0, 404, 0,
-	97, 98, 99, 0, 100, 0, 101, 0, 294, 102,
-	1564, 0, 405, 407, 0, 406, 408, 104, 105, 106,
-	107, 431, 108, 432, 433, 0, 0, 109, 0, 0,
-	0, 424, 111, 0, 0, 0, 0, 377, 112, 412,
-	391, 0, 113, 114, 434, 115, 0, 0, 0, 295,
-	0, 116, 422, 0, 190, 0, 117, 418, 420, 0,
-	0, 0, 296, 118, 435, 436, 437, 0, 403, 0,
-	0, 119, 298, 120, 0, 0, 423, 299, 121, 0,
-	0, 250, 0, 0, 0, 122, 123, 124, 125, 251,
-	301, 126, 127, 367, 128, 392, 419, 129, 438, 130,
-	131, 0, 0, 0, 0, 0, 132, 200, 302, 133,
-	303, 413, 134, 135, 0, 414, 136, 203, 0, 137,
-	138, 439, 139, 140, 0, 141, 142, 143, 0, 144,
-	304, 145, 146, 381, 147, 0, 148, 149, 0, 150,
-	252, 409, 151, 152, 0, 153, 440, 154, 0, 155,
-	157, 207, 156, 415, 0, 0, 158, 159, 0, 254,
-	441, 0, 0, 253, 416, 417, 390, 160, 161, 1563,
-	163, 0, 0, 164, 165, 410, 0, 166, 167, 168,
-	212, 442, 0, 169, 0, 0, 0, 0, 170, 171,
-	172, 173, 396, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 364, 365, 89, 90, 0, 91, 366, 0,
-	0, 373, 0, 0, 0, 0, 92, 93, 174, 175,
-	176, 94, 177, 178, 0, 95, 179, 96, 0, 411,
-	180, 181, 0, 421, 0, 404, 0, 97, 98, 99,
-	0, 100, 0, 101, 0, 294, 102, 103, 0, 405,
-	407, 0, 406, 408, 104, 105, 106, 107, 183, 108,
-	184, 185, 0, 0, 109, 0, 0, 0, 110, 111,
-	0, 0, 0, 0, 186, 112, 412, 0, 0, 113,
-	114, 188, 115, 0, 0, 0, 295, 0, 116, 422,
-	0, 190, 0, 117, 418, 420, 0, 0, 0, 296,
-	118, 193, 194, 195, 0, 196, 0, 297, 119, 298,
-	120, 0, 0, 423, 299, 121, 300, 0, 250, 0,
-	0, 0, 122, 123, 124, 125, 251, 301, 126, 127,
-	0, 128, 0, 419, 129, 199, 130, 131, 0, 0,
-	0, 0, 0, 132, 200, 302, 133, 303, 413, 134,
-	135, 0, 414, 136, 203, 0, 137, 138, 204, 139,
-	140, 0, 141, 142, 143, 0, 144, 304, 145, 146,
-	205, 147, 0, 148, 149, 0, 150, 252, 409, 151,
-	152, 305, 153, 206, 154, 0, 155, 157, 207, 156,
-	415, 0, 0, 158, 159, 0, 254, 209, 0, 0,
-	253, 416, 417, 0, 160, 161, 162, 163, 0, 0,
-	164, 165, 410, 0, 166, 167, 168, 212, 213, 0,
-	169, 0, 0, 0, 0, 170, 171, 172, 173, 288,
+	740, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 736, 0, 0, 727, 726, 729, 710, 0, 737,
+	0, 722, 723, 724, 0, 721, 718, 719, 720, 713,
+	714, 715, 716, 717, 742, 712, 735, 0, 710, 1542,
+	731, 732, 733, 0, 0, 0, 0, 740, 0, 0,
+	734, 0, 0, 0, 711, 0, 712, 0, 736, 741,
+	725, 0, 0, 729, 0, 728, 0, 0, 0, 0,
+	730, 0, 0, 0, 0, 711, 0, 0, 0, 0,
+	738, 725, 0, 735, 0, 0, 728, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 89, 90, 0, 91, 0, 0, 0, 1362, 0,
-	0, 0, 0, 92, 93, 174, 175, 176, 94, 177,
-	178, 0, 95, 179, 96, 0, 0, 180, 181, 0,
-	182, 0, 293, 0, 97, 98, 99, 0, 100, 0,
-	101, 0, 294, 102, 103, 0, 0, 0, 0, 0,
-	0, 104, 105, 106, 107, 183, 108, 184, 185, 0,
-	0, 109, 0, 0, 0, 110, 111, 0, 0, 0,
-	0, 186, 112, 187, 0, 0, 113, 114, 188, 115,
-	0, 0, 0, 295, 0, 116, 189, 0, 190, 0,
-	117, 191, 192, 0, 0, 0, 296, 118, 193, 194,
-	195, 0, 196, 0, 297, 119, 298, 120, 0, 0,
-	197, 299, 121, 300, 0, 250, 0, 0, 0, 122,
-	123, 124, 125, 251, 301, 126, 127, 0, 128, 0,
-	198, 129, 199, 130, 131, 0, 0, 0, 0, 0,
-	132, 200, 302, 133, 303, 201, 134, 135, 0, 202,
-	136, 203, 0, 137, 138, 204, 139, 140, 0, 141,
-	142, 143, 0, 144, 304, 145, 146, 205, 147, 0,
-	148, 149, 43, 150, 252, 0, 151, 152, 305, 153,
-	206, 154, 0, 155, 157, 207, 156, 208, 0, 45,
-	158, 159, 0, 254, 209, 0, 0, 253, 210, 211,
-	0, 160, 161, 162, 163, 0, 0, 164, 165, 0,
-	0, 166, 167, 168, 292, 213, 0, 169, 0, 0,
-	0, 41, 170, 171, 172, 173, 0, 42, 288, 507,
-	511, 0, 512, 502, 0, 0, 0, 0, 0, 0,
-	89, 90, 0, 91, 0, 40, 0, 0, 0, 0,
-	0, 0, 92, 93, 174, 175, 176, 94, 177, 178,
-	0, 95, 179, 96, 0, 0, 180, 181, 0, 182,
-	0, 293, 0, 97, 98, 99, 0, 100, 0, 101,
-	0, 294, 102, 103, 0, 0, 0, 0, 0, 0,
-	104, 105, 106, 107, 183, 108, 184, 185, 515, 0,
-	109, 0, 0, 0, 110, 111, 0, 0, 0, 0,
-	186, 112, 187, 504, 0, 113, 114, 188, 115, 0,
-	0, 0, 295, 0, 116, 189, 0, 190, 0, 117,
-	191, 192, 0, 0, 0, 296, 118, 193, 194, 195,
-	0, 196, 0, 297, 119, 298, 120, 0, 0, 197,
-	299, 121, 300, 0, 250, 0, 0, 0, 122, 123,
-	124, 125, 251, 301, 126, 127, 0, 128, 0, 198,
-	129, 199, 130, 131, 0, 505, 0, 0, 0, 132,
-	200, 302, 133, 303, 201, 134, 135, 0, 202, 136,
-	203, 0, 137, 138, 204, 139, 140, 0, 141, 142,
-	143, 0, 144, 304, 145, 146, 205, 147, 0, 148,
-	149, 0, 150, 252, 0, 151, 152, 305, 153, 206,
-	154, 0, 155, 157, 207, 156, 208, 0, 0, 158,
-	159, 0, 254, 209, 0, 0, 253, 210, 211, 503,
-	160, 161, 162, 163, 0, 0, 164, 165, 0, 0,
-	166, 167, 168, 212, 213, 0, 169, 0, 0, 0,
-	0, 170, 171, 172, 173, 288, 507, 511, 0, 512,
-	502, 0, 0, 0, 0, 513, 508, 89, 90, 0,
-	91, 0, 0, 0, 0, 0, 0, 0, 0, 92,
-	93, 174, 175, 176, 94, 177, 178, 0, 95, 179,
-	96, 0, 0, 180, 181, 0, 182, 0, 293, 0,
-	97, 98, 99, 0, 100, 0, 101, 0, 294, 102,
-	103, 0, 0, 0, 0, 0, 0, 104, 105, 106,
-	107, 183, 108, 184, 185, 498, 0, 109, 0, 0,
-	0, 110, 111, 0, 0, 0, 0, 186, 112, 187,
-	504, 0, 113, 114, 188, 115, 0, 0, 0, 295,
-	0, 116, 189, 0, 190, 0, 117, 191, 192, 0,
-	0, 0, 296, 118, 193, 194, 195, 0, 196, 0,
-	297, 119, 298, 120, 0, 0, 197, 299, 121, 300,
-	0, 250, 0, 0, 0, 122, 123, 124, 125, 251,
-	301, 126, 127, 0, 128, 0, 198, 129, 199, 130,
-	131, 0, 505, 0, 0, 0, 132, 200, 302, 133,
-	303, 201, 134, 135, 0, 202, 136, 203, 0, 137,
-	138, 204, 139, 140, 0, 141, 142, 143, 0, 144,
-	304, 145, 146, 205, 147, 0, 148, 149, 0, 150,
-	252, 0, 151, 152, 305, 153, 206, 154, 0, 155,
-	157, 207, 156, 208, 0, 0, 158, 159, 0, 254,
-	209, 0, 0, 253, 210, 211, 503, 160, 161, 162,
-	163, 0, 0, 164, 165, 0, 0, 166, 167, 168,
-	212, 213, 0, 169, 0, 0, 0, 0, 170, 171,
-	172, 173, 288, 507, 511, 0, 512, 502, 0, 0,
-	0, 0, 513, 508, 89, 90, 0, 91, 0, 0,
-	0, 0, 0, 0, 0, 0, 92, 93, 174, 175,
-	176, 94, 177, 178, 0, 95, 179, 96, 0, 0,
-	180, 181, 0, 182, 0, 293, 0, 97, 98, 99,
-	0, 100, 0, 101, 0, 294, 102, 103, 0, 0,
-	0, 0, 0, 0, 104, 105, 106, 107, 183, 108,
-	184, 185, 0, 0, 109, 0, 0, 0, 110, 111,
-	0, 0, 0, 0, 186, 112, 187, 504, 0, 113,
-	114, 188, 115, 0, 0, 0, 295, 0, 116, 189,
-	0, 190, 0, 117, 191, 192, 0, 0, 0, 296,
-	118, 193, 194, 195, 0, 196, 0, 297, 119, 298,
-	120, 0, 0, 197, 299, 121, 300, 0, 250, 0,
-	0, 0, 122, 123, 124, 125, 251, 301, 126, 127,
-	0, 128, 0, 198, 129, 199, 130, 131, 0, 505,
-	0, 0, 0, 132, 200, 302, 133, 303, 201, 134,
-	135, 0, 202, 136, 203, 0, 137, 138, 204, 139,
-	140, 0, 141, 142, 143, 0, 144, 304, 145, 146,
-	205, 147, 0, 148, 149, 0, 150, 252, 0, 151,
-	152, 305, 153, 206, 154, 0, 155, 157, 207, 156,
-	208, 0, 0, 158, 159, 0, 254, 209, 0, 0,
-	253, 210, 211, 503, 160, 161, 162, 163, 0, 0,
-	164, 165, 0, 0, 166, 167, 168, 212, 213, 86,
-	169, 0, 0, 0, 0, 170, 171, 172, 173, 0,
-	0, 89, 90, 0, 91, 0, 0, 0, 0, 513,
-	508, 0, 0, 92, 93, 174, 175, 176, 94, 177,
-	178, 0, 95, 179, 96, 0, 0, 180, 181, 0,
-	182, 0, 0, 0, 97, 98, 99, 0, 100, 0,
-	101, 0, 0, 102, 103, 0, 0, 0, 0, 0,
-	0, 104, 105, 106, 107, 183, 108, 184, 185, 0,
-	0, 109, 0, 0, 0, 110, 111, 0, 0, 0,
-	0, 186, 112, 187, 0, 0, 113, 114, 188, 115,
-	0, 0, 0, 0, 0, 116, 189, 0, 190, 0,
-	117, 191, 192, 0, 0, 0, 0, 118, 193, 194,
-	195, 0, 196, 0, 0, 119, 0, 120, 0, 0,
-	197, 0, 121, 0, 0, 250, 0, 0, 0, 122,
-	123, 124, 125, 251, 0, 126, 127, 0, 128, 0,
-	198, 129, 199, 130, 131, 0, 0, 263, 0, 0,
-	132, 200, 0, 133, 0, 201, 134, 135, 0, 202,
-	136, 203, 0, 137, 138, 204, 139, 140, 0, 141,
-	142, 143, 0, 144, 0, 145, 146, 205, 147, 0,
-	148, 149, 43, 150, 252, 0, 151, 152, 0, 153,
-	206, 154, 0, 155, 157, 207, 156, 208, 0, 45,
-	158, 159, 0, 254, 209, 0, 0, 253, 210, 211,
-	0, 160, 161, 162, 163, 0, 0, 164, 165, 0,
-	0, 166, 167, 168, 292, 213, 0, 169, 0, 0,
-	0, 41, 170, 171, 172, 173, 86, 42, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 89, 90,
-	0, 91, 0, 0, 0, 829, 0, 0, 0, 0,
-	92, 93, 174, 175, 176, 94, 177, 178, 0, 95,
-	179, 96, 0, 0, 180, 181, 0, 182, 0, 0,
-	0, 97, 98, 99, 0, 100, 0, 101, 0, 0,
-	102, 103, 0, 0, 0, 0, 0, 0, 104, 105,
-	106, 107, 183, 108, 184, 185, 0, 0, 109, 0,
-	0, 0, 110, 111, 0, 0, 0, 0, 186, 112,
-	187, 0, 0, 113, 114, 188, 115, 0, 0, 0,
-	0, 0, 116, 189, 0, 190, 0, 117, 191, 192,
-	0, 0, 0, 0, 118, 193, 194, 195, 0, 196,
-	0, 0, 119, 0, 120, 0, 0, 197, 0, 121,
-	0, 0, 250, 0, 0, 0, 122, 123, 124, 125,
-	251, 0, 126, 127, 0, 128, 0, 198, 129, 199,
-	130, 131, 0, 0, 0, 0, 0, 132, 200, 0,
-	133, 0, 201, 134, 135, 0, 202, 136, 203, 0,
-	137, 138, 204, 139, 140, 0, 141, 142, 143, 0,
-	144, 0, 145, 146, 205, 147, 0, 148, 149, 43,
-	150, 252, 0, 151, 152, 0, 153, 206, 154, 0,
-	155, 157, 207, 156, 208, 0, 45, 158, 159, 0,
-	254, 209, 0, 0, 253, 210, 211, 0, 160, 161,
-	162, 163, 0, 0, 164, 165, 0, 0, 166, 167,
-	168, 292, 213, 0, 169, 0, 0, 0, 41, 170,
-	171, 172, 173, 86, 42, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 89, 90, 0, 91, 0,
-	0, 0, 40, 0, 1061, 0, 0, 92, 93, 174,
-	175, 176, 94, 177, 178, 0, 95, 179, 96, 0,
-	0, 180, 181, 0, 182, 0, 0, 0, 97, 98,
-	99, 0, 100, 0, 101, 0, 0, 102, 103, 0,
-	0, 0, 0, 0, 0, 104, 105, 106, 107, 183,
-	108, 184, 185, 0, 0, 109, 0, 0, 0, 110,
-	111, 0, 0, 0, 0, 186, 112, 187, 0, 0,
-	113, 114, 188, 115, 0, 0, 0, 0, 0, 116,
-	189, 0, 190, 0, 117, 191, 192, 0, 0, 0,
-	0, 118, 193, 194, 195, 0, 196, 0, 0, 119,
-	0, 120, 0, 0, 197, 0, 121, 0, 0, 250,
-	0, 0, 0, 122, 123, 124, 125, 251, 0, 126,
-	127, 0, 128, 0, 198, 129, 199, 130, 131, 0,
-	0, 0, 0, 0, 132, 200, 0, 133, 0, 201,
-	134, 135, 0, 202, 136, 203, 0, 137, 138, 204,
-	139, 140, 0, 141, 142, 143, 0, 144, 0, 145,
-	146, 205, 147, 0, 148, 149, 0, 150, 252, 0,
-	151, 152, 0, 153, 206, 154, 0, 155, 157, 207,
-	156, 208, 0, 0, 158, 159, 0, 254, 209, 0,
-	0, 253, 210, 211, 0, 160, 161, 162, 163, 0,
-	86, 164, 165, 0, 0, 166, 167, 168, 212, 213,
-	0, 169, 89, 90, 0, 91, 170, 171, 172, 173,
-	0, 0, 0, 0, 92, 93, 174, 175, 176, 94,
-	177, 178, 0, 95, 179, 96, 0, 0, 180, 181,
-	353, 182, 0, 0, 0, 97, 98, 99, 0, 100,
-	0, 101, 0, 0, 102, 103, 0, 0, 0, 0,
-	0, 0, 104, 105, 106, 107, 183, 108, 184, 185,
-	0, 0, 109, 0, 0, 0, 110, 111, 0, 0,
-	0, 0, 186, 112, 187, 0, 0, 113, 114, 188,
-	115, 0, 0, 0, 0, 0, 116, 189, 0, 190,
-	0, 117, 191, 192, 0, 0, 0, 0, 118, 193,
-	194, 195, 0, 196, 0, 0, 119, 0, 120, 0,
-	0, 197, 0, 121, 0, 0, 250, 0, 0, 0,
-	122, 123, 124, 125, 251, 0, 126, 127, 0, 128,
-	0, 198, 129, 199, 130, 131, 0, 0, 263, 0,
-	0, 132, 200, 0, 133, 0, 201, 134, 135, 0,
-	202, 136, 203, 0, 137, 138, 204, 139, 140, 0,
-	141, 142, 143, 0, 144, 0, 145, 146, 205, 147,
-	0, 148, 149, 0, 150, 252, 0, 151, 152, 0,
-	153, 206, 154, 0, 155, 157, 207, 156, 208, 0,
-	0, 158, 159, 0, 254, 209, 0, 0, 253, 210,
-	211, 0, 160, 161, 162, 163, 0, 0, 164, 165,
-	0, 0, 166, 167, 168, 212, 213, 0, 169, 0,
-	0, 0, 0, 170, 171, 172, 173, 86, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 89,
-	90, 0, 91, 0, 0, 0, 829, 0, 0, 0,
-	0, 92, 93, 174, 175, 176, 94, 177, 178, 0,
-	95, 179, 96, 0, 0, 180, 181, 0, 182, 0,
-	0, 0, 97, 98, 99, 0, 100, 0, 101, 0,
-	0, 102, 103, 0, 0, 0, 0, 0, 0, 104,
-	105, 106, 107, 183, 108, 184, 185, 0, 0, 109,
-	0, 0, 0, 110, 111, 0, 0, 0, 0, 186,
-	112, 187, 0, 0, 113, 114, 188, 115, 0, 0,
-	0, 0, 0, 116, 189, 0, 190, 0, 117, 191,
-	192, 0, 0, 0, 0, 118, 193, 194, 195, 0,
-	196, 0, 0, 119, 0, 120, 0, 0, 197, 0,
-	121, 0, 0, 250, 0, 0, 0, 122, 123, 124,
-	125, 251, 0, 126, 127, 0, 128, 0, 198, 129,
-	199, 130, 131, 0, 0, 0, 0, 0, 132, 200,
-	0, 133, 0, 201, 134, 135, 0, 202, 136, 203,
-	0, 137, 138, 204, 139, 140, 0, 141, 142, 143,
-	0, 144, 0, 145, 146, 205, 147, 0, 148, 149,
-	0, 150, 252, 0, 151, 152, 0, 153, 206, 154,
-	0, 155, 157, 207, 156, 208, 0, 0, 158, 159,
-	0, 254, 209, 0, 0, 253, 210, 211, 0, 160,
-	161, 162, 163, 0, 0, 164, 165, 0, 0, 166,
-	167, 168, 212, 213, 0, 169, 0, 0, 0, 0,
-	170, 171, 172, 173, 86, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 89, 90, 0, 91,
-	0, 0, 0, 774, 0, 0, 0, 0, 92, 93,
-	174, 175, 176, 94, 177, 178, 0, 95, 179, 96,
-	0, 0, 180, 181, 0, 182, 0, 0, 0, 97,
-	98, 99, 0, 100, 0, 101, 0, 0, 102, 103,
-	0, 0, 0, 0, 0, 0, 104, 105, 106, 107,
-	183, 108, 184, 185, 0, 0, 109, 0, 0, 0,
-	110, 111, 0, 0, 0, 0, 186, 112, 187, 0,
-	0, 113, 114, 188, 115, 0, 0, 0, 0, 0,
-	116, 189, 0, 190, 0, 117, 191, 192, 0, 0,
-	0, 0, 118, 193, 194, 195, 0, 196, 0, 0,
-	119, 0, 120, 0, 0, 197, 0, 121, 0, 0,
-	250, 0, 0, 0, 122, 123, 124, 125, 251, 0,
-	126, 127, 0, 128, 0, 198, 129, 199, 130, 131,
-	0, 0, 0, 0, 0, 132, 200, 0, 133, 0,
-	201, 134, 135, 0, 202, 136, 203, 0, 137, 138,
-	204, 139, 140, 0, 141, 142, 143, 0, 144, 0,
-	145, 146, 205, 147, 0, 148, 149, 0, 150, 252,
-	0, 151, 152, 0, 153, 206, 154, 0, 155, 157,
-	207, 156, 208, 0, 0, 158, 159, 0, 254, 209,
-	0, 0, 253, 210, 211, 0, 160, 161, 162, 163,
-	0, 0, 164, 165, 0, 0, 166, 167, 168, 212,
-	213, 0, 169, 0, 0, 0, 0, 170, 171, 172,
-	173, 86, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 89, 90, 0, 91, 0, 0, 0,
-	1269, 0, 0, 0, 0, 92, 93, 174, 175, 176,
-	94, 177, 178, 0, 95, 179, 96, 0, 0, 180,
-	181, 0, 182, 0, 0, 0, 97, 98, 99, 0,
-	100, 0, 101, 0, 0, 102, 103, 0, 0, 0,
-	0, 0, 0, 104, 105, 106, 107, 183, 108, 184,
-	185, 0, 0, 109, 0, 0, 0, 110, 111, 0,
-	0, 0, 0, 186, 112, 187, 0, 0, 113, 114,
-	188, 115, 0, 0, 0, 0, 0, 116, 189, 0,
-	190, 0, 117, 191, 192, 0, 0, 0, 0, 118,
-	193, 194, 195, 0, 196, 0, 0, 119, 0, 120,
-	0, 0, 197, 0, 121, 0, 0, 250, 0, 0,
-	0, 122, 123, 124, 125, 251, 0, 126, 127, 0,
-	128, 0, 198, 129, 199, 130, 131, 0, 0, 0,
-	0, 0, 132, 200, 0, 133, 0, 201, 134, 135,
-	0, 202, 136, 203, 0, 137, 138, 204, 139, 140,
-	0, 141, 142, 143, 0, 144, 0, 145, 146, 205,
-	147, 0, 148, 149, 0, 150, 252, 0, 151, 152,
-	0, 153, 206, 154, 0, 155, 157, 207, 156, 208,
-	0, 0, 158, 159, 0, 254, 209, 0, 0, 253,
-	210, 211, 0, 160, 161, 162, 163, 0, 0, 164,
-	165, 0, 0, 166, 167, 168, 212, 213, 0, 169,
-	0, 0, 0, 0, 170, 171, 172, 173, 288, 0,
+	0, 0, 0, 0, 727, 726, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 730, 0, 0,
+	0, 0, 0, 0, 0, 727, 726, 738, 0, 0,
+	737, 0, 722, 723, 724, 0, 721, 718, 719, 720,
+	713, 714, 715, 716, 717, 742, 0, 0, 0, 0,
+	1512, 0, 0, 729, 0, 0, 0, 0, 740, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 736,
+	0, 0, 0, 0, 729, 0, 0, 737, 0, 722,
+	723, 724, 0, 721, 718, 719, 720, 713, 714, 715,
+	716, 717, 0, 0, 735, 0, 710, 1455, 731, 732,
+	733, 0, 0, 0, 0, 0, 0, 730, 734, 0,
+	0, 0, 0, 0, 712, 0, 0, 741, 0, 710,
+	0, 731, 732, 733, 0, 0, 0, 0, 730, 0,
+	0, 734, 0, 711, 0, 0, 0, 712, 738, 725,
+	741, 0, 0, 0, 728, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 711, 0, 0, 0,
+	0, 0, 725, 0, 0, 0, 0, 728, 0, 0,
+	0, 0, 0, 721, 718, 719, 720, 713, 714, 715,
+	716, 717, 0, 727, 726, 0, 0, 0, 737, 0,
+	722, 723, 724, 0, 721, 718, 719, 720, 713, 714,
+	715, 716, 717, 742, 0, 0, 727, 726, 1390, 0,
+	0, 0, 0, 0, 0, 0, 740, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 742, 736, 0, 0,
+	0, 0, 729, 0, 0, 0, 0, 0, 0, 740,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	89, 90, 0, 91, 0, 0, 0, 453, 0, 0,
-	0, 0, 92, 93, 174, 175, 176, 94, 177, 178,
-	0, 95, 179, 96, 0, 0, 180, 181, 0, 182,
-	0, 293, 0, 97, 98, 99, 0, 100, 0, 101,
-	0, 294, 102, 103, 0, 0, 0, 0, 0, 0,
-	104, 105, 106, 107, 183, 108, 184, 185, 0, 0,
-	109, 0, 0, 0, 110, 111, 0, 0, 0, 0,
-	186, 112, 187, 0, 0, 113, 114, 188, 115, 0,
-	0, 0, 295, 0, 116, 189, 0, 190, 0, 117,
-	191, 192, 0, 0, 0, 296, 118, 193, 194, 195,
-	0, 196, 0, 297, 119, 298, 120, 0, 0, 197,
-	299, 121, 300, 0, 250, 0, 0, 0, 122, 123,
-	124, 125, 251, 301, 126, 127, 0, 128, 0, 198,
-	129, 199, 130, 131, 0, 0, 0, 0, 0, 132,
-	200, 302, 133, 303, 201, 134, 135, 0, 202, 136,
-	203, 0, 137, 138, 204, 139, 140, 0, 141, 142,
-	143, 0, 144, 304, 145, 146, 205, 147, 0, 148,
-	149, 0, 150, 252, 0, 151, 152, 305, 153, 206,
-	154, 0, 155, 157, 207, 156, 208, 0, 0, 158,
-	159, 0, 254, 209, 0, 0, 253, 210, 211, 0,
-	160, 161, 162, 163, 0, 86, 164, 165, 0, 0,
-	166, 167, 168, 212, 213, 0, 169, 89, 90, 0,
-	91, 170, 171, 172, 173, 0, 0, 0, 0, 92,
-	93, 174, 175, 176, 94, 177, 178, 0, 95, 179,
-	96, 0, 0, 180, 181, 749, 182, 0, 0, 0,
-	97, 98, 99, 0, 100, 747, 101, 0, 0, 102,
-	103, 0, 0, 0, 0, 0, 0, 104, 105, 106,
-	107, 183, 108, 184, 185, 0, 0, 109, 0, 0,
-	0, 110, 111, 0, 0, 0, 0, 186, 112, 187,
-	0, 0, 113, 114, 188, 115, 0, 752, 0, 0,
-	0, 116, 189, 0, 190, 0, 117, 191, 192, 0,
-	807, 0, 0, 118, 193, 194, 195, 0, 196, 0,
-	0, 119, 0, 120, 0, 0, 197, 0, 121, 0,
-	0, 250, 0, 0, 0, 122, 123, 124, 125, 251,
-	0, 126, 127, 0, 128, 0, 198, 129, 199, 130,
-	131, 0, 0, 0, 0, 0, 132, 200, 0, 133,
-	0, 201, 134, 135, 0, 202, 136, 203, 751, 137,
-	138, 204, 139, 140, 0, 141, 142, 143, 0, 144,
-	0, 145, 146, 205, 147, 0, 148, 149, 0, 150,
-	252, 0, 151, 152, 0, 153, 206, 154, 0, 155,
-	157, 207, 156, 208, 0, 0, 158, 159, 0, 254,
-	209, 0, 0, 253, 210, 211, 0, 160, 161, 162,
-	163, 0, 808, 164, 165, 0, 0, 166, 167, 168,
-	212, 213, 86, 169, 0, 0, 0, 0, 170, 171,
-	172, 173, 0, 0, 89, 90, 0, 91, 0, 0,
-	0, 0, 0, 0, 0, 0, 92, 93, 174, 175,
-	176, 94, 177, 178, 0, 95, 179, 96, 0, 0,
-	180, 181, 749, 182, 0, 0, 744, 97, 98, 99,
-	0, 100, 747, 101, 0, 0, 102, 103, 0, 0,
-	0, 0, 0, 0, 104, 105, 106, 107, 183, 108,
-	184, 185, 0, 0, 109, 0, 0, 0, 110, 111,
-	0, 0, 0, 0, 186, 112, 187, 0, 0, 113,
-	114, 188, 115, 0, 752, 0, 0, 0, 116, 189,
-	0, 190, 0, 117, 743, 192, 0, 0, 0, 0,
-	118, 193, 194, 195, 0, 196, 0, 0, 119, 0,
-	120, 0, 0, 197, 0, 121, 0, 0, 250, 0,
-	0, 0, 122, 123, 124, 125, 251, 0, 126, 127,
-	0, 128, 0, 198, 129, 199, 130, 131, 0, 0,
-	0, 0, 0, 132, 200, 0, 133, 0, 201, 134,
-	135, 0, 202, 136, 203, 751, 137, 138, 204, 139,
-	140, 0, 141, 142, 143, 0, 144, 0, 145, 146,
-	205, 147, 0, 148, 149, 0, 150, 252, 0, 151,
-	152, 0, 153, 206, 154, 0, 155, 157, 207, 156,
-	208, 0, 0, 158, 159, 0, 254, 209, 0, 0,
-	253, 210, 211, 0, 160, 161, 162, 163, 0, 750,
-	164, 165, 0, 0, 166, 167, 168, 212, 213, 86,
-	169, 0, 0, 0, 0, 170, 171, 172, 173, 0,
-	0, 89, 90, 0, 91, 0, 0, 0, 0, 0,
-	1061, 0, 0, 92, 93, 174, 175, 176, 94, 177,
-	178, 0, 95, 179, 96, 0, 0, 180, 181, 0,
-	182, 0, 0, 0, 97, 98, 99, 0, 100, 0,
-	101, 0, 0, 102, 103, 0, 0, 0, 0, 0,
-	0, 104, 105, 106, 107, 183, 108, 184, 185, 0,
-	0, 109, 0, 0, 0, 110, 111, 0, 0, 0,
-	0, 186, 112, 187, 0, 0, 113, 114, 188, 115,
-	0, 0, 0, 0, 0, 116, 189, 0, 190, 0,
-	117, 191, 192, 0, 0, 0, 0, 118, 193, 194,
-	195, 0, 196, 0, 0, 119, 0, 120, 0, 0,
-	197, 0, 121, 0, 0, 250, 0, 0, 0, 122,
-	123, 124, 125, 251, 0, 126, 127, 0, 128, 0,
-	198, 129, 199, 130, 131, 0, 0, 0, 0, 0,
-	132, 200, 0, 133, 0, 201, 134, 135, 0, 202,
-	136, 203, 0, 137, 138, 204, 139, 140, 0, 141,
-	142, 143, 0, 144, 0, 145, 146, 205, 147, 0,
-	148, 149, 0, 150, 252, 0, 151, 152, 0, 153,
-	206, 154, 0, 155, 157, 207, 156, 208, 0, 0,
-	158, 159, 0, 254, 209, 0, 0, 253, 210, 211,
-	0, 160, 161, 162, 163, 0, 86, 164, 165, 0,
-	0, 166, 167, 168, 212, 213, 0, 169, 89, 90,
-	0, 91, 170, 171, 172, 173, 0, 0, 0, 0,
-	92, 93, 174, 175, 176, 94, 177, 178, 0, 95,
-	179, 96, 0, 0, 180, 181, 0, 182, 0, 0,
-	0, 97, 98, 99, 0, 100, 0, 101, 0, 0,
-	102, 103, 0, 0, 0, 0, 0, 0, 104, 105,
-	106, 107, 183, 108, 184, 185, 0, 0, 109, 0,
-	0, 0, 110, 111, 0, 0, 0, 0, 186, 112,
-	187, 0, 0, 113, 114, 188, 115, 0, 0, 0,
-	0, 0, 116, 189, 0, 190, 0, 117, 191, 192,
-	0, 0, 0, 0, 118, 193, 194, 195, 0, 196,
-	0, 0, 119, 0, 120, 0, 0, 197, 0, 121,
-	0, 0, 250, 0, 0, 0, 122, 123, 124, 125,
-	251, 0, 126, 127, 0, 128, 0, 198, 129, 199,
-	130, 131, 0, 0, 263, 0, 0, 132, 200, 0,
-	133, 0, 201, 134, 135, 0, 202, 136, 203, 0,
-	137, 138, 204, 139, 140, 0, 141, 142, 143, 0,
-	144, 0, 145, 146, 205, 147, 0, 148, 149, 0,
-	150, 252, 0, 151, 152, 0, 153, 206, 154, 0,
-	155, 157, 207, 156, 208, 0, 0, 158, 159, 0,
-	254, 209, 0, 0, 253, 210, 211, 0, 160, 161,
-	162, 163, 0, 86, 164, 165, 0, 0, 166, 167,
-	168, 212, 213, 0, 169, 89, 90, 0, 91, 170,
-	171, 172, 173, 0, 0, 0, 0, 92, 93, 174,
-	175, 176, 94, 177, 178, 0, 95, 179, 96, 0,
-	0, 180, 181, 0, 182, 0, 0, 0, 97, 98,
-	99, 0, 100, 0, 101, 0, 0, 102, 103, 0,
-	0, 0, 0, 0, 0, 104, 105, 493, 107, 183,
-	108, 184, 185, 0, 0, 109, 0, 0, 0, 110,
-	111, 0, 0, 0, 0, 186, 112, 187, 0, 0,
-	113, 114, 188, 115, 0, 0, 0, 0, 0, 116,
-	189, 0, 190, 0, 117, 191, 192, 0, 0, 0,
-	0, 118, 193, 194, 195, 0, 196, 0, 0, 119,
-	0, 120, 0, 0, 197, 0, 121, 0, 0, 250,
-	0, 0, 0, 122, 123, 124, 125, 251, 0, 126,
-	127, 0, 128, 0, 198, 129, 199, 130, 131, 0,
-	0, 0, 0, 0, 132, 200, 0, 133, 0, 201,
-	134, 135, 0, 202, 136, 203, 0, 137, 138, 204,
-	139, 140, 0, 141, 142, 143, 0, 144, 0, 145,
-	146, 205, 147, 0, 148, 149, 0, 150, 252, 0,
-	151, 152, 0, 153, 206, 154, 0, 155, 157, 207,
-	156, 208, 0, 492, 158, 159, 0, 254, 209, 0,
-	0, 253, 210, 211, 0, 160, 161, 162, 163, 0,
-	86, 164, 165, 0, 0, 166, 167, 168, 212, 213,
-	0, 169, 89, 90, 0, 91, 170, 171, 172, 173,
-	0, 0, 0, 0, 92, 93, 174, 175, 176, 94,
-	177, 178, 0, 95, 179, 96, 0, 0, 180, 181,
-	0, 182, 0, 0, 0, 97, 98, 99, 0, 100,
-	0, 101, 0, 0, 102, 103, 0, 0, 0, 0,
-	0, 0, 104, 105, 106, 107, 183, 108, 184, 185,
-	0, 0, 109, 0, 0, 0, 110, 111, 0, 0,
-	0, 0, 186, 112, 187, 0, 0, 113, 114, 188,
-	115, 0, 0, 0, 0, 0, 116, 189, 0, 190,
-	0, 117, 269, 192, 0, 0, 0, 0, 118, 193,
-	194, 195, 0, 196, 0, 0, 119, 0, 120, 0,
-	0, 197, 0, 121, 0, 0, 250, 0, 0, 0,
-	122, 123, 124, 125, 251, 0, 126, 127, 0, 128,
-	0, 198, 129, 199, 130, 131, 0, 0, 263, 0,
-	0, 132, 200, 0, 133, 0, 201, 134, 135, 0,
-	202, 136, 203, 0, 137, 138, 204, 139, 140, 0,
-	141, 142, 143, 0, 144, 0, 145, 146, 205, 147,
-	0, 148, 149, 0, 150, 252, 0, 151, 152, 0,
-	153, 206, 154, 0, 155, 157, 207, 156, 208, 0,
-	0, 158, 159, 0, 254, 209, 0, 0, 253, 210,
-	211, 0, 160, 161, 162, 163, 0, 86, 164, 165,
-	0, 0, 166, 167, 168, 212, 213, 0, 169, 89,
-	90, 0, 91, 170, 171, 172, 173, 0, 0, 0,
-	0, 92, 93, 174, 175, 176, 94, 177, 178, 0,
-	95, 179, 96, 0, 0, 180, 181, 0, 182, 0,
-	0, 0, 97, 98, 99, 0, 100, 0, 101, 0,
-	0, 102, 103, 0, 0, 0, 0, 0, 0, 104,
-	105, 106, 107, 183, 108, 184, 185, 0, 0, 109,
-	0, 0, 0, 110, 111, 0, 0, 0, 0, 186,
-	112, 187, 0, 0, 113, 114, 188, 115, 0, 0,
-	0, 0, 0, 116, 189, 0, 190, 0, 117, 191,
-	192, 0, 0, 0, 0, 118, 193, 194, 195, 0,
-	196, 0, 0, 119, 0, 120, 0, 0, 197, 0,
-	121, 0, 0, 250, 0, 0, 0, 122, 123, 124,
-	125, 251, 0, 126, 127, 0, 128, 0, 198, 129,
-	199, 130, 131, 0, 0, 0, 0, 0, 132, 200,
-	0, 133, 0, 201, 134, 135, 0, 202, 136, 203,
-	0, 137, 138, 204, 139, 140, 0, 141, 142, 143,
-	0, 144, 0, 145, 146, 205, 147, 0, 148, 149,
-	0, 150, 252, 0, 151, 152, 0, 153, 206, 154,
-	0, 155, 157, 207, 156, 208, 0, 0, 158, 159,
-	0, 254, 209, 0, 0, 253, 210, 211, 0, 160,
-	161, 162, 163, 0, 86, 164, 165, 0, 0, 166,
-	167, 168, 212, 213, 0, 169, 89, 90, 0, 91,
-	170, 171, 172, 173, 0, 0, 0, 0, 92, 93,
-	174, 175, 176, 94, 177, 178, 0, 95, 179, 96,
-	0, 0, 180, 181, 0, 182, 0, 0, 0, 97,
-	98, 99, 0, 100, 0, 101, 0, 0, 102, 103,
-	0, 0, 0, 0, 0, 0, 104, 105, 106, 107,
-	183, 108, 184, 185, 0, 0, 109, 0, 0, 0,
-	110, 111, 0, 0, 0, 0, 186, 112, 187, 0,
-	0, 113, 114, 188, 115, 0, 0, 0, 0, 0,
-	116, 189, 0, 190, 0, 117, 1006, 192, 0, 0,
-	0, 0, 118, 193, 194, 195, 0, 196, 0, 0,
-	119, 0, 120, 0, 0, 197, 0, 121, 0, 0,
-	250, 0, 0, 0, 122, 123, 124, 125, 251, 0,
-	126, 127, 0, 128, 0, 198, 129, 199, 130, 131,
-	0, 0, 0, 0, 0, 132, 200, 0, 133, 0,
-	201, 134, 135, 0, 202, 136, 203, 0, 137, 138,
-	204, 139, 140, 0, 141, 142, 143, 0, 144, 0,
-	145, 146, 205, 147, 0, 148, 149, 0, 150, 252,
-	0, 151, 152, 0, 153, 206, 154, 0, 155, 157,
-	207, 156, 208, 0, 0, 158, 159, 0, 254, 209,
-	0, 0, 253, 210, 211, 0, 160, 161, 162, 163,
-	0, 86, 164, 165, 0, 0, 166, 167, 168, 212,
-	213, 0, 169, 89, 90, 0, 91, 170, 171, 172,
-	173, 0, 0, 0, 0, 92, 93, 174, 175, 176,
-	94, 177, 178, 0, 95, 179, 96, 0, 0, 180,
-	181, 0, 182, 0, 0, 0, 97, 98, 99, 0,
-	100, 0, 101, 0, 0, 102, 103, 0, 0, 0,
-	0, 0, 0, 104, 105, 106, 107, 183, 108, 184,
-	185, 0, 0, 109, 0, 0, 0, 110, 111, 0,
-	0, 0, 0, 186, 112, 187, 0, 0, 113, 114,
-	188, 115, 0, 0, 0, 0, 0, 116, 189, 0,
-	190, 0, 117, 1004, 192, 0, 0, 0, 0, 118,
-	193, 194, 195, 0, 196, 0, 0, 119, 0, 120,
-	0, 0, 197, 0, 121, 0, 0, 250, 0, 0,
-	0, 122, 123, 124, 125, 251, 0, 126, 127, 0,
-	128, 0, 198, 129, 199, 130, 131, 0, 0, 0,
-	0, 0, 132, 200, 0, 133, 0, 201, 134, 135,
-	0, 202, 136, 203, 0, 137, 138, 204, 139, 140,
-	0, 141, 142, 143, 0, 144, 0, 145, 146, 205,
-	147, 0, 148, 149, 0, 150, 252, 0, 151, 152,
-	0, 153, 206, 154, 0, 155, 157, 207, 156, 208,
-	0, 0, 158, 159, 0, 254, 209, 0, 0, 253,
-	210, 211, 0, 160, 161, 162, 163, 0, 86, 164,
-	165, 0, 0, 166, 167, 168, 212, 213, 0, 169,
-	89, 90, 0, 91, 170, 171, 172, 173, 0, 0,
-	0, 0, 92, 93, 174, 175, 176, 94, 177, 178,
-	0, 95, 179, 96, 0, 0, 180, 181, 0, 182,
-	0, 0, 0, 97, 98, 99, 0, 100, 0, 101,
-	0, 0, 102, 103, 0, 0, 0, 0, 0, 0,
-	104, 105, 106, 107, 183, 108, 184, 185, 0, 0,
-	109, 0, 0, 0, 110, 111, 0, 0, 0, 0,
-	186, 112, 187, 0, 0, 113, 114, 188, 115, 0,
-	0, 0, 0, 0, 116, 189, 0, 190, 0, 117,
-	995, 192, 0, 0, 0, 0, 118, 193, 194, 195,
-	0, 196, 0, 0, 119, 0, 120, 0, 0, 197,
-	0, 121, 0, 0, 250, 0, 0, 0, 122, 123,
-	124, 125, 251, 0, 126, 127, 0, 128, 0, 198,
-	129, 199, 130, 131, 0, 0, 0, 0, 0, 132,
-	200, 0, 133, 0, 201, 134, 135, 0, 202, 136,
-	203, 0, 137, 138, 204, 139, 140, 0, 141, 142,
-	143, 0, 144, 0, 145, 146, 205, 147, 0, 148,
-	149, 0, 150, 252, 0, 151, 152, 0, 153, 206,
-	154, 0, 155, 157, 207, 156, 208, 0, 0, 158,
-	159, 0, 254, 209, 0, 0, 253, 210, 211, 0,
-	160, 161, 162, 163, 0, 86, 164, 165, 0, 0,
-	166, 167, 168, 212, 213, 0, 169, 89, 90, 0,
-	91, 170, 171, 172, 173, 0, 0, 0, 0, 92,
-	93, 174, 175, 176, 94, 177, 178, 0, 95, 179,
-	96, 0, 0, 180, 181, 0, 182, 0, 0, 0,
-	97, 98, 99, 0, 100, 0, 101, 0, 0, 102,
-	103, 0, 0, 0, 0, 0, 0, 104, 105, 106,
-	107, 183, 108, 184, 185, 0, 0, 109, 0, 0,
-	0, 110, 111, 0, 0, 0, 0, 186, 112, 187,
-	0, 0, 113, 114, 188, 115, 0, 0, 0, 0,
-	0, 116, 189, 0, 190, 0, 117, 622, 192, 0,
-	0, 0, 0, 118, 193, 194, 195, 0, 196, 0,
-	0, 119, 0, 120, 0, 0, 197, 0, 121, 0,
-	0, 250, 0, 0, 0, 122, 123, 124, 125, 251,
-	0, 126, 127, 0, 128, 0, 198, 129, 199, 130,
-	131, 0, 0, 0, 0, 0, 132, 200, 0, 133,
-	0, 201, 134, 135, 0, 202, 136, 203, 0, 137,
-	138, 204, 139, 140, 0, 141, 142, 143, 0, 144,
-	0, 145, 146, 205, 147, 0, 148, 149, 0, 150,
-	252, 0, 151, 152, 0, 153, 206, 154, 0, 155,
-	157, 207, 156, 208, 0, 0, 158, 159, 0, 254,
-	209, 0, 0, 253, 210, 211, 0, 160, 161, 162,
-	163, 0, 86, 164, 165, 0, 0, 166, 167, 168,
-	212, 213, 0, 169, 89, 90, 0, 91, 170, 171,
-	172, 173, 0, 479, 0, 0, 92, 93, 174, 175,
-	176, 94, 177, 178, 0, 95, 179, 96, 0, 0,
-	180, 181, 0, 182, 0, 0, 0, 97, 98, 99,
-	0, 100, 0, 101, 0, 0, 102, 103, 0, 0,
-	0, 0, 0, 0, 104, 105, 106, 107, 183, 108,
-	184, 185, 0, 0, 109, 0, 0, 0, 110, 111,
-	0, 0, 0, 0, 186, 112, 187, 0, 0, 113,
-	114, 188, 115, 0, 0, 0, 0, 0, 116, 189,
-	0, 190, 0, 117, 191, 192, 0, 0, 0, 0,
-	118, 193, 194, 195, 0, 196, 0, 0, 119, 0,
-	120, 0, 0, 197, 0, 121, 0, 0, 250, 0,
-	0, 0, 122, 123, 124, 125, 251, 0, 126, 127,
-	0, 128, 0, 198, 129, 199, 130, 131, 0, 0,
-	0, 0, 0, 132, 200, 0, 133, 0, 201, 134,
-	135, 0, 202, 136, 203, 0, 137, 138, 204, 139,
-	140, 0, 141, 142, 143, 0, 144, 0, 145, 146,
-	205, 147, 0, 148, 149, 0, 150, 252, 0, 0,
-	152, 0, 153, 206, 154, 0, 155, 157, 207, 156,
-	208, 0, 0, 158, 159, 0, 254, 209, 0, 0,
-	253, 210, 211, 0, 160, 161, 162, 163, 0, 86,
-	164, 165, 0, 0, 166, 167, 168, 212, 213, 0,
-	169, 89, 90, 0, 91, 170, 171, 172, 173, 0,
-	0, 0, 0, 92, 93, 174, 175, 176, 94, 177,
-	178, 0, 95, 179, 96, 0, 0, 180, 181, 0,
-	182, 0, 0, 0, 97, 98, 99, 0, 100, 0,
-	101, 0, 0, 102, 103, 0, 0, 0, 0, 0,
-	0, 104, 105, 106, 107, 183, 108, 184, 185, 0,
-	0, 109, 0, 0, 0, 110, 111, 0, 0, 0,
-	0, 186, 112, 187, 0, 0, 113, 114, 188, 115,
-	0, 0, 0, 0, 0, 116, 189, 0, 190, 0,
-	117, 338, 192, 0, 0, 0, 0, 118, 193, 194,
-	195, 0, 196, 0, 0, 119, 0, 120, 0, 0,
-	197, 0, 121, 0, 0, 250, 0, 0, 0, 122,
-	123, 124, 125, 251, 0, 126, 127, 0, 128, 0,
-	198, 129, 199, 130, 131, 0, 0, 0, 0, 0,
-	132, 200, 0, 133, 0, 201, 134, 135, 0, 202,
-	136, 203, 0, 137, 138, 204, 139, 140, 0, 141,
-	142, 143, 0, 144, 0, 145, 146, 205, 147, 0,
-	148, 149, 0, 150, 252, 0, 151, 152, 0, 153,
-	206, 154, 0, 155, 157, 207, 156, 208, 0, 0,
-	158, 159, 0, 254, 209, 0, 0, 253, 210, 211,
-	0, 160, 161, 162, 163, 0, 86, 164, 165, 0,
-	0, 166, 167, 168, 212, 213, 0, 169, 89, 90,
-	0, 91, 170, 171, 172, 173, 0, 0, 0, 0,
-	92, 93, 174, 175, 176, 94, 177, 178, 0, 95,
-	179, 96, 0, 0, 180, 181, 0, 182, 0, 0,
-	0, 97, 98, 99, 0, 100, 0, 101, 0, 0,
-	102, 103, 0, 0, 0, 0, 0, 0, 104, 105,
-	106, 107, 183, 108, 184, 185, 0, 0, 109, 0,
-	0, 0, 110, 111, 0, 0, 0, 0, 186, 112,
-	187, 0, 0, 113, 114, 188, 115, 0, 0, 0,
-	0, 0, 116, 189, 0, 190, 0, 117, 335, 192,
-	0, 0, 0, 0, 118, 193, 194, 195, 0, 196,
-	0, 0, 119, 0, 120, 0, 0, 197, 0, 121,
-	0, 0, 250, 0, 0, 0, 122, 123, 124, 125,
-	251, 0, 126, 127, 0, 128, 0, 198, 129, 199,
-	130, 131, 0, 0, 0, 0, 0, 132, 200, 0,
-	133, 0, 201, 134, 135, 0, 202, 136, 203, 0,
-	137, 138, 204, 139, 140, 0, 141, 142, 143, 0,
-	144, 0, 145, 146, 205, 147, 0, 148, 149, 0,
-	150, 252, 0, 151, 152, 0, 153, 206, 154, 0,
-	155, 157, 207, 156, 208, 0, 0, 158, 159, 0,
-	254, 209, 0, 0, 253, 210, 211, 0, 160, 161,
-	162, 163, 0, 86, 164, 165, 0, 0, 166, 167,
-	168, 212, 213, 0, 169, 89, 90, 0, 91, 170,
-	171, 172, 173, 0, 0, 0, 0, 92, 93, 174,
-	175, 176, 94, 177, 178, 0, 95, 179, 96, 0,
-	0, 180, 181, 0, 182, 0, 0, 0, 97, 98,
-	99, 0, 100, 0, 101, 0, 0, 102, 103, 0,
-	0, 0, 0, 0, 0, 104, 105, 106, 107, 183,
-	108, 184, 185, 0, 0, 109, 0, 0, 0, 110,
-	111, 0, 0, 0, 0, 186, 112, 187, 0, 0,
-	113, 114, 188, 115, 0, 0, 0, 0, 0, 116,
-	189, 0, 190, 0, 117, 191, 192, 0, 0, 0,
-	0, 118, 193, 194, 195, 0, 196, 0, 0, 119,
-	0, 120, 0, 0, 197, 0, 121, 0, 0, 250,
-	0, 0, 0, 122, 123, 124, 125, 83, 0, 126,
-	127, 0, 128, 0, 198, 129, 199, 130, 131, 0,
-	0, 0, 0, 0, 132, 200, 0, 133, 0, 201,
-	134, 135, 0, 202, 136, 203, 0, 137, 138, 204,
-	139, 140, 0, 141, 142, 143, 0, 144, 0, 145,
-	146, 205, 147, 0, 148, 149, 0, 150, 252, 0,
-	151, 152, 0, 153, 206, 154, 0, 155, 157, 207,
-	156, 208, 0, 0, 158, 159, 0, 82, 209, 0,
-	0, 78, 210, 211, 0, 160, 161, 162, 163, 0,
-	86, 164, 165, 0, 0, 166, 167, 168, 212, 213,
-	0, 169, 89, 90, 0, 91, 170, 171, 172, 173,
-	0, 0, 0, 0, 92, 93, 174, 175, 176, 94,
-	177, 178, 0, 95, 179, 96, 0, 0, 180, 181,
-	0, 182, 0, 0, 0, 97, 98, 99, 0, 100,
-	0, 101, 0, 0, 102, 103, 0, 0, 0, 0,
-	0, 0, 104, 105, 106, 107, 183, 108, 184, 185,
-	0, 0, 109, 0, 0, 0, 110, 111, 0, 0,
-	0, 0, 186, 112, 187, 0, 0, 113, 114, 188,
-	115, 0, 0, 0, 0, 0, 116, 189, 0, 190,
-	0, 117, 283, 192, 0, 0, 0, 0, 118, 193,
-	194, 195, 0, 196, 0, 0, 119, 0, 120, 0,
-	0, 197, 0, 121, 0, 0, 250, 0, 0, 0,
-	122, 123, 124, 125, 251, 0, 126, 127, 0, 128,
-	0, 198, 129, 199, 130, 131, 0, 0, 0, 0,
-	0, 132, 200, 0, 133, 0, 201, 134, 135, 0,
-	202, 136, 203, 0, 137, 138, 204, 139, 140, 0,
-	141, 142, 143, 0, 144, 0, 145, 146, 205, 147,
-	0, 148, 149, 0, 150, 252, 0, 151, 152, 0,
-	153, 206, 154, 0, 155, 157, 207, 156, 208, 0,
-	0, 158, 159, 0, 254, 209, 0, 0, 253, 210,
-	211, 0, 160, 161, 162, 163, 0, 86, 164, 165,
-	0, 0, 166, 167, 168, 212, 213, 0, 169, 89,
-	90, 0, 91, 170, 171, 172, 173, 0, 0, 0,
-	0, 92, 93, 174, 175, 176, 94, 177, 178, 0,
-	95, 179, 96, 0, 0, 180, 181, 0, 182, 0,
-	0, 0, 97, 98, 99, 0, 100, 0, 101, 0,
-	0, 102, 103, 0, 0, 0, 0, 0, 0, 104,
-	105, 106, 107, 183, 108, 184, 185, 0, 0, 109,
-	0, 0, 0, 110, 111, 0, 0, 0, 0, 186,
-	112, 187, 0, 0, 113, 114, 188, 115, 0, 0,
-	0, 0, 0, 116, 189, 0, 190, 0, 117, 280,
-	192, 0, 0, 0, 0, 118, 193, 194, 195, 0,
-	196, 0, 0, 119, 0, 120, 0, 0, 197, 0,
-	121, 0, 0, 250, 0, 0, 0, 122, 123, 124,
-	125, 251, 0, 126, 127, 0, 128, 0, 198, 129,
-	199, 130, 131, 0, 0, 0, 0, 0, 132, 200,
-	0, 133, 0, 201, 134, 135, 0, 202, 136, 203,
-	0, 137, 138, 204, 139, 140, 0, 141, 142, 143,
-	0, 144, 0, 145, 146, 205, 147, 0, 148, 149,
-	0, 150, 252, 0, 151, 152, 0, 153, 206, 154,
-	0, 155, 157, 207, 156, 208, 0, 0, 158, 159,
-	0, 254, 209, 0, 0, 253, 210, 211, 0, 160,
-	161, 162, 163, 0, 86, 164, 165, 0, 0, 166,
-	167, 168, 212, 213, 0, 169, 89, 90, 0, 91,
-	170, 171, 172, 173, 0, 0, 0, 0, 92, 93,
-	174, 175, 176, 94, 177, 178, 0, 95, 179, 96,
-	0, 0, 180, 181, 0, 182, 0, 0, 0, 97,
-	98, 99, 0, 100, 0, 101, 0, 0, 102, 103,
-	0, 0, 0, 0, 0, 0, 104, 105, 106, 107,
-	183, 108, 184, 185, 0, 0, 109, 0, 0, 0,
-	110, 111, 0, 0, 0, 0, 186, 112, 187, 0,
-	0, 113, 114, 188, 115, 0, 0, 0, 0, 0,
-	116, 189, 0, 190, 0, 117, 278, 192, 0, 0,
-	0, 0, 118, 193, 194, 195, 0, 196, 0, 0,
-	119, 0, 120, 0, 0, 197, 0, 121, 0, 0,
-	250, 0, 0, 0, 122, 123, 124, 125, 251, 0,
-	126, 127, 0, 128, 0, 198, 129, 199, 130, 131,
-	0, 0, 0, 0, 0, 132, 200, 0, 133, 0,
-	201, 134, 135, 0, 202, 136, 203, 0, 137, 138,
-	204, 139, 140, 0, 141, 142, 143, 0, 144, 0,
-	145, 146, 205, 147, 0, 148, 149, 0, 150, 252,
-	0, 151, 152, 0, 153, 206, 154, 0, 155, 157,
-	207, 156, 208, 0, 0, 158, 159, 0, 254, 209,
-	0, 0, 253, 210, 211, 0, 160, 161, 162, 163,
-	0, 86, 164, 165, 0, 0, 166, 167, 168, 212,
-	213, 0, 169, 89, 90, 0, 91, 170, 171, 172,
-	173, 0, 0, 0, 0, 92, 93, 174, 175, 176,
-	94, 177, 178, 0, 95, 179, 96, 0, 0, 180,
-	181, 0, 182, 0, 0, 0, 97, 98, 99, 0,
-	100, 0, 101, 0, 0, 102, 103, 0, 0, 0,
-	0, 0, 0, 104, 105, 106, 107, 183, 108, 184,
-	185, 0, 0, 109, 0, 0, 0, 110, 111, 0,
-	0, 0, 0, 186, 112, 187, 0, 0, 113, 114,
-	188, 115, 0, 0, 0, 0, 0, 116, 189, 0,
-	190, 0, 117, 272, 192, 0, 0, 0, 0, 118,
-	193, 194, 195, 0, 196, 0, 0, 119, 0, 120,
-	0, 0, 197, 0, 121, 0, 0, 250, 0, 0,
-	0, 122, 123, 124, 125, 251, 0, 126, 127, 0,
-	128, 0, 198, 129, 199, 130, 131, 0, 0, 0,
-	0, 0, 132, 200, 0, 133, 0, 201, 134, 135,
-	0, 202, 136, 203, 0, 137, 138, 204, 139, 140,
-	0, 141, 142, 143, 0, 144, 0, 145, 146, 205,
-	147, 0, 148, 149, 0, 150, 252, 0, 151, 152,
-	0, 153, 206, 154, 0, 155, 157, 207, 156, 208,
-	0, 0, 158, 159, 0, 254, 209, 0, 0, 253,
-	210, 211, 0, 160, 161, 162, 163, 0, 86, 164,
-	165, 0, 0, 166, 167, 168, 212, 213, 0, 169,
-	89, 90, 0, 91, 170, 171, 172, 173, 0, 0,
-	0, 0, 92, 93, 174, 175, 176, 94, 177, 178,
-	0, 95, 179, 96, 0, 0, 180, 181, 0, 182,
-	0, 0, 0, 97, 98, 99, 0, 100, 0, 101,
-	0, 0, 102, 103, 0, 0, 0, 0, 0, 0,
-	104, 105, 106, 107, 183, 108, 184, 185, 0, 0,
-	109, 0, 0, 0, 110, 111, 0, 0, 0, 0,
-	186, 112, 187, 0, 0, 113, 114, 188, 115, 0,
-	0, 0, 0, 0, 116, 189, 0, 190, 0, 117,
-	191, 192, 0, 0, 0, 0, 118, 193, 194, 195,
-	0, 196, 0, 0, 119, 0, 120, 0, 0, 197,
-	0, 121, 0, 0, 250, 0, 0, 0, 122, 123,
-	124, 125, 251, 0, 126, 127, 0, 128, 0, 198,
-	129, 199, 130, 131, 0, 0, 0, 0, 0, 132,
-	200, 0, 133, 0, 201, 134, 135, 0, 202, 136,
-	203, 0, 137, 138, 204, 247, 140, 0, 141, 142,
-	143, 0, 144, 0, 145, 146, 205, 147, 0, 148,
-	149, 0, 150, 252, 0, 151, 152, 0, 153, 206,
-	154, 0, 155, 157, 207, 156, 208, 0, 0, 158,
-	159, 0, 254, 209, 0, 0, 253, 210, 211, 0,
-	160, 161, 162, 163, 0, 86, 164, 165, 0, 0,
-	166, 167, 168, 212, 213, 0, 169, 89, 90, 0,
-	91, 170, 171, 172, 173, 0, 0, 0, 0, 92,
-	93, 174, 175, 176, 94, 177, 178, 0, 95, 179,
-	96, 0, 0, 180, 181, 0, 182, 0, 0, 0,
-	97, 98, 99, 0, 100, 0, 101, 0, 0, 102,
-	103, 0, 0, 0, 0, 0, 0, 104, 105, 106,
-	107, 183, 108, 184, 185, 0, 0, 109, 0, 0,
-	0, 110, 111, 0, 0, 0, 0, 186, 112, 187,
-	0, 0, 113, 114, 188, 115, 0, 0, 0, 0,
-	0, 116, 189, 0, 190, 0, 117, 191, 192, 0,
-	0, 0, 0, 118, 193, 194, 195, 0, 196, 0,
-	0, 119, 0, 120, 0, 0, 197, 0, 121, 0,
-	0, 76, 0, 0, 0, 122, 123, 124, 125, 83,
-	0, 126, 127, 0, 128, 0, 198, 129, 199, 130,
-	131, 0, 0, 0, 0, 0, 132, 200, 0, 133,
-	0, 201, 134, 135, 0, 202, 136, 203, 0, 137,
-	138, 204, 139, 140, 0, 141, 142, 143, 0, 144,
-	0, 145, 146, 205, 147, 0, 148, 149, 0, 150,
-	77, 0, 151, 152, 0, 153, 206, 154, 0, 155,
-	157, 207, 156, 208, 0, 0, 158, 159, 0, 82,
-	209, 0, 0, 78, 210, 211, 0, 160, 161, 162,
-	163, 0, 86, 164, 165, 0, 0, 166, 167, 168,
-	212, 213, 0, 169, 89, 90, 0, 91, 170, 171,
-	172, 173, 0, 0, 0, 0, 92, 93, 174, 175,
-	176, 94, 177, 178, 0, 95, 179, 96, 0, 0,
-	180, 181, 0, 182, 0, 0, 0, 97, 98, 99,
-	0, 100, 0, 101, 0, 0, 102, 103, 0, 0,
-	0, 0, 0, 0, 104, 105, 106, 107, 183, 108,
-	184, 185, 0, 0, 109, 0, 0, 0, 110, 111,
-	0, 0, 0, 0, 186, 112, 187, 0, 0, 113,
-	114, 188, 115, 0, 0, 0, 0, 0, 116, 189,
-	0, 190, 0, 117, 191, 192, 0, 0, 0, 0,
-	118, 193, 194, 195, 0, 196, 0, 0, 119, 0,
-	120, 0, 0, 197, 0, 121, 0, 0, 250, 0,
-	0, 0, 122, 123, 124, 125, 251, 0, 126, 127,
-	0, 128, 0, 198, 129, 199, 130, 131, 0, 0,
-	0, 0, 0, 132, 200, 0, 133, 0, 201, 134,
-	0, 0, 202, 136, 203, 0, 0, 138, 204, 139,
-	140, 0, 141, 142, 143, 0, 144, 0, 145, 146,
-	205, 0, 0, 148, 149, 0, 150, 252, 0, 151,
-	152, 0, 153, 206, 154, 0, 155, 157, 207, 156,
-	208, 0, 0, 158, 159, 0, 254, 209, 0, 0,
-	253, 210, 211, 0, 160, 161, 162, 163, 0, 0,
-	164, 165, 0, 0, 166, 167, 168, 212, 213, 646,
-	169, 664, 665, 666, 0, 170, 171, 172, 173, 0,
-	0, 667, 0, 0, 0, 0, 0, 648, 0, 673,
-	0, 0, 0, 0, 0, 646, 0, 664, 665, 666,
-	0, 0, 0, 0, 0, 647, 0, 667, 0, 0,
-	0, 661, 0, 648, 0, 673, 0, 0, 0, 0,
-	0, 0, 646, 0, 664, 665, 666, 0, 0, 0,
-	0, 647, 0, 0, 667, 0, 0, 661, 0, 0,
-	648, 646, 673, 664, 665, 666, 0, 0, 0, 0,
-	0, 0, 0, 667, 0, 0, 0, 0, 647, 648,
-	0, 673, 0, 0, 661, 0, 0, 674, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 647, 672, 0,
-	0, 0, 0, 661, 0, 0, 0, 669, 0, 0,
-	0, 0, 662, 674, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 672, 0, 0, 0, 0, 0,
-	0, 0, 668, 669, 0, 0, 0, 0, 662, 0,
-	674, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 672, 0, 0, 0, 0, 0, 0, 668, 674,
-	669, 0, 0, 663, 0, 662, 0, 0, 0, 0,
-	672, 0, 671, 0, 0, 0, 0, 0, 0, 669,
-	0, 0, 0, 0, 662, 668, 0, 0, 0, 663,
-	0, 0, 0, 0, 0, 0, 0, 0, 671, 0,
-	0, 0, 0, 0, 668, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 663, 0, 0, 0,
-	670, 0, 658, 659, 660, 671, 657, 654, 655, 656,
-	649, 650, 651, 652, 653, 663, 0, 0, 0, 0,
-	0, 0, 0, 1165, 671, 0, 670, 0, 658, 659,
-	660, 0, 657, 654, 655, 656, 649, 650, 651, 652,
-	653, 0, 0, 0, 0, 0, 0, 0, 0, 1164,
-	0, 0, 0, 670, 0, 658, 659, 660, 0, 657,
-	654, 655, 656, 649, 650, 651, 652, 653, 0, 0,
-	0, 0, 670, 1521, 658, 659, 660, 0, 657, 654,
-	655, 656, 649, 650, 651, 652, 653, 646, 0, 664,
-	665, 666, 1520, 0, 0, 0, 0, 0, 0, 667,
-	0, 0, 0, 0, 0, 648, 646, 673, 664, 665,
-	666, 0, 0, 0, 0, 0, 0, 0, 667, 0,
-	0, 0, 0, 647, 648, 0, 673, 0, 0, 661,
-	0, 0, 0, 646, 0, 664, 665, 666, 0, 0,
-	0, 0, 647, 0, 0, 667, 0, 0, 661, 0,
-	0, 648, 0, 673, 0, 0, 0, 0, 0, 646,
-	0, 664, 665, 666, 0, 0, 0, 0, 0, 647,
-	0, 667, 0, 0, 0, 661, 0, 648, 0, 673,
-	0, 0, 0, 0, 0, 674, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 647, 672, 0, 0, 0,
-	0, 661, 0, 0, 674, 669, 0, 0, 0, 0,
-	662, 0, 0, 0, 0, 672, 0, 0, 0, 0,
-	0, 0, 0, 0, 669, 0, 0, 0, 0, 662,
-	668, 674, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 672, 0, 0, 0, 0, 0, 0, 668,
-	0, 669, 0, 0, 0, 0, 662, 674, 0, 0,
-	0, 663, 0, 0, 0, 0, 0, 0, 672, 0,
-	671, 0, 0, 0, 0, 0, 668, 669, 0, 0,
-	663, 0, 662, 0, 0, 0, 0, 0, 0, 671,
+	736, 0, 735, 0, 0, 729, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 668, 0, 0, 0, 0, 663, 0, 0,
-	0, 0, 0, 0, 0, 0, 671, 0, 670, 0,
-	658, 659, 660, 0, 657, 654, 655, 656, 649, 650,
-	651, 652, 653, 663, 0, 0, 0, 670, 1507, 658,
-	659, 660, 671, 657, 654, 655, 656, 649, 650, 651,
-	652, 653, 0, 0, 0, 0, 0, 1484, 0, 0,
-	0, 0, 0, 0, 670, 0, 658, 659, 660, 0,
-	657, 654, 655, 656, 649, 650, 651, 652, 653, 0,
-	0, 0, 0, 0, 1479, 0, 0, 0, 0, 0,
-	670, 0, 658, 659, 660, 0, 657, 654, 655, 656,
-	649, 650, 651, 652, 653, 646, 0, 664, 665, 666,
-	1475, 0, 0, 0, 0, 0, 0, 667, 0, 0,
-	0, 0, 0, 648, 646, 673, 664, 665, 666, 0,
-	0, 0, 0, 0, 0, 0, 667, 0, 0, 0,
-	0, 647, 648, 0, 673, 0, 0, 661, 0, 0,
-	0, 646, 0, 664, 665, 666, 0, 0, 0, 0,
-	647, 0, 0, 667, 0, 0, 661, 0, 0, 648,
-	0, 673, 0, 0, 0, 0, 0, 646, 0, 664,
-	665, 666, 0, 0, 0, 0, 0, 647, 0, 667,
-	0, 0, 0, 661, 0, 648, 0, 673, 0, 0,
-	0, 0, 0, 674, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 647, 672, 0, 0, 0, 0, 661,
-	0, 0, 674, 669, 0, 0, 0, 0, 662, 0,
-	0, 0, 0, 672, 0, 0, 0, 0, 0, 0,
-	0, 0, 669, 0, 0, 0, 0, 662, 668, 674,
+	0, 0, 0, 0, 0, 735, 0, 710, 0, 731,
+	732, 733, 0, 0, 0, 0, 730, 0, 0, 734,
+	0, 0, 0, 0, 0, 712, 738, 0, 741, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 730,
+	0, 0, 0, 0, 711, 0, 0, 0, 0, 738,
+	725, 0, 0, 0, 0, 728, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	672, 0, 0, 0, 0, 0, 0, 668, 0, 669,
-	0, 0, 0, 0, 662, 674, 0, 0, 0, 663,
-	0, 0, 0, 0, 0, 0, 672, 0, 671, 0,
-	0, 0, 0, 0, 668, 669, 0, 0, 663, 0,
-	662, 0, 0, 0, 0, 0, 0, 671, 0, 0,
+	0, 0, 0, 0, 0, 0, 737, 0, 722, 723,
+	724, 0, 721, 718, 719, 720, 713, 714, 715, 716,
+	717, 0, 0, 0, 727, 726, 1365, 0, 0, 737,
+	0, 722, 723, 724, 0, 721, 718, 719, 720, 713,
+	714, 715, 716, 717, 742, 0, 0, 0, 710, 998,
+	731, 732, 733, 0, 0, 0, 0, 740, 0, 0,
+	734, 0, 0, 0, 0, 0, 712, 0, 736, 741,
+	0, 0, 0, 729, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 711, 0, 0, 0, 0,
+	0, 725, 0, 735, 0, 0, 728, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	668, 0, 0, 0, 0, 663, 0, 0, 0, 0,
-	0, 0, 0, 0, 671, 0, 670, 0, 658, 659,
-	660, 0, 657, 654, 655, 656, 649, 650, 651, 652,
-	653, 663, 0, 0, 0, 670, 1417, 658, 659, 660,
-	671, 657, 654, 655, 656, 649, 650, 651, 652, 653,
-	0, 0, 0, 0, 0, 1416, 0, 0, 0, 0,
-	0, 0, 670, 0, 658, 659, 660, 0, 657, 654,
-	655, 656, 649, 650, 651, 652, 653, 0, 0, 0,
-	0, 0, 1334, 0, 0, 0, 0, 0, 670, 0,
-	658, 659, 660, 0, 657, 654, 655, 656, 649, 650,
-	651, 652, 653, 646, 0, 664, 665, 666, 1272, 0,
-	0, 0, 0, 0, 0, 667, 0, 0, 0, 0,
-	0, 648, 646, 673, 664, 665, 666, 0, 0, 0,
-	0, 0, 0, 0, 667, 0, 0, 0, 0, 647,
-	648, 0, 673, 0, 0, 661, 0, 0, 0, 646,
-	0, 664, 665, 666, 0, 0, 0, 0, 647, 0,
-	0, 667, 0, 0, 661, 0, 0, 648, 0, 673,
-	0, 0, 0, 0, 0, 646, 0, 664, 665, 666,
-	0, 0, 0, 0, 0, 647, 0, 667, 0, 0,
-	0, 661, 0, 648, 0, 673, 0, 0, 0, 0,
-	0, 674, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 647, 672, 0, 0, 0, 0, 661, 0, 0,
-	674, 669, 0, 0, 0, 0, 662, 0, 0, 0,
-	0, 672, 0, 0, 0, 0, 0, 0, 0, 0,
-	669, 0, 0, 0, 0, 662, 668, 674, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 672, 1581,
-	0, 0, 0, 0, 0, 668, 0, 669, 0, 0,
-	0, 0, 662, 674, 0, 0, 0, 663, 0, 0,
-	0, 0, 0, 0, 672, 0, 671, 0, 0, 0,
-	0, 0, 668, 669, 0, 0, 663, 0, 662, 0,
-	0, 0, 0, 0, 0, 671, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 668, 0,
-	0, 0, 0, 663, 0, 0, 0, 0, 0, 0,
-	1580, 0, 671, 0, 670, 0, 658, 659, 660, 0,
-	657, 654, 655, 656, 649, 650, 651, 652, 653, 663,
-	0, 0, 0, 670, 1247, 658, 659, 660, 671, 657,
-	654, 655, 656, 649, 650, 651, 652, 653, 0, 0,
-	0, 0, 0, 910, 0, 0, 0, 0, 0, 0,
-	670, 0, 658, 659, 660, 0, 657, 654, 655, 656,
-	649, 650, 651, 652, 653, 0, 0, 0, 1318, 0,
-	0, 0, 0, 0, 0, 0, 670, 0, 658, 659,
-	660, 0, 657, 654, 655, 656, 649, 650, 651, 652,
-	653, 646, 0, 664, 665, 666, 0, 0, 0, 0,
-	0, 0, 0, 667, 0, 0, 0, 0, 0, 648,
-	646, 673, 664, 665, 666, 0, 0, 0, 0, 0,
-	0, 0, 667, 0, 0, 0, 818, 647, 648, 0,
-	673, 0, 676, 661, 0, 0, 0, 0, 646, 0,
-	664, 665, 666, 0, 0, 0, 647, 0, 0, 0,
-	667, 0, 661, 675, 0, 0, 648, 0, 673, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 647, 0, 1156, 819, 1155, 0,
-	661, 0, 0, 0, 0, 0, 0, 0, 0, 674,
+	0, 0, 0, 0, 0, 0, 710, 730, 731, 732,
+	733, 0, 0, 0, 0, 727, 726, 738, 734, 0,
+	1716, 0, 0, 0, 712, 0, 0, 741, 0, 0,
+	0, 0, 0, 0, 0, 742, 0, 0, 0, 0,
+	0, 0, 0, 711, 0, 0, 0, 0, 740, 725,
+	0, 0, 0, 0, 728, 0, 0, 0, 0, 736,
+	0, 0, 0, 0, 729, 0, 0, 737, 0, 722,
+	723, 724, 0, 721, 718, 719, 720, 713, 714, 715,
+	716, 717, 0, 0, 735, 1309, 0, 0, 710, 0,
+	731, 732, 733, 727, 726, 0, 0, 1715, 0, 1261,
+	734, 1260, 0, 0, 893, 0, 712, 0, 0, 741,
+	0, 0, 0, 742, 0, 0, 0, 0, 730, 0,
+	0, 0, 0, 0, 0, 711, 740, 0, 738, 0,
+	0, 725, 0, 0, 0, 0, 728, 736, 0, 0,
+	0, 0, 729, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	672, 0, 0, 0, 0, 0, 0, 0, 674, 669,
-	0, 0, 0, 0, 662, 0, 0, 0, 0, 672,
-	0, 0, 0, 0, 0, 0, 0, 0, 669, 0,
-	0, 0, 0, 662, 668, 0, 674, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 672, 0, 0,
-	0, 0, 0, 668, 0, 0, 669, 0, 0, 0,
-	0, 662, 0, 0, 0, 663, 0, 0, 0, 0,
-	0, 0, 0, 0, 671, 0, 0, 0, 0, 0,
-	0, 668, 0, 0, 663, 0, 0, 0, 0, 0,
-	0, 0, 0, 671, 0, 0, 0, 0, 0, 0,
+	894, 0, 735, 0, 1228, 0, 1247, 1248, 1249, 0,
+	0, 0, 0, 0, 0, 727, 726, 0, 737, 0,
+	722, 723, 724, 0, 721, 718, 719, 720, 713, 714,
+	715, 716, 717, 744, 0, 742, 730, 0, 0, 710,
+	0, 731, 732, 733, 0, 0, 738, 1241, 740, 0,
+	0, 734, 1244, 0, 743, 0, 0, 712, 0, 736,
+	741, 0, 0, 0, 729, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 711, 0, 0, 0,
+	0, 0, 725, 0, 735, 0, 0, 728, 0, 0,
+	0, 1243, 1242, 0, 0, 0, 737, 0, 722, 723,
+	724, 0, 721, 718, 719, 720, 713, 714, 715, 716,
+	717, 0, 0, 0, 0, 0, 0, 710, 730, 731,
+	732, 733, 0, 0, 1250, 0, 727, 726, 738, 734,
+	0, 0, 0, 0, 0, 712, 0, 0, 741, 0,
+	1245, 0, 0, 0, 0, 0, 742, 0, 0, 0,
+	0, 0, 0, 0, 711, 0, 0, 0, 0, 740,
+	725, 0, 0, 0, 0, 728, 0, 0, 0, 0,
+	736, 0, 0, 0, 0, 729, 0, 0, 737, 0,
+	722, 723, 724, 0, 721, 718, 719, 720, 713, 714,
+	715, 716, 717, 0, 1246, 735, 0, 0, 710, 0,
+	731, 732, 733, 0, 727, 726, 0, 0, 0, 0,
+	734, 0, 0, 0, 0, 0, 712, 0, 0, 741,
+	0, 0, 0, 0, 742, 0, 0, 0, 0, 730,
+	0, 0, 0, 0, 0, 711, 0, 740, 0, 738,
+	0, 725, 0, 0, 0, 0, 728, 0, 736, 0,
+	0, 0, 0, 729, 0, 0, 1238, 1239, 1240, 0,
+	1237, 1234, 1235, 1236, 1229, 1230, 1231, 1232, 1233, 0,
+	0, 0, 0, 735, 284, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 727, 726, 0, 0, 737,
+	0, 722, 723, 724, 0, 721, 718, 719, 720, 713,
+	714, 715, 716, 717, 0, 742, 0, 730, 0, 710,
+	0, 731, 732, 733, 0, 0, 0, 738, 740, 0,
+	0, 734, 0, 0, 0, 0, 0, 712, 0, 736,
+	741, 0, 0, 0, 729, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 711, 0, 0, 0,
+	0, 0, 725, 0, 735, 0, 0, 728, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 737, 0, 722,
+	723, 724, 0, 721, 718, 719, 720, 713, 714, 715,
+	716, 717, 0, 0, 0, 0, 0, 710, 730, 731,
+	732, 733, 0, 0, 0, 0, 727, 726, 738, 734,
+	0, 0, 1262, 0, 1267, 712, 0, 0, 741, 0,
+	0, 0, 0, 1384, 0, 0, 742, 0, 0, 0,
+	0, 0, 0, 0, 711, 0, 0, 0, 0, 740,
+	725, 0, 0, 0, 0, 728, 0, 0, 0, 0,
+	736, 0, 0, 0, 0, 729, 0, 0, 737, 0,
+	722, 723, 724, 0, 721, 718, 719, 720, 713, 714,
+	715, 716, 717, 0, 0, 735, 0, 0, 710, 0,
+	731, 732, 733, 0, 727, 726, 0, 0, 0, 0,
+	734, 0, 0, 0, 0, 0, 712, 0, 0, 741,
+	0, 0, 0, 0, 742, 0, 0, 0, 0, 730,
+	0, 0, 0, 0, 0, 711, 0, 740, 0, 738,
+	0, 725, 0, 0, 0, 0, 728, 0, 736, 0,
+	0, 0, 0, 729, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 663, 0, 0, 0, 0, 0, 0, 0,
-	0, 671, 670, 0, 658, 659, 660, 0, 657, 654,
-	655, 656, 649, 650, 651, 652, 653, 0, 0, 0,
-	0, 670, 0, 658, 659, 660, 0, 657, 654, 655,
-	656, 649, 650, 651, 652, 653, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 670,
-	0, 658, 659, 660, 0, 657, 654, 655, 656, 649,
-	650, 651, 652, 653, 646, 0, 664, 665, 666, 0,
-	0, 0, 0, 0, 0, 0, 667, 0, 0, 0,
-	0, 0, 648, 646, 673, 664, 665, 666, 0, 0,
-	0, 0, 0, 0, 0, 667, 0, 0, 0, 0,
-	647, 648, 0, 673, 0, 0, 661, 0, 0, 0,
-	0, 646, 0, 664, 665, 666, 0, 0, 0, 647,
-	0, 0, 0, 667, 0, 661, 0, 0, 0, 648,
-	0, 673, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 647, 0, 0,
-	0, 0, 0, 661, 0, 0, 0, 0, 0, 0,
-	0, 0, 674, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 672, 0, 0, 0, 0, 0, 0,
-	0, 674, 669, 0, 0, 0, 0, 662, 0, 0,
-	0, 0, 672, 0, 0, 0, 0, 0, 1162, 0,
-	0, 669, 0, 0, 0, 0, 662, 668, 242, 674,
+	0, 0, 0, 735, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 727, 726, 0, 0, 737,
+	0, 722, 723, 724, 0, 721, 718, 719, 720, 713,
+	714, 715, 716, 717, 0, 742, 0, 730, 0, 710,
+	0, 731, 732, 733, 0, 0, 0, 738, 740, 0,
+	0, 734, 0, 0, 1221, 0, 0, 712, 0, 736,
+	741, 0, 0, 0, 729, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 711, 0, 0, 0,
+	0, 0, 725, 0, 735, 0, 0, 728, 0, 0,
+	0, 0, 0, 0, 0, 1226, 0, 737, 0, 722,
+	723, 724, 0, 721, 718, 719, 720, 713, 714, 715,
+	716, 717, 0, 0, 0, 0, 0, 710, 730, 731,
+	732, 733, 0, 0, 0, 0, 727, 726, 738, 734,
+	0, 0, 0, 0, 0, 712, 0, 0, 741, 0,
+	0, 0, 0, 0, 0, 0, 742, 0, 0, 0,
+	0, 0, 0, 0, 711, 0, 0, 0, 0, 740,
+	725, 0, 0, 0, 0, 728, 0, 0, 0, 0,
+	736, 0, 0, 0, 0, 729, 0, 0, 737, 0,
+	722, 723, 724, 0, 721, 718, 719, 720, 713, 714,
+	715, 716, 717, 0, 0, 735, 0, 0, 710, 0,
+	731, 732, 733, 0, 727, 726, 0, 0, 0, 0,
+	734, 0, 0, 0, 0, 0, 712, 0, 0, 741,
+	0, 0, 0, 0, 742, 0, 0, 0, 0, 730,
+	0, 0, 0, 0, 0, 711, 0, 740, 0, 738,
+	0, 725, 0, 0, 0, 0, 728, 0, 736, 0,
+	0, 0, 0, 729, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	672, 0, 0, 0, 0, 0, 668, 0, 0, 669,
-	0, 0, 0, 0, 662, 0, 0, 0, 663, 0,
-	0, 0, 0, 0, 0, 0, 0, 671, 0, 0,
-	0, 0, 0, 0, 668, 0, 0, 663, 0, 0,
-	0, 0, 0, 0, 0, 0, 671, 0, 0, 0,
+	0, 0, 0, 735, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 727, 726, 0, 0, 737,
+	0, 722, 723, 724, 0, 721, 718, 719, 720, 713,
+	714, 715, 716, 717, 0, 742, 710, 730, 731, 732,
+	733, 0, 0, 0, 0, 0, 0, 738, 740, 0,
+	0, 0, 0, 0, 712, 0, 0, 741, 0, 736,
+	0, 0, 0, 0, 729, 0, 0, 0, 0, 0,
+	0, 0, 0, 711, 0, 0, 0, 0, 0, 725,
+	0, 0, 0, 0, 728, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 737, 0, 722,
+	723, 724, 0, 721, 718, 719, 720, 713, 714, 715,
+	716, 717, 710, 0, 731, 732, 733, 0, 730, 0,
+	0, 0, 0, 727, 726, 0, 0, 710, 738, 0,
+	712, 0, 0, 741, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 742, 0, 712, 0, 0, 741, 711,
+	0, 0, 0, 0, 0, 725, 740, 0, 0, 0,
+	728, 0, 0, 0, 711, 0, 0, 736, 0, 0,
+	725, 0, 729, 0, 0, 728, 0, 0, 737, 0,
+	722, 723, 724, 0, 721, 718, 719, 720, 713, 714,
+	715, 716, 717, 0, 0, 0, 0, 0, 0, 727,
+	726, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 727, 726, 0, 0, 0, 742,
+	0, 0, 0, 0, 0, 0, 730, 0, 0, 0,
+	0, 0, 0, 0, 742, 0, 738, 0, 0, 0,
+	0, 0, 0, 736, 0, 0, 0, 0, 729, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 736, 0,
+	0, 0, 0, 729, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	1266, 0, 0, 0, 0, 663, 0, 0, 0, 0,
-	0, 0, 0, 0, 671, 670, 0, 658, 659, 660,
-	0, 657, 654, 655, 656, 649, 650, 651, 652, 653,
-	0, 0, 0, 0, 670, 0, 658, 659, 660, 0,
-	657, 654, 655, 656, 649, 650, 651, 652, 653, 1126,
-	0, 1142, 1143, 1144, 0, 0, 0, 0, 0, 0,
-	0, 1385, 670, 0, 658, 659, 660, 0, 657, 654,
-	655, 656, 649, 650, 651, 652, 653, 646, 0, 664,
-	665, 666, 0, 0, 0, 0, 0, 0, 0, 667,
-	0, 1139, 1157, 0, 0, 648, 646, 673, 664, 665,
-	666, 0, 0, 0, 0, 0, 0, 0, 667, 0,
-	0, 0, 0, 647, 648, 0, 673, 0, 0, 661,
-	0, 0, 0, 0, 646, 0, 664, 665, 666, 0,
-	0, 0, 647, 0, 0, 0, 667, 0, 661, 1119,
-	0, 0, 648, 0, 673, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 1145, 0,
-	647, 0, 0, 0, 0, 0, 661, 0, 0, 0,
-	0, 0, 1140, 0, 0, 674, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 672, 0, 0, 0,
-	0, 0, 0, 0, 674, 669, 0, 0, 0, 0,
-	662, 0, 0, 0, 0, 672, 0, 0, 0, 0,
-	0, 0, 0, 0, 669, 0, 0, 0, 0, 662,
-	668, 0, 674, 1141, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 672, 0, 0, 0, 0, 0, 668,
-	0, 0, 669, 0, 0, 0, 0, 662, 0, 1124,
-	0, 663, 0, 0, 0, 0, 0, 0, 0, 0,
-	671, 0, 0, 0, 0, 0, 0, 668, 0, 0,
-	663, 0, 0, 0, 0, 0, 0, 0, 0, 671,
-	0, 0, 1136, 1137, 1138, 0, 1135, 1132, 1133, 1134,
-	1127, 1128, 1129, 1130, 1131, 0, 0, 0, 663, 0,
-	0, 0, 0, 0, 0, 0, 0, 671, 670, 0,
-	658, 659, 660, 0, 657, 654, 655, 656, 649, 650,
-	651, 652, 653, 0, 0, 0, 0, 670, 0, 658,
-	659, 660, 0, 657, 654, 655, 656, 649, 650, 651,
-	652, 653, 1126, 0, 1142, 1143, 1144, 0, 0, 0,
-	0, 0, 0, 0, 1242, 670, 0, 658, 659, 660,
-	0, 657, 654, 655, 656, 649, 650, 651, 652, 653,
-	646, 0, 664, 665, 666, 0, 0, 0, 0, 0,
-	0, 0, 667, 0, 1139, 0, 0, 0, 648, 646,
-	673, 664, 665, 666, 0, 0, 0, 0, 0, 0,
-	0, 667, 0, 0, 0, 0, 647, 648, 0, 673,
-	0, 0, 661, 0, 0, 0, 646, 0, 664, 665,
-	666, 0, 0, 0, 0, 647, 0, 0, 0, 0,
-	0, 661, 0, 0, 648, 0, 673, 0, 0, 0,
-	0, 0, 1126, 0, 1142, 1143, 1144, 0, 0, 0,
-	0, 1145, 647, 0, 1241, 0, 0, 0, 661, 0,
-	0, 0, 0, 0, 0, 1140, 0, 0, 674, 1126,
-	0, 1142, 1143, 1144, 0, 0, 0, 0, 0, 672,
-	0, 0, 0, 0, 1139, 0, 0, 674, 669, 0,
-	0, 0, 0, 662, 0, 0, 0, 0, 672, 0,
-	0, 0, 0, 0, 0, 0, 0, 669, 0, 0,
-	0, 1139, 662, 668, 674, 1126, 1141, 1142, 1143, 1144,
-	0, 0, 0, 0, 0, 672, 0, 0, 0, 0,
-	0, 0, 0, 0, 669, 0, 0, 0, 0, 662,
-	0, 0, 0, 0, 663, 0, 0, 0, 0, 0,
-	0, 1145, 0, 671, 0, 0, 0, 1139, 0, 0,
-	0, 0, 0, 663, 0, 1140, 0, 0, 0, 0,
-	0, 0, 671, 0, 0, 1136, 1137, 1138, 1145, 1135,
-	1132, 1133, 1134, 1127, 1128, 1129, 1130, 1131, 0, 0,
-	663, 0, 1140, 0, 0, 0, 0, 0, 0, 671,
-	0, 670, 0, 658, 659, 660, 0, 657, 654, 655,
-	656, 649, 650, 651, 652, 653, 1141, 0, 0, 0,
-	670, 0, 658, 659, 660, 0, 657, 654, 655, 656,
-	649, 650, 651, 652, 653, 0, 0, 0, 1140, 0,
-	0, 0, 0, 1141, 0, 0, 0, 670, 0, 658,
-	659, 660, 0, 657, 654, 655, 656, 649, 650, 651,
-	652, 653, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 1136, 1137, 1138, 0, 1135,
-	1132, 1133, 1134, 1127, 1128, 1129, 1130, 1131, 0, 1141,
+	0, 0, 0, 0, 0, 0, 737, 0, 722, 723,
+	724, 0, 721, 718, 719, 720, 713, 714, 715, 716,
+	717, 0, 730, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 738, 0, 0, 0, 0, 730, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 738, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 1136, 1137, 1138, 0, 1135, 1132, 1133, 1134,
-	1127, 1128, 1129, 1130, 1131, 0, 0, 0, 0, 0,
-	0, 0, 846, 861, 838, 854, 853, 0, 0, 839,
-	0, 0, 0, 863, 862, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 1136, 1137,
-	1138, 0, 1135, 1132, 1133, 1134, 1127, 1128, 1129, 1130,
-	1131, 859, 0, 851, 850, 0, 0, 0, 0, 0,
-	0, 849, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 848, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 927,
+	942, 916, 935, 934, 0, 0, 917, 0, 0, 0,
+	944, 943, 737, 0, 722, 723, 724, 0, 721, 718,
+	719, 720, 713, 714, 715, 716, 717, 737, 0, 0,
+	0, 0, 0, 721, 718, 719, 720, 713, 714, 715,
+	716, 717, 940, 0, 932, 931, 0, 0, 0, 0,
+	0, 0, 930, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 929, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 842, 843, 844, 0, 524, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	923, 924, 925, 0, 0, 666, 0, 0, 0, 0,
+	918, 919, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 852, 0,
+	0, 0, 0, 0, 0, 0, 0, 933, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 847, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 928, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 845, 0, 0, 0,
-	0, 841, 0, 0, 0, 0, 0, 840, 0, 0,
-	860, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 926,
+	0, 0, 0, 0, 0, 922, 0, 0, 0, 0,
+	0, 921, 0, 0, 941, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 864,
+	0, 920, 0, 0, 0, 0, 945,
 }
 var sqlPact = [...]int{
 
-	1951, -1000, -12, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
-	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, 709,
-	-1000, -1000, -1000, 535, 635, 90, 864, 864, -1000, -1000,
-	15081, 1760, 306, 306, 306, 396, 480, 99, -1000, 755,
-	27, 14864, 12043, 1060, -14, 11392, 212, 1951, 11826, 12043,
-	14647, 931, 862, 11392, 14430, 14213, 13996, -1000, 7925, -1000,
-	-1000, -1000, -1000, 682, -1000, -15, -1000, -1000, -1000, -1000,
-	-1000, -1000, -1000, -1000, 676, -1000, 13779, 13779, 853, -1000,
-	-1000, 472, 258, 1086, -1000, -7, -1000, -1000, -1000, -1000,
+	2204, -1000, -34, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
+	-1000, -1000, -1000, 668, 12669, -1000, -1000, -1000, 526, 633,
+	27, 1028, 470, 12669, 1028, -1000, -1000, 17097, 1682, 363,
+	363, 363, 13161, 16851, 469, 543, 50, -1000, 885, -9,
+	16605, 13161, 1131, -38, 12423, 241, 2204, 12915, 13161, 16359,
+	441, -40, 13161, 13161, -1000, -159, -1000, -1000, -1000, -1000,
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
@@ -2747,338 +3085,368 @@ var sqlPact = [...]int{
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
-	-1000, -1000, -1000, -1000, -1000, -1000, 930, -1000, 675, 928,
-	913, 252, 859, -1000, 853, -1000, -1000, -1000, 11392, -1000,
-	13562, 875, 13345, -1000, 755, -1000, -1000, -1000, 805, 1059,
-	1059, 1059, 1075, 79, 77, 99, -16, 12043, -1000, 216,
-	-1000, -1000, -1000, -1000, -1000, -16, 6001, 6001, -1000, -1000,
-	212, -1000, 232, 10267, -139, -1000, 5523, -1000, 602, 987,
-	511, 506, 983, 11392, 12043, 434, 13128, -1000, 980, 78,
-	979, -1000, -32, 977, -1000, -23, -1000, -1000, -1000, -1000,
-	-1000, -1000, 212, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
-	-1000, -1000, -1000, -1000, -1000, -1000, 11609, 910, 11609, -1000,
-	-1000, -1000, 806, 8401, 8164, 1021, 1599, -1000, -1000, -1000,
-	-10, 3356, 12043, 942, 11609, 12043, -1000, 12043, -1000, 800,
-	-1000, -1000, 85, -1000, 210, 765, 12911, -1000, 761, -1000,
-	805, -1000, 631, 788, 6258, 6975, 99, -1000, -1000, 99,
-	99, 6975, -1000, -1000, 12043, -16, 1095, 12043, 911, -18,
-	-1000, 16838, -1000, -1000, 6975, 6975, 6975, 6975, 6975, 578,
-	-1000, -1000, -1000, 3832, -1000, -1000, -139, 202, 222, -1000,
-	-1000, 200, -139, -1000, -1000, -1000, -1000, 197, 1192, 330,
-	-1000, -1000, -1000, 6975, 263, -1000, -1000, -1000, -1000, -1000,
-	-1000, -1000, -1000, -1000, 939, 196, 194, -1000, -1000, -1000,
-	-1000, 191, 187, 182, 180, 178, 166, 160, 159, 154,
-	152, 150, 146, 145, 569, -1000, 274, -1000, -1000, 274,
-	274, -1000, 131, 131, 132, -1000, -1000, -1000, 131, -1000,
-	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, 144, 52,
-	-1000, -1000, -1000, 12043, -139, -1000, 3118, 3356, 6975, -24,
-	-1000, 17670, -1000, -71, 562, -1000, 10948, 1036, 1034, 1047,
-	11392, 382, 377, 12043, 268, 55, 1090, 9793, -1000, 12043,
-	12043, -1000, 12043, -1000, -1000, 12043, 12043, 12043, 27, 10504,
-	375, -41, 12043, 12043, -1000, 909, 650, -19, -1000, -1000,
-	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, 1172, -1000,
-	-1000, -1000, -1000, 1183, -19, -1000, -1000, -1000, -1000, -1000,
-	1190, -1000, -1000, -1000, -1000, 3356, -1000, -1000, -1000, -1000,
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
+	-1000, -1000, -1000, 989, 890, 12423, 16113, 15867, 15621, -1000,
+	9143, -1000, -1000, -1000, -1000, 725, -1000, -39, -1000, -1000,
+	-1000, -1000, -1000, -1000, -1000, -1000, 13161, 985, 712, -1000,
+	15375, 15375, 878, -1000, -1000, 480, 306, 1150, -1000, -28,
+	-1000, -1000, -1000, 982, 373, -1000, 709, 975, 1122, 974,
+	305, 886, -1000, 878, -1000, -1000, 432, -1000, 13161, -1000,
+	12423, -1000, 15129, 909, 14883, -1000, 885, -1000, -1000, -1000,
+	772, 1129, 1129, 1129, 1170, 76, 72, 50, -43, 13161,
+	-1000, 245, -43, 6811, 6811, -1000, -1000, 241, -1000, 275,
+	11183, -1000, 6295, -1000, 572, 1051, 339, 776, 607, 1049,
+	1289, 13161, -40, -41, -1000, -159, -1000, 3442, 3699, 7861,
+	12423, 13161, 504, 14637, -1000, 1042, 64, 1040, -49, 1039,
+	-1000, -54, -1000, -1000, -1000, -1000, -1000, -1000, 241, -1000,
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
+	-1000, -1000, -1000, 12669, 1118, 1101, 1278, 12669, -1000, -1000,
+	-1000, 850, 9657, 9401, 1094, 935, -1000, -1000, -1000, -30,
+	3699, 13161, 13161, 1005, 12669, 13161, 1002, -1000, 13161, -1000,
+	847, -1000, -1000, 14391, -1000, 79, -1000, 239, 802, 14145,
+	-1000, 796, -1000, 782, 1001, -1000, 728, 827, 343, 7087,
+	7861, 50, -1000, -1000, 50, 50, 7861, -1000, -1000, 13161,
+	-43, 1210, 13161, 972, -45, -1000, 19689, -1000, -1000, 7861,
+	7861, 7861, 7861, 7861, 672, -1000, -1000, -1000, 4471, -1000,
+	-1000, -159, 229, 113, -1000, -1000, 228, -159, -1000, -1000,
+	-1000, -1000, 227, 1287, 345, -1000, -1000, -1000, 7861, 310,
+	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, 993,
+	213, 210, -1000, -1000, -1000, -1000, 204, 203, 202, 197,
+	196, 190, 187, 186, 185, 181, 179, 177, 176, 660,
+	-1000, 322, -1000, -1000, 322, 322, -1000, 157, 157, 158,
+	160, -1000, -1000, 157, -1000, -1000, -1000, -1000, -1000, -1000,
+	-1000, -1000, -1000, 174, 115, -1000, -1000, -1000, 13161, -55,
+	-1000, 20307, -1000, -53, 614, -1000, 11931, 1162, 1160, 1114,
+	12423, 304, 299, 421, 419, 13161, 919, -1000, 13161, -1000,
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
-	-1000, -1000, 12043, -1000, -1000, -1000, -1000, -1000, 11392, 10721,
-	976, 673, 756, -1000, 971, -1000, -1000, -1000, -1000, 17670,
-	-1000, 17670, 448, 867, -1000, 867, -20, -1000, 16810, -1000,
-	143, -25, -1000, 268, 9556, 6001, 18001, 12043, 385, 6975,
-	6975, 6975, 6975, 6975, 6975, 6975, 6975, 6975, 6975, 6975,
-	6975, 6975, 6975, 6975, 6975, 6975, 6975, 6975, 6975, 6975,
-	657, 370, 896, 618, 130, 3356, -1000, 1136, 1136, 1136,
-	17716, 17716, 153, -140, 16492, -21, -139, -1000, -1000, 5027,
-	4788, -139, 2706, -1000, 634, 1181, 271, 17670, 948, 895,
-	142, 72, 70, 6975, 725, 6975, 7214, 6975, 6975, 4071,
-	6975, 6975, 6975, 6975, 6975, 6975, -1000, 138, -1000, -1000,
-	-1000, -1000, 1180, -1000, -1000, 1176, -1000, 1175, 268, 64,
-	-1000, -1000, -1000, -1000, 1826, 5523, -1000, 605, 12043, 12043,
-	12043, -1000, -1000, 742, 12694, -1000, 18001, 12043, -1000, 136,
-	135, 829, 821, 12043, 12043, 12477, 12260, 12043, 528, 12043,
-	12043, 474, -1000, 6975, 659, -1000, 9102, 279, 12043, 50,
-	-1000, -1000, -1000, 243, 12043, -1000, -1000, -1000, 78, -1000,
-	-32, -1000, -1000, 12043, -41, -52, 12043, -1000, 470, 546,
-	-1000, -1000, 8638, -1000, -1000, -1000, 634, -1000, -84, -1000,
-	-1000, 59, -53, -1000, -1000, -1000, -1000, 12043, 209, 12043,
-	12043, 968, 12043, -1000, -1000, -1000, 6975, -1000, -1000, -1000,
-	27, 12043, -1000, 888, -70, 921, 11175, 11175, -1000, 8865,
-	-1000, -1000, 1105, -1000, -1000, -1000, -1000, 61, -1000, -1000,
-	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, 132, 569,
-	131, 131, 131, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
-	-1000, 274, 274, 274, -1000, -1000, 249, 383, 383, 1096,
-	1096, 1096, 495, 495, 422, 763, 1985, 1985, 1985, 445,
-	706, 706, 1985, 1985, 1985, 17716, 17689, 2341, 6975, 354,
-	580, 130, 6975, -1000, 975, -1000, -1000, -1000, 908, 129,
-	7214, 7214, -1000, -1000, -1000, 3832, -1000, -1000, 128, 6975,
-	-1000, 6975, -29, -30, -1000, 17670, -1000, -36, -1000, -1000,
-	-38, 6975, 6975, 6975, 51, -1000, 350, -1000, 347, 342,
-	336, -1000, 127, 49, 438, -1000, 6975, 596, 124, 121,
-	6975, -1000, -1000, 17424, 48, 907, -1000, -1000, -1000, -1000,
-	-1000, -1000, -1000, 47, 17396, 46, 2004, -1000, 7214, 7214,
-	7214, 3832, 120, 45, 16791, -116, 17377, 5762, 5762, 5762,
-	44, 17131, 6975, -116, 15545, 15519, 2318, -37, -42, -43,
-	1174, -44, 43, 41, 888, -1000, -1000, 6975, -1000, -1000,
-	-1000, 329, 318, 966, -1000, 734, -1000, 670, 6975, 12043,
-	119, 118, 527, -1000, 964, 694, 962, 694, -1000, -71,
-	551, -1000, -1000, 317, 17670, -1000, 1049, -48, -1000, -1000,
-	268, 9793, 5523, -54, -1000, -84, -84, -1000, -1000, -1000,
-	-1000, -1000, 12043, -1000, 10721, 117, 12043, 116, 115, 12043,
-	-1000, -1000, 40, -1000, -1000, -1000, -1000, -1000, 883, 1073,
-	9556, 851, 844, 9556, 856, 606, 606, 606, -1000, -1000,
-	-1000, 12043, 113, -1000, 9339, 38, 921, 225, 223, -1000,
-	1173, 6975, 2341, 6975, 7214, 7214, -1000, 2341, -1000, -1000,
-	-1000, -1000, 905, 111, 6975, 18001, 17742, 17642, -55, 4549,
-	-95, 16473, 6975, -1000, -1000, 222, -1000, 37, 5284, -1000,
-	17084, -11, -11, -1000, 778, 746, 492, 440, 1161, 1187,
-	978, -1000, 6975, 17103, -1000, 10030, 269, 620, 16227, 18001,
-	-1000, 6975, -1000, 904, 6975, -1000, 18001, 7214, 7214, 7214,
-	7214, 7214, 7214, 7214, 7214, 7214, 7214, 7214, 7214, 7214,
-	7214, 7214, 7214, 7214, 7214, 802, 7214, 1130, 1130, 1130,
-	-103, 4310, -1000, 938, 904, 6975, 6975, 18001, 35, 34,
-	31, -1000, 6975, -116, 6975, 6975, 6975, -1000, -1000, -1000,
-	29, -1000, 1160, -1000, -1000, 883, 16519, 12043, 12043, 12043,
-	955, 1566, -1000, 16201, -56, 12043, 12043, -1000, 768, 882,
-	299, 12043, -1000, 12043, -1000, 12043, 12043, 12043, 12043, 137,
-	27, -1000, -1000, -1000, 241, -1000, -1000, 12043, 103, 10721,
-	7688, 653, -1000, 266, 6975, 6975, 921, 9556, 9556, 1606,
-	831, 9556, -1000, -1000, -1000, -1000, 101, 12043, 11175, 356,
-	1139, 24, 1093, 2341, 17349, 1810, 6975, 18001, 2448, -60,
-	-1000, 6975, 6975, -1000, -61, -1000, 6975, -1000, 17670, -1000,
-	1186, 6975, 22, 21, 20, -1000, -1000, -1000, -1000, -1000,
-	-1000, -1000, 15, -1000, -1000, 17670, 6975, -1000, -1000, 15298,
-	6975, 13, -1000, 11, 17670, 938, 17670, -1000, 553, 553,
-	1130, 1130, 1130, 548, 548, 741, 283, 2414, 2414, 2414,
-	1151, 389, 389, 2414, 2414, 2414, 903, 757, 98, 17769,
-	6975, -62, -1000, -1000, -1000, 17670, 17670, 10, -1000, -1000,
-	-1000, -116, 2161, 16174, 16155, -1000, 9, 266, -1000, -1000,
-	-1000, -1000, 12043, -1000, 12043, -1000, 12043, 697, -1000, -1000,
-	817, 97, 7214, 12043, -1000, 577, -66, -72, 692, -1000,
-	642, 6975, -1000, 18001, 694, 694, -1000, 316, 315, -1000,
-	996, 7688, 1044, -1000, 95, -73, 12043, 5, -74, -1000,
-	65, 1058, 6975, -1000, -1000, 94, 12043, -1000, 12043, 17670,
-	-116, -1000, 1606, -1000, 93, 6975, 9556, -1000, 12043, -80,
-	-1000, -1000, 220, 218, -1000, 6975, 6975, 2448, -82, -1000,
-	18001, 2341, 2341, -1000, 15909, -1000, 17084, -1000, -1000, -1000,
-	-1000, 17670, 557, -1000, 15883, -1000, -1000, -1000, 7214, 901,
-	91, 18001, 15856, -1000, -1000, 6975, -1000, -1000, -1000, -1000,
-	-1000, 1503, -1000, -1000, -1000, 6975, 17769, 62, -1000, 87,
-	-1000, -1000, -1000, 515, -1000, -1000, 17670, 1061, -1000, -1000,
-	12043, 12043, 374, -86, 12043, -1000, -1000, 3593, 577, -87,
-	-1000, 577, 7688, 1057, -139, 12043, 1057, 15837, 2706, 84,
-	-109, -1000, 1089, -1000, 12043, 17670, -1000, -91, -1000, -1000,
-	-1000, 2341, 2341, -1000, -1000, -1000, 4, 620, 1072, -1000,
-	17815, 7214, 18001, -93, -1000, 15591, -1000, 15572, 781, 12043,
-	12043, 12043, 285, 12043, -1000, -1000, 413, -1000, 268, -1000,
-	-1000, 577, -1000, -1000, -1000, -1000, -1000, 1058, -38, 7688,
-	12043, 83, -108, -1000, -1000, 555, 6975, 17815, -120, -1000,
-	-1000, -1000, 624, 684, -126, -128, 62, -1000, 6975, -1000,
-	9793, -1000, -1000, 1057, 3, -133, -1000, -1000, -1000, 1,
-	6736, 6736, -116, -1000, -1000, 643, 641, 497, -1000, -1000,
-	-1000, -1000, -1000, 781, 17670, -104, -1000, -1000, 577, -1000,
-	-1000, -1000, 7451, 630, 453, 16545, -1000, -1000, 1003, -1000,
-	292, 652, 652, 624, -1000, -1000, 1110, -1000, -1000, -1000,
-	-1000, -1000, -1000, 1119, -1000, -1000, 798, -1000, -1000, 6497,
-	-1000, -1000, -1000, -1000,
+	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
+	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
+	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
+	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
+	-1000, -1000, -1000, -1000, -1000, -1000, 2363, 315, 93, 1195,
+	10671, -1000, 13161, 13161, -1000, -1000, -1000, 13161, 13161, 13161,
+	-9, 11439, 418, -1000, 335, -117, -1000, 971, 581, -46,
+	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
+	1269, -1000, -1000, -1000, -1000, 1275, -46, -1000, -1000, -1000,
+	-1000, -1000, 1286, -1000, -1000, -1000, -1000, 3699, -1000, -1000,
+	-1000, -1000, 13161, -1000, -1000, 599, -1000, -1000, 13161, -1000,
+	-1000, 12423, 11685, 1038, 705, 791, -1000, 1036, -1000, -1000,
+	-1000, -1000, -1000, -1000, 20307, -1000, 20307, 570, 893, -1000,
+	893, -47, -1000, 19578, -1000, 173, -59, 315, 8631, 6811,
+	20787, 13161, 410, 7861, 7861, 7861, 7861, 7861, 7861, 7861,
+	7861, 7861, 7861, 7861, 7861, 7861, 7861, 7861, 7861, 7861,
+	7861, 7861, 7861, 7861, 7861, 7861, 7861, 950, 414, 784,
+	756, 673, 152, 3699, -1000, 1238, 1238, 1238, 20496, 20496,
+	151, -157, 19149, -48, -159, -1000, -1000, 5761, 5503, -159,
+	3955, -1000, 749, 1273, 320, 20307, 1011, 938, 172, 65,
+	57, 7861, 758, 7861, 8119, 7861, 7861, 4729, 7861, 7861,
+	7861, 7861, 7861, 7861, -1000, 168, -1000, -1000, -1000, -1000,
+	1272, -1000, -1000, 1271, 1270, -1000, 1267, 315, 54, 6295,
+	-1000, 618, 13161, 13161, 13161, -1000, -1000, 781, 13899, -1000,
+	20787, 13161, -1000, 166, 165, 864, 859, 13161, 13161, 13653,
+	13407, 13161, 571, 896, 896, 13161, 13161, 586, -1000, 963,
+	-1000, -1000, 7861, -1000, 7861, 704, -1000, 10159, 326, 13161,
+	43, -1000, -1000, -1000, 286, 13161, -1000, -1000, 64, -1000,
+	-49, -1000, -1000, 13161, 1285, 1284, 13161, -1000, 548, 658,
+	-1000, -1000, 9913, -1000, -1000, -1000, 749, -1000, -56, -1000,
+	13161, 13161, -1000, -1000, 53, -50, -1000, -1000, -1000, -1000,
+	-1000, 13161, 223, 13161, 13161, 13161, 1033, 13161, -1000, -1000,
+	-1000, 7861, -1000, -1000, -1000, -9, -1000, 937, -51, 1107,
+	12177, 12177, 12177, -1000, 8375, -1000, -1000, 162, -1000, -1000,
+	1212, -1000, -1000, -1000, -1000, 38, -1000, -1000, -1000, -1000,
+	-1000, -1000, 161, 160, -1000, -1000, -1000, -1000, -1000, 158,
+	660, 157, 157, 157, -1000, -1000, -1000, -1000, -1000, -1000,
+	-1000, -1000, 322, 322, 322, -1000, -1000, 297, 481, 481,
+	1179, 1179, 1179, 415, 415, 801, 359, 20587, 20587, 20587,
+	263, 263, 263, 263, 240, 240, 20587, 20587, 20587, 20496,
+	20388, 18947, 7861, 413, 656, 152, 7861, 149, -1000, -1000,
+	-1000, -1000, 822, -1000, -1000, -1000, 960, 148, 8119, 8119,
+	-1000, -1000, -1000, 4471, -1000, -1000, 147, 7861, -159, 7861,
+	-60, -61, -1000, 20307, -1000, -64, -1000, -1000, -36, 7861,
+	7861, 7861, 45, -1000, 412, -1000, 411, 386, 385, -1000,
+	140, 39, 493, -1000, 7861, 683, 137, 136, 7861, -1000,
+	-1000, 20229, 36, 959, -1000, -1000, -1000, -1000, -1000, -1000,
+	-1000, 33, 20118, 32, 18167, -1000, 8119, 8119, 8119, 4471,
+	134, 28, 19496, -147, 20037, 6553, 6553, 6553, 24, 19959,
+	7861, -147, 17664, 17609, 17583, -68, -69, -76, 1265, -77,
+	22, 21, 19, 937, -1000, -1000, -1000, -1000, 384, 382,
+	1031, -1000, 767, -1000, 774, 7861, 8887, 133, 132, 664,
+	-1000, 1030, 733, 1026, 733, -1000, -53, 560, -1000, -1000,
+	-1000, -1000, -1000, -1000, 378, 1278, 19307, 20307, -1000, 1119,
+	-78, -1000, -1000, 315, 10671, 6295, -82, -1000, -56, 1098,
+	-1000, -56, -1000, -1000, -1000, -1000, -1000, 13161, -1000, -1000,
+	905, 11685, 131, 13161, 129, 125, 124, 13161, -1000, -1000,
+	13, -1000, -1000, -1000, -1000, 930, 1156, 8631, 871, 870,
+	8631, 1110, 686, 686, 686, -1000, -1000, -1000, 13161, 118,
+	-1000, -1000, 10415, 12, 1107, 3955, 250, 242, -1000, 1260,
+	1250, 7861, 18947, 7861, 8119, 8119, -1000, 18947, 7861, -1000,
+	-1000, -1000, -1000, 954, 117, 7861, 20787, 2849, 2513, -83,
+	5245, -73, -159, 19126, 7861, -1000, -1000, 113, -1000, 10,
+	6037, -1000, 19767, -23, -23, -1000, 788, 657, 609, 553,
+	1249, 1281, 1056, -1000, 7861, 19848, -1000, 10927, 316, 690,
+	18968, 20787, -1000, 7861, -1000, 946, 7861, -1000, 20787, 8119,
+	8119, 8119, 8119, 8119, 8119, 8119, 8119, 8119, 8119, 8119,
+	8119, 8119, 8119, 8119, 8119, 8119, 8119, 8119, 8119, 8119,
+	866, 8119, 1237, 1237, 1237, -99, 4987, -1000, 992, 946,
+	7861, 7861, 20787, 9, 6, 5, -1000, 7861, -147, 7861,
+	7861, 7861, -1000, -1000, -1000, 3, -1000, 1244, -1000, -1000,
+	-1000, 930, 13161, 13161, 13161, 1024, 1782, -1000, 18857, -84,
+	-1000, 69, 1141, 7861, 8887, 13161, -1000, 863, 914, 352,
+	13161, -1000, 13161, -1000, 13161, 13161, 13161, 13161, -117, -1000,
+	120, -9, -1000, -1000, -1000, 284, 1085, -1000, -1000, 923,
+	-1000, 8887, 116, 13161, 11685, 8887, 703, -1000, 313, 7861,
+	7861, 1107, 8631, 8631, 902, 853, 8631, -1000, -1000, -1000,
+	-1000, 112, 13161, 12177, -36, 367, 1243, 1, -1, 1175,
+	18947, 2457, 2228, 18810, 7861, 20787, 20572, -89, -1000, 7861,
+	7861, -1000, -90, -1000, 7861, -1000, 20307, -1000, 1280, 7861,
+	-3, -4, -6, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
+	-11, -1000, -1000, 20307, 7861, -1000, -1000, 17343, 7861, -13,
+	-1000, -14, 20307, 992, 20307, -1000, 550, 550, 1237, 1237,
+	1237, 425, 425, 268, 613, 111, 111, 111, 408, 408,
+	408, 408, 348, 348, 111, 111, 111, 944, 845, 95,
+	19654, 7861, -91, -1000, -1000, -1000, 20307, 20307, -15, -1000,
+	-1000, -1000, -147, 2796, 18699, 18541, -1000, -16, 313, -1000,
+	-1000, -1000, 13161, -1000, 13161, -1000, 13161, 762, -1000, -1000,
+	856, 92, 8119, 91, 13161, -1000, 642, 8887, 1128, -159,
+	13161, 1128, 18518, -95, -100, 761, -1000, 742, 7861, -1000,
+	20787, 733, 733, -1000, 369, 366, -1000, 1062, 8887, 1113,
+	-1000, 89, 88, 679, -101, 8887, -102, -17, -107, 13161,
+	-1000, 13161, 20307, -147, -1000, 902, -1000, 87, 7861, 8631,
+	-1000, 13161, -109, -1000, -18, -1000, 248, 247, -1000, -1000,
+	7861, 7861, -1000, 20572, -113, -1000, 20787, 18947, 18947, -1000,
+	18360, -1000, 19767, -1000, -1000, -1000, -1000, 20307, 670, -1000,
+	18249, -1000, -1000, -1000, 8119, 940, 86, 20787, 18202, -1000,
+	-1000, 7861, -1000, -1000, -1000, -1000, -1000, 1594, -1000, -1000,
+	-1000, 7861, 19654, 7861, 78, 315, 85, -1000, -1000, -1000,
+	-1000, -1000, -1000, 1141, -1000, 623, -1000, -1000, 20307, 1133,
+	-1000, -1000, 13161, 13161, 426, -115, 13161, -1000, -1000, 4213,
+	1278, 13161, 642, -120, -1000, 905, 642, 84, -134, -1000,
+	1193, -1000, 13161, 20307, -1000, -121, -1000, -1000, -1000, -1000,
+	18947, 18947, -1000, -1000, -1000, -19, 690, 1153, -1000, 2871,
+	8119, 20787, -122, -1000, 18091, -1000, 17930, 17892, 835, 13161,
+	-1000, 13161, 1128, 13161, 334, 13161, -1000, -1000, 495, -1000,
+	315, -1000, -126, 82, 315, 642, -1000, 315, 8887, 13161,
+	80, -130, -1000, -1000, 646, 7861, 2871, -137, -1000, -1000,
+	-1000, 438, 694, 745, -142, -143, -1000, 78, -1000, 7861,
+	-1000, 10671, -1000, -1000, 13161, -1000, 315, -1000, -150, -1000,
+	-1000, -1000, -22, 7603, 7603, -147, -1000, -1000, -1000, 702,
+	699, 505, -1000, -1000, -1000, -1000, -1000, 835, 20307, -136,
+	-151, -1000, 642, -1000, -1000, -1000, 3143, 750, 565, 19418,
+	-1000, -1000, 1067, -1000, 342, 830, 830, 694, -1000, -1000,
+	315, 1222, -1000, -1000, -1000, -1000, -1000, -1000, 1229, -1000,
+	-1000, 854, -1000, -1000, -1000, 7345, -1000, -1000, -1000, -1000,
 }
 var sqlPgo = [...]int{
 
-	0, 1450, 1449, 1105, 1447, 1445, 1441, 1436, 1434, 83,
-	1432, 1430, 85, 1429, 79, 1428, 1425, 1424, 64, 1423,
-	1421, 1418, 1417, 78, 33, 1754, 114, 102, 1415, 1413,
-	1412, 10, 76, 72, 1409, 37, 1408, 542, 1163, 49,
-	20, 15, 4, 1407, 1397, 1393, 29, 1391, 1390, 1389,
-	11, 40, 25, 1388, 14, 89, 1384, 1379, 74, 1373,
-	127, 42, 96, 97, 1367, 1341, 484, 1340, 12, 46,
-	1339, 24, 1333, 19, 51, 103, 1332, 499, 38, 22,
-	36, 1331, 1329, 1328, 61, 70, 43, 1325, 30, 32,
-	1324, 45, 1322, 95, 98, 1321, 1315, 1314, 1312, 1311,
-	1310, 1094, 1309, 7, 52, 41, 28, 39, 0, 215,
-	551, 1299, 56, 27, 35, 31, 1298, 77, 1293, 1292,
-	1289, 1285, 1283, 54, 1282, 44, 107, 26, 63, 69,
-	23, 101, 57, 88, 112, 84, 1281, 91, 1279, 75,
-	1278, 1277, 633, 73, 1274, 1273, 1272, 617, 614, 474,
-	176, 1270, 1268, 334, 324, 1266, 1263, 65, 1259, 1257,
-	106, 1256, 120, 92, 1254, 87, 1253, 66, 1251, 108,
-	113, 86, 1250, 94, 48, 1248, 1246, 1245, 17, 1,
-	6, 5, 8, 3, 18, 16, 1244, 1238, 82, 67,
-	1236, 511, 1235, 1230, 47, 1228, 1225, 21, 1216, 13,
-	1211, 9, 2, 1210, 100, 1208, 71, 1207, 1136, 1204,
-	110, 1201, 1199, 1144, 62,
+	0, 1513, 1510, 1173, 1505, 1503, 1496, 1492, 1487, 1486,
+	1485, 80, 1484, 1477, 108, 1475, 1472, 78, 1465, 1464,
+	1463, 1461, 31, 1459, 1458, 1457, 1455, 76, 42, 1951,
+	98, 88, 1452, 1447, 1444, 14, 77, 70, 1438, 46,
+	1437, 562, 1390, 50, 1436, 156, 26, 1466, 1435, 1432,
+	1431, 28, 1430, 17, 1427, 1426, 12, 30, 51, 1425,
+	15, 25, 1421, 19, 1420, 75, 1419, 74, 1417, 112,
+	36, 113, 32, 1413, 1411, 97, 1410, 7, 52, 1409,
+	34, 1407, 24, 54, 94, 1405, 105, 48, 21, 41,
+	1404, 1403, 1402, 55, 64, 56, 1401, 37, 29, 1400,
+	57, 1398, 87, 90, 1397, 1394, 1393, 1392, 1391, 1388,
+	596, 1387, 8, 27, 45, 5, 44, 0, 874, 795,
+	1384, 59, 35, 40, 18, 1381, 81, 1380, 1378, 1369,
+	1367, 1366, 58, 1362, 1358, 47, 91, 33, 65, 68,
+	22, 39, 66, 84, 96, 82, 1357, 110, 1356, 38,
+	1352, 1351, 830, 63, 1350, 1348, 1347, 705, 668, 442,
+	177, 1346, 1345, 321, 259, 1340, 1339, 60, 1336, 1335,
+	95, 1334, 101, 83, 1333, 106, 1332, 67, 1331, 280,
+	152, 115, 1329, 86, 53, 1328, 1327, 1325, 1324, 20,
+	1, 9, 4, 6, 3, 134, 49, 1323, 16, 85,
+	71, 1320, 103, 1318, 1316, 23, 1315, 1314, 13, 1313,
+	11, 1309, 10, 2, 1308, 92, 1306, 69, 1303, 1241,
+	1299, 93, 1298, 1296, 1192, 89, 61,
 }
 var sqlR1 = [...]int{
 
 	0, 1, 2, 2, 3, 3, 3, 3, 3, 3,
 	3, 3, 3, 3, 3, 3, 3, 3, 3, 3,
-	4, 4, 33, 33, 32, 32, 32, 32, 32, 32,
-	32, 32, 32, 32, 32, 32, 32, 32, 32, 29,
-	29, 35, 35, 35, 34, 34, 30, 30, 5, 5,
-	5, 9, 10, 10, 10, 10, 10, 10, 62, 62,
-	61, 61, 65, 65, 11, 11, 12, 12, 12, 12,
-	138, 138, 137, 13, 17, 204, 204, 204, 208, 208,
-	209, 209, 210, 210, 210, 210, 210, 210, 210, 206,
-	206, 19, 19, 19, 101, 101, 100, 100, 100, 100,
-	102, 102, 102, 102, 162, 160, 160, 167, 167, 167,
-	44, 44, 44, 44, 44, 159, 159, 159, 159, 168,
-	168, 168, 168, 168, 168, 45, 45, 45, 166, 166,
-	20, 20, 20, 20, 20, 20, 20, 20, 20, 20,
-	161, 161, 205, 205, 207, 207, 8, 8, 46, 46,
-	47, 47, 105, 105, 105, 104, 176, 176, 177, 177,
-	177, 178, 178, 178, 178, 178, 178, 178, 175, 175,
-	173, 173, 174, 174, 174, 174, 211, 211, 103, 103,
-	50, 50, 181, 181, 181, 181, 179, 179, 179, 179,
-	179, 182, 180, 183, 183, 183, 183, 183, 126, 126,
-	126, 22, 7, 7, 90, 90, 54, 54, 130, 130,
-	130, 41, 41, 31, 31, 31, 16, 16, 16, 16,
-	16, 16, 16, 16, 16, 91, 91, 92, 92, 21,
-	21, 21, 213, 213, 36, 36, 37, 6, 6, 14,
-	43, 43, 97, 97, 97, 99, 99, 99, 98, 98,
-	98, 23, 68, 68, 69, 69, 136, 70, 70, 18,
-	18, 25, 25, 24, 24, 24, 24, 24, 24, 26,
-	26, 27, 27, 27, 27, 27, 27, 27, 189, 189,
-	189, 191, 191, 188, 15, 15, 15, 15, 190, 190,
-	212, 212, 77, 77, 77, 49, 48, 48, 52, 52,
-	51, 53, 53, 129, 75, 75, 75, 75, 93, 94,
-	94, 95, 95, 96, 96, 74, 74, 113, 113, 28,
-	28, 58, 58, 59, 59, 131, 131, 131, 131, 132,
-	132, 132, 132, 132, 132, 127, 127, 127, 127, 128,
-	128, 80, 80, 80, 80, 78, 78, 79, 79, 133,
-	133, 133, 133, 76, 76, 134, 134, 134, 106, 106,
-	139, 139, 139, 57, 57, 57, 140, 140, 140, 140,
-	140, 140, 140, 140, 140, 140, 141, 141, 141, 141,
-	143, 143, 143, 142, 142, 142, 142, 142, 142, 142,
-	142, 142, 142, 142, 142, 142, 144, 144, 151, 151,
-	152, 152, 153, 154, 145, 145, 146, 146, 147, 148,
-	155, 155, 155, 157, 157, 149, 149, 150, 85, 85,
-	85, 85, 85, 85, 85, 85, 85, 85, 85, 85,
-	85, 85, 86, 86, 108, 108, 108, 108, 108, 108,
-	108, 108, 108, 108, 108, 108, 108, 108, 108, 108,
-	108, 108, 108, 108, 108, 108, 108, 108, 108, 108,
-	108, 108, 108, 108, 108, 108, 108, 108, 108, 108,
-	108, 108, 108, 108, 108, 108, 108, 108, 108, 108,
-	108, 108, 108, 108, 108, 108, 109, 109, 109, 109,
-	109, 109, 109, 109, 109, 109, 109, 109, 109, 109,
-	109, 109, 109, 109, 109, 109, 109, 109, 109, 109,
-	109, 109, 109, 110, 110, 110, 110, 110, 110, 110,
-	110, 110, 110, 110, 110, 184, 184, 184, 184, 184,
-	184, 184, 186, 186, 187, 187, 185, 185, 185, 185,
-	185, 185, 185, 185, 185, 185, 185, 185, 185, 185,
-	185, 185, 185, 185, 185, 185, 185, 185, 185, 185,
-	185, 192, 192, 193, 193, 194, 194, 195, 195, 197,
-	198, 198, 198, 199, 203, 203, 196, 196, 200, 200,
-	200, 201, 201, 202, 202, 202, 202, 202, 117, 117,
-	117, 118, 118, 119, 63, 63, 115, 115, 114, 114,
-	114, 116, 116, 64, 156, 156, 156, 156, 156, 156,
-	156, 81, 81, 87, 82, 82, 83, 83, 83, 83,
-	83, 83, 88, 89, 84, 84, 84, 112, 112, 120,
-	124, 124, 123, 122, 122, 121, 121, 107, 107, 107,
-	107, 107, 71, 71, 214, 214, 125, 125, 72, 72,
-	73, 67, 67, 66, 66, 135, 135, 135, 135, 60,
-	60, 42, 42, 55, 55, 56, 56, 40, 40, 111,
-	111, 111, 111, 111, 111, 111, 111, 111, 111, 111,
-	158, 158, 158, 38, 38, 38, 39, 39, 164, 164,
-	164, 165, 165, 165, 165, 163, 163, 163, 163, 163,
-	169, 169, 169, 169, 169, 169, 169, 169, 169, 169,
-	169, 169, 169, 169, 169, 169, 169, 169, 169, 169,
-	169, 169, 169, 169, 169, 169, 169, 169, 169, 169,
-	169, 169, 169, 169, 169, 169, 169, 169, 169, 169,
-	169, 169, 169, 169, 169, 169, 169, 169, 169, 169,
-	169, 169, 169, 169, 169, 169, 169, 169, 169, 169,
-	169, 169, 169, 169, 169, 169, 169, 169, 169, 169,
-	169, 169, 169, 169, 169, 169, 169, 169, 169, 169,
-	169, 169, 169, 169, 169, 169, 169, 169, 169, 171,
-	171, 171, 171, 171, 171, 171, 171, 171, 171, 171,
-	171, 171, 171, 171, 171, 171, 171, 171, 171, 171,
-	171, 171, 171, 171, 171, 171, 171, 171, 171, 171,
-	171, 171, 171, 171, 171, 171, 171, 171, 171, 171,
-	170, 170, 170, 170, 170, 170, 170, 170, 170, 170,
-	170, 170, 170, 172, 172, 172, 172, 172, 172, 172,
-	172, 172, 172, 172, 172, 172, 172, 172, 172, 172,
-	172, 172, 172, 172, 172, 172, 172, 172, 172, 172,
-	172, 172, 172, 172, 172, 172, 172, 172, 172, 172,
-	172, 172, 172, 172, 172, 172, 172, 172, 172, 172,
-	172, 172, 172, 172, 172, 172, 172, 172, 172, 172,
-	172, 172, 172, 172, 172, 172, 172, 172, 172, 172,
-	172, 172, 172, 172, 172, 172, 172, 172, 172, 172,
-	172,
+	3, 3, 3, 3, 4, 4, 6, 6, 65, 65,
+	37, 37, 36, 36, 36, 36, 36, 36, 36, 36,
+	36, 36, 36, 36, 36, 36, 36, 33, 33, 39,
+	39, 39, 38, 38, 34, 34, 7, 7, 7, 11,
+	12, 12, 12, 12, 12, 12, 71, 71, 70, 70,
+	74, 74, 13, 13, 14, 14, 14, 14, 148, 148,
+	147, 15, 21, 215, 215, 215, 219, 219, 220, 220,
+	221, 221, 221, 221, 221, 221, 221, 217, 217, 23,
+	23, 23, 110, 110, 109, 109, 109, 109, 111, 111,
+	111, 111, 172, 170, 170, 177, 177, 177, 49, 49,
+	49, 49, 49, 169, 169, 169, 169, 178, 178, 178,
+	178, 178, 178, 50, 50, 50, 176, 176, 24, 24,
+	24, 24, 24, 24, 24, 24, 24, 24, 24, 24,
+	24, 171, 171, 216, 216, 218, 218, 10, 10, 53,
+	53, 51, 51, 52, 52, 114, 114, 114, 114, 113,
+	187, 187, 188, 188, 188, 189, 189, 189, 189, 189,
+	189, 189, 189, 185, 185, 186, 183, 183, 184, 184,
+	184, 184, 222, 222, 112, 112, 56, 56, 192, 192,
+	192, 192, 190, 190, 190, 190, 190, 193, 191, 194,
+	194, 194, 194, 194, 136, 136, 136, 5, 64, 64,
+	20, 16, 26, 9, 9, 99, 99, 60, 60, 140,
+	140, 140, 46, 46, 35, 35, 35, 19, 19, 19,
+	19, 19, 19, 19, 19, 19, 100, 100, 101, 101,
+	25, 25, 25, 25, 25, 25, 25, 25, 224, 224,
+	40, 40, 41, 8, 8, 17, 48, 48, 106, 106,
+	106, 108, 108, 108, 107, 107, 107, 27, 77, 77,
+	78, 78, 146, 79, 79, 22, 22, 29, 29, 28,
+	28, 28, 28, 28, 28, 28, 28, 30, 30, 225,
+	31, 31, 31, 31, 31, 31, 31, 200, 200, 200,
+	202, 202, 199, 18, 18, 18, 18, 201, 201, 223,
+	223, 86, 86, 86, 55, 54, 54, 58, 58, 57,
+	59, 59, 139, 84, 84, 84, 84, 102, 103, 103,
+	104, 104, 105, 105, 83, 83, 122, 122, 32, 32,
+	67, 67, 68, 68, 141, 141, 141, 141, 141, 142,
+	142, 142, 142, 142, 142, 137, 137, 137, 137, 138,
+	138, 89, 89, 89, 89, 87, 87, 88, 88, 143,
+	143, 143, 143, 85, 85, 144, 144, 144, 115, 115,
+	149, 149, 149, 66, 66, 66, 150, 150, 150, 150,
+	150, 150, 150, 150, 150, 150, 150, 150, 150, 150,
+	151, 151, 151, 151, 153, 153, 153, 152, 152, 152,
+	152, 152, 152, 152, 152, 152, 152, 152, 152, 152,
+	152, 154, 154, 161, 161, 162, 162, 163, 164, 155,
+	155, 156, 156, 157, 158, 165, 165, 165, 167, 167,
+	159, 159, 160, 94, 94, 94, 94, 94, 94, 94,
+	94, 94, 94, 94, 94, 94, 94, 95, 95, 117,
+	117, 117, 117, 117, 117, 117, 117, 117, 117, 117,
+	117, 117, 117, 117, 117, 117, 117, 117, 117, 117,
+	117, 117, 117, 117, 117, 117, 117, 117, 117, 117,
+	117, 117, 117, 117, 117, 117, 117, 117, 117, 117,
+	117, 117, 117, 117, 117, 117, 117, 117, 117, 117,
+	117, 117, 117, 117, 117, 118, 118, 118, 118, 118,
+	118, 118, 118, 118, 118, 118, 118, 118, 118, 118,
+	118, 118, 118, 118, 118, 118, 118, 118, 118, 118,
+	118, 118, 118, 118, 118, 119, 119, 119, 119, 119,
+	119, 119, 119, 119, 119, 119, 119, 119, 195, 195,
+	195, 195, 195, 195, 195, 197, 197, 198, 198, 196,
+	196, 196, 196, 196, 196, 196, 196, 196, 196, 196,
+	196, 196, 196, 196, 196, 196, 196, 196, 196, 196,
+	196, 196, 196, 196, 203, 203, 204, 204, 205, 205,
+	206, 206, 208, 209, 209, 209, 210, 214, 214, 207,
+	207, 211, 211, 211, 212, 212, 213, 213, 213, 213,
+	213, 126, 126, 126, 127, 127, 128, 134, 134, 134,
+	44, 44, 44, 44, 44, 44, 44, 44, 72, 72,
+	124, 124, 123, 123, 123, 125, 125, 73, 166, 166,
+	166, 166, 166, 166, 166, 90, 90, 96, 91, 91,
+	92, 92, 92, 92, 92, 92, 97, 98, 93, 93,
+	93, 121, 121, 129, 133, 133, 132, 131, 131, 130,
+	130, 116, 116, 116, 116, 116, 80, 80, 226, 226,
+	135, 135, 81, 81, 82, 76, 76, 75, 75, 145,
+	145, 145, 145, 69, 69, 47, 47, 61, 61, 63,
+	63, 62, 62, 45, 45, 120, 120, 120, 120, 120,
+	120, 120, 120, 120, 120, 120, 168, 168, 168, 42,
+	42, 42, 43, 43, 174, 174, 174, 175, 175, 175,
+	175, 173, 173, 173, 173, 173, 179, 179, 179, 179,
+	179, 179, 179, 179, 179, 179, 179, 179, 179, 179,
+	179, 179, 179, 179, 179, 179, 179, 179, 179, 179,
+	179, 179, 179, 179, 179, 179, 179, 179, 179, 179,
+	179, 179, 179, 179, 179, 179, 179, 179, 179, 179,
+	179, 179, 179, 179, 179, 179, 179, 179, 179, 179,
+	179, 179, 179, 179, 179, 179, 179, 179, 179, 179,
+	179, 179, 179, 179, 179, 179, 179, 179, 179, 179,
+	179, 179, 179, 179, 179, 179, 179, 179, 179, 179,
+	179, 179, 179, 179, 179, 179, 179, 179, 179, 179,
+	179, 179, 179, 179, 179, 179, 179, 179, 179, 179,
+	181, 181, 181, 181, 181, 181, 181, 181, 181, 181,
+	181, 181, 181, 181, 181, 181, 181, 181, 181, 181,
+	181, 181, 181, 181, 181, 181, 181, 181, 181, 181,
+	181, 181, 181, 181, 181, 181, 181, 181, 181, 181,
+	181, 180, 180, 180, 180, 180, 180, 180, 180, 180,
+	180, 180, 180, 180, 180, 182, 182, 182, 182, 182,
+	182, 182, 182, 182, 182, 182, 182, 182, 182, 182,
+	182, 182, 182, 182, 182, 182, 182, 182, 182, 182,
+	182, 182, 182, 182, 182, 182, 182, 182, 182, 182,
+	182, 182, 182, 182, 182, 182, 182, 182, 182, 182,
+	182, 182, 182, 182, 182, 182, 182, 182, 182, 182,
+	182, 182, 182, 182, 182, 182, 182, 182, 182, 182,
+	182, 182, 182, 182, 182, 182, 182, 182, 182, 182,
+	182, 182, 182,
 }
 var sqlR2 = [...]int{
 
 	0, 1, 3, 1, 1, 1, 1, 1, 1, 1,
-	1, 1, 1, 1, 1, 1, 1, 1, 1, 0,
-	4, 6, 1, 3, 2, 5, 3, 6, 4, 6,
-	6, 6, 4, 8, 2, 3, 3, 6, 4, 3,
-	2, 1, 1, 0, 2, 0, 2, 0, 1, 1,
-	1, 5, 3, 5, 4, 6, 3, 5, 1, 3,
-	1, 2, 2, 3, 2, 5, 1, 1, 1, 1,
-	1, 3, 1, 6, 6, 1, 2, 2, 1, 1,
-	1, 3, 1, 1, 1, 1, 1, 1, 1, 1,
-	3, 2, 3, 3, 2, 1, 3, 3, 3, 3,
-	1, 3, 3, 2, 1, 1, 3, 1, 1, 1,
-	2, 2, 2, 1, 1, 1, 1, 1, 1, 1,
-	1, 3, 1, 1, 1, 1, 1, 0, 1, 1,
-	2, 2, 4, 2, 4, 4, 3, 3, 4, 2,
-	2, 0, 2, 0, 2, 0, 6, 9, 1, 0,
-	1, 3, 1, 1, 1, 3, 2, 0, 3, 1,
-	2, 2, 1, 1, 2, 4, 2, 5, 6, 7,
-	3, 1, 4, 5, 5, 10, 1, 1, 4, 0,
-	3, 0, 2, 2, 2, 0, 1, 1, 2, 2,
-	0, 3, 3, 2, 1, 1, 2, 2, 1, 2,
-	1, 4, 10, 13, 1, 0, 1, 3, 3, 3,
-	5, 2, 0, 1, 1, 0, 6, 6, 8, 6,
-	8, 8, 10, 8, 10, 1, 0, 2, 0, 3,
-	2, 2, 1, 0, 1, 0, 3, 3, 6, 6,
-	1, 3, 1, 4, 2, 8, 5, 0, 4, 3,
-	0, 7, 1, 3, 1, 1, 3, 5, 5, 1,
-	1, 3, 3, 1, 2, 3, 2, 3, 4, 1,
-	1, 8, 8, 1, 2, 4, 4, 4, 2, 2,
-	3, 1, 3, 6, 1, 1, 1, 1, 1, 0,
-	1, 0, 1, 1, 0, 1, 1, 0, 1, 0,
-	3, 1, 3, 2, 2, 2, 1, 1, 2, 2,
-	3, 1, 1, 1, 1, 3, 0, 2, 0, 2,
-	3, 2, 0, 1, 3, 2, 2, 1, 4, 3,
+	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
+	1, 1, 1, 0, 4, 6, 6, 6, 1, 1,
+	1, 3, 2, 5, 3, 6, 4, 6, 6, 6,
+	4, 8, 2, 3, 3, 6, 4, 3, 2, 1,
+	1, 0, 2, 0, 2, 0, 1, 1, 1, 5,
+	3, 5, 4, 6, 3, 5, 1, 3, 1, 2,
+	2, 3, 2, 5, 1, 1, 1, 1, 1, 3,
+	1, 6, 6, 1, 2, 2, 1, 1, 1, 3,
+	1, 1, 1, 1, 1, 1, 1, 1, 3, 2,
+	3, 3, 2, 1, 3, 3, 3, 3, 1, 3,
+	3, 2, 1, 1, 3, 1, 1, 1, 2, 2,
+	2, 1, 1, 1, 1, 1, 1, 1, 1, 3,
+	1, 1, 1, 1, 1, 0, 1, 1, 2, 2,
+	4, 4, 2, 4, 4, 6, 6, 3, 3, 4,
+	2, 2, 0, 2, 0, 2, 0, 7, 10, 7,
+	0, 1, 0, 1, 3, 1, 1, 1, 1, 3,
+	2, 0, 3, 1, 2, 2, 1, 1, 2, 4,
+	2, 5, 5, 7, 8, 5, 3, 1, 4, 6,
+	5, 10, 1, 1, 4, 0, 3, 0, 2, 2,
+	2, 0, 1, 1, 2, 2, 0, 3, 3, 2,
+	1, 1, 2, 2, 1, 2, 1, 5, 3, 0,
+	4, 11, 4, 11, 14, 1, 0, 1, 3, 3,
+	3, 5, 2, 0, 1, 1, 0, 6, 6, 8,
+	6, 8, 8, 10, 8, 10, 1, 0, 2, 0,
+	3, 2, 2, 2, 3, 2, 5, 4, 1, 0,
+	1, 0, 3, 3, 6, 6, 1, 3, 1, 4,
+	2, 8, 5, 0, 4, 3, 0, 7, 1, 3,
+	1, 1, 3, 5, 5, 1, 1, 3, 3, 1,
+	2, 3, 3, 4, 2, 3, 4, 1, 1, 2,
+	8, 8, 1, 2, 4, 4, 4, 2, 2, 3,
+	1, 3, 6, 1, 1, 1, 1, 1, 0, 1,
+	0, 1, 1, 0, 1, 1, 0, 1, 0, 3,
+	1, 3, 2, 2, 2, 1, 1, 2, 2, 3,
+	1, 1, 1, 1, 3, 0, 2, 0, 2, 3,
+	2, 0, 1, 3, 2, 2, 2, 1, 4, 3,
 	4, 5, 4, 5, 4, 5, 2, 4, 1, 1,
 	0, 2, 2, 2, 1, 1, 0, 4, 2, 1,
 	2, 2, 4, 1, 3, 1, 2, 3, 2, 0,
 	2, 5, 2, 3, 4, 0, 1, 1, 1, 1,
-	2, 4, 1, 1, 1, 1, 1, 1, 1, 1,
-	3, 5, 0, 1, 1, 1, 1, 1, 1, 2,
-	2, 2, 2, 2, 1, 1, 3, 0, 1, 1,
-	1, 1, 5, 2, 1, 1, 1, 1, 4, 1,
-	2, 2, 1, 1, 0, 1, 1, 1, 1, 1,
-	1, 1, 1, 1, 3, 3, 3, 3, 3, 3,
-	3, 0, 1, 4, 1, 3, 3, 5, 2, 2,
-	2, 3, 3, 3, 3, 3, 3, 3, 3, 3,
+	2, 4, 1, 1, 1, 1, 1, 1, 1, 4,
+	1, 1, 1, 1, 3, 5, 0, 1, 4, 1,
+	1, 1, 1, 1, 2, 2, 2, 2, 2, 1,
+	1, 3, 0, 1, 1, 1, 1, 5, 2, 1,
+	1, 1, 1, 4, 1, 2, 2, 1, 1, 0,
+	1, 1, 1, 1, 1, 1, 1, 1, 1, 3,
+	3, 3, 3, 3, 3, 3, 0, 1, 4, 1,
+	3, 3, 5, 2, 2, 2, 3, 3, 3, 3,
+	3, 3, 3, 3, 3, 3, 3, 3, 3, 3,
+	3, 3, 3, 3, 3, 3, 3, 3, 3, 2,
+	2, 3, 4, 4, 5, 6, 3, 4, 3, 3,
+	4, 3, 4, 3, 4, 5, 6, 6, 7, 6,
+	7, 6, 7, 3, 4, 1, 3, 2, 2, 2,
+	3, 3, 3, 3, 3, 3, 3, 3, 3, 3,
 	3, 3, 3, 3, 3, 3, 3, 3, 3, 3,
-	3, 2, 2, 3, 4, 4, 5, 3, 4, 3,
-	3, 4, 3, 4, 3, 4, 5, 6, 6, 7,
-	6, 7, 6, 7, 3, 4, 1, 3, 2, 2,
-	2, 3, 3, 3, 3, 3, 3, 3, 3, 3,
-	3, 3, 3, 3, 3, 3, 3, 3, 3, 5,
-	6, 6, 7, 1, 1, 1, 3, 1, 1, 1,
-	2, 2, 2, 1, 1, 3, 5, 6, 8, 6,
-	6, 4, 4, 1, 1, 1, 5, 1, 3, 1,
-	3, 1, 1, 1, 1, 6, 4, 4, 4, 4,
-	6, 5, 5, 5, 4, 8, 6, 6, 4, 4,
-	4, 5, 0, 5, 0, 2, 0, 1, 3, 3,
-	2, 2, 0, 6, 1, 0, 3, 0, 2, 2,
-	0, 1, 4, 2, 2, 2, 2, 2, 4, 3,
-	5, 4, 3, 5, 1, 3, 1, 3, 3, 3,
-	2, 1, 3, 3, 1, 1, 1, 1, 1, 1,
-	1, 4, 3, 2, 3, 0, 3, 3, 2, 2,
-	1, 0, 2, 2, 3, 2, 1, 1, 3, 5,
-	1, 2, 4, 2, 0, 1, 0, 2, 2, 2,
-	3, 5, 1, 2, 1, 0, 1, 1, 1, 3,
-	3, 1, 0, 1, 3, 3, 2, 1, 1, 1,
-	3, 1, 2, 1, 3, 3, 0, 1, 2, 1,
-	1, 1, 1, 6, 2, 3, 5, 1, 1, 1,
-	1, 2, 2, 1, 1, 1, 1, 0, 1, 1,
+	3, 5, 6, 6, 7, 1, 1, 1, 3, 4,
+	1, 1, 1, 2, 2, 2, 1, 1, 3, 5,
+	6, 8, 6, 6, 4, 4, 1, 1, 1, 5,
+	1, 3, 1, 3, 1, 1, 1, 1, 6, 4,
+	4, 4, 4, 6, 5, 5, 5, 4, 8, 6,
+	6, 4, 4, 4, 5, 0, 5, 0, 2, 0,
+	1, 3, 3, 2, 2, 0, 6, 1, 0, 3,
+	0, 2, 2, 0, 1, 4, 2, 2, 2, 2,
+	2, 4, 3, 5, 4, 3, 5, 1, 1, 1,
+	1, 1, 1, 1, 1, 1, 1, 2, 1, 3,
+	1, 3, 3, 3, 2, 1, 3, 3, 1, 1,
+	1, 1, 1, 1, 1, 4, 3, 2, 3, 0,
+	3, 3, 2, 2, 1, 0, 2, 2, 3, 2,
+	1, 1, 3, 5, 1, 2, 4, 2, 0, 1,
+	0, 2, 2, 2, 3, 5, 1, 2, 1, 0,
+	1, 1, 1, 3, 3, 1, 0, 1, 3, 3,
+	2, 1, 1, 1, 3, 1, 2, 1, 3, 1,
+	3, 3, 0, 1, 2, 1, 1, 1, 1, 6,
+	2, 3, 5, 1, 1, 1, 1, 2, 2, 1,
+	1, 1, 1, 0, 1, 1, 1, 1, 1, 1,
 	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
 	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
 	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
@@ -3102,349 +3470,376 @@ var sqlR2 = [...]int{
 	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
 	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
 	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
-	1,
+	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
+	1, 1, 1,
 }
 var sqlChk = [...]int{
 
-	-1000, -1, -2, -3, -4, -5, -9, -10, -11, -13,
-	-14, -16, -17, -18, -19, -20, -21, -22, -23, 19,
-	-6, -7, -8, -190, 81, 87, 99, 178, -24, -25,
-	191, 192, 29, 50, 180, 216, 56, -189, -27, -26,
-	260, 236, 242, 187, -28, 204, 229, 263, 204, 68,
-	109, 76, 112, 223, 68, 109, 204, -12, 260, -18,
-	-14, -23, -9, -208, 18, -209, -210, 56, 81, 99,
-	187, 112, 76, 223, -208, -101, 130, 189, 212, -102,
-	-100, -162, 208, 138, -61, -38, 4, -169, -171, 16,
-	17, 19, 28, 29, 33, 37, 39, 49, 50, 51,
-	53, 55, 58, 59, 66, 67, 68, 69, 71, 76,
-	80, 81, 87, 91, 92, 94, 100, 105, 112, 120,
-	122, 127, 134, 135, 136, 137, 140, 141, 143, 146,
-	148, 149, 155, 158, 161, 162, 165, 168, 169, 171,
-	172, 174, 175, 176, 178, 180, 181, 183, 185, 186,
-	188, 191, 192, 194, 196, 198, 201, 199, 205, 206,
-	216, 217, 218, 219, 222, 223, 226, 227, 228, 232,
-	237, 238, 239, 240, 30, 31, 32, 34, 35, 38,
-	42, 43, 45, 70, 72, 73, 86, 88, 93, 101,
-	103, 106, 107, 113, 114, 115, 117, 125, 145, 147,
-	156, 160, 164, 166, 170, 182, 195, 200, 202, 209,
-	213, 214, 229, 230, 4, 68, 49, 69, 100, 109,
-	205, 208, 212, 18, -213, 212, -213, -213, -212, 204,
-	204, -90, 68, 221, -26, -27, -25, -51, -52, 220,
-	116, 85, 154, -24, -25, -189, -191, 171, -188, -38,
-	130, 138, 189, 212, 208, -191, -48, -49, 18, 78,
-	264, -133, -42, 152, -38, -73, 260, -3, -133, 106,
-	-38, -42, 106, 97, 118, -134, -133, -38, 106, -60,
-	106, -42, -62, 106, -61, -138, -137, -165, 4, -169,
-	-171, -170, 229, 47, 57, 98, 111, 119, 121, 126,
-	128, 139, 157, 159, 179, 193, 151, 264, 151, -101,
-	-101, -37, 120, 210, 245, 97, 240, -45, 6, 74,
-	-65, 262, 97, -205, 151, 97, -161, 97, 240, 120,
-	-36, -37, -76, -133, -61, 106, 109, -38, 106, -51,
-	-52, -75, -93, -94, 129, 150, -77, 18, 78, -77,
-	-77, 37, 261, 261, 264, -191, -56, 260, -67, -66,
-	-135, -108, 253, -110, 251, 252, 257, 142, 241, -117,
-	-42, -111, 9, 260, -120, -186, -25, 86, 24, -118,
-	-119, 182, -38, 8, 5, 6, 7, -40, -141, -150,
-	215, 89, 144, 40, -184, -185, 4, -169, -164, -142,
-	-152, -146, -149, 117, 47, 61, 64, 62, 65, 190,
-	224, 41, 88, 160, 164, 202, 213, 214, 106, 145,
-	107, 45, 101, 125, 80, 31, 32, 34, 35, 42,
-	43, 70, 72, 73, 93, 113, 114, 115, 147, 170,
-	195, 209, 230, -170, -153, -154, -147, -148, -155, -66,
-	-73, 253, -42, 260, -71, -107, 262, 265, 258, -72,
-	-125, -108, 74, -33, 174, -32, 17, 19, 81, 227,
-	86, 174, 174, 86, -134, -43, -42, 191, -38, 25,
-	86, -35, 264, 39, 176, 86, 264, 86, 261, 264,
-	-204, -60, 204, 68, -210, -204, 127, -160, 74, -167,
-	-159, -126, 9, 215, 89, 151, -166, 5, 252, -158,
-	-165, 6, 8, 251, -160, 74, 59, -168, 6, 4,
-	-150, -126, 74, 130, 117, 262, -163, 4, -169, -171,
-	-170, -172, 18, 20, 21, 22, 23, 24, 25, 26,
-	27, 36, 40, 41, 44, 46, 48, 54, 56, 60,
-	61, 62, 63, 64, 65, 74, 75, 77, 78, 79,
-	82, 83, 85, 89, 90, 95, 96, 97, 99, 102,
-	104, 108, 109, 110, 116, 118, 123, 124, 129, 131,
-	132, 142, 144, 150, 151, 152, 153, 154, 163, 167,
-	173, 177, 187, 190, 197, 203, 204, 207, 210, 211,
-	215, 220, 221, 224, 225, 231, 233, 234, 235, 236,
-	-162, -207, 95, -204, -162, -162, 127, -35, 264, 260,
-	142, -39, 106, -38, 142, -75, -94, -93, -95, -108,
-	18, -108, -110, -26, -26, -26, -53, -129, -108, -188,
-	25, -55, -38, -58, 97, 264, 10, 46, 28, 251,
-	252, 253, 254, 255, 248, 249, 250, 247, 243, 244,
-	245, 52, 133, 184, 12, 13, 14, 22, 153, 128,
-	241, 193, 119, 30, 108, 25, 4, -108, -108, -108,
-	-108, -108, 159, -25, -108, -63, -71, -25, -114, 258,
-	260, -71, 260, 6, 6, 260, -121, -108, -192, 237,
-	95, 260, 260, 260, 260, 260, 260, 260, 260, 260,
-	260, 260, 260, 260, 260, 260, 166, -157, 232, -157,
-	-157, -143, 260, -143, -144, 260, -143, 260, -58, -42,
-	-107, -163, 253, -163, -108, 264, 261, 264, 210, -91,
-	54, 48, -104, 106, 48, -173, -38, 54, -174, 44,
-	221, 167, 96, -91, 54, -91, 54, 54, -133, 210,
-	210, -42, -106, 234, -97, -18, 260, 74, 25, -68,
-	-69, -136, -70, -42, 260, -38, -38, -42, -60, -61,
-	-62, -12, -137, 210, -60, -55, 97, -44, 169, 175,
-	196, 188, 264, 5, 8, 8, 6, -163, -206, -38,
-	-133, -46, -47, -105, -104, -175, -173, 109, 221, 86,
-	151, 142, 86, -96, 182, 183, 264, -31, 26, 77,
-	260, 264, 261, -106, -59, -131, -133, -25, -132, 260,
-	-135, -139, -140, -142, -151, -145, -149, -150, 33, 38,
-	206, 200, 113, 114, 115, 195, 31, 170, 93, 80,
-	73, 72, 147, 35, 34, -153, -154, -147, -148, 70,
-	209, 32, 43, 42, 230, -61, 208, -108, -108, -108,
-	-108, -108, -108, -108, -108, -108, -108, -108, -108, -108,
-	-108, -108, -108, -108, -108, -108, -108, -108, 128, 193,
-	30, 108, 210, 144, 142, 215, 89, 222, 78, 148,
-	-214, 203, 27, -112, -25, 260, -163, -117, 182, 260,
-	261, 264, -63, -116, 259, -108, -114, -63, 261, 261,
-	-63, 231, 18, 78, 253, -85, 239, 136, 71, 105,
-	135, -86, 186, 8, -124, -123, 233, -193, 91, 102,
-	260, 261, 261, -108, -64, -156, 4, 239, 136, 71,
-	105, 135, 186, -81, -108, -82, -109, -110, 251, 252,
-	257, 260, 182, -83, -108, -63, -108, 36, 124, 211,
-	-84, -108, 97, -63, -108, -108, -108, -63, -63, -63,
-	260, 8, 8, 8, -106, 261, 259, 266, -125, -32,
-	-42, -38, -38, 142, -104, 106, -139, -38, 260, 260,
-	122, 122, -38, -38, 106, -38, 106, -38, -38, -33,
-	174, -38, -38, 174, -108, -99, 151, -60, 229, -38,
-	-58, 264, 245, -60, -35, -206, -206, 219, 51, 169,
-	-167, -85, 264, 261, 264, -39, 109, -61, -42, 86,
-	-38, -129, -15, -18, -14, -23, -9, -38, -74, 102,
-	264, 57, -80, 121, 139, 98, 126, 179, 111, -128,
-	-127, 25, -38, -128, -25, -132, -131, -57, 24, -85,
-	260, 240, -108, 210, -214, 203, -112, -108, 144, 215,
-	89, 222, 78, 148, 97, 260, -109, -109, -63, 260,
-	-63, -108, 264, 259, 259, 264, 261, -52, 264, -51,
-	-108, -63, -63, 261, 210, 210, 210, 210, 260, 261,
-	-122, -123, 82, -108, -198, 158, 260, 260, -108, 25,
-	261, 97, 261, -87, 163, 261, 10, 251, 252, 253,
-	254, 255, 248, 249, 250, 247, 243, 244, 245, 52,
-	133, 184, 12, 13, 14, 119, 108, -109, -109, -109,
-	-63, 260, 261, -88, -89, 97, 95, 25, -84, -84,
-	-84, 261, 97, -63, 264, 264, 264, 261, 261, 261,
-	8, 261, 264, 261, 261, -74, -108, 210, 210, 86,
-	142, -176, -174, -108, -55, 260, 260, -29, 81, 191,
-	-92, 86, -35, 86, -35, 210, -91, 54, 210, 53,
-	261, -106, -69, -125, 261, -38, -105, 260, -39, 260,
-	260, -38, 261, -113, 104, 37, -131, 121, 121, -131,
-	-80, 121, -78, 157, -78, -78, -38, 260, 261, 258,
-	258, 8, -108, -108, -109, -109, 97, 260, -108, -115,
-	-139, 22, 22, 261, -63, 261, 264, 261, -108, -114,
-	261, 231, -52, -52, -52, 136, 105, 135, -86, 135,
-	-86, -86, 8, 6, 83, -108, 207, -199, -38, 260,
-	234, -51, 261, -139, -108, -88, -108, -139, -109, -109,
-	-109, -109, -109, -109, -109, -109, -109, -109, -109, -109,
-	-109, -109, -109, -109, -109, -109, 78, 142, 148, -109,
-	264, -63, 261, -89, -88, -108, -108, -139, 261, 261,
-	261, -63, -108, -108, -108, 261, 8, -113, 259, -38,
-	-38, -104, 86, -177, 54, -178, 46, 142, 144, 221,
-	167, 44, 74, 173, 261, 261, -55, -55, 142, 74,
-	142, 74, 67, 217, -38, -38, -42, -38, -38, -38,
-	-98, 260, 151, -18, 245, -55, 260, -46, -54, -130,
-	-38, -187, 260, -184, -185, -40, 151, -194, 235, -108,
-	-63, -131, -131, -79, 225, 151, 121, -131, 260, -55,
-	-127, 259, 8, 8, 261, 22, 22, -108, -115, 261,
-	264, -108, -108, 261, -108, 6, -108, 261, 261, 261,
-	261, -108, -203, -38, -108, 261, 261, -89, 97, 78,
-	148, 260, -108, 261, 261, 264, 261, 261, 261, -194,
-	-104, -38, -61, 144, 122, 260, -109, -42, -103, -211,
-	55, 201, 261, 261, 144, 144, -108, -139, -35, -35,
-	210, 210, 79, -54, 54, -73, -25, 260, 261, -55,
-	261, 261, 264, -41, -71, 46, -41, -108, 260, -42,
-	-195, -197, -38, -79, 260, -108, -131, -55, 261, 259,
-	259, -108, -108, 261, -139, 261, -52, -196, 162, 261,
-	-109, 97, 260, -115, 261, -108, -178, -108, -50, 260,
-	260, 173, -34, 46, -38, -38, 223, 143, 261, -38,
-	-103, 261, -103, -130, -31, -61, -31, 261, -63, 260,
-	264, 25, -55, 261, 261, -52, 37, -109, -115, 261,
-	261, 261, -181, 134, -55, -55, -42, -30, 225, -61,
-	191, -106, -103, -41, -52, -54, -197, -199, 261, -200,
-	168, 183, -63, 261, -179, -182, -180, 151, 98, 161,
-	194, 261, 261, -50, -108, -68, -31, 261, 261, 261,
-	-201, -202, 30, 218, 59, -108, -201, -180, 151, -182,
-	151, 223, 76, -181, -106, -103, -202, 165, 94, 182,
-	165, 94, -183, 141, 176, 39, 191, -183, -179, 22,
-	16, 144, 74, -202,
+	-1000, -1, -2, -3, -4, -5, -6, -7, -11, -12,
+	-13, -15, -16, -17, -19, -20, -21, -22, -23, -24,
+	-25, -26, -27, 19, 29, -8, -9, -10, -201, 86,
+	92, 107, 116, 191, 194, -28, -29, 208, 209, 30,
+	51, 196, 201, 188, 234, 60, -200, -31, -30, 279,
+	255, 261, 204, -32, 222, 248, 282, 222, 73, 119,
+	-215, -69, 222, 73, -47, -42, 4, -179, -181, 16,
+	17, 19, 28, 29, 30, 34, 38, 40, 50, 51,
+	52, 54, 55, 56, 59, 61, 63, 64, 71, 72,
+	74, 76, 81, 85, 86, 92, 99, 100, 102, 108,
+	113, 116, 118, 122, 126, 131, 133, 134, 135, 140,
+	143, 147, 148, 149, 150, 151, 153, 154, 156, 159,
+	161, 162, 168, 171, 174, 175, 176, 179, 182, 183,
+	185, 186, 188, 189, 190, 191, 192, 194, 196, 197,
+	199, 201, 202, 203, 205, 206, 208, 209, 211, 213,
+	215, 216, 219, 217, 223, 224, 230, 234, 235, 236,
+	237, 240, 241, 244, 245, 246, 247, 251, 256, 257,
+	258, 259, 31, 32, 33, 35, 36, 39, 43, 44,
+	46, 75, 77, 78, 91, 93, 101, 109, 111, 114,
+	115, 123, 124, 125, 128, 138, 158, 160, 169, 173,
+	178, 180, 184, 198, 212, 218, 220, 226, 227, 231,
+	232, 248, 249, 81, 122, 241, 73, 119, 222, -14,
+	279, -22, -17, -27, -11, -219, 18, -220, -221, 60,
+	86, 107, 204, 122, 81, 241, 222, -215, -219, -110,
+	143, 206, 230, -111, -109, -172, 226, 151, -70, -42,
+	73, 4, 73, 50, 60, 74, 108, 119, 259, 223,
+	226, 230, 18, -224, 230, -224, -224, -42, 201, -42,
+	-223, 222, 222, -99, 73, 239, -30, -31, -29, -57,
+	-58, 238, 127, 90, 167, -28, -29, -200, -202, 185,
+	-199, -42, -202, -54, -55, 18, 83, 283, -143, -47,
+	165, -82, 279, -3, -143, 114, -47, -42, -47, 114,
+	228, 283, -69, -61, -42, -80, -116, 281, 284, 277,
+	105, 129, -144, -143, -42, 114, -69, 114, -71, 114,
+	-70, -148, -147, -175, 4, -179, -181, -180, 248, 48,
+	62, 95, 106, 121, 130, 132, 139, 141, 152, 170,
+	172, 195, 210, 164, 283, -47, 105, 164, -110, -110,
+	-41, 131, 228, 264, 105, 259, -50, 6, 79, -74,
+	281, 105, 222, -216, 164, 105, 54, -171, 105, 259,
+	131, -40, -41, 228, -42, -85, -143, -70, 114, 119,
+	-42, 114, -57, -58, -84, -225, -102, -103, 103, 142,
+	163, -86, 18, 83, -86, -86, 38, 280, 280, 283,
+	-202, -62, 279, -76, -75, -145, -117, 272, -119, 270,
+	271, 276, 155, 260, -126, -47, -120, 9, 279, -129,
+	-197, -29, 91, 24, -127, -128, 198, -42, 8, 5,
+	6, 7, -45, -151, -160, 233, 94, 157, 41, -195,
+	-196, 4, -179, -174, -152, -162, -156, -159, 128, 48,
+	66, 69, 67, 70, 207, 242, 42, 93, 173, 178,
+	220, 231, 232, 114, 158, 115, 46, 109, 138, 85,
+	32, 33, 35, 36, 43, 44, 75, 77, 78, 101,
+	123, 124, 125, 160, 184, 212, 227, 249, -180, -163,
+	-164, -157, -158, -165, -75, -82, 272, -47, 279, -81,
+	-135, -117, 79, -37, 189, -36, 17, 19, 86, 246,
+	91, 55, 55, 189, 189, 91, 6, -47, 283, -116,
+	-173, 272, 4, -179, -181, -180, -182, 18, 20, 21,
+	22, 23, 24, 25, 26, 27, 37, 41, 42, 45,
+	47, 49, 57, 60, 65, 66, 67, 68, 69, 70,
+	79, 80, 82, 83, 84, 87, 88, 90, 94, 96,
+	103, 104, 105, 107, 110, 112, 117, 119, 120, 127,
+	129, 136, 137, 142, 144, 145, 155, 157, 163, 164,
+	165, 166, 167, 177, 181, 187, 193, 204, 207, 214,
+	221, 222, 225, 228, 229, 233, 238, 239, 242, 243,
+	250, 252, 253, 254, 255, -173, -117, -144, -48, -47,
+	208, -42, 25, 91, -39, 40, 192, 91, 283, 91,
+	280, 283, -215, -221, 60, -63, 6, -215, 140, -170,
+	79, -177, -169, -136, 9, 233, 94, 164, -176, 5,
+	271, -168, -175, 6, 8, 270, -170, 79, 64, -178,
+	6, 4, -160, -136, 79, 143, 128, 281, -173, -172,
+	-172, -218, 103, -215, -172, 103, -172, 140, 201, -42,
+	-39, 283, 279, 155, -43, 114, -42, 155, -84, -225,
+	-103, -102, 241, -104, -117, 18, -117, -119, -30, -30,
+	-30, -59, -139, -117, -199, 25, -61, -67, 105, 283,
+	10, 47, 28, 270, 271, 272, 273, 274, 267, 268,
+	269, 266, 262, 263, 264, 53, 98, 97, 58, 146,
+	200, 12, 13, 14, 22, 166, 141, 260, 210, -44,
+	130, 31, 117, 25, 4, -117, -117, -117, -117, -117,
+	172, -29, -117, -72, -80, -29, -123, 277, 279, -80,
+	279, 6, 6, 279, -130, -117, -203, 256, 103, 279,
+	279, 279, 279, 279, 279, 279, 279, 279, 279, 279,
+	279, 279, 279, 279, 180, -167, 251, -167, -167, -153,
+	279, -153, -154, 279, 279, -153, 279, -67, -47, 283,
+	280, 283, 228, -100, 57, 49, -113, 114, 49, -183,
+	-42, 57, -184, 45, 239, 181, 104, -100, 57, -100,
+	57, 57, -143, 259, 259, 228, 228, -47, -64, 118,
+	-42, 278, 285, -115, 253, -106, -22, 279, 79, 25,
+	-77, -78, -146, -79, -47, 279, -42, -42, -69, -70,
+	-71, -14, -147, 228, 243, 283, 105, -49, 183, 190,
+	213, 205, 283, 5, 8, 8, 6, -173, -217, -42,
+	73, 222, -42, -143, -51, -52, -114, -113, -185, -186,
+	-183, 119, 239, 95, 91, 164, 155, 91, -105, 198,
+	199, 283, -35, 26, 82, 279, 280, -115, -68, -141,
+	-143, -29, -198, -142, 279, -195, -196, -45, -145, -149,
+	-150, -152, -161, -155, -159, -160, 34, 39, 133, 134,
+	244, 224, 218, 123, 124, 125, 212, 32, 184, 101,
+	85, 78, 77, 160, 36, 35, -163, -164, -157, -158,
+	75, 227, 33, 44, 43, 249, -70, 226, -117, -117,
+	-117, -117, -117, -117, -117, -117, -117, -117, -117, -117,
+	-117, -117, -117, -117, -117, -117, -117, -117, -117, -117,
+	-117, -117, 141, 210, 31, 117, 228, -134, 23, 214,
+	18, 157, 155, 233, 94, 240, 83, 161, -226, 221,
+	27, -121, -29, 279, -173, -126, 198, 279, 280, 283,
+	-72, -125, 278, -117, -123, -72, 280, 280, -72, 250,
+	18, 83, 272, -94, 258, 149, 76, 113, 148, -95,
+	203, 8, -133, -132, 252, -204, 99, 110, 279, 280,
+	280, -117, -73, -166, 4, 258, 149, 76, 113, 148,
+	203, -90, -117, -91, -118, -119, 270, 271, 276, 279,
+	198, -92, -117, -72, -117, 37, 137, 229, -93, -117,
+	105, -72, -117, -117, -117, -72, -72, -72, 279, 8,
+	8, 8, 8, -115, 280, -135, -36, -47, -42, -42,
+	155, -113, 114, -149, -42, 279, 279, 135, 135, -42,
+	-42, 114, -42, 114, -42, -42, -37, 189, -65, 6,
+	157, -65, -42, -42, 189, 105, -117, -117, -108, 164,
+	-69, 248, -42, -67, 283, 264, -69, -39, -217, 6,
+	6, -217, 237, 52, 183, -177, -94, 283, -42, -47,
+	280, 283, -43, 119, -43, -70, -47, 91, -42, -139,
+	-18, -22, -17, -27, -11, -83, 110, 283, 62, -89,
+	132, 152, 106, 139, 195, 121, -138, -137, 25, -42,
+	-138, -138, -29, -142, -141, 279, -66, 24, -94, 279,
+	279, 259, -117, 228, -226, 221, -121, -117, 279, 157,
+	233, 94, 240, 83, 161, 105, 279, -118, -118, -72,
+	279, -72, -80, -117, 283, 278, 278, 283, 280, -58,
+	283, -57, -117, -72, -72, 280, 228, 228, 228, 228,
+	279, 280, -131, -132, 87, -117, -209, 171, 279, 279,
+	-117, 25, 280, 105, 280, -96, 177, 280, 10, 270,
+	271, 272, 273, 274, 267, 268, 269, 266, 262, 263,
+	264, 53, 98, 97, 58, 146, 200, 12, 13, 14,
+	130, 117, -118, -118, -118, -72, 279, 280, -97, -98,
+	105, 103, 25, -93, -93, -93, 280, 105, -72, 283,
+	283, 283, 280, 280, 280, 8, 280, 283, 280, 280,
+	280, -83, 228, 228, 91, 155, -187, -184, -117, -60,
+	-140, -42, -198, 279, 279, 279, -33, 86, 208, -101,
+	91, -39, 91, -39, 228, -100, 57, 228, -63, 278,
+	56, 280, -115, -78, -135, 280, 61, -42, -53, 126,
+	-114, 279, -43, 279, 279, 279, -42, 280, -122, 112,
+	38, -141, 132, 132, -141, -89, 132, -87, 170, -87,
+	-87, -42, 279, 280, -72, 277, 277, 8, 8, -117,
+	-117, -118, -118, -117, 105, 279, -117, -124, -149, 22,
+	22, 280, -72, 280, 283, 280, -117, -123, 280, 250,
+	-58, -58, -58, 149, 113, 148, -95, 148, -95, -95,
+	8, 6, 88, -117, 225, -210, -42, 279, 253, -57,
+	280, -149, -117, -97, -117, -149, -118, -118, -118, -118,
+	-118, -118, -118, -118, -118, -118, -118, -118, -118, -118,
+	-118, -118, -118, -118, -118, -118, -118, 83, 155, 161,
+	-118, 283, -72, 280, -98, -97, -117, -117, -149, 280,
+	280, 280, -72, -117, -117, -117, 280, 8, -122, -42,
+	-42, -113, 91, -188, 57, -189, 47, 155, 157, 239,
+	181, 45, 79, 25, 187, 280, 280, 283, -46, -80,
+	47, -46, -117, -60, -61, 155, 79, 155, 79, 72,
+	235, -42, -42, -47, -42, -42, -42, -107, 279, 164,
+	-22, 264, 72, 117, -60, 279, -61, -51, -60, 164,
+	-205, 254, -117, -72, -141, -141, -88, 243, 164, 132,
+	-141, 279, -61, -137, -58, 278, 8, 8, 280, 280,
+	22, 22, 280, -117, -124, 280, 283, -117, -117, 280,
+	-117, 6, -117, 280, 280, 280, 280, -117, -214, -42,
+	-117, 280, 280, -98, 105, 83, 161, 279, -117, 280,
+	280, 283, 280, 280, 280, -205, -113, -42, -70, 157,
+	135, 279, -118, 279, -47, -112, -222, 59, 219, -140,
+	-35, -70, -35, 280, 280, 280, 157, 157, -117, -149,
+	-39, -39, 228, 228, 84, -60, 57, -82, -29, 279,
+	279, 174, 280, -60, 280, 280, 280, -47, -206, -208,
+	-42, -88, 279, -117, -141, -61, 280, 280, 278, 278,
+	-117, -117, 280, -149, 280, -58, -207, 176, 280, -118,
+	105, 279, -124, 280, -117, -189, -117, -117, -56, 279,
+	-115, 279, -46, 187, -38, 47, -42, -42, 241, 156,
+	280, -42, -63, -47, -112, 280, -53, -112, 279, 283,
+	25, -61, 280, 280, -58, 38, -118, -124, 280, 280,
+	280, 280, -192, 147, -61, -61, -35, -47, -34, 243,
+	-70, 208, -115, 280, 279, -115, -112, -115, -60, -208,
+	-210, 280, -211, 182, 199, -72, 280, 216, -190, -193,
+	-191, 164, 106, 175, 211, 280, 280, -56, -117, -77,
+	-61, -115, 280, 280, -212, -213, 31, 236, 64, -117,
+	-212, -191, 164, -193, 164, 241, 81, -192, -115, 280,
+	-112, -213, 179, 102, 198, 179, 102, -194, 154, 192,
+	40, 208, -194, -190, -115, 22, 16, 157, 79, -213,
 }
 var sqlDef = [...]int{
 
 	-2, -2, 1, 3, 4, 5, 6, 7, 8, 9,
-	10, 11, 12, 13, 14, 15, 16, 17, 18, 0,
-	48, 49, 50, 0, 0, 289, 0, 0, 259, -2,
-	0, 0, 233, 233, 233, 291, 205, 288, -2, 299,
-	0, 0, 0, 297, 273, 0, 0, -2, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 64, 0, 66,
-	67, 68, 69, 0, 78, 79, 80, 82, 83, 84,
-	85, 86, 87, 88, 0, 91, 733, 764, 774, 95,
-	100, 0, 824, -2, 104, 60, 683, 684, 685, 700,
-	701, 702, 703, 704, 705, 706, 707, 708, 709, 710,
-	711, 712, 713, 714, 715, 716, 717, 718, 719, 720,
-	721, 722, 723, 724, 725, 726, 727, 728, 729, 730,
-	731, 732, 734, 735, 736, 737, 739, 740, 741, 742,
-	743, 744, 745, 746, 747, 748, 749, 750, 751, 752,
-	753, 754, 755, 756, 757, 758, 759, 760, 761, 762,
-	763, 765, 766, 767, 768, 769, 770, 771, 772, 773,
-	775, 776, 777, 778, 779, 780, 781, 782, 783, 784,
-	785, 786, 787, 788, 789, 790, 791, 792, 793, 794,
-	795, 796, 797, 798, 799, 800, 801, 802, 803, 804,
-	805, 806, 807, 808, 809, 810, 811, 812, 813, 814,
-	815, 816, 817, 818, 819, 820, 821, 822, 823, 825,
-	826, 827, 828, 829, 130, 131, 0, 133, 143, 0,
-	141, 0, 0, 139, 235, 232, 230, 231, 0, 290,
-	0, 0, 0, 204, -2, 269, 270, -2, 0, 294,
-	294, 294, 0, 0, 270, 0, 278, 752, 281, 666,
-	733, 738, 764, 774, 824, 279, 652, 0, 296, 295,
-	0, 274, 349, 0, 661, 319, 0, 2, 0, 806,
-	0, 0, 806, 0, 0, 0, 355, 52, 806, 43,
-	806, 659, 56, 806, 58, 0, 70, 72, 691, 692,
-	693, 694, 828, 830, 831, 832, 833, 834, 835, 836,
-	837, 838, 839, 840, 841, 842, 0, 0, 0, 92,
-	93, 94, 0, 0, 0, 0, 0, 103, 125, 126,
-	61, 0, 0, 145, 0, 0, 136, 0, 137, 0,
-	229, 234, 43, 353, 0, 806, 687, 237, 806, -2,
-	0, 265, 306, 307, 0, 0, 0, 292, 293, 0,
-	0, 0, 261, 262, 0, 280, 0, 0, 322, 651,
-	653, 657, 658, 434, 0, 0, 0, 0, 0, 0,
-	513, 514, 515, 0, 517, 518, 519, 801, 0, 523,
-	524, 820, 661, 669, 670, 671, 672, 0, 0, 0,
-	677, 678, 679, 636, 562, 533, -2, -2, 667, 376,
-	377, 378, 379, -2, 830, 537, 539, 541, 542, 543,
-	544, 0, 802, 816, 817, 823, 826, 827, 806, 813,
-	807, 797, 804, 812, 721, -2, -2, -2, -2, -2,
+	10, 11, 12, 13, 14, 15, 16, 17, 18, 19,
+	20, 21, 22, 0, 0, 56, 57, 58, 0, 0,
+	318, 0, 0, 0, 0, 285, -2, 0, 0, 259,
+	259, 259, 0, 0, 320, 226, 317, -2, 328, 0,
+	0, 0, 326, 302, 0, 0, -2, 0, 0, 0,
+	0, 83, 0, 777, 713, 715, 739, 740, 741, 756,
+	757, 758, 759, 760, 761, 762, 763, 764, 765, 766,
+	767, 768, 769, 770, 771, 772, 773, 774, 775, 776,
+	778, 779, 780, 781, 782, 783, 784, 785, 786, 787,
+	788, 789, 790, 791, 792, 793, 794, 795, 796, 797,
+	798, 799, 800, 801, 802, 803, 804, 805, 806, 807,
+	808, 809, 810, 811, 812, 813, 814, 815, 816, 817,
+	818, 819, 820, 821, 822, 823, 824, 825, 826, 827,
+	828, 829, 830, 831, 832, 833, 834, 835, 836, 837,
+	838, 839, 840, 841, 842, 843, 844, 845, 846, 847,
+	848, 849, 850, 851, 852, 853, 854, 855, 856, 857,
+	858, 859, 860, 861, 862, 863, 864, 865, 866, 867,
+	868, 869, 870, 871, 872, 873, 874, 875, 876, 877,
+	878, 879, 880, 881, 882, 883, 884, 885, 886, 887,
+	888, 889, 890, 891, 892, 893, 894, 895, 896, 897,
+	898, 899, 900, 0, 0, 0, 0, 0, 0, 72,
+	0, 74, 75, 76, 77, 0, 86, 87, 88, 90,
+	91, 92, 93, 94, 95, 96, 0, 0, 0, 99,
+	798, 833, 844, 103, 108, 0, 895, -2, 112, 68,
+	777, 138, 139, 0, 0, 142, 154, 0, 0, 152,
+	0, 0, 150, 261, 258, 251, 252, 253, 829, 255,
+	0, 319, 0, 0, 0, 225, -2, 297, 298, -2,
+	0, 323, 323, 323, 0, 0, 298, 0, 307, 818,
+	310, 722, 308, 706, 0, 325, 324, 0, 303, 379,
+	0, 348, 0, 2, 0, 877, 379, 0, 0, 877,
+	0, 0, 84, 85, 717, 716, 696, 0, 0, 0,
+	0, 0, 0, 385, 60, 877, 51, 877, 64, 877,
+	66, 0, 78, 80, 747, 748, 749, 750, 899, 901,
+	902, 903, 904, 905, 906, 907, 908, 909, 910, 911,
+	912, 913, 914, 0, 0, 0, 0, 0, 100, 101,
+	102, 0, 0, 0, 0, 0, 111, 133, 134, 69,
+	0, 0, 0, 156, 0, 0, 0, 147, 0, 148,
+	0, 250, 260, 0, 254, 51, 383, 0, 877, 743,
+	263, 877, -2, 0, 291, 292, 335, 336, 0, 0,
+	0, 0, 321, 322, 0, 0, 0, 287, 288, 0,
+	309, 0, 0, 351, 705, 707, 711, 712, 469, 0,
+	0, 0, 0, 0, 0, 555, 556, 557, 0, 560,
+	561, 562, 872, 0, 566, 567, 891, 715, 725, 726,
+	727, 728, 0, 0, 0, 733, 734, 735, 690, 605,
+	576, -2, -2, 723, 410, 411, 412, 413, -2, 901,
+	580, 582, 584, 585, 586, 587, 0, 873, 887, 888,
+	894, 897, 898, 877, 884, 878, 868, 875, 883, 781,
 	-2, -2, -2, -2, -2, -2, -2, -2, -2, -2,
-	-2, -2, -2, 690, 400, 401, 406, 407, 409, 322,
-	320, 350, 351, 0, 662, 642, 0, 0, 0, 0,
-	648, 646, 647, 20, 226, 22, 0, 226, 226, 0,
-	0, 0, 0, 0, 359, 0, 240, 0, 356, 0,
-	0, 54, 0, 41, 42, 0, 0, 0, 289, 0,
-	0, 75, 0, 717, 81, 0, 0, 96, 98, 105,
-	107, 108, 109, 115, 116, 117, 118, 198, 0, 200,
-	128, 129, 680, 0, 97, 99, 101, 102, 119, 120,
-	0, 122, 123, 124, 417, 0, 62, 695, 696, 697,
-	698, 699, 843, 844, 845, 846, 847, 848, 849, 850,
-	851, 852, 853, 854, 855, 856, 857, 858, 859, 860,
-	861, 862, 863, 864, 865, 866, 867, 868, 869, 870,
-	871, 872, 873, 874, 875, 876, 877, 878, 879, 880,
-	881, 882, 883, 884, 885, 886, 887, 888, 889, 890,
-	891, 892, 893, 894, 895, 896, 897, 898, 899, 900,
-	901, 902, 903, 904, 905, 906, 907, 908, 909, 910,
-	911, 912, 913, 914, 915, 916, 917, 918, 919, 920,
-	132, 134, 0, 142, 135, 140, 138, 201, 0, 149,
-	0, 0, 806, 686, 0, 268, 304, 305, 308, 311,
-	312, 309, 434, 275, 276, 277, 300, 301, 215, 282,
-	0, 0, 663, 359, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	-2, -2, -2, -2, -2, -2, -2, -2, 746, 435,
+	436, 441, 442, 444, 351, 349, 380, 381, 0, 0,
+	702, 700, 701, 24, 247, 30, 0, 247, 247, 0,
+	0, 0, 0, 0, 0, 0, 219, 714, 0, 697,
+	691, 692, 751, 752, 753, 754, 755, 915, 916, 917,
+	918, 919, 920, 921, 922, 923, 924, 925, 926, 927,
+	928, 929, 930, 931, 932, 933, 934, 935, 936, 937,
+	938, 939, 940, 941, 942, 943, 944, 945, 946, 947,
+	948, 949, 950, 951, 952, 953, 954, 955, 956, 957,
+	958, 959, 960, 961, 962, 963, 964, 965, 966, 967,
+	968, 969, 970, 971, 972, 973, 974, 975, 976, 977,
+	978, 979, 980, 981, 982, 983, 984, 985, 986, 987,
+	988, 989, 990, 991, 992, 693, 0, 389, 0, 266,
+	0, 386, 0, 0, 62, 49, 50, 0, 0, 0,
+	318, 0, 0, 89, 0, 220, 719, 0, 0, 104,
+	106, 113, 115, 116, 117, 123, 124, 125, 126, 214,
+	0, 216, 136, 137, 736, 0, 105, 107, 109, 110,
+	127, 128, 0, 130, 131, 132, 452, 0, 70, 140,
+	141, 143, 0, 153, 144, 0, 151, 149, 829, 257,
+	222, 0, 162, 0, 0, 877, 742, 0, 296, 293,
+	333, 334, 299, 337, 340, 341, 338, 469, 304, 305,
+	306, 329, 330, 236, 311, 0, 0, 389, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 645, 0, 0, 656, 438, 439, 440,
-	461, 462, 0, -2, 594, 0, 520, 521, 522, 0,
-	0, -2, 0, 674, 431, 0, 0, 635, 564, 0,
-	0, 0, 0, 0, 0, 0, 615, 621, 0, 0,
-	0, 0, 0, 0, 0, 0, 390, 403, 413, 411,
-	410, 392, 0, 391, 389, 0, 393, 0, 359, 0,
-	643, 637, 638, 639, 0, 0, 650, 0, 0, 0,
-	0, 225, 24, 806, 0, 34, 0, 0, 171, 0,
+	0, 0, 640, 641, 642, 0, 0, 0, 0, 0,
+	0, 643, 644, 645, 0, 0, 646, 0, 0, 0,
+	0, 699, 0, 0, 710, 473, 474, 475, 499, 500,
+	0, -2, 648, 0, 563, 564, 565, 0, 0, -2,
+	0, 730, 466, 0, 0, 689, 607, 0, 0, 0,
+	0, 0, 0, 0, 669, 675, 0, 0, 0, 0,
+	0, 0, 0, 0, 425, 438, 448, 446, 445, 427,
+	0, 426, 424, 0, 0, 428, 0, 389, 0, 0,
+	704, 0, 0, 0, 0, 246, 32, 877, 0, 42,
+	0, 0, 187, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 217, 0,
+	718, 694, 0, 59, 0, 273, 268, 0, 0, 0,
+	351, 278, 280, 281, 0, 0, 387, 61, 51, 67,
+	65, 73, 79, 0, 0, 0, 0, 262, 0, 0,
+	121, 122, 0, 215, 738, 737, 466, 71, 155, 97,
+	0, 0, 256, 384, 0, 161, 163, 165, 166, 167,
+	168, 743, 0, 743, 0, 0, 0, 0, 339, 342,
+	343, 0, 332, 234, 235, 318, 721, 345, 350, 352,
+	370, 370, 370, 357, 0, 577, 578, 0, 708, 470,
+	395, 396, 397, 398, 399, 466, 402, 403, 404, 405,
+	406, 407, 408, 417, 419, 420, 421, 422, 423, 432,
+	0, 416, 416, 416, 429, 430, 433, 434, 439, 440,
+	450, 451, 449, 449, 449, 447, 471, 0, 476, 477,
+	478, 479, 480, 481, 482, 483, 484, -2, -2, -2,
+	488, 489, 490, 491, 492, 493, -2, -2, -2, 497,
+	498, -2, 647, 0, 699, 0, 0, 0, 637, 638,
+	639, 506, 0, 509, 511, 513, 0, 0, 0, 0,
+	698, 523, 681, 0, 709, 508, 0, 0, 558, 0,
+	0, 0, 654, 648, 655, 0, -2, 568, 328, 0,
+	0, 0, 0, 731, 453, 454, 455, 456, 457, 458,
+	467, 0, 688, 684, 0, 615, 0, 0, 0, 581,
+	583, 0, 0, 0, 658, 659, 660, 661, 662, 663,
+	664, 0, 0, 0, 0, 525, 0, 0, 0, 0,
+	891, 0, 648, 674, 0, 0, 0, 0, 0, 648,
+	0, 680, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 345, 382, 703, 31, 238, 0, 0,
+	0, 34, 877, 171, 0, 0, 0, 0, 0, 249,
+	43, 877, 51, 877, 51, 44, 25, 247, 27, 28,
+	29, 26, 237, 240, 0, 0, 0, 388, 265, 0,
+	0, 270, 267, 389, 0, 0, 0, 63, 81, 0,
+	720, 82, 118, 119, 120, 114, 129, 0, 145, 146,
+	160, 0, 0, 743, 0, 0, 0, 0, 264, 331,
+	0, 313, 314, 315, 316, 347, 0, 0, 0, 0,
+	0, 0, 376, 376, 376, 374, 354, 369, 0, 368,
+	355, 356, -2, 357, 0, 0, 390, 392, 400, 0,
+	0, 0, -2, 0, 0, 0, 524, -2, 0, 507,
+	510, 512, 514, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 559, 649, 0, 652, 653, 0, -2, 0,
+	0, 327, 328, 328, 328, 574, 0, 0, 0, 0,
+	0, 0, 0, 685, 0, 0, 575, 0, 0, 0,
+	0, 0, 589, 0, 590, 0, 0, 591, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 51, 0, 247, 242, 0, 0, 0, 322,
-	252, 254, 255, 0, 0, 357, 53, 660, 43, 59,
-	57, 65, 71, 0, 76, 77, 0, 236, 0, 0,
-	113, 114, 0, 199, 682, 681, 431, 63, 144, 89,
-	354, 0, 148, 150, 152, 153, 154, 687, 0, 0,
-	0, 0, 0, 310, 313, 314, 0, 303, 213, 214,
-	289, 0, 665, 316, 321, 323, 340, 340, 327, 0,
-	654, 435, 365, 366, 367, 368, 369, 431, 372, 373,
-	374, 375, 383, 384, 385, 386, 387, 388, 397, 0,
-	382, 382, 382, 394, 395, 398, 399, 404, 405, 415,
-	416, 414, 414, 414, 412, 436, 0, 441, 442, 443,
-	444, 445, 446, 447, 448, 449, -2, -2, -2, 453,
-	454, 455, -2, -2, -2, 459, 460, -2, 0, 0,
-	645, 0, 0, 467, 0, 470, 472, 474, 0, 0,
-	0, 0, 644, 484, 627, 0, 655, 469, 0, 0,
-	516, 0, 0, 0, 600, 594, 601, 0, -2, 525,
-	299, 0, 0, 0, 0, 675, 418, 419, 420, 421,
-	422, 423, 432, 0, 634, 630, 0, 572, 0, 0,
-	0, 538, 540, 0, 0, 0, 604, 605, 606, 607,
-	608, 609, 610, 0, 0, 0, 0, 486, 0, 0,
-	0, 0, 820, 0, 594, 620, 0, 0, 0, 0,
-	0, 594, 0, 626, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 316, 352, 640, 0, 649, 23,
-	217, 0, 0, 0, 26, 806, 157, 0, 0, 0,
-	0, 0, 228, 35, 806, 43, 806, 43, 36, 21,
-	226, 216, 219, 0, 358, 239, 0, 0, 244, 241,
-	359, 0, 0, 0, 55, 73, 74, 110, 111, 112,
-	106, 121, 0, 146, 0, 0, 687, 0, 0, 0,
-	238, 302, 0, 284, 285, 286, 287, 664, 318, 0,
-	0, 0, 0, 0, 0, 346, 346, 346, 344, 325,
-	339, 0, 338, 326, -2, 327, 0, 360, 362, 370,
-	0, 0, -2, 0, 0, 0, 485, -2, 468, 471,
-	473, 475, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 595, 0, 598, 599, 0, -2, 0, 0, 298,
-	299, 299, 299, 531, 0, 0, 0, 0, 0, 0,
-	0, 631, 0, 0, 532, 0, 0, 0, 0, 0,
-	546, 0, 547, 0, 0, 548, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 488, 489, 490,
-	0, 0, 549, 618, 619, 0, 0, 0, 0, 0,
-	0, 554, 0, 625, 0, 0, 0, 558, 559, 560,
-	0, 380, 0, 396, 408, 318, 0, 0, 0, 0,
-	0, 155, 170, 0, 0, 0, 0, 28, 0, 0,
-	0, 0, 32, 0, 38, 0, 0, 0, 0, 250,
-	0, 251, 253, 256, 0, 90, 151, 0, 0, 149,
-	0, 0, 283, 566, 0, 0, 324, 0, 0, 0,
-	0, 0, 341, 345, 342, 343, 336, 0, 329, 0,
-	0, 0, 437, -2, 0, 0, 0, 0, -2, 0,
-	596, 0, 0, 628, 0, 589, 0, -2, 595, 602,
-	526, 0, 0, 0, 0, 424, 425, 426, 427, 428,
-	429, 430, 0, 676, 629, 633, 0, 570, 571, 575,
-	0, 0, 536, 0, 603, 612, 613, 487, 491, 492,
-	493, 494, 495, 496, 497, 498, 499, -2, -2, -2,
-	503, 504, 505, -2, -2, -2, 0, 0, 0, 614,
-	0, 0, 592, 616, 617, 622, 623, 0, 551, 552,
-	553, 624, 0, 0, 0, 402, 0, 566, 641, 221,
-	223, 25, 0, 156, 0, 159, 0, 0, 162, 163,
-	0, 0, 0, 0, 172, 179, 0, 0, 0, 40,
-	0, 0, 227, 0, 43, 43, 218, 0, 0, 220,
-	0, 0, 0, 243, 0, 0, 0, 0, 0, 206,
-	212, 212, 0, 534, 535, 0, 0, 271, 0, 317,
-	315, 330, 0, 332, 0, 0, 0, 334, 0, 0,
-	328, 363, 0, 0, 371, 0, 0, -2, 0, 478,
-	0, -2, -2, 588, 595, 673, 299, 527, 529, 530,
-	433, 632, 577, 574, 0, 561, 545, 611, 0, 0,
-	0, 0, 595, 591, 550, 0, 556, 557, 381, 272,
-	27, 0, 160, 161, 164, 0, 166, 181, 173, 0,
-	176, 177, 174, 0, 29, 30, 39, 45, 31, 37,
-	0, 0, 0, 0, 0, 257, 258, 0, 179, 0,
-	147, 179, 0, 215, 668, 0, 215, 0, 0, 0,
-	565, 567, 0, 331, 0, 348, 333, 0, 337, 364,
-	361, -2, -2, 479, 597, 590, 0, 299, 0, 563,
-	-2, 0, 0, 0, 593, 0, 158, 0, 185, 0,
-	0, 0, 47, 0, 222, 224, 0, 246, 359, 249,
-	168, 179, 202, 207, 208, 211, 209, 212, 299, 0,
-	0, 0, 0, 335, 528, 580, 0, -2, 0, 511,
-	555, 165, 190, 0, 0, 0, 181, 33, 0, 44,
-	0, 248, 169, 215, 0, 0, 568, 569, 347, 0,
-	0, 0, 576, 512, 167, 186, 187, 0, 182, 183,
-	184, 180, 178, 185, 46, 359, 210, 526, 179, 573,
-	578, 581, -2, 777, 714, 0, 579, 188, 0, 189,
-	0, 0, 0, 190, 245, 203, 0, 583, 584, 585,
-	586, 587, 191, 0, 194, 195, 0, 192, 175, 0,
-	193, 196, 197, 582,
+	0, 0, 527, 528, 529, 0, 0, 592, 672, 673,
+	0, 0, 0, 0, 0, 0, 597, 0, 679, 0,
+	0, 0, 601, 602, 603, 0, 414, 0, 431, 418,
+	443, 347, 0, 0, 0, 0, 169, 186, 0, 0,
+	227, 233, 233, 0, 0, 0, 36, 0, 0, 0,
+	0, 40, 0, 46, 0, 0, 0, 0, 218, 695,
+	276, 0, 277, 279, 282, 0, 0, 98, 157, 0,
+	164, 0, 0, 0, 162, 0, 0, 312, 609, 0,
+	0, 353, 0, 0, 0, 0, 0, 371, 375, 372,
+	373, 366, 0, 359, 328, 0, 0, 0, 0, 472,
+	-2, 0, 0, 0, 0, 0, -2, 0, 650, 0,
+	0, 682, 0, 632, 0, -2, 649, 656, 569, 0,
+	0, 0, 0, 459, 460, 461, 462, 463, 464, 465,
+	0, 732, 683, 687, 0, 613, 614, 618, 0, 0,
+	579, 0, 657, 666, 667, 526, 530, 531, 532, 533,
+	534, 535, 536, 537, 538, -2, -2, -2, 542, 543,
+	544, 545, 546, 547, -2, -2, -2, 0, 0, 0,
+	668, 0, 0, 635, 670, 671, 676, 677, 0, 594,
+	595, 596, 678, 0, 0, 0, 437, 0, 609, 242,
+	244, 33, 0, 170, 0, 173, 0, 0, 176, 177,
+	0, 0, 0, 0, 0, 188, 195, 0, 236, 724,
+	0, 236, 0, 0, 0, 0, 48, 0, 0, 248,
+	0, 51, 51, 239, 0, 0, 241, 0, 0, 0,
+	269, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	300, 0, 346, 344, 360, 0, 362, 0, 0, 0,
+	364, 0, 0, 358, 0, 393, 0, 0, 401, 409,
+	0, 0, 505, -2, 0, 517, 0, -2, -2, 631,
+	649, 729, 328, 570, 572, 573, 468, 686, 620, 617,
+	0, 604, 588, 665, 0, 0, 0, 0, 649, 634,
+	593, 0, 599, 600, 415, 301, 35, 0, 174, 175,
+	178, 0, 180, 0, 197, 389, 0, 192, 193, 228,
+	229, 232, 230, 233, 190, 0, 37, 38, 47, 53,
+	39, 45, 0, 0, 0, 0, 0, 283, 284, 0,
+	0, 0, 195, 0, 185, 160, 195, 0, 608, 610,
+	0, 361, 0, 378, 363, 0, 367, 569, 394, 391,
+	-2, -2, 518, 651, 633, 0, 328, 0, 606, -2,
+	0, 0, 0, 636, 0, 172, 0, 0, 201, 0,
+	189, 0, 236, 0, 55, 0, 243, 245, 0, 272,
+	389, 275, 0, 0, 389, 195, 158, 389, 0, 0,
+	0, 0, 365, 571, 623, 0, -2, 0, 553, 598,
+	179, 0, 206, 0, 0, 0, 231, 197, 41, 0,
+	52, 0, 274, 221, 0, 183, 389, 223, 0, 611,
+	612, 377, 0, 0, 0, 619, 554, 181, 182, 202,
+	203, 0, 198, 199, 200, 196, 194, 201, 54, 389,
+	0, 184, 195, 616, 621, 624, -2, 847, 774, 0,
+	622, 204, 0, 205, 0, 0, 0, 206, 271, 159,
+	389, 0, 626, 627, 628, 629, 630, 207, 0, 210,
+	211, 0, 208, 191, 224, 0, 209, 212, 213, 625,
 }
 var sqlTok1 = [...]int{
 
 	1, 3, 3, 3, 3, 3, 3, 3, 3, 3,
 	3, 3, 3, 3, 3, 3, 3, 3, 3, 3,
 	3, 3, 3, 3, 3, 3, 3, 3, 3, 3,
-	3, 3, 3, 3, 3, 249, 3, 255, 250, 3,
-	260, 261, 253, 251, 264, 252, 262, 254, 3, 3,
-	3, 3, 3, 3, 3, 3, 3, 3, 266, 263,
-	243, 245, 244, 3, 265, 3, 3, 3, 3, 3,
+	3, 3, 3, 3, 3, 268, 3, 274, 269, 3,
+	279, 280, 272, 270, 283, 271, 281, 273, 3, 3,
+	3, 3, 3, 3, 3, 3, 3, 3, 285, 282,
+	262, 264, 263, 3, 284, 3, 3, 3, 3, 3,
 	3, 3, 3, 3, 3, 3, 3, 3, 3, 3,
 	3, 3, 3, 3, 3, 3, 3, 3, 3, 3,
-	3, 258, 3, 259, 248, 3, 3, 3, 3, 3,
+	3, 277, 3, 278, 267, 3, 3, 3, 3, 3,
 	3, 3, 3, 3, 3, 3, 3, 3, 3, 3,
 	3, 3, 3, 3, 3, 3, 3, 3, 3, 3,
-	3, 3, 3, 3, 247, 3, 257,
+	3, 3, 3, 3, 266, 3, 276,
 }
 var sqlTok2 = [...]int{
 
@@ -3472,10 +3867,12 @@ var sqlTok2 = [...]int{
 	212, 213, 214, 215, 216, 217, 218, 219, 220, 221,
 	222, 223, 224, 225, 226, 227, 228, 229, 230, 231,
 	232, 233, 234, 235, 236, 237, 238, 239, 240, 241,
-	242, 246, 256,
+	242, 243, 244, 245, 246, 247, 248, 249, 250, 251,
+	252, 253, 254, 255, 256, 257,
 }
 var sqlTok3 = [...]int{
-	0,
+	57600, 258, 57601, 259, 57602, 260, 57603, 261, 57604, 265,
+	57605, 275, 0,
 }
 
 var sqlErrorMessages = [...]struct {
@@ -3820,13 +4217,13 @@ sqldefault:
 
 	case 1:
 		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
-		//line sql.y:439
+		//line sql.y:470
 		{
 			sqllex.(*scanner).stmts = sqlDollar[1].stmts
 		}
 	case 2:
 		sqlDollar = sqlS[sqlpt-3 : sqlpt+1]
-		//line sql.y:445
+		//line sql.y:476
 		{
 			if sqlDollar[3].stmt != nil {
 				sqlVAL.stmts = append(sqlDollar[1].stmts, sqlDollar[3].stmt)
@@ -3834,7 +4231,7 @@ sqldefault:
 		}
 	case 3:
 		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
-		//line sql.y:451
+		//line sql.y:482
 		{
 			if sqlDollar[1].stmt != nil {
 				sqlVAL.stmts = []Statement{sqlDollar[1].stmt}
@@ -3842,549 +4239,574 @@ sqldefault:
 				sqlVAL.stmts = nil
 			}
 		}
-	case 13:
+	case 17:
 		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
-		//line sql.y:470
+		//line sql.y:505
 		{
 			sqlVAL.stmt = sqlDollar[1].selectStmt
 		}
-	case 19:
+	case 23:
 		sqlDollar = sqlS[sqlpt-0 : sqlpt+1]
-		//line sql.y:479
+		//line sql.y:514
 		{
 			sqlVAL.stmt = nil
 		}
-	case 20:
+	case 24:
 		sqlDollar = sqlS[sqlpt-4 : sqlpt+1]
-		//line sql.y:485
+		//line sql.y:520
 		{
 			sqlVAL.stmt = &AlterTable{Table: sqlDollar[3].qname, IfExists: false, Cmds: sqlDollar[4].alterTableCmds}
 		}
-	case 21:
+	case 25:
 		sqlDollar = sqlS[sqlpt-6 : sqlpt+1]
-		//line sql.y:489
+		//line sql.y:524
 		{
 			sqlVAL.stmt = &AlterTable{Table: sqlDollar[5].qname, IfExists: true, Cmds: sqlDollar[6].alterTableCmds}
 		}
-	case 22:
+	case 26:
+		sqlDollar = sqlS[sqlpt-6 : sqlpt+1]
+		//line sql.y:532
+		{
+			sqlVAL.stmt = &SetZoneConfig{ZoneSpecifier: ZoneSpecifier{Database: Name(sqlDollar[3].str)}, YAMLConfig: sqlDollar[6].expr}
+		}
+	case 27:
+		sqlDollar = sqlS[sqlpt-6 : sqlpt+1]
+		//line sql.y:536
+		{
+			sqlVAL.stmt = &SetZoneConfig{ZoneSpecifier: ZoneSpecifier{Table: sqlDollar[3].qname}, YAMLConfig: sqlDollar[6].expr}
+		}
+	case 28:
+		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
+		//line sql.y:543
+		{
+			sqlVAL.expr = DString(sqlDollar[1].str)
+		}
+	case 29:
+		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
+		//line sql.y:547
+		{
+			sqlVAL.expr = DNull
+		}
+	case 30:
 		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
-		//line sql.y:495
+		//line sql.y:553
 		{
 			sqlVAL.alterTableCmds = AlterTableCmds{sqlDollar[1].alterTableCmd}
 		}
-	case 23:
+	case 31:
 		sqlDollar = sqlS[sqlpt-3 : sqlpt+1]
-		//line sql.y:499
+		//line sql.y:557
 		{
 			sqlVAL.alterTableCmds = append(sqlDollar[1].alterTableCmds, sqlDollar[3].alterTableCmd)
 		}
-	case 24:
+	case 32:
 		sqlDollar = sqlS[sqlpt-2 : sqlpt+1]
-		//line sql.y:506
+		//line sql.y:564
 		{
 			sqlVAL.alterTableCmd = &AlterTableAddColumn{columnKeyword: false, IfNotExists: false, ColumnDef: sqlDollar[2].colDef}
 		}
-	case 25:
+	case 33:
 		sqlDollar = sqlS[sqlpt-5 : sqlpt+1]
-		//line sql.y:511
+		//line sql.y:569
 		{
 			sqlVAL.alterTableCmd = &AlterTableAddColumn{columnKeyword: false, IfNotExists: true, ColumnDef: sqlDollar[5].colDef}
 		}
-	case 26:
+	case 34:
 		sqlDollar = sqlS[sqlpt-3 : sqlpt+1]
-		//line sql.y:516
+		//line sql.y:574
 		{
 			sqlVAL.alterTableCmd = &AlterTableAddColumn{columnKeyword: true, IfNotExists: false, ColumnDef: sqlDollar[3].colDef}
 		}
-	case 27:
+	case 35:
 		sqlDollar = sqlS[sqlpt-6 : sqlpt+1]
-		//line sql.y:521
+		//line sql.y:579
 		{
 			sqlVAL.alterTableCmd = &AlterTableAddColumn{columnKeyword: true, IfNotExists: true, ColumnDef: sqlDollar[6].colDef}
 		}
-	case 28:
+	case 36:
 		sqlDollar = sqlS[sqlpt-4 : sqlpt+1]
-		//line sql.y:525
+		//line sql.y:584
 		{
-			unimplemented()
+			sqlVAL.alterTableCmd = &AlterTableSetDefault{columnKeyword: sqlDollar[2].boolVal, Column: sqlDollar[3].str, Default: sqlDollar[4].expr}
 		}
-	case 29:
+	case 37:
 		sqlDollar = sqlS[sqlpt-6 : sqlpt+1]
-		//line sql.y:527
+		//line sql.y:589
 		{
-			unimplemented()
+			sqlVAL.alterTableCmd = &AlterTableDropNotNull{columnKeyword: sqlDollar[2].boolVal, Column: sqlDollar[3].str}
 		}
-	case 30:
+	case 38:
 		sqlDollar = sqlS[sqlpt-6 : sqlpt+1]
-		//line sql.y:529
+		//line sql.y:594
 		{
-			unimplemented()
+			sqlVAL.alterTableCmd = &AlterTableSetNotNull{columnKeyword: sqlDollar[2].boolVal, Column: sqlDollar[3].str}
 		}
-	case 31:
+	case 39:
 		sqlDollar = sqlS[sqlpt-6 : sqlpt+1]
-		//line sql.y:532
+		//line sql.y:599
 		{
 			sqlVAL.alterTableCmd = &AlterTableDropColumn{columnKeyword: sqlDollar[2].boolVal, IfExists: true, Column: sqlDollar[5].str}
 		}
-	case 32:
+	case 40:
 		sqlDollar = sqlS[sqlpt-4 : sqlpt+1]
-		//line sql.y:537
+		//line sql.y:604
 		{
 			sqlVAL.alterTableCmd = &AlterTableDropColumn{columnKeyword: sqlDollar[2].boolVal, IfExists: false, Column: sqlDollar[3].str}
 		}
-	case 33:
+	case 41:
 		sqlDollar = sqlS[sqlpt-8 : sqlpt+1]
-		//line sql.y:542
+		//line sql.y:610
 		{
+			sqlVAL.alterTableCmd = &AlterTableAlterColumnType{columnKeyword: sqlDollar[2].boolVal, Column: sqlDollar[3].str, Type: sqlDollar[6].colType}
 		}
-	case 34:
+	case 42:
 		sqlDollar = sqlS[sqlpt-2 : sqlpt+1]
-		//line sql.y:545
+		//line sql.y:615
 		{
 			sqlVAL.alterTableCmd = &AlterTableAddConstraint{ConstraintDef: sqlDollar[2].constraintDef}
 		}
-	case 35:
+	case 43:
 		sqlDollar = sqlS[sqlpt-3 : sqlpt+1]
-		//line sql.y:549
+		//line sql.y:619
 		{
 			unimplemented()
 		}
-	case 36:
+	case 44:
 		sqlDollar = sqlS[sqlpt-3 : sqlpt+1]
-		//line sql.y:551
+		//line sql.y:621
 		{
 			unimplemented()
 		}
-	case 37:
+	case 45:
 		sqlDollar = sqlS[sqlpt-6 : sqlpt+1]
-		//line sql.y:554
+		//line sql.y:624
 		{
 			sqlVAL.alterTableCmd = &AlterTableDropConstraint{IfExists: true, Constraint: sqlDollar[5].str}
 		}
-	case 38:
+	case 46:
 		sqlDollar = sqlS[sqlpt-4 : sqlpt+1]
-		//line sql.y:559
+		//line sql.y:629
 		{
 			sqlVAL.alterTableCmd = &AlterTableDropConstraint{IfExists: false, Constraint: sqlDollar[3].str}
 		}
-	case 39:
+	case 47:
 		sqlDollar = sqlS[sqlpt-3 : sqlpt+1]
-		//line sql.y:564
+		//line sql.y:635
 		{
-			unimplemented()
+			sqlVAL.expr = sqlDollar[3].expr
 		}
-	case 40:
+	case 48:
 		sqlDollar = sqlS[sqlpt-2 : sqlpt+1]
-		//line sql.y:565
+		//line sql.y:639
 		{
-			unimplemented()
+			sqlVAL.expr = nil
 		}
-	case 41:
+	case 49:
 		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
-		//line sql.y:568
+		//line sql.y:644
 		{
 			unimplemented()
 		}
-	case 42:
+	case 50:
 		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
-		//line sql.y:569
+		//line sql.y:645
 		{
 			unimplemented()
 		}
-	case 43:
+	case 51:
 		sqlDollar = sqlS[sqlpt-0 : sqlpt+1]
-		//line sql.y:570
+		//line sql.y:646
 		{
 		}
-	case 44:
+	case 52:
 		sqlDollar = sqlS[sqlpt-2 : sqlpt+1]
-		//line sql.y:573
+		//line sql.y:649
 		{
 			unimplemented()
 		}
-	case 45:
+	case 53:
 		sqlDollar = sqlS[sqlpt-0 : sqlpt+1]
-		//line sql.y:574
+		//line sql.y:650
 		{
 		}
-	case 46:
+	case 54:
 		sqlDollar = sqlS[sqlpt-2 : sqlpt+1]
-		//line sql.y:577
+		//line sql.y:653
 		{
 			unimplemented()
 		}
-	case 47:
+	case 55:
 		sqlDollar = sqlS[sqlpt-0 : sqlpt+1]
-		//line sql.y:578
+		//line sql.y:654
 		{
 		}
-	case 51:
+	case 59:
 		sqlDollar = sqlS[sqlpt-5 : sqlpt+1]
-		//line sql.y:589
+		//line sql.y:665
 		{
 			sqlVAL.stmt = &Delete{Table: sqlDollar[4].tblExpr, Where: newWhere(astWhere, sqlDollar[5].expr)}
 		}
-	case 52:
+	case 60:
 		sqlDollar = sqlS[sqlpt-3 : sqlpt+1]
-		//line sql.y:596
+		//line sql.y:672
 		{
 			sqlVAL.stmt = &DropDatabase{Name: Name(sqlDollar[3].str), IfExists: false}
 		}
-	case 53:
+	case 61:
 		sqlDollar = sqlS[sqlpt-5 : sqlpt+1]
-		//line sql.y:600
+		//line sql.y:676
 		{
 			sqlVAL.stmt = &DropDatabase{Name: Name(sqlDollar[5].str), IfExists: true}
 		}
-	case 54:
+	case 62:
 		sqlDollar = sqlS[sqlpt-4 : sqlpt+1]
-		//line sql.y:604
+		//line sql.y:680
 		{
 			sqlVAL.stmt = &DropIndex{Names: sqlDollar[3].qnames, IfExists: false}
 		}
-	case 55:
+	case 63:
 		sqlDollar = sqlS[sqlpt-6 : sqlpt+1]
-		//line sql.y:608
+		//line sql.y:684
 		{
 			sqlVAL.stmt = &DropIndex{Names: sqlDollar[5].qnames, IfExists: true}
 		}
-	case 56:
+	case 64:
 		sqlDollar = sqlS[sqlpt-3 : sqlpt+1]
-		//line sql.y:612
+		//line sql.y:688
 		{
 			sqlVAL.stmt = &DropTable{Names: sqlDollar[3].qnames, IfExists: false}
 		}
-	case 57:
+	case 65:
 		sqlDollar = sqlS[sqlpt-5 : sqlpt+1]
-		//line sql.y:616
+		//line sql.y:692
 		{
 			sqlVAL.stmt = &DropTable{Names: sqlDollar[5].qnames, IfExists: true}
 		}
-	case 58:
+	case 66:
 		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
-		//line sql.y:622
+		//line sql.y:698
 		{
 			sqlVAL.qnames = QualifiedNames{sqlDollar[1].qname}
 		}
-	case 59:
+	case 67:
 		sqlDollar = sqlS[sqlpt-3 : sqlpt+1]
-		//line sql.y:626
+		//line sql.y:702
 		{
 			sqlVAL.qnames = append(sqlDollar[1].qnames, sqlDollar[3].qname)
 		}
-	case 60:
+	case 68:
 		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
-		//line sql.y:632
+		//line sql.y:708
 		{
 			sqlVAL.qname = &QualifiedName{Base: Name(sqlDollar[1].str)}
 		}
-	case 61:
+	case 69:
 		sqlDollar = sqlS[sqlpt-2 : sqlpt+1]
-		//line sql.y:636
+		//line sql.y:712
 		{
 			sqlVAL.qname = &QualifiedName{Base: Name(sqlDollar[1].str), Indirect: sqlDollar[2].indirect}
 		}
-	case 62:
+	case 70:
 		sqlDollar = sqlS[sqlpt-2 : sqlpt+1]
-		//line sql.y:642
+		//line sql.y:718
 		{
 			sqlVAL.indirect = Indirection{NameIndirection(sqlDollar[2].str)}
 		}
-	case 63:
+	case 71:
 		sqlDollar = sqlS[sqlpt-3 : sqlpt+1]
-		//line sql.y:646
+		//line sql.y:722
 		{
 			sqlVAL.indirect = append(sqlDollar[1].indirect, NameIndirection(sqlDollar[3].str))
 		}
-	case 64:
+	case 72:
 		sqlDollar = sqlS[sqlpt-2 : sqlpt+1]
-		//line sql.y:653
+		//line sql.y:729
 		{
 			sqlVAL.stmt = &Explain{Statement: sqlDollar[2].stmt}
 		}
-	case 65:
+	case 73:
 		sqlDollar = sqlS[sqlpt-5 : sqlpt+1]
-		//line sql.y:657
+		//line sql.y:733
 		{
 			sqlVAL.stmt = &Explain{Options: sqlDollar[3].strs, Statement: sqlDollar[5].stmt}
 		}
-	case 66:
+	case 74:
 		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
-		//line sql.y:663
+		//line sql.y:739
 		{
 			sqlVAL.stmt = sqlDollar[1].selectStmt
 		}
-	case 70:
+	case 78:
 		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
-		//line sql.y:672
+		//line sql.y:748
 		{
 			sqlVAL.strs = []string{sqlDollar[1].str}
 		}
-	case 71:
+	case 79:
 		sqlDollar = sqlS[sqlpt-3 : sqlpt+1]
-		//line sql.y:676
+		//line sql.y:752
 		{
 			sqlVAL.strs = append(sqlDollar[1].strs, sqlDollar[3].str)
 		}
-	case 73:
+	case 81:
 		sqlDollar = sqlS[sqlpt-6 : sqlpt+1]
-		//line sql.y:686
+		//line sql.y:762
 		{
 			sqlVAL.stmt = &Grant{Privileges: sqlDollar[2].privilegeList, Grantees: NameList(sqlDollar[6].strs), Targets: sqlDollar[4].targetList}
 		}
-	case 74:
+	case 82:
 		sqlDollar = sqlS[sqlpt-6 : sqlpt+1]
-		//line sql.y:693
+		//line sql.y:769
 		{
 			sqlVAL.stmt = &Revoke{Privileges: sqlDollar[2].privilegeList, Grantees: NameList(sqlDollar[6].strs), Targets: sqlDollar[4].targetList}
 		}
-	case 75:
+	case 83:
 		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
-		//line sql.y:700
+		//line sql.y:776
 		{
 			sqlVAL.targetList = TargetList{Tables: QualifiedNames(sqlDollar[1].qnames)}
 		}
-	case 76:
+	case 84:
 		sqlDollar = sqlS[sqlpt-2 : sqlpt+1]
-		//line sql.y:704
+		//line sql.y:780
 		{
 			// TODO(marc): this is postgres' grammar, but do we really need
 			// both "x" and "TABLE X"?
 			sqlVAL.targetList = TargetList{Tables: QualifiedNames(sqlDollar[2].qnames)}
 		}
-	case 77:
+	case 85:
 		sqlDollar = sqlS[sqlpt-2 : sqlpt+1]
-		//line sql.y:710
+		//line sql.y:786
 		{
 			sqlVAL.targetList = TargetList{Databases: NameList(sqlDollar[2].strs)}
 		}
-	case 78:
+	case 86:
 		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
-		//line sql.y:717
+		//line sql.y:793
 		{
 			sqlVAL.privilegeList = privilege.List{privilege.ALL}
 		}
-	case 79:
+	case 87:
 		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
-		//line sql.y:720
+		//line sql.y:796
 		{
 		}
-	case 80:
+	case 88:
 		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
-		//line sql.y:724
+		//line sql.y:800
 		{
 			sqlVAL.privilegeList = privilege.List{sqlDollar[1].privilegeType}
 		}
-	case 81:
+	case 89:
 		sqlDollar = sqlS[sqlpt-3 : sqlpt+1]
-		//line sql.y:728
+		//line sql.y:804
 		{
 			sqlVAL.privilegeList = append(sqlDollar[1].privilegeList, sqlDollar[3].privilegeType)
 		}
-	case 82:
+	case 90:
 		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
-		//line sql.y:735
+		//line sql.y:811
 		{
 			sqlVAL.privilegeType = privilege.CREATE
 		}
-	case 83:
+	case 91:
 		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
-		//line sql.y:739
+		//line sql.y:815
 		{
 			sqlVAL.privilegeType = privilege.DROP
 		}
-	case 84:
+	case 92:
 		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
-		//line sql.y:743
+		//line sql.y:819
 		{
 			sqlVAL.privilegeType = privilege.GRANT
 		}
-	case 85:
+	case 93:
 		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
-		//line sql.y:747
+		//line sql.y:823
 		{
 			sqlVAL.privilegeType = privilege.SELECT
 		}
-	case 86:
+	case 94:
 		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
-		//line sql.y:751
+		//line sql.y:827
 		{
 			sqlVAL.privilegeType = privilege.INSERT
 		}
-	case 87:
+	case 95:
 		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
-		//line sql.y:755
+		//line sql.y:831
 		{
 			sqlVAL.privilegeType = privilege.DELETE
 		}
-	case 88:
+	case 96:
 		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
-		//line sql.y:759
+		//line sql.y:835
 		{
 			sqlVAL.privilegeType = privilege.UPDATE
 		}
-	case 89:
+	case 97:
 		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
-		//line sql.y:767
+		//line sql.y:843
 		{
 			sqlVAL.strs = []string{sqlDollar[1].str}
 		}
-	case 90:
+	case 98:
 		sqlDollar = sqlS[sqlpt-3 : sqlpt+1]
-		//line sql.y:771
+		//line sql.y:847
 		{
 			sqlVAL.strs = append(sqlDollar[1].strs, sqlDollar[3].str)
 		}
-	case 91:
+	case 99:
 		sqlDollar = sqlS[sqlpt-2 : sqlpt+1]
-		//line sql.y:779
+		//line sql.y:855
 		{
 			sqlVAL.stmt = sqlDollar[2].stmt
 		}
-	case 92:
+	case 100:
 		sqlDollar = sqlS[sqlpt-3 : sqlpt+1]
-		//line sql.y:783
+		//line sql.y:859
 		{
 			sqlVAL.stmt = sqlDollar[3].stmt
 		}
-	case 93:
+	case 101:
 		sqlDollar = sqlS[sqlpt-3 : sqlpt+1]
-		//line sql.y:787
+		//line sql.y:863
 		{
 			sqlVAL.stmt = sqlDollar[3].stmt
 		}
-	case 94:
+	case 102:
 		sqlDollar = sqlS[sqlpt-2 : sqlpt+1]
-		//line sql.y:793
+		//line sql.y:869
 		{
 			sqlVAL.stmt = &SetTransaction{Isolation: sqlDollar[2].isoLevel}
 		}
-	case 96:
+	case 104:
 		sqlDollar = sqlS[sqlpt-3 : sqlpt+1]
-		//line sql.y:800
+		//line sql.y:876
 		{
 			sqlVAL.stmt = &Set{Name: sqlDollar[1].qname, Values: sqlDollar[3].exprs}
 		}
-	case 97:
+	case 105:
 		sqlDollar = sqlS[sqlpt-3 : sqlpt+1]
-		//line sql.y:804
+		//line sql.y:880
 		{
 			sqlVAL.stmt = &Set{Name: sqlDollar[1].qname, Values: sqlDollar[3].exprs}
 		}
-	case 98:
+	case 106:
 		sqlDollar = sqlS[sqlpt-3 : sqlpt+1]
-		//line sql.y:808
+		//line sql.y:884
 		{
 			sqlVAL.stmt = &Set{Name: sqlDollar[1].qname}
 		}
-	case 99:
+	case 107:
 		sqlDollar = sqlS[sqlpt-3 : sqlpt+1]
-		//line sql.y:812
+		//line sql.y:888
 		{
 			sqlVAL.stmt = &Set{Name: sqlDollar[1].qname}
 		}
-	case 101:
+	case 109:
 		sqlDollar = sqlS[sqlpt-3 : sqlpt+1]
-		//line sql.y:819
+		//line sql.y:895
 		{
 			unimplemented()
 		}
-	case 102:
+	case 110:
 		sqlDollar = sqlS[sqlpt-3 : sqlpt+1]
-		//line sql.y:822
+		//line sql.y:898
 		{
 			sqlVAL.stmt = &SetTimeZone{Value: sqlDollar[3].expr}
 		}
-	case 103:
+	case 111:
 		sqlDollar = sqlS[sqlpt-2 : sqlpt+1]
-		//line sql.y:825
+		//line sql.y:901
 		{
 			unimplemented()
 		}
-	case 105:
+	case 113:
 		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
-		//line sql.y:832
+		//line sql.y:908
 		{
 			sqlVAL.exprs = []Expr{sqlDollar[1].expr}
 		}
-	case 106:
+	case 114:
 		sqlDollar = sqlS[sqlpt-3 : sqlpt+1]
-		//line sql.y:836
+		//line sql.y:912
 		{
 			sqlVAL.exprs = append(sqlDollar[1].exprs, sqlDollar[3].expr)
 		}
-	case 109:
+	case 117:
 		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
-		//line sql.y:844
+		//line sql.y:920
 		{
 			sqlVAL.expr = ValArg{name: sqlDollar[1].str}
 		}
-	case 110:
+	case 118:
 		sqlDollar = sqlS[sqlpt-2 : sqlpt+1]
-		//line sql.y:850
+		//line sql.y:926
 		{
 			// Mapped to the closest supported isolation level.
 			sqlVAL.isoLevel = SnapshotIsolation
 		}
-	case 111:
+	case 119:
 		sqlDollar = sqlS[sqlpt-2 : sqlpt+1]
-		//line sql.y:855
+		//line sql.y:931
 		{
 			// Mapped to the closest supported isolation level.
 			sqlVAL.isoLevel = SnapshotIsolation
 		}
-	case 112:
+	case 120:
 		sqlDollar = sqlS[sqlpt-2 : sqlpt+1]
-		//line sql.y:860
+		//line sql.y:936
 		{
 			// Mapped to the closest supported isolation level.
 			sqlVAL.isoLevel = SnapshotIsolation
 		}
-	case 113:
+	case 121:
 		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
-		//line sql.y:865
+		//line sql.y:941
 		{
 			sqlVAL.isoLevel = SnapshotIsolation
 		}
-	case 114:
+	case 122:
 		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
-		//line sql.y:869
+		//line sql.y:945
 		{
 			sqlVAL.isoLevel = SerializableIsolation
 		}
-	case 115:
+	case 123:
 		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
-		//line sql.y:875
+		//line sql.y:951
 		{
 			sqlVAL.expr = DBool(true)
 		}
-	case 116:
+	case 124:
 		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
-		//line sql.y:879
+		//line sql.y:955
 		{
 			sqlVAL.expr = DBool(false)
 		}
-	case 117:
+	case 125:
 		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
-		//line sql.y:883
+		//line sql.y:959
 		{
 			sqlVAL.expr = DString(sqlDollar[1].str)
 		}
-	case 119:
+	case 127:
 		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
-		//line sql.y:898
+		//line sql.y:974
 		{
 			sqlVAL.expr = DString(sqlDollar[1].str)
 		}
-	case 120:
+	case 128:
 		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
-		//line sql.y:902
+		//line sql.y:978
 		{
 			sqlVAL.expr = DString(sqlDollar[1].str)
 		}
-	case 121:
+	case 129:
 		sqlDollar = sqlS[sqlpt-3 : sqlpt+1]
-		//line sql.y:906
+		//line sql.y:982
 		{
 			// TODO(pmattis): support opt_interval?
 			expr := &CastExpr{Expr: DString(sqlDollar[2].str), Type: sqlDollar[1].colType}
@@ -4400,250 +4822,280 @@ sqldefault:
 			}
 			sqlVAL.expr = d
 		}
-	case 123:
+	case 131:
 		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
-		//line sql.y:923
+		//line sql.y:999
 		{
 			sqlVAL.expr = DString(sqlDollar[1].str)
 		}
-	case 124:
+	case 132:
 		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
-		//line sql.y:927
+		//line sql.y:1003
 		{
 			sqlVAL.expr = DString(sqlDollar[1].str)
 		}
-	case 125:
+	case 133:
 		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
-		//line sql.y:932
+		//line sql.y:1008
 		{
 			unimplemented()
 		}
-	case 126:
+	case 134:
 		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
-		//line sql.y:933
+		//line sql.y:1009
 		{
 			unimplemented()
 		}
-	case 127:
+	case 135:
 		sqlDollar = sqlS[sqlpt-0 : sqlpt+1]
-		//line sql.y:934
+		//line sql.y:1010
 		{
 		}
-	case 128:
+	case 136:
 		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
-		//line sql.y:938
+		//line sql.y:1014
 		{
 			sqlVAL.expr = DString(sqlDollar[1].str)
 		}
-	case 129:
+	case 137:
 		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
-		//line sql.y:942
+		//line sql.y:1018
 		{
 			sqlVAL.expr = DString(sqlDollar[1].str)
 		}
-	case 130:
+	case 138:
 		sqlDollar = sqlS[sqlpt-2 : sqlpt+1]
-		//line sql.y:948
+		//line sql.y:1024
 		{
 			sqlVAL.stmt = &Show{Name: sqlDollar[2].str}
 		}
-	case 131:
+	case 139:
 		sqlDollar = sqlS[sqlpt-2 : sqlpt+1]
-		//line sql.y:952
+		//line sql.y:1028
 		{
 			sqlVAL.stmt = &Show{Name: sqlDollar[2].str}
 		}
-	case 132:
+	case 140:
 		sqlDollar = sqlS[sqlpt-4 : sqlpt+1]
-		//line sql.y:956
+		//line sql.y:1032
 		{
 			sqlVAL.stmt = &ShowColumns{Table: sqlDollar[4].qname}
 		}
-	case 133:
+	case 141:
+		sqlDollar = sqlS[sqlpt-4 : sqlpt+1]
+		//line sql.y:1036
+		{
+			sqlVAL.stmt = &ShowCreateTable{Table: sqlDollar[4].qname}
+		}
+	case 142:
 		sqlDollar = sqlS[sqlpt-2 : sqlpt+1]
-		//line sql.y:960
+		//line sql.y:1040
 		{
 			sqlVAL.stmt = &ShowDatabases{}
 		}
-	case 134:
+	case 143:
 		sqlDollar = sqlS[sqlpt-4 : sqlpt+1]
-		//line sql.y:964
+		//line sql.y:1044
 		{
 			sqlVAL.stmt = &ShowGrants{Targets: sqlDollar[3].targetListPtr, Grantees: sqlDollar[4].strs}
 		}
-	case 135:
+	case 144:
 		sqlDollar = sqlS[sqlpt-4 : sqlpt+1]
-		//line sql.y:968
+		//line sql.y:1048
 		{
 			sqlVAL.stmt = &ShowIndex{Table: sqlDollar[4].qname}
 		}
-	case 136:
+	case 145:
+		sqlDollar = sqlS[sqlpt-6 : sqlpt+1]
+		//line sql.y:1052
+		{
+			sqlVAL.stmt = &ShowZoneConfig{ZoneSpecifier{Database: Name(sqlDollar[6].str)}}
+		}
+	case 146:
+		sqlDollar = sqlS[sqlpt-6 : sqlpt+1]
+		//line sql.y:1056
+		{
+			sqlVAL.stmt = &ShowZoneConfig{ZoneSpecifier{Table: sqlDollar[6].qname}}
+		}
+	case 147:
 		sqlDollar = sqlS[sqlpt-3 : sqlpt+1]
-		//line sql.y:972
+		//line sql.y:1060
 		{
 			sqlVAL.stmt = &ShowTables{Name: sqlDollar[3].qname}
 		}
-	case 137:
+	case 148:
 		sqlDollar = sqlS[sqlpt-3 : sqlpt+1]
-		//line sql.y:976
+		//line sql.y:1064
 		{
 			sqlVAL.stmt = &Show{Name: "TIME ZONE"}
 		}
-	case 138:
+	case 149:
 		sqlDollar = sqlS[sqlpt-4 : sqlpt+1]
-		//line sql.y:980
+		//line sql.y:1068
 		{
 			sqlVAL.stmt = &Show{Name: "TRANSACTION ISOLATION LEVEL"}
 		}
-	case 139:
+	case 150:
 		sqlDollar = sqlS[sqlpt-2 : sqlpt+1]
-		//line sql.y:984
+		//line sql.y:1072
 		{
 			sqlVAL.stmt = nil
 		}
-	case 140:
+	case 151:
 		sqlDollar = sqlS[sqlpt-2 : sqlpt+1]
-		//line sql.y:990
+		//line sql.y:1078
 		{
 			sqlVAL.qname = sqlDollar[2].qname
 		}
-	case 141:
+	case 152:
 		sqlDollar = sqlS[sqlpt-0 : sqlpt+1]
-		//line sql.y:994
+		//line sql.y:1082
 		{
 			sqlVAL.qname = nil
 		}
-	case 142:
+	case 153:
 		sqlDollar = sqlS[sqlpt-2 : sqlpt+1]
-		//line sql.y:1000
+		//line sql.y:1088
 		{
 			tmp := sqlDollar[2].targetList
 			sqlVAL.targetListPtr = &tmp
 		}
-	case 143:
+	case 154:
 		sqlDollar = sqlS[sqlpt-0 : sqlpt+1]
-		//line sql.y:1005
+		//line sql.y:1093
 		{
 			sqlVAL.targetListPtr = nil
 		}
-	case 144:
+	case 155:
 		sqlDollar = sqlS[sqlpt-2 : sqlpt+1]
-		//line sql.y:1011
+		//line sql.y:1099
 		{
 			sqlVAL.strs = sqlDollar[2].strs
 		}
-	case 145:
+	case 156:
 		sqlDollar = sqlS[sqlpt-0 : sqlpt+1]
-		//line sql.y:1015
+		//line sql.y:1103
 		{
 			sqlVAL.strs = nil
 		}
-	case 146:
-		sqlDollar = sqlS[sqlpt-6 : sqlpt+1]
-		//line sql.y:1022
+	case 157:
+		sqlDollar = sqlS[sqlpt-7 : sqlpt+1]
+		//line sql.y:1110
 		{
-			sqlVAL.stmt = &CreateTable{Table: sqlDollar[3].qname, IfNotExists: false, Defs: sqlDollar[5].tblDefs}
+			sqlVAL.stmt = &CreateTable{Table: sqlDollar[3].qname, IfNotExists: false, Defs: sqlDollar[5].tblDefs, Interleave: sqlDollar[7].interleave}
 		}
-	case 147:
-		sqlDollar = sqlS[sqlpt-9 : sqlpt+1]
-		//line sql.y:1026
+	case 158:
+		sqlDollar = sqlS[sqlpt-10 : sqlpt+1]
+		//line sql.y:1114
 		{
-			sqlVAL.stmt = &CreateTable{Table: sqlDollar[6].qname, IfNotExists: true, Defs: sqlDollar[8].tblDefs}
+			sqlVAL.stmt = &CreateTable{Table: sqlDollar[6].qname, IfNotExists: true, Defs: sqlDollar[8].tblDefs, Interleave: sqlDollar[10].interleave}
 		}
-	case 149:
+	case 159:
+		sqlDollar = sqlS[sqlpt-7 : sqlpt+1]
+		//line sql.y:1120
+		{
+			sqlVAL.interleave = &InterleaveDef{Parent: sqlDollar[4].qname, Fields: NameList(sqlDollar[6].strs)}
+		}
+	case 160:
 		sqlDollar = sqlS[sqlpt-0 : sqlpt+1]
-		//line sql.y:1033
+		//line sql.y:1124
+		{
+			sqlVAL.interleave = nil
+		}
+	case 162:
+		sqlDollar = sqlS[sqlpt-0 : sqlpt+1]
+		//line sql.y:1131
 		{
 			sqlVAL.tblDefs = nil
 		}
-	case 150:
+	case 163:
 		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
-		//line sql.y:1039
+		//line sql.y:1137
 		{
 			sqlVAL.tblDefs = TableDefs{sqlDollar[1].tblDef}
 		}
-	case 151:
+	case 164:
 		sqlDollar = sqlS[sqlpt-3 : sqlpt+1]
-		//line sql.y:1043
+		//line sql.y:1141
 		{
 			sqlVAL.tblDefs = append(sqlDollar[1].tblDefs, sqlDollar[3].tblDef)
 		}
-	case 152:
+	case 165:
 		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
-		//line sql.y:1049
+		//line sql.y:1147
 		{
 			sqlVAL.tblDef = sqlDollar[1].colDef
 		}
-	case 154:
+	case 168:
 		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
-		//line sql.y:1054
+		//line sql.y:1153
 		{
 			sqlVAL.tblDef = sqlDollar[1].constraintDef
 		}
-	case 155:
+	case 169:
 		sqlDollar = sqlS[sqlpt-3 : sqlpt+1]
-		//line sql.y:1060
+		//line sql.y:1159
 		{
 			sqlVAL.colDef = newColumnTableDef(Name(sqlDollar[1].str), sqlDollar[2].colType, sqlDollar[3].colQuals)
 		}
-	case 156:
+	case 170:
 		sqlDollar = sqlS[sqlpt-2 : sqlpt+1]
-		//line sql.y:1066
+		//line sql.y:1165
 		{
 			sqlVAL.colQuals = append(sqlDollar[1].colQuals, sqlDollar[2].colQual)
 		}
-	case 157:
+	case 171:
 		sqlDollar = sqlS[sqlpt-0 : sqlpt+1]
-		//line sql.y:1070
+		//line sql.y:1169
 		{
 			sqlVAL.colQuals = nil
 		}
-	case 158:
+	case 172:
 		sqlDollar = sqlS[sqlpt-3 : sqlpt+1]
-		//line sql.y:1076
+		//line sql.y:1175
 		{
 			// TODO(pmattis): Handle constraint name.
 			sqlVAL.colQual = sqlDollar[3].colQual
 		}
-	case 160:
+	case 174:
 		sqlDollar = sqlS[sqlpt-2 : sqlpt+1]
-		//line sql.y:1081
+		//line sql.y:1180
 		{
 			unimplemented()
 		}
-	case 161:
+	case 175:
 		sqlDollar = sqlS[sqlpt-2 : sqlpt+1]
-		//line sql.y:1097
+		//line sql.y:1196
 		{
 			sqlVAL.colQual = NotNullConstraint{}
 		}
-	case 162:
+	case 176:
 		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
-		//line sql.y:1101
+		//line sql.y:1200
 		{
 			sqlVAL.colQual = NullConstraint{}
 		}
-	case 163:
+	case 177:
 		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
-		//line sql.y:1105
+		//line sql.y:1204
 		{
 			sqlVAL.colQual = UniqueConstraint{}
 		}
-	case 164:
+	case 178:
 		sqlDollar = sqlS[sqlpt-2 : sqlpt+1]
-		//line sql.y:1109
+		//line sql.y:1208
 		{
 			sqlVAL.colQual = PrimaryKeyConstraint{}
 		}
-	case 165:
+	case 179:
 		sqlDollar = sqlS[sqlpt-4 : sqlpt+1]
-		//line sql.y:1112
+		//line sql.y:1211
 		{
 			unimplemented()
 		}
-	case 166:
+	case 180:
 		sqlDollar = sqlS[sqlpt-2 : sqlpt+1]
-		//line sql.y:1114
+		//line sql.y:1213
 		{
 			if ContainsVars(sqlDollar[2].expr) {
 				sqllex.Error("default expression contains a variable")
@@ -4655,602 +5107,723 @@ sqldefault:
 			}
 			sqlVAL.colQual = &ColumnDefault{Expr: sqlDollar[2].expr}
 		}
-	case 167:
+	case 181:
 		sqlDollar = sqlS[sqlpt-5 : sqlpt+1]
-		//line sql.y:1125
+		//line sql.y:1225
+		{
+			if containsSubquery(sqlDollar[3].expr) {
+				sqllex.Error("computed column expression contains a subquery")
+				return 1
+			}
+			sqlVAL.colQual = &ColumnComputedDef{Expr: sqlDollar[3].expr}
+		}
+	case 182:
+		sqlDollar = sqlS[sqlpt-5 : sqlpt+1]
+		//line sql.y:1232
 		{
 			unimplemented()
 		}
-	case 168:
-		sqlDollar = sqlS[sqlpt-6 : sqlpt+1]
-		//line sql.y:1129
+	case 183:
+		sqlDollar = sqlS[sqlpt-7 : sqlpt+1]
+		//line sql.y:1236
 		{
 			sqlVAL.tblDef = &IndexTableDef{
-				Name:    Name(sqlDollar[2].str),
-				Columns: NameList(sqlDollar[4].strs),
-				Storing: sqlDollar[6].strs,
+				Name:      Name(sqlDollar[2].str),
+				Columns:   sqlDollar[4].idxElems,
+				Storing:   sqlDollar[6].strs,
+				Predicate: sqlDollar[7].expr,
 			}
 		}
-	case 169:
-		sqlDollar = sqlS[sqlpt-7 : sqlpt+1]
-		//line sql.y:1137
+	case 184:
+		sqlDollar = sqlS[sqlpt-8 : sqlpt+1]
+		//line sql.y:1245
 		{
 			sqlVAL.tblDef = &UniqueConstraintTableDef{
 				IndexTableDef: IndexTableDef{
-					Name:    Name(sqlDollar[3].str),
-					Columns: NameList(sqlDollar[5].strs),
-					Storing: sqlDollar[7].strs,
+					Name:      Name(sqlDollar[3].str),
+					Columns:   sqlDollar[5].idxElems,
+					Storing:   sqlDollar[7].strs,
+					Predicate: sqlDollar[8].expr,
 				},
 			}
 		}
-	case 170:
+	case 185:
+		sqlDollar = sqlS[sqlpt-5 : sqlpt+1]
+		//line sql.y:1258
+		{
+			sqlVAL.tblDef = &FamilyTableDef{
+				Name:    Name(sqlDollar[2].str),
+				Columns: NameList(sqlDollar[4].strs),
+			}
+		}
+	case 186:
 		sqlDollar = sqlS[sqlpt-3 : sqlpt+1]
-		//line sql.y:1152
+		//line sql.y:1270
 		{
 			sqlVAL.constraintDef = sqlDollar[3].constraintDef
 			sqlVAL.constraintDef.setName(Name(sqlDollar[2].str))
 		}
-	case 171:
+	case 187:
 		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
-		//line sql.y:1157
+		//line sql.y:1275
 		{
 			sqlVAL.constraintDef = sqlDollar[1].constraintDef
 		}
-	case 172:
+	case 188:
 		sqlDollar = sqlS[sqlpt-4 : sqlpt+1]
-		//line sql.y:1162
+		//line sql.y:1280
 		{
 			unimplemented()
 		}
-	case 173:
-		sqlDollar = sqlS[sqlpt-5 : sqlpt+1]
-		//line sql.y:1164
+	case 189:
+		sqlDollar = sqlS[sqlpt-6 : sqlpt+1]
+		//line sql.y:1282
 		{
 			sqlVAL.constraintDef = &UniqueConstraintTableDef{
 				IndexTableDef: IndexTableDef{
-					Columns: NameList(sqlDollar[3].strs),
-					Storing: sqlDollar[5].strs,
+					Columns:   sqlDollar[3].idxElems,
+					Storing:   sqlDollar[5].strs,
+					Predicate: sqlDollar[6].expr,
 				},
 			}
 		}
-	case 174:
+	case 190:
 		sqlDollar = sqlS[sqlpt-5 : sqlpt+1]
-		//line sql.y:1173
+		//line sql.y:1292
 		{
 			sqlVAL.constraintDef = &UniqueConstraintTableDef{
 				IndexTableDef: IndexTableDef{
-					Columns: NameList(sqlDollar[4].strs),
+					Columns: sqlDollar[4].idxElems,
 				},
 				PrimaryKey: true,
 			}
 		}
-	case 175:
+	case 191:
 		sqlDollar = sqlS[sqlpt-10 : sqlpt+1]
-		//line sql.y:1182
+		//line sql.y:1301
 		{
 			unimplemented()
 		}
-	case 178:
+	case 194:
 		sqlDollar = sqlS[sqlpt-4 : sqlpt+1]
-		//line sql.y:1199
+		//line sql.y:1318
 		{
 			sqlVAL.strs = sqlDollar[3].strs
 		}
-	case 179:
+	case 195:
 		sqlDollar = sqlS[sqlpt-0 : sqlpt+1]
-		//line sql.y:1203
+		//line sql.y:1322
 		{
 			sqlVAL.strs = nil
 		}
-	case 180:
+	case 196:
 		sqlDollar = sqlS[sqlpt-3 : sqlpt+1]
-		//line sql.y:1209
+		//line sql.y:1328
 		{
 			sqlVAL.strs = sqlDollar[2].strs
 		}
-	case 181:
+	case 197:
 		sqlDollar = sqlS[sqlpt-0 : sqlpt+1]
-		//line sql.y:1213
+		//line sql.y:1332
 		{
 			sqlVAL.strs = nil
 		}
-	case 182:
+	case 198:
 		sqlDollar = sqlS[sqlpt-2 : sqlpt+1]
-		//line sql.y:1218
+		//line sql.y:1337
 		{
 			unimplemented()
 		}
-	case 183:
+	case 199:
 		sqlDollar = sqlS[sqlpt-2 : sqlpt+1]
-		//line sql.y:1219
+		//line sql.y:1338
 		{
 			unimplemented()
 		}
-	case 184:
+	case 200:
 		sqlDollar = sqlS[sqlpt-2 : sqlpt+1]
-		//line sql.y:1220
+		//line sql.y:1339
 		{
 			unimplemented()
 		}
-	case 185:
+	case 201:
 		sqlDollar = sqlS[sqlpt-0 : sqlpt+1]
-		//line sql.y:1221
+		//line sql.y:1340
 		{
 		}
-	case 186:
+	case 202:
 		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
-		//line sql.y:1228
+		//line sql.y:1347
 		{
 			unimplemented()
 		}
-	case 187:
+	case 203:
 		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
-		//line sql.y:1229
+		//line sql.y:1348
 		{
 			unimplemented()
 		}
-	case 188:
+	case 204:
 		sqlDollar = sqlS[sqlpt-2 : sqlpt+1]
-		//line sql.y:1230
+		//line sql.y:1349
 		{
 			unimplemented()
 		}
-	case 189:
+	case 205:
 		sqlDollar = sqlS[sqlpt-2 : sqlpt+1]
-		//line sql.y:1231
+		//line sql.y:1350
 		{
 			unimplemented()
 		}
-	case 190:
+	case 206:
 		sqlDollar = sqlS[sqlpt-0 : sqlpt+1]
-		//line sql.y:1232
+		//line sql.y:1351
 		{
 		}
-	case 191:
+	case 207:
 		sqlDollar = sqlS[sqlpt-3 : sqlpt+1]
-		//line sql.y:1235
+		//line sql.y:1354
 		{
 			unimplemented()
 		}
-	case 192:
+	case 208:
 		sqlDollar = sqlS[sqlpt-3 : sqlpt+1]
-		//line sql.y:1238
+		//line sql.y:1357
 		{
 			unimplemented()
 		}
-	case 193:
+	case 209:
 		sqlDollar = sqlS[sqlpt-2 : sqlpt+1]
-		//line sql.y:1241
+		//line sql.y:1360
 		{
 			unimplemented()
 		}
-	case 194:
+	case 210:
 		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
-		//line sql.y:1242
+		//line sql.y:1361
 		{
 			unimplemented()
 		}
-	case 195:
+	case 211:
 		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
-		//line sql.y:1243
+		//line sql.y:1362
 		{
 			unimplemented()
 		}
-	case 196:
+	case 212:
 		sqlDollar = sqlS[sqlpt-2 : sqlpt+1]
-		//line sql.y:1244
+		//line sql.y:1363
 		{
 			unimplemented()
 		}
-	case 197:
+	case 213:
 		sqlDollar = sqlS[sqlpt-2 : sqlpt+1]
-		//line sql.y:1245
+		//line sql.y:1364
 		{
 			unimplemented()
 		}
-	case 198:
+	case 214:
 		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
-		//line sql.y:1249
+		//line sql.y:1368
 		{
 			sqlVAL.expr = NumVal(sqlDollar[1].str)
 		}
-	case 199:
+	case 215:
 		sqlDollar = sqlS[sqlpt-2 : sqlpt+1]
-		//line sql.y:1253
+		//line sql.y:1372
 		{
 			sqlVAL.expr = NumVal("-" + sqlDollar[2].str)
 		}
-	case 200:
+	case 216:
 		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
-		//line sql.y:1257
+		//line sql.y:1376
 		{
 			sqlVAL.expr = DInt(sqlDollar[1].ival)
 		}
-	case 201:
+	case 217:
+		sqlDollar = sqlS[sqlpt-5 : sqlpt+1]
+		//line sql.y:1384
+		{
+			sqlVAL.stmt = &Backup{Targets: sqlDollar[2].targetList, To: sqlDollar[4].str, IncrementalFrom: sqlDollar[5].strs}
+		}
+	case 218:
+		sqlDollar = sqlS[sqlpt-3 : sqlpt+1]
+		//line sql.y:1390
+		{
+			sqlVAL.strs = sqlDollar[3].strs
+		}
+	case 219:
+		sqlDollar = sqlS[sqlpt-0 : sqlpt+1]
+		//line sql.y:1394
+		{
+			sqlVAL.strs = nil
+		}
+	case 220:
+		sqlDollar = sqlS[sqlpt-4 : sqlpt+1]
+		//line sql.y:1402
+		{
+			sqlVAL.stmt = &Restore{Targets: sqlDollar[2].targetList, From: sqlDollar[4].strs}
+		}
+	case 221:
+		sqlDollar = sqlS[sqlpt-11 : sqlpt+1]
+		//line sql.y:1409
+		{
+			sqlVAL.stmt = &Import{Table: sqlDollar[3].qname, CreateFile: sqlDollar[6].str, Files: sqlDollar[10].strs}
+		}
+	case 222:
 		sqlDollar = sqlS[sqlpt-4 : sqlpt+1]
-		//line sql.y:1264
+		//line sql.y:1416
 		{
 			sqlVAL.stmt = &Truncate{Tables: sqlDollar[3].qnames}
 		}
-	case 202:
-		sqlDollar = sqlS[sqlpt-10 : sqlpt+1]
-		//line sql.y:1271
+	case 223:
+		sqlDollar = sqlS[sqlpt-11 : sqlpt+1]
+		//line sql.y:1423
 		{
 			sqlVAL.stmt = &CreateIndex{
-				Name:    Name(sqlDollar[4].str),
-				Table:   sqlDollar[6].qname,
-				Unique:  sqlDollar[2].boolVal,
-				Columns: sqlDollar[8].strs,
-				Storing: sqlDollar[10].strs,
+				Name:      Name(sqlDollar[4].str),
+				Table:     sqlDollar[6].qname,
+				Unique:    sqlDollar[2].boolVal,
+				Columns:   sqlDollar[8].idxElems,
+				Storing:   sqlDollar[10].strs,
+				Predicate: sqlDollar[11].expr,
 			}
 		}
-	case 203:
-		sqlDollar = sqlS[sqlpt-13 : sqlpt+1]
-		//line sql.y:1281
+	case 224:
+		sqlDollar = sqlS[sqlpt-14 : sqlpt+1]
+		//line sql.y:1434
 		{
 			sqlVAL.stmt = &CreateIndex{
 				Name:        Name(sqlDollar[7].str),
 				Table:       sqlDollar[9].qname,
 				Unique:      sqlDollar[2].boolVal,
 				IfNotExists: true,
-				Columns:     sqlDollar[11].strs,
+				Columns:     sqlDollar[11].idxElems,
 				Storing:     sqlDollar[13].strs,
+				Predicate:   sqlDollar[14].expr,
 			}
 		}
-	case 204:
+	case 225:
 		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
-		//line sql.y:1294
+		//line sql.y:1448
 		{
 			sqlVAL.boolVal = true
 		}
-	case 205:
+	case 226:
 		sqlDollar = sqlS[sqlpt-0 : sqlpt+1]
-		//line sql.y:1298
+		//line sql.y:1452
 		{
 			sqlVAL.boolVal = false
 		}
-	case 206:
+	case 227:
 		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
-		//line sql.y:1304
+		//line sql.y:1458
 		{
-			sqlVAL.strs = []string{sqlDollar[1].str}
+			sqlVAL.idxElems = IndexElemList{sqlDollar[1].idxElem}
 		}
-	case 207:
+	case 228:
 		sqlDollar = sqlS[sqlpt-3 : sqlpt+1]
-		//line sql.y:1308
+		//line sql.y:1462
 		{
-			sqlVAL.strs = append(sqlDollar[1].strs, sqlDollar[3].str)
+			sqlVAL.idxElems = append(sqlDollar[1].idxElems, sqlDollar[3].idxElem)
 		}
-	case 208:
+	case 229:
 		sqlDollar = sqlS[sqlpt-3 : sqlpt+1]
-		//line sql.y:1317
+		//line sql.y:1471
 		{
-			// TODO(pmattis): Support opt_asc_desc.
-			sqlVAL.str = sqlDollar[1].str
+			sqlVAL.idxElem = IndexElem{Column: Name(sqlDollar[1].str), Direction: sqlDollar[3].dir}
 		}
-	case 209:
+	case 230:
 		sqlDollar = sqlS[sqlpt-3 : sqlpt+1]
-		//line sql.y:1321
+		//line sql.y:1474
 		{
 			unimplemented()
 		}
-	case 210:
+	case 231:
 		sqlDollar = sqlS[sqlpt-5 : sqlpt+1]
-		//line sql.y:1322
+		//line sql.y:1475
 		{
 			unimplemented()
 		}
-	case 211:
+	case 232:
 		sqlDollar = sqlS[sqlpt-2 : sqlpt+1]
-		//line sql.y:1325
+		//line sql.y:1478
 		{
 			unimplemented()
 		}
-	case 212:
+	case 233:
 		sqlDollar = sqlS[sqlpt-0 : sqlpt+1]
-		//line sql.y:1326
+		//line sql.y:1479
 		{
 		}
-	case 213:
+	case 234:
 		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
-		//line sql.y:1330
+		//line sql.y:1483
 		{
 			sqlVAL.dir = Ascending
 		}
-	case 214:
+	case 235:
 		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
-		//line sql.y:1334
+		//line sql.y:1487
 		{
 			sqlVAL.dir = Descending
 		}
-	case 215:
+	case 236:
 		sqlDollar = sqlS[sqlpt-0 : sqlpt+1]
-		//line sql.y:1338
+		//line sql.y:1491
 		{
 			sqlVAL.dir = DefaultDirection
 		}
-	case 216:
+	case 237:
 		sqlDollar = sqlS[sqlpt-6 : sqlpt+1]
-		//line sql.y:1345
+		//line sql.y:1498
 		{
 			sqlVAL.stmt = &RenameDatabase{Name: Name(sqlDollar[3].str), NewName: Name(sqlDollar[6].str)}
 		}
-	case 217:
+	case 238:
 		sqlDollar = sqlS[sqlpt-6 : sqlpt+1]
-		//line sql.y:1349
+		//line sql.y:1502
 		{
 			sqlVAL.stmt = &RenameTable{Name: sqlDollar[3].qname, NewName: sqlDollar[6].qname, IfExists: false}
 		}
-	case 218:
+	case 239:
 		sqlDollar = sqlS[sqlpt-8 : sqlpt+1]
-		//line sql.y:1353
+		//line sql.y:1506
 		{
 			sqlVAL.stmt = &RenameTable{Name: sqlDollar[5].qname, NewName: sqlDollar[8].qname, IfExists: true}
 		}
-	case 219:
+	case 240:
 		sqlDollar = sqlS[sqlpt-6 : sqlpt+1]
-		//line sql.y:1357
+		//line sql.y:1510
 		{
 			sqlVAL.stmt = &RenameIndex{Name: sqlDollar[3].qname, NewName: Name(sqlDollar[6].str), IfExists: false}
 		}
-	case 220:
+	case 241:
 		sqlDollar = sqlS[sqlpt-8 : sqlpt+1]
-		//line sql.y:1361
+		//line sql.y:1514
 		{
 			sqlVAL.stmt = &RenameIndex{Name: sqlDollar[5].qname, NewName: Name(sqlDollar[8].str), IfExists: true}
 		}
-	case 221:
+	case 242:
 		sqlDollar = sqlS[sqlpt-8 : sqlpt+1]
-		//line sql.y:1365
+		//line sql.y:1518
 		{
 			sqlVAL.stmt = &RenameColumn{Table: sqlDollar[3].qname, Name: Name(sqlDollar[6].str), NewName: Name(sqlDollar[8].str), IfExists: false}
 		}
-	case 222:
+	case 243:
 		sqlDollar = sqlS[sqlpt-10 : sqlpt+1]
-		//line sql.y:1369
+		//line sql.y:1522
 		{
 			sqlVAL.stmt = &RenameColumn{Table: sqlDollar[5].qname, Name: Name(sqlDollar[8].str), NewName: Name(sqlDollar[10].str), IfExists: true}
 		}
-	case 223:
+	case 244:
 		sqlDollar = sqlS[sqlpt-8 : sqlpt+1]
-		//line sql.y:1373
+		//line sql.y:1526
 		{
 			sqlVAL.stmt = nil
 		}
-	case 224:
+	case 245:
 		sqlDollar = sqlS[sqlpt-10 : sqlpt+1]
-		//line sql.y:1377
+		//line sql.y:1530
 		{
 			sqlVAL.stmt = nil
 		}
-	case 225:
+	case 246:
 		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
-		//line sql.y:1383
+		//line sql.y:1536
 		{
 			sqlVAL.boolVal = true
 		}
-	case 226:
+	case 247:
+		sqlDollar = sqlS[sqlpt-0 : sqlpt+1]
+		//line sql.y:1540
+		{
+			sqlVAL.boolVal = false
+		}
+	case 248:
+		sqlDollar = sqlS[sqlpt-2 : sqlpt+1]
+		//line sql.y:1545
+		{
+		}
+	case 249:
 		sqlDollar = sqlS[sqlpt-0 : sqlpt+1]
-		//line sql.y:1387
+		//line sql.y:1546
+		{
+		}
+	case 250:
+		sqlDollar = sqlS[sqlpt-3 : sqlpt+1]
+		//line sql.y:1551
+		{
+			sqlVAL.stmt = &BeginTransaction{Isolation: sqlDollar[3].isoLevel}
+		}
+	case 251:
+		sqlDollar = sqlS[sqlpt-2 : sqlpt+1]
+		//line sql.y:1555
 		{
-			sqlVAL.boolVal = false
+			sqlVAL.stmt = &CommitTransaction{}
 		}
-	case 227:
+	case 252:
 		sqlDollar = sqlS[sqlpt-2 : sqlpt+1]
-		//line sql.y:1392
+		//line sql.y:1559
 		{
+			sqlVAL.stmt = &RollbackTransaction{}
 		}
-	case 228:
-		sqlDollar = sqlS[sqlpt-0 : sqlpt+1]
-		//line sql.y:1393
+	case 253:
+		sqlDollar = sqlS[sqlpt-2 : sqlpt+1]
+		//line sql.y:1563
 		{
+			sqlVAL.stmt = &Savepoint{Name: sqlDollar[2].str}
 		}
-	case 229:
+	case 254:
 		sqlDollar = sqlS[sqlpt-3 : sqlpt+1]
-		//line sql.y:1398
+		//line sql.y:1567
 		{
-			sqlVAL.stmt = &BeginTransaction{Isolation: sqlDollar[3].isoLevel}
+			sqlVAL.stmt = &ReleaseSavepoint{Savepoint: sqlDollar[3].str}
 		}
-	case 230:
+	case 255:
 		sqlDollar = sqlS[sqlpt-2 : sqlpt+1]
-		//line sql.y:1402
+		//line sql.y:1571
 		{
-			sqlVAL.stmt = &CommitTransaction{}
+			sqlVAL.stmt = &ReleaseSavepoint{Savepoint: sqlDollar[2].str}
 		}
-	case 231:
-		sqlDollar = sqlS[sqlpt-2 : sqlpt+1]
-		//line sql.y:1406
+	case 256:
+		sqlDollar = sqlS[sqlpt-5 : sqlpt+1]
+		//line sql.y:1575
 		{
-			sqlVAL.stmt = &RollbackTransaction{}
+			sqlVAL.stmt = &RollbackToSavepoint{Savepoint: sqlDollar[5].str}
 		}
-	case 232:
+	case 257:
+		sqlDollar = sqlS[sqlpt-4 : sqlpt+1]
+		//line sql.y:1579
+		{
+			sqlVAL.stmt = &RollbackToSavepoint{Savepoint: sqlDollar[4].str}
+		}
+	case 258:
 		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
-		//line sql.y:1411
+		//line sql.y:1584
 		{
 		}
-	case 233:
+	case 259:
 		sqlDollar = sqlS[sqlpt-0 : sqlpt+1]
-		//line sql.y:1412
+		//line sql.y:1585
 		{
 		}
-	case 235:
+	case 261:
 		sqlDollar = sqlS[sqlpt-0 : sqlpt+1]
-		//line sql.y:1417
+		//line sql.y:1590
 		{
 			sqlVAL.isoLevel = UnspecifiedIsolation
 		}
-	case 236:
+	case 262:
 		sqlDollar = sqlS[sqlpt-3 : sqlpt+1]
-		//line sql.y:1423
+		//line sql.y:1596
 		{
 			sqlVAL.isoLevel = sqlDollar[3].isoLevel
 		}
-	case 237:
+	case 263:
 		sqlDollar = sqlS[sqlpt-3 : sqlpt+1]
-		//line sql.y:1429
+		//line sql.y:1602
 		{
 			sqlVAL.stmt = &CreateDatabase{Name: Name(sqlDollar[3].str)}
 		}
-	case 238:
+	case 264:
 		sqlDollar = sqlS[sqlpt-6 : sqlpt+1]
-		//line sql.y:1433
+		//line sql.y:1606
 		{
 			sqlVAL.stmt = &CreateDatabase{IfNotExists: true, Name: Name(sqlDollar[6].str)}
 		}
-	case 239:
+	case 265:
 		sqlDollar = sqlS[sqlpt-6 : sqlpt+1]
-		//line sql.y:1439
+		//line sql.y:1612
 		{
 			sqlVAL.stmt = sqlDollar[5].stmt
 			sqlVAL.stmt.(*Insert).Table = sqlDollar[4].qname
 		}
-	case 242:
+	case 268:
 		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
-		//line sql.y:1455
+		//line sql.y:1628
 		{
 			sqlVAL.stmt = &Insert{Rows: sqlDollar[1].selectStmt}
 		}
-	case 243:
+	case 269:
 		sqlDollar = sqlS[sqlpt-4 : sqlpt+1]
-		//line sql.y:1459
+		//line sql.y:1632
 		{
 			sqlVAL.stmt = &Insert{Columns: sqlDollar[2].qnames, Rows: sqlDollar[4].selectStmt}
 		}
-	case 244:
+	case 270:
 		sqlDollar = sqlS[sqlpt-2 : sqlpt+1]
-		//line sql.y:1463
+		//line sql.y:1636
 		{
 			sqlVAL.stmt = &Insert{}
 		}
-	case 245:
+	case 271:
 		sqlDollar = sqlS[sqlpt-8 : sqlpt+1]
-		//line sql.y:1468
+		//line sql.y:1641
 		{
 			unimplemented()
 		}
-	case 246:
+	case 272:
 		sqlDollar = sqlS[sqlpt-5 : sqlpt+1]
-		//line sql.y:1469
+		//line sql.y:1642
 		{
 			unimplemented()
 		}
-	case 247:
+	case 273:
 		sqlDollar = sqlS[sqlpt-0 : sqlpt+1]
-		//line sql.y:1470
+		//line sql.y:1643
 		{
 		}
-	case 248:
+	case 274:
 		sqlDollar = sqlS[sqlpt-4 : sqlpt+1]
-		//line sql.y:1473
+		//line sql.y:1646
 		{
 			unimplemented()
 		}
-	case 249:
+	case 275:
 		sqlDollar = sqlS[sqlpt-3 : sqlpt+1]
-		//line sql.y:1474
+		//line sql.y:1647
 		{
 			unimplemented()
 		}
-	case 250:
+	case 276:
 		sqlDollar = sqlS[sqlpt-0 : sqlpt+1]
-		//line sql.y:1475
+		//line sql.y:1648
 		{
 		}
-	case 251:
+	case 277:
 		sqlDollar = sqlS[sqlpt-7 : sqlpt+1]
-		//line sql.y:1480
+		//line sql.y:1653
 		{
 			sqlVAL.stmt = &Update{Table: sqlDollar[3].tblExpr, Exprs: sqlDollar[5].updateExprs, Where: newWhere(astWhere, sqlDollar[7].expr)}
 		}
-	case 252:
+	case 278:
 		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
-		//line sql.y:1486
+		//line sql.y:1659
 		{
 			sqlVAL.updateExprs = UpdateExprs{sqlDollar[1].updateExpr}
 		}
-	case 253:
+	case 279:
 		sqlDollar = sqlS[sqlpt-3 : sqlpt+1]
-		//line sql.y:1490
+		//line sql.y:1663
 		{
 			sqlVAL.updateExprs = append(sqlDollar[1].updateExprs, sqlDollar[3].updateExpr)
 		}
-	case 256:
+	case 282:
 		sqlDollar = sqlS[sqlpt-3 : sqlpt+1]
-		//line sql.y:1500
+		//line sql.y:1673
 		{
 			sqlVAL.updateExpr = &UpdateExpr{Names: QualifiedNames{sqlDollar[1].qname}, Expr: sqlDollar[3].expr}
 		}
-	case 257:
+	case 283:
 		sqlDollar = sqlS[sqlpt-5 : sqlpt+1]
-		//line sql.y:1512
+		//line sql.y:1685
 		{
 			sqlVAL.updateExpr = &UpdateExpr{Tuple: true, Names: sqlDollar[2].qnames, Expr: Tuple(sqlDollar[5].exprs)}
 		}
-	case 258:
+	case 284:
 		sqlDollar = sqlS[sqlpt-5 : sqlpt+1]
-		//line sql.y:1516
+		//line sql.y:1689
 		{
 			sqlVAL.updateExpr = &UpdateExpr{Tuple: true, Names: sqlDollar[2].qnames, Expr: &Subquery{Select: sqlDollar[5].selectStmt}}
 		}
-	case 261:
+	case 287:
 		sqlDollar = sqlS[sqlpt-3 : sqlpt+1]
-		//line sql.y:1563
+		//line sql.y:1736
 		{
 			sqlVAL.selectStmt = &ParenSelect{Select: sqlDollar[2].selectStmt}
 		}
-	case 262:
+	case 288:
 		sqlDollar = sqlS[sqlpt-3 : sqlpt+1]
-		//line sql.y:1567
+		//line sql.y:1740
 		{
 			sqlVAL.selectStmt = &ParenSelect{Select: sqlDollar[2].selectStmt}
 		}
-	case 264:
+	case 290:
 		sqlDollar = sqlS[sqlpt-2 : sqlpt+1]
-		//line sql.y:1583
+		//line sql.y:1756
 		{
 			sqlVAL.selectStmt = sqlDollar[1].selectStmt
 			if s, ok := sqlVAL.selectStmt.(*Select); ok {
 				s.OrderBy = sqlDollar[2].orderBy
 			}
 		}
-	case 265:
+	case 291:
 		sqlDollar = sqlS[sqlpt-3 : sqlpt+1]
-		//line sql.y:1590
+		//line sql.y:1763
+		{
+			sqlVAL.selectStmt = sqlDollar[1].selectStmt
+			if s, ok := sqlVAL.selectStmt.(*Select); ok {
+				s.OrderBy = sqlDollar[2].orderBy
+				s.Limit = sqlDollar[3].limit
+			}
+		}
+	case 292:
+		sqlDollar = sqlS[sqlpt-3 : sqlpt+1]
+		//line sql.y:1771
+		{
+			sqlVAL.selectStmt = sqlDollar[1].selectStmt
+			if s, ok := sqlVAL.selectStmt.(*Select); ok {
+				s.OrderBy = sqlDollar[2].orderBy
+				s.ForUpdate = true
+			}
+		}
+	case 293:
+		sqlDollar = sqlS[sqlpt-4 : sqlpt+1]
+		//line sql.y:1779
 		{
 			sqlVAL.selectStmt = sqlDollar[1].selectStmt
 			if s, ok := sqlVAL.selectStmt.(*Select); ok {
 				s.OrderBy = sqlDollar[2].orderBy
 				s.Limit = sqlDollar[3].limit
+				s.ForUpdate = true
 			}
 		}
-	case 266:
+	case 294:
 		sqlDollar = sqlS[sqlpt-2 : sqlpt+1]
-		//line sql.y:1598
+		//line sql.y:1788
 		{
-			sqlVAL.selectStmt = sqlDollar[2].selectStmt
+			sqlVAL.selectStmt = setWith(sqlDollar[1].with, sqlDollar[2].selectStmt)
+			if sqlVAL.selectStmt == nil {
+				sqllex.Error("WITH is only supported with SELECT")
+				return 1
+			}
 		}
-	case 267:
+	case 295:
 		sqlDollar = sqlS[sqlpt-3 : sqlpt+1]
-		//line sql.y:1602
+		//line sql.y:1796
 		{
-			sqlVAL.selectStmt = sqlDollar[2].selectStmt
+			sqlVAL.selectStmt = setWith(sqlDollar[1].with, sqlDollar[2].selectStmt)
+			if sqlVAL.selectStmt == nil {
+				sqllex.Error("WITH is only supported with SELECT")
+				return 1
+			}
 			if s, ok := sqlVAL.selectStmt.(*Select); ok {
 				s.OrderBy = sqlDollar[3].orderBy
 			}
 		}
-	case 268:
+	case 296:
 		sqlDollar = sqlS[sqlpt-4 : sqlpt+1]
-		//line sql.y:1609
+		//line sql.y:1807
 		{
-			sqlVAL.selectStmt = sqlDollar[2].selectStmt
+			sqlVAL.selectStmt = setWith(sqlDollar[1].with, sqlDollar[2].selectStmt)
+			if sqlVAL.selectStmt == nil {
+				sqllex.Error("WITH is only supported with SELECT")
+				return 1
+			}
 			if s, ok := sqlVAL.selectStmt.(*Select); ok {
 				s.OrderBy = sqlDollar[3].orderBy
 				s.Limit = sqlDollar[4].limit
 			}
 		}
-	case 271:
+	case 299:
+		sqlDollar = sqlS[sqlpt-2 : sqlpt+1]
+		//line sql.y:1824
+		{
+		}
+	case 300:
 		sqlDollar = sqlS[sqlpt-8 : sqlpt+1]
-		//line sql.y:1647
+		//line sql.y:1852
 		{
 			sqlVAL.selectStmt = &Select{
 				Exprs:   sqlDollar[3].selExprs,
@@ -5260,9 +5833,9 @@ sqldefault:
 				Having:  newWhere(astHaving, sqlDollar[7].expr),
 			}
 		}
-	case 272:
+	case 301:
 		sqlDollar = sqlS[sqlpt-8 : sqlpt+1]
-		//line sql.y:1659
+		//line sql.y:1864
 		{
 			sqlVAL.selectStmt = &Select{
 				Distinct: sqlDollar[2].boolVal,
@@ -5273,9 +5846,9 @@ sqldefault:
 				Having:   newWhere(astHaving, sqlDollar[7].expr),
 			}
 		}
-	case 274:
+	case 303:
 		sqlDollar = sqlS[sqlpt-2 : sqlpt+1]
-		//line sql.y:1671
+		//line sql.y:1876
 		{
 			sqlVAL.selectStmt = &Select{
 				Exprs:       SelectExprs{StarSelectExpr()},
@@ -5283,9 +5856,9 @@ sqldefault:
 				tableSelect: true,
 			}
 		}
-	case 275:
+	case 304:
 		sqlDollar = sqlS[sqlpt-4 : sqlpt+1]
-		//line sql.y:1679
+		//line sql.y:1884
 		{
 			sqlVAL.selectStmt = &Union{
 				Type:  astUnion,
@@ -5294,9 +5867,9 @@ sqldefault:
 				All:   sqlDollar[3].boolVal,
 			}
 		}
-	case 276:
+	case 305:
 		sqlDollar = sqlS[sqlpt-4 : sqlpt+1]
-		//line sql.y:1688
+		//line sql.y:1893
 		{
 			sqlVAL.selectStmt = &Union{
 				Type:  astIntersect,
@@ -5305,9 +5878,9 @@ sqldefault:
 				All:   sqlDollar[3].boolVal,
 			}
 		}
-	case 277:
+	case 306:
 		sqlDollar = sqlS[sqlpt-4 : sqlpt+1]
-		//line sql.y:1697
+		//line sql.y:1902
 		{
 			sqlVAL.selectStmt = &Union{
 				Type:  astExcept,
@@ -5316,142 +5889,148 @@ sqldefault:
 				All:   sqlDollar[3].boolVal,
 			}
 		}
-	case 278:
+	case 307:
 		sqlDollar = sqlS[sqlpt-2 : sqlpt+1]
-		//line sql.y:1715
+		//line sql.y:1921
 		{
-			unimplemented()
+			sqlVAL.with = &With{CTEs: sqlDollar[2].ctes}
 		}
-	case 279:
+	case 308:
 		sqlDollar = sqlS[sqlpt-2 : sqlpt+1]
-		//line sql.y:1716
+		//line sql.y:1925
 		{
-			unimplemented()
+			sqlVAL.with = &With{CTEs: sqlDollar[2].ctes}
 		}
-	case 280:
+	case 309:
 		sqlDollar = sqlS[sqlpt-3 : sqlpt+1]
-		//line sql.y:1717
+		//line sql.y:1929
 		{
-			unimplemented()
+			sqlVAL.with = &With{Recursive: true, CTEs: sqlDollar[3].ctes}
 		}
-	case 281:
+	case 310:
 		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
-		//line sql.y:1720
+		//line sql.y:1935
 		{
-			unimplemented()
+			sqlVAL.ctes = []*CTE{sqlDollar[1].cte}
 		}
-	case 282:
+	case 311:
 		sqlDollar = sqlS[sqlpt-3 : sqlpt+1]
-		//line sql.y:1721
+		//line sql.y:1939
 		{
-			unimplemented()
+			sqlVAL.ctes = append(sqlDollar[1].ctes, sqlDollar[3].cte)
 		}
-	case 283:
+	case 312:
 		sqlDollar = sqlS[sqlpt-6 : sqlpt+1]
-		//line sql.y:1724
+		//line sql.y:1945
 		{
-			unimplemented()
+			stmt, ok := sqlDollar[5].stmt.(SelectStatement)
+			if !ok {
+				sqllex.Error("WITH only supports SELECT in common table expressions")
+				return 1
+			}
+			sqlVAL.cte = &CTE{Name: Name(sqlDollar[1].str), Columns: NameList(sqlDollar[2].strs), Stmt: stmt}
 		}
-	case 284:
+	case 313:
 		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
-		//line sql.y:1728
+		//line sql.y:1956
 		{
 			sqlVAL.stmt = sqlDollar[1].selectStmt
 		}
-	case 288:
+	case 317:
 		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
-		//line sql.y:1736
+		//line sql.y:1965
 		{
-			unimplemented()
+			sqllex.Error("WITH is only supported with SELECT")
+			return 1
 		}
-	case 289:
+	case 318:
 		sqlDollar = sqlS[sqlpt-0 : sqlpt+1]
-		//line sql.y:1737
+		//line sql.y:1969
 		{
 		}
-	case 290:
+	case 319:
 		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
-		//line sql.y:1740
+		//line sql.y:1972
 		{
 		}
-	case 291:
+	case 320:
 		sqlDollar = sqlS[sqlpt-0 : sqlpt+1]
-		//line sql.y:1741
+		//line sql.y:1973
 		{
 		}
-	case 292:
+	case 321:
 		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
-		//line sql.y:1745
+		//line sql.y:1977
 		{
 			sqlVAL.boolVal = true
 		}
-	case 293:
+	case 322:
 		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
-		//line sql.y:1749
+		//line sql.y:1981
 		{
 			sqlVAL.boolVal = false
 		}
-	case 294:
+	case 323:
 		sqlDollar = sqlS[sqlpt-0 : sqlpt+1]
-		//line sql.y:1753
+		//line sql.y:1985
 		{
 			sqlVAL.boolVal = false
 		}
-	case 295:
+	case 324:
 		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
-		//line sql.y:1759
+		//line sql.y:1991
 		{
 			sqlVAL.boolVal = true
 		}
-	case 296:
+	case 325:
 		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
-		//line sql.y:1764
+		//line sql.y:1996
 		{
 		}
-	case 297:
+	case 326:
 		sqlDollar = sqlS[sqlpt-0 : sqlpt+1]
-		//line sql.y:1765
+		//line sql.y:1997
 		{
 		}
-	case 298:
+	case 327:
 		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
-		//line sql.y:1769
+		//line sql.y:2001
 		{
 			sqlVAL.orderBy = sqlDollar[1].orderBy
 		}
-	case 299:
+	case 328:
 		sqlDollar = sqlS[sqlpt-0 : sqlpt+1]
-		//line sql.y:1773
+		//line sql.y:2005
 		{
 			sqlVAL.orderBy = nil
 		}
-	case 300:
+	case 329:
 		sqlDollar = sqlS[sqlpt-3 : sqlpt+1]
-		//line sql.y:1779
+		//line sql.y:2011
 		{
 			sqlVAL.orderBy = OrderBy(sqlDollar[3].orders)
 		}
-	case 301:
+	case 330:
 		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
-		//line sql.y:1785
+		//line sql.y:2017
 		{
 			sqlVAL.orders = []*Order{sqlDollar[1].order}
 		}
-	case 302:
+	case 331:
 		sqlDollar = sqlS[sqlpt-3 : sqlpt+1]
-		//line sql.y:1789
+		//line sql.y:2021
 		{
 			sqlVAL.orders = append(sqlDollar[1].orders, sqlDollar[3].order)
 		}
-	case 303:
+	case 332:
 		sqlDollar = sqlS[sqlpt-2 : sqlpt+1]
-		//line sql.y:1795
+		//line sql.y:2027
 		{
 			sqlVAL.order = &Order{Expr: sqlDollar[1].expr, Direction: sqlDollar[2].dir}
 		}
-	case 304:
+	case 333:
 		sqlDollar = sqlS[sqlpt-2 : sqlpt+1]
-		//line sql.y:1803
+		//line sql.y:2035
 		{
 			if sqlDollar[1].limit == nil {
 				sqlVAL.limit = sqlDollar[2].limit
@@ -5460,18 +6039,18 @@ sqldefault:
 				sqlVAL.limit.Offset = sqlDollar[2].limit.Offset
 			}
 		}
-	case 305:
+	case 334:
 		sqlDollar = sqlS[sqlpt-2 : sqlpt+1]
-		//line sql.y:1812
+		//line sql.y:2044
 		{
 			sqlVAL.limit = sqlDollar[1].limit
 			if sqlDollar[2].limit != nil {
 				sqlVAL.limit.Count = sqlDollar[2].limit.Count
 			}
 		}
-	case 308:
+	case 337:
 		sqlDollar = sqlS[sqlpt-2 : sqlpt+1]
-		//line sql.y:1823
+		//line sql.y:2055
 		{
 			if sqlDollar[2].expr == nil {
 				sqlVAL.limit = nil
@@ -5479,2030 +6058,2207 @@ sqldefault:
 				sqlVAL.limit = &Limit{Count: sqlDollar[2].expr}
 			}
 		}
-	case 309:
+	case 338:
 		sqlDollar = sqlS[sqlpt-2 : sqlpt+1]
-		//line sql.y:1836
+		//line sql.y:2068
 		{
 			sqlVAL.limit = &Limit{Offset: sqlDollar[2].expr}
 		}
-	case 310:
+	case 339:
 		sqlDollar = sqlS[sqlpt-3 : sqlpt+1]
-		//line sql.y:1843
+		//line sql.y:2075
 		{
 			sqlVAL.limit = &Limit{Offset: sqlDollar[2].expr}
 		}
-	case 312:
+	case 341:
 		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
-		//line sql.y:1850
+		//line sql.y:2082
 		{
 			sqlVAL.expr = nil
 		}
-	case 313:
+	case 342:
 		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
-		//line sql.y:1864
+		//line sql.y:2096
 		{
 		}
-	case 314:
+	case 343:
 		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
-		//line sql.y:1865
+		//line sql.y:2097
 		{
 		}
-	case 315:
+	case 344:
 		sqlDollar = sqlS[sqlpt-3 : sqlpt+1]
-		//line sql.y:1891
+		//line sql.y:2123
 		{
 			sqlVAL.groupBy = GroupBy(sqlDollar[3].exprs)
 		}
-	case 316:
+	case 345:
 		sqlDollar = sqlS[sqlpt-0 : sqlpt+1]
-		//line sql.y:1895
+		//line sql.y:2127
 		{
 			sqlVAL.groupBy = nil
 		}
-	case 317:
+	case 346:
 		sqlDollar = sqlS[sqlpt-2 : sqlpt+1]
-		//line sql.y:1901
+		//line sql.y:2133
 		{
 			sqlVAL.expr = sqlDollar[2].expr
 		}
-	case 318:
+	case 347:
 		sqlDollar = sqlS[sqlpt-0 : sqlpt+1]
-		//line sql.y:1905
+		//line sql.y:2137
 		{
 			sqlVAL.expr = nil
 		}
-	case 319:
+	case 348:
 		sqlDollar = sqlS[sqlpt-2 : sqlpt+1]
-		//line sql.y:1911
+		//line sql.y:2143
 		{
 			sqlVAL.selectStmt = Values{Tuple(sqlDollar[2].exprs)}
 		}
-	case 320:
+	case 349:
 		sqlDollar = sqlS[sqlpt-3 : sqlpt+1]
-		//line sql.y:1915
+		//line sql.y:2147
 		{
 			sqlVAL.selectStmt = append(sqlDollar[1].selectStmt.(Values), Tuple(sqlDollar[3].exprs))
 		}
-	case 321:
+	case 350:
 		sqlDollar = sqlS[sqlpt-2 : sqlpt+1]
-		//line sql.y:1925
+		//line sql.y:2157
 		{
 			sqlVAL.tblExprs = sqlDollar[2].tblExprs
 		}
-	case 322:
+	case 351:
 		sqlDollar = sqlS[sqlpt-0 : sqlpt+1]
-		//line sql.y:1929
+		//line sql.y:2161
 		{
 			sqlVAL.tblExprs = nil
 		}
-	case 323:
+	case 352:
 		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
-		//line sql.y:1935
+		//line sql.y:2167
 		{
 			sqlVAL.tblExprs = TableExprs{sqlDollar[1].tblExpr}
 		}
-	case 324:
+	case 353:
 		sqlDollar = sqlS[sqlpt-3 : sqlpt+1]
-		//line sql.y:1939
+		//line sql.y:2171
 		{
 			sqlVAL.tblExprs = append(sqlDollar[1].tblExprs, sqlDollar[3].tblExpr)
 		}
-	case 325:
+	case 354:
 		sqlDollar = sqlS[sqlpt-2 : sqlpt+1]
-		//line sql.y:1946
+		//line sql.y:2178
 		{
 			sqlVAL.tblExpr = &AliasedTableExpr{Expr: sqlDollar[1].qname, As: Name(sqlDollar[2].str)}
 		}
-	case 326:
+	case 355:
 		sqlDollar = sqlS[sqlpt-2 : sqlpt+1]
-		//line sql.y:1950
+		//line sql.y:2182
 		{
 			sqlVAL.tblExpr = &AliasedTableExpr{Expr: &Subquery{Select: sqlDollar[1].selectStmt}, As: Name(sqlDollar[2].str)}
 		}
-	case 328:
+	case 356:
+		sqlDollar = sqlS[sqlpt-2 : sqlpt+1]
+		//line sql.y:2186
+		{
+			fn, ok := sqlDollar[1].expr.(*FuncExpr)
+			if !ok {
+				sqllex.Error("syntax error")
+				return 1
+			}
+			sqlVAL.tblExpr = &AliasedTableExpr{Expr: fn, As: Name(sqlDollar[2].str)}
+		}
+	case 358:
 		sqlDollar = sqlS[sqlpt-4 : sqlpt+1]
-		//line sql.y:1954
+		//line sql.y:2195
 		{
 			unimplemented()
 		}
-	case 329:
+	case 359:
 		sqlDollar = sqlS[sqlpt-3 : sqlpt+1]
-		//line sql.y:1972
+		//line sql.y:2213
 		{
 			sqlVAL.tblExpr = &ParenTableExpr{Expr: sqlDollar[2].tblExpr}
 		}
-	case 330:
+	case 360:
 		sqlDollar = sqlS[sqlpt-4 : sqlpt+1]
-		//line sql.y:1976
+		//line sql.y:2217
 		{
 			sqlVAL.tblExpr = &JoinTableExpr{Join: astCrossJoin, Left: sqlDollar[1].tblExpr, Right: sqlDollar[4].tblExpr}
 		}
-	case 331:
+	case 361:
 		sqlDollar = sqlS[sqlpt-5 : sqlpt+1]
-		//line sql.y:1980
+		//line sql.y:2221
 		{
 			sqlVAL.tblExpr = &JoinTableExpr{Join: sqlDollar[2].str, Left: sqlDollar[1].tblExpr, Right: sqlDollar[4].tblExpr, Cond: sqlDollar[5].joinCond}
 		}
-	case 332:
+	case 362:
 		sqlDollar = sqlS[sqlpt-4 : sqlpt+1]
-		//line sql.y:1984
+		//line sql.y:2225
 		{
 			sqlVAL.tblExpr = &JoinTableExpr{Join: astJoin, Left: sqlDollar[1].tblExpr, Right: sqlDollar[3].tblExpr, Cond: sqlDollar[4].joinCond}
 		}
-	case 333:
+	case 363:
 		sqlDollar = sqlS[sqlpt-5 : sqlpt+1]
-		//line sql.y:1988
+		//line sql.y:2229
 		{
 			sqlVAL.tblExpr = &JoinTableExpr{Join: astNaturalJoin, Left: sqlDollar[1].tblExpr, Right: sqlDollar[5].tblExpr}
 		}
-	case 334:
+	case 364:
 		sqlDollar = sqlS[sqlpt-4 : sqlpt+1]
-		//line sql.y:1992
+		//line sql.y:2233
 		{
 			sqlVAL.tblExpr = &JoinTableExpr{Join: astNaturalJoin, Left: sqlDollar[1].tblExpr, Right: sqlDollar[4].tblExpr}
 		}
-	case 335:
+	case 365:
 		sqlDollar = sqlS[sqlpt-5 : sqlpt+1]
-		//line sql.y:1997
+		//line sql.y:2238
 		{
 			unimplemented()
 		}
-	case 336:
+	case 366:
 		sqlDollar = sqlS[sqlpt-2 : sqlpt+1]
-		//line sql.y:1999
+		//line sql.y:2240
 		{
 			sqlVAL.str = sqlDollar[2].str
 		}
-	case 337:
+	case 367:
 		sqlDollar = sqlS[sqlpt-4 : sqlpt+1]
-		//line sql.y:2002
+		//line sql.y:2243
 		{
 			unimplemented()
 		}
-	case 338:
+	case 368:
 		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
-		//line sql.y:2004
+		//line sql.y:2245
 		{
 			sqlVAL.str = sqlDollar[1].str
 		}
-	case 340:
+	case 370:
 		sqlDollar = sqlS[sqlpt-0 : sqlpt+1]
-		//line sql.y:2011
+		//line sql.y:2252
 		{
 			sqlVAL.str = ""
 		}
-	case 341:
+	case 371:
 		sqlDollar = sqlS[sqlpt-2 : sqlpt+1]
-		//line sql.y:2017
+		//line sql.y:2258
 		{
 			sqlVAL.str = astFullJoin
 		}
-	case 342:
+	case 372:
 		sqlDollar = sqlS[sqlpt-2 : sqlpt+1]
-		//line sql.y:2021
+		//line sql.y:2262
 		{
 			sqlVAL.str = astLeftJoin
 		}
-	case 343:
+	case 373:
 		sqlDollar = sqlS[sqlpt-2 : sqlpt+1]
-		//line sql.y:2025
+		//line sql.y:2266
 		{
 			sqlVAL.str = astRightJoin
 		}
-	case 344:
+	case 374:
 		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
-		//line sql.y:2029
+		//line sql.y:2270
 		{
 			sqlVAL.str = astInnerJoin
 		}
-	case 345:
+	case 375:
 		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
-		//line sql.y:2035
+		//line sql.y:2276
 		{
 		}
-	case 346:
+	case 376:
 		sqlDollar = sqlS[sqlpt-0 : sqlpt+1]
-		//line sql.y:2036
+		//line sql.y:2277
 		{
 		}
-	case 347:
+	case 377:
 		sqlDollar = sqlS[sqlpt-4 : sqlpt+1]
-		//line sql.y:2047
+		//line sql.y:2288
 		{
 			sqlVAL.joinCond = &UsingJoinCond{Cols: NameList(sqlDollar[3].strs)}
 		}
-	case 348:
+	case 378:
 		sqlDollar = sqlS[sqlpt-2 : sqlpt+1]
-		//line sql.y:2051
+		//line sql.y:2292
 		{
 			sqlVAL.joinCond = &OnJoinCond{Expr: sqlDollar[2].expr}
 		}
-	case 349:
+	case 379:
 		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
-		//line sql.y:2057
+		//line sql.y:2298
 		{
 			sqlVAL.qname = sqlDollar[1].qname
 		}
-	case 350:
+	case 380:
 		sqlDollar = sqlS[sqlpt-2 : sqlpt+1]
-		//line sql.y:2061
+		//line sql.y:2302
 		{
 			// TODO(pmattis): Handle the "*".
 			sqlVAL.qname = sqlDollar[1].qname
 		}
-	case 351:
+	case 381:
 		sqlDollar = sqlS[sqlpt-2 : sqlpt+1]
-		//line sql.y:2066
+		//line sql.y:2307
 		{
 			// TODO(pmattis): Support ONLY.
 			sqlVAL.qname = sqlDollar[2].qname
 		}
-	case 352:
+	case 382:
 		sqlDollar = sqlS[sqlpt-4 : sqlpt+1]
-		//line sql.y:2071
+		//line sql.y:2312
 		{
 			// TODO(pmattis): Support ONLY.
 			sqlVAL.qname = sqlDollar[3].qname
 		}
-	case 353:
+	case 383:
 		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
-		//line sql.y:2078
+		//line sql.y:2319
 		{
 			sqlVAL.qnames = QualifiedNames{sqlDollar[1].qname}
 		}
-	case 354:
+	case 384:
 		sqlDollar = sqlS[sqlpt-3 : sqlpt+1]
-		//line sql.y:2082
+		//line sql.y:2323
 		{
 			sqlVAL.qnames = append(sqlDollar[1].qnames, sqlDollar[3].qname)
 		}
-	case 355:
+	case 385:
 		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
-		//line sql.y:2095
+		//line sql.y:2336
 		{
 			sqlVAL.tblExpr = &AliasedTableExpr{Expr: sqlDollar[1].qname}
 		}
-	case 356:
+	case 386:
 		sqlDollar = sqlS[sqlpt-2 : sqlpt+1]
-		//line sql.y:2099
+		//line sql.y:2340
 		{
 			sqlVAL.tblExpr = &AliasedTableExpr{Expr: sqlDollar[1].qname, As: Name(sqlDollar[2].str)}
 		}
-	case 357:
+	case 387:
 		sqlDollar = sqlS[sqlpt-3 : sqlpt+1]
-		//line sql.y:2103
+		//line sql.y:2344
 		{
 			sqlVAL.tblExpr = &AliasedTableExpr{Expr: sqlDollar[1].qname, As: Name(sqlDollar[3].str)}
 		}
-	case 358:
+	case 388:
 		sqlDollar = sqlS[sqlpt-2 : sqlpt+1]
-		//line sql.y:2109
+		//line sql.y:2350
 		{
 			sqlVAL.expr = sqlDollar[2].expr
 		}
-	case 359:
+	case 389:
 		sqlDollar = sqlS[sqlpt-0 : sqlpt+1]
-		//line sql.y:2113
+		//line sql.y:2354
 		{
 			sqlVAL.expr = nil
 		}
-	case 360:
+	case 390:
 		sqlDollar = sqlS[sqlpt-2 : sqlpt+1]
-		//line sql.y:2125
+		//line sql.y:2366
 		{
-			sqlVAL.colType = sqlDollar[1].colType
+			if sqlDollar[2].boolVal {
+				sqlVAL.colType = &ArrayType{ElemType: sqlDollar[1].colType}
+			} else {
+				sqlVAL.colType = sqlDollar[1].colType
+			}
 		}
-	case 361:
+	case 391:
 		sqlDollar = sqlS[sqlpt-5 : sqlpt+1]
-		//line sql.y:2129
+		//line sql.y:2375
 		{
-			unimplemented()
+			sqlVAL.colType = &ArrayType{ElemType: sqlDollar[1].colType}
 		}
-	case 362:
+	case 392:
 		sqlDollar = sqlS[sqlpt-2 : sqlpt+1]
-		//line sql.y:2130
+		//line sql.y:2379
 		{
-			unimplemented()
+			sqlVAL.colType = &ArrayType{ElemType: sqlDollar[1].colType}
 		}
-	case 363:
+	case 393:
 		sqlDollar = sqlS[sqlpt-3 : sqlpt+1]
-		//line sql.y:2133
+		//line sql.y:2387
 		{
-			unimplemented()
+			if sqlDollar[1].boolVal {
+				sqllex.Error("multi-dimensional arrays are not supported")
+				return 1
+			}
+			sqlVAL.boolVal = true
 		}
-	case 364:
+	case 394:
 		sqlDollar = sqlS[sqlpt-4 : sqlpt+1]
-		//line sql.y:2134
+		//line sql.y:2395
 		{
-			unimplemented()
+			if sqlDollar[1].boolVal {
+				sqllex.Error("multi-dimensional arrays are not supported")
+				return 1
+			}
+			sqlVAL.boolVal = true
 		}
-	case 365:
+	case 395:
 		sqlDollar = sqlS[sqlpt-0 : sqlpt+1]
-		//line sql.y:2135
+		//line sql.y:2403
 		{
+			sqlVAL.boolVal = false
 		}
-	case 371:
+	case 401:
 		sqlDollar = sqlS[sqlpt-4 : sqlpt+1]
-		//line sql.y:2143
+		//line sql.y:2413
 		{
 			unimplemented()
 		}
-	case 372:
+	case 402:
 		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
-		//line sql.y:2145
+		//line sql.y:2415
 		{
 			sqlVAL.colType = &BytesType{Name: "BLOB"}
 		}
-	case 373:
+	case 403:
 		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
-		//line sql.y:2149
+		//line sql.y:2419
 		{
 			sqlVAL.colType = &BytesType{Name: "BYTES"}
 		}
-	case 374:
+	case 404:
+		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
+		//line sql.y:2423
+		{
+			sqlVAL.colType = &JSONType{Name: "JSON"}
+		}
+	case 405:
+		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
+		//line sql.y:2427
+		{
+			sqlVAL.colType = &JSONType{Name: "JSONB"}
+		}
+	case 406:
+		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
+		//line sql.y:2431
+		{
+			sqlVAL.colType = &UUIDType{}
+		}
+	case 407:
 		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
-		//line sql.y:2153
+		//line sql.y:2435
 		{
 			sqlVAL.colType = &StringType{Name: "TEXT"}
 		}
-	case 375:
+	case 408:
 		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
-		//line sql.y:2157
+		//line sql.y:2439
 		{
 			sqlVAL.colType = &StringType{Name: "STRING"}
 		}
-	case 380:
+	case 409:
+		sqlDollar = sqlS[sqlpt-4 : sqlpt+1]
+		//line sql.y:2443
+		{
+			sqlVAL.colType = &StringType{Name: "STRING", N: int(sqlDollar[3].ival)}
+		}
+	case 414:
 		sqlDollar = sqlS[sqlpt-3 : sqlpt+1]
-		//line sql.y:2178
+		//line sql.y:2464
 		{
 			sqlVAL.colType = &DecimalType{Prec: int(sqlDollar[2].ival)}
 		}
-	case 381:
+	case 415:
 		sqlDollar = sqlS[sqlpt-5 : sqlpt+1]
-		//line sql.y:2182
+		//line sql.y:2468
 		{
 			sqlVAL.colType = &DecimalType{Prec: int(sqlDollar[2].ival), Scale: int(sqlDollar[4].ival)}
 		}
-	case 382:
+	case 416:
 		sqlDollar = sqlS[sqlpt-0 : sqlpt+1]
-		//line sql.y:2186
+		//line sql.y:2472
 		{
 			sqlVAL.colType = &DecimalType{}
 		}
-	case 383:
+	case 417:
 		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
-		//line sql.y:2193
+		//line sql.y:2479
 		{
 			sqlVAL.colType = &IntType{Name: "INT"}
 		}
-	case 384:
+	case 418:
+		sqlDollar = sqlS[sqlpt-4 : sqlpt+1]
+		//line sql.y:2483
+		{
+			sqlVAL.colType = &IntType{Name: "INT", N: int(sqlDollar[3].ival)}
+		}
+	case 419:
 		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
-		//line sql.y:2197
+		//line sql.y:2487
 		{
 			sqlVAL.colType = &IntType{Name: "INT64"}
 		}
-	case 385:
+	case 420:
 		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
-		//line sql.y:2201
+		//line sql.y:2491
 		{
 			sqlVAL.colType = &IntType{Name: "INTEGER"}
 		}
-	case 386:
+	case 421:
 		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
-		//line sql.y:2205
+		//line sql.y:2495
 		{
 			sqlVAL.colType = &IntType{Name: "SMALLINT"}
 		}
-	case 387:
+	case 422:
 		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
-		//line sql.y:2209
+		//line sql.y:2499
 		{
 			sqlVAL.colType = &IntType{Name: "BIGINT"}
 		}
-	case 388:
+	case 423:
 		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
-		//line sql.y:2213
+		//line sql.y:2503
 		{
 			sqlVAL.colType = &FloatType{Name: "REAL"}
 		}
-	case 389:
+	case 424:
 		sqlDollar = sqlS[sqlpt-2 : sqlpt+1]
-		//line sql.y:2217
+		//line sql.y:2507
 		{
 			sqlVAL.colType = &FloatType{Name: "FLOAT", Prec: int(sqlDollar[2].ival)}
 		}
-	case 390:
+	case 425:
 		sqlDollar = sqlS[sqlpt-2 : sqlpt+1]
-		//line sql.y:2221
+		//line sql.y:2511
 		{
 			sqlVAL.colType = &FloatType{Name: "DOUBLE PRECISION"}
 		}
-	case 391:
+	case 426:
 		sqlDollar = sqlS[sqlpt-2 : sqlpt+1]
-		//line sql.y:2225
+		//line sql.y:2515
 		{
 			sqlVAL.colType = sqlDollar[2].colType
 			sqlVAL.colType.(*DecimalType).Name = "DECIMAL"
 		}
-	case 392:
+	case 427:
 		sqlDollar = sqlS[sqlpt-2 : sqlpt+1]
-		//line sql.y:2230
+		//line sql.y:2520
 		{
 			sqlVAL.colType = sqlDollar[2].colType
 			sqlVAL.colType.(*DecimalType).Name = "DEC"
 		}
-	case 393:
+	case 428:
 		sqlDollar = sqlS[sqlpt-2 : sqlpt+1]
-		//line sql.y:2235
+		//line sql.y:2525
 		{
 			sqlVAL.colType = sqlDollar[2].colType
 			sqlVAL.colType.(*DecimalType).Name = "NUMERIC"
 		}
-	case 394:
+	case 429:
 		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
-		//line sql.y:2240
+		//line sql.y:2530
 		{
 			sqlVAL.colType = &BoolType{Name: "BOOLEAN"}
 		}
-	case 395:
+	case 430:
 		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
-		//line sql.y:2244
+		//line sql.y:2534
 		{
 			sqlVAL.colType = &BoolType{Name: "BOOL"}
 		}
-	case 396:
+	case 431:
 		sqlDollar = sqlS[sqlpt-3 : sqlpt+1]
-		//line sql.y:2250
+		//line sql.y:2540
 		{
 			sqlVAL.ival = sqlDollar[2].ival
 		}
-	case 397:
+	case 432:
 		sqlDollar = sqlS[sqlpt-0 : sqlpt+1]
-		//line sql.y:2254
+		//line sql.y:2544
 		{
 			sqlVAL.ival = 0
 		}
-	case 402:
+	case 437:
 		sqlDollar = sqlS[sqlpt-5 : sqlpt+1]
-		//line sql.y:2272
+		//line sql.y:2562
 		{
 			sqlVAL.colType = &IntType{Name: "BIT", N: int(sqlDollar[4].ival)}
 		}
-	case 403:
+	case 438:
 		sqlDollar = sqlS[sqlpt-2 : sqlpt+1]
-		//line sql.y:2278
+		//line sql.y:2568
 		{
 			sqlVAL.colType = &IntType{Name: "BIT"}
 		}
-	case 408:
+	case 443:
 		sqlDollar = sqlS[sqlpt-4 : sqlpt+1]
-		//line sql.y:2294
+		//line sql.y:2584
 		{
 			sqlVAL.colType = sqlDollar[1].colType
 			sqlVAL.colType.(*StringType).N = int(sqlDollar[3].ival)
 		}
-	case 409:
+	case 444:
 		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
-		//line sql.y:2301
+		//line sql.y:2591
 		{
 			sqlVAL.colType = sqlDollar[1].colType
 		}
-	case 410:
+	case 445:
 		sqlDollar = sqlS[sqlpt-2 : sqlpt+1]
-		//line sql.y:2307
+		//line sql.y:2597
 		{
 			sqlVAL.colType = &StringType{Name: "CHAR"}
 		}
-	case 411:
+	case 446:
 		sqlDollar = sqlS[sqlpt-2 : sqlpt+1]
-		//line sql.y:2311
+		//line sql.y:2601
 		{
 			sqlVAL.colType = &StringType{Name: "CHAR"}
 		}
-	case 412:
+	case 447:
 		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
-		//line sql.y:2315
+		//line sql.y:2605
 		{
 			sqlVAL.colType = &StringType{Name: "VARCHAR"}
 		}
-	case 413:
+	case 448:
 		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
-		//line sql.y:2320
+		//line sql.y:2610
 		{
 		}
-	case 414:
+	case 449:
 		sqlDollar = sqlS[sqlpt-0 : sqlpt+1]
-		//line sql.y:2321
+		//line sql.y:2611
 		{
 		}
-	case 415:
+	case 450:
 		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
-		//line sql.y:2326
+		//line sql.y:2616
 		{
 			sqlVAL.colType = &DateType{}
 		}
-	case 416:
+	case 451:
 		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
-		//line sql.y:2330
+		//line sql.y:2620
 		{
 			sqlVAL.colType = &TimestampType{}
 		}
-	case 417:
+	case 452:
 		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
-		//line sql.y:2335
+		//line sql.y:2625
 		{
 			sqlVAL.colType = &IntervalType{}
 		}
-	case 418:
+	case 453:
 		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
-		//line sql.y:2340
+		//line sql.y:2630
 		{
 			unimplemented()
 		}
-	case 419:
+	case 454:
 		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
-		//line sql.y:2341
+		//line sql.y:2631
 		{
 			unimplemented()
 		}
-	case 420:
+	case 455:
 		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
-		//line sql.y:2342
+		//line sql.y:2632
 		{
 			unimplemented()
 		}
-	case 421:
+	case 456:
 		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
-		//line sql.y:2343
+		//line sql.y:2633
 		{
 			unimplemented()
 		}
-	case 422:
+	case 457:
 		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
-		//line sql.y:2344
+		//line sql.y:2634
 		{
 			unimplemented()
 		}
-	case 423:
+	case 458:
 		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
-		//line sql.y:2345
+		//line sql.y:2635
 		{
 			unimplemented()
 		}
-	case 424:
+	case 459:
 		sqlDollar = sqlS[sqlpt-3 : sqlpt+1]
-		//line sql.y:2346
+		//line sql.y:2636
 		{
 			unimplemented()
 		}
-	case 425:
+	case 460:
 		sqlDollar = sqlS[sqlpt-3 : sqlpt+1]
-		//line sql.y:2347
+		//line sql.y:2637
 		{
 			unimplemented()
 		}
-	case 426:
+	case 461:
 		sqlDollar = sqlS[sqlpt-3 : sqlpt+1]
-		//line sql.y:2348
+		//line sql.y:2638
 		{
 			unimplemented()
 		}
-	case 427:
+	case 462:
 		sqlDollar = sqlS[sqlpt-3 : sqlpt+1]
-		//line sql.y:2349
+		//line sql.y:2639
 		{
 			unimplemented()
 		}
-	case 428:
+	case 463:
 		sqlDollar = sqlS[sqlpt-3 : sqlpt+1]
-		//line sql.y:2350
+		//line sql.y:2640
 		{
 			unimplemented()
 		}
-	case 429:
+	case 464:
 		sqlDollar = sqlS[sqlpt-3 : sqlpt+1]
-		//line sql.y:2351
+		//line sql.y:2641
 		{
 			unimplemented()
 		}
-	case 430:
+	case 465:
 		sqlDollar = sqlS[sqlpt-3 : sqlpt+1]
-		//line sql.y:2352
+		//line sql.y:2642
 		{
 			unimplemented()
 		}
-	case 431:
+	case 466:
 		sqlDollar = sqlS[sqlpt-0 : sqlpt+1]
-		//line sql.y:2353
+		//line sql.y:2643
 		{
 		}
-	case 432:
+	case 467:
 		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
-		//line sql.y:2356
+		//line sql.y:2646
 		{
 			unimplemented()
 		}
-	case 433:
+	case 468:
 		sqlDollar = sqlS[sqlpt-4 : sqlpt+1]
-		//line sql.y:2357
+		//line sql.y:2647
 		{
 			unimplemented()
 		}
-	case 435:
+	case 470:
 		sqlDollar = sqlS[sqlpt-3 : sqlpt+1]
-		//line sql.y:2381
+		//line sql.y:2671
 		{
 			sqlVAL.expr = &CastExpr{Expr: sqlDollar[1].expr, Type: sqlDollar[3].colType}
 		}
-	case 436:
+	case 471:
 		sqlDollar = sqlS[sqlpt-3 : sqlpt+1]
-		//line sql.y:2384
+		//line sql.y:2674
 		{
 			unimplemented()
 		}
-	case 437:
+	case 472:
 		sqlDollar = sqlS[sqlpt-5 : sqlpt+1]
-		//line sql.y:2385
+		//line sql.y:2675
 		{
 			unimplemented()
 		}
-	case 438:
+	case 473:
 		sqlDollar = sqlS[sqlpt-2 : sqlpt+1]
-		//line sql.y:2394
+		//line sql.y:2684
 		{
 			sqlVAL.expr = &UnaryExpr{Operator: UnaryPlus, Expr: sqlDollar[2].expr}
 		}
-	case 439:
+	case 474:
 		sqlDollar = sqlS[sqlpt-2 : sqlpt+1]
-		//line sql.y:2398
+		//line sql.y:2688
 		{
 			sqlVAL.expr = &UnaryExpr{Operator: UnaryMinus, Expr: sqlDollar[2].expr}
 		}
-	case 440:
+	case 475:
 		sqlDollar = sqlS[sqlpt-2 : sqlpt+1]
-		//line sql.y:2402
+		//line sql.y:2692
 		{
 			sqlVAL.expr = &UnaryExpr{Operator: UnaryComplement, Expr: sqlDollar[2].expr}
 		}
-	case 441:
+	case 476:
 		sqlDollar = sqlS[sqlpt-3 : sqlpt+1]
-		//line sql.y:2406
+		//line sql.y:2696
 		{
 			sqlVAL.expr = &BinaryExpr{Operator: Plus, Left: sqlDollar[1].expr, Right: sqlDollar[3].expr}
 		}
-	case 442:
+	case 477:
 		sqlDollar = sqlS[sqlpt-3 : sqlpt+1]
-		//line sql.y:2410
+		//line sql.y:2700
 		{
 			sqlVAL.expr = &BinaryExpr{Operator: Minus, Left: sqlDollar[1].expr, Right: sqlDollar[3].expr}
 		}
-	case 443:
+	case 478:
 		sqlDollar = sqlS[sqlpt-3 : sqlpt+1]
-		//line sql.y:2414
+		//line sql.y:2704
 		{
 			sqlVAL.expr = &BinaryExpr{Operator: Mult, Left: sqlDollar[1].expr, Right: sqlDollar[3].expr}
 		}
-	case 444:
+	case 479:
 		sqlDollar = sqlS[sqlpt-3 : sqlpt+1]
-		//line sql.y:2418
+		//line sql.y:2708
 		{
 			sqlVAL.expr = &BinaryExpr{Operator: Div, Left: sqlDollar[1].expr, Right: sqlDollar[3].expr}
 		}
-	case 445:
+	case 480:
 		sqlDollar = sqlS[sqlpt-3 : sqlpt+1]
-		//line sql.y:2422
+		//line sql.y:2712
 		{
 			sqlVAL.expr = &BinaryExpr{Operator: Mod, Left: sqlDollar[1].expr, Right: sqlDollar[3].expr}
 		}
-	case 446:
+	case 481:
 		sqlDollar = sqlS[sqlpt-3 : sqlpt+1]
-		//line sql.y:2426
+		//line sql.y:2716
 		{
 			sqlVAL.expr = &BinaryExpr{Operator: Bitxor, Left: sqlDollar[1].expr, Right: sqlDollar[3].expr}
 		}
-	case 447:
+	case 482:
 		sqlDollar = sqlS[sqlpt-3 : sqlpt+1]
-		//line sql.y:2430
+		//line sql.y:2720
 		{
 			sqlVAL.expr = &BinaryExpr{Operator: Bitxor, Left: sqlDollar[1].expr, Right: sqlDollar[3].expr}
 		}
-	case 448:
+	case 483:
 		sqlDollar = sqlS[sqlpt-3 : sqlpt+1]
-		//line sql.y:2434
+		//line sql.y:2724
 		{
 			sqlVAL.expr = &BinaryExpr{Operator: Bitand, Left: sqlDollar[1].expr, Right: sqlDollar[3].expr}
 		}
-	case 449:
+	case 484:
 		sqlDollar = sqlS[sqlpt-3 : sqlpt+1]
-		//line sql.y:2438
+		//line sql.y:2728
 		{
 			sqlVAL.expr = &BinaryExpr{Operator: Bitor, Left: sqlDollar[1].expr, Right: sqlDollar[3].expr}
 		}
-	case 450:
+	case 485:
 		sqlDollar = sqlS[sqlpt-3 : sqlpt+1]
-		//line sql.y:2442
+		//line sql.y:2732
 		{
 			sqlVAL.expr = &ComparisonExpr{Operator: LT, Left: sqlDollar[1].expr, Right: sqlDollar[3].expr}
 		}
-	case 451:
+	case 486:
 		sqlDollar = sqlS[sqlpt-3 : sqlpt+1]
-		//line sql.y:2446
+		//line sql.y:2736
 		{
 			sqlVAL.expr = &ComparisonExpr{Operator: GT, Left: sqlDollar[1].expr, Right: sqlDollar[3].expr}
 		}
-	case 452:
+	case 487:
 		sqlDollar = sqlS[sqlpt-3 : sqlpt+1]
-		//line sql.y:2450
+		//line sql.y:2740
 		{
 			sqlVAL.expr = &ComparisonExpr{Operator: EQ, Left: sqlDollar[1].expr, Right: sqlDollar[3].expr}
 		}
-	case 453:
+	case 488:
+		sqlDollar = sqlS[sqlpt-3 : sqlpt+1]
+		//line sql.y:2744
+		{
+			sqlVAL.expr = &BinaryExpr{Operator: Concat, Left: sqlDollar[1].expr, Right: sqlDollar[3].expr}
+		}
+	case 489:
+		sqlDollar = sqlS[sqlpt-3 : sqlpt+1]
+		//line sql.y:2748
+		{
+			sqlVAL.expr = &BinaryExpr{Operator: FetchVal, Left: sqlDollar[1].expr, Right: sqlDollar[3].expr}
+		}
+	case 490:
+		sqlDollar = sqlS[sqlpt-3 : sqlpt+1]
+		//line sql.y:2752
+		{
+			sqlVAL.expr = &BinaryExpr{Operator: FetchText, Left: sqlDollar[1].expr, Right: sqlDollar[3].expr}
+		}
+	case 491:
 		sqlDollar = sqlS[sqlpt-3 : sqlpt+1]
-		//line sql.y:2454
+		//line sql.y:2756
 		{
-			sqlVAL.expr = &BinaryExpr{Operator: Concat, Left: sqlDollar[1].expr, Right: sqlDollar[3].expr}
+			sqlVAL.expr = &ComparisonExpr{Operator: Contains, Left: sqlDollar[1].expr, Right: sqlDollar[3].expr}
 		}
-	case 454:
+	case 492:
 		sqlDollar = sqlS[sqlpt-3 : sqlpt+1]
-		//line sql.y:2458
+		//line sql.y:2760
 		{
 			sqlVAL.expr = &BinaryExpr{Operator: LShift, Left: sqlDollar[1].expr, Right: sqlDollar[3].expr}
 		}
-	case 455:
+	case 493:
 		sqlDollar = sqlS[sqlpt-3 : sqlpt+1]
-		//line sql.y:2462
+		//line sql.y:2764
 		{
 			sqlVAL.expr = &BinaryExpr{Operator: RShift, Left: sqlDollar[1].expr, Right: sqlDollar[3].expr}
 		}
-	case 456:
+	case 494:
 		sqlDollar = sqlS[sqlpt-3 : sqlpt+1]
-		//line sql.y:2466
+		//line sql.y:2768
 		{
 			sqlVAL.expr = &ComparisonExpr{Operator: LE, Left: sqlDollar[1].expr, Right: sqlDollar[3].expr}
 		}
-	case 457:
+	case 495:
 		sqlDollar = sqlS[sqlpt-3 : sqlpt+1]
-		//line sql.y:2470
+		//line sql.y:2772
 		{
 			sqlVAL.expr = &ComparisonExpr{Operator: GE, Left: sqlDollar[1].expr, Right: sqlDollar[3].expr}
 		}
-	case 458:
+	case 496:
 		sqlDollar = sqlS[sqlpt-3 : sqlpt+1]
-		//line sql.y:2474
+		//line sql.y:2776
 		{
 			sqlVAL.expr = &ComparisonExpr{Operator: NE, Left: sqlDollar[1].expr, Right: sqlDollar[3].expr}
 		}
-	case 459:
+	case 497:
 		sqlDollar = sqlS[sqlpt-3 : sqlpt+1]
-		//line sql.y:2478
+		//line sql.y:2780
 		{
 			sqlVAL.expr = &AndExpr{Left: sqlDollar[1].expr, Right: sqlDollar[3].expr}
 		}
-	case 460:
+	case 498:
 		sqlDollar = sqlS[sqlpt-3 : sqlpt+1]
-		//line sql.y:2482
+		//line sql.y:2784
 		{
 			sqlVAL.expr = &OrExpr{Left: sqlDollar[1].expr, Right: sqlDollar[3].expr}
 		}
-	case 461:
+	case 499:
 		sqlDollar = sqlS[sqlpt-2 : sqlpt+1]
-		//line sql.y:2486
+		//line sql.y:2788
 		{
 			sqlVAL.expr = &NotExpr{Expr: sqlDollar[2].expr}
 		}
-	case 462:
+	case 500:
 		sqlDollar = sqlS[sqlpt-2 : sqlpt+1]
-		//line sql.y:2490
+		//line sql.y:2792
 		{
 			sqlVAL.expr = &NotExpr{Expr: sqlDollar[2].expr}
 		}
-	case 463:
+	case 501:
 		sqlDollar = sqlS[sqlpt-3 : sqlpt+1]
-		//line sql.y:2494
+		//line sql.y:2796
 		{
 			sqlVAL.expr = &ComparisonExpr{Operator: Like, Left: sqlDollar[1].expr, Right: sqlDollar[3].expr}
 		}
-	case 464:
+	case 502:
 		sqlDollar = sqlS[sqlpt-4 : sqlpt+1]
-		//line sql.y:2498
+		//line sql.y:2800
 		{
 			sqlVAL.expr = &ComparisonExpr{Operator: NotLike, Left: sqlDollar[1].expr, Right: sqlDollar[4].expr}
 		}
-	case 465:
+	case 503:
 		sqlDollar = sqlS[sqlpt-4 : sqlpt+1]
-		//line sql.y:2502
+		//line sql.y:2804
 		{
 			sqlVAL.expr = &ComparisonExpr{Operator: SimilarTo, Left: sqlDollar[1].expr, Right: sqlDollar[4].expr}
 		}
-	case 466:
+	case 504:
 		sqlDollar = sqlS[sqlpt-5 : sqlpt+1]
-		//line sql.y:2506
+		//line sql.y:2808
 		{
 			sqlVAL.expr = &ComparisonExpr{Operator: NotSimilarTo, Left: sqlDollar[1].expr, Right: sqlDollar[5].expr}
 		}
-	case 467:
+	case 505:
+		sqlDollar = sqlS[sqlpt-6 : sqlpt+1]
+		//line sql.y:2812
+		{
+			sqlVAL.expr = &AnyExpr{Operator: sqlDollar[2].comparisonOp, Type: sqlDollar[3].str, Left: sqlDollar[1].expr, Right: sqlDollar[5].expr}
+		}
+	case 506:
 		sqlDollar = sqlS[sqlpt-3 : sqlpt+1]
-		//line sql.y:2510
+		//line sql.y:2816
 		{
 			sqlVAL.expr = &ComparisonExpr{Operator: Is, Left: sqlDollar[1].expr, Right: DNull}
 		}
-	case 468:
+	case 507:
 		sqlDollar = sqlS[sqlpt-4 : sqlpt+1]
-		//line sql.y:2514
+		//line sql.y:2820
 		{
 			sqlVAL.expr = &ComparisonExpr{Operator: IsNot, Left: sqlDollar[1].expr, Right: DNull}
 		}
-	case 469:
+	case 508:
 		sqlDollar = sqlS[sqlpt-3 : sqlpt+1]
-		//line sql.y:2517
+		//line sql.y:2823
 		{
 			unimplemented()
 		}
-	case 470:
+	case 509:
 		sqlDollar = sqlS[sqlpt-3 : sqlpt+1]
-		//line sql.y:2519
+		//line sql.y:2825
 		{
 			sqlVAL.expr = &ComparisonExpr{Operator: Is, Left: sqlDollar[1].expr, Right: DBool(true)}
 		}
-	case 471:
+	case 510:
 		sqlDollar = sqlS[sqlpt-4 : sqlpt+1]
-		//line sql.y:2523
+		//line sql.y:2829
 		{
 			sqlVAL.expr = &ComparisonExpr{Operator: IsNot, Left: sqlDollar[1].expr, Right: DBool(true)}
 		}
-	case 472:
+	case 511:
 		sqlDollar = sqlS[sqlpt-3 : sqlpt+1]
-		//line sql.y:2527
+		//line sql.y:2833
 		{
 			sqlVAL.expr = &ComparisonExpr{Operator: Is, Left: sqlDollar[1].expr, Right: DBool(false)}
 		}
-	case 473:
+	case 512:
 		sqlDollar = sqlS[sqlpt-4 : sqlpt+1]
-		//line sql.y:2531
+		//line sql.y:2837
 		{
 			sqlVAL.expr = &ComparisonExpr{Operator: IsNot, Left: sqlDollar[1].expr, Right: DBool(false)}
 		}
-	case 474:
+	case 513:
 		sqlDollar = sqlS[sqlpt-3 : sqlpt+1]
-		//line sql.y:2535
+		//line sql.y:2841
 		{
 			sqlVAL.expr = &ComparisonExpr{Operator: Is, Left: sqlDollar[1].expr, Right: DNull}
 		}
-	case 475:
+	case 514:
 		sqlDollar = sqlS[sqlpt-4 : sqlpt+1]
-		//line sql.y:2539
+		//line sql.y:2845
 		{
 			sqlVAL.expr = &ComparisonExpr{Operator: IsNot, Left: sqlDollar[1].expr, Right: DNull}
 		}
-	case 476:
+	case 515:
 		sqlDollar = sqlS[sqlpt-5 : sqlpt+1]
-		//line sql.y:2543
+		//line sql.y:2849
 		{
 			sqlVAL.expr = &ComparisonExpr{Operator: IsDistinctFrom, Left: sqlDollar[1].expr, Right: sqlDollar[5].expr}
 		}
-	case 477:
+	case 516:
 		sqlDollar = sqlS[sqlpt-6 : sqlpt+1]
-		//line sql.y:2547
+		//line sql.y:2853
 		{
 			sqlVAL.expr = &ComparisonExpr{Operator: IsNotDistinctFrom, Left: sqlDollar[1].expr, Right: sqlDollar[6].expr}
 		}
-	case 478:
+	case 517:
 		sqlDollar = sqlS[sqlpt-6 : sqlpt+1]
-		//line sql.y:2551
+		//line sql.y:2857
 		{
 			sqlVAL.expr = &IsOfTypeExpr{Expr: sqlDollar[1].expr, Types: sqlDollar[5].colTypes}
 		}
-	case 479:
+	case 518:
 		sqlDollar = sqlS[sqlpt-7 : sqlpt+1]
-		//line sql.y:2555
+		//line sql.y:2861
 		{
 			sqlVAL.expr = &IsOfTypeExpr{Not: true, Expr: sqlDollar[1].expr, Types: sqlDollar[6].colTypes}
 		}
-	case 480:
+	case 519:
 		sqlDollar = sqlS[sqlpt-6 : sqlpt+1]
-		//line sql.y:2559
+		//line sql.y:2865
 		{
 			sqlVAL.expr = &RangeCond{Left: sqlDollar[1].expr, From: sqlDollar[4].expr, To: sqlDollar[6].expr}
 		}
-	case 481:
+	case 520:
 		sqlDollar = sqlS[sqlpt-7 : sqlpt+1]
-		//line sql.y:2563
+		//line sql.y:2869
 		{
 			sqlVAL.expr = &RangeCond{Not: true, Left: sqlDollar[1].expr, From: sqlDollar[5].expr, To: sqlDollar[7].expr}
 		}
-	case 482:
+	case 521:
 		sqlDollar = sqlS[sqlpt-6 : sqlpt+1]
-		//line sql.y:2567
+		//line sql.y:2873
 		{
 			sqlVAL.expr = &RangeCond{Left: sqlDollar[1].expr, From: sqlDollar[4].expr, To: sqlDollar[6].expr}
 		}
-	case 483:
+	case 522:
 		sqlDollar = sqlS[sqlpt-7 : sqlpt+1]
-		//line sql.y:2571
+		//line sql.y:2877
 		{
 			sqlVAL.expr = &RangeCond{Not: true, Left: sqlDollar[1].expr, From: sqlDollar[5].expr, To: sqlDollar[7].expr}
 		}
-	case 484:
+	case 523:
 		sqlDollar = sqlS[sqlpt-3 : sqlpt+1]
-		//line sql.y:2575
+		//line sql.y:2881
 		{
 			sqlVAL.expr = &ComparisonExpr{Operator: In, Left: sqlDollar[1].expr, Right: sqlDollar[3].expr}
 		}
-	case 485:
+	case 524:
 		sqlDollar = sqlS[sqlpt-4 : sqlpt+1]
-		//line sql.y:2579
+		//line sql.y:2885
 		{
 			sqlVAL.expr = &ComparisonExpr{Operator: NotIn, Left: sqlDollar[1].expr, Right: sqlDollar[4].expr}
 		}
-	case 487:
+	case 526:
 		sqlDollar = sqlS[sqlpt-3 : sqlpt+1]
-		//line sql.y:2596
+		//line sql.y:2902
 		{
 			sqlVAL.expr = &CastExpr{Expr: sqlDollar[1].expr, Type: sqlDollar[3].colType}
 		}
-	case 488:
+	case 527:
 		sqlDollar = sqlS[sqlpt-2 : sqlpt+1]
-		//line sql.y:2600
+		//line sql.y:2906
 		{
 			sqlVAL.expr = &UnaryExpr{Operator: UnaryPlus, Expr: sqlDollar[2].expr}
 		}
-	case 489:
+	case 528:
 		sqlDollar = sqlS[sqlpt-2 : sqlpt+1]
-		//line sql.y:2604
+		//line sql.y:2910
 		{
 			sqlVAL.expr = &UnaryExpr{Operator: UnaryMinus, Expr: sqlDollar[2].expr}
 		}
-	case 490:
+	case 529:
 		sqlDollar = sqlS[sqlpt-2 : sqlpt+1]
-		//line sql.y:2608
+		//line sql.y:2914
 		{
 			sqlVAL.expr = &UnaryExpr{Operator: UnaryComplement, Expr: sqlDollar[2].expr}
 		}
-	case 491:
+	case 530:
 		sqlDollar = sqlS[sqlpt-3 : sqlpt+1]
-		//line sql.y:2612
+		//line sql.y:2918
 		{
 			sqlVAL.expr = &BinaryExpr{Operator: Plus, Left: sqlDollar[1].expr, Right: sqlDollar[3].expr}
 		}
-	case 492:
+	case 531:
 		sqlDollar = sqlS[sqlpt-3 : sqlpt+1]
-		//line sql.y:2616
+		//line sql.y:2922
 		{
 			sqlVAL.expr = &BinaryExpr{Operator: Minus, Left: sqlDollar[1].expr, Right: sqlDollar[3].expr}
 		}
-	case 493:
+	case 532:
 		sqlDollar = sqlS[sqlpt-3 : sqlpt+1]
-		//line sql.y:2620
+		//line sql.y:2926
 		{
 			sqlVAL.expr = &BinaryExpr{Operator: Mult, Left: sqlDollar[1].expr, Right: sqlDollar[3].expr}
 		}
-	case 494:
+	case 533:
 		sqlDollar = sqlS[sqlpt-3 : sqlpt+1]
-		//line sql.y:2624
+		//line sql.y:2930
 		{
 			sqlVAL.expr = &BinaryExpr{Operator: Div, Left: sqlDollar[1].expr, Right: sqlDollar[3].expr}
 		}
-	case 495:
+	case 534:
 		sqlDollar = sqlS[sqlpt-3 : sqlpt+1]
-		//line sql.y:2628
+		//line sql.y:2934
 		{
 			sqlVAL.expr = &BinaryExpr{Operator: Mod, Left: sqlDollar[1].expr, Right: sqlDollar[3].expr}
 		}
-	case 496:
+	case 535:
 		sqlDollar = sqlS[sqlpt-3 : sqlpt+1]
-		//line sql.y:2632
+		//line sql.y:2938
 		{
 			sqlVAL.expr = &BinaryExpr{Operator: Bitxor, Left: sqlDollar[1].expr, Right: sqlDollar[3].expr}
 		}
-	case 497:
+	case 536:
 		sqlDollar = sqlS[sqlpt-3 : sqlpt+1]
-		//line sql.y:2636
+		//line sql.y:2942
 		{
 			sqlVAL.expr = &BinaryExpr{Operator: Bitxor, Left: sqlDollar[1].expr, Right: sqlDollar[3].expr}
 		}
-	case 498:
+	case 537:
 		sqlDollar = sqlS[sqlpt-3 : sqlpt+1]
-		//line sql.y:2640
+		//line sql.y:2946
 		{
 			sqlVAL.expr = &BinaryExpr{Operator: Bitand, Left: sqlDollar[1].expr, Right: sqlDollar[3].expr}
 		}
-	case 499:
+	case 538:
 		sqlDollar = sqlS[sqlpt-3 : sqlpt+1]
-		//line sql.y:2644
+		//line sql.y:2950
 		{
 			sqlVAL.expr = &BinaryExpr{Operator: Bitor, Left: sqlDollar[1].expr, Right: sqlDollar[3].expr}
 		}
-	case 500:
+	case 539:
 		sqlDollar = sqlS[sqlpt-3 : sqlpt+1]
-		//line sql.y:2648
+		//line sql.y:2954
 		{
 			sqlVAL.expr = &ComparisonExpr{Operator: LT, Left: sqlDollar[1].expr, Right: sqlDollar[3].expr}
 		}
-	case 501:
+	case 540:
 		sqlDollar = sqlS[sqlpt-3 : sqlpt+1]
-		//line sql.y:2652
+		//line sql.y:2958
 		{
 			sqlVAL.expr = &ComparisonExpr{Operator: GT, Left: sqlDollar[1].expr, Right: sqlDollar[3].expr}
 		}
-	case 502:
+	case 541:
 		sqlDollar = sqlS[sqlpt-3 : sqlpt+1]
-		//line sql.y:2656
+		//line sql.y:2962
 		{
 			sqlVAL.expr = &ComparisonExpr{Operator: EQ, Left: sqlDollar[1].expr, Right: sqlDollar[3].expr}
 		}
-	case 503:
+	case 542:
 		sqlDollar = sqlS[sqlpt-3 : sqlpt+1]
-		//line sql.y:2660
+		//line sql.y:2966
 		{
 			sqlVAL.expr = &BinaryExpr{Operator: Concat, Left: sqlDollar[1].expr, Right: sqlDollar[3].expr}
 		}
-	case 504:
+	case 543:
+		sqlDollar = sqlS[sqlpt-3 : sqlpt+1]
+		//line sql.y:2970
+		{
+			sqlVAL.expr = &BinaryExpr{Operator: FetchVal, Left: sqlDollar[1].expr, Right: sqlDollar[3].expr}
+		}
+	case 544:
+		sqlDollar = sqlS[sqlpt-3 : sqlpt+1]
+		//line sql.y:2974
+		{
+			sqlVAL.expr = &BinaryExpr{Operator: FetchText, Left: sqlDollar[1].expr, Right: sqlDollar[3].expr}
+		}
+	case 545:
+		sqlDollar = sqlS[sqlpt-3 : sqlpt+1]
+		//line sql.y:2978
+		{
+			sqlVAL.expr = &ComparisonExpr{Operator: Contains, Left: sqlDollar[1].expr, Right: sqlDollar[3].expr}
+		}
+	case 546:
 		sqlDollar = sqlS[sqlpt-3 : sqlpt+1]
-		//line sql.y:2664
+		//line sql.y:2982
 		{
 			sqlVAL.expr = &BinaryExpr{Operator: LShift, Left: sqlDollar[1].expr, Right: sqlDollar[3].expr}
 		}
-	case 505:
+	case 547:
 		sqlDollar = sqlS[sqlpt-3 : sqlpt+1]
-		//line sql.y:2668
+		//line sql.y:2986
 		{
 			sqlVAL.expr = &BinaryExpr{Operator: RShift, Left: sqlDollar[1].expr, Right: sqlDollar[3].expr}
 		}
-	case 506:
+	case 548:
 		sqlDollar = sqlS[sqlpt-3 : sqlpt+1]
-		//line sql.y:2672
+		//line sql.y:2990
 		{
 			sqlVAL.expr = &ComparisonExpr{Operator: LE, Left: sqlDollar[1].expr, Right: sqlDollar[3].expr}
 		}
-	case 507:
+	case 549:
 		sqlDollar = sqlS[sqlpt-3 : sqlpt+1]
-		//line sql.y:2676
+		//line sql.y:2994
 		{
 			sqlVAL.expr = &ComparisonExpr{Operator: GE, Left: sqlDollar[1].expr, Right: sqlDollar[3].expr}
 		}
-	case 508:
+	case 550:
 		sqlDollar = sqlS[sqlpt-3 : sqlpt+1]
-		//line sql.y:2680
+		//line sql.y:2998
 		{
 			sqlVAL.expr = &ComparisonExpr{Operator: NE, Left: sqlDollar[1].expr, Right: sqlDollar[3].expr}
 		}
-	case 509:
+	case 551:
 		sqlDollar = sqlS[sqlpt-5 : sqlpt+1]
-		//line sql.y:2684
+		//line sql.y:3002
 		{
 			sqlVAL.expr = &ComparisonExpr{Operator: IsDistinctFrom, Left: sqlDollar[1].expr, Right: sqlDollar[5].expr}
 		}
-	case 510:
+	case 552:
 		sqlDollar = sqlS[sqlpt-6 : sqlpt+1]
-		//line sql.y:2688
+		//line sql.y:3006
 		{
 			sqlVAL.expr = &ComparisonExpr{Operator: IsNotDistinctFrom, Left: sqlDollar[1].expr, Right: sqlDollar[6].expr}
 		}
-	case 511:
+	case 553:
 		sqlDollar = sqlS[sqlpt-6 : sqlpt+1]
-		//line sql.y:2692
+		//line sql.y:3010
 		{
 			sqlVAL.expr = &IsOfTypeExpr{Expr: sqlDollar[1].expr, Types: sqlDollar[5].colTypes}
 		}
-	case 512:
+	case 554:
 		sqlDollar = sqlS[sqlpt-7 : sqlpt+1]
-		//line sql.y:2696
+		//line sql.y:3014
 		{
 			sqlVAL.expr = &IsOfTypeExpr{Not: true, Expr: sqlDollar[1].expr, Types: sqlDollar[6].colTypes}
 		}
-	case 513:
+	case 555:
 		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
-		//line sql.y:2708
+		//line sql.y:3026
 		{
 			sqlVAL.expr = sqlDollar[1].qname
 		}
-	case 515:
+	case 557:
 		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
-		//line sql.y:2713
+		//line sql.y:3031
 		{
 			sqlVAL.expr = ValArg{name: sqlDollar[1].str}
 		}
-	case 516:
+	case 558:
 		sqlDollar = sqlS[sqlpt-3 : sqlpt+1]
-		//line sql.y:2717
+		//line sql.y:3035
 		{
 			sqlVAL.expr = &ParenExpr{Expr: sqlDollar[2].expr}
 		}
-	case 519:
+	case 559:
+		sqlDollar = sqlS[sqlpt-4 : sqlpt+1]
+		//line sql.y:3039
+		{
+			var expr Expr = &ParenExpr{Expr: sqlDollar[2].expr}
+			for _, elem := range sqlDollar[4].indirect {
+				subscript, ok := elem.(*ArrayIndirection)
+				if !ok {
+					sqllex.Error("only array subscripts are supported on expressions")
+					return 1
+				}
+				expr = &IndirectionExpr{Expr: expr, Indirection: subscript}
+			}
+			sqlVAL.expr = expr
+		}
+	case 562:
 		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
-		//line sql.y:2723
+		//line sql.y:3054
 		{
 			sqlVAL.expr = &Subquery{Select: sqlDollar[1].selectStmt}
 		}
-	case 520:
+	case 563:
 		sqlDollar = sqlS[sqlpt-2 : sqlpt+1]
-		//line sql.y:2727
+		//line sql.y:3058
 		{
 			sqlVAL.expr = &Subquery{Select: sqlDollar[1].selectStmt}
 		}
-	case 521:
+	case 564:
 		sqlDollar = sqlS[sqlpt-2 : sqlpt+1]
-		//line sql.y:2731
+		//line sql.y:3062
 		{
 			sqlVAL.expr = &ExistsExpr{Subquery: &Subquery{Select: sqlDollar[2].selectStmt}}
 		}
-	case 522:
+	case 565:
 		sqlDollar = sqlS[sqlpt-2 : sqlpt+1]
-		//line sql.y:2737
+		//line sql.y:3068
 		{
 			sqlVAL.expr = sqlDollar[2].expr
 		}
-	case 523:
+	case 566:
 		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
-		//line sql.y:2741
+		//line sql.y:3072
 		{
 			sqlVAL.expr = sqlDollar[1].expr
 		}
-	case 524:
+	case 567:
 		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
-		//line sql.y:2745
+		//line sql.y:3076
 		{
 			sqlVAL.expr = sqlDollar[1].expr
 		}
-	case 525:
+	case 568:
 		sqlDollar = sqlS[sqlpt-3 : sqlpt+1]
-		//line sql.y:2753
+		//line sql.y:3084
 		{
 			sqlVAL.expr = &FuncExpr{Name: sqlDollar[1].qname}
 		}
-	case 526:
+	case 569:
 		sqlDollar = sqlS[sqlpt-5 : sqlpt+1]
-		//line sql.y:2757
+		//line sql.y:3088
 		{
 			// TODO(pmattis): Support opt_sort_clause or remove it?
 			sqlVAL.expr = &FuncExpr{Name: sqlDollar[1].qname, Exprs: sqlDollar[3].exprs}
 		}
-	case 527:
+	case 570:
 		sqlDollar = sqlS[sqlpt-6 : sqlpt+1]
-		//line sql.y:2761
+		//line sql.y:3092
 		{
 			unimplemented()
 		}
-	case 528:
+	case 571:
 		sqlDollar = sqlS[sqlpt-8 : sqlpt+1]
-		//line sql.y:2762
+		//line sql.y:3093
 		{
 			unimplemented()
 		}
-	case 529:
+	case 572:
 		sqlDollar = sqlS[sqlpt-6 : sqlpt+1]
-		//line sql.y:2763
+		//line sql.y:3094
 		{
 			unimplemented()
 		}
-	case 530:
+	case 573:
 		sqlDollar = sqlS[sqlpt-6 : sqlpt+1]
-		//line sql.y:2765
+		//line sql.y:3096
 		{
 			// TODO(pmattis): Support opt_sort_clause or remove it?
 			sqlVAL.expr = &FuncExpr{Name: sqlDollar[1].qname, Distinct: true, Exprs: sqlDollar[4].exprs}
 		}
-	case 531:
+	case 574:
 		sqlDollar = sqlS[sqlpt-4 : sqlpt+1]
-		//line sql.y:2770
+		//line sql.y:3101
 		{
 			sqlVAL.expr = &FuncExpr{Name: sqlDollar[1].qname, Exprs: Exprs{StarExpr()}}
 		}
-	case 532:
+	case 575:
 		sqlDollar = sqlS[sqlpt-4 : sqlpt+1]
-		//line sql.y:2783
+		//line sql.y:3114
 		{
 			// TODO(pmattis): Support within_group_clause, filter_clause and
 			// over_clause?
 			sqlVAL.expr = sqlDollar[1].expr
 		}
-	case 533:
+	case 576:
 		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
-		//line sql.y:2789
+		//line sql.y:3120
 		{
 			sqlVAL.expr = sqlDollar[1].expr
 		}
-	case 534:
-		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
-		//line sql.y:2798
-		{
-			unimplemented()
-		}
-	case 535:
-		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
-		//line sql.y:2799
-		{
-			unimplemented()
-		}
-	case 536:
+	case 579:
 		sqlDollar = sqlS[sqlpt-5 : sqlpt+1]
-		//line sql.y:2803
+		//line sql.y:3134
 		{
 			unimplemented()
 		}
-	case 537:
+	case 580:
 		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
-		//line sql.y:2805
+		//line sql.y:3136
 		{
 			sqlVAL.expr = &FuncExpr{Name: &QualifiedName{Base: Name(sqlDollar[1].str)}}
 		}
-	case 538:
+	case 581:
 		sqlDollar = sqlS[sqlpt-3 : sqlpt+1]
-		//line sql.y:2809
+		//line sql.y:3140
 		{
 			sqlVAL.expr = &FuncExpr{Name: &QualifiedName{Base: Name(sqlDollar[1].str)}}
 		}
-	case 539:
+	case 582:
 		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
-		//line sql.y:2813
+		//line sql.y:3144
 		{
 			sqlVAL.expr = &FuncExpr{Name: &QualifiedName{Base: Name(sqlDollar[1].str)}}
 		}
-	case 540:
+	case 583:
 		sqlDollar = sqlS[sqlpt-3 : sqlpt+1]
-		//line sql.y:2817
+		//line sql.y:3148
 		{
 			sqlVAL.expr = &FuncExpr{Name: &QualifiedName{Base: Name(sqlDollar[1].str)}}
 		}
-	case 541:
+	case 584:
 		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
-		//line sql.y:2820
+		//line sql.y:3151
 		{
 			unimplemented()
 		}
-	case 542:
+	case 585:
 		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
-		//line sql.y:2821
+		//line sql.y:3152
 		{
 			unimplemented()
 		}
-	case 543:
+	case 586:
 		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
-		//line sql.y:2822
+		//line sql.y:3153
 		{
 			unimplemented()
 		}
-	case 544:
+	case 587:
 		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
-		//line sql.y:2823
+		//line sql.y:3154
 		{
 			unimplemented()
 		}
-	case 545:
+	case 588:
 		sqlDollar = sqlS[sqlpt-6 : sqlpt+1]
-		//line sql.y:2825
+		//line sql.y:3156
 		{
 			sqlVAL.expr = &CastExpr{Expr: sqlDollar[3].expr, Type: sqlDollar[5].colType}
 		}
-	case 546:
+	case 589:
 		sqlDollar = sqlS[sqlpt-4 : sqlpt+1]
-		//line sql.y:2829
+		//line sql.y:3160
 		{
 			sqlVAL.expr = &FuncExpr{Name: &QualifiedName{Base: Name(sqlDollar[1].str)}, Exprs: sqlDollar[3].exprs}
 		}
-	case 547:
+	case 590:
 		sqlDollar = sqlS[sqlpt-4 : sqlpt+1]
-		//line sql.y:2832
+		//line sql.y:3163
 		{
 			unimplemented()
 		}
-	case 548:
+	case 591:
 		sqlDollar = sqlS[sqlpt-4 : sqlpt+1]
-		//line sql.y:2833
+		//line sql.y:3164
 		{
 			unimplemented()
 		}
-	case 549:
+	case 592:
 		sqlDollar = sqlS[sqlpt-4 : sqlpt+1]
-		//line sql.y:2834
+		//line sql.y:3165
 		{
 			unimplemented()
 		}
-	case 550:
+	case 593:
 		sqlDollar = sqlS[sqlpt-6 : sqlpt+1]
-		//line sql.y:2835
+		//line sql.y:3166
 		{
 			unimplemented()
 		}
-	case 551:
+	case 594:
 		sqlDollar = sqlS[sqlpt-5 : sqlpt+1]
-		//line sql.y:2836
+		//line sql.y:3167
 		{
 			unimplemented()
 		}
-	case 552:
+	case 595:
 		sqlDollar = sqlS[sqlpt-5 : sqlpt+1]
-		//line sql.y:2837
+		//line sql.y:3168
 		{
 			unimplemented()
 		}
-	case 553:
+	case 596:
 		sqlDollar = sqlS[sqlpt-5 : sqlpt+1]
-		//line sql.y:2838
+		//line sql.y:3169
 		{
 			unimplemented()
 		}
-	case 554:
+	case 597:
 		sqlDollar = sqlS[sqlpt-4 : sqlpt+1]
-		//line sql.y:2839
+		//line sql.y:3170
 		{
 			unimplemented()
 		}
-	case 555:
+	case 598:
 		sqlDollar = sqlS[sqlpt-8 : sqlpt+1]
-		//line sql.y:2841
+		//line sql.y:3172
 		{
 			sqlVAL.expr = &IfExpr{Cond: sqlDollar[3].expr, True: sqlDollar[5].expr, Else: sqlDollar[7].expr}
 		}
-	case 556:
+	case 599:
 		sqlDollar = sqlS[sqlpt-6 : sqlpt+1]
-		//line sql.y:2845
+		//line sql.y:3176
 		{
 			sqlVAL.expr = &NullIfExpr{Expr1: sqlDollar[3].expr, Expr2: sqlDollar[5].expr}
 		}
-	case 557:
+	case 600:
 		sqlDollar = sqlS[sqlpt-6 : sqlpt+1]
-		//line sql.y:2849
+		//line sql.y:3180
 		{
 			sqlVAL.expr = &CoalesceExpr{Name: "IFNULL", Exprs: Exprs{sqlDollar[3].expr, sqlDollar[5].expr}}
 		}
-	case 558:
+	case 601:
 		sqlDollar = sqlS[sqlpt-4 : sqlpt+1]
-		//line sql.y:2853
+		//line sql.y:3184
 		{
 			sqlVAL.expr = &CoalesceExpr{Name: "COALESCE", Exprs: sqlDollar[3].exprs}
 		}
-	case 559:
+	case 602:
 		sqlDollar = sqlS[sqlpt-4 : sqlpt+1]
-		//line sql.y:2857
+		//line sql.y:3188
 		{
 			sqlVAL.expr = &FuncExpr{Name: &QualifiedName{Base: Name(sqlDollar[1].str)}, Exprs: sqlDollar[3].exprs}
 		}
-	case 560:
+	case 603:
 		sqlDollar = sqlS[sqlpt-4 : sqlpt+1]
-		//line sql.y:2861
+		//line sql.y:3192
 		{
 			sqlVAL.expr = &FuncExpr{Name: &QualifiedName{Base: Name(sqlDollar[1].str)}, Exprs: sqlDollar[3].exprs}
 		}
-	case 561:
+	case 604:
 		sqlDollar = sqlS[sqlpt-5 : sqlpt+1]
-		//line sql.y:2867
+		//line sql.y:3198
 		{
 			unimplemented()
 		}
-	case 562:
+	case 605:
 		sqlDollar = sqlS[sqlpt-0 : sqlpt+1]
-		//line sql.y:2868
+		//line sql.y:3199
 		{
 		}
-	case 563:
+	case 606:
 		sqlDollar = sqlS[sqlpt-5 : sqlpt+1]
-		//line sql.y:2871
+		//line sql.y:3202
 		{
 			unimplemented()
 		}
-	case 564:
+	case 607:
 		sqlDollar = sqlS[sqlpt-0 : sqlpt+1]
-		//line sql.y:2872
+		//line sql.y:3203
 		{
 		}
-	case 565:
+	case 608:
 		sqlDollar = sqlS[sqlpt-2 : sqlpt+1]
-		//line sql.y:2876
+		//line sql.y:3207
 		{
 			unimplemented()
 		}
-	case 566:
+	case 609:
 		sqlDollar = sqlS[sqlpt-0 : sqlpt+1]
-		//line sql.y:2877
+		//line sql.y:3208
 		{
 		}
-	case 567:
+	case 610:
 		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
-		//line sql.y:2880
+		//line sql.y:3211
 		{
 			unimplemented()
 		}
-	case 568:
+	case 611:
 		sqlDollar = sqlS[sqlpt-3 : sqlpt+1]
-		//line sql.y:2881
+		//line sql.y:3212
 		{
 			unimplemented()
 		}
-	case 569:
+	case 612:
 		sqlDollar = sqlS[sqlpt-3 : sqlpt+1]
-		//line sql.y:2884
+		//line sql.y:3215
 		{
 			unimplemented()
 		}
-	case 570:
+	case 613:
 		sqlDollar = sqlS[sqlpt-2 : sqlpt+1]
-		//line sql.y:2887
+		//line sql.y:3218
 		{
 			unimplemented()
 		}
-	case 571:
+	case 614:
 		sqlDollar = sqlS[sqlpt-2 : sqlpt+1]
-		//line sql.y:2888
+		//line sql.y:3219
 		{
 			unimplemented()
 		}
-	case 572:
+	case 615:
 		sqlDollar = sqlS[sqlpt-0 : sqlpt+1]
-		//line sql.y:2889
+		//line sql.y:3220
 		{
 		}
-	case 573:
+	case 616:
 		sqlDollar = sqlS[sqlpt-6 : sqlpt+1]
-		//line sql.y:2893
+		//line sql.y:3224
 		{
 			unimplemented()
 		}
-	case 574:
+	case 617:
 		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
-		//line sql.y:2904
+		//line sql.y:3235
 		{
 			unimplemented()
 		}
-	case 575:
+	case 618:
 		sqlDollar = sqlS[sqlpt-0 : sqlpt+1]
-		//line sql.y:2905
+		//line sql.y:3236
 		{
 		}
-	case 576:
+	case 619:
 		sqlDollar = sqlS[sqlpt-3 : sqlpt+1]
-		//line sql.y:2908
+		//line sql.y:3239
 		{
 			unimplemented()
 		}
-	case 577:
+	case 620:
 		sqlDollar = sqlS[sqlpt-0 : sqlpt+1]
-		//line sql.y:2909
+		//line sql.y:3240
 		{
 		}
-	case 578:
+	case 621:
 		sqlDollar = sqlS[sqlpt-2 : sqlpt+1]
-		//line sql.y:2917
+		//line sql.y:3248
 		{
 			unimplemented()
 		}
-	case 579:
+	case 622:
 		sqlDollar = sqlS[sqlpt-2 : sqlpt+1]
-		//line sql.y:2918
+		//line sql.y:3249
 		{
 			unimplemented()
 		}
-	case 580:
+	case 623:
 		sqlDollar = sqlS[sqlpt-0 : sqlpt+1]
-		//line sql.y:2919
+		//line sql.y:3250
 		{
 		}
-	case 581:
+	case 624:
 		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
-		//line sql.y:2922
+		//line sql.y:3253
 		{
 			unimplemented()
 		}
-	case 582:
+	case 625:
 		sqlDollar = sqlS[sqlpt-4 : sqlpt+1]
-		//line sql.y:2923
+		//line sql.y:3254
 		{
 			unimplemented()
 		}
-	case 583:
+	case 626:
 		sqlDollar = sqlS[sqlpt-2 : sqlpt+1]
-		//line sql.y:2929
+		//line sql.y:3260
 		{
 			unimplemented()
 		}
-	case 584:
+	case 627:
 		sqlDollar = sqlS[sqlpt-2 : sqlpt+1]
-		//line sql.y:2930
+		//line sql.y:3261
 		{
 			unimplemented()
 		}
-	case 585:
+	case 628:
 		sqlDollar = sqlS[sqlpt-2 : sqlpt+1]
-		//line sql.y:2931
+		//line sql.y:3262
 		{
 			unimplemented()
 		}
-	case 586:
+	case 629:
 		sqlDollar = sqlS[sqlpt-2 : sqlpt+1]
-		//line sql.y:2932
+		//line sql.y:3263
 		{
 			unimplemented()
 		}
-	case 587:
+	case 630:
 		sqlDollar = sqlS[sqlpt-2 : sqlpt+1]
-		//line sql.y:2933
+		//line sql.y:3264
 		{
 			unimplemented()
 		}
-	case 588:
+	case 631:
 		sqlDollar = sqlS[sqlpt-4 : sqlpt+1]
-		//line sql.y:2944
+		//line sql.y:3275
 		{
 			sqlVAL.expr = Row(sqlDollar[3].exprs)
 		}
-	case 589:
+	case 632:
 		sqlDollar = sqlS[sqlpt-3 : sqlpt+1]
-		//line sql.y:2948
+		//line sql.y:3279
 		{
 			sqlVAL.expr = Row(nil)
 		}
-	case 590:
+	case 633:
 		sqlDollar = sqlS[sqlpt-5 : sqlpt+1]
-		//line sql.y:2952
+		//line sql.y:3283
 		{
 			sqlVAL.expr = Tuple(append(sqlDollar[2].exprs, sqlDollar[4].expr))
 		}
-	case 591:
+	case 634:
 		sqlDollar = sqlS[sqlpt-4 : sqlpt+1]
-		//line sql.y:2958
+		//line sql.y:3289
 		{
 			sqlVAL.expr = Row(sqlDollar[3].exprs)
 		}
-	case 592:
+	case 635:
 		sqlDollar = sqlS[sqlpt-3 : sqlpt+1]
-		//line sql.y:2962
+		//line sql.y:3293
 		{
 			sqlVAL.expr = Row(nil)
 		}
-	case 593:
+	case 636:
 		sqlDollar = sqlS[sqlpt-5 : sqlpt+1]
-		//line sql.y:2968
+		//line sql.y:3299
 		{
 			sqlVAL.expr = Tuple(append(sqlDollar[2].exprs, sqlDollar[4].expr))
 		}
-	case 594:
+	case 637:
+		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
+		//line sql.y:3305
+		{
+			sqlVAL.str = "ANY"
+		}
+	case 638:
+		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
+		//line sql.y:3309
+		{
+			sqlVAL.str = "SOME"
+		}
+	case 639:
+		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
+		//line sql.y:3313
+		{
+			sqlVAL.str = "ALL"
+		}
+	case 640:
+		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
+		//line sql.y:3337
+		{
+			sqlVAL.comparisonOp = LT
+		}
+	case 641:
+		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
+		//line sql.y:3341
+		{
+			sqlVAL.comparisonOp = GT
+		}
+	case 642:
+		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
+		//line sql.y:3345
+		{
+			sqlVAL.comparisonOp = EQ
+		}
+	case 643:
+		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
+		//line sql.y:3349
+		{
+			sqlVAL.comparisonOp = LE
+		}
+	case 644:
+		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
+		//line sql.y:3353
+		{
+			sqlVAL.comparisonOp = GE
+		}
+	case 645:
+		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
+		//line sql.y:3357
+		{
+			sqlVAL.comparisonOp = NE
+		}
+	case 646:
+		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
+		//line sql.y:3361
+		{
+			sqlVAL.comparisonOp = Like
+		}
+	case 647:
+		sqlDollar = sqlS[sqlpt-2 : sqlpt+1]
+		//line sql.y:3365
+		{
+			sqlVAL.comparisonOp = NotLike
+		}
+	case 648:
 		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
-		//line sql.y:3009
+		//line sql.y:3378
 		{
 			sqlVAL.exprs = Exprs{sqlDollar[1].expr}
 		}
-	case 595:
+	case 649:
 		sqlDollar = sqlS[sqlpt-3 : sqlpt+1]
-		//line sql.y:3013
+		//line sql.y:3382
 		{
 			sqlVAL.exprs = append(sqlDollar[1].exprs, sqlDollar[3].expr)
 		}
-	case 596:
+	case 650:
 		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
-		//line sql.y:3019
+		//line sql.y:3388
 		{
 			sqlVAL.colTypes = []ColumnType{sqlDollar[1].colType}
 		}
-	case 597:
+	case 651:
 		sqlDollar = sqlS[sqlpt-3 : sqlpt+1]
-		//line sql.y:3023
+		//line sql.y:3392
 		{
 			sqlVAL.colTypes = append(sqlDollar[1].colTypes, sqlDollar[3].colType)
 		}
-	case 598:
+	case 652:
 		sqlDollar = sqlS[sqlpt-3 : sqlpt+1]
-		//line sql.y:3029
+		//line sql.y:3398
 		{
 			sqlVAL.expr = Array(sqlDollar[2].exprs)
 		}
-	case 599:
+	case 653:
 		sqlDollar = sqlS[sqlpt-3 : sqlpt+1]
-		//line sql.y:3033
+		//line sql.y:3402
 		{
 			sqlVAL.expr = Array(sqlDollar[2].exprs)
 		}
-	case 600:
+	case 654:
 		sqlDollar = sqlS[sqlpt-2 : sqlpt+1]
-		//line sql.y:3037
+		//line sql.y:3406
 		{
 			sqlVAL.expr = Array(nil)
 		}
-	case 601:
+	case 655:
 		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
-		//line sql.y:3043
+		//line sql.y:3412
 		{
 			sqlVAL.exprs = Exprs{sqlDollar[1].expr}
 		}
-	case 602:
+	case 656:
 		sqlDollar = sqlS[sqlpt-3 : sqlpt+1]
-		//line sql.y:3047
+		//line sql.y:3416
 		{
 			sqlVAL.exprs = append(sqlDollar[1].exprs, sqlDollar[3].expr)
 		}
-	case 603:
+	case 657:
 		sqlDollar = sqlS[sqlpt-3 : sqlpt+1]
-		//line sql.y:3053
+		//line sql.y:3422
 		{
 			sqlVAL.exprs = Exprs{DString(sqlDollar[1].str), sqlDollar[3].expr}
 		}
-	case 611:
+	case 665:
 		sqlDollar = sqlS[sqlpt-4 : sqlpt+1]
-		//line sql.y:3074
+		//line sql.y:3443
 		{
 			unimplemented()
 		}
-	case 612:
+	case 666:
 		sqlDollar = sqlS[sqlpt-3 : sqlpt+1]
-		//line sql.y:3075
+		//line sql.y:3444
 		{
 			unimplemented()
 		}
-	case 613:
+	case 667:
 		sqlDollar = sqlS[sqlpt-2 : sqlpt+1]
-		//line sql.y:3078
+		//line sql.y:3447
 		{
 			unimplemented()
 		}
-	case 614:
+	case 668:
 		sqlDollar = sqlS[sqlpt-3 : sqlpt+1]
-		//line sql.y:3082
+		//line sql.y:3451
 		{
 			unimplemented()
 		}
-	case 615:
+	case 669:
 		sqlDollar = sqlS[sqlpt-0 : sqlpt+1]
-		//line sql.y:3083
+		//line sql.y:3452
 		{
 		}
-	case 616:
+	case 670:
 		sqlDollar = sqlS[sqlpt-3 : sqlpt+1]
-		//line sql.y:3097
+		//line sql.y:3466
 		{
 			unimplemented()
 		}
-	case 617:
+	case 671:
 		sqlDollar = sqlS[sqlpt-3 : sqlpt+1]
-		//line sql.y:3098
+		//line sql.y:3467
 		{
 			unimplemented()
 		}
-	case 618:
+	case 672:
 		sqlDollar = sqlS[sqlpt-2 : sqlpt+1]
-		//line sql.y:3099
+		//line sql.y:3468
 		{
 			unimplemented()
 		}
-	case 619:
+	case 673:
 		sqlDollar = sqlS[sqlpt-2 : sqlpt+1]
-		//line sql.y:3100
+		//line sql.y:3469
 		{
 			unimplemented()
 		}
-	case 620:
+	case 674:
 		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
-		//line sql.y:3101
+		//line sql.y:3470
 		{
 			unimplemented()
 		}
-	case 621:
+	case 675:
 		sqlDollar = sqlS[sqlpt-0 : sqlpt+1]
-		//line sql.y:3102
+		//line sql.y:3471
 		{
 		}
-	case 622:
+	case 676:
 		sqlDollar = sqlS[sqlpt-2 : sqlpt+1]
-		//line sql.y:3105
+		//line sql.y:3474
 		{
 			unimplemented()
 		}
-	case 623:
+	case 677:
 		sqlDollar = sqlS[sqlpt-2 : sqlpt+1]
-		//line sql.y:3108
+		//line sql.y:3477
 		{
 			unimplemented()
 		}
-	case 624:
+	case 678:
 		sqlDollar = sqlS[sqlpt-3 : sqlpt+1]
-		//line sql.y:3111
+		//line sql.y:3480
 		{
 			unimplemented()
 		}
-	case 625:
+	case 679:
 		sqlDollar = sqlS[sqlpt-2 : sqlpt+1]
-		//line sql.y:3112
+		//line sql.y:3481
 		{
 			unimplemented()
 		}
-	case 626:
+	case 680:
 		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
-		//line sql.y:3113
+		//line sql.y:3482
 		{
 			unimplemented()
 		}
-	case 627:
+	case 681:
 		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
-		//line sql.y:3117
+		//line sql.y:3486
 		{
 			sqlVAL.expr = &Subquery{Select: sqlDollar[1].selectStmt}
 		}
-	case 628:
+	case 682:
 		sqlDollar = sqlS[sqlpt-3 : sqlpt+1]
-		//line sql.y:3121
+		//line sql.y:3490
 		{
 			sqlVAL.expr = Tuple(sqlDollar[2].exprs)
 		}
-	case 629:
+	case 683:
 		sqlDollar = sqlS[sqlpt-5 : sqlpt+1]
-		//line sql.y:3132
+		//line sql.y:3501
 		{
 			sqlVAL.expr = &CaseExpr{Expr: sqlDollar[2].expr, Whens: sqlDollar[3].whens, Else: sqlDollar[4].expr}
 		}
-	case 630:
+	case 684:
 		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
-		//line sql.y:3139
+		//line sql.y:3508
 		{
 			sqlVAL.whens = []*When{sqlDollar[1].when}
 		}
-	case 631:
+	case 685:
 		sqlDollar = sqlS[sqlpt-2 : sqlpt+1]
-		//line sql.y:3143
+		//line sql.y:3512
 		{
 			sqlVAL.whens = append(sqlDollar[1].whens, sqlDollar[2].when)
 		}
-	case 632:
+	case 686:
 		sqlDollar = sqlS[sqlpt-4 : sqlpt+1]
-		//line sql.y:3149
+		//line sql.y:3518
 		{
 			sqlVAL.when = &When{Cond: sqlDollar[2].expr, Val: sqlDollar[4].expr}
 		}
-	case 633:
+	case 687:
 		sqlDollar = sqlS[sqlpt-2 : sqlpt+1]
-		//line sql.y:3155
+		//line sql.y:3524
 		{
 			sqlVAL.expr = sqlDollar[2].expr
 		}
-	case 634:
+	case 688:
 		sqlDollar = sqlS[sqlpt-0 : sqlpt+1]
-		//line sql.y:3159
+		//line sql.y:3528
 		{
 			sqlVAL.expr = nil
 		}
-	case 636:
+	case 690:
 		sqlDollar = sqlS[sqlpt-0 : sqlpt+1]
-		//line sql.y:3166
+		//line sql.y:3535
 		{
 			sqlVAL.expr = nil
 		}
-	case 637:
+	case 691:
 		sqlDollar = sqlS[sqlpt-2 : sqlpt+1]
-		//line sql.y:3172
+		//line sql.y:3541
 		{
 			sqlVAL.indirectElem = NameIndirection(sqlDollar[2].str)
 		}
-	case 638:
+	case 692:
 		sqlDollar = sqlS[sqlpt-2 : sqlpt+1]
-		//line sql.y:3176
+		//line sql.y:3545
 		{
 			sqlVAL.indirectElem = qualifiedStar
 		}
-	case 639:
+	case 693:
 		sqlDollar = sqlS[sqlpt-2 : sqlpt+1]
-		//line sql.y:3180
+		//line sql.y:3549
 		{
 			sqlVAL.indirectElem = IndexIndirection(sqlDollar[2].str)
 		}
-	case 640:
+	case 694:
 		sqlDollar = sqlS[sqlpt-3 : sqlpt+1]
-		//line sql.y:3184
+		//line sql.y:3553
 		{
 			sqlVAL.indirectElem = &ArrayIndirection{Begin: sqlDollar[2].expr}
 		}
-	case 641:
+	case 695:
 		sqlDollar = sqlS[sqlpt-5 : sqlpt+1]
-		//line sql.y:3188
+		//line sql.y:3557
 		{
 			sqlVAL.indirectElem = &ArrayIndirection{Begin: sqlDollar[2].expr, End: sqlDollar[4].expr}
 		}
-	case 642:
+	case 696:
 		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
-		//line sql.y:3194
+		//line sql.y:3563
 		{
 			sqlVAL.indirect = Indirection{sqlDollar[1].indirectElem}
 		}
-	case 643:
+	case 697:
 		sqlDollar = sqlS[sqlpt-2 : sqlpt+1]
-		//line sql.y:3198
+		//line sql.y:3567
 		{
 			sqlVAL.indirect = append(sqlDollar[1].indirect, sqlDollar[2].indirectElem)
 		}
-	case 644:
+	case 698:
 		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
-		//line sql.y:3203
+		//line sql.y:3572
 		{
 		}
-	case 645:
+	case 699:
 		sqlDollar = sqlS[sqlpt-0 : sqlpt+1]
-		//line sql.y:3204
+		//line sql.y:3573
 		{
 		}
-	case 647:
+	case 701:
 		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
-		//line sql.y:3213
+		//line sql.y:3582
 		{
 			sqlVAL.expr = DefaultVal{}
 		}
-	case 648:
+	case 702:
 		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
-		//line sql.y:3219
+		//line sql.y:3588
 		{
 			sqlVAL.exprs = []Expr{sqlDollar[1].expr}
 		}
-	case 649:
+	case 703:
 		sqlDollar = sqlS[sqlpt-3 : sqlpt+1]
-		//line sql.y:3223
+		//line sql.y:3592
 		{
 			sqlVAL.exprs = append(sqlDollar[1].exprs, sqlDollar[3].expr)
 		}
-	case 650:
+	case 704:
 		sqlDollar = sqlS[sqlpt-3 : sqlpt+1]
-		//line sql.y:3232
+		//line sql.y:3601
 		{
 			sqlVAL.exprs = sqlDollar[2].exprs
 		}
-	case 652:
+	case 706:
 		sqlDollar = sqlS[sqlpt-0 : sqlpt+1]
-		//line sql.y:3240
+		//line sql.y:3609
 		{
 			sqlVAL.selExprs = nil
 		}
-	case 653:
+	case 707:
 		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
-		//line sql.y:3246
+		//line sql.y:3615
 		{
 			sqlVAL.selExprs = SelectExprs{sqlDollar[1].selExpr}
 		}
-	case 654:
+	case 708:
 		sqlDollar = sqlS[sqlpt-3 : sqlpt+1]
-		//line sql.y:3250
+		//line sql.y:3619
 		{
 			sqlVAL.selExprs = append(sqlDollar[1].selExprs, sqlDollar[3].selExpr)
 		}
-	case 655:
+	case 709:
 		sqlDollar = sqlS[sqlpt-3 : sqlpt+1]
-		//line sql.y:3256
+		//line sql.y:3625
 		{
 			sqlVAL.selExpr = SelectExpr{Expr: sqlDollar[1].expr, As: Name(sqlDollar[3].str)}
 		}
-	case 656:
+	case 710:
 		sqlDollar = sqlS[sqlpt-2 : sqlpt+1]
-		//line sql.y:3265
+		//line sql.y:3634
 		{
 			sqlVAL.selExpr = SelectExpr{Expr: sqlDollar[1].expr, As: Name(sqlDollar[2].str)}
 		}
-	case 657:
+	case 711:
 		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
-		//line sql.y:3269
+		//line sql.y:3638
 		{
 			sqlVAL.selExpr = SelectExpr{Expr: sqlDollar[1].expr}
 		}
-	case 658:
+	case 712:
 		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
-		//line sql.y:3273
+		//line sql.y:3642
 		{
 			sqlVAL.selExpr = StarSelectExpr()
 		}
-	case 659:
+	case 713:
 		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
-		//line sql.y:3281
+		//line sql.y:3650
 		{
 			sqlVAL.qnames = QualifiedNames{sqlDollar[1].qname}
 		}
-	case 660:
+	case 714:
 		sqlDollar = sqlS[sqlpt-3 : sqlpt+1]
-		//line sql.y:3285
+		//line sql.y:3654
 		{
 			sqlVAL.qnames = append(sqlDollar[1].qnames, sqlDollar[3].qname)
 		}
-	case 661:
+	case 715:
 		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
-		//line sql.y:3296
+		//line sql.y:3665
 		{
 			sqlVAL.qname = &QualifiedName{Base: Name(sqlDollar[1].str)}
 		}
-	case 662:
+	case 716:
 		sqlDollar = sqlS[sqlpt-2 : sqlpt+1]
-		//line sql.y:3300
+		//line sql.y:3669
 		{
 			sqlVAL.qname = &QualifiedName{Base: Name(sqlDollar[1].str), Indirect: sqlDollar[2].indirect}
 		}
-	case 663:
+	case 717:
 		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
-		//line sql.y:3306
+		//line sql.y:3675
 		{
 			sqlVAL.strs = []string{sqlDollar[1].str}
 		}
-	case 664:
+	case 718:
 		sqlDollar = sqlS[sqlpt-3 : sqlpt+1]
-		//line sql.y:3310
+		//line sql.y:3679
 		{
 			sqlVAL.strs = append(sqlDollar[1].strs, sqlDollar[3].str)
 		}
-	case 665:
+	case 719:
+		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
+		//line sql.y:3685
+		{
+			sqlVAL.strs = []string{sqlDollar[1].str}
+		}
+	case 720:
 		sqlDollar = sqlS[sqlpt-3 : sqlpt+1]
-		//line sql.y:3316
+		//line sql.y:3689
+		{
+			sqlVAL.strs = append(sqlDollar[1].strs, sqlDollar[3].str)
+		}
+	case 721:
+		sqlDollar = sqlS[sqlpt-3 : sqlpt+1]
+		//line sql.y:3695
 		{
 			sqlVAL.strs = sqlDollar[2].strs
 		}
-	case 666:
+	case 722:
 		sqlDollar = sqlS[sqlpt-0 : sqlpt+1]
-		//line sql.y:3319
+		//line sql.y:3698
 		{
 		}
-	case 667:
+	case 723:
 		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
-		//line sql.y:3329
+		//line sql.y:3708
 		{
 			sqlVAL.qname = &QualifiedName{Base: Name(sqlDollar[1].str)}
 		}
-	case 668:
+	case 724:
 		sqlDollar = sqlS[sqlpt-2 : sqlpt+1]
-		//line sql.y:3333
+		//line sql.y:3712
 		{
 			sqlVAL.qname = &QualifiedName{Base: Name(sqlDollar[1].str), Indirect: sqlDollar[2].indirect}
 		}
-	case 669:
+	case 725:
 		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
-		//line sql.y:3340
+		//line sql.y:3719
 		{
 			sqlVAL.expr = IntVal(sqlDollar[1].ival)
 		}
-	case 670:
+	case 726:
 		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
-		//line sql.y:3344
+		//line sql.y:3723
 		{
 			sqlVAL.expr = NumVal(sqlDollar[1].str)
 		}
-	case 671:
+	case 727:
 		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
-		//line sql.y:3348
+		//line sql.y:3727
 		{
 			sqlVAL.expr = DString(sqlDollar[1].str)
 		}
-	case 672:
+	case 728:
 		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
-		//line sql.y:3352
+		//line sql.y:3731
 		{
 			sqlVAL.expr = DBytes(sqlDollar[1].str)
 		}
-	case 673:
+	case 729:
 		sqlDollar = sqlS[sqlpt-6 : sqlpt+1]
-		//line sql.y:3355
+		//line sql.y:3734
 		{
 			unimplemented()
 		}
-	case 674:
+	case 730:
 		sqlDollar = sqlS[sqlpt-2 : sqlpt+1]
-		//line sql.y:3357
+		//line sql.y:3736
 		{
 			sqlVAL.expr = &CastExpr{Expr: DString(sqlDollar[2].str), Type: sqlDollar[1].colType}
 		}
-	case 675:
+	case 731:
 		sqlDollar = sqlS[sqlpt-3 : sqlpt+1]
-		//line sql.y:3361
+		//line sql.y:3740
 		{
 			// TODO(pmattis): support opt_interval?
 			sqlVAL.expr = &CastExpr{Expr: DString(sqlDollar[2].str), Type: sqlDollar[1].colType}
 		}
-	case 676:
+	case 732:
 		sqlDollar = sqlS[sqlpt-5 : sqlpt+1]
-		//line sql.y:3366
+		//line sql.y:3745
 		{
 			// TODO(pmattis): Support the precision specification?
 			sqlVAL.expr = &CastExpr{Expr: DString(sqlDollar[5].str), Type: sqlDollar[1].colType}
 		}
-	case 677:
+	case 733:
 		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
-		//line sql.y:3371
+		//line sql.y:3750
 		{
 			sqlVAL.expr = DBool(true)
 		}
-	case 678:
+	case 734:
 		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
-		//line sql.y:3375
+		//line sql.y:3754
 		{
 			sqlVAL.expr = DBool(false)
 		}
-	case 679:
+	case 735:
 		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
-		//line sql.y:3379
+		//line sql.y:3758
 		{
 			sqlVAL.expr = DNull
 		}
-	case 681:
+	case 737:
 		sqlDollar = sqlS[sqlpt-2 : sqlpt+1]
-		//line sql.y:3386
+		//line sql.y:3765
 		{
 			sqlVAL.ival = +sqlDollar[2].ival
 		}
-	case 682:
+	case 738:
 		sqlDollar = sqlS[sqlpt-2 : sqlpt+1]
-		//line sql.y:3390
+		//line sql.y:3769
 		{
 			sqlVAL.ival = -sqlDollar[2].ival
 		}
-	case 687:
+	case 743:
 		sqlDollar = sqlS[sqlpt-0 : sqlpt+1]
-		//line sql.y:3412
+		//line sql.y:3791
 		{
 			sqlVAL.str = ""
 		}
@@ -25,6 +25,19 @@ import "github.com/cockroachdb/cockroach/sql/privilege"
 func unimplemented() {
   panic("TODO(pmattis): unimplemented")
 }
+
+// setWith attaches a WITH clause to a SELECT statement. It returns nil if the
+// statement is a set operation or VALUES, which do not support WITH clauses.
+func setWith(with *With, stmt SelectStatement) SelectStatement {
+  switch t := stmt.(type) {
+  case *Select:
+    t.With = with
+    return t
+  case *ParenSelect:
+    return setWith(with, t.Select)
+  }
+  return nil
+}
 %}
 
 %union {
@@ -33,6 +46,7 @@ func unimplemented() {
   empty          struct{}
   ival           int64
   boolVal        bool
+  comparisonOp   ComparisonOp
   str            string
   strs           []string
   qname          *QualifiedName
@@ -71,9 +85,15 @@ func unimplemented() {
   order          *Order
   groupBy        GroupBy
   dir            Direction
+  idxElem        IndexElem
+  idxElems       IndexElemList
   alterTableCmd  AlterTableCmd
   alterTableCmds AlterTableCmds
   isoLevel       IsolationLevel
+  with           *With
+  cte            *CTE
+  ctes           []*CTE
+  interleave     *InterleaveDef
 }
 
 %type <stmts> stmt_block
@@ -81,6 +101,8 @@ func unimplemented() {
 %type <stmt> stmt
 
 %type <stmt> alter_table_stmt
+%type <stmt> backup_stmt
+%type <stmt> configure_zone_stmt
 %type <stmt> create_stmt
 %type <stmt> create_database_stmt
 %type <stmt> create_index_stmt
@@ -90,9 +112,11 @@ func unimplemented() {
 %type <stmt> explain_stmt
 %type <stmt> explainable_stmt
 %type <stmt> grant_stmt
+%type <stmt> import_stmt
 %type <stmt> insert_stmt
 %type <stmt> preparable_stmt
 %type <stmt> rename_stmt
+%type <stmt> restore_stmt
 %type <stmt> revoke_stmt
 %type <selectStmt> select_stmt
 %type <stmt> set_stmt
@@ -104,7 +128,8 @@ func unimplemented() {
 %type <selectStmt> select_no_parens select_with_parens select_clause
 %type <selectStmt> simple_select values_clause
 
-%type <empty> alter_column_default alter_using
+%type <expr> alter_column_default
+%type <empty> alter_using
 %type <dir> opt_asc_desc
 
 %type <alterTableCmd> alter_table_cmd
@@ -118,7 +143,7 @@ func unimplemented() {
 
 %type <str>   name opt_name
 
-// %type <empty> subquery_op
+%type <comparisonOp> subquery_op
 %type <qname> func_name
 %type <empty> opt_collate
 
@@ -131,14 +156,18 @@ func unimplemented() {
 %type <empty> opt_encoding
 
 %type <tblDefs> opt_table_elem_list table_elem_list
+%type <interleave> opt_interleave
 %type <empty> opt_all_clause
 %type <boolVal> distinct_clause
 %type <strs> opt_column_list
 %type <orderBy> sort_clause opt_sort_clause
 %type <orders> sortby_list
-%type <strs> index_params
+%type <idxElems> index_params
 %type <strs> name_list opt_name_list
-%type <empty> opt_array_bounds
+%type <strs> string_list
+%type <strs> opt_incremental
+%type <expr> zone_config
+%type <boolVal> opt_array_bounds
 %type <tblExprs> from_clause from_list
 %type <qnames> qualified_name_list
 %type <qname> any_name
@@ -195,12 +224,12 @@ func unimplemented() {
 %type <expr>  case_expr case_arg case_default
 %type <when>  when_clause
 %type <whens> when_clause_list
-// %type <empty> sub_type
+%type <str> sub_type
 %type <expr> ctext_expr
 %type <expr> numeric_only
 %type <str> alias_clause opt_alias_clause
 %type <order> sortby
-%type <str> index_elem
+%type <idxElem> index_elem
 %type <tblExpr> table_ref
 %type <tblExpr> joined_table
 %type <qname> relation_expr
@@ -236,16 +265,17 @@ func unimplemented() {
 %type <str>   col_name_keyword reserved_keyword
 
 %type <constraintDef> table_constraint constraint_elem
-%type <tblDef> index_def
+%type <tblDef> index_def family_def
 %type <colQuals> col_qual_list
 %type <colQual> col_qualification col_qualification_elem
 %type <empty> key_actions key_delete key_match key_update key_action
 
 %type <expr>  func_application func_expr_common_subexpr
 %type <expr>  func_expr func_expr_windowless
-%type <empty> common_table_expr
-%type <empty> with_clause opt_with_clause
-%type <empty> cte_list
+%type <cte> common_table_expr
+%type <with> with_clause
+%type <empty> opt_with_clause
+%type <ctes> cte_list
 
 %type <empty> within_group_clause
 %type <empty> filter_clause
@@ -284,14 +314,15 @@ func unimplemented() {
 %token <str>   ALL ALTER ANALYSE ANALYZE AND ANY ARRAY AS ASC
 %token <str>   ASYMMETRIC AT
 
-%token <str>   BEGIN BETWEEN BIGINT BIT
+%token <str>   BACKUP BEGIN BETWEEN BIGINT BIT
 %token <str>   BLOB BOOL BOOLEAN BOTH BY BYTES
 
 %token <str>   CASCADE CASE CAST CHAR
 %token <str>   CHARACTER CHECK
 %token <str>   COALESCE COLLATE COLLATION COLUMN COLUMNS COMMIT
-%token <str>   COMMITTED CONCAT CONFLICT CONSTRAINT
-%token <str>   COVERING CREATE
+%token <str>   COMMITTED CONCAT CONFIGURATION CONFIGURE CONFLICT CONSTRAINT
+%token <str>   CONTAINS
+%token <str>   COVERING CREATE CSV
 %token <str>   CROSS CUBE CURRENT CURRENT_CATALOG CURRENT_DATE
 %token <str>   CURRENT_ROLE CURRENT_TIME CURRENT_TIMESTAMP
 %token <str>   CURRENT_USER CYCLE
@@ -303,19 +334,19 @@ func unimplemented() {
 %token <str>   ELSE END ESCAPE EXCEPT
 %token <str>   EXISTS EXPLAIN EXTRACT
 
-%token <str>   FALSE FETCH FILTER FIRST FLOAT FOLLOWING FOR
+%token <str>   FALSE FAMILY FETCH FETCHTEXT FETCHVAL FILTER FIRST FLOAT FOLLOWING FOR
 %token <str>   FOREIGN FROM FULL
 
 %token <str>   GRANT GRANTS GREATEST GROUP GROUPING
 
 %token <str>   HAVING HOUR
 
-%token <str>   IF IFNULL IN
-%token <str>   INDEX INITIALLY
+%token <str>   IF IFNULL IMPORT IN
+%token <str>   INCREMENTAL INDEX INITIALLY
 %token <str>   INNER INSERT INT INT64 INTEGER
-%token <str>   INTERSECT INTERVAL INTO IS ISOLATION
+%token <str>   INTERLEAVE INTERSECT INTERVAL INTO IS ISOLATION
 
-%token <str>   JOIN
+%token <str>   JOIN JSON JSONB
 
 %token <str>   KEY
 
@@ -332,18 +363,18 @@ func unimplemented() {
 %token <str>   OF OFF OFFSET ON ONLY OR
 %token <str>   ORDER ORDINALITY OUT OUTER OVER OVERLAPS OVERLAY
 
-%token <str>   PARTIAL PARTITION PLACING POSITION
+%token <str>   PARENT PARTIAL PARTITION PLACING POSITION
 %token <str>   PRECEDING PRECISION PRIMARY
 
 %token <str>   RANGE READ REAL RECURSIVE REF REFERENCES
-%token <str>   RENAME REPEATABLE
-%token <str>   RESTRICT RETURNING REVOKE RIGHT ROLLBACK ROLLUP
+%token <str>   RELEASE RENAME REPEATABLE
+%token <str>   RESTORE RESTRICT RETURNING REVOKE RIGHT ROLLBACK ROLLUP
 %token <str>   ROW ROWS RSHIFT
 
-%token <str>   SEARCH SECOND SELECT
+%token <str>   SAVEPOINT SEARCH SECOND SELECT
 %token <str>   SERIALIZABLE SESSION SESSION_USER SET SHOW
 %token <str>   SIMILAR SIMPLE SMALLINT SNAPSHOT SOME SQL
-%token <str>   STRICT STRING STORING SUBSTRING
+%token <str>   STORED STRICT STRING STORING SUBSTRING
 %token <str>   SYMMETRIC
 
 %token <str>   TABLE TABLES TEXT THEN
@@ -351,7 +382,7 @@ func unimplemented() {
 %token <str>   TRUNCATE TYPE
 
 %token <str>   UNBOUNDED UNCOMMITTED UNION UNIQUE UNKNOWN
-%token <str>   UPDATE USER USING
+%token <str>   UPDATE USER USING UUID
 
 %token <str>   VALID VALIDATE VALUE VALUES VARCHAR VARIADIC VARYING
 
@@ -409,7 +440,7 @@ func unimplemented() {
 // funny behavior of UNBOUNDED on the SQL standard, though.
 %nonassoc  UNBOUNDED         // ideally should have same precedence as IDENT
 %nonassoc  IDENT NULL PARTITION RANGE ROWS PRECEDING FOLLOWING CUBE ROLLUP
-%left      CONCAT       // multi-character ops
+%left      CONCAT FETCHVAL FETCHTEXT CONTAINS // multi-character ops
 %left      '|'
 %left      '^' '#'
 %left      '&'
@@ -458,13 +489,17 @@ stmt_list:
 
 stmt:
   alter_table_stmt
+| backup_stmt
+| configure_zone_stmt
 | create_stmt
 | delete_stmt
 | drop_stmt
 | explain_stmt
 | grant_stmt
+| import_stmt
 | insert_stmt
 | rename_stmt
+| restore_stmt
 | revoke_stmt
 | select_stmt
   {
@@ -490,6 +525,29 @@ alter_table_stmt:
     $$ = &AlterTable{Table: $5, IfExists: true, Cmds: $6}
   }
 
+// ALTER DATABASE <name> CONFIGURE ZONE 'yaml'
+// ALTER TABLE <name> CONFIGURE ZONE 'yaml'
+configure_zone_stmt:
+  ALTER DATABASE name CONFIGURE ZONE zone_config
+  {
+    $$ = &SetZoneConfig{ZoneSpecifier: ZoneSpecifier{Database: Name($3)}, YAMLConfig: $6}
+  }
+| ALTER TABLE qualified_name CONFIGURE ZONE zone_config
+  {
+    $$ = &SetZoneConfig{ZoneSpecifier: ZoneSpecifier{Table: $3}, YAMLConfig: $6}
+  }
+
+// A NULL zone config removes the zone config of the object.
+zone_config:
+  SCONST
+  {
+    $$ = DString($1)
+  }
+| NULL
+  {
+    $$ = DNull
+  }
+
 alter_table_cmds:
   alter_table_cmd
   {
@@ -522,11 +580,20 @@ alter_table_cmd:
     $$ = &AlterTableAddColumn{columnKeyword: true, IfNotExists: true, ColumnDef: $6}
   }
   // ALTER TABLE <name> ALTER [COLUMN] <colname> {SET DEFAULT <expr>|DROP DEFAULT}
-| ALTER opt_column name alter_column_default { unimplemented() }
+| ALTER opt_column name alter_column_default
+  {
+    $$ = &AlterTableSetDefault{columnKeyword: $2, Column: $3, Default: $4}
+  }
   // ALTER TABLE <name> ALTER [COLUMN] <colname> DROP NOT NULL
-| ALTER opt_column name DROP NOT NULL { unimplemented() }
+| ALTER opt_column name DROP NOT NULL
+  {
+    $$ = &AlterTableDropNotNull{columnKeyword: $2, Column: $3}
+  }
   // ALTER TABLE <name> ALTER [COLUMN] <colname> SET NOT NULL
-| ALTER opt_column name SET NOT NULL { unimplemented() }
+| ALTER opt_column name SET NOT NULL
+  {
+    $$ = &AlterTableSetNotNull{columnKeyword: $2, Column: $3}
+  }
   // ALTER TABLE <name> DROP [COLUMN] IF EXISTS <colname> [RESTRICT|CASCADE]
 | DROP opt_column IF EXISTS name opt_drop_behavior
   {
@@ -539,7 +606,10 @@ alter_table_cmd:
   }
   // ALTER TABLE <name> ALTER [COLUMN] <colname> [SET DATA] TYPE <typename>
   //     [ USING <expression> ]
-| ALTER opt_column name opt_set_data TYPE typename opt_collate_clause alter_using {}
+| ALTER opt_column name opt_set_data TYPE typename opt_collate_clause alter_using
+  {
+    $$ = &AlterTableAlterColumnType{columnKeyword: $2, Column: $3, Type: $6}
+  }
   // ALTER TABLE <name> ADD CONSTRAINT ...
 | ADD table_constraint
   {
@@ -561,8 +631,14 @@ alter_table_cmd:
   }
 
 alter_column_default:
-  SET DEFAULT a_expr { unimplemented() }
-| DROP DEFAULT { unimplemented() }
+  SET DEFAULT a_expr
+  {
+    $$ = $3
+  }
+| DROP DEFAULT
+  {
+    $$ = nil
+  }
 
 opt_drop_behavior:
   CASCADE { unimplemented() }
@@ -956,6 +1032,10 @@ show_stmt:
   {
     $$ = &ShowColumns{Table: $4}
   }
+| SHOW CREATE TABLE var_name
+  {
+    $$ = &ShowCreateTable{Table: $4}
+  }
 | SHOW DATABASES
   {
     $$ = &ShowDatabases{}
@@ -968,6 +1048,14 @@ show_stmt:
   {
     $$ = &ShowIndex{Table: $4}
   }
+| SHOW ZONE CONFIGURATION FOR DATABASE name
+  {
+    $$ = &ShowZoneConfig{ZoneSpecifier{Database: Name($6)}}
+  }
+| SHOW ZONE CONFIGURATION FOR TABLE qualified_name
+  {
+    $$ = &ShowZoneConfig{ZoneSpecifier{Table: $6}}
+  }
 | SHOW TABLES opt_from_var_name_clause
   {
     $$ = &ShowTables{Name: $3}
@@ -1018,13 +1106,23 @@ for_grantee_clause:
 
 // CREATE TABLE relname
 create_table_stmt:
-  CREATE TABLE any_name '(' opt_table_elem_list ')'
+  CREATE TABLE any_name '(' opt_table_elem_list ')' opt_interleave
+  {
+    $$ = &CreateTable{Table: $3, IfNotExists: false, Defs: $5, Interleave: $7}
+  }
+| CREATE TABLE IF NOT EXISTS any_name '(' opt_table_elem_list ')' opt_interleave
+  {
+    $$ = &CreateTable{Table: $6, IfNotExists: true, Defs: $8, Interleave: $10}
+  }
+
+opt_interleave:
+  INTERLEAVE IN PARENT qualified_name '(' name_list ')'
   {
-    $$ = &CreateTable{Table: $3, IfNotExists: false, Defs: $5}
+    $$ = &InterleaveDef{Parent: $4, Fields: NameList($6)}
   }
-| CREATE TABLE IF NOT EXISTS any_name '(' opt_table_elem_list ')'
+| /* EMPTY */
   {
-    $$ = &CreateTable{Table: $6, IfNotExists: true, Defs: $8}
+    $$ = nil
   }
 
 opt_table_elem_list:
@@ -1050,6 +1148,7 @@ table_elem:
     $$ = $1
   }
 | index_def
+| family_def
 | table_constraint
   {
     $$ = $1
@@ -1122,28 +1221,47 @@ col_qualification_elem:
     }
     $$ = &ColumnDefault{Expr: $2}
   }
+| AS '(' a_expr ')' STORED
+  {
+    if containsSubquery($3) {
+      sqllex.Error("computed column expression contains a subquery")
+      return 1
+    }
+    $$ = &ColumnComputedDef{Expr: $3}
+  }
 | REFERENCES qualified_name opt_column_list key_match key_actions { unimplemented() }
 
 index_def:
-  INDEX opt_name '(' name_list ')' opt_storing
+  INDEX opt_name '(' index_params ')' opt_storing where_clause
   {
     $$ = &IndexTableDef{
-      Name:    Name($2),
-      Columns: NameList($4),
-      Storing: $6,
+      Name:      Name($2),
+      Columns:   $4,
+      Storing:   $6,
+      Predicate: $7,
     }
   }
-| UNIQUE INDEX opt_name '(' name_list ')' opt_storing
+| UNIQUE INDEX opt_name '(' index_params ')' opt_storing where_clause
   {
     $$ = &UniqueConstraintTableDef{
       IndexTableDef: IndexTableDef {
-        Name:    Name($3),
-        Columns: NameList($5),
-        Storing: $7,
+        Name:      Name($3),
+        Columns:   $5,
+        Storing:   $7,
+        Predicate: $8,
       },
     }
   }
 
+family_def:
+  FAMILY opt_name '(' name_list ')'
+  {
+    $$ = &FamilyTableDef{
+      Name:    Name($2),
+      Columns: NameList($4),
+    }
+  }
+
 // constraint_elem specifies constraint syntax which is not embedded into a
 // column definition. col_qualification_elem specifies the embedded form.
 // - thomas 1997-12-03
@@ -1160,20 +1278,21 @@ table_constraint:
 
 constraint_elem:
   CHECK '(' a_expr ')' { unimplemented() }
-| UNIQUE '(' name_list ')' opt_storing
+| UNIQUE '(' index_params ')' opt_storing where_clause
   {
     $$ = &UniqueConstraintTableDef{
       IndexTableDef: IndexTableDef{
-        Columns: NameList($3),
-        Storing: $5,
+        Columns:   $3,
+        Storing:   $5,
+        Predicate: $6,
       },
     }
   }
-| PRIMARY KEY '(' name_list ')'
+| PRIMARY KEY '(' index_params ')'
   {
     $$ = &UniqueConstraintTableDef{
       IndexTableDef: IndexTableDef{
-        Columns: NameList($4),
+        Columns: $4,
       },
       PrimaryKey:    true,
     }
@@ -1258,6 +1377,39 @@ numeric_only:
     $$ = DInt($1)
   }
 
+// BACKUP DATABASE <name>, ... TO 'dir' [INCREMENTAL FROM 'dir', ...]
+// BACKUP [TABLE] <name>, ... TO 'dir' [INCREMENTAL FROM 'dir', ...]
+backup_stmt:
+  BACKUP privilege_target TO SCONST opt_incremental
+  {
+    $$ = &Backup{Targets: $2, To: $4, IncrementalFrom: $5}
+  }
+
+opt_incremental:
+  INCREMENTAL FROM string_list
+  {
+    $$ = $3
+  }
+| /* EMPTY */
+  {
+    $$ = nil
+  }
+
+// RESTORE DATABASE <name>, ... FROM 'dir', ...
+// RESTORE [TABLE] <name>, ... FROM 'dir', ...
+restore_stmt:
+  RESTORE privilege_target FROM string_list
+  {
+    $$ = &Restore{Targets: $2, From: $4}
+  }
+
+// IMPORT TABLE <name> CREATE USING 'schema' CSV DATA ('file', ...)
+import_stmt:
+  IMPORT TABLE qualified_name CREATE USING SCONST CSV DATA '(' string_list ')'
+  {
+    $$ = &Import{Table: $3, CreateFile: $6, Files: $10}
+  }
+
 // TRUNCATE table relname1, relname2, ...
 truncate_stmt:
   TRUNCATE opt_table relation_expr_list opt_drop_behavior
@@ -1267,17 +1419,18 @@ truncate_stmt:
 
 // CREATE INDEX
 create_index_stmt:
-  CREATE opt_unique INDEX opt_name ON qualified_name '(' index_params ')' opt_storing
+  CREATE opt_unique INDEX opt_name ON qualified_name '(' index_params ')' opt_storing where_clause
   {
     $$ = &CreateIndex{
-      Name:    Name($4),
-      Table:   $6,
-      Unique:  $2,
-      Columns: $8,
-      Storing: $10,
+      Name:      Name($4),
+      Table:     $6,
+      Unique:    $2,
+      Columns:   $8,
+      Storing:   $10,
+      Predicate: $11,
     }
   }
-| CREATE opt_unique INDEX IF NOT EXISTS name ON qualified_name '(' index_params ')' opt_storing
+| CREATE opt_unique INDEX IF NOT EXISTS name ON qualified_name '(' index_params ')' opt_storing where_clause
   {
     $$ = &CreateIndex{
       Name:        Name($7),
@@ -1286,6 +1439,7 @@ create_index_stmt:
       IfNotExists: true,
       Columns:     $11,
       Storing:     $13,
+      Predicate:   $14,
     }
   }
 
@@ -1302,7 +1456,7 @@ opt_unique:
 index_params:
   index_elem
   {
-    $$ = []string{$1}
+    $$ = IndexElemList{$1}
   }
 | index_params ',' index_elem
   {
@@ -1315,8 +1469,7 @@ index_params:
 index_elem:
   name opt_collate opt_asc_desc
   {
-    // TODO(pmattis): Support opt_asc_desc.
-    $$ = $1
+    $$ = IndexElem{Column: Name($1), Direction: $3}
   }
 | func_expr_windowless opt_collate opt_asc_desc { unimplemented() }
 | '(' a_expr ')' opt_collate opt_asc_desc { unimplemented() }
@@ -1406,6 +1559,26 @@ transaction_stmt:
   {
     $$ = &RollbackTransaction{}
   }
+| SAVEPOINT name
+  {
+    $$ = &Savepoint{Name: $2}
+  }
+| RELEASE SAVEPOINT name
+  {
+    $$ = &ReleaseSavepoint{Savepoint: $3}
+  }
+| RELEASE name
+  {
+    $$ = &ReleaseSavepoint{Savepoint: $2}
+  }
+| ROLLBACK opt_transaction TO SAVEPOINT name
+  {
+    $$ = &RollbackToSavepoint{Savepoint: $5}
+  }
+| ROLLBACK opt_transaction TO name
+  {
+    $$ = &RollbackToSavepoint{Savepoint: $4}
+  }
 
 opt_transaction:
   TRANSACTION {}
@@ -1594,20 +1767,49 @@ select_no_parens:
       s.Limit = $3
     }
   }
+| select_clause opt_sort_clause for_update_clause
+  {
+    $$ = $1
+    if s, ok := $$.(*Select); ok {
+      s.OrderBy = $2
+      s.ForUpdate = true
+    }
+  }
+| select_clause opt_sort_clause select_limit for_update_clause
+  {
+    $$ = $1
+    if s, ok := $$.(*Select); ok {
+      s.OrderBy = $2
+      s.Limit = $3
+      s.ForUpdate = true
+    }
+  }
 | with_clause select_clause
   {
-    $$ = $2
+    $$ = setWith($1, $2)
+    if $$ == nil {
+      sqllex.Error("WITH is only supported with SELECT")
+      return 1
+    }
   }
 | with_clause select_clause sort_clause
   {
-    $$ = $2
+    $$ = setWith($1, $2)
+    if $$ == nil {
+      sqllex.Error("WITH is only supported with SELECT")
+      return 1
+    }
     if s, ok := $$.(*Select); ok {
       s.OrderBy = $3
     }
   }
 | with_clause select_clause opt_sort_clause select_limit
   {
-    $$ = $2
+    $$ = setWith($1, $2)
+    if $$ == nil {
+      sqllex.Error("WITH is only supported with SELECT")
+      return 1
+    }
     if s, ok := $$.(*Select); ok {
       s.OrderBy = $3
       s.Limit = $4
@@ -1618,6 +1820,9 @@ select_clause:
   simple_select
 | select_with_parens
 
+for_update_clause:
+  FOR UPDATE {}
+
 // This rule parses SELECT statements that can appear within set operations,
 // including UNION, INTERSECT and EXCEPT. '(' and ')' can be used to specify
 // the ordering of the set operations. Without '(' and ')' we want the
@@ -1712,16 +1917,39 @@ simple_select:
 //
 // Recognizing WITH_LA here allows a CTE to be named TIME or ORDINALITY.
 with_clause:
-WITH cte_list { unimplemented() }
-| WITH_LA cte_list { unimplemented() }
-| WITH RECURSIVE cte_list { unimplemented() }
+  WITH cte_list
+  {
+    $$ = &With{CTEs: $2}
+  }
+| WITH_LA cte_list
+  {
+    $$ = &With{CTEs: $2}
+  }
+| WITH RECURSIVE cte_list
+  {
+    $$ = &With{Recursive: true, CTEs: $3}
+  }
 
 cte_list:
-  common_table_expr { unimplemented() }
-| cte_list ',' common_table_expr { unimplemented() }
+  common_table_expr
+  {
+    $$ = []*CTE{$1}
+  }
+| cte_list ',' common_table_expr
+  {
+    $$ = append($1, $3)
+  }
 
 common_table_expr:
-  name opt_name_list AS '(' preparable_stmt ')' { unimplemented() }
+  name opt_name_list AS '(' preparable_stmt ')'
+  {
+    stmt, ok := $5.(SelectStatement)
+    if !ok {
+      sqllex.Error("WITH only supports SELECT in common table expressions")
+      return 1
+    }
+    $$ = &CTE{Name: Name($1), Columns: NameList($2), Stmt: stmt}
+  }
 
 preparable_stmt:
   select_stmt
@@ -1733,7 +1961,11 @@ preparable_stmt:
 | delete_stmt
 
 opt_with_clause:
-  with_clause { unimplemented() }
+  with_clause
+  {
+    sqllex.Error("WITH is only supported with SELECT")
+    return 1
+  }
 | /* EMPTY */ {}
 
 opt_table:
@@ -1950,6 +2182,15 @@ table_ref:
   {
     $$ = &AliasedTableExpr{Expr: &Subquery{Select: $1}, As: Name($2)}
   }
+| func_expr_windowless opt_alias_clause
+  {
+    fn, ok := $1.(*FuncExpr)
+    if !ok {
+      sqllex.Error("syntax error")
+      return 1
+    }
+    $$ = &AliasedTableExpr{Expr: fn, As: Name($2)}
+  }
 | joined_table
 | '(' joined_table ')' alias_clause { unimplemented() }
 
@@ -2123,16 +2364,45 @@ where_clause:
 typename:
   simple_typename opt_array_bounds
   {
-    $$ = $1
+    if $2 {
+      $$ = &ArrayType{ElemType: $1}
+    } else {
+      $$ = $1
+    }
   }
   // SQL standard syntax, currently only one-dimensional
-| simple_typename ARRAY '[' ICONST ']' { unimplemented() }
-| simple_typename ARRAY { unimplemented() }
+| simple_typename ARRAY '[' ICONST ']'
+  {
+    $$ = &ArrayType{ElemType: $1}
+  }
+| simple_typename ARRAY
+  {
+    $$ = &ArrayType{ElemType: $1}
+  }
 
+// The array bounds are ignored, as in Postgres. Only one-dimensional arrays
+// are supported.
 opt_array_bounds:
-  opt_array_bounds '[' ']' { unimplemented() }
-| opt_array_bounds '[' ICONST ']' { unimplemented() }
-| /* EMPTY */ {}
+  opt_array_bounds '[' ']'
+  {
+    if $1 {
+      sqllex.Error("multi-dimensional arrays are not supported")
+      return 1
+    }
+    $$ = true
+  }
+| opt_array_bounds '[' ICONST ']'
+  {
+    if $1 {
+      sqllex.Error("multi-dimensional arrays are not supported")
+      return 1
+    }
+    $$ = true
+  }
+| /* EMPTY */
+  {
+    $$ = false
+  }
 
 simple_typename:
   numeric
@@ -2149,6 +2419,18 @@ simple_typename:
   {
     $$ = &BytesType{Name: "BYTES"}
   }
+| JSON
+  {
+    $$ = &JSONType{Name: "JSON"}
+  }
+| JSONB
+  {
+    $$ = &JSONType{Name: "JSONB"}
+  }
+| UUID
+  {
+    $$ = &UUIDType{}
+  }
 | TEXT
   {
     $$ = &StringType{Name: "TEXT"}
@@ -2157,6 +2439,10 @@ simple_typename:
   {
     $$ = &StringType{Name: "STRING"}
   }
+| STRING '(' ICONST ')'
+  {
+    $$ = &StringType{Name: "STRING", N: int($3)}
+  }
 
 // We have a separate const_typename to allow defaulting fixed-length types
 // such as CHAR() and BIT() to an unspecified length. SQL9x requires that these
@@ -2193,6 +2479,10 @@ numeric:
   {
     $$ = &IntType{Name: "INT"}
   }
+| INT '(' ICONST ')'
+  {
+    $$ = &IntType{Name: "INT", N: int($3)}
+  }
 | INT64
   {
     $$ = &IntType{Name: "INT64"}
@@ -2454,6 +2744,18 @@ a_expr:
   {
     $$ = &BinaryExpr{Operator: Concat, Left: $1, Right: $3}
   }
+| a_expr FETCHVAL a_expr
+  {
+    $$ = &BinaryExpr{Operator: FetchVal, Left: $1, Right: $3}
+  }
+| a_expr FETCHTEXT a_expr
+  {
+    $$ = &BinaryExpr{Operator: FetchText, Left: $1, Right: $3}
+  }
+| a_expr CONTAINS a_expr
+  {
+    $$ = &ComparisonExpr{Operator: Contains, Left: $1, Right: $3}
+  }
 | a_expr LSHIFT a_expr
   {
     $$ = &BinaryExpr{Operator: LShift, Left: $1, Right: $3}
@@ -2506,6 +2808,10 @@ a_expr:
   {
     $$ = &ComparisonExpr{Operator: NotSimilarTo, Left: $1, Right: $5}
   }
+| a_expr subquery_op sub_type '(' a_expr ')' %prec IS
+  {
+    $$ = &AnyExpr{Operator: $2, Type: $3, Left: $1, Right: $5}
+  }
 | a_expr IS NULL %prec IS
   {
     $$ = &ComparisonExpr{Operator: Is, Left: $1, Right: DNull}
@@ -2660,6 +2966,18 @@ b_expr:
   {
     $$ = &BinaryExpr{Operator: Concat, Left: $1, Right: $3}
   }
+| b_expr FETCHVAL b_expr
+  {
+    $$ = &BinaryExpr{Operator: FetchVal, Left: $1, Right: $3}
+  }
+| b_expr FETCHTEXT b_expr
+  {
+    $$ = &BinaryExpr{Operator: FetchText, Left: $1, Right: $3}
+  }
+| b_expr CONTAINS b_expr
+  {
+    $$ = &ComparisonExpr{Operator: Contains, Left: $1, Right: $3}
+  }
 | b_expr LSHIFT b_expr
   {
     $$ = &BinaryExpr{Operator: LShift, Left: $1, Right: $3}
@@ -2717,6 +3035,19 @@ c_expr:
   {
     $$ = &ParenExpr{Expr: $2}
   }
+| '(' a_expr ')' indirection
+  {
+    var expr Expr = &ParenExpr{Expr: $2}
+    for _, elem := range $4 {
+      subscript, ok := elem.(*ArrayIndirection)
+      if !ok {
+        sqllex.Error("only array subscripts are supported on expressions")
+        return 1
+      }
+      expr = &IndirectionExpr{Expr: expr, Indirection: subscript}
+    }
+    $$ = expr
+  }
 | case_expr
 | func_expr
 | select_with_parens %prec UMINUS
@@ -2795,8 +3126,8 @@ func_expr:
 // expressions are not allowed, where needed to disambiguate the grammar
 // (e.g. in CREATE INDEX).
 func_expr_windowless:
-  func_application { unimplemented() }
-| func_expr_common_subexpr { unimplemented() }
+  func_application
+| func_expr_common_subexpr
 
 // Special expressions that are considered to be functions.
 func_expr_common_subexpr:
@@ -2969,10 +3300,19 @@ implicit_row:
     $$ = Tuple(append($2, $4))
   }
 
-// sub_type:
-//   ANY { unimplemented() }
-// | SOME { unimplemented() }
-// | ALL { unimplemented() }
+sub_type:
+  ANY
+  {
+    $$ = "ANY"
+  }
+| SOME
+  {
+    $$ = "SOME"
+  }
+| ALL
+  {
+    $$ = "ALL"
+  }
 
 // math_op:
 //   '+' { unimplemented() }
@@ -2992,10 +3332,39 @@ implicit_row:
 // | GREATER_EQUALS { unimplemented() }
 // | NOT_EQUALS { unimplemented() }
 
-// subquery_op:
-//   math_op { unimplemented() }
-// | LIKE { unimplemented() }
-// | NOT_LA LIKE { unimplemented() }
+subquery_op:
+  '<'
+  {
+    $$ = LT
+  }
+| '>'
+  {
+    $$ = GT
+  }
+| '='
+  {
+    $$ = EQ
+  }
+| LESS_EQUALS
+  {
+    $$ = LE
+  }
+| GREATER_EQUALS
+  {
+    $$ = GE
+  }
+| NOT_EQUALS
+  {
+    $$ = NE
+  }
+| LIKE
+  {
+    $$ = Like
+  }
+| NOT_LA LIKE
+  {
+    $$ = NotLike
+  }
   // cannot put SIMILAR TO here, because SIMILAR TO is a hack.
   // the regular expression is preprocessed by a function (similar_escape),
   // and the ~ operator for posix regular expressions is used.
@@ -3311,6 +3680,16 @@ name_list:
     $$ = append($1, $3)
   }
 
+string_list:
+  SCONST
+  {
+    $$ = []string{$1}
+  }
+| string_list ',' SCONST
+  {
+    $$ = append($1, $3)
+  }
+
 opt_name_list:
   '(' name_list ')'
   {
@@ -3452,6 +3831,7 @@ unreserved_keyword:
 | ADD
 | ALTER
 | AT
+| BACKUP
 | BEGIN
 | BLOB
 | BY
@@ -3459,8 +3839,11 @@ unreserved_keyword:
 | COLUMNS
 | COMMIT
 | COMMITTED
+| CONFIGURATION
+| CONFIGURE
 | CONFLICT
 | COVERING
+| CSV
 | CUBE
 | CURRENT
 | CYCLE
@@ -3477,8 +3860,13 @@ unreserved_keyword:
 | FOLLOWING
 | GRANTS
 | HOUR
+| IMPORT
+| INCREMENTAL
 | INSERT
+| INTERLEAVE
 | ISOLATION
+| JSON
+| JSONB
 | KEY
 | LEVEL
 | LOCAL
@@ -3495,6 +3883,7 @@ unreserved_keyword:
 | OFF
 | ORDINALITY
 | OVER
+| PARENT
 | PARTIAL
 | PARTITION
 | PRECEDING
@@ -3502,13 +3891,16 @@ unreserved_keyword:
 | READ
 | RECURSIVE
 | REF
+| RELEASE
 | RENAME
 | REPEATABLE
+| RESTORE
 | RESTRICT
 | REVOKE
 | ROLLBACK
 | ROLLUP
 | ROWS
+| SAVEPOINT
 | SEARCH
 | SECOND
 | SERIALIZABLE
@@ -3518,6 +3910,7 @@ unreserved_keyword:
 | SIMPLE
 | SNAPSHOT
 | SQL
+| STORED
 | STORING
 | STRICT
 | TABLES
@@ -3529,6 +3922,7 @@ unreserved_keyword:
 | UNCOMMITTED
 | UNKNOWN
 | UPDATE
+| UUID
 | VALID
 | VALIDATE
 | VALUE
@@ -3602,6 +3996,7 @@ col_name_keyword:
 type_func_name_keyword:
   COLLATION
 | CROSS
+| FAMILY
 | FULL
 | INNER
 | IS
@@ -61,6 +61,9 @@ type Statement interface {
 // StatementType implements the Statement interface.
 func (*AlterTable) StatementType() StatementType { return DDL }
 
+// StatementType implements the Statement interface.
+func (*Backup) StatementType() StatementType { return Ack }
+
 // StatementType implements the Statement interface.
 func (*BeginTransaction) StatementType() StatementType { return Ack }
 
@@ -94,12 +97,18 @@ func (*Explain) StatementType() StatementType { return Rows }
 // StatementType implements the Statement interface.
 func (*Grant) StatementType() StatementType { return DDL }
 
+// StatementType implements the Statement interface.
+func (*Import) StatementType() StatementType { return RowsAffected }
+
 // StatementType implements the Statement interface.
 func (*Insert) StatementType() StatementType { return RowsAffected }
 
 // StatementType implements the Statement interface.
 func (*ParenSelect) StatementType() StatementType { return Rows }
 
+// StatementType implements the Statement interface.
+func (*ReleaseSavepoint) StatementType() StatementType { return Ack }
+
 // StatementType implements the Statement interface.
 func (*RenameColumn) StatementType() StatementType { return DDL }
 
@@ -112,12 +121,21 @@ func (*RenameIndex) StatementType() StatementType { return DDL }
 // StatementType implements the Statement interface.
 func (*RenameTable) StatementType() StatementType { return DDL }
 
+// StatementType implements the Statement interface.
+func (*Restore) StatementType() StatementType { return DDL }
+
 // StatementType implements the Statement interface.
 func (*Revoke) StatementType() StatementType { return DDL }
 
 // StatementType implements the Statement interface.
 func (*RollbackTransaction) StatementType() StatementType { return Ack }
 
+// StatementType implements the Statement interface.
+func (*RollbackToSavepoint) StatementType() StatementType { return Ack }
+
+// StatementType implements the Statement interface.
+func (*Savepoint) StatementType() StatementType { return Ack }
+
 // StatementType implements the Statement interface.
 func (*Select) StatementType() StatementType { return Rows }
 
@@ -130,12 +148,18 @@ func (*SetTransaction) StatementType() StatementType { return Ack }
 // StatementType implements the Statement interface.
 func (*SetTimeZone) StatementType() StatementType { return Ack }
 
+// StatementType implements the Statement interface.
+func (*SetZoneConfig) StatementType() StatementType { return DDL }
+
 // StatementType implements the Statement interface.
 func (*Show) StatementType() StatementType { return Rows }
 
 // StatementType implements the Statement interface.
 func (*ShowColumns) StatementType() StatementType { return Rows }
 
+// StatementType implements the Statement interface.
+func (*ShowCreateTable) StatementType() StatementType { return Rows }
+
 // StatementType implements the Statement interface.
 func (*ShowDatabases) StatementType() StatementType { return Rows }
 
@@ -148,6 +172,9 @@ func (*ShowIndex) StatementType() StatementType { return Rows }
 // StatementType implements the Statement interface.
 func (*ShowTables) StatementType() StatementType { return Rows }
 
+// StatementType implements the Statement interface.
+func (*ShowZoneConfig) StatementType() StatementType { return Rows }
+
 // StatementType implements the Statement interface.
 func (*Truncate) StatementType() StatementType { return Ack }
 
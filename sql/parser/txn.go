@@ -72,3 +72,30 @@ type RollbackTransaction struct{}
 func (node *RollbackTransaction) String() string {
 	return "ROLLBACK TRANSACTION"
 }
+
+// Savepoint represents a SAVEPOINT <name> statement.
+type Savepoint struct {
+	Name string
+}
+
+func (node *Savepoint) String() string {
+	return fmt.Sprintf("SAVEPOINT %s", Name(node.Name))
+}
+
+// ReleaseSavepoint represents a RELEASE SAVEPOINT <name> statement.
+type ReleaseSavepoint struct {
+	Savepoint string
+}
+
+func (node *ReleaseSavepoint) String() string {
+	return fmt.Sprintf("RELEASE SAVEPOINT %s", Name(node.Savepoint))
+}
+
+// RollbackToSavepoint represents a ROLLBACK TO SAVEPOINT <name> statement.
+type RollbackToSavepoint struct {
+	Savepoint string
+}
+
+func (node *RollbackToSavepoint) String() string {
+	return fmt.Sprintf("ROLLBACK TRANSACTION TO SAVEPOINT %s", Name(node.Savepoint))
+}
@@ -47,6 +47,29 @@ func (expr *AndExpr) TypeCheck() (Datum, error) {
 	return typeCheckBooleanExprs(expr.Left, expr.Right)
 }
 
+// TypeCheck implements the Expr interface.
+func (expr *AnyExpr) TypeCheck() (Datum, error) {
+	leftType, err := expr.Left.TypeCheck()
+	if err != nil {
+		return nil, err
+	}
+	rightType, err := expr.Right.TypeCheck()
+	if err != nil {
+		return nil, err
+	}
+	if rightType == DNull {
+		return DNull, nil
+	}
+	array, ok := rightType.(DArray)
+	if !ok {
+		return nil, fmt.Errorf("op %s (array) requires an array on the right side, not %s",
+			expr.Type, rightType.Type())
+	}
+	d, cmp, err := typeCheckComparisonOp(expr.Operator, leftType, array.ElemType)
+	expr.fn = cmp
+	return d, err
+}
+
 // TypeCheck implements the Expr interface.
 func (expr *BinaryExpr) TypeCheck() (Datum, error) {
 	dummyLeft, err := expr.Left.TypeCheck()
@@ -103,7 +126,7 @@ func (expr *CaseExpr) TypeCheck() (Datum, error) {
 		}
 		if dummyCond == nil || dummyCond == DNull {
 			dummyCond = nextDummyCond
-		} else if !(nextDummyCond == DNull || nextDummyCond == dummyCond) {
+		} else if !(nextDummyCond == DNull || SameType(nextDummyCond, dummyCond)) {
 			return nil, fmt.Errorf("incompatible condition types %s, %s", dummyCond.Type(), nextDummyCond.Type())
 		}
 
@@ -113,7 +136,7 @@ func (expr *CaseExpr) TypeCheck() (Datum, error) {
 		}
 		if dummyVal == nil || dummyVal == DNull {
 			dummyVal = nextDummyVal
-		} else if !(nextDummyVal == DNull || nextDummyVal == dummyVal) {
+		} else if !(nextDummyVal == DNull || SameType(nextDummyVal, dummyVal)) {
 			return nil, fmt.Errorf("incompatible value types %s, %s", dummyVal.Type(), nextDummyVal.Type())
 		}
 	}
@@ -128,7 +151,7 @@ func (expr *CastExpr) TypeCheck() (Datum, error) {
 		return nil, err
 	}
 
-	switch expr.Type.(type) {
+	switch t := expr.Type.(type) {
 	case *BoolType:
 		switch dummyExpr {
 		case DummyBool, DummyInt, DummyFloat, DummyString:
@@ -149,13 +172,13 @@ func (expr *CastExpr) TypeCheck() (Datum, error) {
 
 	case *StringType:
 		switch dummyExpr {
-		case DummyBool, DummyInt, DummyFloat, DNull, DummyString, DummyBytes:
+		case DummyBool, DummyInt, DummyFloat, DNull, DummyString, DummyBytes, DummyJSON, DummyUUID:
 			return DummyString, nil
 		}
 
 	case *BytesType:
 		switch dummyExpr {
-		case DummyBytes, DummyString:
+		case DummyBytes, DummyString, DummyUUID:
 			return DummyBytes, nil
 		}
 
@@ -177,6 +200,36 @@ func (expr *CastExpr) TypeCheck() (Datum, error) {
 			return DummyInterval, nil
 		}
 
+	case *JSONType:
+		switch dummyExpr {
+		case DummyString, DummyJSON:
+			return DummyJSON, nil
+		}
+
+	case *UUIDType:
+		switch dummyExpr {
+		case DummyString, DummyBytes, DummyUUID:
+			return DummyUUID, nil
+		}
+
+	case *ArrayType:
+		elemType, err := t.elemDummy()
+		if err != nil {
+			return nil, err
+		}
+		switch d := dummyExpr.(type) {
+		case dNull, DString:
+			return DArray{ElemType: elemType}, nil
+		case DArray:
+			if d.ElemType != DNull {
+				cast := &CastExpr{Expr: d.ElemType, Type: t.ElemType}
+				if _, err := cast.TypeCheck(); err != nil {
+					return nil, err
+				}
+			}
+			return DArray{ElemType: elemType}, nil
+		}
+
 	// TODO(pmattis): unimplemented.
 	case *DecimalType:
 	}
@@ -194,7 +247,7 @@ func (expr *CoalesceExpr) TypeCheck() (Datum, error) {
 		}
 		if dummyArg == nil || dummyArg == DNull {
 			dummyArg = arg
-		} else if dummyArg != arg && arg != DNull {
+		} else if arg != DNull && !SameType(dummyArg, arg) {
 			return nil, fmt.Errorf("incompatible %s expressions %s, %s", expr.Name, dummyArg.Type(), arg.Type())
 		}
 	}
@@ -249,6 +302,9 @@ func (expr *FuncExpr) TypeCheck() (Datum, error) {
 		name := string(expr.Name.Base)
 		candidates, ok := builtins[strings.ToLower(name)]
 		if !ok {
+			if _, ok := generators[strings.ToLower(name)]; ok {
+				return nil, fmt.Errorf("set-returning function %s is only allowed in FROM", name)
+			}
 			return nil, fmt.Errorf("unknown function: %s", name)
 		}
 
@@ -307,7 +363,7 @@ func (expr *IfExpr) TypeCheck() (Datum, error) {
 	if dummyElse == DNull {
 		return dummyTrue, nil
 	}
-	if dummyTrue != dummyElse {
+	if !SameType(dummyTrue, dummyElse) {
 		return nil, fmt.Errorf("incompatible IF expressions %s, %s", dummyTrue.Type(), dummyElse.Type())
 	}
 	return dummyTrue, nil
@@ -339,7 +395,7 @@ func (expr *NullIfExpr) TypeCheck() (Datum, error) {
 	if expr1 == DNull {
 		return expr2, nil
 	}
-	if expr2 != DNull && expr1 != expr2 {
+	if expr2 != DNull && !SameType(expr1, expr2) {
 		return nil, fmt.Errorf("incompatible NULLIF expressions %s, %s", expr1.Type(), expr2.Type())
 	}
 	return expr1, nil
@@ -405,9 +461,59 @@ func (expr *UnaryExpr) TypeCheck() (Datum, error) {
 		expr.Operator, dummyExpr.Type())
 }
 
+// TypeCheck implements the Expr interface.
+func (expr *IndirectionExpr) TypeCheck() (Datum, error) {
+	d, err := expr.Expr.TypeCheck()
+	if err != nil {
+		return nil, err
+	}
+	for _, e := range []Expr{expr.Indirection.Begin, expr.Indirection.End} {
+		if e == nil {
+			continue
+		}
+		subscript, err := e.TypeCheck()
+		if err != nil {
+			return nil, err
+		}
+		if subscript != DNull && subscript != DummyInt {
+			return nil, fmt.Errorf("array subscript must have type int, not %s", subscript.Type())
+		}
+	}
+	if d == DNull {
+		return DNull, nil
+	}
+	array, ok := d.(DArray)
+	if !ok {
+		return nil, fmt.Errorf("cannot subscript type %s because it is not an array", d.Type())
+	}
+	if expr.Indirection.End != nil {
+		// A slice of an array is an array.
+		return DArray{ElemType: array.ElemType}, nil
+	}
+	return array.ElemType, nil
+}
+
 // TypeCheck implements the Expr interface.
 func (expr Array) TypeCheck() (Datum, error) {
-	return nil, util.Errorf("unhandled type %T", expr)
+	var elemType Datum = DNull
+	for _, e := range expr {
+		d, err := e.TypeCheck()
+		if err != nil {
+			return nil, err
+		}
+		if d == DNull {
+			continue
+		}
+		if err := checkArrayElemType(d); err != nil {
+			return nil, err
+		}
+		if elemType == DNull {
+			elemType = d
+		} else if !SameType(elemType, d) {
+			return nil, fmt.Errorf("ARRAY types %s and %s cannot be matched", elemType.Type(), d.Type())
+		}
+	}
+	return DArray{ElemType: elemType}, nil
 }
 
 // TypeCheck implements the Expr interface.
@@ -448,6 +554,11 @@ func (expr ValArg) TypeCheck() (Datum, error) {
 	return nil, util.Errorf("unhandled type %T", expr)
 }
 
+// TypeCheck implements the Expr interface.
+func (expr DArray) TypeCheck() (Datum, error) {
+	return DArray{ElemType: expr.ElemType}, nil
+}
+
 // TypeCheck implements the Expr interface.
 func (expr DBool) TypeCheck() (Datum, error) {
 	return DummyBool, nil
@@ -478,6 +589,16 @@ func (expr DInterval) TypeCheck() (Datum, error) {
 	return DummyInterval, nil
 }
 
+// TypeCheck implements the Expr interface.
+func (expr DJSON) TypeCheck() (Datum, error) {
+	return DummyJSON, nil
+}
+
+// TypeCheck implements the Expr interface.
+func (expr DUUID) TypeCheck() (Datum, error) {
+	return DummyUUID, nil
+}
+
 // TypeCheck implements the Expr interface.
 func (expr dNull) TypeCheck() (Datum, error) {
 	return DNull, nil
@@ -547,6 +668,9 @@ func typeCheckComparisonOp(op ComparisonOp, dummyLeft, dummyRight Datum) (Datum,
 			if err := typeCheckTupleIN(dummyLeft, dummyRight); err != nil {
 				return nil, cmpOp{}, err
 			}
+		} else if lType == arrayType && !SameType(dummyLeft, dummyRight) {
+			return nil, cmpOp{}, fmt.Errorf("unsupported comparison operator: <%s> %s <%s>",
+				dummyLeft.Type(), op, dummyRight.Type())
 		}
 
 		return cmpOpResultType, cmp, nil
@@ -586,3 +710,38 @@ func typeCheckTupleIN(arg, values Datum) error {
 
 	return nil
 }
+
+// SameType returns whether two placeholder values, as returned by TypeCheck,
+// have the same type. An array whose element type is unknown, such as
+// ARRAY[], has the same type as any other array.
+func SameType(a, b Datum) bool {
+	switch t := a.(type) {
+	case DArray:
+		u, ok := b.(DArray)
+		return ok && (t.ElemType == DNull || u.ElemType == DNull || SameType(t.ElemType, u.ElemType))
+	case DTuple:
+		u, ok := b.(DTuple)
+		if !ok || len(t) != len(u) {
+			return false
+		}
+		for i := range t {
+			if !SameType(t[i], u[i]) {
+				return false
+			}
+		}
+		return true
+	}
+	return a == b
+}
+
+// checkArrayElemType returns an error if arrays cannot hold values of the
+// type of the placeholder value d.
+func checkArrayElemType(d Datum) error {
+	switch d.(type) {
+	case DBool, DInt, DFloat, DString, DBytes, DDate, DTimestamp, DInterval, DUUID:
+		return nil
+	case DArray:
+		return fmt.Errorf("multi-dimensional arrays are not supported")
+	}
+	return fmt.Errorf("arrays of type %s are not supported", d.Type())
+}
@@ -37,6 +37,9 @@ func (*TimestampType) columnType() {}
 func (*IntervalType) columnType()  {}
 func (*StringType) columnType()    {}
 func (*BytesType) columnType()     {}
+func (*JSONType) columnType()      {}
+func (*UUIDType) columnType()      {}
+func (*ArrayType) columnType()     {}
 
 // BoolType represents a BOOLEAN type.
 type BoolType struct {
@@ -144,3 +147,57 @@ type BytesType struct {
 func (node *BytesType) String() string {
 	return node.Name
 }
+
+// JSONType represents a JSON or JSONB type. Both are stored as the canonical
+// text of the JSON value.
+type JSONType struct {
+	Name string
+}
+
+func (node *JSONType) String() string {
+	return node.Name
+}
+
+// UUIDType represents a UUID type.
+type UUIDType struct {
+}
+
+func (node *UUIDType) String() string {
+	return "UUID"
+}
+
+// ArrayType represents an array type such as INT[] or STRING ARRAY. Only
+// one-dimensional arrays are supported.
+type ArrayType struct {
+	ElemType ColumnType
+}
+
+func (node *ArrayType) String() string {
+	return node.ElemType.String() + "[]"
+}
+
+// elemDummy returns a placeholder value of the type of the elements of the
+// array.
+func (node *ArrayType) elemDummy() (Datum, error) {
+	switch node.ElemType.(type) {
+	case *BoolType:
+		return DummyBool, nil
+	case *IntType:
+		return DummyInt, nil
+	case *FloatType:
+		return DummyFloat, nil
+	case *StringType:
+		return DummyString, nil
+	case *BytesType:
+		return DummyBytes, nil
+	case *DateType:
+		return DummyDate, nil
+	case *TimestampType:
+		return DummyTimestamp, nil
+	case *IntervalType:
+		return DummyInterval, nil
+	case *UUIDType:
+		return DummyUUID, nil
+	}
+	return nil, fmt.Errorf("arrays of type %s are not supported", node.ElemType)
+}
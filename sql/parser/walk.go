@@ -35,6 +35,12 @@ func (expr *AndExpr) Walk(v Visitor) {
 	expr.Right = WalkExpr(v, expr.Right)
 }
 
+// Walk implements the Expr interface.
+func (expr *AnyExpr) Walk(v Visitor) {
+	expr.Left = WalkExpr(v, expr.Left)
+	expr.Right = WalkExpr(v, expr.Right)
+}
+
 // Walk implements the Expr interface.
 func (expr *BinaryExpr) Walk(v Visitor) {
 	expr.Left = WalkExpr(v, expr.Left)
@@ -137,6 +143,15 @@ func (expr *UnaryExpr) Walk(v Visitor) {
 	expr.Expr = WalkExpr(v, expr.Expr)
 }
 
+// Walk implements the Expr interface.
+func (expr *IndirectionExpr) Walk(v Visitor) {
+	expr.Expr = WalkExpr(v, expr.Expr)
+	expr.Indirection.Begin = WalkExpr(v, expr.Indirection.Begin)
+	if expr.Indirection.End != nil {
+		expr.Indirection.End = WalkExpr(v, expr.Indirection.End)
+	}
+}
+
 // Walk implements the Expr interface.
 func (expr Array) Walk(v Visitor) {
 	for i := range expr {
@@ -170,6 +185,9 @@ func (expr Tuple) Walk(v Visitor) {
 // Walk implements the Expr interface.
 func (ValArg) Walk(_ Visitor) {}
 
+// Walk implements the Expr interface.
+func (DArray) Walk(_ Visitor) {}
+
 // Walk implements the Expr interface.
 func (DBool) Walk(_ Visitor) {}
 
@@ -188,6 +206,12 @@ func (DInt) Walk(_ Visitor) {}
 // Walk implements the Expr interface.
 func (DInterval) Walk(_ Visitor) {}
 
+// Walk implements the Expr interface.
+func (DJSON) Walk(_ Visitor) {}
+
+// Walk implements the Expr interface.
+func (DUUID) Walk(_ Visitor) {}
+
 // Walk implements the Expr interface.
 func (dNull) Walk(_ Visitor) {}
 
@@ -266,6 +290,11 @@ func WalkStmt(v Visitor, stmt Statement) {
 	case *ParenSelect:
 		WalkStmt(v, stmt.Select)
 	case *Select:
+		if stmt.With != nil {
+			for _, cte := range stmt.With.CTEs {
+				WalkStmt(v, cte.Stmt)
+			}
+		}
 		for i := range stmt.Exprs {
 			stmt.Exprs[i].Expr = WalkExpr(v, stmt.Exprs[i].Expr)
 		}
@@ -293,6 +322,9 @@ func WalkStmt(v Visitor, stmt Statement) {
 		for i, expr := range stmt.Values {
 			stmt.Values[i] = WalkExpr(v, expr)
 		}
+	case *Union:
+		WalkStmt(v, stmt.Left)
+		WalkStmt(v, stmt.Right)
 	case *Update:
 		for i, expr := range stmt.Exprs {
 			stmt.Exprs[i].Expr = WalkExpr(v, expr.Expr)
@@ -316,7 +348,7 @@ var _ Visitor = &containsSubqueryVisitor{}
 func (v *containsSubqueryVisitor) Visit(expr Expr, pre bool) (Visitor, Expr) {
 	if pre && !v.containsSubquery {
 		switch expr.(type) {
-		case *Subquery:
+		case *Subquery, *ExistsExpr:
 			v.containsSubquery = true
 			return nil, expr
 		}
@@ -0,0 +1,257 @@
+// Copyright 2015 The Cockroach Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+// implied. See the License for the specific language governing
+// permissions and limitations under the License. See the AUTHORS file
+// for names of contributors.
+
+package sql
+
+import (
+	"fmt"
+
+	"github.com/cockroachdb/cockroach/sql/parser"
+)
+
+// setPredicate sets the predicate of a partial index from the WHERE clause of
+// an index definition. The predicate is nil for indexes which are not partial.
+func (desc *IndexDescriptor) setPredicate(expr parser.Expr) {
+	if expr == nil {
+		return
+	}
+	s := expr.String()
+	desc.Predicate = &s
+}
+
+// validateIndexPredicate checks the predicate of a new partial index. The
+// predicate may only refer to columns of the table, must be a boolean
+// expression and may not contain aggregates, placeholders or impure
+// functions. The column references in the stored predicate are stripped of
+// the table name, so that the table can be renamed.
+func (p *planner) validateIndexPredicate(tableDesc *TableDescriptor, index *IndexDescriptor) error {
+	if index.Predicate == nil {
+		return nil
+	}
+	expr, _, err := p.resolveTableExpr(tableDesc, *index.Predicate)
+	if err != nil {
+		return err
+	}
+	resolved := expr.String()
+	if _, funcs, err := extractAggregateFuncs(expr); err != nil {
+		return err
+	} else if len(funcs) > 0 {
+		return fmt.Errorf("index %q predicate cannot contain aggregate functions", index.Name)
+	}
+	typ, err := expr.TypeCheck()
+	if err != nil {
+		return err
+	}
+	if !(typ == parser.DNull || parser.SameType(parser.DummyBool, typ)) {
+		return fmt.Errorf("index %q predicate must be type %s, not type %s",
+			index.Name, parser.DummyBool.Type(), typ.Type())
+	}
+	v := computedExprVisitor{}
+	parser.WalkExpr(&v, expr)
+	if v.found != "" {
+		return fmt.Errorf("index %q predicate cannot contain %s", index.Name, v.found)
+	}
+	index.Predicate = &resolved
+	return nil
+}
+
+// indexPredicates holds the predicates of the partial indexes of a table,
+// which determine the rows that have an entry in each index.
+type indexPredicates struct {
+	exprs map[IndexID]parser.Expr
+	// The columns referenced by each predicate. The qvalues are bound to the
+	// values of the row being written before the predicate is evaluated.
+	qvals map[IndexID]qvalMap
+}
+
+// makeIndexPredicates returns the predicates of the partial indexes of the
+// table. The result is nil if the table has no partial indexes.
+func (p *planner) makeIndexPredicates(tableDesc *TableDescriptor) (*indexPredicates, error) {
+	var ip *indexPredicates
+	for _, index := range tableDesc.Indexes {
+		if index.Predicate == nil {
+			continue
+		}
+		expr, qvals, err := p.resolveTableExpr(tableDesc, *index.Predicate)
+		if err != nil {
+			return nil, err
+		}
+		if _, err := expr.TypeCheck(); err != nil {
+			return nil, err
+		}
+		if expr, err = p.evalCtx.NormalizeExpr(expr); err != nil {
+			return nil, err
+		}
+		if ip == nil {
+			ip = &indexPredicates{
+				exprs: map[IndexID]parser.Expr{},
+				qvals: map[IndexID]qvalMap{},
+			}
+		}
+		ip.exprs[index.ID] = expr
+		ip.qvals[index.ID] = qvals
+	}
+	return ip, nil
+}
+
+// matches returns true if the row has an entry in the index, which is the
+// case for every row if the index is not partial. The values of the columns
+// referenced by the predicate are looked up in rowVals using colIDtoRowIndex;
+// columns missing from the row are NULL.
+func (ip *indexPredicates) matches(
+	ctx parser.EvalContext, index *IndexDescriptor, colIDtoRowIndex map[ColumnID]int,
+	rowVals parser.DTuple,
+) (bool, error) {
+	if ip == nil {
+		return true, nil
+	}
+	expr, ok := ip.exprs[index.ID]
+	if !ok {
+		return true, nil
+	}
+	for id, qval := range ip.qvals[index.ID] {
+		qval.datum = parser.DNull
+		if j, ok := colIDtoRowIndex[id]; ok {
+			qval.datum = rowVals[j]
+		}
+	}
+	d, err := expr.Eval(ctx)
+	if err != nil {
+		return false, fmt.Errorf("index %q predicate: %v", index.Name, err)
+	}
+	return d == parser.DBool(true), nil
+}
+
+// filter returns the indexes in which the row has an entry.
+func (ip *indexPredicates) filter(
+	ctx parser.EvalContext, indexes []IndexDescriptor, colIDtoRowIndex map[ColumnID]int,
+	rowVals parser.DTuple,
+) ([]IndexDescriptor, error) {
+	if ip == nil {
+		return indexes, nil
+	}
+	result := make([]IndexDescriptor, 0, len(indexes))
+	for i := range indexes {
+		ok, err := ip.matches(ctx, &indexes[i], colIDtoRowIndex, rowVals)
+		if err != nil {
+			return nil, err
+		}
+		if ok {
+			result = append(result, indexes[i])
+		}
+	}
+	return result, nil
+}
+
+// references returns true if the predicate of the index refers to one of the
+// columns in the set.
+func (ip *indexPredicates) references(index *IndexDescriptor, colIDSet map[ColumnID]struct{}) bool {
+	if ip == nil {
+		return false
+	}
+	for id := range ip.qvals[index.ID] {
+		if _, ok := colIDSet[id]; ok {
+			return true
+		}
+	}
+	return false
+}
+
+// removeUnusablePartialIndexes removes the candidate partial indexes whose
+// predicate is not implied by the filter of the scan, which has been
+// analyzed into exprs. Such an index does not contain all of the rows the
+// scan needs. It is an error for the index requested explicitly by the query
+// to be removed.
+func (p *planner) removeUnusablePartialIndexes(
+	s *scanNode, candidates []*indexInfo, exprs []parser.Exprs,
+) ([]*indexInfo, error) {
+	n := 0
+	for _, c := range candidates {
+		if c.index.Predicate != nil {
+			pred, _, err := p.resolveTableExpr(s.desc, *c.index.Predicate)
+			if err != nil {
+				return nil, err
+			}
+			if pred, err = p.evalCtx.NormalizeExpr(pred); err != nil {
+				return nil, err
+			}
+			if !predicateImplied(exprs, pred) {
+				if s.isSecondaryIndex {
+					return nil, fmt.Errorf("partial index %q cannot be used for this query: "+
+						"the WHERE clause does not imply the index predicate", c.index.Name)
+				}
+				continue
+			}
+		}
+		candidates[n] = c
+		n++
+	}
+	return candidates[:n], nil
+}
+
+// predicateImplied returns true if the filter expressions imply the predicate
+// of a partial index, so that every row for which the filter is true has an
+// entry in the index. The filter expressions are the disjunctions of
+// conjunctions returned by analyzeExpr. Each of the conjuncts of the
+// predicate needs to be implied by one of the conjuncts of every disjunction.
+func predicateImplied(exprs []parser.Exprs, pred parser.Expr) bool {
+	if len(exprs) == 0 {
+		return false
+	}
+	for _, andExprs := range exprs {
+		for _, c := range splitAndExpr(pred, nil) {
+			if !conjunctImplied(andExprs, c) {
+				return false
+			}
+		}
+	}
+	return true
+}
+
+// conjunctImplied returns true if one of the expressions implies c. An
+// expression implies an identical expression. A comparison of a column with a
+// constant implies another such comparison of the same column if the two
+// cannot be true and false at the same time. For example, "a > 2" implies
+// "a >= 1" and "a = 1" implies "a IS NOT NULL".
+func conjunctImplied(andExprs parser.Exprs, c parser.Expr) bool {
+	for _, e := range andExprs {
+		if d, ok := e.(parser.DBool); ok && !bool(d) {
+			return true
+		}
+		if e.String() == c.String() {
+			return true
+		}
+		lcmp, ok := e.(*parser.ComparisonExpr)
+		if !ok || !isDatum(lcmp.Right) || lcmp.Operator == parser.Is {
+			// When "a IS NULL" is true, a comparison such as "a < 1" is NULL
+			// rather than false.
+			continue
+		}
+		rcmp, ok := c.(*parser.ComparisonExpr)
+		if !ok || !isDatum(rcmp.Right) || !varEqual(lcmp.Left, rcmp.Left) {
+			continue
+		}
+		if rcmp.Right == parser.DNull && rcmp.Operator != parser.Is && rcmp.Operator != parser.IsNot {
+			continue
+		}
+		// e implies c if "e AND NOT c" is false. Whenever e is true the column
+		// is not NULL, so c is either true or false.
+		s, _ := simplifyExpr(&parser.AndExpr{Left: lcmp, Right: &parser.NotExpr{Expr: rcmp}})
+		if d, ok := s.(parser.DBool); ok && !bool(d) {
+			return true
+		}
+	}
+	return false
+}
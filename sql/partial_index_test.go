@@ -0,0 +1,55 @@
+// Copyright 2015 The Cockroach Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+// implied. See the License for the specific language governing
+// permissions and limitations under the License. See the AUTHORS file
+// for names of contributors.
+
+package sql
+
+import (
+	"testing"
+
+	"github.com/cockroachdb/cockroach/util/leaktest"
+)
+
+func TestPredicateImplied(t *testing.T) {
+	defer leaktest.AfterTest(t)
+
+	testData := []struct {
+		filter   string
+		pred     string
+		expected bool
+	}{
+		{`a IS NULL`, `a IS NULL`, true},
+		{`a = 1`, `a IS NOT NULL`, true},
+		{`a > 2`, `a >= 1`, true},
+		{`a >= 1`, `a > 0`, true},
+		{`a < 0`, `a != 3`, true},
+		{`a = 1 AND c`, `c AND a IS NOT NULL`, true},
+		{`a = 1 AND b = 2`, `b > 1`, true},
+
+		{`a IS NULL`, `a < 5`, false},
+		{`a IS NOT NULL`, `a IS NULL`, false},
+		{`a > 0`, `a > 1`, false},
+		{`b = 1`, `a IS NULL`, false},
+		{`c`, `c AND d`, false},
+		{`a = 1 OR b = 3`, `a > 0`, false},
+	}
+	for _, d := range testData {
+		filter, _ := parseAndNormalizeExpr(t, d.filter)
+		exprs, _ := analyzeExpr(filter)
+		pred, _ := parseAndNormalizeExpr(t, d.pred)
+		if implied := predicateImplied(exprs, pred); d.expected != implied {
+			t.Errorf("%s => %s: expected %v, but found %v", d.filter, d.pred, d.expected, implied)
+		}
+	}
+}
@@ -35,6 +35,7 @@ type schemaInfo struct {
 // planner is the centerpiece of SQL statement execution combining session
 // state and database state with the logic for SQL execution.
 type planner struct {
+	db           *client.DB
 	txn          *client.Txn
 	session      Session
 	user         string
@@ -42,6 +43,35 @@ type planner struct {
 	leases       map[ID]*LeaseState
 	leaseMgr     *LeaseManager
 	systemConfig *config.SystemConfig
+	stmtStats    *stmtStatsRegistry
+	distSQL      *distSQLContext
+
+	// The monitor of the memory used by the session, and the accounts of the
+	// operators of the statement being executed.
+	mon         *memoryMonitor
+	memAccounts []*memoryAccount
+
+	// Set when the transaction was started for the statement being executed
+	// rather than by BEGIN.
+	implicitTxn bool
+	// Set while planning the statement of an EXPLAIN which only describes its
+	// plan. The rows which are computed at planning time are not computed.
+	planOnly bool
+	// The directory IMPORT, BACKUP and RESTORE are confined to.
+	externalIODir string
+
+	// Set by SAVEPOINT cockroach_restart: a retryable error leaves the
+	// transaction open to be restarted instead of aborting it.
+	retryIntent bool
+	// Set when the transaction encountered a retryable error and is waiting
+	// for ROLLBACK TO SAVEPOINT cockroach_restart.
+	restartWait bool
+
+	// The common table expressions of the WITH clauses enclosing the statement
+	// being planned, innermost last.
+	ctes []map[string]*materializedTable
+	// The queries enclosing the subquery being planned, innermost last.
+	subqueryScopes []*subqueryScope
 
 	// TODO(pmattis): This is a hack to force updating to the latest version of a
 	// lease after a schema change operation such as CREATE INDEX.
@@ -55,6 +85,9 @@ func (p *planner) setTxn(txn *client.Txn, timestamp time.Time) {
 
 func (p *planner) resetTxn() {
 	p.setTxn(nil, time.Time{})
+	p.implicitTxn = false
+	p.retryIntent = false
+	p.restartWait = false
 }
 
 // makePlan creates the query plan for a single SQL statement. The returned
@@ -73,6 +106,8 @@ func (p *planner) makePlan(stmt parser.Statement) (planNode, error) {
 	switch n := stmt.(type) {
 	case *parser.AlterTable:
 		return p.AlterTable(n)
+	case *parser.Backup:
+		return p.Backup(n)
 	case *parser.BeginTransaction:
 		return p.BeginTransaction(n)
 	case *parser.CommitTransaction:
@@ -95,6 +130,8 @@ func (p *planner) makePlan(stmt parser.Statement) (planNode, error) {
 		return p.Explain(n)
 	case *parser.Grant:
 		return p.Grant(n)
+	case *parser.Import:
+		return p.Import(n)
 	case *parser.Insert:
 		return p.Insert(n)
 	case *parser.ParenSelect:
@@ -107,10 +144,18 @@ func (p *planner) makePlan(stmt parser.Statement) (planNode, error) {
 		return p.RenameIndex(n)
 	case *parser.RenameTable:
 		return p.RenameTable(n)
+	case *parser.Restore:
+		return p.Restore(n)
 	case *parser.Revoke:
 		return p.Revoke(n)
+	case *parser.ReleaseSavepoint:
+		return p.ReleaseSavepoint(n)
+	case *parser.RollbackToSavepoint:
+		return p.RollbackToSavepoint(n)
 	case *parser.RollbackTransaction:
 		return p.RollbackTransaction(n)
+	case *parser.Savepoint:
+		return p.Savepoint(n)
 	case *parser.Select:
 		return p.Select(n)
 	case *parser.Set:
@@ -119,6 +164,8 @@ func (p *planner) makePlan(stmt parser.Statement) (planNode, error) {
 		return p.SetTimeZone(n)
 	case *parser.SetTransaction:
 		return p.SetTransaction(n)
+	case *parser.SetZoneConfig:
+		return p.SetZoneConfig(n)
 	case *parser.Show:
 		return p.Show(n)
 	case *parser.ShowColumns:
@@ -127,10 +174,14 @@ func (p *planner) makePlan(stmt parser.Statement) (planNode, error) {
 		return p.ShowDatabases(n)
 	case *parser.ShowGrants:
 		return p.ShowGrants(n)
+	case *parser.ShowCreateTable:
+		return p.ShowCreateTable(n)
 	case *parser.ShowIndex:
 		return p.ShowIndex(n)
 	case *parser.ShowTables:
 		return p.ShowTables(n)
+	case *parser.ShowZoneConfig:
+		return p.ShowZoneConfig(n)
 	case *parser.Truncate:
 		return p.Truncate(n)
 	case *parser.Update:
@@ -234,6 +285,10 @@ func (p *planner) releaseLeases(db client.DB) {
 				lease, err = p.leaseMgr.Acquire(txn, d.id, d.version)
 				return err
 			})
+			if err == errTableDropped {
+				// The lease manager has released its leases on the dropped table.
+				continue
+			}
 			if err != nil {
 				log.Warning(err)
 				continue
@@ -271,6 +326,7 @@ type planNode interface {
 }
 
 var _ planNode = &distinctNode{}
+var _ planNode = &flowNode{}
 var _ planNode = &groupNode{}
 var _ planNode = &indexJoinNode{}
 var _ planNode = &limitNode{}
@@ -294,6 +294,47 @@ func (p *planner) RenameColumn(n *parser.RenameColumn) (planNode, error) {
 			}
 		}
 	}
+	for _, family := range tableDesc.Families {
+		for i, id := range family.ColumnIDs {
+			if id == column.ID {
+				family.ColumnNames[i] = newColName
+			}
+		}
+	}
+	// Rewrite the expressions of the computed columns which refer to the
+	// column. The column references in a resolved expression print the names
+	// of the columns they refer to.
+	for j := range tableDesc.Columns {
+		other := &tableDesc.Columns[j]
+		if other.ComputedExpr == nil {
+			continue
+		}
+		expr, qvals, err := p.resolveComputedExpr(tableDesc, *other)
+		if err != nil {
+			return nil, err
+		}
+		if qval, ok := qvals[column.ID]; ok {
+			qval.col.Name = newColName
+			s := expr.String()
+			other.ComputedExpr = &s
+		}
+	}
+	// Likewise rewrite the predicates of the partial indexes.
+	for j := range tableDesc.Indexes {
+		idx := &tableDesc.Indexes[j]
+		if idx.Predicate == nil {
+			continue
+		}
+		expr, qvals, err := p.resolveTableExpr(tableDesc, *idx.Predicate)
+		if err != nil {
+			return nil, err
+		}
+		if qval, ok := qvals[column.ID]; ok {
+			qval.col.Name = newColName
+			s := expr.String()
+			idx.Predicate = &s
+		}
+	}
 	column.Name = newColName
 
 	// TODO(pmattis): This is a hack. Remove when schema change operations work
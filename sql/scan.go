@@ -31,6 +31,7 @@ import (
 	"github.com/cockroachdb/cockroach/util"
 	"github.com/cockroachdb/cockroach/util/encoding"
 	"github.com/cockroachdb/cockroach/util/log"
+	"github.com/cockroachdb/cockroach/util/uuid"
 )
 
 // qvalue implements the parser.DRereference interface and is used as a
@@ -47,7 +48,7 @@ var _ parser.VariableExpr = &qvalue{}
 func (*qvalue) Variable() {}
 
 func (q *qvalue) String() string {
-	return q.col.Name
+	return parser.Name(q.col.Name).String()
 }
 
 func (q *qvalue) Walk(v parser.Visitor) {
@@ -63,7 +64,7 @@ func (q *qvalue) Eval(ctx parser.EvalContext) (parser.Datum, error) {
 }
 
 type qvalMap map[ColumnID]*qvalue
-type colKindMap map[ColumnID]ColumnType_Kind
+type colTypeMap map[ColumnID]ColumnType
 
 type span struct {
 	start roachpb.Key
@@ -74,6 +75,13 @@ type span struct {
 // prettyKey pretty-prints the specified key, skipping over the first skip
 // fields.
 func prettyKey(key roachpb.Key, skip int) string {
+	return prettyIndexKey(key, nil, skip)
+}
+
+// prettyIndexKey pretty-prints the specified index key, skipping over the
+// first skip fields. The directions of the index columns are needed to
+// decode descending integers, which are indistinguishable from ascending ones.
+func prettyIndexKey(key roachpb.Key, dirs []IndexDescriptor_Direction, skip int) string {
 	if !bytes.HasPrefix(key, keys.TableDataPrefix) {
 		return fmt.Sprintf("index key missing table data prefix: %q vs %q",
 			key, keys.TableDataPrefix)
@@ -93,20 +101,45 @@ func prettyKey(key roachpb.Key, skip int) string {
 			d = "#"
 		case encoding.Int:
 			var i int64
-			key, i, err = encoding.DecodeVarint(key)
+			// The first two fields are the table and index IDs.
+			if k >= 2 && encodingDirection(dirs, k-2) == encoding.Descending {
+				key, i, err = encoding.DecodeVarintDecreasing(key)
+			} else {
+				key, i, err = encoding.DecodeVarint(key)
+			}
 			d = parser.DInt(i)
 		case encoding.Float:
 			var f float64
 			key, f, err = encoding.DecodeFloat(key, nil)
 			d = parser.DFloat(f)
+		case encoding.FloatDesc:
+			var f float64
+			key, f, err = encoding.DecodeFloatDecreasing(key, nil)
+			d = parser.DFloat(f)
 		case encoding.Bytes:
 			var s string
 			key, s, err = encoding.DecodeString(key, nil)
 			d = parser.DString(s)
+		case encoding.BytesDesc:
+			var s string
+			key, s, err = encoding.DecodeStringDecreasing(key, nil)
+			d = parser.DString(s)
 		case encoding.Time:
 			var t time.Time
 			key, t, err = encoding.DecodeTime(key)
 			d = parser.DTimestamp{Time: t}
+		case encoding.TimeDesc:
+			var t time.Time
+			key, t, err = encoding.DecodeTimeDecreasing(key)
+			d = parser.DTimestamp{Time: t}
+		case encoding.UUID:
+			var u uuid.UUID
+			key, u, err = encoding.DecodeUUID(key)
+			d = u
+		case encoding.UUIDDesc:
+			var u uuid.UUID
+			key, u, err = encoding.DecodeUUIDDecreasing(key)
+			d = u
 		default:
 			// This shouldn't ever happen, but if it does let the loop exit.
 			key = nil
@@ -125,22 +158,23 @@ func prettyKey(key roachpb.Key, skip int) string {
 	return buf.String()
 }
 
-func prettySpan(span span, skip int) string {
+func prettySpan(span span, dirs []IndexDescriptor_Direction, skip int) string {
 	var buf bytes.Buffer
 	if span.count != 0 {
 		fmt.Fprintf(&buf, "%d:", span.count)
 	}
-	fmt.Fprintf(&buf, "%s-%s", prettyKey(span.start, skip), prettyKey(span.end, skip))
+	fmt.Fprintf(&buf, "%s-%s", prettyIndexKey(span.start, dirs, skip),
+		prettyIndexKey(span.end, dirs, skip))
 	return buf.String()
 }
 
-func prettySpans(spans []span, skip int) string {
+func prettySpans(spans []span, dirs []IndexDescriptor_Direction, skip int) string {
 	var buf bytes.Buffer
 	for i, span := range spans {
 		if i > 0 {
 			buf.WriteString(" ")
 		}
-		buf.WriteString(prettySpan(span, skip))
+		buf.WriteString(prettySpan(span, dirs, skip))
 	}
 	return buf.String()
 }
@@ -149,7 +183,7 @@ func prettySpans(spans []span, skip int) string {
 // reconstructing them into rows.
 type scanNode struct {
 	planner          *planner
-	txn              *client.Txn
+	txn              client.Runner
 	desc             *TableDescriptor
 	index            *IndexDescriptor
 	spans            []span
@@ -161,22 +195,29 @@ type scanNode struct {
 	ordering         []int
 	exactPrefix      int
 	err              error
-	indexKey         []byte            // the index key of the current row
-	kvs              []client.KeyValue // the raw key/value pairs
-	kvIndex          int               // current index into the key/value pairs
-	rowIndex         int               // the index of the current row
-	colID            ColumnID          // column ID of the current key
-	valTypes         []parser.Datum    // the index key value types for the current row
-	vals             []parser.Datum    // the index key values for the current row
-	implicitValTypes []parser.Datum    // the implicit value types for unique indexes
-	implicitVals     []parser.Datum    // the implicit values for unique indexes
-	qvals            qvalMap           // the values in the current row
-	colKind          colKindMap        // map of column kinds for decoding column values
-	row              parser.DTuple     // the rendered row
-	filter           parser.Expr       // filtering expression for rows
-	render           []parser.Expr     // rendering expressions for rows
+	indexKey         []byte                  // the index key of the current row
+	kvs              []client.KeyValue       // the raw key/value pairs
+	kvIndex          int                     // current index into the key/value pairs
+	rowIndex         int                     // the index of the current row
+	colID            ColumnID                // column ID of the current key
+	family           *ColumnFamilyDescriptor // column family of the current key
+	valTypes         []parser.Datum          // the index key value types for the current row
+	vals             []parser.Datum          // the index key values for the current row
+	implicitValTypes []parser.Datum          // the implicit value types for unique indexes
+	implicitVals     []parser.Datum          // the implicit values for unique indexes
+	qvals            qvalMap                 // the values in the current row
+	colType          colTypeMap              // map of column types for decoding column values
+	row              parser.DTuple           // the rendered row
+	filter           parser.Expr             // filtering expression for rows
+	render           []parser.Expr           // rendering expressions for rows
+	lockRows         bool                    // lay down intents on the rows for SELECT FOR UPDATE
+	lockLimit        int64                   // the maximum number of rows to lock
+	lockedRows       int64                   // the number of rows locked so far
 	explain          explainMode
 	explainValue     parser.Datum
+	virtualRows      []parser.DTuple    // the rows of a virtual table
+	materialized     *materializedTable // the materialized rows being scanned
+	changedSince     roachpb.Timestamp  // only keep the values written after this, for ScanFilter
 }
 
 func (n *scanNode) Columns() []string {
@@ -202,6 +243,10 @@ func (n *scanNode) Next() bool {
 		}
 	}
 
+	if isVirtualDescriptor(n.desc) {
+		return n.nextVirtual()
+	}
+
 	// All of the columns for a particular row will be grouped together. We loop
 	// over the key/value pairs and decode the key to extract the columns encoded
 	// within the key and the column ID. We use the column ID to lookup the
@@ -234,9 +279,11 @@ func (n *scanNode) ExplainPlan() (name, description string, children []planNode)
 	}
 	if n.desc == nil {
 		description = "-"
+	} else if isVirtualDescriptor(n.desc) {
+		description = fmt.Sprintf("%s (virtual)", n.desc.Name)
 	} else {
 		description = fmt.Sprintf("%s@%s %s", n.desc.Name, n.index.Name,
-			prettySpans(n.spans, 2))
+			prettySpans(n.spans, n.index.ColumnDirections, 2))
 	}
 	return name, description, nil
 }
@@ -248,6 +295,16 @@ func (n *scanNode) initFrom(p *planner, from parser.TableExprs) error {
 		return nil
 
 	case 1:
+		if c := p.findCTE(from[0]); c != nil {
+			n.initMaterialized(from[0].(*parser.AliasedTableExpr), c)
+			return nil
+		}
+		if ate, ok := from[0].(*parser.AliasedTableExpr); ok {
+			if fn, ok := ate.Expr.(*parser.FuncExpr); ok {
+				return n.initGenerator(ate, fn)
+			}
+		}
+
 		if n.desc, n.err = p.getAliasedTableLease(from[0]); n.err != nil {
 			return n.err
 		}
@@ -321,10 +378,20 @@ func (n *scanNode) initScan() bool {
 		return true
 	}
 
+	if isVirtualDescriptor(n.desc) {
+		n.kvs = []client.KeyValue{}
+		if n.materialized != nil {
+			n.virtualRows = n.materialized.rows
+			return true
+		}
+		n.virtualRows, n.err = n.planner.virtualTableRows(n.desc)
+		return n.err == nil
+	}
+
 	if len(n.spans) == 0 {
 		// If no spans were specified retrieve all of the keys that start with our
 		// index key prefix.
-		start := roachpb.Key(MakeIndexKeyPrefix(n.desc.ID, n.index.ID))
+		start := roachpb.Key(indexKeyPrefix(n.desc.ID, n.index))
 		n.spans = append(n.spans, span{
 			start: start,
 			end:   start.PrefixEnd(),
@@ -333,7 +400,13 @@ func (n *scanNode) initScan() bool {
 
 	// Retrieve all the spans.
 	b := &client.Batch{}
-	if n.reverse {
+	if filter := n.pushDownFilter(); filter != nil {
+		// The ranges only return the rows which pass the filter. The filter is
+		// still evaluated for every returned row by filterRow.
+		for i := 0; i < len(n.spans); i++ {
+			b.FilteredScan(n.spans[i].start, n.spans[i].end, 0, filter)
+		}
+	} else if n.reverse {
 		for i := len(n.spans) - 1; i >= 0; i-- {
 			b.ReverseScan(n.spans[i].start, n.spans[i].end, n.spans[i].count)
 		}
@@ -354,6 +427,69 @@ func (n *scanNode) initScan() bool {
 		}
 	}
 
+	if !n.initValTypes() {
+		return false
+	}
+	if n.lockRows {
+		return n.lockScannedRows()
+	}
+	return true
+}
+
+// lockScannedRows lays down intents on the key-value pairs of the first
+// lockLimit scanned rows which pass the filter, counting the rows locked by
+// the previous batches. Competing writers then wait for the transaction to
+// finish instead of forcing it to restart. The intents are conditional puts
+// of the scanned values: they fail if the row changed since it was scanned,
+// and commit the values unchanged. Empty values, such as the row sentinels of
+// tables using BaseFormatVersion, are written again with a plain put since a
+// conditional put expecting an empty value requires the key to be absent.
+func (n *scanNode) lockScannedRows() bool {
+	b := &client.Batch{}
+	numLocked := 0
+	rowStart := 0
+	for n.kvIndex < len(n.kvs) && n.lockedRows < n.lockLimit {
+		if !n.processKV(n.kvs[n.kvIndex]) {
+			return false
+		}
+		n.kvIndex++
+		if n.indexKey == nil {
+			// The key-value pair belongs to a row of an interleaved table.
+			rowStart = n.kvIndex
+			continue
+		}
+		if n.kvIndex < len(n.kvs) && bytes.HasPrefix(n.kvs[n.kvIndex].Key, n.indexKey) {
+			continue
+		}
+		// The key-value pairs in [rowStart, kvIndex) make up a row.
+		n.indexKey = nil
+		if n.filterRow() {
+			for _, kv := range n.kvs[rowStart:n.kvIndex] {
+				value := roachpb.Value{RawBytes: kv.Value.RawBytes, Tag: kv.Value.Tag}
+				if len(value.RawBytes) == 0 {
+					b.Put(kv.Key, value)
+				} else {
+					b.CPut(kv.Key, value, value)
+				}
+				numLocked++
+			}
+			n.lockedRows++
+		} else if n.err != nil {
+			return false
+		}
+		rowStart = n.kvIndex
+	}
+	// Rewind to return the rows.
+	n.kvIndex = 0
+	if numLocked > 0 {
+		n.err = n.txn.Run(b)
+	}
+	return n.err == nil
+}
+
+// initValTypes prepares the slices and maps used to decode the key-value
+// pairs of the scanned index.
+func (n *scanNode) initValTypes() bool {
 	if n.valTypes == nil {
 		// Prepare our index key vals slice.
 		if n.valTypes, n.err = makeKeyVals(n.desc, n.columnIDs); n.err != nil {
@@ -371,9 +507,9 @@ func (n *scanNode) initScan() bool {
 		}
 
 		// Prepare a map from column ID to column kind used for unmarshalling values.
-		n.colKind = make(colKindMap, len(n.desc.Columns))
+		n.colType = make(colTypeMap, len(n.desc.Columns))
 		for _, col := range n.desc.Columns {
-			n.colKind[col.ID] = col.Type.Kind
+			n.colType[col.ID] = col.Type
 		}
 	}
 	return true
@@ -399,7 +535,7 @@ func (n *scanNode) initWhere(where *parser.Where) error {
 		n.filter, n.err = n.planner.evalCtx.NormalizeExpr(n.filter)
 	}
 	if n.err == nil {
-		n.filter, n.err = n.planner.expandSubqueries(n.filter, 1)
+		n.filter, n.err = n.planner.expandSubqueries(n.filter, 1, n)
 	}
 	return n.err
 }
@@ -424,7 +560,7 @@ func (n *scanNode) initOrdering(exactPrefix int) {
 	}
 	n.exactPrefix = exactPrefix
 	n.columnIDs = n.index.fullColumnIDs()
-	n.ordering = n.computeOrdering(n.columnIDs)
+	n.ordering = n.computeOrdering(n.columnIDs, n.index.ColumnDirections)
 	if n.reverse {
 		for i := range n.ordering {
 			n.ordering[i] = -n.ordering[i]
@@ -441,8 +577,9 @@ func (n *scanNode) initOrdering(exactPrefix int) {
 //
 // If there is an index on (k, v) and we're asking for the ordering for those
 // columns, computeOrdering will return (0, 1). This indicates that column k is
-// not part of the output column set and column v is in ascending order.
-func (n *scanNode) computeOrdering(columnIDs []ColumnID) []int {
+// not part of the output column set and column v is in ascending order. The
+// entries for columns stored in descending order are negated.
+func (n *scanNode) computeOrdering(columnIDs []ColumnID, dirs []IndexDescriptor_Direction) []int {
 	// Loop over the column IDs and determine if they are used for any of the
 	// render targets.
 	ordering := make([]int, len(columnIDs))
@@ -450,6 +587,9 @@ func (n *scanNode) computeOrdering(columnIDs []ColumnID) []int {
 		for i, r := range n.render {
 			if qval, ok := r.(*qvalue); ok && qval.col.ID == colID {
 				ordering[j] = i + 1
+				if encodingDirection(dirs, j) == encoding.Descending {
+					ordering[j] = -ordering[j]
+				}
 				break
 			}
 		}
@@ -497,7 +637,7 @@ func (n *scanNode) addRender(target parser.SelectExpr) error {
 					n.render = append(n.render, n.getQVal(*col))
 				}
 			} else {
-				for _, col := range n.desc.Columns {
+				for _, col := range n.visibleCols {
 					n.columns = append(n.columns, col.Name)
 					n.render = append(n.render, n.getQVal(col))
 				}
@@ -517,7 +657,7 @@ func (n *scanNode) addRender(target parser.SelectExpr) error {
 	if normalized, n.err = n.planner.evalCtx.TypeCheckAndNormalizeExpr(resolved); n.err != nil {
 		return n.err
 	}
-	if normalized, n.err = n.planner.expandSubqueries(normalized, 1); n.err != nil {
+	if normalized, n.err = n.planner.expandSubqueries(normalized, 1, n); n.err != nil {
 		return n.err
 	}
 	n.render = append(n.render, normalized)
@@ -543,11 +683,19 @@ func (n *scanNode) processKV(kv client.KeyValue) bool {
 		}
 	}
 
-	var remaining []byte
-	remaining, n.err = decodeIndexKey(n.desc, *n.index, n.valTypes, n.vals, kv.Key)
-	if n.err != nil {
+	remaining, ok, err := decodeIndexKey(n.desc, *n.index, n.valTypes, n.vals, kv.Key)
+	if err != nil {
+		n.err = err
 		return false
 	}
+	if !ok {
+		// The key-value pair belongs to a row of a table interleaved with the
+		// scanned table.
+		if log.V(2) {
+			log.Infof("Scan %s (skipped interleaved row)", prettyKey(kv.Key, 0))
+		}
+		return true
+	}
 
 	if n.indexKey == nil {
 		n.indexKey = []byte(kv.Key[:len(kv.Key)-len(remaining)])
@@ -563,8 +711,19 @@ func (n *scanNode) processKV(kv client.KeyValue) bool {
 
 	var value parser.Datum
 	n.colID = 0
+	n.family = nil
 
-	if !n.isSecondaryIndex && len(remaining) > 0 {
+	if !n.isSecondaryIndex && len(remaining) > 0 && n.desc.FormatVersion == FamilyFormatVersion {
+		var v uint64
+		_, v, n.err = encoding.DecodeUvarint(remaining)
+		if n.err != nil {
+			return false
+		}
+		var ok bool
+		if value, ok = n.processFamilyValue(kv, FamilyID(v)); !ok {
+			return false
+		}
+	} else if !n.isSecondaryIndex && len(remaining) > 0 {
 		var v uint64
 		_, v, n.err = encoding.DecodeUvarint(remaining)
 		if n.err != nil {
@@ -590,7 +749,7 @@ func (n *scanNode) processKV(kv client.KeyValue) bool {
 		}
 	} else {
 		if n.implicitVals != nil {
-			if _, n.err = decodeKeyVals(n.implicitValTypes, n.implicitVals, kv.ValueBytes()); n.err != nil {
+			if _, n.err = decodeKeyVals(n.implicitValTypes, n.implicitVals, nil, kv.ValueBytes()); n.err != nil {
 				return false
 			}
 			for i, id := range n.index.ImplicitColumnIDs {
@@ -626,6 +785,92 @@ func (n *scanNode) processKV(kv client.KeyValue) bool {
 	return true
 }
 
+// processFamilyValue decodes the columns stored in the value of a column
+// family key. The columns which are not needed by the render or filter
+// expressions are skipped. When explaining the key-value pairs, the values of
+// all of the columns in the family are returned as a tuple.
+func (n *scanNode) processFamilyValue(kv client.KeyValue, familyID FamilyID) (parser.Datum, bool) {
+	n.family, n.err = n.desc.FindFamilyByID(familyID)
+	if n.err != nil {
+		return nil, false
+	}
+
+	var explainVals map[ColumnID]parser.Datum
+	if n.explain == explainDebug {
+		explainVals = map[ColumnID]parser.Datum{}
+	}
+	n.err = decodeFamilyValue(kv.ValueBytes(), func(colID ColumnID, encoded []byte) error {
+		qval, needed := n.qvals[colID]
+		needed = needed && qval.datum == nil
+		if !needed && explainVals == nil {
+			// The column isn't needed by any of the render or filter expressions.
+			return nil
+		}
+		typ, ok := n.colType[colID]
+		if !ok {
+			// The column is not part of the table descriptor used by this scan,
+			// which happens when the value was written by a newer version of
+			// the descriptor.
+			return nil
+		}
+		d, err := decodeFamilyColumn(typ, encoded)
+		if err != nil {
+			return err
+		}
+		if needed {
+			qval.datum = d
+		}
+		if explainVals != nil {
+			explainVals[colID] = d
+		}
+		return nil
+	})
+	if n.err != nil {
+		return nil, false
+	}
+	if log.V(2) {
+		log.Infof("Scan %s -> family %q", prettyKey(kv.Key, 0), n.family.Name)
+	}
+
+	if explainVals == nil {
+		return nil, true
+	}
+	if len(n.family.ColumnIDs) == 0 {
+		return parser.DNull, true
+	}
+	value := make(parser.DTuple, len(n.family.ColumnIDs))
+	for i, id := range n.family.ColumnIDs {
+		value[i] = parser.DNull
+		if d, ok := explainVals[id]; ok {
+			value[i] = d
+		}
+	}
+	return value, true
+}
+
+// nextVirtual advances to the next row of a virtual table that passes the
+// filter.
+func (n *scanNode) nextVirtual() bool {
+	for len(n.virtualRows) > 0 {
+		row := n.virtualRows[0]
+		n.virtualRows = n.virtualRows[1:]
+		for i, col := range n.desc.Columns {
+			if qval, ok := n.qvals[col.ID]; ok {
+				qval.datum = row[i]
+			}
+		}
+		output := n.filterRow()
+		if n.err != nil {
+			return false
+		}
+		if output {
+			n.renderRow()
+			return n.err == nil
+		}
+	}
+	return false
+}
+
 // maybeOutputRow checks to see if the current key belongs to a new row and if
 // it does it outputs the last row. The return value indicates whether a row
 // was output or an error occurred. In either case, iteration should terminate.
@@ -709,6 +954,9 @@ func (n *scanNode) explainDebug(endOfRow, outputRow bool) {
 	n.row[1] = parser.DString(n.prettyKey())
 	if n.implicitVals != nil {
 		n.row[2] = parser.DString(prettyKeyVals(n.implicitVals))
+	} else if vals, ok := n.explainValue.(parser.DTuple); ok {
+		// The values of the columns in a column family.
+		n.row[2] = parser.DString(prettyKeyVals(vals))
 	} else {
 		// This conversion to DString is odd. `n.explainValue` is already a
 		// `Datum`, but logic_test currently expects EXPLAIN DEBUG output
@@ -740,6 +988,8 @@ func (n *scanNode) prettyKey() string {
 			panic(err)
 		}
 		fmt.Fprintf(&buf, "/%s", col.Name)
+	} else if n.family != nil && n.family.ID != 0 {
+		fmt.Fprintf(&buf, "/%s", n.family.Name)
 	}
 	return buf.String()
 }
@@ -753,13 +1003,13 @@ func prettyKeyVals(vals []parser.Datum) string {
 }
 
 func (n *scanNode) unmarshalValue(kv client.KeyValue) (parser.Datum, bool) {
-	kind, ok := n.colKind[n.colID]
+	typ, ok := n.colType[n.colID]
 	if !ok {
 		n.err = fmt.Errorf("column-id \"%d\" does not exist", n.colID)
 		return nil, false
 	}
 	var d parser.Datum
-	d, n.err = unmarshalColumnValue(kind, kv.Value)
+	d, n.err = unmarshalColumnValue(typ, kv.Value)
 	return d, n.err == nil
 }
 
@@ -793,6 +1043,15 @@ func (n *scanNode) getQVal(col ColumnDescriptor) *qvalue {
 			qval.datum = parser.DummyTimestamp
 		case ColumnType_INTERVAL:
 			qval.datum = parser.DummyInterval
+		case ColumnType_JSON:
+			qval.datum = parser.DummyJSON
+		case ColumnType_UUID:
+			qval.datum = parser.DummyUUID
+		case ColumnType_ARRAY:
+			var err error
+			if qval.datum, err = makeValType(col.Type); err != nil {
+				panic(err)
+			}
 		default:
 			panic(fmt.Sprintf("unsupported column type: %s", col.Type.Kind))
 		}
@@ -836,6 +1095,7 @@ func (v *qnameVisitor) Visit(expr parser.Expr, pre bool) (parser.Visitor, parser
 			return nil, expr
 		}
 
+		base := qname.Base
 		desc := v.getDesc(qname)
 		if desc != nil {
 			name := qname.Column()
@@ -843,9 +1103,16 @@ func (v *qnameVisitor) Visit(expr parser.Expr, pre bool) (parser.Visitor, parser
 				if !equalName(name, col.Name) {
 					continue
 				}
+				if subscript := qname.ArraySubscript(); subscript != nil {
+					return v, &parser.IndirectionExpr{Expr: v.getQVal(col), Indirection: subscript}
+				}
 				return v, v.getQVal(col)
 			}
 		}
+		// The name may refer to a column of a query enclosing a subquery.
+		if outer := v.planner.resolveOuterQName(qname, base); outer != nil {
+			return v, outer
+		}
 
 		v.err = fmt.Errorf("qualified name \"%s\" not found", qname)
 		return nil, expr
@@ -891,7 +1158,7 @@ func (v *qnameVisitor) Visit(expr parser.Expr, pre bool) (parser.Visitor, parser
 		t.Exprs[0] = v.getQVal(*col)
 		return v, expr
 
-	case *parser.Subquery:
+	case *parser.Subquery, *parser.ExistsExpr:
 		// Do not recurse into subqueries.
 		return nil, expr
 	}
@@ -0,0 +1,423 @@
+// Copyright 2015 The Cockroach Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+// implied. See the License for the specific language governing
+// permissions and limitations under the License. See the AUTHORS file
+// for names of contributors.
+
+package sql
+
+import (
+	"bytes"
+	"fmt"
+	"sync"
+
+	"github.com/cockroachdb/cockroach/client"
+	"github.com/cockroachdb/cockroach/roachpb"
+	"github.com/cockroachdb/cockroach/sql/driver"
+	"github.com/cockroachdb/cockroach/sql/parser"
+	"github.com/cockroachdb/cockroach/util/cache"
+	"github.com/cockroachdb/cockroach/util/log"
+	"github.com/gogo/protobuf/proto"
+)
+
+// scanFilterCacheSize is the number of distinct filters for which
+// RunScanFilter keeps the parsed filter around.
+const scanFilterCacheSize = 64
+
+// scanFilters caches the scanNodes evaluating the filters of the FilteredScan
+// requests received by the stores of the node, so that a filter is parsed and
+// type checked once per query instead of once per range and batch.
+var scanFilters = newScanFilterCache(scanFilterCacheSize)
+
+// pushDownFilter returns the serialized ScanFilter to send with the scans of
+// the node so that the ranges only return the rows which pass the filter. It
+// returns nil if the filter cannot be evaluated by the ranges, in which case
+// every row in the spans is retrieved.
+func (n *scanNode) pushDownFilter() []byte {
+	if n.filter == nil || n.reverse || n.explain == explainDebug {
+		return nil
+	}
+	if n.index.isInterleaved() {
+		// The ranges would have to skip the rows of the interleaved tables.
+		return nil
+	}
+	for _, s := range n.spans {
+		if s.count != 0 {
+			// The count limits the number of key-value pairs retrieved, which
+			// can't be combined with filtering on the ranges.
+			return nil
+		}
+	}
+	v := scanFilterVisitor{ok: true, colIDs: map[ColumnID]struct{}{}}
+	_ = parser.WalkExpr(&v, n.filter)
+	if !v.ok || len(v.colIDs) == 0 {
+		return nil
+	}
+
+	sf := n.makeScanFilter(n.filter.String(), v.colIDs)
+	// Verify that the ranges will evaluate the same expression. This fails
+	// for expressions which don't survive the round trip through SQL syntax.
+	if f, err := makeScanFilterNode(&sf); err != nil || f.filter.String() != sf.Expr {
+		if log.V(2) {
+			log.Infof("not pushing down filter %s: %v", sf.Expr, err)
+		}
+		return nil
+	}
+	filter, err := proto.Marshal(&sf)
+	if err != nil {
+		return nil
+	}
+	return filter
+}
+
+// makeScanFilter returns the ScanFilter which evaluates expr for the rows of
+// the scanned index, decoding the given columns.
+func (n *scanNode) makeScanFilter(expr string, colIDs map[ColumnID]struct{}) ScanFilter {
+	sf := ScanFilter{
+		Table:   n.scanFilterTable(colIDs),
+		IndexID: n.index.ID,
+		Expr:    expr,
+		Session: Session{
+			Syntax:   n.planner.session.Syntax,
+			Timezone: n.planner.session.Timezone,
+		},
+		StmtTimestamp: driver.Timestamp(n.planner.evalCtx.StmtTimestamp.Time),
+		TxnTimestamp:  driver.Timestamp(n.planner.evalCtx.TxnTimestamp.Time),
+	}
+	for _, col := range n.visibleCols {
+		if _, ok := colIDs[col.ID]; ok {
+			sf.ColumnIDs = append(sf.ColumnIDs, col.ID)
+		}
+	}
+	return sf
+}
+
+// scanFilterTable returns the parts of the descriptor of the scanned table
+// the ranges need to decode the given columns from the rows of the scanned
+// index: the index, the definitions of the columns of the index and of the
+// given columns, and the IDs of the column families.
+func (n *scanNode) scanFilterTable(colIDs map[ColumnID]struct{}) TableDescriptor {
+	desc := TableDescriptor{
+		ID:            n.desc.ID,
+		Name:          n.desc.Name,
+		FormatVersion: n.desc.FormatVersion,
+	}
+	if n.isSecondaryIndex {
+		desc.Indexes = []IndexDescriptor{*n.index}
+	} else {
+		desc.PrimaryIndex = *n.index
+	}
+	for _, family := range n.desc.Families {
+		desc.Families = append(desc.Families, ColumnFamilyDescriptor{ID: family.ID, Name: family.Name})
+	}
+
+	needed := make(map[ColumnID]struct{}, len(colIDs))
+	for id := range colIDs {
+		needed[id] = struct{}{}
+	}
+	for _, id := range n.index.fullColumnIDs() {
+		needed[id] = struct{}{}
+	}
+	for _, id := range n.index.ImplicitColumnIDs {
+		needed[id] = struct{}{}
+	}
+	for _, col := range n.desc.Columns {
+		if _, ok := needed[col.ID]; ok {
+			desc.Columns = append(desc.Columns, col)
+		}
+	}
+	return desc
+}
+
+// scanFilterVisitor determines whether an expression can be evaluated by the
+// ranges and collects the columns it references.
+type scanFilterVisitor struct {
+	ok     bool
+	colIDs map[ColumnID]struct{}
+}
+
+var _ parser.Visitor = &scanFilterVisitor{}
+
+func (v *scanFilterVisitor) Visit(expr parser.Expr, pre bool) (parser.Visitor, parser.Expr) {
+	if !pre || !v.ok {
+		return nil, expr
+	}
+
+	switch t := expr.(type) {
+	case *qvalue:
+		v.colIDs[t.col.ID] = struct{}{}
+		return nil, expr
+
+	case parser.VariableExpr:
+		// Placeholders and other variables can't be evaluated by the ranges.
+		v.ok = false
+
+	case *parser.Subquery:
+		v.ok = false
+
+	case *parser.FuncExpr:
+		// Impure functions would return different values on the ranges and
+		// when the filter is evaluated again by the scanNode.
+		if t.IsImpure() {
+			v.ok = false
+		}
+
+	case parser.Datum:
+		v.ok = isPushableDatum(t)
+	}
+
+	if !v.ok {
+		return nil, expr
+	}
+	return v, expr
+}
+
+// isPushableDatum returns true if the SQL representation of the datum parses
+// back into an equal datum.
+func isPushableDatum(d parser.Datum) bool {
+	switch t := d.(type) {
+	case parser.DBool, parser.DInt, parser.DFloat, parser.DString, parser.DBytes:
+		return true
+	case parser.DTuple:
+		for _, e := range t {
+			if !isPushableDatum(e) {
+				return false
+			}
+		}
+		return true
+	}
+	return d == parser.DNull
+}
+
+// makeScanFilterNode returns a scanNode which decodes the rows of the index
+// described by the filter and evaluates the filter expression for them.
+func makeScanFilterNode(sf *ScanFilter) (*scanNode, error) {
+	if sf.ChangedSince != roachpb.ZeroTimestamp {
+		return &scanNode{changedSince: sf.ChangedSince}, nil
+	}
+	p := &planner{
+		session: Session{
+			Syntax:   sf.Session.Syntax,
+			Timezone: sf.Session.Timezone,
+		},
+	}
+	p.evalCtx = parser.EvalContext{
+		StmtTimestamp: parser.DTimestamp{Time: sf.StmtTimestamp.GoTime()},
+		TxnTimestamp:  parser.DTimestamp{Time: sf.TxnTimestamp.GoTime()},
+		GetLocation:   p.session.getLocation,
+	}
+	n := &scanNode{planner: p, desc: &sf.Table}
+	// The columns in the expression are not qualified.
+	n.desc.Alias = n.desc.Name
+	if sf.IndexID == n.desc.PrimaryIndex.ID {
+		n.index = &n.desc.PrimaryIndex
+	} else {
+		var err error
+		if n.index, err = n.desc.FindIndexByID(sf.IndexID); err != nil {
+			return nil, err
+		}
+		n.isSecondaryIndex = true
+	}
+	n.columnIDs = n.index.fullColumnIDs()
+	// Only the columns referenced by the filter are resolved and decoded.
+	for _, id := range sf.ColumnIDs {
+		col, err := n.desc.FindColumnByID(id)
+		if err != nil {
+			return nil, err
+		}
+		n.visibleCols = append(n.visibleCols, *col)
+	}
+
+	if sf.Expr == "" {
+		return n, nil
+	}
+	var err error
+	if n.filter, err = n.parseExpr(sf.Expr); err != nil {
+		return nil, err
+	}
+	return n, nil
+}
+
+// parseExpr parses an expression sent by the gateway, resolving the names
+// of the columns and type checking it.
+func (n *scanNode) parseExpr(s string) (parser.Expr, error) {
+	expr, err := parser.ParseExpr(s, parser.Syntax(n.planner.session.Syntax))
+	if err != nil {
+		return nil, err
+	}
+	if expr, err = n.resolveQNames(expr); err != nil {
+		return nil, err
+	}
+	if _, err := expr.TypeCheck(); err != nil {
+		return nil, err
+	}
+	return expr, nil
+}
+
+// RunScanFilter evaluates a serialized ScanFilter for the key-value pairs
+// read by a FilteredScan request and returns the key-value pairs of the rows
+// which pass the filter. It is the storage.ScanFilterFunc used by the stores.
+//
+// The first and the last row are always returned because they might be
+// incomplete: the key-value pairs of a row can be split across ranges and
+// across the batches in which the ranges read them. The gateway evaluates the
+// filter again once it has seen all of a row.
+func RunScanFilter(filter []byte, kvs []roachpb.KeyValue) ([]roachpb.KeyValue, error) {
+	n, err := scanFilters.acquire(filter)
+	if err != nil {
+		return nil, err
+	}
+	result, err := runScanFilterNode(n, kvs)
+	if err != nil {
+		// The node is left in an unknown state.
+		return nil, err
+	}
+	scanFilters.release(filter, n)
+	return result, nil
+}
+
+// runScanFilterNode evaluates the filter of the scanNode for the key-value
+// pairs.
+func runScanFilterNode(n *scanNode, kvs []roachpb.KeyValue) ([]roachpb.KeyValue, error) {
+	if n.changedSince != roachpb.ZeroTimestamp {
+		return filterChangedSince(n.changedSince, kvs), nil
+	}
+	n.kvs = make([]client.KeyValue, len(kvs))
+	for i := range kvs {
+		n.kvs[i] = client.KeyValue{Key: kvs[i].Key, Value: &kvs[i].Value}
+	}
+
+	var result []roachpb.KeyValue
+	rowStart := 0
+	for n.kvIndex < len(n.kvs) {
+		if !n.processKV(n.kvs[n.kvIndex]) {
+			return nil, n.err
+		}
+		n.kvIndex++
+		if n.kvIndex < len(n.kvs) && bytes.HasPrefix(n.kvs[n.kvIndex].Key, n.indexKey) {
+			continue
+		}
+		// The key-value pairs in [rowStart, kvIndex) make up a row.
+		n.indexKey = nil
+		output := rowStart == 0 || n.kvIndex == len(n.kvs)
+		if !output {
+			output = n.filterRow()
+			if n.err != nil {
+				return nil, n.err
+			}
+		}
+		if output {
+			result = append(result, kvs[rowStart:n.kvIndex]...)
+		}
+		rowStart = n.kvIndex
+	}
+	return result, nil
+}
+
+// filterChangedSince strips the values of the key-value pairs which were not
+// written after the given timestamp, keeping their keys and timestamps.
+func filterChangedSince(ts roachpb.Timestamp, kvs []roachpb.KeyValue) []roachpb.KeyValue {
+	for i := range kvs {
+		if t := kvs[i].Value.Timestamp; t == nil || !ts.Less(*t) {
+			kvs[i].Value.RawBytes = nil
+			kvs[i].Value.Checksum = nil
+		}
+	}
+	return kvs
+}
+
+// scanFilterCache caches the scanNodes evaluating serialized ScanFilters. A
+// scanNode evaluates the filter for one set of key-value pairs at a time, so
+// the cache holds the idle nodes of each filter. The cache is safe for
+// concurrent use by multiple goroutines.
+type scanFilterCache struct {
+	mu    sync.Mutex
+	cache *cache.UnorderedCache
+}
+
+// idleScanFilterNodes are the cached scanNodes of a filter which are not in
+// use.
+type idleScanFilterNodes struct {
+	nodes []*scanNode
+}
+
+// newScanFilterCache creates a new scanFilterCache holding the nodes of up
+// to the given number of filters.
+func newScanFilterCache(size int) *scanFilterCache {
+	return &scanFilterCache{
+		cache: cache.NewUnorderedCache(cache.Config{
+			Policy: cache.CacheLRU,
+			ShouldEvict: func(s int, key, value interface{}) bool {
+				return s > size
+			},
+		}),
+	}
+}
+
+// acquire returns a scanNode evaluating the serialized filter, taking an
+// idle one from the cache if there is one. The node must be returned with
+// release once it is no longer used.
+func (c *scanFilterCache) acquire(filter []byte) (*scanNode, error) {
+	if n := c.lookup(string(filter)); n != nil {
+		return n, nil
+	}
+	var sf ScanFilter
+	if err := proto.Unmarshal(filter, &sf); err != nil {
+		return nil, err
+	}
+	n, err := makeScanFilterNode(&sf)
+	if err != nil {
+		return nil, fmt.Errorf("invalid scan filter %q: %v", sf.Expr, err)
+	}
+	if n.changedSince != roachpb.ZeroTimestamp {
+		return n, nil
+	}
+	if !n.initValTypes() {
+		return nil, n.err
+	}
+	return n, nil
+}
+
+// lookup removes an idle scanNode of the filter from the cache and returns
+// it. It returns nil if there is none.
+func (c *scanFilterCache) lookup(key string) *scanNode {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	v, ok := c.cache.Get(key)
+	if !ok {
+		return nil
+	}
+	idle := v.(*idleScanFilterNodes)
+	l := len(idle.nodes)
+	if l == 0 {
+		return nil
+	}
+	n := idle.nodes[l-1]
+	idle.nodes = idle.nodes[:l-1]
+	return n
+}
+
+// release returns a scanNode obtained from acquire to the cache.
+func (c *scanFilterCache) release(filter []byte, n *scanNode) {
+	n.kvs = nil
+	n.kvIndex = 0
+	n.indexKey = nil
+	key := string(filter)
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if v, ok := c.cache.Get(key); ok {
+		idle := v.(*idleScanFilterNodes)
+		idle.nodes = append(idle.nodes, n)
+		return
+	}
+	c.cache.Add(key, &idleScanFilterNodes{nodes: []*scanNode{n}})
+}
@@ -0,0 +1,146 @@
+// Copyright 2015 The Cockroach Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+// implied. See the License for the specific language governing
+// permissions and limitations under the License. See the AUTHORS file
+// for names of contributors.
+
+package sql_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/cockroachdb/cockroach/client"
+	"github.com/cockroachdb/cockroach/keys"
+	"github.com/cockroachdb/cockroach/roachpb"
+	"github.com/cockroachdb/cockroach/sql"
+	"github.com/cockroachdb/cockroach/testutils"
+	"github.com/cockroachdb/cockroach/util/leaktest"
+	"github.com/gogo/protobuf/proto"
+)
+
+// TestFilteredScan verifies that the ranges evaluate the filters sent with
+// FilteredScan requests.
+func TestFilteredScan(t *testing.T) {
+	defer leaktest.AfterTest(t)
+	s, sqlDB, kvDB := setup(t)
+	defer cleanup(s, sqlDB)
+
+	if _, err := sqlDB.Exec(`
+CREATE DATABASE t;
+CREATE TABLE t.kv (k INT PRIMARY KEY, v INT, w STRING);
+`); err != nil {
+		t.Fatal(err)
+	}
+	for i := 1; i <= 10; i++ {
+		if _, err := sqlDB.Exec(`INSERT INTO t.kv VALUES ($1, $1, $2)`, i, fmt.Sprint(i)); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	gr, err := kvDB.Get(sql.MakeNameMetadataKey(keys.MaxReservedDescID+1, "kv"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	desc := &sql.Descriptor{}
+	if err := kvDB.GetProto(sql.MakeDescMetadataKey(sql.ID(gr.ValueInt())), desc); err != nil {
+		t.Fatal(err)
+	}
+	tableDesc := desc.GetTable()
+	start := roachpb.Key(sql.MakeIndexKeyPrefix(tableDesc.ID, tableDesc.PrimaryIndex.ID))
+
+	filteredScan := func(expr string, maxRows int64, colIDs ...sql.ColumnID) ([]client.KeyValue, error) {
+		filter, err := proto.Marshal(&sql.ScanFilter{
+			Table:     *tableDesc,
+			IndexID:   tableDesc.PrimaryIndex.ID,
+			Expr:      expr,
+			ColumnIDs: colIDs,
+		})
+		if err != nil {
+			t.Fatal(err)
+		}
+		b := &client.Batch{}
+		b.FilteredScan(start, start.PrefixEnd(), maxRows, filter)
+		if err := s.DB().Run(b); err != nil {
+			return nil, err
+		}
+		return b.Results[0].Rows, nil
+	}
+
+	// Each row is stored in a single key-value pair. The first and the last
+	// row are always returned.
+	kvs, err := filteredScan(`v % 3 = 0`, 0, tableDesc.Columns[1].ID)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if l := 5; len(kvs) != l {
+		t.Fatalf("expected %d key value pairs, but got %d", l, len(kvs))
+	}
+
+	// The number of returned key-value pairs is limited after filtering.
+	kvs, err = filteredScan(`v % 3 = 0`, 3, tableDesc.Columns[1].ID)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if l := 3; len(kvs) != l {
+		t.Fatalf("expected %d key value pairs, but got %d", l, len(kvs))
+	}
+
+	if _, err := filteredScan(`w = '5'`, 0, tableDesc.Columns[1].ID); !testutils.IsError(err, `qualified name "kv.w" not found`) {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	// A filter with a timestamp only returns the values written after it, and
+	// the keys of the other key-value pairs. The rows were inserted in order.
+	all, err := kvDB.Scan(start, start.PrefixEnd(), 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	filter, err := proto.Marshal(&sql.ScanFilter{ChangedSince: *all[4].Value.Timestamp})
+	if err != nil {
+		t.Fatal(err)
+	}
+	b := &client.Batch{}
+	b.FilteredScan(start, start.PrefixEnd(), 0, filter)
+	if err := s.DB().Run(b); err != nil {
+		t.Fatal(err)
+	}
+	kvs = b.Results[0].Rows
+	if l := 10; len(kvs) != l {
+		t.Fatalf("expected %d key value pairs, but got %d", l, len(kvs))
+	}
+	for i, kv := range kvs {
+		if changed := kv.Value.RawBytes != nil; changed != (i >= 5) {
+			t.Errorf("%d: expected the value to be returned only for the rows after 5, but found %v", i+1, kv.Value)
+		}
+	}
+
+	// The filter is pushed down by SELECT and evaluated again on the gateway.
+	rows, err := sqlDB.Query(`SELECT k FROM t.kv WHERE v % 3 = 0 AND w != '6'`)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var results []int
+	for rows.Next() {
+		var k int
+		if err := rows.Scan(&k); err != nil {
+			t.Fatal(err)
+		}
+		results = append(results, k)
+	}
+	if err := rows.Err(); err != nil {
+		t.Fatal(err)
+	}
+	if s, expected := fmt.Sprint(results), "[3 9]"; s != expected {
+		t.Fatalf("expected %s, but found %s", expected, s)
+	}
+}
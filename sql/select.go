@@ -20,11 +20,13 @@ package sql
 import (
 	"bytes"
 	"fmt"
+	"math"
 	"reflect"
 	"sort"
 
 	"github.com/cockroachdb/cockroach/roachpb"
 	"github.com/cockroachdb/cockroach/sql/parser"
+	"github.com/cockroachdb/cockroach/sql/privilege"
 	"github.com/cockroachdb/cockroach/util"
 	"github.com/cockroachdb/cockroach/util/encoding"
 	"github.com/cockroachdb/cockroach/util/log"
@@ -36,10 +38,16 @@ import (
 // arbitrary columns. Full table scans can be avoided when indexes can be used
 // to satisfy the where-clause.
 //
-// Privileges: SELECT on table
+// Privileges: SELECT on table, and UPDATE on table for SELECT FOR UPDATE
 //   Notes: postgres requires SELECT. Also requires UPDATE on "FOR UPDATE".
 //          mysql requires SELECT.
 func (p *planner) Select(n *parser.Select) (planNode, error) {
+	if n.With != nil {
+		defer p.popWith()
+		if err := p.pushWith(n.With); err != nil {
+			return nil, err
+		}
+	}
 	scan := &scanNode{planner: p, txn: p.txn}
 	if err := scan.initFrom(p, n.From); err != nil {
 		return nil, err
@@ -54,6 +62,11 @@ func (p *planner) Select(n *parser.Select) (planNode, error) {
 	if err != nil {
 		return nil, err
 	}
+	if n.ForUpdate {
+		if err := p.initLockRows(scan, group, n); err != nil {
+			return nil, err
+		}
+	}
 	if group != nil && n.OrderBy != nil {
 		// TODO(pmattis): orderBy currently uses deep knowledge of the
 		// scanNode. Need to lift that out or make orderBy compatible with
@@ -69,14 +82,56 @@ func (p *planner) Select(n *parser.Select) (planNode, error) {
 	if err != nil {
 		return nil, err
 	}
+	if group != nil && plan == planNode(scan) {
+		flows, err := p.planDistributedAggregation(group, scan)
+		if err != nil {
+			return nil, err
+		}
+		if flows != nil {
+			plan = flows
+		}
+	}
 
 	limit, err := p.limit(n, p.distinct(n, sort.wrap(group.wrap(plan))))
 	if err != nil {
 		return nil, err
 	}
+	if l, ok := limit.(*limitNode); ok && scan.lockRows && l.planNode == plan {
+		// The rows are returned in the order they are scanned: only the rows
+		// returned by the limit are locked.
+		scan.lockLimit = l.count
+		if l.offset < math.MaxInt64-l.count {
+			scan.lockLimit += l.offset
+		} else {
+			scan.lockLimit = math.MaxInt64
+		}
+	}
 	return limit, nil
 }
 
+// initLockRows sets up the scanNode of a SELECT FOR UPDATE to lock the rows
+// it returns.
+func (p *planner) initLockRows(scan *scanNode, group *groupNode, n *parser.Select) error {
+	if group != nil {
+		return util.Errorf("FOR UPDATE is not allowed with aggregate functions")
+	}
+	if n.Distinct {
+		return util.Errorf("FOR UPDATE is not allowed with DISTINCT clause")
+	}
+	if scan.desc == nil {
+		return nil
+	}
+	if isVirtualDescriptor(scan.desc) {
+		return util.Errorf("FOR UPDATE is not allowed with virtual tables")
+	}
+	if err := p.checkPrivilege(scan.desc, privilege.UPDATE); err != nil {
+		return err
+	}
+	scan.lockRows = true
+	scan.lockLimit = math.MaxInt64
+	return nil
+}
+
 // selectIndex analyzes the scanNode to determine if there is an index
 // available that can fulfill the query with a more restrictive scan.
 //
@@ -94,12 +149,22 @@ func (p *planner) selectIndex(s *scanNode, group *groupNode, sort *sortNode) (pl
 		ordering, _ = sort.Ordering()
 	}
 
-	if s.desc == nil || (s.filter == nil && ordering == nil) {
+	// An explicitly requested partial index needs the where-clause to imply
+	// its predicate.
+	partial := s.isSecondaryIndex && s.index.Predicate != nil
+	if s.desc == nil || (s.filter == nil && ordering == nil && !partial) {
 		// No table or no where-clause and no ordering.
 		s.initOrdering(0)
 		return s, nil
 	}
 
+	if isVirtualDescriptor(s.desc) {
+		// Virtual tables cannot be constrained by an index; their rows are
+		// always produced in primary key order.
+		s.initOrdering(0)
+		return s, nil
+	}
+
 	candidates := make([]*indexInfo, 0, len(s.desc.Indexes)+1)
 	if s.isSecondaryIndex {
 		// An explicit secondary index was requested. Only add it to the candidate
@@ -125,6 +190,7 @@ func (p *planner) selectIndex(s *scanNode, group *groupNode, sort *sortNode) (pl
 		c.init(s)
 	}
 
+	var exprs []parser.Exprs
 	if s.filter != nil {
 		if group != nil {
 			// Allow the group-by to add an implicit "IS NOT NULL" filter.
@@ -133,7 +199,8 @@ func (p *planner) selectIndex(s *scanNode, group *groupNode, sort *sortNode) (pl
 
 		// Analyze the filter expression, simplifying it and splitting it up into
 		// possibly overlapping ranges.
-		exprs, equivalent := analyzeExpr(s.filter)
+		var equivalent bool
+		exprs, equivalent = analyzeExpr(s.filter)
 		if log.V(2) {
 			log.Infof("analyzeExpr: %s -> %s [equivalent=%v]", s.filter, exprs, equivalent)
 		}
@@ -171,7 +238,16 @@ func (p *planner) selectIndex(s *scanNode, group *groupNode, sort *sortNode) (pl
 		//
 		// Each disjunctive expression might generate multiple ranges of an index
 		// to scan. An examples of this is "a IN (1, 2, 3)".
+	}
 
+	// Partial indexes can only be used if the where-clause implies their
+	// predicates.
+	var err error
+	if candidates, err = p.removeUnusablePartialIndexes(s, candidates, exprs); err != nil {
+		return nil, err
+	}
+
+	if s.filter != nil {
 		for _, c := range candidates {
 			c.analyzeExprs(exprs)
 		}
@@ -197,7 +273,7 @@ func (p *planner) selectIndex(s *scanNode, group *groupNode, sort *sortNode) (pl
 	c := candidates[0]
 	s.index = c.index
 	s.isSecondaryIndex = (c.index != &s.desc.PrimaryIndex)
-	s.spans = makeSpans(c.constraints, c.desc.ID, c.index.ID)
+	s.spans = makeSpans(c.constraints, c.desc.ID, c.index)
 	if len(s.spans) == 0 {
 		// There are no spans to scan.
 		s.desc = nil
@@ -208,18 +284,18 @@ func (p *planner) selectIndex(s *scanNode, group *groupNode, sort *sortNode) (pl
 	s.reverse = c.reverse
 
 	var plan planNode
-	if c.covering {
+	if c.covering && !(s.lockRows && s.isSecondaryIndex) {
 		s.initOrdering(c.exactPrefix)
 		plan = s
 	} else {
-		var err error
 		plan, err = makeIndexJoin(s, c.exactPrefix)
 		if err != nil {
 			return nil, err
 		}
 	}
 
-	if group != nil && len(group.desiredOrdering) == 1 && len(s.spans) == 1 && s.filter == nil {
+	if group != nil && len(group.desiredOrdering) == 1 && len(s.spans) == 1 && s.filter == nil &&
+		!s.index.isInterleaved() {
 		// If grouping has a desired order and there is a single span for which the
 		// filter is true, check to see if the ordering matches the desired
 		// ordering. If it does we can limit the scan to a single key.
@@ -233,7 +309,7 @@ func (p *planner) selectIndex(s *scanNode, group *groupNode, sort *sortNode) (pl
 	if log.V(3) {
 		log.Infof("%s: filter=%v", c.index.Name, s.filter)
 		for i, span := range s.spans {
-			log.Infof("%s/%d: %s", c.index.Name, i, prettySpan(span, 2))
+			log.Infof("%s/%d: %s", c.index.Name, i, prettySpan(span, c.index.ColumnDirections, 2))
 		}
 	}
 
@@ -282,6 +358,23 @@ func (c indexConstraints) String() string {
 	return buf.String()
 }
 
+// truncate returns the prefix of the constraints which only constrain the
+// first n columns of the index. Tuple constraints cover several columns.
+func (c indexConstraints) truncate(n int) indexConstraints {
+	colIdx := 0
+	for i := range c {
+		if c[i].tupleMap != nil {
+			colIdx += len(c[i].tupleMap)
+		} else {
+			colIdx++
+		}
+		if colIdx > n {
+			return c[:i]
+		}
+	}
+	return c
+}
+
 type indexInfo struct {
 	desc        *TableDescriptor
 	index       *IndexDescriptor
@@ -298,7 +391,8 @@ func (v *indexInfo) init(s *scanNode) {
 	// The base cost is the number of keys per row.
 	if v.index == &v.desc.PrimaryIndex {
 		// The primary index contains 1 key per column plus the sentinel key per
-		// row.
+		// row. Tables using column families store fewer keys, but the cost of
+		// reading the column values is similar, so the same estimate is used.
 		v.cost = float64(1 + len(v.desc.Columns) - len(v.desc.PrimaryIndex.ColumnIDs))
 	} else {
 		v.cost = 1
@@ -315,6 +409,12 @@ func (v *indexInfo) init(s *scanNode) {
 // index.
 func (v *indexInfo) analyzeExprs(exprs []parser.Exprs) {
 	v.makeConstraints(exprs)
+	if len(v.index.Interleave) > 0 {
+		// The rows of an interleaved index are stored under the keys of its
+		// outermost ancestor. Only the constraints on the columns shared with
+		// it are used to restrict the spans of the index.
+		v.constraints = v.constraints.truncate(int(v.index.Interleave[0].SharedPrefixLen))
+	}
 
 	// Count the number of elements used to limit the start and end keys. We then
 	// boost the cost by what fraction of the index keys are being used. The
@@ -337,7 +437,7 @@ func (v *indexInfo) analyzeOrdering(scan *scanNode, ordering []int) {
 	v.exactPrefix = exactPrefix(v.constraints)
 
 	// Compute the ordering provided by the index.
-	indexOrdering := scan.computeOrdering(v.index.fullColumnIDs())
+	indexOrdering := scan.computeOrdering(v.index.fullColumnIDs(), v.index.ColumnDirections)
 
 	// Compute how much of the index ordering matches the requested ordering for
 	// both forward and reverse scans.
@@ -380,7 +480,9 @@ func (v *indexInfo) analyzeOrdering(scan *scanNode, ordering []int) {
 //
 // Start constraints look for comparison expressions with the operators >, >=,
 // = or IN. End constraints look for comparison expressions with the operators
-// <, <=, = or IN.
+// <, <=, = or IN. The keys of a descending column sort in the reverse order of
+// its values, so for such a column the operators < and <= constrain the start
+// of the spans and the operators > and >= their end.
 func (v *indexInfo) makeConstraints(exprs []parser.Exprs) {
 	if len(exprs) != 1 {
 		return
@@ -434,6 +536,7 @@ func (v *indexInfo) makeConstraints(exprs []parser.Exprs) {
 					// We can only handle tuples in IN expressions.
 					continue
 				}
+				desc := encodingDirection(v.index.ColumnDirections, i) == encoding.Descending
 
 				switch c.Operator {
 				case parser.EQ:
@@ -447,7 +550,12 @@ func (v *indexInfo) makeConstraints(exprs []parser.Exprs) {
 					// Note that makeSpans treats "a != x" the same as "a IS NOT
 					// NULL". We don't simplify "a != x" to "a IS NOT NULL" in
 					// simplifyExpr because doing so affects other simplifications.
-					if !startDone {
+					// NULL sorts after the values of a descending column.
+					if desc {
+						if !endDone {
+							constraint.end = c
+						}
+					} else if !startDone {
 						constraint.start = c
 					}
 				case parser.In:
@@ -475,19 +583,41 @@ func (v *indexInfo) makeConstraints(exprs []parser.Exprs) {
 						constraint.tupleMap = tupleMap
 					}
 				case parser.GT, parser.GE:
-					if !startDone && constraint.start == nil {
+					if desc {
+						if !endDone && constraint.end == nil {
+							constraint.end = c
+						}
+					} else if !startDone && constraint.start == nil {
 						constraint.start = c
 					}
 				case parser.LT, parser.LE:
-					if !endDone && constraint.end == nil {
+					if desc {
+						if !startDone && constraint.start == nil {
+							constraint.start = c
+						}
+					} else if !endDone && constraint.end == nil {
 						constraint.end = c
 					}
 				case parser.Is:
-					if c.Right == parser.DNull && !endDone {
+					if c.Right != parser.DNull {
+						break
+					}
+					if desc {
+						if !startDone {
+							constraint.start = c
+						}
+					} else if !endDone {
 						constraint.end = c
 					}
 				case parser.IsNot:
-					if c.Right == parser.DNull && !startDone {
+					if c.Right != parser.DNull {
+						break
+					}
+					if desc {
+						if !endDone {
+							constraint.end = c
+						}
+					} else if !startDone {
 						constraint.start = c
 					}
 				}
@@ -511,7 +641,14 @@ func (v *indexInfo) makeConstraints(exprs []parser.Exprs) {
 				Right:    constraint.start.Right.(parser.Datum).Next(),
 			}
 		}
-		if constraint.end != nil && constraint.end.Operator == parser.LT {
+		if constraint.start != nil && constraint.start.Operator == parser.LT {
+			// A < constraint on a descending column is an exclusive start key,
+			// which makeSpans computes by taking the prefix end of the encoded
+			// value. Further columns cannot be appended to such a key.
+			startDone = true
+		}
+		if constraint.end != nil &&
+			(constraint.end.Operator == parser.LT || constraint.end.Operator == parser.GT) {
 			endDone = true
 		}
 
@@ -568,15 +705,25 @@ func (v indexInfoByCost) Sort() {
 }
 
 // makeSpans constructs the spans for an index given a set of constraints.
-func makeSpans(constraints indexConstraints, tableID ID, indexID IndexID) []span {
-	prefix := roachpb.Key(MakeIndexKeyPrefix(tableID, indexID))
+func makeSpans(constraints indexConstraints, tableID ID, index *IndexDescriptor) []span {
+	prefix := roachpb.Key(indexKeyPrefix(tableID, index))
 	spans := []span{{
 		start: append(roachpb.Key(nil), prefix...),
 		end:   append(roachpb.Key(nil), prefix...),
 	}}
 	var buf [100]byte
 
+	// colIdx is the index of the first column covered by the current
+	// constraint. Tuple constraints cover several columns.
+	colIdx := 0
 	for i, c := range constraints {
+		dir := encodingDirection(index.ColumnDirections, colIdx)
+		if c.tupleMap != nil {
+			colIdx += len(c.tupleMap)
+		} else {
+			colIdx++
+		}
+
 		// Is this the last end constraint? We perform special processing on the
 		// last end constraint to account for the exclusive nature of the scan end
 		// key.
@@ -606,13 +753,18 @@ func makeSpans(constraints indexConstraints, tableID ID, indexID IndexID) []span
 			spans = make([]span, 0, len(existingSpans)*len(tuple))
 			for _, datum := range tuple {
 				var start, end []byte
+				// The successor of a value in a descending column is not the
+				// encoding of datum.Next(). Such end keys are instead computed by
+				// taking the prefix end of the encoded value.
+				prefixEnd := false
 
 				switch t := datum.(type) {
 				case parser.DTuple:
 					start = buf[:0]
-					for _, i := range c.tupleMap {
+					for j, k := range c.tupleMap {
 						var err error
-						if start, err = encodeTableKey(start, t[i]); err != nil {
+						tupleDir := encodingDirection(index.ColumnDirections, colIdx-len(c.tupleMap)+j)
+						if start, err = encodeTableKey(start, t[k], tupleDir); err != nil {
 							panic(err)
 						}
 					}
@@ -620,13 +772,18 @@ func makeSpans(constraints indexConstraints, tableID ID, indexID IndexID) []span
 					end = start
 					if lastEnd {
 						end = nil
-						for i := range c.tupleMap {
-							d := t[c.tupleMap[i]]
-							if i+1 == len(c.tupleMap) {
-								d = d.Next()
+						for j := range c.tupleMap {
+							d := t[c.tupleMap[j]]
+							tupleDir := encodingDirection(index.ColumnDirections, colIdx-len(c.tupleMap)+j)
+							if j+1 == len(c.tupleMap) {
+								if tupleDir == encoding.Descending {
+									prefixEnd = true
+								} else {
+									d = d.Next()
+								}
 							}
 							var err error
-							if end, err = encodeTableKey(end, d); err != nil {
+							if end, err = encodeTableKey(end, d, tupleDir); err != nil {
 								panic(err)
 							}
 						}
@@ -634,15 +791,19 @@ func makeSpans(constraints indexConstraints, tableID ID, indexID IndexID) []span
 
 				default:
 					var err error
-					if start, err = encodeTableKey(buf[:0], datum); err != nil {
+					if start, err = encodeTableKey(buf[:0], datum, dir); err != nil {
 						panic(err)
 					}
 
 					end = start
 					if lastEnd {
-						var err error
-						if end, err = encodeTableKey(nil, datum.Next()); err != nil {
-							panic(err)
+						if dir == encoding.Descending {
+							prefixEnd = true
+						} else {
+							var err error
+							if end, err = encodeTableKey(nil, datum.Next(), dir); err != nil {
+								panic(err)
+							}
 						}
 					}
 				}
@@ -653,6 +814,9 @@ func makeSpans(constraints indexConstraints, tableID ID, indexID IndexID) []span
 					}
 					if c.end != nil {
 						s.end = append(append(roachpb.Key(nil), s.end...), end...)
+						if prefixEnd {
+							s.end = s.end.PrefixEnd()
+						}
 					}
 					spans = append(spans, s)
 				}
@@ -672,13 +836,17 @@ func makeSpans(constraints indexConstraints, tableID ID, indexID IndexID) []span
 				}
 			default:
 				if datum, ok := c.start.Right.(parser.Datum); ok {
-					key, err := encodeTableKey(buf[:0], datum)
+					key, err := encodeTableKey(buf[:0], datum, dir)
 					if err != nil {
 						panic(err)
 					}
-					// Append the constraint to all of the existing spans.
+					// Append the constraint to all of the existing spans. A < constraint
+					// on a descending column excludes the keys of its value.
 					for i := range spans {
 						spans[i].start = append(spans[i].start, key...)
+						if c.start.Operator == parser.LT {
+							spans[i].start = spans[i].start.PrefixEnd()
+						}
 					}
 				}
 			}
@@ -693,24 +861,41 @@ func makeSpans(constraints indexConstraints, tableID ID, indexID IndexID) []span
 				for i := range spans {
 					spans[i].end = encoding.EncodeNotNull(spans[i].end)
 				}
+			case parser.NE, parser.IsNot:
+				// NULL sorts after the values of a descending column, so a != or IS
+				// NOT NULL expression constrains the end of the range to stop before
+				// NULL.
+				for i := range spans {
+					spans[i].end = encoding.EncodeNullDecreasing(spans[i].end)
+				}
 			default:
 				if datum, ok := c.end.Right.(parser.Datum); ok {
-					if lastEnd && c.end.Operator != parser.LT {
-						datum = datum.Next()
+					prefixEnd := false
+					if lastEnd && c.end.Operator != parser.LT && c.end.Operator != parser.GT {
+						if dir == encoding.Descending {
+							prefixEnd = true
+						} else {
+							datum = datum.Next()
+						}
 					}
-					key, err := encodeTableKey(buf[:0], datum)
+					key, err := encodeTableKey(buf[:0], datum, dir)
 					if err != nil {
 						panic(err)
 					}
 					// Append the constraint to all of the existing spans.
 					for i := range spans {
 						spans[i].end = append(spans[i].end, key...)
+						if prefixEnd {
+							spans[i].end = spans[i].end.PrefixEnd()
+						}
 					}
 				}
 
-				if c.start == nil && (i == 0 || constraints[i-1].start != nil) {
+				if c.start == nil && (i == 0 || constraints[i-1].start != nil) &&
+					dir == encoding.Ascending {
 					// This is the first constraint for which we don't have a start
-					// constraint. Add a not-NULL endpoint.
+					// constraint. Add a not-NULL endpoint. The end of the range already
+					// excludes NULL on a descending column.
 					for i := range spans {
 						spans[i].start = encoding.EncodeNotNull(spans[i].start)
 					}
@@ -737,9 +922,26 @@ func makeSpans(constraints indexConstraints, tableID ID, indexID IndexID) []span
 	}
 	spans = spans[:n]
 
+	// IN constraints on descending columns produce spans in the reverse order
+	// of their keys.
+	sort.Sort(spansByStartKey(spans))
 	return spans
 }
 
+type spansByStartKey []span
+
+func (s spansByStartKey) Len() int {
+	return len(s)
+}
+
+func (s spansByStartKey) Less(i, j int) bool {
+	return bytes.Compare(s[i].start, s[j].start) < 0
+}
+
+func (s spansByStartKey) Swap(i, j int) {
+	s[i], s[j] = s[j], s[i]
+}
+
 // exactPrefix returns the count of the columns of the index for which an exact
 // prefix match was requested. For example, if an index was defined on the
 // columns (a, b, c) and the WHERE clause was "(a, b) = (1, 2)", exactPrefix()
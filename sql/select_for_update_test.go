@@ -0,0 +1,181 @@
+// Copyright 2015 The Cockroach Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+// implied. See the License for the specific language governing
+// permissions and limitations under the License. See the AUTHORS file
+// for names of contributors.
+
+package sql_test
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/cockroachdb/cockroach/client"
+	"github.com/cockroachdb/cockroach/keys"
+	"github.com/cockroachdb/cockroach/roachpb"
+	"github.com/cockroachdb/cockroach/sql"
+	"github.com/cockroachdb/cockroach/storage/engine"
+	"github.com/cockroachdb/cockroach/util/encoding"
+	"github.com/cockroachdb/cockroach/util/leaktest"
+)
+
+// TestSelectForUpdate verifies that SELECT FOR UPDATE lays down intents on
+// the primary index key-value pairs of the selected rows.
+func TestSelectForUpdate(t *testing.T) {
+	defer leaktest.AfterTest(t)
+	s, sqlDB, kvDB := setup(t)
+	defer cleanup(s, sqlDB)
+
+	if _, err := sqlDB.Exec(`
+CREATE DATABASE t;
+CREATE TABLE t.kv (k INT PRIMARY KEY, v INT, INDEX foo (v));
+INSERT INTO t.kv VALUES (1, 1), (2, 2), (3, 3);
+`); err != nil {
+		t.Fatal(err)
+	}
+
+	gr, err := kvDB.Get(sql.MakeNameMetadataKey(keys.MaxReservedDescID+1, "kv"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	desc := &sql.Descriptor{}
+	if err := kvDB.GetProto(sql.MakeDescMetadataKey(sql.ID(gr.ValueInt())), desc); err != nil {
+		t.Fatal(err)
+	}
+	tableDesc := desc.GetTable()
+	tableStart := roachpb.Key(keys.MakeTablePrefix(uint32(tableDesc.ID)))
+	rowPrefix := func(k int64) []byte {
+		prefix := sql.MakeIndexKeyPrefix(tableDesc.ID, tableDesc.PrimaryIndex.ID)
+		return encoding.EncodeVarint(prefix, k)
+	}
+	// checkIntents verifies that the table only has intents on the rows with
+	// the given primary keys.
+	checkIntents := func(expected ...int64) {
+		_, intents, err := engine.MVCCScan(s.Ctx.Engines[0], tableStart, tableStart.PrefixEnd(),
+			0, roachpb.MaxTimestamp, false, nil)
+		if err != nil {
+			t.Fatal(err)
+		}
+		found := map[int64]bool{}
+		for _, intent := range intents {
+			ok := false
+			for _, k := range expected {
+				if bytes.HasPrefix(intent.Key, rowPrefix(k)) {
+					found[k] = true
+					ok = true
+				}
+			}
+			if !ok {
+				t.Errorf("unexpected intent on %s", intent.Key)
+			}
+		}
+		if len(found) != len(expected) {
+			t.Errorf("expected intents on rows %v, but found %v", expected, found)
+		}
+	}
+
+	tx, err := sqlDB.Begin()
+	if err != nil {
+		t.Fatal(err)
+	}
+	// Only the rows returned by the limit are locked.
+	var k int
+	if err := tx.QueryRow(`SELECT k FROM t.kv WHERE k >= 2 LIMIT 1 FOR UPDATE`).Scan(&k); err != nil {
+		t.Fatal(err)
+	}
+	checkIntents(2)
+
+	if err := tx.QueryRow(`SELECT k FROM t.kv WHERE k = 2 FOR UPDATE`).Scan(&k); err != nil {
+		t.Fatal(err)
+	}
+	checkIntents(2)
+
+	// Selecting the rows through a secondary index locks the rows of the
+	// primary index.
+	if err := tx.QueryRow(`SELECT k FROM t.kv@foo WHERE v = 3 FOR UPDATE`).Scan(&k); err != nil {
+		t.Fatal(err)
+	}
+	checkIntents(2, 3)
+
+	if err := tx.Commit(); err != nil {
+		t.Fatal(err)
+	}
+	var sum int
+	if err := sqlDB.QueryRow(`SELECT SUM(v) FROM t.kv`).Scan(&sum); err != nil {
+		t.Fatal(err)
+	}
+	if sum != 6 {
+		t.Errorf("expected the values to be unchanged, but found a sum of %d", sum)
+	}
+}
+
+// TestSelectForUpdateBaseFormatVersion verifies that SELECT FOR UPDATE locks
+// the rows of tables created before column families, whose row sentinels
+// have empty values.
+func TestSelectForUpdateBaseFormatVersion(t *testing.T) {
+	defer leaktest.AfterTest(t)
+	s, sqlDB, kvDB := setup(t)
+	defer cleanup(s, sqlDB)
+
+	if _, err := sqlDB.Exec(`
+CREATE DATABASE t;
+CREATE TABLE t.kv (k INT PRIMARY KEY, v INT);
+`); err != nil {
+		t.Fatal(err)
+	}
+
+	gr, err := kvDB.Get(sql.MakeNameMetadataKey(keys.MaxReservedDescID+1, "kv"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	descKey := sql.MakeDescMetadataKey(sql.ID(gr.ValueInt()))
+	desc := &sql.Descriptor{}
+	if err := kvDB.GetProto(descKey, desc); err != nil {
+		t.Fatal(err)
+	}
+	// Rewrite the descriptor as it was written before column families.
+	tableDesc := desc.GetTable()
+	tableDesc.FormatVersion = sql.BaseFormatVersion
+	tableDesc.Families = nil
+	tableDesc.NextFamilyID = 0
+	if err := s.DB().Txn(func(txn *client.Txn) error {
+		txn.SetSystemDBTrigger()
+		return txn.Put(descKey, desc)
+	}); err != nil {
+		t.Fatal(err)
+	}
+
+	if _, err := sqlDB.Exec(`INSERT INTO t.kv VALUES (1, 1), (2, NULL)`); err != nil {
+		t.Fatal(err)
+	}
+	tx, err := sqlDB.Begin()
+	if err != nil {
+		t.Fatal(err)
+	}
+	rows, err := tx.Query(`SELECT k FROM t.kv FOR UPDATE`)
+	if err != nil {
+		t.Fatal(err)
+	}
+	count := 0
+	for rows.Next() {
+		count++
+	}
+	if err := rows.Err(); err != nil {
+		t.Fatal(err)
+	}
+	if count != 2 {
+		t.Fatalf("expected 2 rows, but found %d", count)
+	}
+	if err := tx.Commit(); err != nil {
+		t.Fatal(err)
+	}
+}
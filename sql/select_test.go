@@ -238,8 +238,51 @@ func TestMakeSpans(t *testing.T) {
 	for _, d := range testData {
 		desc, index := makeTestIndex(t, d.columns)
 		constraints, _ := makeConstraints(t, d.expr, desc, index)
-		spans := makeSpans(constraints, desc.ID, index.ID)
-		if s := prettySpans(spans, 2); d.expected != s {
+		spans := makeSpans(constraints, desc.ID, index)
+		if s := prettySpans(spans, index.ColumnDirections, 2); d.expected != s {
+			t.Errorf("%s: expected %s, but found %s", d.expr, d.expected, s)
+		}
+	}
+}
+
+func TestMakeSpansDescending(t *testing.T) {
+	defer leaktest.AfterTest(t)
+
+	const (
+		asc  = IndexDescriptor_ASC
+		desc = IndexDescriptor_DESC
+	)
+
+	testData := []struct {
+		expr       string
+		columns    []string
+		directions []IndexDescriptor_Direction
+		expected   string
+	}{
+		{`a = 1`, []string{"a"}, []IndexDescriptor_Direction{desc}, `/1-/0`},
+		{`a IN (1, 2)`, []string{"a"}, []IndexDescriptor_Direction{desc}, `/2-/1 /1-/0`},
+		{`a != 1`, []string{"a"}, []IndexDescriptor_Direction{desc}, `-/NULL`},
+		{`a > 1`, []string{"a"}, []IndexDescriptor_Direction{desc}, `-/1`},
+		{`a >= 1`, []string{"a"}, []IndexDescriptor_Direction{desc}, `-/0`},
+		{`a < 1`, []string{"a"}, []IndexDescriptor_Direction{desc}, `/0-`},
+		{`a <= 1`, []string{"a"}, []IndexDescriptor_Direction{desc}, `/1-`},
+		{`a IS NULL`, []string{"a"}, []IndexDescriptor_Direction{desc}, `/NULL-`},
+		{`a IS NOT NULL`, []string{"a"}, []IndexDescriptor_Direction{desc}, `-/NULL`},
+		{`a > 1 AND a < 5`, []string{"a"}, []IndexDescriptor_Direction{desc}, `/4-/1`},
+		{`a >= 1 AND a <= 5`, []string{"a"}, []IndexDescriptor_Direction{desc}, `/5-/0`},
+		{`a = 1 AND b > 1`, []string{"a", "b"}, []IndexDescriptor_Direction{asc, desc}, `/1-/1/1`},
+		{`a = 1 AND b <= 1`, []string{"a", "b"}, []IndexDescriptor_Direction{asc, desc}, `/1/1-/2`},
+		{`a = 1 AND b > 1`, []string{"a", "b"}, []IndexDescriptor_Direction{desc, asc}, `/1/2-/0`},
+		{`a = 1 AND b = 2`, []string{"a", "b"}, []IndexDescriptor_Direction{asc, desc}, `/1/2-/1/1`},
+		{`(a, b) IN ((1, 2), (1, 3))`, []string{"a", "b"}, []IndexDescriptor_Direction{asc, desc},
+			`/1/3-/1/2 /1/2-/1/1`},
+	}
+	for _, d := range testData {
+		tableDesc, index := makeTestIndex(t, d.columns)
+		index.ColumnDirections = d.directions
+		constraints, _ := makeConstraints(t, d.expr, tableDesc, index)
+		spans := makeSpans(constraints, tableDesc.ID, index)
+		if s := prettySpans(spans, index.ColumnDirections, 2); d.expected != s {
 			t.Errorf("%s: expected %s, but found %s", d.expr, d.expected, s)
 		}
 	}
@@ -31,6 +31,8 @@ type Session struct {
 	//	*Session_Location
 	//	*Session_Offset
 	Timezone isSession_Timezone `protobuf_oneof:"timezone"`
+	// Identifies the session, whose memory is accounted for across requests.
+	ID []byte `protobuf:"bytes,7,opt,name=id" json:"id,omitempty"`
 }
 
 func (m *Session) Reset()         { *m = Session{} }
@@ -126,6 +128,13 @@ type Session_Transaction struct {
 	// Timestamp to be used by SQL in the above transaction. Note: this is not the
 	// transaction timestamp in roachpb.Transaction above.
 	Timestamp cockroach_sql_driver.Datum_Timestamp `protobuf:"bytes,2,opt,name=timestamp" json:"timestamp"`
+	// Set by SAVEPOINT cockroach_restart. Retryable errors then leave the
+	// transaction open so that the client can restart it with ROLLBACK TO
+	// SAVEPOINT cockroach_restart.
+	RetryIntent bool `protobuf:"varint,3,opt,name=retry_intent" json:"retry_intent"`
+	// Set when the transaction encountered a retryable error and is waiting
+	// for ROLLBACK TO SAVEPOINT cockroach_restart.
+	RestartWait bool `protobuf:"varint,4,opt,name=restart_wait" json:"restart_wait"`
 }
 
 func (m *Session_Transaction) Reset()         { *m = Session_Transaction{} }
@@ -179,6 +188,12 @@ func (m *Session) MarshalTo(data []byte) (int, error) {
 		}
 		i += nn2
 	}
+	if m.ID != nil {
+		data[i] = 0x3a
+		i++
+		i = encodeVarintSession(data, i, uint64(len(m.ID)))
+		i += copy(data[i:], m.ID)
+	}
 	return i, nil
 }
 
@@ -228,6 +243,22 @@ func (m *Session_Transaction) MarshalTo(data []byte) (int, error) {
 		return 0, err
 	}
 	i += n4
+	data[i] = 0x18
+	i++
+	if m.RetryIntent {
+		data[i] = 1
+	} else {
+		data[i] = 0
+	}
+	i++
+	data[i] = 0x20
+	i++
+	if m.RestartWait {
+		data[i] = 1
+	} else {
+		data[i] = 0
+	}
+	i++
 	return i, nil
 }
 
@@ -272,6 +303,10 @@ func (m *Session) Size() (n int) {
 	if m.Timezone != nil {
 		n += m.Timezone.Size()
 	}
+	if m.ID != nil {
+		l = len(m.ID)
+		n += 1 + l + sovSession(uint64(l))
+	}
 	return n
 }
 
@@ -295,6 +330,8 @@ func (m *Session_Transaction) Size() (n int) {
 	n += 1 + l + sovSession(uint64(l))
 	l = m.Timestamp.Size()
 	n += 1 + l + sovSession(uint64(l))
+	n += 2
+	n += 2
 	return n
 }
 
@@ -490,6 +527,37 @@ func (m *Session) Unmarshal(data []byte) error {
 				}
 			}
 			m.Timezone = &Session_Offset{v}
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ID", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSession
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				byteLen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthSession
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ID = append(m.ID[:0], data[iNdEx:postIndex]...)
+			if m.ID == nil {
+				m.ID = []byte{}
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipSession(data[iNdEx:])
@@ -600,6 +668,46 @@ func (m *Session_Transaction) Unmarshal(data []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RetryIntent", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSession
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				v |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.RetryIntent = bool(v != 0)
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RestartWait", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSession
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				v |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.RestartWait = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipSession(data[iNdEx:])
@@ -79,6 +79,89 @@ func (p *planner) ShowColumns(n *parser.ShowColumns) (planNode, error) {
 	return v, nil
 }
 
+// ShowCreateTable returns a CREATE TABLE statement for the specified table.
+// Privileges: None.
+//   Notes: postgres does not have a SHOW CREATE TABLE statement.
+//          mysql requires some privilege for any column.
+func (p *planner) ShowCreateTable(n *parser.ShowCreateTable) (planNode, error) {
+	desc, err := p.getTableDesc(n.Table)
+	if err != nil {
+		return nil, err
+	}
+	interleave, err := p.interleaveDef(desc)
+	if err != nil {
+		return nil, err
+	}
+
+	v := &valuesNode{columns: []string{"Table", "CreateTable"}}
+	v.rows = append(v.rows, []parser.Datum{
+		parser.DString(n.Table.Table()),
+		parser.DString(desc.createTableString(interleave)),
+	})
+	return v, nil
+}
+
+// createTableString returns the CREATE TABLE statement which recreates the
+// columns, indexes and column families of the table, followed by the
+// INTERLEAVE IN PARENT clause if interleave is not nil.
+func (desc *TableDescriptor) createTableString(interleave *parser.InterleaveDef) string {
+	var defs []string
+	for _, col := range desc.Columns {
+		var buf bytes.Buffer
+		fmt.Fprintf(&buf, "%s %s", parser.Name(col.Name), col.Type.SQLString())
+		if !col.Nullable {
+			buf.WriteString(" NOT NULL")
+		}
+		if col.DefaultExpr != nil {
+			fmt.Fprintf(&buf, " DEFAULT %s", *col.DefaultExpr)
+		}
+		if col.ComputedExpr != nil {
+			fmt.Fprintf(&buf, " AS (%s) STORED", *col.ComputedExpr)
+		}
+		defs = append(defs, buf.String())
+	}
+	defs = append(defs, (&parser.UniqueConstraintTableDef{
+		IndexTableDef: parser.IndexTableDef{
+			Name:    parser.Name(desc.PrimaryIndex.Name),
+			Columns: desc.PrimaryIndex.indexElems(),
+		},
+		PrimaryKey: true,
+	}).String())
+	for _, index := range desc.Indexes {
+		def := parser.IndexTableDef{
+			Name:    parser.Name(index.Name),
+			Columns: index.indexElems(),
+			Storing: index.StoreColumnNames,
+		}
+		var s string
+		if index.Unique {
+			s = (&parser.UniqueConstraintTableDef{IndexTableDef: def}).String()
+		} else {
+			s = def.String()
+		}
+		if index.Predicate != nil {
+			s += fmt.Sprintf(" WHERE %s", *index.Predicate)
+		}
+		defs = append(defs, s)
+	}
+	for _, family := range desc.Families {
+		if len(family.ColumnNames) == 0 {
+			// Empty families cannot be declared, and are not needed to
+			// recreate the table.
+			continue
+		}
+		defs = append(defs, (&parser.FamilyTableDef{
+			Name:    parser.Name(family.Name),
+			Columns: family.ColumnNames,
+		}).String())
+	}
+	s := fmt.Sprintf("CREATE TABLE %s (\n\t%s\n)", parser.Name(desc.Name), strings.Join(defs, ",\n\t"))
+	if interleave != nil {
+		s += fmt.Sprintf(" %s", interleave)
+	}
+	return s
+}
+
 // ShowDatabases returns all the databases.
 // Privileges: None.
 //   Notes: postgres does not have a "show databases"
@@ -122,10 +122,11 @@ func (p *planner) orderBy(n *parser.Select, s *scanNode) (*sortNode, error) {
 		ordering = append(ordering, index)
 	}
 
-	return &sortNode{columns: columns, ordering: ordering}, nil
+	return &sortNode{planner: p, columns: columns, ordering: ordering}, nil
 }
 
 type sortNode struct {
+	planner  *planner
 	plan     planNode
 	columns  []string
 	ordering []int
@@ -158,7 +159,11 @@ func (n *sortNode) Next() bool {
 			return false
 		}
 	}
-	return n.plan.Next()
+	if !n.plan.Next() {
+		n.err = n.plan.Err()
+		return false
+	}
+	return true
 }
 
 func (n *sortNode) Err() error {
@@ -218,21 +223,49 @@ func (n *sortNode) wrap(plan planNode) planNode {
 }
 
 func (n *sortNode) initValues() bool {
-	// TODO(pmattis): If the result set is large, we might need to perform the
-	// sort on disk.
 	v := &valuesNode{ordering: n.ordering}
+	acc := n.planner.newMemoryAccount()
+	// Set once the rows exceed the memory budget and are sorted on disk.
+	var sorter *externalSorter
 	for n.plan.Next() {
 		values := n.plan.Values()
 		valuesCopy := make(parser.DTuple, len(values))
 		copy(valuesCopy, values)
+		size := rowSize(valuesCopy)
+		if err := acc.grow(size); err != nil {
+			if !isMemoryBudgetExceeded(err) || len(v.rows) == 0 {
+				n.err = err
+				return false
+			}
+			// Spill the buffered rows to disk as a sorted run.
+			if sorter == nil {
+				sorter = &externalSorter{acc: acc, ordering: n.ordering}
+			}
+			if n.err = sorter.addRun(v); n.err != nil {
+				return false
+			}
+			v.rows = nil
+			acc.clear()
+			if n.err = acc.grow(size); n.err != nil {
+				return false
+			}
+		}
 		v.rows = append(v.rows, valuesCopy)
 	}
 	n.err = n.plan.Err()
 	if n.err != nil {
 		return false
 	}
-	sort.Sort(v)
-	n.plan = v
+	if sorter == nil {
+		sort.Sort(v)
+		n.plan = v
+		return true
+	}
+	if n.err = sorter.addRun(v); n.err != nil {
+		return false
+	}
+	acc.clear()
+	n.plan = sorter.merge(n.plan.Columns())
 	return true
 }
 
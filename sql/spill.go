@@ -0,0 +1,420 @@
+// Copyright 2015 The Cockroach Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+// implied. See the License for the specific language governing
+// permissions and limitations under the License. See the AUTHORS file
+// for names of contributors.
+
+package sql
+
+import (
+	"bytes"
+	"container/heap"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"sort"
+
+	"github.com/cockroachdb/cockroach/roachpb"
+	"github.com/cockroachdb/cockroach/sql/parser"
+	"github.com/cockroachdb/cockroach/storage/engine"
+	"github.com/cockroachdb/cockroach/util/encoding"
+	"github.com/cockroachdb/cockroach/util/log"
+	"github.com/cockroachdb/cockroach/util/stop"
+)
+
+// tempEngineCacheSize is the size of the block cache of the temporary
+// engines rows are spilled to.
+const tempEngineCacheSize = 8 << 20 // 8 MB
+
+// tempEngine is an ephemeral RocksDB instance in a temporary directory to
+// which the operators spill rows when they exceed their memory budget. The
+// directory is removed when the engine is closed.
+type tempEngine struct {
+	*engine.RocksDB
+	dir     string
+	stopper *stop.Stopper
+}
+
+func newTempEngine() (*tempEngine, error) {
+	dir, err := ioutil.TempDir("", "cockroach-sql-spill")
+	if err != nil {
+		return nil, err
+	}
+	stopper := stop.NewStopper()
+	r := engine.NewTempRocksDB(dir, tempEngineCacheSize, stopper)
+	if err := r.Open(); err != nil {
+		stopper.Stop()
+		_ = os.RemoveAll(dir)
+		return nil, err
+	}
+	if log.V(2) {
+		log.Infof("spilling rows to %s", dir)
+	}
+	return &tempEngine{RocksDB: r, dir: dir, stopper: stopper}, nil
+}
+
+func (e *tempEngine) close() {
+	// Stopping the stopper closes the engine.
+	e.stopper.Stop()
+	if err := os.RemoveAll(e.dir); err != nil {
+		log.Warningf("unable to remove %s: %s", e.dir, err)
+	}
+}
+
+func isMemoryBudgetExceeded(err error) bool {
+	_, ok := err.(*memoryBudgetExceededError)
+	return ok
+}
+
+// spillableSet is a set of encoded values, such as the rows seen by DISTINCT,
+// which is spilled to the temporary engine of its memory account when it
+// exceeds the memory budget. The spilled values are keyed by the generation
+// of the set followed by the value. The generation is bumped when the set is
+// reset.
+type spillableSet struct {
+	acc        *memoryAccount
+	values     map[string]struct{}
+	spilled    bool
+	generation uint64
+}
+
+func newSpillableSet(acc *memoryAccount) *spillableSet {
+	return &spillableSet{acc: acc, values: make(map[string]struct{})}
+}
+
+// contains returns whether the value is in the set.
+func (s *spillableSet) contains(value []byte) (bool, error) {
+	if _, ok := s.values[string(value)]; ok {
+		return true, nil
+	}
+	if !s.spilled {
+		return false, nil
+	}
+	eng, err := s.acc.spillEngine()
+	if err != nil {
+		return false, err
+	}
+	v, err := eng.Get(s.spillKey(s.generation, value))
+	return v != nil, err
+}
+
+// add adds the value to the set. When the set exceeds the memory budget, the
+// values in memory are spilled to disk.
+func (s *spillableSet) add(value []byte) error {
+	err := s.acc.grow(sizeOfMapEntry + int64(len(value)))
+	if err == nil {
+		s.values[string(value)] = struct{}{}
+		return nil
+	}
+	if !isMemoryBudgetExceeded(err) {
+		return err
+	}
+
+	eng, err := s.acc.spillEngine()
+	if err != nil {
+		return err
+	}
+	b := eng.NewBatch()
+	defer b.Close()
+	// The value is not used, but an empty value would read as a missing key.
+	present := []byte{1}
+	for v := range s.values {
+		if err := b.Put(s.spillKey(s.generation, []byte(v)), present); err != nil {
+			return err
+		}
+	}
+	if err := b.Put(s.spillKey(s.generation, value), present); err != nil {
+		return err
+	}
+	if err := b.Commit(); err != nil {
+		return err
+	}
+	s.values = make(map[string]struct{})
+	s.acc.clear()
+	s.spilled = true
+	return nil
+}
+
+// reset empties the set. The values spilled by the current generation are
+// cleared from the temporary engine.
+func (s *spillableSet) reset() error {
+	if len(s.values) > 0 {
+		s.values = make(map[string]struct{})
+		s.acc.clear()
+	}
+	if !s.spilled {
+		return nil
+	}
+	eng, err := s.acc.spillEngine()
+	if err != nil {
+		return err
+	}
+	start := s.spillKey(s.generation, nil)
+	end := s.spillKey(s.generation+1, nil)
+	if _, err := engine.ClearRange(eng, start, end); err != nil {
+		return err
+	}
+	s.spilled = false
+	s.generation++
+	return nil
+}
+
+// close releases the memory of the set and removes the temporary engine the
+// values were spilled to, if any.
+func (s *spillableSet) close() {
+	s.values = nil
+	s.spilled = false
+	s.acc.close()
+}
+
+func (s *spillableSet) spillKey(generation uint64, value []byte) roachpb.EncodedKey {
+	key := encoding.EncodeUvarint(nil, generation)
+	return roachpb.EncodedKey(append(key, value...))
+}
+
+// externalSorter sorts rows which do not fit in the memory budget. The rows
+// are sorted in memory in runs which are spilled to the temporary engine of
+// the memory account and merged back by a sortedRunsNode.
+//
+// The key of a spilled row is the run number, followed by the values of
+// the ordering columns encoded so that the keys sort in the desired order
+// and a sequence number which makes the key unique. The value is the
+// encoding of all the values of the row.
+type externalSorter struct {
+	acc      *memoryAccount
+	ordering []int
+	// The type of each column, taken from its first non-NULL value, which is
+	// needed to decode the spilled values.
+	valTypes []parser.Datum
+	runs     int
+	seq      uint64
+}
+
+// addRun sorts the rows and spills them to the temporary engine as a new
+// run.
+func (s *externalSorter) addRun(v *valuesNode) error {
+	if len(v.rows) == 0 {
+		return nil
+	}
+	eng, err := s.acc.spillEngine()
+	if err != nil {
+		return err
+	}
+	sort.Sort(v)
+
+	b := eng.NewBatch()
+	defer b.Close()
+	prefix := encoding.EncodeUvarint(nil, uint64(s.runs))
+	for _, row := range v.rows {
+		if s.valTypes == nil {
+			s.valTypes = make([]parser.Datum, len(row))
+		}
+		key := append([]byte(nil), prefix...)
+		for _, o := range s.ordering {
+			dir := encoding.Ascending
+			if o < 0 {
+				o = -o
+				dir = encoding.Descending
+			}
+			if key, err = encodeTableKey(key, row[o-1], dir); err != nil {
+				return errUnspillable(row[o-1], err)
+			}
+		}
+		key = encoding.EncodeUvarint(key, s.seq)
+		s.seq++
+
+		var value []byte
+		for i, d := range row {
+			if s.valTypes[i] == nil && d != parser.DNull {
+				s.valTypes[i] = d
+			}
+			if value, err = encodeTableKey(value, d, encoding.Ascending); err != nil {
+				return errUnspillable(d, err)
+			}
+		}
+		if err := b.Put(roachpb.EncodedKey(key), value); err != nil {
+			return err
+		}
+	}
+	if err := b.Commit(); err != nil {
+		return err
+	}
+	s.runs++
+	return nil
+}
+
+// errUnspillable returns the error of a sort which exceeded its memory
+// budget with a value that cannot be spilled to disk.
+func errUnspillable(d parser.Datum, err error) error {
+	return fmt.Errorf("sort exceeded the memory budget and values of type %s cannot be spilled to disk: %v",
+		d.Type(), err)
+}
+
+// merge returns the node merging the spilled runs. The node releases its
+// iterators when the memory account is closed, which happens at the latest
+// once the statement has been executed.
+func (s *externalSorter) merge(columns []string) *sortedRunsNode {
+	n := &sortedRunsNode{
+		columns:  columns,
+		ordering: s.ordering,
+		sorter:   s,
+	}
+	s.acc.releaseSpill = n.closeRuns
+	return n
+}
+
+// sortedRunsNode merges the runs spilled by an externalSorter, producing
+// the rows in sorted order. The temporary engine is removed once all of the
+// rows have been produced, or when the statement has been executed if not
+// all of the rows were consumed.
+type sortedRunsNode struct {
+	columns  []string
+	ordering []int
+	sorter   *externalSorter
+	runs     runHeap
+	started  bool
+	row      parser.DTuple
+	err      error
+}
+
+func (n *sortedRunsNode) Columns() []string {
+	return n.columns
+}
+
+func (n *sortedRunsNode) Ordering() ([]int, int) {
+	return n.ordering, 0
+}
+
+func (n *sortedRunsNode) Values() parser.DTuple {
+	return n.row
+}
+
+func (n *sortedRunsNode) Next() bool {
+	if n.err != nil {
+		return false
+	}
+	if !n.started {
+		n.started = true
+		if n.err = n.initRuns(); n.err != nil {
+			return false
+		}
+	} else if len(n.runs) > 0 {
+		// Advance the run which produced the last row.
+		r := n.runs[0]
+		r.iter.Next()
+		if r.valid() {
+			heap.Fix(&n.runs, 0)
+		} else {
+			if n.err = r.iter.Error(); n.err != nil {
+				return false
+			}
+			r.iter.Close()
+			heap.Pop(&n.runs)
+		}
+	}
+	if len(n.runs) == 0 {
+		n.close()
+		return false
+	}
+
+	value := n.runs[0].iter.Value()
+	n.row = make(parser.DTuple, len(n.sorter.valTypes))
+	for i := range n.row {
+		if n.row[i], value, n.err = decodeTableKey(n.sorter.valTypes[i], value, encoding.Ascending); n.err != nil {
+			return false
+		}
+	}
+	return true
+}
+
+// initRuns positions an iterator at the start of each run.
+func (n *sortedRunsNode) initRuns() error {
+	eng, err := n.sorter.acc.spillEngine()
+	if err != nil {
+		return err
+	}
+	for i := 0; i < n.sorter.runs; i++ {
+		r := &sortedRun{
+			iter:   eng.NewIterator(),
+			prefix: encoding.EncodeUvarint(nil, uint64(i)),
+		}
+		r.iter.Seek(r.prefix)
+		if !r.valid() {
+			err := r.iter.Error()
+			r.iter.Close()
+			if err != nil {
+				return err
+			}
+			continue
+		}
+		n.runs = append(n.runs, r)
+	}
+	heap.Init(&n.runs)
+	return nil
+}
+
+// close releases the iterators and removes the temporary engine.
+func (n *sortedRunsNode) close() {
+	n.sorter.acc.close()
+}
+
+// closeRuns releases the iterators over the runs which have not been
+// exhausted.
+func (n *sortedRunsNode) closeRuns() {
+	for _, r := range n.runs {
+		r.iter.Close()
+	}
+	n.runs = nil
+}
+
+func (n *sortedRunsNode) Err() error {
+	return n.err
+}
+
+func (n *sortedRunsNode) ExplainPlan() (name, description string, children []planNode) {
+	return "sorted runs", fmt.Sprintf("%d", n.sorter.runs), nil
+}
+
+// sortedRun is a run of rows spilled by an externalSorter.
+type sortedRun struct {
+	iter   engine.Iterator
+	prefix []byte
+}
+
+func (r *sortedRun) valid() bool {
+	return r.iter.Valid() && bytes.HasPrefix(r.iter.Key(), r.prefix)
+}
+
+// key returns the key of the current row without the run number.
+func (r *sortedRun) key() []byte {
+	return r.iter.Key()[len(r.prefix):]
+}
+
+// runHeap is a min-heap of the runs being merged, ordered by the key of
+// their current row.
+type runHeap []*sortedRun
+
+func (h runHeap) Len() int           { return len(h) }
+func (h runHeap) Less(i, j int) bool { return bytes.Compare(h[i].key(), h[j].key()) < 0 }
+func (h runHeap) Swap(i, j int)      { h[i], h[j] = h[j], h[i] }
+
+func (h *runHeap) Push(x interface{}) {
+	*h = append(*h, x.(*sortedRun))
+}
+
+func (h *runHeap) Pop() interface{} {
+	old := *h
+	n := len(old)
+	x := old[n-1]
+	*h = old[:n-1]
+	return x
+}
@@ -0,0 +1,135 @@
+// Copyright 2015 The Cockroach Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+// implied. See the License for the specific language governing
+// permissions and limitations under the License. See the AUTHORS file
+// for names of contributors.
+
+package sql
+
+import (
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/montanaflynn/stats"
+)
+
+const (
+	// maxStmtFingerprints bounds the number of distinct statement
+	// fingerprints for which statistics are kept. Statements with new
+	// fingerprints are not tracked once the limit is reached.
+	maxStmtFingerprints = 5000
+	// maxLatencySamples is the number of most recent latencies retained per
+	// fingerprint for computing latency percentiles.
+	maxLatencySamples = 1000
+)
+
+// StatementStatistics holds the statistics collected for all of the
+// statements sharing a fingerprint.
+type StatementStatistics struct {
+	Fingerprint string        `json:"fingerprint"`
+	Count       int64         `json:"count"`
+	Rows        int64         `json:"rows"`
+	Errors      int64         `json:"errors"`
+	Retries     int64         `json:"retries"`
+	LatencyP50  time.Duration `json:"latencyP50"`
+	LatencyP90  time.Duration `json:"latencyP90"`
+	LatencyP99  time.Duration `json:"latencyP99"`
+	LatencyMax  time.Duration `json:"latencyMax"`
+}
+
+type stmtStats struct {
+	count   int64
+	rows    int64
+	errors  int64
+	retries int64
+	// latencies is a ring buffer of the most recent latencies in
+	// nanoseconds; next is the position of the next sample.
+	latencies stats.Float64Data
+	next      int
+}
+
+func (s *stmtStats) addLatency(latency time.Duration) {
+	if len(s.latencies) < maxLatencySamples {
+		s.latencies = append(s.latencies, float64(latency))
+		return
+	}
+	s.latencies[s.next] = float64(latency)
+	s.next = (s.next + 1) % maxLatencySamples
+}
+
+// stmtStatsRegistry accumulates statistics per statement fingerprint.
+type stmtStatsRegistry struct {
+	sync.Mutex
+	stats map[string]*stmtStats
+}
+
+func newStmtStatsRegistry() *stmtStatsRegistry {
+	return &stmtStatsRegistry{stats: map[string]*stmtStats{}}
+}
+
+// record adds the outcome of a single statement execution to the
+// statistics for its fingerprint.
+func (r *stmtStatsRegistry) record(fingerprint string, latency time.Duration, rows, retries int, err error) {
+	r.Lock()
+	defer r.Unlock()
+	s, ok := r.stats[fingerprint]
+	if !ok {
+		if len(r.stats) >= maxStmtFingerprints {
+			return
+		}
+		s = &stmtStats{}
+		r.stats[fingerprint] = s
+	}
+	s.count++
+	s.rows += int64(rows)
+	s.retries += int64(retries)
+	if err != nil {
+		s.errors++
+	}
+	s.addLatency(latency)
+}
+
+// snapshot returns the current statistics sorted by fingerprint.
+func (r *stmtStatsRegistry) snapshot() []StatementStatistics {
+	r.Lock()
+	defer r.Unlock()
+	result := make([]StatementStatistics, 0, len(r.stats))
+	for fingerprint, s := range r.stats {
+		ss := StatementStatistics{
+			Fingerprint: fingerprint,
+			Count:       s.count,
+			Rows:        s.rows,
+			Errors:      s.errors,
+			Retries:     s.retries,
+		}
+		// The percentile functions only fail on empty input and every entry
+		// has at least one sample, so the errors are ignored.
+		p50, _ := stats.Percentile(s.latencies, 50)
+		p90, _ := stats.Percentile(s.latencies, 90)
+		p99, _ := stats.Percentile(s.latencies, 99)
+		max, _ := stats.Max(s.latencies)
+		ss.LatencyP50 = time.Duration(p50)
+		ss.LatencyP90 = time.Duration(p90)
+		ss.LatencyP99 = time.Duration(p99)
+		ss.LatencyMax = time.Duration(max)
+		result = append(result, ss)
+	}
+	sort.Sort(stmtStatsByFingerprint(result))
+	return result
+}
+
+type stmtStatsByFingerprint []StatementStatistics
+
+func (s stmtStatsByFingerprint) Len() int           { return len(s) }
+func (s stmtStatsByFingerprint) Swap(i, j int)      { s[i], s[j] = s[j], s[i] }
+func (s stmtStatsByFingerprint) Less(i, j int) bool { return s[i].Fingerprint < s[j].Fingerprint }
@@ -26,7 +26,7 @@ import (
 	"github.com/cockroachdb/cockroach/util"
 )
 
-// ID, ColumnID, and IndexID are all uint32, but are each given a
+// ID, ColumnID, IndexID and FamilyID are all uint32, but are each given a
 // type alias to prevent accidental use of one of the types where
 // another is expected.
 
@@ -39,9 +39,31 @@ type ColumnID uint32
 // IndexID is a custom type for IndexDescriptor IDs.
 type IndexID uint32
 
+// FamilyID is a custom type for ColumnFamilyDescriptor IDs.
+type FamilyID uint32
+
+// FormatVersion is a custom type for the encoding of the rows of a table.
+type FormatVersion uint32
+
+const (
+	// BaseFormatVersion stores every non-NULL, non-primary key column of a row
+	// in its own key-value pair, next to a sentinel key which marks the
+	// existence of the row. The system tables and the tables created before
+	// column families use this format and continue to be read and written
+	// using it.
+	BaseFormatVersion FormatVersion = iota
+	// FamilyFormatVersion stores the non-NULL, non-primary key columns of a row
+	// in one key-value pair per column family. The key-value pair for family 0
+	// is always written and takes the place of the sentinel key.
+	FamilyFormatVersion
+)
+
 const (
 	// PrimaryKeyIndexName is the name of the index for the primary key.
 	PrimaryKeyIndexName = "primary"
+	// DefaultFamilyName is the name of the column family created for the
+	// columns not assigned to a family.
+	DefaultFamilyName = "primary"
 )
 
 var errMissingColumns = errors.New("table must contain at least 1 column")
@@ -114,6 +136,13 @@ func (desc *IndexDescriptor) fullColumnIDs() []ColumnID {
 	return columnIDs
 }
 
+// isInterleaved returns true if the key span scanned for the index contains
+// the rows of other tables, either because the index is interleaved in
+// another table or because other tables are interleaved in it.
+func (desc *IndexDescriptor) isInterleaved() bool {
+	return len(desc.Interleave) > 0 || len(desc.InterleavedBy) > 0
+}
+
 // SetID implements the descriptorProto interface.
 func (desc *TableDescriptor) SetID(id ID) {
 	desc.ID = id
@@ -129,8 +158,27 @@ func (desc *TableDescriptor) SetName(name string) {
 	desc.Name = name
 }
 
+// Dropped returns true if the table has been dropped and is waiting for its
+// data to be garbage collected.
+func (desc *TableDescriptor) Dropped() bool {
+	return desc.State == TableDescriptor_DROP
+}
+
+// Offline returns true if the table is being populated by an IMPORT or
+// RESTORE and can't be used yet.
+func (desc *TableDescriptor) Offline() bool {
+	return desc.State == TableDescriptor_OFFLINE
+}
+
+// allocateName sets the name of the family if it was not specified by the
+// user.
+func (desc *ColumnFamilyDescriptor) allocateName() {
+	desc.Name = fmt.Sprintf("fam_%d_%s", desc.ID, strings.Join(desc.ColumnNames, "_"))
+}
+
 // AllocateIDs allocates column and index ids for any column or index which has
-// an ID of 0.
+// an ID of 0. For tables using FamilyFormatVersion, it also allocates family
+// ids and adds the columns which are not part of a family to family 0.
 func (desc *TableDescriptor) AllocateIDs() error {
 	if desc.NextColumnID == 0 {
 		desc.NextColumnID = 1
@@ -216,6 +264,10 @@ func (desc *TableDescriptor) AllocateIDs() error {
 		}
 	}
 
+	if desc.FormatVersion == FamilyFormatVersion {
+		desc.allocateFamilies(columnNames)
+	}
+
 	// This is sort of ugly. If the descriptor does not have an ID, we hack one in
 	// to pass the table ID check. We use a non-reserved ID, reserved ones being set
 	// before AllocateIDs.
@@ -228,6 +280,62 @@ func (desc *TableDescriptor) AllocateIDs() error {
 	return err
 }
 
+// allocateFamilies allocates the family ids and keeps the families in sync
+// with the columns of the table: dropped columns are removed from their family
+// and columns which are not part of any family are added to family 0.
+func (desc *TableDescriptor) allocateFamilies(columnNames map[string]ColumnID) {
+	if desc.NextFamilyID == 0 {
+		// The families are being allocated for a new table. Family 0 is the
+		// first family specified or, if there is none, the default family.
+		if len(desc.Families) == 0 {
+			desc.Families = append(desc.Families, ColumnFamilyDescriptor{Name: DefaultFamilyName})
+		}
+		for i := range desc.Families {
+			desc.Families[i].ID = FamilyID(i)
+		}
+		desc.NextFamilyID = FamilyID(len(desc.Families))
+	}
+
+	columnIDs := map[ColumnID]struct{}{}
+	for _, col := range desc.Columns {
+		columnIDs[col.ID] = struct{}{}
+	}
+	inFamily := map[ColumnID]struct{}{}
+	for i := range desc.Families {
+		family := &desc.Families[i]
+		var names []string
+		var ids []ColumnID
+		for j, name := range family.ColumnNames {
+			var id ColumnID
+			if j < len(family.ColumnIDs) {
+				id = family.ColumnIDs[j]
+				if _, ok := columnIDs[id]; !ok {
+					// The column was dropped.
+					continue
+				}
+			} else {
+				// Unknown column names are left for Validate to report.
+				id = columnNames[normalizeName(name)]
+			}
+			names = append(names, name)
+			ids = append(ids, id)
+			inFamily[id] = struct{}{}
+		}
+		family.ColumnNames, family.ColumnIDs = names, ids
+		if len(family.Name) == 0 {
+			family.allocateName()
+		}
+	}
+
+	for _, col := range desc.Columns {
+		if _, ok := inFamily[col.ID]; ok || desc.PrimaryIndex.containsColumnID(col.ID) {
+			continue
+		}
+		desc.Families[0].ColumnNames = append(desc.Families[0].ColumnNames, col.Name)
+		desc.Families[0].ColumnIDs = append(desc.Families[0].ColumnIDs, col.ID)
+	}
+}
+
 // Validate validates that the table descriptor is well formed. Checks include
 // validating the table, column and index names, verifying that column names
 // and index names are unique and verifying that column IDs and index IDs are
@@ -318,6 +426,11 @@ func (desc *TableDescriptor) Validate() error {
 			return fmt.Errorf("index \"%s\" must contain at least 1 column", index.Name)
 		}
 
+		if len(index.ColumnDirections) > len(index.ColumnIDs) {
+			return fmt.Errorf("mismatched column IDs (%d) and directions (%d)",
+				len(index.ColumnIDs), len(index.ColumnDirections))
+		}
+
 		for i, name := range index.ColumnNames {
 			colID, ok := columnNames[normalizeName(name)]
 			if !ok {
@@ -329,10 +442,116 @@ func (desc *TableDescriptor) Validate() error {
 			}
 		}
 	}
+	if err := desc.validateInterleave(); err != nil {
+		return err
+	}
+	if err := desc.validateFamilies(columnNames); err != nil {
+		return err
+	}
 	// Validate the privilege descriptor.
 	return desc.Privileges.Validate(desc.GetID())
 }
 
+// validateInterleave verifies that only the primary index is interleaved and
+// that its ancestors share no more than its columns.
+func (desc *TableDescriptor) validateInterleave() error {
+	for _, index := range desc.Indexes {
+		if len(index.Interleave) > 0 || len(index.InterleavedBy) > 0 {
+			return fmt.Errorf("secondary index \"%s\" cannot be interleaved", index.Name)
+		}
+	}
+	index := &desc.PrimaryIndex
+	sharedPrefixLen := 0
+	for _, ancestor := range index.Interleave {
+		if ancestor.SharedPrefixLen == 0 && sharedPrefixLen == 0 {
+			return fmt.Errorf("index \"%s\" interleaved in table %d shares no columns",
+				index.Name, ancestor.TableID)
+		}
+		sharedPrefixLen += int(ancestor.SharedPrefixLen)
+	}
+	if sharedPrefixLen > len(index.ColumnIDs) {
+		return fmt.Errorf("index \"%s\" interleaved prefix (%d) longer than its columns (%d)",
+			index.Name, sharedPrefixLen, len(index.ColumnIDs))
+	}
+	return nil
+}
+
+// validateFamilies verifies that every non-primary key column is part of
+// exactly one family and that the family names and IDs are unique.
+func (desc *TableDescriptor) validateFamilies(columnNames map[string]ColumnID) error {
+	switch desc.FormatVersion {
+	case BaseFormatVersion:
+		if len(desc.Families) > 0 {
+			return fmt.Errorf("table with format version %d cannot have column families",
+				desc.FormatVersion)
+		}
+		return nil
+	case FamilyFormatVersion:
+	default:
+		return fmt.Errorf("unknown format version %d", desc.FormatVersion)
+	}
+
+	if len(desc.Families) == 0 || desc.Families[0].ID != 0 {
+		return fmt.Errorf("table must contain family 0")
+	}
+
+	familyNames := map[string]struct{}{}
+	familyIDs := map[FamilyID]string{}
+	columnFamilies := map[ColumnID]string{}
+	for _, family := range desc.Families {
+		if err := validateName(family.Name, "family"); err != nil {
+			return err
+		}
+
+		if _, ok := familyNames[normalizeName(family.Name)]; ok {
+			return fmt.Errorf("duplicate family name: \"%s\"", family.Name)
+		}
+		familyNames[normalizeName(family.Name)] = struct{}{}
+
+		if other, ok := familyIDs[family.ID]; ok {
+			return fmt.Errorf("family \"%s\" duplicate ID of family \"%s\": %d",
+				family.Name, other, family.ID)
+		}
+		familyIDs[family.ID] = family.Name
+
+		if family.ID >= desc.NextFamilyID {
+			return fmt.Errorf("family \"%s\" invalid family ID (%d) > next family ID (%d)",
+				family.Name, family.ID, desc.NextFamilyID)
+		}
+
+		if len(family.ColumnIDs) != len(family.ColumnNames) {
+			return fmt.Errorf("mismatched column IDs (%d) and names (%d)",
+				len(family.ColumnIDs), len(family.ColumnNames))
+		}
+
+		for i, name := range family.ColumnNames {
+			colID, ok := columnNames[normalizeName(name)]
+			if !ok {
+				return fmt.Errorf("family \"%s\" contains unknown column \"%s\"", family.Name, name)
+			}
+			if colID != family.ColumnIDs[i] {
+				return fmt.Errorf("family \"%s\" column \"%s\" should have ID %d, but found ID %d",
+					family.Name, name, colID, family.ColumnIDs[i])
+			}
+			if desc.PrimaryIndex.containsColumnID(colID) {
+				return fmt.Errorf("family \"%s\" contains primary key column \"%s\"", family.Name, name)
+			}
+			if other, ok := columnFamilies[colID]; ok {
+				return fmt.Errorf("column \"%s\" is in both family \"%s\" and \"%s\"",
+					name, other, family.Name)
+			}
+			columnFamilies[colID] = family.Name
+		}
+	}
+
+	for _, column := range desc.Columns {
+		if _, ok := columnFamilies[column.ID]; !ok && !desc.PrimaryIndex.containsColumnID(column.ID) {
+			return fmt.Errorf("column \"%s\" is not in any family", column.Name)
+		}
+	}
+	return nil
+}
+
 // AddColumn adds a column to the table.
 func (desc *TableDescriptor) AddColumn(col ColumnDescriptor) {
 	desc.Columns = append(desc.Columns, col)
@@ -399,6 +618,16 @@ func (desc *TableDescriptor) FindIndexByID(id IndexID) (*IndexDescriptor, error)
 	return nil, util.Errorf("index-id \"%d\" does not exist", id)
 }
 
+// FindFamilyByID finds the family with specified ID.
+func (desc *TableDescriptor) FindFamilyByID(id FamilyID) (*ColumnFamilyDescriptor, error) {
+	for i, f := range desc.Families {
+		if f.ID == id {
+			return &desc.Families[i], nil
+		}
+	}
+	return nil, util.Errorf("family-id \"%d\" does not exist", id)
+}
+
 // SQLString returns the SQL string corresponding to the type.
 func (c *ColumnType) SQLString() string {
 	switch c.Kind {
@@ -417,10 +646,40 @@ func (c *ColumnType) SQLString() string {
 			}
 			return fmt.Sprintf("%s(%d)", c.Kind.String(), c.Precision)
 		}
+	case ColumnType_ARRAY:
+		if c.ArrayContents != nil {
+			return fmt.Sprintf("%s[]", c.ArrayContents.String())
+		}
 	}
 	return c.Kind.String()
 }
 
+// canWidenTo returns whether a column of type c can be changed to type other
+// without rewriting the values stored in the column: the kind of the type
+// must be unchanged, and its width and precision may only be increased or
+// removed. A width or precision of 0 means the type is unbounded.
+func (c *ColumnType) canWidenTo(other ColumnType) bool {
+	if c.Kind != other.Kind {
+		return false
+	}
+	wider := func(old, new int32) bool {
+		return new == 0 || (old != 0 && new >= old)
+	}
+	switch c.Kind {
+	case ColumnType_INT, ColumnType_STRING:
+		return wider(c.Width, other.Width)
+	case ColumnType_FLOAT:
+		return wider(c.Precision, other.Precision)
+	case ColumnType_DECIMAL:
+		// Changing the scale of a decimal changes the values it holds.
+		return c.Width == other.Width && wider(c.Precision, other.Precision)
+	case ColumnType_ARRAY:
+		return c.ArrayContents != nil && other.ArrayContents != nil &&
+			*c.ArrayContents == *other.ArrayContents
+	}
+	return true
+}
+
 // SetID implements the descriptorProto interface.
 func (desc *DatabaseDescriptor) SetID(id ID) {
 	desc.ID = id
@@ -32,18 +32,24 @@ const (
 	ColumnType_INTERVAL  ColumnType_Kind = 6
 	ColumnType_STRING    ColumnType_Kind = 7
 	ColumnType_BYTES     ColumnType_Kind = 8
+	ColumnType_ARRAY     ColumnType_Kind = 9
+	ColumnType_JSON      ColumnType_Kind = 10
+	ColumnType_UUID      ColumnType_Kind = 11
 )
 
 var ColumnType_Kind_name = map[int32]string{
-	0: "BOOL",
-	1: "INT",
-	2: "FLOAT",
-	3: "DECIMAL",
-	4: "DATE",
-	5: "TIMESTAMP",
-	6: "INTERVAL",
-	7: "STRING",
-	8: "BYTES",
+	0:  "BOOL",
+	1:  "INT",
+	2:  "FLOAT",
+	3:  "DECIMAL",
+	4:  "DATE",
+	5:  "TIMESTAMP",
+	6:  "INTERVAL",
+	7:  "STRING",
+	8:  "BYTES",
+	9:  "ARRAY",
+	10: "JSON",
+	11: "UUID",
 }
 var ColumnType_Kind_value = map[string]int32{
 	"BOOL":      0,
@@ -55,6 +61,9 @@ var ColumnType_Kind_value = map[string]int32{
 	"INTERVAL":  6,
 	"STRING":    7,
 	"BYTES":     8,
+	"ARRAY":     9,
+	"JSON":      10,
+	"UUID":      11,
 }
 
 func (x ColumnType_Kind) Enum() *ColumnType_Kind {
@@ -74,12 +83,91 @@ func (x *ColumnType_Kind) UnmarshalJSON(data []byte) error {
 	return nil
 }
 
+// The direction in which the values of a column are ordered within the
+// index.
+type IndexDescriptor_Direction int32
+
+const (
+	IndexDescriptor_ASC  IndexDescriptor_Direction = 0
+	IndexDescriptor_DESC IndexDescriptor_Direction = 1
+)
+
+var IndexDescriptor_Direction_name = map[int32]string{
+	0: "ASC",
+	1: "DESC",
+}
+var IndexDescriptor_Direction_value = map[string]int32{
+	"ASC":  0,
+	"DESC": 1,
+}
+
+func (x IndexDescriptor_Direction) Enum() *IndexDescriptor_Direction {
+	p := new(IndexDescriptor_Direction)
+	*p = x
+	return p
+}
+func (x IndexDescriptor_Direction) String() string {
+	return proto.EnumName(IndexDescriptor_Direction_name, int32(x))
+}
+func (x *IndexDescriptor_Direction) UnmarshalJSON(data []byte) error {
+	value, err := proto.UnmarshalJSONEnum(IndexDescriptor_Direction_value, data, "IndexDescriptor_Direction")
+	if err != nil {
+		return err
+	}
+	*x = IndexDescriptor_Direction(value)
+	return nil
+}
+
+type TableDescriptor_State int32
+
+const (
+	// Not dropped.
+	TableDescriptor_PUBLIC TableDescriptor_State = 0
+	// Dropped. The table no longer has a name and its data is waiting to
+	// be garbage collected.
+	TableDescriptor_DROP TableDescriptor_State = 1
+	// Being populated by an IMPORT or RESTORE. The table has a name, which
+	// can't be reused, but it can't be used by other statements until it is
+	// made public.
+	TableDescriptor_OFFLINE TableDescriptor_State = 2
+)
+
+var TableDescriptor_State_name = map[int32]string{
+	0: "PUBLIC",
+	1: "DROP",
+	2: "OFFLINE",
+}
+var TableDescriptor_State_value = map[string]int32{
+	"PUBLIC":  0,
+	"DROP":    1,
+	"OFFLINE": 2,
+}
+
+func (x TableDescriptor_State) Enum() *TableDescriptor_State {
+	p := new(TableDescriptor_State)
+	*p = x
+	return p
+}
+func (x TableDescriptor_State) String() string {
+	return proto.EnumName(TableDescriptor_State_name, int32(x))
+}
+func (x *TableDescriptor_State) UnmarshalJSON(data []byte) error {
+	value, err := proto.UnmarshalJSONEnum(TableDescriptor_State_value, data, "TableDescriptor_State")
+	if err != nil {
+		return err
+	}
+	*x = TableDescriptor_State(value)
+	return nil
+}
+
 type ColumnType struct {
 	Kind ColumnType_Kind `protobuf:"varint,1,opt,name=kind,enum=cockroach.sql.ColumnType_Kind" json:"kind"`
 	// BIT, INT, FLOAT, DECIMAL, CHAR and BINARY
 	Width int32 `protobuf:"varint,2,opt,name=width" json:"width"`
 	// FLOAT and DECIMAL.
 	Precision int32 `protobuf:"varint,3,opt,name=precision" json:"precision"`
+	// The kind of the elements of an ARRAY.
+	ArrayContents *ColumnType_Kind `protobuf:"varint,4,opt,name=array_contents,enum=cockroach.sql.ColumnType_Kind" json:"array_contents,omitempty"`
 }
 
 func (m *ColumnType) Reset()         { *m = ColumnType{} }
@@ -94,12 +182,31 @@ type ColumnDescriptor struct {
 	// Default expression to use to populate the column on insert if no
 	// value is provided.
 	DefaultExpr *string `protobuf:"bytes,5,opt,name=default_expr" json:"default_expr,omitempty"`
+	// Expression computing the value of the column from the other columns of
+	// the row. The value is computed and stored on every write; the column
+	// cannot be written directly.
+	ComputedExpr *string `protobuf:"bytes,6,opt,name=computed_expr" json:"computed_expr,omitempty"`
 }
 
 func (m *ColumnDescriptor) Reset()         { *m = ColumnDescriptor{} }
 func (m *ColumnDescriptor) String() string { return proto.CompactTextString(m) }
 func (*ColumnDescriptor) ProtoMessage()    {}
 
+// InterleaveAncestor is an index of another table under whose keys the rows
+// of an interleaved index are stored.
+type InterleaveAncestor struct {
+	TableID ID      `protobuf:"varint,1,opt,name=table_id,casttype=ID" json:"table_id"`
+	IndexID IndexID `protobuf:"varint,2,opt,name=index_id,casttype=IndexID" json:"index_id"`
+	// The number of leading columns of the interleaved index which are shared
+	// with the ancestor, not counting the columns shared with the previous
+	// ancestors.
+	SharedPrefixLen uint32 `protobuf:"varint,3,opt,name=shared_prefix_len" json:"shared_prefix_len"`
+}
+
+func (m *InterleaveAncestor) Reset()         { *m = InterleaveAncestor{} }
+func (m *InterleaveAncestor) String() string { return proto.CompactTextString(m) }
+func (*InterleaveAncestor) ProtoMessage()    {}
+
 type IndexDescriptor struct {
 	Name   string  `protobuf:"bytes,1,opt,name=name" json:"name"`
 	ID     IndexID `protobuf:"varint,2,opt,name=id,casttype=IndexID" json:"id"`
@@ -121,12 +228,43 @@ type IndexDescriptor struct {
 	// computed as PrimaryIndex.column_ids - column_ids. For the primary index
 	// the list will be empty.
 	ImplicitColumnIDs []ColumnID `protobuf:"varint,7,rep,name=implicit_column_ids,casttype=ColumnID" json:"implicit_column_ids,omitempty"`
+	// An ordered list of the directions of the columns of the index. This list
+	// parallels the column_ids list. Indexes created before directions were
+	// supported have an empty list and all of their columns are ascending.
+	ColumnDirections []IndexDescriptor_Direction `protobuf:"varint,8,rep,name=column_directions,enum=cockroach.sql.IndexDescriptor_Direction" json:"column_directions,omitempty"`
+	// The predicate of a partial index. Only the rows for which the
+	// predicate is true have an entry in the index.
+	Predicate *string `protobuf:"bytes,9,opt,name=predicate" json:"predicate,omitempty"`
+	// The ancestors in which the rows of the index are interleaved, starting
+	// with the outermost one. The list is empty if the index is not
+	// interleaved.
+	Interleave []InterleaveAncestor `protobuf:"bytes,10,rep,name=interleave" json:"interleave"`
+	// The IDs of the tables whose primary indexes are interleaved in the
+	// index.
+	InterleavedBy []ID `protobuf:"varint,11,rep,name=interleaved_by,casttype=ID" json:"interleaved_by,omitempty"`
 }
 
 func (m *IndexDescriptor) Reset()         { *m = IndexDescriptor{} }
 func (m *IndexDescriptor) String() string { return proto.CompactTextString(m) }
 func (*IndexDescriptor) ProtoMessage()    {}
 
+// ColumnFamilyDescriptor is a set of non-primary key columns of a table
+// whose values are stored together in a single key-value pair per row.
+type ColumnFamilyDescriptor struct {
+	Name string   `protobuf:"bytes,1,opt,name=name" json:"name"`
+	ID   FamilyID `protobuf:"varint,2,opt,name=id,casttype=FamilyID" json:"id"`
+	// An ordered list of the names of the columns in the family. This list
+	// parallels the column_ids list.
+	ColumnNames []string `protobuf:"bytes,3,rep,name=column_names" json:"column_names,omitempty"`
+	// An ordered list of the ids of the columns in the family. This list
+	// parallels the column_names list.
+	ColumnIDs []ColumnID `protobuf:"varint,4,rep,name=column_ids,casttype=ColumnID" json:"column_ids,omitempty"`
+}
+
+func (m *ColumnFamilyDescriptor) Reset()         { *m = ColumnFamilyDescriptor{} }
+func (m *ColumnFamilyDescriptor) String() string { return proto.CompactTextString(m) }
+func (*ColumnFamilyDescriptor) ProtoMessage()    {}
+
 // A TableDescriptor represents a table and is stored in a structured metadata
 // key. The TableDescriptor has a globally-unique ID, while its member
 // {Column,Index}Descriptors have locally-unique IDs.
@@ -151,6 +289,23 @@ type TableDescriptor struct {
 	// next_index_id is used to ensure that deleted index ids are not reused.
 	NextIndexID IndexID              `protobuf:"varint,11,opt,name=next_index_id,casttype=IndexID" json:"next_index_id"`
 	Privileges  *PrivilegeDescriptor `protobuf:"bytes,12,opt,name=privileges" json:"privileges,omitempty"`
+	// The encoding used for the rows of the table. See FormatVersion.
+	FormatVersion FormatVersion `protobuf:"varint,13,opt,name=format_version,casttype=FormatVersion" json:"format_version"`
+	// families are the column families of the table. They are only used by
+	// tables with FamilyFormatVersion.
+	Families []ColumnFamilyDescriptor `protobuf:"bytes,14,rep,name=families" json:"families"`
+	// next_family_id is used to ensure that deleted family ids are not reused.
+	NextFamilyID FamilyID              `protobuf:"varint,15,opt,name=next_family_id,casttype=FamilyID" json:"next_family_id"`
+	State        TableDescriptor_State `protobuf:"varint,16,opt,name=state,enum=cockroach.sql.TableDescriptor_State" json:"state"`
+	// The wall time, in nanoseconds since the epoch, at which the table was
+	// dropped. The data of the table is cleared once the GC TTL of its zone
+	// has elapsed since.
+	DropTime int64 `protobuf:"varint,17,opt,name=drop_time" json:"drop_time"`
+	// The wall time, in nanoseconds since the epoch, at which the statement
+	// populating an OFFLINE table last made progress. The table is removed if
+	// it makes no progress for a while, which means the node populating it
+	// died.
+	OfflineHeartbeat int64 `protobuf:"varint,18,opt,name=offline_heartbeat" json:"offline_heartbeat"`
 }
 
 func (m *TableDescriptor) Reset()         { *m = TableDescriptor{} }
@@ -241,6 +396,48 @@ func (m *TableDescriptor) GetPrivileges() *PrivilegeDescriptor {
 	return nil
 }
 
+func (m *TableDescriptor) GetFormatVersion() FormatVersion {
+	if m != nil {
+		return m.FormatVersion
+	}
+	return 0
+}
+
+func (m *TableDescriptor) GetFamilies() []ColumnFamilyDescriptor {
+	if m != nil {
+		return m.Families
+	}
+	return nil
+}
+
+func (m *TableDescriptor) GetNextFamilyID() FamilyID {
+	if m != nil {
+		return m.NextFamilyID
+	}
+	return 0
+}
+
+func (m *TableDescriptor) GetState() TableDescriptor_State {
+	if m != nil {
+		return m.State
+	}
+	return TableDescriptor_PUBLIC
+}
+
+func (m *TableDescriptor) GetDropTime() int64 {
+	if m != nil {
+		return m.DropTime
+	}
+	return 0
+}
+
+func (m *TableDescriptor) GetOfflineHeartbeat() int64 {
+	if m != nil {
+		return m.OfflineHeartbeat
+	}
+	return 0
+}
+
 // DatabaseDescriptor represents a namespace (aka database) and is stored
 // in a structured metadata key. The DatabaseDescriptor has a globally-unique
 // ID shared with the TableDescriptor ID.
@@ -380,6 +577,8 @@ func _Descriptor_OneofUnmarshaler(msg proto.Message, tag, wire int, b *proto.Buf
 
 func init() {
 	proto.RegisterEnum("cockroach.sql.ColumnType_Kind", ColumnType_Kind_name, ColumnType_Kind_value)
+	proto.RegisterEnum("cockroach.sql.IndexDescriptor_Direction", IndexDescriptor_Direction_name, IndexDescriptor_Direction_value)
+	proto.RegisterEnum("cockroach.sql.TableDescriptor_State", TableDescriptor_State_name, TableDescriptor_State_value)
 }
 func (m *ColumnType) Marshal() (data []byte, err error) {
 	size := m.Size()
@@ -405,6 +604,11 @@ func (m *ColumnType) MarshalTo(data []byte) (int, error) {
 	data[i] = 0x18
 	i++
 	i = encodeVarintStructured(data, i, uint64(m.Precision))
+	if m.ArrayContents != nil {
+		data[i] = 0x20
+		i++
+		i = encodeVarintStructured(data, i, uint64(*m.ArrayContents))
+	}
 	return i, nil
 }
 
@@ -452,6 +656,39 @@ func (m *ColumnDescriptor) MarshalTo(data []byte) (int, error) {
 		i = encodeVarintStructured(data, i, uint64(len(*m.DefaultExpr)))
 		i += copy(data[i:], *m.DefaultExpr)
 	}
+	if m.ComputedExpr != nil {
+		data[i] = 0x32
+		i++
+		i = encodeVarintStructured(data, i, uint64(len(*m.ComputedExpr)))
+		i += copy(data[i:], *m.ComputedExpr)
+	}
+	return i, nil
+}
+
+func (m *InterleaveAncestor) Marshal() (data []byte, err error) {
+	size := m.Size()
+	data = make([]byte, size)
+	n, err := m.MarshalTo(data)
+	if err != nil {
+		return nil, err
+	}
+	return data[:n], nil
+}
+
+func (m *InterleaveAncestor) MarshalTo(data []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	data[i] = 0x8
+	i++
+	i = encodeVarintStructured(data, i, uint64(m.TableID))
+	data[i] = 0x10
+	i++
+	i = encodeVarintStructured(data, i, uint64(m.IndexID))
+	data[i] = 0x18
+	i++
+	i = encodeVarintStructured(data, i, uint64(m.SharedPrefixLen))
 	return i, nil
 }
 
@@ -529,6 +766,85 @@ func (m *IndexDescriptor) MarshalTo(data []byte) (int, error) {
 			i = encodeVarintStructured(data, i, uint64(num))
 		}
 	}
+	if len(m.ColumnDirections) > 0 {
+		for _, num := range m.ColumnDirections {
+			data[i] = 0x40
+			i++
+			i = encodeVarintStructured(data, i, uint64(num))
+		}
+	}
+	if m.Predicate != nil {
+		data[i] = 0x4a
+		i++
+		i = encodeVarintStructured(data, i, uint64(len(*m.Predicate)))
+		i += copy(data[i:], *m.Predicate)
+	}
+	if len(m.Interleave) > 0 {
+		for _, msg := range m.Interleave {
+			data[i] = 0x52
+			i++
+			i = encodeVarintStructured(data, i, uint64(msg.Size()))
+			n, err := msg.MarshalTo(data[i:])
+			if err != nil {
+				return 0, err
+			}
+			i += n
+		}
+	}
+	if len(m.InterleavedBy) > 0 {
+		for _, num := range m.InterleavedBy {
+			data[i] = 0x58
+			i++
+			i = encodeVarintStructured(data, i, uint64(num))
+		}
+	}
+	return i, nil
+}
+
+func (m *ColumnFamilyDescriptor) Marshal() (data []byte, err error) {
+	size := m.Size()
+	data = make([]byte, size)
+	n, err := m.MarshalTo(data)
+	if err != nil {
+		return nil, err
+	}
+	return data[:n], nil
+}
+
+func (m *ColumnFamilyDescriptor) MarshalTo(data []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	data[i] = 0xa
+	i++
+	i = encodeVarintStructured(data, i, uint64(len(m.Name)))
+	i += copy(data[i:], m.Name)
+	data[i] = 0x10
+	i++
+	i = encodeVarintStructured(data, i, uint64(m.ID))
+	if len(m.ColumnNames) > 0 {
+		for _, s := range m.ColumnNames {
+			data[i] = 0x1a
+			i++
+			l = len(s)
+			for l >= 1<<7 {
+				data[i] = uint8(uint64(l)&0x7f | 0x80)
+				l >>= 7
+				i++
+			}
+			data[i] = uint8(l)
+			i++
+			i += copy(data[i:], s)
+		}
+	}
+	if len(m.ColumnIDs) > 0 {
+		for _, num := range m.ColumnIDs {
+			data[i] = 0x20
+			i++
+			i = encodeVarintStructured(data, i, uint64(num))
+		}
+	}
 	return i, nil
 }
 
@@ -620,6 +936,39 @@ func (m *TableDescriptor) MarshalTo(data []byte) (int, error) {
 		}
 		i += n4
 	}
+	data[i] = 0x68
+	i++
+	i = encodeVarintStructured(data, i, uint64(m.FormatVersion))
+	if len(m.Families) > 0 {
+		for _, msg := range m.Families {
+			data[i] = 0x72
+			i++
+			i = encodeVarintStructured(data, i, uint64(msg.Size()))
+			n, err := msg.MarshalTo(data[i:])
+			if err != nil {
+				return 0, err
+			}
+			i += n
+		}
+	}
+	data[i] = 0x78
+	i++
+	i = encodeVarintStructured(data, i, uint64(m.NextFamilyID))
+	data[i] = 0x80
+	i++
+	data[i] = 0x1
+	i++
+	i = encodeVarintStructured(data, i, uint64(m.State))
+	data[i] = 0x88
+	i++
+	data[i] = 0x1
+	i++
+	i = encodeVarintStructured(data, i, uint64(m.DropTime))
+	data[i] = 0x90
+	i++
+	data[i] = 0x1
+	i++
+	i = encodeVarintStructured(data, i, uint64(m.OfflineHeartbeat))
 	return i, nil
 }
 
@@ -744,6 +1093,9 @@ func (m *ColumnType) Size() (n int) {
 	n += 1 + sovStructured(uint64(m.Kind))
 	n += 1 + sovStructured(uint64(m.Width))
 	n += 1 + sovStructured(uint64(m.Precision))
+	if m.ArrayContents != nil {
+		n += 1 + sovStructured(uint64(*m.ArrayContents))
+	}
 	return n
 }
 
@@ -760,6 +1112,19 @@ func (m *ColumnDescriptor) Size() (n int) {
 		l = len(*m.DefaultExpr)
 		n += 1 + l + sovStructured(uint64(l))
 	}
+	if m.ComputedExpr != nil {
+		l = len(*m.ComputedExpr)
+		n += 1 + l + sovStructured(uint64(l))
+	}
+	return n
+}
+
+func (m *InterleaveAncestor) Size() (n int) {
+	var l int
+	_ = l
+	n += 1 + sovStructured(uint64(m.TableID))
+	n += 1 + sovStructured(uint64(m.IndexID))
+	n += 1 + sovStructured(uint64(m.SharedPrefixLen))
 	return n
 }
 
@@ -792,6 +1157,46 @@ func (m *IndexDescriptor) Size() (n int) {
 			n += 1 + sovStructured(uint64(e))
 		}
 	}
+	if len(m.ColumnDirections) > 0 {
+		for _, e := range m.ColumnDirections {
+			n += 1 + sovStructured(uint64(e))
+		}
+	}
+	if m.Predicate != nil {
+		l = len(*m.Predicate)
+		n += 1 + l + sovStructured(uint64(l))
+	}
+	if len(m.Interleave) > 0 {
+		for _, e := range m.Interleave {
+			l = e.Size()
+			n += 1 + l + sovStructured(uint64(l))
+		}
+	}
+	if len(m.InterleavedBy) > 0 {
+		for _, e := range m.InterleavedBy {
+			n += 1 + sovStructured(uint64(e))
+		}
+	}
+	return n
+}
+
+func (m *ColumnFamilyDescriptor) Size() (n int) {
+	var l int
+	_ = l
+	l = len(m.Name)
+	n += 1 + l + sovStructured(uint64(l))
+	n += 1 + sovStructured(uint64(m.ID))
+	if len(m.ColumnNames) > 0 {
+		for _, s := range m.ColumnNames {
+			l = len(s)
+			n += 1 + l + sovStructured(uint64(l))
+		}
+	}
+	if len(m.ColumnIDs) > 0 {
+		for _, e := range m.ColumnIDs {
+			n += 1 + sovStructured(uint64(e))
+		}
+	}
 	return n
 }
 
@@ -827,6 +1232,17 @@ func (m *TableDescriptor) Size() (n int) {
 		l = m.Privileges.Size()
 		n += 1 + l + sovStructured(uint64(l))
 	}
+	n += 1 + sovStructured(uint64(m.FormatVersion))
+	if len(m.Families) > 0 {
+		for _, e := range m.Families {
+			l = e.Size()
+			n += 1 + l + sovStructured(uint64(l))
+		}
+	}
+	n += 1 + sovStructured(uint64(m.NextFamilyID))
+	n += 2 + sovStructured(uint64(m.State))
+	n += 2 + sovStructured(uint64(m.DropTime))
+	n += 2 + sovStructured(uint64(m.OfflineHeartbeat))
 	return n
 }
 
@@ -970,6 +1386,26 @@ func (m *ColumnType) Unmarshal(data []byte) error {
 					break
 				}
 			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ArrayContents", wireType)
+			}
+			var v ColumnType_Kind
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStructured
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				v |= (ColumnType_Kind(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.ArrayContents = &v
 		default:
 			iNdEx = preIndex
 			skippy, err := skipStructured(data[iNdEx:])
@@ -1148,26 +1584,163 @@ func (m *ColumnDescriptor) Unmarshal(data []byte) error {
 			s := string(data[iNdEx:postIndex])
 			m.DefaultExpr = &s
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipStructured(data[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if skippy < 0 {
-				return ErrInvalidLengthStructured
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ComputedExpr", wireType)
 			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStructured
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStructured
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			s := string(data[iNdEx:postIndex])
+			m.ComputedExpr = &s
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipStructured(data[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthStructured
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *InterleaveAncestor) Unmarshal(data []byte) error {
+	l := len(data)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowStructured
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := data[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: InterleaveAncestor: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: InterleaveAncestor: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TableID", wireType)
+			}
+			m.TableID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStructured
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				m.TableID |= (ID(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IndexID", wireType)
+			}
+			m.IndexID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStructured
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				m.IndexID |= (IndexID(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SharedPrefixLen", wireType)
+			}
+			m.SharedPrefixLen = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStructured
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				m.SharedPrefixLen |= (uint32(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipStructured(data[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthStructured
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
 }
 func (m *IndexDescriptor) Unmarshal(data []byte) error {
 	l := len(data)
@@ -1364,6 +1937,254 @@ func (m *IndexDescriptor) Unmarshal(data []byte) error {
 				}
 			}
 			m.ImplicitColumnIDs = append(m.ImplicitColumnIDs, v)
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ColumnDirections", wireType)
+			}
+			var v IndexDescriptor_Direction
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStructured
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				v |= (IndexDescriptor_Direction(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.ColumnDirections = append(m.ColumnDirections, v)
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Predicate", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStructured
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStructured
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			s := string(data[iNdEx:postIndex])
+			m.Predicate = &s
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Interleave", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStructured
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthStructured
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Interleave = append(m.Interleave, InterleaveAncestor{})
+			if err := m.Interleave[len(m.Interleave)-1].Unmarshal(data[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 11:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InterleavedBy", wireType)
+			}
+			var v ID
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStructured
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				v |= (ID(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.InterleavedBy = append(m.InterleavedBy, v)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipStructured(data[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthStructured
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ColumnFamilyDescriptor) Unmarshal(data []byte) error {
+	l := len(data)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowStructured
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := data[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ColumnFamilyDescriptor: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ColumnFamilyDescriptor: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStructured
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStructured
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(data[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ID", wireType)
+			}
+			m.ID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStructured
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				m.ID |= (FamilyID(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ColumnNames", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStructured
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStructured
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ColumnNames = append(m.ColumnNames, string(data[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ColumnIDs", wireType)
+			}
+			var v ColumnID
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStructured
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				v |= (ColumnID(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.ColumnIDs = append(m.ColumnIDs, v)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipStructured(data[iNdEx:])
@@ -1722,6 +2543,132 @@ func (m *TableDescriptor) Unmarshal(data []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 13:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FormatVersion", wireType)
+			}
+			m.FormatVersion = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStructured
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				m.FormatVersion |= (FormatVersion(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 14:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Families", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStructured
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthStructured
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Families = append(m.Families, ColumnFamilyDescriptor{})
+			if err := m.Families[len(m.Families)-1].Unmarshal(data[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 15:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NextFamilyID", wireType)
+			}
+			m.NextFamilyID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStructured
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				m.NextFamilyID |= (FamilyID(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 16:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field State", wireType)
+			}
+			m.State = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStructured
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				m.State |= (TableDescriptor_State(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 17:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DropTime", wireType)
+			}
+			m.DropTime = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStructured
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				m.DropTime |= (int64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 18:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OfflineHeartbeat", wireType)
+			}
+			m.OfflineHeartbeat = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStructured
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				m.OfflineHeartbeat |= (int64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipStructured(data[iNdEx:])
@@ -217,6 +217,19 @@ func TestValidateTableDesc(t *testing.T) {
 				NextColumnID: 3,
 				NextIndexID:  2,
 			}},
+		{`mismatched column IDs (1) and directions (2)`,
+			sql.TableDescriptor{
+				ID:       2,
+				ParentID: 1,
+				Name:     "foo",
+				Columns: []sql.ColumnDescriptor{
+					{ID: 1, Name: "bar"},
+				},
+				PrimaryIndex: sql.IndexDescriptor{ID: 1, Name: "bar", ColumnIDs: []sql.ColumnID{1}, ColumnNames: []string{"bar"},
+					ColumnDirections: []sql.IndexDescriptor_Direction{sql.IndexDescriptor_ASC, sql.IndexDescriptor_DESC}},
+				NextColumnID: 2,
+				NextIndexID:  2,
+			}},
 		{`duplicate index name: "bar"`,
 			sql.TableDescriptor{
 				ID:       2,
@@ -271,6 +284,35 @@ func TestValidateTableDesc(t *testing.T) {
 				NextColumnID: 2,
 				NextIndexID:  2,
 			}},
+		{`index "bar" interleaved prefix (2) longer than its columns (1)`,
+			sql.TableDescriptor{
+				ID:       2,
+				ParentID: 1,
+				Name:     "foo",
+				Columns: []sql.ColumnDescriptor{
+					{ID: 1, Name: "bar"},
+				},
+				PrimaryIndex: sql.IndexDescriptor{ID: 1, Name: "bar", ColumnIDs: []sql.ColumnID{1}, ColumnNames: []string{"bar"},
+					Interleave: []sql.InterleaveAncestor{{TableID: 3, IndexID: 1, SharedPrefixLen: 2}}},
+				NextColumnID: 2,
+				NextIndexID:  2,
+			}},
+		{`secondary index "blah" cannot be interleaved`,
+			sql.TableDescriptor{
+				ID:       2,
+				ParentID: 1,
+				Name:     "foo",
+				Columns: []sql.ColumnDescriptor{
+					{ID: 1, Name: "bar"},
+				},
+				PrimaryIndex: sql.IndexDescriptor{ID: 1, Name: "bar", ColumnIDs: []sql.ColumnID{1}, ColumnNames: []string{"bar"}},
+				Indexes: []sql.IndexDescriptor{
+					{ID: 2, Name: "blah", ColumnIDs: []sql.ColumnID{1}, ColumnNames: []string{"bar"},
+						Interleave: []sql.InterleaveAncestor{{TableID: 3, IndexID: 1, SharedPrefixLen: 1}}},
+				},
+				NextColumnID: 2,
+				NextIndexID:  3,
+			}},
 	}
 	for i, d := range testData {
 		if err := d.desc.Validate(); err == nil {
@@ -23,12 +23,149 @@ import (
 	"github.com/cockroachdb/cockroach/sql/parser"
 )
 
-func (p *planner) expandSubqueries(expr parser.Expr, columns int) (parser.Expr, error) {
+// expandSubqueries replaces the subqueries of an expression with their
+// results. The subqueries can reference the columns of the table scanned by
+// scan, if any. Such correlated subqueries are replaced by an expression
+// running the subquery for each row of the scan.
+func (p *planner) expandSubqueries(expr parser.Expr, columns int, scan *scanNode) (parser.Expr, error) {
+	p.subqueryScopes = append(p.subqueryScopes, &subqueryScope{scan: scan})
+	defer func() {
+		p.subqueryScopes = p.subqueryScopes[:len(p.subqueryScopes)-1]
+	}()
+
 	v := subqueryVisitor{planner: p, columns: columns}
 	expr = parser.WalkExpr(&v, expr)
 	return expr, v.err
 }
 
+// subqueryScope is a query whose subqueries are being planned.
+type subqueryScope struct {
+	// The table scanned by the query, whose columns the subqueries can
+	// reference. Nil if the query does not scan a table.
+	scan *scanNode
+	// Set when a subquery of the query references the columns of this or an
+	// enclosing query.
+	correlated bool
+}
+
+// resolveOuterQName resolves a qualified name which does not refer to a
+// column of the table scanned by a subquery against the tables scanned by the
+// enclosing queries, innermost first. The returned expression evaluates to
+// the value of the column in the current row of the enclosing query. Returns
+// nil if the name can't be resolved.
+func (p *planner) resolveOuterQName(qname *parser.QualifiedName, base parser.Name) parser.Expr {
+	resolvedBase := qname.Base
+	for i := len(p.subqueryScopes) - 1; i >= 0; i-- {
+		scan := p.subqueryScopes[i].scan
+		if scan == nil {
+			continue
+		}
+		qname.Base = base
+		v := qnameVisitor{scanNode: scan}
+		if v.getDesc(qname) == nil {
+			continue
+		}
+		name := qname.Column()
+		for _, col := range scan.visibleCols {
+			if !equalName(name, col.Name) {
+				continue
+			}
+			// The subqueries of this query and of the queries nested in it
+			// between here and the referencing subquery are correlated.
+			for _, s := range p.subqueryScopes[i:] {
+				s.correlated = true
+			}
+			return &outerQValue{scan.getQVal(col)}
+		}
+	}
+	qname.Base = resolvedBase
+	return nil
+}
+
+// outerQValue references a column of an enclosing query from a correlated
+// subquery. It is distinct from qvalue so that the column is not mistaken
+// for a column of the table scanned by the subquery, for example during
+// index selection.
+type outerQValue struct {
+	*qvalue
+}
+
+var _ parser.VariableExpr = &outerQValue{}
+
+// correlatedSubquery is a subquery referencing the columns of an enclosing
+// query. The subquery is planned and run anew each time it is evaluated, that
+// is for each row of the enclosing query.
+type correlatedSubquery struct {
+	planner *planner
+	// The text of the subquery, as planning modifies its syntax tree.
+	sql          string
+	exists       bool
+	multipleRows bool
+	// The enclosing queries and CTEs visible to the subquery.
+	scopes []*subqueryScope
+	ctes   []map[string]*materializedTable
+	// The result of the last evaluation, and the memory it uses.
+	datum parser.Datum
+	acc   *memoryAccount
+}
+
+var _ parser.VariableExpr = &correlatedSubquery{}
+
+func (*correlatedSubquery) Variable() {}
+
+func (s *correlatedSubquery) String() string {
+	if s.exists {
+		return fmt.Sprintf("EXISTS %s", s.sql)
+	}
+	return s.sql
+}
+
+func (*correlatedSubquery) Walk(v parser.Visitor) {}
+
+func (s *correlatedSubquery) TypeCheck() (parser.Datum, error) {
+	if s.exists {
+		return parser.DummyBool, nil
+	}
+	if s.datum == nil {
+		// As for uncorrelated subqueries, the type of the result is unknown
+		// until the subquery is run.
+		return parser.DNull, nil
+	}
+	return s.datum.TypeCheck()
+}
+
+func (s *correlatedSubquery) Eval(ctx parser.EvalContext) (parser.Datum, error) {
+	stmts, err := parser.ParseTraditional(s.sql)
+	if err != nil {
+		return nil, err
+	}
+	p := s.planner
+	scopes, ctes := p.subqueryScopes, p.ctes
+	p.subqueryScopes, p.ctes = s.scopes, s.ctes
+	defer func() {
+		p.subqueryScopes, p.ctes = scopes, ctes
+	}()
+
+	if s.acc == nil {
+		s.acc = p.newMemoryAccount()
+	}
+	// The result of the previous evaluation is replaced.
+	s.acc.clear()
+	// The memory used by the operators of the subquery is released once its
+	// result has been computed.
+	defer p.releaseMemorySince(len(p.memAccounts))
+
+	plan, err := p.makePlan(stmts[0])
+	if err != nil {
+		return nil, err
+	}
+	if s.exists {
+		return existsResult(plan)
+	}
+	s.datum, err = subqueryResult(plan, s.multipleRows, s.acc)
+	return s.datum, err
+}
+
 type subqueryVisitor struct {
 	*planner
 	columns int
@@ -48,18 +185,42 @@ func (v *subqueryVisitor) Visit(expr parser.Expr, pre bool) (parser.Visitor, par
 	}
 	v.path = append(v.path, expr)
 
-	subquery, ok := expr.(*parser.Subquery)
-	if !ok {
+	var subquery *parser.Subquery
+	exists := false
+	switch t := expr.(type) {
+	case *parser.Subquery:
+		subquery = t
+	case *parser.ExistsExpr:
+		subquery = t.Subquery
+		exists = true
+	case *correlatedSubquery:
+		// We will encounter an expanded correlated subquery during retry of an
+		// auto-transaction. It is expanded again to hook it up to the scanNodes
+		// of the new plan.
+		stmts, err := parser.ParseTraditional(t.sql)
+		if err != nil {
+			v.err = err
+			return nil, expr
+		}
+		subquery = &parser.Subquery{Select: stmts[0].(parser.SelectStatement)}
+		exists = t.exists
+	default:
 		return v, expr
 	}
 
+	// Planning the subquery modifies its syntax tree, so its text is saved
+	// beforehand in case it needs to be planned again.
+	sql := subquery.String()
+	scope := v.subqueryScopes[len(v.subqueryScopes)-1]
+	scope.correlated = false
+
 	var plan planNode
 	if plan, v.err = v.makePlan(subquery.Select); v.err != nil {
 		return nil, expr
 	}
 
 	columns, multipleRows := v.getSubqueryContext()
-	if n := len(plan.Columns()); columns != n {
+	if n := len(plan.Columns()); !exists && columns != n {
 		switch columns {
 		case 1:
 			v.err = fmt.Errorf("subquery must return only one column, found %d", n)
@@ -69,11 +230,51 @@ func (v *subqueryVisitor) Visit(expr parser.Expr, pre bool) (parser.Visitor, par
 		return nil, expr
 	}
 
-	var result parser.Expr
+	if scope.correlated {
+		return v, &correlatedSubquery{
+			planner:      v.planner,
+			sql:          sql,
+			exists:       exists,
+			multipleRows: multipleRows,
+			scopes:       append([]*subqueryScope(nil), v.subqueryScopes...),
+			ctes:         append([]map[string]*materializedTable(nil), v.ctes...),
+		}
+	}
+
+	var result parser.Datum
+	if exists {
+		result, v.err = existsResult(plan)
+	} else {
+		result, v.err = subqueryResult(plan, multipleRows, v.newMemoryAccount())
+	}
+	if v.err != nil {
+		return nil, expr
+	}
+	return v, result
+}
+
+// existsResult runs the plan of an EXISTS subquery and returns whether it
+// produces any rows.
+func existsResult(plan planNode) (parser.Datum, error) {
+	exists := plan.Next()
+	if err := plan.Err(); err != nil {
+		return nil, err
+	}
+	return parser.DBool(exists), nil
+}
+
+// subqueryResult runs the plan of a subquery and returns its result: a tuple
+// of the rows if the subquery can return multiple rows, and otherwise its
+// single row or NULL if it returns no rows. The rows of the result are charged
+// to the memory account.
+func subqueryResult(plan planNode, multipleRows bool, acc *memoryAccount) (parser.Datum, error) {
 	if multipleRows {
 		var rows parser.DTuple
 		for plan.Next() {
 			values := plan.Values()
+			if err := acc.grow(rowSize(values)); err != nil {
+				return nil, err
+			}
 			switch len(values) {
 			case 1:
 				// This seems hokey, but if we don't do this then the subquery expands
@@ -89,32 +290,32 @@ func (v *subqueryVisitor) Visit(expr parser.Expr, pre bool) (parser.Visitor, par
 				rows = append(rows, valuesCopy)
 			}
 		}
-		rows.Normalize()
-		result = rows
-	} else {
-		result = parser.DNull
-		for plan.Next() {
-			values := plan.Values()
-			switch len(values) {
-			case 1:
-				result = values[0]
-			default:
-				valuesCopy := make(parser.DTuple, len(values))
-				copy(valuesCopy, values)
-				result = valuesCopy
-			}
-			if plan.Next() {
-				v.err = fmt.Errorf("more than one row returned by a subquery used as an expression")
-				return nil, expr
-			}
+		if err := plan.Err(); err != nil {
+			return nil, err
 		}
+		rows.Normalize()
+		return rows, nil
 	}
 
-	v.err = plan.Err()
-	if v.err != nil {
-		return nil, expr
+	var result parser.Datum = parser.DNull
+	for plan.Next() {
+		values := plan.Values()
+		switch len(values) {
+		case 1:
+			result = values[0]
+		default:
+			valuesCopy := make(parser.DTuple, len(values))
+			copy(valuesCopy, values)
+			result = valuesCopy
+		}
+		if plan.Next() {
+			return nil, fmt.Errorf("more than one row returned by a subquery used as an expression")
+		}
 	}
-	return v, result
+	if err := plan.Err(); err != nil {
+		return nil, err
+	}
+	return result, nil
 }
 
 // getSubqueryContext returns the number of columns and rows the subquery is
@@ -27,6 +27,7 @@ import (
 	"github.com/cockroachdb/cockroach/sql/parser"
 	"github.com/cockroachdb/cockroach/util"
 	"github.com/cockroachdb/cockroach/util/encoding"
+	"github.com/cockroachdb/cockroach/util/uuid"
 	"github.com/gogo/protobuf/proto"
 )
 
@@ -68,9 +69,10 @@ func makeTableDesc(p *parser.CreateTable, parentID ID) (TableDescriptor, error)
 		case *parser.IndexTableDef:
 			idx := IndexDescriptor{
 				Name:             string(d.Name),
-				ColumnNames:      d.Columns,
 				StoreColumnNames: d.Storing,
 			}
+			idx.fillColumns(d.Columns)
+			idx.setPredicate(d.Predicate)
 			if err := desc.AddIndex(idx, false); err != nil {
 				return desc, err
 			}
@@ -78,12 +80,18 @@ func makeTableDesc(p *parser.CreateTable, parentID ID) (TableDescriptor, error)
 			idx := IndexDescriptor{
 				Name:             string(d.Name),
 				Unique:           true,
-				ColumnNames:      d.Columns,
 				StoreColumnNames: d.Storing,
 			}
+			idx.fillColumns(d.Columns)
+			idx.setPredicate(d.Predicate)
 			if err := desc.AddIndex(idx, d.PrimaryKey); err != nil {
 				return desc, err
 			}
+		case *parser.FamilyTableDef:
+			desc.Families = append(desc.Families, ColumnFamilyDescriptor{
+				Name:        string(d.Name),
+				ColumnNames: d.Columns,
+			})
 		default:
 			return desc, util.Errorf("unsupported table def: %T", def)
 		}
@@ -130,36 +138,104 @@ func makeColumnDefDescs(d *parser.ColumnTableDef) (*ColumnDescriptor, *IndexDesc
 	case *parser.BytesType:
 		col.Type.Kind = ColumnType_BYTES
 		colDatumType = parser.DummyBytes
+	case *parser.JSONType:
+		col.Type.Kind = ColumnType_JSON
+		colDatumType = parser.DummyJSON
+	case *parser.UUIDType:
+		col.Type.Kind = ColumnType_UUID
+		colDatumType = parser.DummyUUID
+	case *parser.ArrayType:
+		elem, _, err := makeColumnDefDescs(&parser.ColumnTableDef{Name: d.Name, Type: t.ElemType})
+		if err != nil {
+			return nil, nil, err
+		}
+		if elem.Type.Kind == ColumnType_DECIMAL || elem.Type.Kind == ColumnType_JSON {
+			return nil, nil, fmt.Errorf("arrays of type %s are not supported", t.ElemType)
+		}
+		col.Type.Kind = ColumnType_ARRAY
+		col.Type.ArrayContents = &elem.Type.Kind
+		if colDatumType, err = makeValType(col.Type); err != nil {
+			return nil, nil, err
+		}
 	default:
 		return nil, nil, util.Errorf("unexpected type %T", t)
 	}
 
 	if d.DefaultExpr != nil {
-		// Verify the default expression type is compatible with the column type.
-		defaultType, err := d.DefaultExpr.TypeCheck()
-		if err != nil {
+		if err := col.setDefaultExpr(d.DefaultExpr, colDatumType); err != nil {
 			return nil, nil, err
 		}
-		if colDatumType != defaultType {
-			return nil, nil, fmt.Errorf("incompatible column type and default expression: %s vs %s",
-				col.Type.Kind, defaultType.Type())
-		}
+	}
 
-		s := d.DefaultExpr.String()
-		col.DefaultExpr = &s
+	if d.ComputedExpr != nil {
+		if d.DefaultExpr != nil {
+			return nil, nil, fmt.Errorf("computed column %q cannot have a default expression", col.Name)
+		}
+		// The expression refers to the other columns of the table, so it is
+		// checked once the table descriptor is complete (see
+		// validateComputedColumns).
+		s := d.ComputedExpr.String()
+		col.ComputedExpr = &s
 	}
 
 	var idx *IndexDescriptor
 	if d.PrimaryKey || d.Unique {
 		idx = &IndexDescriptor{
-			Unique:      true,
-			ColumnNames: []string{string(d.Name)},
+			Unique:           true,
+			ColumnNames:      []string{string(d.Name)},
+			ColumnDirections: []IndexDescriptor_Direction{IndexDescriptor_ASC},
 		}
 	}
 
 	return col, idx, nil
 }
 
+// fillColumns sets the names and directions of the columns of the index from
+// the columns of an index definition.
+func (desc *IndexDescriptor) fillColumns(elems parser.IndexElemList) {
+	desc.ColumnNames = make([]string, 0, len(elems))
+	desc.ColumnDirections = make([]IndexDescriptor_Direction, 0, len(elems))
+	for _, c := range elems {
+		desc.ColumnNames = append(desc.ColumnNames, string(c.Column))
+		dir := IndexDescriptor_ASC
+		if c.Direction == parser.Descending {
+			dir = IndexDescriptor_DESC
+		}
+		desc.ColumnDirections = append(desc.ColumnDirections, dir)
+	}
+}
+
+// indexElems returns the columns of the index as they would appear in an
+// index definition. Only descending columns have their direction specified.
+func (desc *IndexDescriptor) indexElems() parser.IndexElemList {
+	elems := make(parser.IndexElemList, len(desc.ColumnNames))
+	for i, name := range desc.ColumnNames {
+		elems[i].Column = parser.Name(name)
+		if encodingDirection(desc.ColumnDirections, i) == encoding.Descending {
+			elems[i].Direction = parser.Descending
+		}
+	}
+	return elems
+}
+
+// setDefaultExpr verifies that the type of the default expression is
+// compatible with the column type and sets the default expression of the
+// column.
+func (col *ColumnDescriptor) setDefaultExpr(expr parser.Expr, colDatumType parser.Datum) error {
+	defaultType, err := expr.TypeCheck()
+	if err != nil {
+		return err
+	}
+	if !parser.SameType(colDatumType, defaultType) {
+		return fmt.Errorf("incompatible column type and default expression: %s vs %s",
+			col.Type.Kind, defaultType.Type())
+	}
+
+	s := expr.String()
+	col.DefaultExpr = &s
+	return nil
+}
+
 func (p *planner) getTableDesc(qname *parser.QualifiedName) (*TableDescriptor, error) {
 	if err := qname.NormalizeTableName(p.session.Database); err != nil {
 		return nil, err
@@ -173,6 +249,9 @@ func (p *planner) getTableDesc(qname *parser.QualifiedName) (*TableDescriptor, e
 	if err := p.getDescriptor(tableKey{dbDesc.ID, qname.Table()}, &desc); err != nil {
 		return nil, err
 	}
+	if desc.Offline() {
+		return nil, fmt.Errorf("table %q is being imported or restored", desc.Name)
+	}
 	return &desc, nil
 }
 
@@ -191,6 +270,18 @@ func (p *planner) getTableLease(qname *parser.QualifiedName) (*TableDescriptor,
 		return p.getTableDesc(qname)
 	}
 
+	if qname.Database() == virtualDBName {
+		return getVirtualTableDesc(qname)
+	}
+
+	if p.txn.SystemDBTrigger() {
+		// The transaction has modified descriptors, which are not visible
+		// outside of it until it commits. In particular TRUNCATE gives the
+		// table a new ID, and the cached name and leased descriptor would
+		// still refer to the old, dropped table.
+		return p.getTableDesc(qname)
+	}
+
 	tableID, err := p.getTableID(qname)
 	if err != nil {
 		return nil, err
@@ -204,6 +295,14 @@ func (p *planner) getTableLease(qname *parser.QualifiedName) (*TableDescriptor,
 	if !ok {
 		var err error
 		lease, err = p.leaseMgr.Acquire(p.txn, tableID, 0)
+		if err == errTableDropped {
+			// The cached name of the table might still refer to a table which has
+			// since been dropped or truncated. Look up the current ID.
+			if tableID, err = p.getUncachedTableID(qname); err != nil {
+				return nil, err
+			}
+			lease, err = p.leaseMgr.Acquire(p.txn, tableID, 0)
+		}
 		if err != nil {
 			return nil, err
 		}
@@ -217,9 +316,35 @@ func (p *planner) getTableLease(qname *parser.QualifiedName) (*TableDescriptor,
 // descriptor cache to perform lookups, falling back to the KV store when
 // necessary.
 func (p *planner) getTableID(qname *parser.QualifiedName) (ID, error) {
-	if err := qname.NormalizeTableName(p.session.Database); err != nil {
+	nameKey, err := p.getTableNameKey(qname)
+	if err != nil {
+		return 0, err
+	}
+
+	// Lookup the ID of the table in the cache. The use of the cache might cause
+	// the usage of a recently renamed table, but that's a race that could occur
+	// anyways.
+	if nameVal := p.systemConfig.GetValue(nameKey.Key()); nameVal != nil {
+		id, err := nameVal.GetInt()
+		return ID(id), err
+	}
+	return p.readTableID(nameKey)
+}
+
+// getUncachedTableID retrieves the table ID for the specified table from the
+// KV store.
+func (p *planner) getUncachedTableID(qname *parser.QualifiedName) (ID, error) {
+	nameKey, err := p.getTableNameKey(qname)
+	if err != nil {
 		return 0, err
 	}
+	return p.readTableID(nameKey)
+}
+
+func (p *planner) getTableNameKey(qname *parser.QualifiedName) (tableKey, error) {
+	if err := qname.NormalizeTableName(p.session.Database); err != nil {
+		return tableKey{}, err
+	}
 
 	// Lookup the database in the cache first, falling back to the KV store if it
 	// isn't present. The cache might cause the usage of a recently renamed
@@ -228,19 +353,13 @@ func (p *planner) getTableID(qname *parser.QualifiedName) (ID, error) {
 	if err != nil {
 		dbDesc, err = p.getDatabaseDesc(qname.Database())
 		if err != nil {
-			return 0, err
+			return tableKey{}, err
 		}
 	}
+	return tableKey{dbDesc.ID, qname.Table()}, nil
+}
 
-	// Lookup the ID of the table in the cache. The use of the cache might cause
-	// the usage of a recently renamed table, but that's a race that could occur
-	// anyways.
-	nameKey := tableKey{dbDesc.ID, qname.Table()}
-	if nameVal := p.systemConfig.GetValue(nameKey.Key()); nameVal != nil {
-		id, err := nameVal.GetInt()
-		return ID(id), err
-	}
-
+func (p *planner) readTableID(nameKey tableKey) (ID, error) {
 	gr, err := p.txn.Get(nameKey.Key())
 	if err != nil {
 		return 0, err
@@ -276,13 +395,27 @@ func (p *planner) getTableNames(dbDesc *DatabaseDescriptor) (parser.QualifiedNam
 	return qualifiedNames, nil
 }
 
-func encodeIndexKey(columnIDs []ColumnID, colMap map[ColumnID]int,
-	values []parser.Datum, indexKey []byte) ([]byte, bool, error) {
+// encodingDirection returns the direction in which the i-th column of an index
+// with the specified column directions is encoded. Columns without a
+// direction, such as the implicit columns of an index or the columns of an
+// index created before directions were supported, are ascending.
+func encodingDirection(dirs []IndexDescriptor_Direction, i int) encoding.Direction {
+	if i < len(dirs) && dirs[i] == IndexDescriptor_DESC {
+		return encoding.Descending
+	}
+	return encoding.Ascending
+}
+
+// encodeIndexKey appends the encoded values of the specified columns to
+// indexKey. The columns are encoded in the directions given by dirs (see
+// encodingDirection).
+func encodeIndexKey(columnIDs []ColumnID, dirs []IndexDescriptor_Direction,
+	colMap map[ColumnID]int, values []parser.Datum, indexKey []byte) ([]byte, bool, error) {
 	var key []byte
 	var containsNull bool
 	key = append(key, indexKey...)
 
-	for _, id := range columnIDs {
+	for j, id := range columnIDs {
 		var val parser.Datum
 		if i, ok := colMap[id]; ok {
 			// TODO(pmattis): Need to convert the values[i] value to the type
@@ -297,14 +430,51 @@ func encodeIndexKey(columnIDs []ColumnID, colMap map[ColumnID]int,
 		}
 
 		var err error
-		if key, err = encodeTableKey(key, val); err != nil {
+		if key, err = encodeTableKey(key, val, encodingDirection(dirs, j)); err != nil {
 			return nil, containsNull, err
 		}
 	}
 	return key, containsNull, nil
 }
 
-func encodeTableKey(b []byte, val parser.Datum) ([]byte, error) {
+// encodePrimaryIndexKey returns the key of a row of the table in the primary
+// index, to which the column family or column IDs are appended to form the
+// keys of the row's data. The key of a row of an interleaved table consists of
+// the keys of the rows of its ancestors sharing its leading column values,
+// each followed by the interleaved sentinel, and then the prefix of the table
+// and the values of the remaining primary key columns.
+func encodePrimaryIndexKey(desc *TableDescriptor, colMap map[ColumnID]int,
+	values []parser.Datum) ([]byte, error) {
+	index := &desc.PrimaryIndex
+	if len(index.Interleave) == 0 {
+		key, _, err := encodeIndexKey(index.ColumnIDs, index.ColumnDirections, colMap, values,
+			MakeIndexKeyPrefix(desc.ID, index.ID))
+		return key, err
+	}
+
+	key := append([]byte(nil), keys.TableDataPrefix...)
+	columnIDs, dirs := index.ColumnIDs, index.ColumnDirections
+	for _, ancestor := range index.Interleave {
+		key = encoding.EncodeUvarint(key, uint64(ancestor.TableID))
+		key = encoding.EncodeUvarint(key, uint64(ancestor.IndexID))
+		n := int(ancestor.SharedPrefixLen)
+		var err error
+		if key, _, err = encodeIndexKey(columnIDs[:n], dirs, colMap, values, key); err != nil {
+			return nil, err
+		}
+		columnIDs, dirs = columnIDs[n:], skipDirections(dirs, n)
+		key = encodeInterleavedSentinel(key)
+	}
+	key = encoding.EncodeUvarint(key, uint64(desc.ID))
+	key = encoding.EncodeUvarint(key, uint64(index.ID))
+	key, _, err := encodeIndexKey(columnIDs, dirs, colMap, values, key)
+	return key, err
+}
+
+func encodeTableKey(b []byte, val parser.Datum, dir encoding.Direction) ([]byte, error) {
+	if dir == encoding.Descending {
+		return encodeTableKeyDecreasing(b, val)
+	}
 	if val == parser.DNull {
 		return encoding.EncodeNull(b), nil
 	}
@@ -329,10 +499,76 @@ func encodeTableKey(b []byte, val parser.Datum) ([]byte, error) {
 		return encoding.EncodeTime(b, t.Time), nil
 	case parser.DInterval:
 		return encoding.EncodeVarint(b, int64(t.Duration)), nil
+	case parser.DJSON:
+		return encoding.EncodeString(b, string(t)), nil
+	case parser.DUUID:
+		return encoding.EncodeUUID(b, t[:]), nil
+	case parser.DArray:
+		elems, err := encodeArrayElems(t)
+		if err != nil {
+			return nil, err
+		}
+		return encoding.EncodeBytes(b, elems), nil
+	}
+	return nil, fmt.Errorf("unable to encode table key: %T", val)
+}
+
+// encodeTableKeyDecreasing is like encodeTableKey but uses the decreasing
+// encodings so that the values sort in reverse order. NULL sorts after the
+// other values.
+func encodeTableKeyDecreasing(b []byte, val parser.Datum) ([]byte, error) {
+	if val == parser.DNull {
+		return encoding.EncodeNullDecreasing(b), nil
+	}
+
+	switch t := val.(type) {
+	case parser.DBool:
+		if t {
+			return encoding.EncodeVarintDecreasing(b, 1), nil
+		}
+		return encoding.EncodeVarintDecreasing(b, 0), nil
+	case parser.DInt:
+		return encoding.EncodeVarintDecreasing(b, int64(t)), nil
+	case parser.DFloat:
+		return encoding.EncodeFloatDecreasing(b, float64(t)), nil
+	case parser.DString:
+		return encoding.EncodeStringDecreasing(b, string(t)), nil
+	case parser.DBytes:
+		return encoding.EncodeStringDecreasing(b, string(t)), nil
+	case parser.DDate:
+		return encoding.EncodeVarintDecreasing(b, int64(t)), nil
+	case parser.DTimestamp:
+		return encoding.EncodeTimeDecreasing(b, t.Time), nil
+	case parser.DInterval:
+		return encoding.EncodeVarintDecreasing(b, int64(t.Duration)), nil
+	case parser.DJSON:
+		return encoding.EncodeStringDecreasing(b, string(t)), nil
+	case parser.DUUID:
+		return encoding.EncodeUUIDDecreasing(b, t[:]), nil
+	case parser.DArray:
+		elems, err := encodeArrayElems(t)
+		if err != nil {
+			return nil, err
+		}
+		return encoding.EncodeBytesDecreasing(b, elems), nil
 	}
 	return nil, fmt.Errorf("unable to encode table key: %T", val)
 }
 
+// encodeArrayElems encodes the elements of an array one after the other. The
+// result is encoded as bytes by encodeTableKey, which preserves the order of
+// the arrays.
+func encodeArrayElems(a parser.DArray) ([]byte, error) {
+	var elems []byte
+	for _, elem := range a.Elems {
+		var err error
+		if elems, err = encodeTableKey(elems, elem, encoding.Ascending); err != nil {
+			return nil, err
+		}
+	}
+	return elems, nil
+}
+
 func makeKeyVals(desc *TableDescriptor, columnIDs []ColumnID) ([]parser.Datum, error) {
 	vals := make([]parser.Datum, len(columnIDs))
 	for i, id := range columnIDs {
@@ -340,30 +576,51 @@ func makeKeyVals(desc *TableDescriptor, columnIDs []ColumnID) ([]parser.Datum, e
 		if err != nil {
 			return nil, err
 		}
-		switch col.Type.Kind {
-		case ColumnType_BOOL:
-			vals[i] = parser.DummyBool
-		case ColumnType_INT:
-			vals[i] = parser.DummyInt
-		case ColumnType_FLOAT:
-			vals[i] = parser.DummyFloat
-		case ColumnType_STRING:
-			vals[i] = parser.DummyString
-		case ColumnType_BYTES:
-			vals[i] = parser.DummyBytes
-		case ColumnType_DATE:
-			vals[i] = parser.DummyDate
-		case ColumnType_TIMESTAMP:
-			vals[i] = parser.DummyTimestamp
-		case ColumnType_INTERVAL:
-			vals[i] = parser.DummyInterval
-		default:
-			return nil, util.Errorf("TODO(pmattis): decoded index key: %s", col.Type.Kind)
+		if vals[i], err = makeValType(col.Type); err != nil {
+			return nil, err
 		}
 	}
 	return vals, nil
 }
 
+// makeValType returns a datum of the type corresponding to the column type,
+// for use as the valType parameter of decodeTableKey.
+func makeValType(typ ColumnType) (parser.Datum, error) {
+	switch typ.Kind {
+	case ColumnType_BOOL:
+		return parser.DummyBool, nil
+	case ColumnType_INT:
+		return parser.DummyInt, nil
+	case ColumnType_FLOAT:
+		return parser.DummyFloat, nil
+	case ColumnType_STRING:
+		return parser.DummyString, nil
+	case ColumnType_BYTES:
+		return parser.DummyBytes, nil
+	case ColumnType_DATE:
+		return parser.DummyDate, nil
+	case ColumnType_TIMESTAMP:
+		return parser.DummyTimestamp, nil
+	case ColumnType_INTERVAL:
+		return parser.DummyInterval, nil
+	case ColumnType_JSON:
+		return parser.DummyJSON, nil
+	case ColumnType_UUID:
+		return parser.DummyUUID, nil
+	case ColumnType_ARRAY:
+		if typ.ArrayContents == nil || *typ.ArrayContents == ColumnType_ARRAY {
+			return nil, util.Errorf("invalid array type: %s", typ.SQLString())
+		}
+		elemType, err := makeValType(ColumnType{Kind: *typ.ArrayContents})
+		if err != nil {
+			return nil, err
+		}
+		return parser.DArray{ElemType: elemType}, nil
+	default:
+		return nil, util.Errorf("TODO(pmattis): decoded index key: %s", typ.Kind)
+	}
+}
+
 func decodeIndexKeyPrefix(desc *TableDescriptor, key []byte) (IndexID, []byte, error) {
 	if !bytes.HasPrefix(key, keys.TableDataPrefix) {
 		return 0, nil, util.Errorf("%s: invalid key prefix: %q", desc.Name, key)
@@ -391,17 +648,84 @@ func decodeIndexKeyPrefix(desc *TableDescriptor, key []byte) (IndexID, []byte, e
 // index key are returned which will either be an encoded column ID for the
 // primary key index, the primary key suffix for non-unique secondary indexes
 // or unique secondary indexes containing NULL or empty.
-func decodeIndexKey(desc *TableDescriptor, index IndexDescriptor, valTypes, vals []parser.Datum, key []byte) ([]byte, error) {
-	indexID, remaining, err := decodeIndexKeyPrefix(desc, key)
-	if err != nil {
-		return nil, err
+//
+// The key span of an index can contain the rows of other tables which are
+// interleaved with the table: the rows of its ancestors if the index is
+// interleaved and the rows of the tables interleaved in it. The second result
+// is false for the keys of such rows, whose values are not decoded.
+func decodeIndexKey(desc *TableDescriptor, index IndexDescriptor, valTypes, vals []parser.Datum, key []byte) ([]byte, bool, error) {
+	if len(index.Interleave) == 0 {
+		indexID, remaining, err := decodeIndexKeyPrefix(desc, key)
+		if err != nil {
+			return nil, false, err
+		}
+		if indexID != index.ID {
+			return nil, false, util.Errorf("%s: unexpected index ID: %d != %d", desc.Name, index.ID, indexID)
+		}
+		key = remaining
+	} else {
+		if !bytes.HasPrefix(key, keys.TableDataPrefix) {
+			return nil, false, util.Errorf("%s: invalid key prefix: %q", desc.Name, key)
+		}
+		key = bytes.TrimPrefix(key, keys.TableDataPrefix)
+		dirs := index.ColumnDirections
+		for _, ancestor := range index.Interleave {
+			var tableID, indexID uint64
+			var err error
+			if key, tableID, err = encoding.DecodeUvarint(key); err != nil {
+				return nil, false, err
+			}
+			if key, indexID, err = encoding.DecodeUvarint(key); err != nil {
+				return nil, false, err
+			}
+			if ID(tableID) != ancestor.TableID || IndexID(indexID) != ancestor.IndexID {
+				return nil, false, nil
+			}
+			n := int(ancestor.SharedPrefixLen)
+			if key, err = decodeKeyVals(valTypes[:n], vals[:n], dirs, key); err != nil {
+				return nil, false, err
+			}
+			valTypes, vals, dirs = valTypes[n:], vals[n:], skipDirections(dirs, n)
+			var ok bool
+			if key, ok = decodeInterleavedSentinel(key); !ok {
+				// The key belongs to a row of the ancestor.
+				return nil, false, nil
+			}
+		}
+		var tableID, indexID uint64
+		var err error
+		if key, tableID, err = encoding.DecodeUvarint(key); err != nil {
+			return nil, false, err
+		}
+		if key, indexID, err = encoding.DecodeUvarint(key); err != nil {
+			return nil, false, err
+		}
+		if ID(tableID) != desc.ID || IndexID(indexID) != index.ID {
+			// The key belongs to a row of another table interleaved in the
+			// ancestor.
+			return nil, false, nil
+		}
+		index.ColumnDirections = dirs
 	}
 
-	if indexID != index.ID {
-		return nil, util.Errorf("%s: unexpected index ID: %d != %d", desc.Name, index.ID, indexID)
+	remaining, err := decodeKeyVals(valTypes, vals, index.ColumnDirections, key)
+	if err != nil {
+		return nil, false, err
+	}
+	if _, ok := decodeInterleavedSentinel(remaining); ok {
+		// The key belongs to a row of a table interleaved in the index.
+		return nil, false, nil
 	}
+	return remaining, true, nil
+}
 
-	return decodeKeyVals(valTypes, vals, remaining)
+// skipDirections returns the column directions of the columns following the
+// first n columns of an index. See encodingDirection.
+func skipDirections(dirs []IndexDescriptor_Direction, n int) []IndexDescriptor_Direction {
+	if n < len(dirs) {
+		return dirs[n:]
+	}
+	return nil
 }
 
 // decodeKeyVals decodes the values that are part of the key. ValTypes is a
@@ -409,12 +733,14 @@ func decodeIndexKey(desc *TableDescriptor, index IndexDescriptor, valTypes, vals
 // parameter while the valTypes parameter is unmodified. Note that len(vals) >=
 // len(valTypes). The types of the decoded values will match the corresponding
 // entry in the valTypes parameter with the exception that a value might also
-// be parser.DNull. The remaining bytes in the key after decoding the values
-// are returned.
-func decodeKeyVals(valTypes, vals []parser.Datum, key []byte) ([]byte, error) {
+// be parser.DNull. The values are decoded in the directions given by dirs
+// (see encodingDirection). The remaining bytes in the key after decoding the
+// values are returned.
+func decodeKeyVals(valTypes, vals []parser.Datum, dirs []IndexDescriptor_Direction,
+	key []byte) ([]byte, error) {
 	for j := range valTypes {
 		var err error
-		vals[j], key, err = decodeTableKey(valTypes[j], key)
+		vals[j], key, err = decodeTableKey(valTypes[j], key, encodingDirection(dirs, j))
 		if err != nil {
 			return nil, err
 		}
@@ -422,12 +748,15 @@ func decodeKeyVals(valTypes, vals []parser.Datum, key []byte) ([]byte, error) {
 	return key, nil
 }
 
-func decodeTableKey(valType parser.Datum, key []byte) (parser.Datum, []byte, error) {
+func decodeTableKey(valType parser.Datum, key []byte, dir encoding.Direction) (parser.Datum, []byte, error) {
 	var isNull bool
 	if key, isNull = encoding.DecodeIfNull(key); isNull {
 		return parser.DNull, key, nil
 	}
-	switch valType.(type) {
+	if dir == encoding.Descending {
+		return decodeTableKeyDecreasing(valType, key)
+	}
+	switch vt := valType.(type) {
 	case parser.DBool:
 		rkey, i, err := encoding.DecodeVarint(key)
 		return parser.DBool(i != 0), rkey, err
@@ -452,11 +781,85 @@ func decodeTableKey(valType parser.Datum, key []byte) (parser.Datum, []byte, err
 	case parser.DInterval:
 		rkey, d, err := encoding.DecodeVarint(key)
 		return parser.DInterval{Duration: time.Duration(d)}, rkey, err
+	case parser.DJSON:
+		rkey, r, err := encoding.DecodeString(key, nil)
+		return parser.DJSON(r), rkey, err
+	case parser.DUUID:
+		rkey, u, err := encoding.DecodeUUID(key)
+		return parser.MakeDUUID(u), rkey, err
+	case parser.DArray:
+		rkey, elems, err := encoding.DecodeBytes(key, nil)
+		if err != nil {
+			return nil, nil, err
+		}
+		a, err := decodeArrayElems(vt.ElemType, elems)
+		return a, rkey, err
 	default:
 		return nil, nil, util.Errorf("TODO(pmattis): decoded index key: %s", valType.Type())
 	}
 }
 
+// decodeTableKeyDecreasing decodes a non-NULL value encoded by
+// encodeTableKeyDecreasing.
+func decodeTableKeyDecreasing(valType parser.Datum, key []byte) (parser.Datum, []byte, error) {
+	switch vt := valType.(type) {
+	case parser.DBool:
+		rkey, i, err := encoding.DecodeVarintDecreasing(key)
+		return parser.DBool(i != 0), rkey, err
+	case parser.DInt:
+		rkey, i, err := encoding.DecodeVarintDecreasing(key)
+		return parser.DInt(i), rkey, err
+	case parser.DFloat:
+		rkey, f, err := encoding.DecodeFloatDecreasing(key, nil)
+		return parser.DFloat(f), rkey, err
+	case parser.DString:
+		rkey, r, err := encoding.DecodeStringDecreasing(key, nil)
+		return parser.DString(r), rkey, err
+	case parser.DBytes:
+		rkey, r, err := encoding.DecodeStringDecreasing(key, nil)
+		return parser.DBytes(r), rkey, err
+	case parser.DDate:
+		rkey, t, err := encoding.DecodeVarintDecreasing(key)
+		return parser.DDate(t), rkey, err
+	case parser.DTimestamp:
+		rkey, t, err := encoding.DecodeTimeDecreasing(key)
+		return parser.DTimestamp{Time: t}, rkey, err
+	case parser.DInterval:
+		rkey, d, err := encoding.DecodeVarintDecreasing(key)
+		return parser.DInterval{Duration: time.Duration(d)}, rkey, err
+	case parser.DJSON:
+		rkey, r, err := encoding.DecodeStringDecreasing(key, nil)
+		return parser.DJSON(r), rkey, err
+	case parser.DUUID:
+		rkey, u, err := encoding.DecodeUUIDDecreasing(key)
+		return parser.MakeDUUID(u), rkey, err
+	case parser.DArray:
+		rkey, elems, err := encoding.DecodeBytesDecreasing(key, nil)
+		if err != nil {
+			return nil, nil, err
+		}
+		a, err := decodeArrayElems(vt.ElemType, elems)
+		return a, rkey, err
+	default:
+		return nil, nil, util.Errorf("unsupported descending index key type: %s", valType.Type())
+	}
+}
+
+// decodeArrayElems decodes the elements of an array encoded by
+// encodeTableKey.
+func decodeArrayElems(elemType parser.Datum, elems []byte) (parser.DArray, error) {
+	a := parser.DArray{ElemType: elemType, Elems: parser.DTuple{}}
+	for len(elems) > 0 {
+		var elem parser.Datum
+		var err error
+		if elem, elems, err = decodeTableKey(elemType, elems, encoding.Ascending); err != nil {
+			return parser.DArray{}, err
+		}
+		a.Elems = append(a.Elems, elem)
+	}
+	return a, nil
+}
+
 type indexEntry struct {
 	key   []byte
 	value []byte
@@ -467,13 +870,13 @@ func encodeSecondaryIndexes(tableID ID, indexes []IndexDescriptor,
 	var secondaryIndexEntries []indexEntry
 	for _, secondaryIndex := range indexes {
 		secondaryIndexKeyPrefix := MakeIndexKeyPrefix(tableID, secondaryIndex.ID)
-		secondaryIndexKey, containsNull, err := encodeIndexKey(
-			secondaryIndex.ColumnIDs, colMap, values, secondaryIndexKeyPrefix)
+		secondaryIndexKey, containsNull, err := encodeIndexKey(secondaryIndex.ColumnIDs,
+			secondaryIndex.ColumnDirections, colMap, values, secondaryIndexKeyPrefix)
 		if err != nil {
 			return nil, err
 		}
 
-		extraKey, _, err := encodeIndexKey(secondaryIndex.ImplicitColumnIDs, colMap, values, nil)
+		extraKey, _, err := encodeIndexKey(secondaryIndex.ImplicitColumnIDs, nil, colMap, values, nil)
 		if err != nil {
 			return nil, err
 		}
@@ -500,6 +903,84 @@ func encodeSecondaryIndexes(tableID ID, indexes []IndexDescriptor,
 	return secondaryIndexEntries, nil
 }
 
+// encodeFamilies returns the key-value pairs storing the non-primary key
+// columns of a row of a table using FamilyFormatVersion, one per column
+// family. The value of a family is the sequence of its non-NULL columns, each
+// encoded as the column ID followed by the length-prefixed key encoding of the
+// column value. The length prefix allows readers to skip the columns they do
+// not know about. The value of a family containing only NULL columns is nil,
+// except for family 0 which is always written as it marks the existence of
+// the row.
+func encodeFamilies(families []ColumnFamilyDescriptor, primaryIndexKey []byte,
+	colMap map[ColumnID]int, values []parser.Datum) ([]indexEntry, error) {
+	entries := make([]indexEntry, 0, len(families))
+	for _, family := range families {
+		var value []byte
+		if family.ID == 0 {
+			value = []byte{}
+		}
+		for _, id := range family.ColumnIDs {
+			i, ok := colMap[id]
+			if !ok || values[i] == parser.DNull {
+				continue
+			}
+			encoded, err := encodeTableKey(nil, values[i], encoding.Ascending)
+			if err != nil {
+				return nil, err
+			}
+			value = encoding.EncodeUvarint(value, uint64(id))
+			value = encoding.EncodeUvarint(value, uint64(len(encoded)))
+			value = append(value, encoded...)
+		}
+		entries = append(entries, indexEntry{
+			key:   MakeFamilyKey(family.ID, primaryIndexKey),
+			value: value,
+		})
+	}
+	return entries, nil
+}
+
+// decodeFamilyValue calls fn with the ID and the encoded value of each column
+// stored in a column family value. See encodeFamilies.
+func decodeFamilyValue(value []byte, fn func(colID ColumnID, encoded []byte) error) error {
+	for len(value) > 0 {
+		var colID, length uint64
+		var err error
+		if value, colID, err = encoding.DecodeUvarint(value); err != nil {
+			return err
+		}
+		if value, length, err = encoding.DecodeUvarint(value); err != nil {
+			return err
+		}
+		if uint64(len(value)) < length {
+			return util.Errorf("invalid family value: column %d has length %d, but only %d bytes remain",
+				colID, length, len(value))
+		}
+		if err := fn(ColumnID(colID), value[:length]); err != nil {
+			return err
+		}
+		value = value[length:]
+	}
+	return nil
+}
+
+// decodeFamilyColumn decodes a column value stored in a column family value
+// using the type expected by the column.
+func decodeFamilyColumn(typ ColumnType, encoded []byte) (parser.Datum, error) {
+	valType, err := makeValType(typ)
+	if err != nil {
+		return nil, err
+	}
+	d, remaining, err := decodeTableKey(valType, encoded, encoding.Ascending)
+	if err != nil {
+		return nil, err
+	}
+	if len(remaining) > 0 {
+		return nil, util.Errorf("%d trailing bytes in encoded %s value", len(remaining), typ.Kind)
+	}
+	return d, nil
+}
+
 // marshalColumnValue returns a Go primitive value equivalent of val, of the
 // type expected by col. If val's type is incompatible with col, or if
 // col's type is not yet implemented, an error is returned.
@@ -541,6 +1022,24 @@ func marshalColumnValue(col ColumnDescriptor, val parser.Datum) (interface{}, er
 		if v, ok := val.(parser.DInterval); ok {
 			return v.Duration, nil
 		}
+	case ColumnType_JSON:
+		if v, ok := val.(parser.DJSON); ok {
+			return string(v), nil
+		}
+	case ColumnType_UUID:
+		if v, ok := val.(parser.DUUID); ok {
+			return v[:], nil
+		}
+	case ColumnType_ARRAY:
+		if v, ok := val.(parser.DArray); ok {
+			colType, err := makeValType(col.Type)
+			if err != nil {
+				return nil, err
+			}
+			if parser.SameType(colType, v) {
+				return encodeTableKey(nil, v, encoding.Ascending)
+			}
+		}
 	default:
 		return nil, util.Errorf("unsupported column type: %s", col.Type.Kind)
 	}
@@ -551,12 +1050,12 @@ func marshalColumnValue(col ColumnDescriptor, val parser.Datum) (interface{}, er
 // unmarshalColumnValue decodes the value from a key-value pair using the type
 // expected by the column. An error is returned if the value's type does not
 // match the column's type.
-func unmarshalColumnValue(kind ColumnType_Kind, value *roachpb.Value) (parser.Datum, error) {
+func unmarshalColumnValue(typ ColumnType, value *roachpb.Value) (parser.Datum, error) {
 	if value == nil {
 		return parser.DNull, nil
 	}
 
-	switch kind {
+	switch typ.Kind {
 	case ColumnType_BOOL:
 		v, err := value.GetInt()
 		if err != nil {
@@ -605,7 +1104,29 @@ func unmarshalColumnValue(kind ColumnType_Kind, value *roachpb.Value) (parser.Da
 			return nil, err
 		}
 		return parser.DInterval{Duration: time.Duration(v)}, nil
+	case ColumnType_JSON:
+		v, err := value.GetBytes()
+		if err != nil {
+			return nil, err
+		}
+		return parser.DJSON(v), nil
+	case ColumnType_UUID:
+		v, err := value.GetBytes()
+		if err != nil {
+			return nil, err
+		}
+		u, err := uuid.FromBytes(v)
+		if err != nil {
+			return nil, err
+		}
+		return parser.MakeDUUID(u), nil
+	case ColumnType_ARRAY:
+		v, err := value.GetBytes()
+		if err != nil {
+			return nil, err
+		}
+		return decodeFamilyColumn(typ, v)
 	default:
-		return nil, util.Errorf("unsupported column type: %s", kind)
+		return nil, util.Errorf("unsupported column type: %s", typ.Kind)
 	}
 }
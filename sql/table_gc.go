@@ -0,0 +1,167 @@
+// Copyright 2015 The Cockroach Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+// implied. See the License for the specific language governing
+// permissions and limitations under the License. See the AUTHORS file
+// for names of contributors.
+
+package sql
+
+import (
+	"bytes"
+	"time"
+
+	"github.com/cockroachdb/cockroach/client"
+	"github.com/cockroachdb/cockroach/config"
+	"github.com/cockroachdb/cockroach/keys"
+	"github.com/cockroachdb/cockroach/roachpb"
+	"github.com/cockroachdb/cockroach/util/log"
+	"github.com/cockroachdb/cockroach/util/stop"
+)
+
+// tableGCInterval is the interval at which the table GC looks for dropped
+// tables whose data can be cleared. The table GC also looks for them whenever
+// the system config changes.
+const tableGCInterval = 5 * time.Minute
+
+// offlineTableTimeout is the time after which the table GC drops an OFFLINE
+// table whose IMPORT or RESTORE has stopped making progress, which means the
+// node populating the table died.
+const offlineTableTimeout = time.Hour
+
+// StartTableGC starts the table GC, which clears the data of the dropped
+// tables once the GC TTL of their zone has elapsed and then removes their
+// descriptor and zone config. The table GC also drops the OFFLINE tables
+// left behind by an IMPORT or RESTORE which did not complete.
+//
+// Every node runs the table GC: clearing the data of a table is idempotent,
+// so nodes racing to clear the same table do no harm.
+func (e *Executor) StartTableGC(stopper *stop.Stopper) {
+	stopper.RunWorker(func() {
+		ticker := time.NewTicker(tableGCInterval)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-ticker.C:
+			case <-e.systemConfigChanged:
+			case <-stopper.ShouldStop():
+				return
+			}
+			stopper.RunTask(func() {
+				if err := e.gcDroppedTables(); err != nil {
+					log.Warning(err)
+				}
+			})
+		}
+	})
+}
+
+// gcDroppedTables clears the dropped tables whose GC TTL has elapsed.
+func (e *Executor) gcDroppedTables() error {
+	cfg := e.getSystemConfig()
+	if cfg == nil {
+		return nil
+	}
+	tables, err := getDroppedTables(cfg)
+	if err != nil {
+		return err
+	}
+	now := e.clock.Now().WallTime
+	for _, table := range tables {
+		if table.Offline() {
+			if now < table.OfflineHeartbeat+int64(offlineTableTimeout) {
+				continue
+			}
+			if err := e.dropOfflineTable(table, now); err != nil {
+				return err
+			}
+			continue
+		}
+		tablePrefix := roachpb.Key(keys.MakeTablePrefix(uint32(table.ID)))
+		zone, err := cfg.GetZoneConfigForKey(roachpb.RKey(tablePrefix))
+		if err != nil {
+			return err
+		}
+		gc := zone.GC
+		if gc == nil {
+			gc = config.DefaultZoneConfig.GC
+		}
+		if now < table.DropTime+int64(gc.TTLSeconds)*int64(time.Second) {
+			continue
+		}
+		if log.V(2) {
+			log.Infof("clearing dropped table %d: %s - %s", table.ID,
+				prettyKey(tablePrefix, 0), prettyKey(tablePrefix.PrefixEnd(), 0))
+		}
+		if err := e.db.ClearRange(tablePrefix, tablePrefix.PrefixEnd()); err != nil {
+			return err
+		}
+		if err := e.db.Txn(func(txn *client.Txn) error {
+			b := &client.Batch{}
+			b.Del(MakeDescMetadataKey(table.ID))
+			b.Del(makeZoneRowKey(table.ID))
+			b.Del(MakeZoneKey(table.ID))
+			txn.SetSystemDBTrigger()
+			return txn.CommitInBatch(b)
+		}); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// dropOfflineTable drops an OFFLINE table which has stopped making progress.
+// The table loses its name and its data is cleared like the data of any
+// other dropped table. Nothing is done if the table has made progress or
+// has been made public in the meantime.
+func (e *Executor) dropOfflineTable(table *TableDescriptor, now int64) error {
+	return e.db.Txn(func(txn *client.Txn) error {
+		descKey := MakeDescMetadataKey(table.ID)
+		desc := &Descriptor{}
+		if err := txn.GetProto(descKey, desc); err != nil {
+			return err
+		}
+		current := desc.GetTable()
+		if current == nil || !current.Offline() || current.OfflineHeartbeat != table.OfflineHeartbeat {
+			return nil
+		}
+		if log.V(2) {
+			log.Infof("dropping offline table %d", table.ID)
+		}
+		current.State = TableDescriptor_DROP
+		current.DropTime = now
+		b := &client.Batch{}
+		b.Del(tableKey{current.ParentID, current.Name}.Key())
+		b.Put(descKey, desc)
+		txn.SetSystemDBTrigger()
+		return txn.CommitInBatch(b)
+	})
+}
+
+// getDroppedTables returns the descriptors of the dropped and of the OFFLINE
+// tables in the system config.
+func getDroppedTables(cfg *config.SystemConfig) ([]*TableDescriptor, error) {
+	descPrefix := MakeIndexKeyPrefix(DescriptorTable.ID, DescriptorTable.PrimaryIndex.ID)
+	var tables []*TableDescriptor
+	for _, kv := range cfg.Values {
+		if !bytes.HasPrefix(kv.Key, descPrefix) {
+			continue
+		}
+		desc := &Descriptor{}
+		if err := kv.Value.GetProto(desc); err != nil {
+			return nil, err
+		}
+		if table := desc.GetTable(); table != nil && (table.Dropped() || table.Offline()) {
+			tables = append(tables, table)
+		}
+	}
+	return tables, nil
+}
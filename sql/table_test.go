@@ -133,60 +133,83 @@ func TestMakeTableDescIndexes(t *testing.T) {
 		{
 			"a INT PRIMARY KEY",
 			IndexDescriptor{
-				Name:        PrimaryKeyIndexName,
-				Unique:      true,
-				ColumnNames: []string{"a"},
+				Name:             PrimaryKeyIndexName,
+				Unique:           true,
+				ColumnNames:      []string{"a"},
+				ColumnDirections: []IndexDescriptor_Direction{IndexDescriptor_ASC},
 			},
 			[]IndexDescriptor{},
 		},
 		{
 			"a INT UNIQUE, b INT PRIMARY KEY",
 			IndexDescriptor{
-				Name:        "primary",
-				Unique:      true,
-				ColumnNames: []string{"b"},
+				Name:             "primary",
+				Unique:           true,
+				ColumnNames:      []string{"b"},
+				ColumnDirections: []IndexDescriptor_Direction{IndexDescriptor_ASC},
 			},
 			[]IndexDescriptor{
 				{
-					Name:        "",
-					Unique:      true,
-					ColumnNames: []string{"a"},
+					Name:             "",
+					Unique:           true,
+					ColumnNames:      []string{"a"},
+					ColumnDirections: []IndexDescriptor_Direction{IndexDescriptor_ASC},
 				},
 			},
 		},
 		{
 			"a INT, b INT, CONSTRAINT c PRIMARY KEY (a, b)",
 			IndexDescriptor{
-				Name:        "c",
-				Unique:      true,
-				ColumnNames: []string{"a", "b"},
+				Name:             "c",
+				Unique:           true,
+				ColumnNames:      []string{"a", "b"},
+				ColumnDirections: []IndexDescriptor_Direction{IndexDescriptor_ASC, IndexDescriptor_ASC},
 			},
 			[]IndexDescriptor{},
 		},
 		{
 			"a INT, b INT, CONSTRAINT c UNIQUE (b), PRIMARY KEY (a, b)",
 			IndexDescriptor{
-				Name:        "primary",
-				Unique:      true,
-				ColumnNames: []string{"a", "b"},
+				Name:             "primary",
+				Unique:           true,
+				ColumnNames:      []string{"a", "b"},
+				ColumnDirections: []IndexDescriptor_Direction{IndexDescriptor_ASC, IndexDescriptor_ASC},
 			},
 			[]IndexDescriptor{
 				{
-					Name:        "c",
-					Unique:      true,
-					ColumnNames: []string{"b"},
+					Name:             "c",
+					Unique:           true,
+					ColumnNames:      []string{"b"},
+					ColumnDirections: []IndexDescriptor_Direction{IndexDescriptor_ASC},
 				},
 			},
 		},
 		{
 			"a INT, b INT, PRIMARY KEY (a, b)",
 			IndexDescriptor{
-				Name:        PrimaryKeyIndexName,
-				Unique:      true,
-				ColumnNames: []string{"a", "b"},
+				Name:             PrimaryKeyIndexName,
+				Unique:           true,
+				ColumnNames:      []string{"a", "b"},
+				ColumnDirections: []IndexDescriptor_Direction{IndexDescriptor_ASC, IndexDescriptor_ASC},
 			},
 			[]IndexDescriptor{},
 		},
+		{
+			"a INT, b INT, PRIMARY KEY (a DESC, b), INDEX c (b DESC)",
+			IndexDescriptor{
+				Name:             PrimaryKeyIndexName,
+				Unique:           true,
+				ColumnNames:      []string{"a", "b"},
+				ColumnDirections: []IndexDescriptor_Direction{IndexDescriptor_DESC, IndexDescriptor_ASC},
+			},
+			[]IndexDescriptor{
+				{
+					Name:             "c",
+					ColumnNames:      []string{"b"},
+					ColumnDirections: []IndexDescriptor_Direction{IndexDescriptor_DESC},
+				},
+			},
+		},
 	}
 	for i, d := range testData {
 		stmt, err := parser.ParseTraditional("CREATE TABLE foo.test (" + d.sql + ")")
@@ -20,20 +20,27 @@ package sql
 import (
 	"github.com/cockroachdb/cockroach/client"
 	"github.com/cockroachdb/cockroach/keys"
-	"github.com/cockroachdb/cockroach/roachpb"
 	"github.com/cockroachdb/cockroach/sql/parser"
 	"github.com/cockroachdb/cockroach/sql/privilege"
-	"github.com/cockroachdb/cockroach/util/log"
+	"github.com/gogo/protobuf/proto"
 )
 
-// Truncate deletes all rows from a table.
+// Truncate deletes all rows from a table. Rather than deleting the rows one by
+// one, the table is recreated empty under a new ID and the old table is
+// dropped, leaving its data to be cleared by the table GC.
 // Privileges: DROP on table.
 //   Notes: postgres requires TRUNCATE.
 //          mysql requires DROP (for mysql >= 5.1.16, DELETE before that).
 func (p *planner) Truncate(n *parser.Truncate) (planNode, error) {
+	// Truncating a table rewrites its descriptor.
+	p.txn.SetSystemDBTrigger()
+
 	b := client.Batch{}
 	for _, tableQualifiedName := range n.Tables {
-		tableDesc, err := p.getTableLease(tableQualifiedName)
+		// The descriptor is rewritten, so like DROP TABLE it is read in the
+		// transaction rather than through a lease, which may be stale and
+		// cannot see a table already truncated by the transaction.
+		tableDesc, err := p.getTableDesc(tableQualifiedName)
 		if err != nil {
 			return nil, err
 		}
@@ -42,15 +49,22 @@ func (p *planner) Truncate(n *parser.Truncate) (planNode, error) {
 			return nil, err
 		}
 
-		tablePrefix := keys.MakeTablePrefix(uint32(tableDesc.ID))
+		if len(tableDesc.PrimaryIndex.InterleavedBy) > 0 {
+			return nil, p.errInterleavedBy(tableDesc)
+		}
+		if len(tableDesc.PrimaryIndex.Interleave) > 0 {
+			// The rows of an interleaved table are stored in the key span of
+			// its outermost ancestor, so the table cannot be replaced by an
+			// empty copy.
+			if err := p.deleteInterleavedRows(tableQualifiedName); err != nil {
+				return nil, err
+			}
+			continue
+		}
 
-		// Delete rows and indexes starting with the table's prefix.
-		tableStartKey := roachpb.Key(tablePrefix)
-		tableEndKey := tableStartKey.PrefixEnd()
-		if log.V(2) {
-			log.Infof("DelRange %s - %s", prettyKey(tableStartKey, 0), prettyKey(tableEndKey, 0))
+		if err := p.truncateTable(&b, tableDesc); err != nil {
+			return nil, err
 		}
-		b.DelRange(tableStartKey, tableEndKey)
 	}
 
 	if err := p.txn.Run(&b); err != nil {
@@ -59,3 +73,33 @@ func (p *planner) Truncate(n *parser.Truncate) (planNode, error) {
 
 	return &valuesNode{}, nil
 }
+
+// truncateTable adds to the batch the writes replacing the table with an
+// empty copy under a new ID. The name and zone config of the table move to
+// the copy and the table itself is dropped.
+func (p *planner) truncateTable(b *client.Batch, tableDesc *TableDescriptor) error {
+	newTableDesc := proto.Clone(tableDesc).(*TableDescriptor)
+	ir, err := p.txn.Inc(keys.DescIDGenerator, 1)
+	if err != nil {
+		return err
+	}
+	newTableDesc.ID = ID(ir.ValueInt() - 1)
+	if err := newTableDesc.Validate(); err != nil {
+		return err
+	}
+
+	b.Put(tableKey{tableDesc.ParentID, tableDesc.Name}.Key(), newTableDesc.ID)
+	b.CPut(MakeDescMetadataKey(newTableDesc.ID), wrapDescriptor(newTableDesc), nil)
+
+	gr, err := p.txn.Get(MakeZoneKey(tableDesc.ID))
+	if err != nil {
+		return err
+	}
+	if gr.Exists() {
+		b.Put(makeZoneRowKey(newTableDesc.ID), nil)
+		b.Put(MakeZoneKey(newTableDesc.ID), gr.ValueBytes())
+	}
+
+	p.dropTableDesc(b, tableDesc)
+	return nil
+}
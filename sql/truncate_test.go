@@ -0,0 +1,96 @@
+// Copyright 2015 The Cockroach Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+// implied. See the License for the specific language governing
+// permissions and limitations under the License. See the AUTHORS file
+// for names of contributors.
+
+package sql_test
+
+import (
+	"testing"
+
+	"github.com/cockroachdb/cockroach/keys"
+	"github.com/cockroachdb/cockroach/roachpb"
+	"github.com/cockroachdb/cockroach/sql"
+	"github.com/cockroachdb/cockroach/util/leaktest"
+)
+
+func TestTruncateTable(t *testing.T) {
+	defer leaktest.AfterTest(t)
+	s, sqlDB, kvDB := setup(t)
+	defer cleanup(s, sqlDB)
+
+	if _, err := sqlDB.Exec(`
+CREATE DATABASE t;
+CREATE TABLE t.kv (k CHAR PRIMARY KEY, v CHAR);
+INSERT INTO t.kv VALUES ('c', 'e'), ('a', 'c'), ('b', 'd');
+`); err != nil {
+		t.Fatal(err)
+	}
+
+	nameKey := sql.MakeNameMetadataKey(keys.MaxReservedDescID+1, "kv")
+	gr, err := kvDB.Get(nameKey)
+	if err != nil {
+		t.Fatal(err)
+	}
+	oldID := sql.ID(gr.ValueInt())
+	setZoneGCTTL(t, s.DB(), oldID, 60)
+
+	if _, err := sqlDB.Exec(`TRUNCATE TABLE t.kv`); err != nil {
+		t.Fatal(err)
+	}
+
+	// The name of the table refers to an empty copy of the table, which
+	// inherits the zone config of the table.
+	if gr, err = kvDB.Get(nameKey); err != nil {
+		t.Fatal(err)
+	}
+	newID := sql.ID(gr.ValueInt())
+	if newID == oldID {
+		t.Fatalf("expected the truncated table to have a new ID, but found %d", newID)
+	}
+	desc := &sql.Descriptor{}
+	if err := kvDB.GetProto(sql.MakeDescMetadataKey(newID), desc); err != nil {
+		t.Fatal(err)
+	}
+	if tableDesc := desc.GetTable(); tableDesc.Name != "kv" || tableDesc.Dropped() {
+		t.Fatalf("unexpected table descriptor: %+v", tableDesc)
+	}
+	if gr, err := kvDB.Get(sql.MakeZoneKey(newID)); err != nil {
+		t.Fatal(err)
+	} else if !gr.Exists() {
+		t.Fatalf("zone config entry not found for the truncated table")
+	}
+
+	var count int
+	if err := sqlDB.QueryRow(`SELECT COUNT(*) FROM t.kv`).Scan(&count); err != nil {
+		t.Fatal(err)
+	} else if count != 0 {
+		t.Fatalf("expected the truncated table to be empty, but found %d rows", count)
+	}
+	if _, err := sqlDB.Exec(`INSERT INTO t.kv VALUES ('a', 'b')`); err != nil {
+		t.Fatal(err)
+	}
+
+	// The old table is dropped and its data cleared once its GC TTL elapses.
+	oldDescKey := sql.MakeDescMetadataKey(oldID)
+	oldTableStartKey := roachpb.Key(keys.MakeTablePrefix(uint32(oldID)))
+	checkTableDropped(t, kvDB, oldDescKey, oldTableStartKey, 3)
+	setZoneGCTTL(t, s.DB(), oldID, 0)
+	waitForTableGC(t, kvDB, oldDescKey, sql.MakeZoneKey(oldID), oldTableStartKey)
+
+	if err := sqlDB.QueryRow(`SELECT COUNT(*) FROM t.kv`).Scan(&count); err != nil {
+		t.Fatal(err)
+	} else if count != 1 {
+		t.Fatalf("expected 1 row, but found %d", count)
+	}
+}
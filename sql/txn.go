@@ -18,6 +18,9 @@
 package sql
 
 import (
+	"strings"
+	"time"
+
 	"github.com/cockroachdb/cockroach/roachpb"
 	"github.com/cockroachdb/cockroach/sql/parser"
 	"github.com/cockroachdb/cockroach/util"
@@ -70,3 +73,72 @@ func (p *planner) setIsolationLevel(level parser.IsolationLevel) error {
 		return util.Errorf("unknown isolation level: %s", level)
 	}
 }
+
+// restartSavepointName is the only savepoint supported. It marks the start of
+// a transaction which the client restarts on retryable errors.
+const restartSavepointName = "cockroach_restart"
+
+func checkRestartSavepoint(name string) error {
+	if !strings.EqualFold(name, restartSavepointName) {
+		return util.Errorf("SAVEPOINT not supported except for %s", restartSavepointName)
+	}
+	return nil
+}
+
+// Savepoint declares the intention of the client to restart the transaction
+// with ROLLBACK TO SAVEPOINT cockroach_restart when it encounters retryable
+// errors. It must precede the other statements of the transaction.
+func (p *planner) Savepoint(n *parser.Savepoint) (planNode, error) {
+	if err := checkRestartSavepoint(n.Name); err != nil {
+		return nil, err
+	}
+	if len(p.txn.Proto.ID) != 0 && !p.retryIntent {
+		return nil, util.Errorf("SAVEPOINT %s needs to be the first statement in a transaction",
+			restartSavepointName)
+	}
+	p.retryIntent = true
+	return &valuesNode{}, nil
+}
+
+// ReleaseSavepoint commits the transaction. A retryable error leaves the
+// transaction open so that it can be restarted. The transaction still needs
+// to be ended with COMMIT.
+func (p *planner) ReleaseSavepoint(n *parser.ReleaseSavepoint) (planNode, error) {
+	if err := checkRestartSavepoint(n.Savepoint); err != nil {
+		return nil, err
+	}
+	if !p.retryIntent {
+		return nil, util.Errorf("savepoint %s does not exist", restartSavepointName)
+	}
+	// The transaction is cleaned up by makeResultFromError unless it is
+	// restarted.
+	return &valuesNode{}, p.txn.CommitNoCleanup()
+}
+
+// RollbackToSavepoint restarts the transaction. The restarted transaction
+// keeps its record and priority; the writes of the previous attempts are
+// discarded.
+func (p *planner) RollbackToSavepoint(n *parser.RollbackToSavepoint) (planNode, error) {
+	if err := checkRestartSavepoint(n.Savepoint); err != nil {
+		return nil, err
+	}
+	if !p.retryIntent {
+		return nil, util.Errorf("savepoint %s does not exist", restartSavepointName)
+	}
+	if !p.restartWait && len(p.txn.Proto.ID) != 0 {
+		// A retryable error already restarted the transaction. Otherwise the
+		// client restarts it voluntarily.
+		p.txn.Proto.Restart(roachpb.Default_Header_UserPriority, p.txn.Proto.Priority,
+			p.txn.Proto.Timestamp)
+	}
+	p.restartWait = false
+	p.setTxn(p.txn, time.Now())
+	return &valuesNode{}, nil
+}
+
+// isRetryableTxnError returns true if the error is a transaction error after
+// which the transaction can be restarted.
+func isRetryableTxnError(err error) bool {
+	restartErr, ok := err.(roachpb.TransactionRestartError)
+	return ok && restartErr.CanRestartTransaction() != roachpb.TransactionRestart_ABORT
+}
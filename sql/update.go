@@ -33,6 +33,12 @@ import (
 //   Notes: postgres requires UPDATE. Requires SELECT with WHERE clause with table.
 //          mysql requires UPDATE. Also requires SELECT with WHERE clause with table.
 func (p *planner) Update(n *parser.Update) (planNode, error) {
+	return p.update(n, false)
+}
+
+// update implements Update. Computed columns can only be written if
+// clearComputed is set, which is used to clear the values of dropped columns.
+func (p *planner) update(n *parser.Update, clearComputed bool) (planNode, error) {
 	tableDesc, err := p.getAliasedTableLease(n.Table)
 	if err != nil {
 		return nil, err
@@ -46,7 +52,7 @@ func (p *planner) Update(n *parser.Update) (planNode, error) {
 	var names parser.QualifiedNames
 	for _, expr := range n.Exprs {
 		var err error
-		expr.Expr, err = p.expandSubqueries(expr.Expr, len(expr.Names))
+		expr.Expr, err = p.expandSubqueries(expr.Expr, len(expr.Names), nil)
 		if err != nil {
 			return nil, err
 		}
@@ -75,12 +81,32 @@ func (p *planner) Update(n *parser.Update) (planNode, error) {
 	if err != nil {
 		return nil, err
 	}
+	if !clearComputed {
+		if err := checkComputedWrites(cols); err != nil {
+			return nil, err
+		}
+	}
 
 	// Set of columns being updated
 	colIDSet := map[ColumnID]struct{}{}
 	for _, c := range cols {
 		colIDSet[c.ID] = struct{}{}
 	}
+
+	// The computed columns which refer to the updated columns are updated
+	// along with them.
+	numUpdateCols := len(cols)
+	computed, err := p.makeComputedColumns(tableDesc, colIDSet)
+	if err != nil {
+		return nil, err
+	}
+	if computed != nil {
+		for _, col := range computed.cols {
+			colIDSet[col.ID] = struct{}{}
+			cols = append(cols, col)
+		}
+	}
+
 	// Don't allow updating any column that is part of the primary key.
 	for i, id := range tableDesc.PrimaryIndex.ColumnIDs {
 		if _, ok := colIDSet[id]; ok {
@@ -88,7 +114,7 @@ func (p *planner) Update(n *parser.Update) (planNode, error) {
 		}
 	}
 
-	defaultExprs, err := p.makeDefaultExprs(cols)
+	defaultExprs, err := p.makeDefaultExprs(cols[:numUpdateCols])
 	if err != nil {
 		return nil, err
 	}
@@ -143,12 +169,21 @@ func (p *planner) Update(n *parser.Update) (planNode, error) {
 		colIDtoRowIndex[col.ID] = i
 	}
 
-	primaryIndex := tableDesc.PrimaryIndex
-	primaryIndexKeyPrefix := MakeIndexKeyPrefix(tableDesc.ID, primaryIndex.ID)
+	// The partial indexes only have entries for the rows matching their
+	// predicates.
+	predicates, err := p.makeIndexPredicates(tableDesc)
+	if err != nil {
+		return nil, err
+	}
 
-	// Secondary indexes needing updating.
+	// Secondary indexes needing updating. A row can enter or leave a partial
+	// index when a column referenced by the predicate is updated.
 	var indexes []IndexDescriptor
 	for _, index := range tableDesc.Indexes {
+		if predicates.references(&index, colIDSet) {
+			indexes = append(indexes, index)
+			continue
+		}
 		for _, id := range index.ColumnIDs {
 			if _, ok := colIDSet[id]; ok {
 				indexes = append(indexes, index)
@@ -156,6 +191,18 @@ func (p *planner) Update(n *parser.Update) (planNode, error) {
 			}
 		}
 	}
+	oldMatches := make([]bool, len(indexes))
+
+	// Column families needing updating.
+	var families []ColumnFamilyDescriptor
+	for _, family := range tableDesc.Families {
+		for _, id := range family.ColumnIDs {
+			if _, ok := colIDSet[id]; ok {
+				families = append(families, family)
+				break
+			}
+		}
+	}
 
 	marshalled := make([]interface{}, len(cols))
 
@@ -165,8 +212,7 @@ func (p *planner) Update(n *parser.Update) (planNode, error) {
 		rowVals := rows.Values()
 		result.rows = append(result.rows, parser.DTuple(nil))
 
-		primaryIndexKey, _, err := encodeIndexKey(
-			primaryIndex.ColumnIDs, colIDtoRowIndex, rowVals, primaryIndexKeyPrefix)
+		primaryIndexKey, err := encodePrimaryIndexKey(tableDesc, colIDtoRowIndex, rowVals)
 		if err != nil {
 			return nil, err
 		}
@@ -176,10 +222,27 @@ func (p *planner) Update(n *parser.Update) (planNode, error) {
 		if err != nil {
 			return nil, err
 		}
+		for i := range indexes {
+			if oldMatches[i], err = predicates.matches(
+				p.evalCtx, &indexes[i], colIDtoRowIndex, rowVals); err != nil {
+				return nil, err
+			}
+		}
 
 		// Our updated value expressions occur immediately after the plain
 		// columns in the output.
 		newVals := rowVals[len(tableDesc.Columns):]
+		if computed != nil {
+			// Compute the values of the computed columns from the updated row.
+			for i, col := range cols[:numUpdateCols] {
+				rowVals[colIDtoRowIndex[col.ID]] = newVals[i]
+			}
+			vals, err := computed.eval(p.evalCtx, colIDtoRowIndex, rowVals)
+			if err != nil {
+				return nil, err
+			}
+			newVals = append(newVals[:numUpdateCols:numUpdateCols], vals...)
+		}
 		// Update the row values.
 		for i, col := range cols {
 			val := newVals[i]
@@ -206,15 +269,26 @@ func (p *planner) Update(n *parser.Update) (planNode, error) {
 			return nil, err
 		}
 
-		// Update secondary indexes.
+		// Update secondary indexes. The entries of the partial indexes are only
+		// present for the old and new rows matching their predicates.
 		for i, newSecondaryIndexEntry := range newSecondaryIndexEntries {
 			secondaryIndexEntry := secondaryIndexEntries[i]
-			if !bytes.Equal(newSecondaryIndexEntry.key, secondaryIndexEntry.key) {
+			oldMatch := oldMatches[i]
+			newMatch, err := predicates.matches(p.evalCtx, &indexes[i], colIDtoRowIndex, rowVals)
+			if err != nil {
+				return nil, err
+			}
+			if oldMatch && newMatch && bytes.Equal(newSecondaryIndexEntry.key, secondaryIndexEntry.key) {
+				continue
+			}
+			if newMatch {
 				if log.V(2) {
 					log.Infof("CPut %s -> %v", prettyKey(newSecondaryIndexEntry.key, 0),
 						newSecondaryIndexEntry.value)
 				}
 				b.CPut(newSecondaryIndexEntry.key, newSecondaryIndexEntry.value, nil)
+			}
+			if oldMatch {
 				if log.V(2) {
 					log.Infof("Del %s", prettyKey(secondaryIndexEntry.key, 0))
 				}
@@ -222,6 +296,29 @@ func (p *planner) Update(n *parser.Update) (planNode, error) {
 			}
 		}
 
+		if tableDesc.FormatVersion == FamilyFormatVersion {
+			// Rewrite the column families containing the updated columns.
+			familyEntries, err := encodeFamilies(families, primaryIndexKey, colIDtoRowIndex, rowVals)
+			if err != nil {
+				return nil, err
+			}
+			for _, familyEntry := range familyEntries {
+				if familyEntry.value != nil {
+					if log.V(2) {
+						log.Infof("Put %s -> %v", prettyKey(familyEntry.key, 0), familyEntry.value)
+					}
+					b.Put(familyEntry.key, familyEntry.value)
+				} else {
+					// All of the columns in the family are NULL, so delete it.
+					if log.V(2) {
+						log.Infof("Del %s", prettyKey(familyEntry.key, 0))
+					}
+					b.Del(familyEntry.key)
+				}
+			}
+			continue
+		}
+
 		// Add the new values.
 		for i, val := range newVals {
 			col := cols[i]
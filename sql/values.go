@@ -38,7 +38,7 @@ func (p *planner) Values(n parser.Values) (planNode, error) {
 			if err != nil {
 				return nil, err
 			}
-			tuple[i], err = p.expandSubqueries(tuple[i], 1)
+			tuple[i], err = p.expandSubqueries(tuple[i], 1, nil)
 			if err != nil {
 				return nil, err
 			}
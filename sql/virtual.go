@@ -0,0 +1,138 @@
+// Copyright 2015 The Cockroach Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+// implied. See the License for the specific language governing
+// permissions and limitations under the License. See the AUTHORS file
+// for names of contributors.
+
+package sql
+
+import (
+	"fmt"
+	"math"
+
+	"github.com/cockroachdb/cockroach/security"
+	"github.com/cockroachdb/cockroach/sql/parser"
+	"github.com/cockroachdb/cockroach/sql/privilege"
+	"github.com/cockroachdb/cockroach/util/log"
+	"github.com/gogo/protobuf/proto"
+)
+
+// Virtual tables expose node-local internal state through SQL. They live in
+// the crdb_internal database, which is never persisted. Their rows are
+// generated on demand by a populate function instead of being read from the
+// KV store and they can only be read.
+
+const (
+	virtualDBName = "crdb_internal"
+
+	// Virtual descriptor IDs are allocated downwards from the top of the ID
+	// space so that they never collide with persisted descriptors.
+	virtualDatabaseID ID = math.MaxUint32
+
+	stmtStatsTableSchema = `
+CREATE TABLE crdb_internal.statement_statistics (
+  fingerprint STRING PRIMARY KEY,
+  count       INT,
+  rows        INT,
+  errors      INT,
+  retries     INT,
+  latency_p50 INTERVAL,
+  latency_p90 INTERVAL,
+  latency_p99 INTERVAL,
+  latency_max INTERVAL
+);`
+)
+
+type virtualTable struct {
+	desc TableDescriptor
+	// populate returns the rows of the table in primary key order, with the
+	// values in the same order as the table's columns.
+	populate func(p *planner) []parser.DTuple
+}
+
+var virtualTables = map[string]*virtualTable{
+	"statement_statistics": {
+		desc:     createVirtualTable(virtualDatabaseID-1, stmtStatsTableSchema),
+		populate: populateStmtStats,
+	},
+}
+
+func createVirtualTable(id ID, schema string) TableDescriptor {
+	stmts, err := parser.ParseTraditional(schema)
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	desc, err := makeTableDesc(stmts[0].(*parser.CreateTable), virtualDatabaseID)
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	desc.ID = id
+	desc.Privileges = NewDefaultPrivilegeDescriptor()
+	if err := desc.AllocateIDs(); err != nil {
+		log.Fatal(err)
+	}
+
+	// Virtual tables are read-only and only accessible to the root user. This
+	// is set after validation, which requires root to hold all privileges on
+	// non-system descriptors.
+	desc.Privileges = NewPrivilegeDescriptor(security.RootUser,
+		privilege.List{privilege.SELECT})
+	return desc
+}
+
+func isVirtualDescriptor(desc *TableDescriptor) bool {
+	return desc != nil && desc.ParentID == virtualDatabaseID
+}
+
+// getVirtualTableDesc returns a copy of the descriptor for the named virtual
+// table. The name must already be normalized.
+func getVirtualTableDesc(qname *parser.QualifiedName) (*TableDescriptor, error) {
+	t, ok := virtualTables[qname.Table()]
+	if !ok {
+		return nil, fmt.Errorf("table %q does not exist", qname.String())
+	}
+	return proto.Clone(&t.desc).(*TableDescriptor), nil
+}
+
+// virtualTableRows returns the rows of the virtual table with the specified
+// descriptor.
+func (p *planner) virtualTableRows(desc *TableDescriptor) ([]parser.DTuple, error) {
+	for _, t := range virtualTables {
+		if t.desc.ID == desc.ID {
+			return t.populate(p), nil
+		}
+	}
+	return nil, fmt.Errorf("unknown virtual table %q", desc.Name)
+}
+
+func populateStmtStats(p *planner) []parser.DTuple {
+	if p.stmtStats == nil {
+		return nil
+	}
+	var rows []parser.DTuple
+	for _, s := range p.stmtStats.snapshot() {
+		rows = append(rows, parser.DTuple{
+			parser.DString(s.Fingerprint),
+			parser.DInt(s.Count),
+			parser.DInt(s.Rows),
+			parser.DInt(s.Errors),
+			parser.DInt(s.Retries),
+			parser.DInterval{Duration: s.LatencyP50},
+			parser.DInterval{Duration: s.LatencyP90},
+			parser.DInterval{Duration: s.LatencyP99},
+			parser.DInterval{Duration: s.LatencyMax},
+		})
+	}
+	return rows
+}
@@ -0,0 +1,215 @@
+// Copyright 2015 The Cockroach Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+// implied. See the License for the specific language governing
+// permissions and limitations under the License. See the AUTHORS file
+// for names of contributors.
+
+package sql
+
+import (
+	"fmt"
+
+	"github.com/cockroachdb/cockroach/sql/parser"
+)
+
+// maxRecursiveCTEIterations is the maximum number of times the recursive term
+// of a WITH RECURSIVE expression is run.
+const maxRecursiveCTEIterations = 10000
+
+// pushWith materializes the common table expressions of a WITH clause and
+// makes them visible to the FROM clauses of the statement, including the
+// later expressions of the same WITH clause. The CTEs remain visible until
+// popWith is called. The materialized rows are charged to the memory monitor
+// of the session until the statement has been executed.
+func (p *planner) pushWith(with *parser.With) error {
+	scope := map[string]*materializedTable{}
+	p.ctes = append(p.ctes, scope)
+	acc := p.newMemoryAccount()
+
+	for _, c := range with.CTEs {
+		name := normalizeName(string(c.Name))
+		if _, ok := scope[name]; ok {
+			return fmt.Errorf("WITH query name \"%s\" specified more than once", c.Name)
+		}
+		var m *materializedTable
+		var err error
+		if u, ok := c.Stmt.(*parser.Union); ok && with.Recursive && u.Type == "UNION" {
+			m, err = p.materializeRecursiveCTE(c, u, acc)
+		} else {
+			m, err = p.materializeCTE(c, acc)
+		}
+		if err != nil {
+			return err
+		}
+		scope[name] = m
+	}
+	return nil
+}
+
+// popWith removes the innermost WITH clause pushed by pushWith.
+func (p *planner) popWith() {
+	p.ctes = p.ctes[:len(p.ctes)-1]
+}
+
+// findCTE returns the common table expression referenced by a table
+// expression or nil if the table expression does not reference a CTE. CTEs
+// shadow tables of the same name and inner WITH clauses shadow outer ones.
+func (p *planner) findCTE(n parser.TableExpr) *materializedTable {
+	ate, ok := n.(*parser.AliasedTableExpr)
+	if !ok {
+		return nil
+	}
+	qname, ok := ate.Expr.(*parser.QualifiedName)
+	if !ok || len(qname.Indirect) > 0 {
+		return nil
+	}
+	name := normalizeName(string(qname.Base))
+	for i := len(p.ctes) - 1; i >= 0; i-- {
+		if c, ok := p.ctes[i][name]; ok {
+			return c
+		}
+	}
+	return nil
+}
+
+// materializeCTE runs the query of a common table expression and returns its
+// rows.
+func (p *planner) materializeCTE(c *parser.CTE, acc *memoryAccount) (*materializedTable, error) {
+	columns, rows, err := p.collectRows(c.Stmt, acc)
+	if err != nil {
+		return nil, err
+	}
+	return makeCTE(c, columns, rows)
+}
+
+// materializeRecursiveCTE evaluates a WITH RECURSIVE expression of the form
+// "non-recursive-term UNION [ALL] recursive-term". The non-recursive term is
+// run first and the recursive term is then run repeatedly, with each
+// reference to the CTE reading the rows produced by the previous iteration,
+// until an iteration does not produce any new rows. For UNION (but not UNION
+// ALL) duplicate rows are discarded. The recursive term is run at most
+// maxRecursiveCTEIterations times.
+func (p *planner) materializeRecursiveCTE(c *parser.CTE, u *parser.Union, acc *memoryAccount) (*materializedTable, error) {
+	// Planning a statement modifies its syntax tree, so the recursive term is
+	// parsed anew for each iteration.
+	recursive := u.Right.String()
+
+	columns, rows, err := p.collectRows(u.Left, acc)
+	if err != nil {
+		return nil, err
+	}
+	var seen map[string]struct{}
+	if !u.All {
+		seen = map[string]struct{}{}
+		if rows, err = dedupRows(seen, rows, acc); err != nil {
+			return nil, err
+		}
+	}
+	m, err := makeCTE(c, columns, rows)
+	if err != nil {
+		return nil, err
+	}
+
+	name := normalizeName(string(c.Name))
+	scope := p.ctes[len(p.ctes)-1]
+	working := &materializedTable{desc: m.desc, rows: m.rows}
+	for i := 0; len(working.rows) > 0; i++ {
+		if i == maxRecursiveCTEIterations {
+			return nil, fmt.Errorf("WITH RECURSIVE query \"%s\" did not finish after %d iterations",
+				c.Name, maxRecursiveCTEIterations)
+		}
+		stmts, err := parser.ParseTraditional(recursive)
+		if err != nil {
+			return nil, err
+		}
+		scope[name] = working
+		columns, rows, err := p.collectRows(stmts[0], acc)
+		if err != nil {
+			return nil, err
+		}
+		if len(columns) != len(m.desc.Columns)-1 {
+			return nil, fmt.Errorf("each UNION query must have the same number of columns: %d vs %d",
+				len(m.desc.Columns)-1, len(columns))
+		}
+		if seen != nil {
+			if rows, err = dedupRows(seen, rows, acc); err != nil {
+				return nil, err
+			}
+		}
+		working = &materializedTable{desc: m.desc, rows: numberRows(rows, len(m.rows))}
+		m.rows = append(m.rows, working.rows...)
+	}
+	delete(scope, name)
+	return m, nil
+}
+
+// collectRows plans and runs a statement and returns its result columns and
+// rows, which are charged to the memory account. The statement is only
+// planned when planning the statement of an EXPLAIN.
+func (p *planner) collectRows(stmt parser.Statement, acc *memoryAccount) ([]string, []parser.DTuple, error) {
+	plan, err := p.makePlan(stmt)
+	if err != nil {
+		return nil, nil, err
+	}
+	if p.planOnly {
+		return plan.Columns(), nil, nil
+	}
+	var rows []parser.DTuple
+	for plan.Next() {
+		values := plan.Values()
+		if err := acc.grow(rowSize(values)); err != nil {
+			return nil, nil, err
+		}
+		rows = append(rows, append(parser.DTuple(nil), values...))
+	}
+	if err := plan.Err(); err != nil {
+		return nil, nil, err
+	}
+	return plan.Columns(), rows, nil
+}
+
+// dedupRows returns the rows which have not been seen yet, adding them to the
+// seen set. The entries of the set are charged to the memory account.
+func dedupRows(seen map[string]struct{}, rows []parser.DTuple, acc *memoryAccount) ([]parser.DTuple, error) {
+	var result []parser.DTuple
+	for _, row := range rows {
+		var key []byte
+		for _, d := range row {
+			var err error
+			if key, err = encodeDatum(key, d); err != nil {
+				return nil, err
+			}
+		}
+		if _, ok := seen[string(key)]; ok {
+			continue
+		}
+		if err := acc.grow(sizeOfMapEntry + int64(len(key))); err != nil {
+			return nil, err
+		}
+		seen[string(key)] = struct{}{}
+		result = append(result, row)
+	}
+	return result, nil
+}
+
+// makeCTE builds the virtual table of a common table expression, applying
+// the column names of the CTE if any.
+func makeCTE(c *parser.CTE, columns []string, rows []parser.DTuple) (*materializedTable, error) {
+	if len(c.Columns) > 0 {
+		if len(c.Columns) != len(columns) {
+			return nil, fmt.Errorf("WITH query \"%s\" has %d columns available but %d columns specified",
+				c.Name, len(columns), len(c.Columns))
+		}
+		columns = []string(c.Columns)
+	}
+	return makeMaterializedTable(string(c.Name), columns, rows), nil
+}
@@ -0,0 +1,141 @@
+// Copyright 2015 The Cockroach Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+// implied. See the License for the specific language governing
+// permissions and limitations under the License. See the AUTHORS file
+// for names of contributors.
+
+package sql
+
+import (
+	"fmt"
+
+	"github.com/cockroachdb/cockroach/client"
+	"github.com/cockroachdb/cockroach/config"
+	"github.com/cockroachdb/cockroach/roachpb"
+	"github.com/cockroachdb/cockroach/security"
+	"github.com/cockroachdb/cockroach/sql/parser"
+	"github.com/cockroachdb/cockroach/util"
+	"github.com/cockroachdb/cockroach/util/encoding"
+	yaml "gopkg.in/yaml.v1"
+)
+
+// defaultZoneName is the name SHOW ZONE CONFIGURATION uses for the default
+// zone config, which applies to objects without a zone config of their own.
+const defaultZoneName = ".default"
+
+// SetZoneConfig sets or removes the zone config of a database or table. The
+// zone config is written to system.zones, which is part of the gossiped
+// system config.
+// Privileges: "root" user.
+func (p *planner) SetZoneConfig(n *parser.SetZoneConfig) (planNode, error) {
+	if p.user != security.RootUser {
+		return nil, fmt.Errorf("only %s is allowed to configure zones", security.RootUser)
+	}
+
+	descriptor, err := p.getZoneSpecifierDesc(n.ZoneSpecifier)
+	if err != nil {
+		return nil, err
+	}
+	id := descriptor.GetID()
+
+	// The zone config is written directly to its KVs, mirroring an INSERT
+	// into system.zones, so that it is visible to SQL queries as well.
+	rowKey := makeZoneRowKey(id)
+	b := client.Batch{}
+	switch t := n.YAMLConfig.(type) {
+	case parser.DString:
+		var zone config.ZoneConfig
+		if err := yaml.Unmarshal([]byte(t), &zone); err != nil {
+			return nil, fmt.Errorf("could not parse zone config: %s", err)
+		}
+		if err := zone.Validate(); err != nil {
+			return nil, err
+		}
+		b.Put(rowKey, nil)
+		b.Put(MakeZoneKey(id), &zone)
+	default:
+		if t != parser.DNull {
+			return nil, util.Errorf("unexpected zone config %s", n.YAMLConfig)
+		}
+		b.Del(rowKey)
+		b.Del(MakeZoneKey(id))
+	}
+	if err := p.txn.Run(&b); err != nil {
+		return nil, err
+	}
+	return &valuesNode{}, nil
+}
+
+// ShowZoneConfig returns the zone config in effect for a database or table,
+// along with the name of the object it is inherited from. Tables without a
+// zone config inherit the zone config of their database and databases
+// without one use the default zone config.
+// Privileges: None.
+func (p *planner) ShowZoneConfig(n *parser.ShowZoneConfig) (planNode, error) {
+	descriptor, err := p.getZoneSpecifierDesc(n.ZoneSpecifier)
+	if err != nil {
+		return nil, err
+	}
+
+	type zoneSource struct {
+		id   ID
+		name string
+	}
+	var sources []zoneSource
+	if tableDesc, ok := descriptor.(*TableDescriptor); ok {
+		sources = append(sources,
+			zoneSource{tableDesc.ID, n.Table.String()},
+			zoneSource{tableDesc.ParentID, n.Table.Database()})
+	} else {
+		sources = append(sources, zoneSource{descriptor.GetID(), descriptor.GetName()})
+	}
+
+	zone, name := config.DefaultZoneConfig, defaultZoneName
+	for _, source := range sources {
+		gr, err := p.txn.Get(MakeZoneKey(source.id))
+		if err != nil {
+			return nil, err
+		}
+		if gr.Exists() {
+			zone = &config.ZoneConfig{}
+			if err := gr.ValueProto(zone); err != nil {
+				return nil, err
+			}
+			name = source.name
+			break
+		}
+	}
+
+	buf, err := yaml.Marshal(zone)
+	if err != nil {
+		return nil, err
+	}
+	v := &valuesNode{columns: []string{"Zone", "Config"}}
+	v.rows = append(v.rows, []parser.Datum{parser.DString(name), parser.DString(buf)})
+	return v, nil
+}
+
+// getZoneSpecifierDesc returns the descriptor of the database or table a
+// zone specifier refers to.
+func (p *planner) getZoneSpecifierDesc(zs parser.ZoneSpecifier) (descriptorProto, error) {
+	if zs.Table != nil {
+		return p.getTableDesc(zs.Table)
+	}
+	return p.getDatabaseDesc(string(zs.Database))
+}
+
+// makeZoneRowKey returns the sentinel key of 'id's row in the system.zones
+// table. The zone config itself is stored under MakeZoneKey.
+func makeZoneRowKey(id ID) roachpb.Key {
+	return encoding.EncodeUvarint(
+		MakeIndexKeyPrefix(ZonesTable.ID, ZonesTable.PrimaryIndex.ID), uint64(id))
+}
@@ -972,6 +972,71 @@ func MVCCDeleteRange(engine Engine, ms *MVCCStats, key, endKey roachpb.Key, max
 	return num, nil
 }
 
+// clearRangeBatchSize is the number of keys MVCCClearRange reads before
+// clearing them.
+const clearRangeBatchSize = 1000
+
+// MVCCClearRange removes every version of the keys in the range
+// [key,endKey) from the engine, including any write intents. Unlike
+// MVCCDeleteRange no tombstones are written, so the data is gone at all
+// timestamps. The supplied stats are updated to account for the removed data
+// using nowNanos to compute its age. Returns the number of keys removed.
+func MVCCClearRange(engine Engine, ms *MVCCStats, key, endKey roachpb.Key, nowNanos int64) (int64, error) {
+	encKey, encEndKey := MVCCEncodeKey(key), MVCCEncodeKey(endKey)
+	if ms != nil {
+		iter := engine.NewIterator()
+		defer iter.Close()
+		iter.Seek(encKey)
+		cleared, err := MVCCComputeStats(spanIterator{iter, encEndKey}, nowNanos)
+		if err != nil {
+			return 0, err
+		}
+		ms.Subtract(&cleared)
+	}
+
+	// The keys are cleared in batches of clearRangeBatchSize keys, so that the
+	// keys of a large range aren't all held in memory at once.
+	num := int64(0)
+	encKeys := make([]roachpb.EncodedKey, 0, clearRangeBatchSize)
+	for {
+		encKeys = encKeys[:0]
+		if err := engine.Iterate(encKey, encEndKey, func(kv roachpb.RawKeyValue) (bool, error) {
+			encKeys = append(encKeys, kv.Key)
+			return len(encKeys) == clearRangeBatchSize, nil
+		}); err != nil {
+			return num, err
+		}
+		for _, k := range encKeys {
+			_, _, isValue, err := MVCCDecodeKey(k)
+			if err != nil {
+				return num, err
+			}
+			if err := engine.Clear(k); err != nil {
+				return num, err
+			}
+			if !isValue {
+				num++
+			}
+		}
+		if len(encKeys) < clearRangeBatchSize {
+			return num, nil
+		}
+		encKey = encKeys[len(encKeys)-1].Next()
+	}
+}
+
+// spanIterator wraps an Iterator, making it invalid once it reaches the end
+// key.
+type spanIterator struct {
+	Iterator
+	end roachpb.EncodedKey
+}
+
+// Valid implements the Iterator interface.
+func (i spanIterator) Valid() bool {
+	return i.Iterator.Valid() && i.Key().Less(i.end)
+}
+
 func getScanMetaKey(iter Iterator, encEndKey roachpb.EncodedKey) (roachpb.Key, roachpb.EncodedKey, error) {
 	metaKey := iter.Key()
 	if bytes.Compare(metaKey, encEndKey) >= 0 {
@@ -56,6 +56,7 @@ type RocksDB struct {
 	cacheSize   int64              // Memory to use to cache values.
 	stopper     *stop.Stopper
 	deallocated chan struct{} // Closed when the underlying handle is deallocated.
+	temp        bool          // Whether opening and closing goes unlogged.
 }
 
 // NewRocksDB allocates and returns a new RocksDB object.
@@ -72,6 +73,15 @@ func NewRocksDB(attrs roachpb.Attributes, dir string, cacheSize int64, stopper *
 	}
 }
 
+// NewTempRocksDB allocates and returns a new RocksDB object for a
+// short-lived database, such as the ones SQL operators spill rows to.
+// Unlike NewRocksDB, opening and closing it is not logged.
+func NewTempRocksDB(dir string, cacheSize int64, stopper *stop.Stopper) *RocksDB {
+	r := NewRocksDB(roachpb.Attributes{}, dir, cacheSize, stopper)
+	r.temp = true
+	return r
+}
+
 func newMemRocksDB(attrs roachpb.Attributes, cacheSize int64, stopper *stop.Stopper) *RocksDB {
 	return &RocksDB{
 		attrs: attrs,
@@ -99,7 +109,7 @@ func (r *RocksDB) Open() error {
 		return nil
 	}
 
-	if len(r.dir) != 0 {
+	if len(r.dir) != 0 && !r.temp {
 		log.Infof("opening rocksdb instance at %q", r.dir)
 	}
 	status := C.DBOpen(&r.rdb, goToCSlice([]byte(r.dir)),
@@ -130,7 +140,7 @@ func (r *RocksDB) Close() {
 	}
 	if len(r.dir) == 0 {
 		log.Infof("closing in-memory rocksdb instance")
-	} else {
+	} else if !r.temp {
 		log.Infof("closing rocksdb instance at %q", r.dir)
 	}
 	if r.rdb != nil {
@@ -66,6 +66,12 @@ const ::google::protobuf::internal::GeneratedMessageReflection*
 const ::google::protobuf::Descriptor* DeleteRangeResponse_descriptor_ = NULL;
 const ::google::protobuf::internal::GeneratedMessageReflection*
   DeleteRangeResponse_reflection_ = NULL;
+const ::google::protobuf::Descriptor* ClearRangeRequest_descriptor_ = NULL;
+const ::google::protobuf::internal::GeneratedMessageReflection*
+  ClearRangeRequest_reflection_ = NULL;
+const ::google::protobuf::Descriptor* ClearRangeResponse_descriptor_ = NULL;
+const ::google::protobuf::internal::GeneratedMessageReflection*
+  ClearRangeResponse_reflection_ = NULL;
 const ::google::protobuf::Descriptor* ScanRequest_descriptor_ = NULL;
 const ::google::protobuf::internal::GeneratedMessageReflection*
   ScanRequest_reflection_ = NULL;
@@ -78,6 +84,12 @@ const ::google::protobuf::internal::GeneratedMessageReflection*
 const ::google::protobuf::Descriptor* ReverseScanResponse_descriptor_ = NULL;
 const ::google::protobuf::internal::GeneratedMessageReflection*
   ReverseScanResponse_reflection_ = NULL;
+const ::google::protobuf::Descriptor* FilteredScanRequest_descriptor_ = NULL;
+const ::google::protobuf::internal::GeneratedMessageReflection*
+  FilteredScanRequest_reflection_ = NULL;
+const ::google::protobuf::Descriptor* FilteredScanResponse_descriptor_ = NULL;
+const ::google::protobuf::internal::GeneratedMessageReflection*
+  FilteredScanResponse_reflection_ = NULL;
 const ::google::protobuf::Descriptor* BeginTransactionRequest_descriptor_ = NULL;
 const ::google::protobuf::internal::GeneratedMessageReflection*
   BeginTransactionRequest_reflection_ = NULL;
@@ -431,7 +443,38 @@ void protobuf_AssignDesc_cockroach_2froachpb_2fapi_2eproto() {
       sizeof(DeleteRangeResponse),
       GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(DeleteRangeResponse, _internal_metadata_),
       -1);
-  ScanRequest_descriptor_ = file->message_type(15);
+  ClearRangeRequest_descriptor_ = file->message_type(15);
+  static const int ClearRangeRequest_offsets_[1] = {
+    GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(ClearRangeRequest, header_),
+  };
+  ClearRangeRequest_reflection_ =
+    ::google::protobuf::internal::GeneratedMessageReflection::NewGeneratedMessageReflection(
+      ClearRangeRequest_descriptor_,
+      ClearRangeRequest::default_instance_,
+      ClearRangeRequest_offsets_,
+      GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(ClearRangeRequest, _has_bits_[0]),
+      -1,
+      -1,
+      sizeof(ClearRangeRequest),
+      GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(ClearRangeRequest, _internal_metadata_),
+      -1);
+  ClearRangeResponse_descriptor_ = file->message_type(16);
+  static const int ClearRangeResponse_offsets_[2] = {
+    GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(ClearRangeResponse, header_),
+    GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(ClearRangeResponse, num_cleared_),
+  };
+  ClearRangeResponse_reflection_ =
+    ::google::protobuf::internal::GeneratedMessageReflection::NewGeneratedMessageReflection(
+      ClearRangeResponse_descriptor_,
+      ClearRangeResponse::default_instance_,
+      ClearRangeResponse_offsets_,
+      GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(ClearRangeResponse, _has_bits_[0]),
+      -1,
+      -1,
+      sizeof(ClearRangeResponse),
+      GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(ClearRangeResponse, _internal_metadata_),
+      -1);
+  ScanRequest_descriptor_ = file->message_type(17);
   static const int ScanRequest_offsets_[2] = {
     GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(ScanRequest, header_),
     GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(ScanRequest, max_results_),
@@ -447,7 +490,7 @@ void protobuf_AssignDesc_cockroach_2froachpb_2fapi_2eproto() {
       sizeof(ScanRequest),
       GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(ScanRequest, _internal_metadata_),
       -1);
-  ScanResponse_descriptor_ = file->message_type(16);
+  ScanResponse_descriptor_ = file->message_type(18);
   static const int ScanResponse_offsets_[2] = {
     GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(ScanResponse, header_),
     GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(ScanResponse, rows_),
@@ -463,7 +506,7 @@ void protobuf_AssignDesc_cockroach_2froachpb_2fapi_2eproto() {
       sizeof(ScanResponse),
       GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(ScanResponse, _internal_metadata_),
       -1);
-  ReverseScanRequest_descriptor_ = file->message_type(17);
+  ReverseScanRequest_descriptor_ = file->message_type(19);
   static const int ReverseScanRequest_offsets_[2] = {
     GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(ReverseScanRequest, header_),
     GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(ReverseScanRequest, max_results_),
@@ -479,7 +522,7 @@ void protobuf_AssignDesc_cockroach_2froachpb_2fapi_2eproto() {
       sizeof(ReverseScanRequest),
       GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(ReverseScanRequest, _internal_metadata_),
       -1);
-  ReverseScanResponse_descriptor_ = file->message_type(18);
+  ReverseScanResponse_descriptor_ = file->message_type(20);
   static const int ReverseScanResponse_offsets_[2] = {
     GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(ReverseScanResponse, header_),
     GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(ReverseScanResponse, rows_),
@@ -495,7 +538,40 @@ void protobuf_AssignDesc_cockroach_2froachpb_2fapi_2eproto() {
       sizeof(ReverseScanResponse),
       GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(ReverseScanResponse, _internal_metadata_),
       -1);
-  BeginTransactionRequest_descriptor_ = file->message_type(19);
+  FilteredScanRequest_descriptor_ = file->message_type(21);
+  static const int FilteredScanRequest_offsets_[3] = {
+    GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(FilteredScanRequest, header_),
+    GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(FilteredScanRequest, filter_),
+    GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(FilteredScanRequest, max_results_),
+  };
+  FilteredScanRequest_reflection_ =
+    ::google::protobuf::internal::GeneratedMessageReflection::NewGeneratedMessageReflection(
+      FilteredScanRequest_descriptor_,
+      FilteredScanRequest::default_instance_,
+      FilteredScanRequest_offsets_,
+      GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(FilteredScanRequest, _has_bits_[0]),
+      -1,
+      -1,
+      sizeof(FilteredScanRequest),
+      GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(FilteredScanRequest, _internal_metadata_),
+      -1);
+  FilteredScanResponse_descriptor_ = file->message_type(22);
+  static const int FilteredScanResponse_offsets_[2] = {
+    GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(FilteredScanResponse, header_),
+    GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(FilteredScanResponse, rows_),
+  };
+  FilteredScanResponse_reflection_ =
+    ::google::protobuf::internal::GeneratedMessageReflection::NewGeneratedMessageReflection(
+      FilteredScanResponse_descriptor_,
+      FilteredScanResponse::default_instance_,
+      FilteredScanResponse_offsets_,
+      GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(FilteredScanResponse, _has_bits_[0]),
+      -1,
+      -1,
+      sizeof(FilteredScanResponse),
+      GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(FilteredScanResponse, _internal_metadata_),
+      -1);
+  BeginTransactionRequest_descriptor_ = file->message_type(23);
   static const int BeginTransactionRequest_offsets_[1] = {
     GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(BeginTransactionRequest, header_),
   };
@@ -510,7 +586,7 @@ void protobuf_AssignDesc_cockroach_2froachpb_2fapi_2eproto() {
       sizeof(BeginTransactionRequest),
       GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(BeginTransactionRequest, _internal_metadata_),
       -1);
-  BeginTransactionResponse_descriptor_ = file->message_type(20);
+  BeginTransactionResponse_descriptor_ = file->message_type(24);
   static const int BeginTransactionResponse_offsets_[1] = {
     GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(BeginTransactionResponse, header_),
   };
@@ -525,7 +601,7 @@ void protobuf_AssignDesc_cockroach_2froachpb_2fapi_2eproto() {
       sizeof(BeginTransactionResponse),
       GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(BeginTransactionResponse, _internal_metadata_),
       -1);
-  EndTransactionRequest_descriptor_ = file->message_type(21);
+  EndTransactionRequest_descriptor_ = file->message_type(25);
   static const int EndTransactionRequest_offsets_[4] = {
     GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(EndTransactionRequest, header_),
     GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(EndTransactionRequest, commit_),
@@ -543,7 +619,7 @@ void protobuf_AssignDesc_cockroach_2froachpb_2fapi_2eproto() {
       sizeof(EndTransactionRequest),
       GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(EndTransactionRequest, _internal_metadata_),
       -1);
-  EndTransactionResponse_descriptor_ = file->message_type(22);
+  EndTransactionResponse_descriptor_ = file->message_type(26);
   static const int EndTransactionResponse_offsets_[3] = {
     GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(EndTransactionResponse, header_),
     GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(EndTransactionResponse, commit_wait_),
@@ -560,7 +636,7 @@ void protobuf_AssignDesc_cockroach_2froachpb_2fapi_2eproto() {
       sizeof(EndTransactionResponse),
       GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(EndTransactionResponse, _internal_metadata_),
       -1);
-  AdminSplitRequest_descriptor_ = file->message_type(23);
+  AdminSplitRequest_descriptor_ = file->message_type(27);
   static const int AdminSplitRequest_offsets_[2] = {
     GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(AdminSplitRequest, header_),
     GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(AdminSplitRequest, split_key_),
@@ -576,7 +652,7 @@ void protobuf_AssignDesc_cockroach_2froachpb_2fapi_2eproto() {
       sizeof(AdminSplitRequest),
       GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(AdminSplitRequest, _internal_metadata_),
       -1);
-  AdminSplitResponse_descriptor_ = file->message_type(24);
+  AdminSplitResponse_descriptor_ = file->message_type(28);
   static const int AdminSplitResponse_offsets_[1] = {
     GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(AdminSplitResponse, header_),
   };
@@ -591,7 +667,7 @@ void protobuf_AssignDesc_cockroach_2froachpb_2fapi_2eproto() {
       sizeof(AdminSplitResponse),
       GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(AdminSplitResponse, _internal_metadata_),
       -1);
-  AdminMergeRequest_descriptor_ = file->message_type(25);
+  AdminMergeRequest_descriptor_ = file->message_type(29);
   static const int AdminMergeRequest_offsets_[1] = {
     GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(AdminMergeRequest, header_),
   };
@@ -606,7 +682,7 @@ void protobuf_AssignDesc_cockroach_2froachpb_2fapi_2eproto() {
       sizeof(AdminMergeRequest),
       GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(AdminMergeRequest, _internal_metadata_),
       -1);
-  AdminMergeResponse_descriptor_ = file->message_type(26);
+  AdminMergeResponse_descriptor_ = file->message_type(30);
   static const int AdminMergeResponse_offsets_[1] = {
     GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(AdminMergeResponse, header_),
   };
@@ -621,7 +697,7 @@ void protobuf_AssignDesc_cockroach_2froachpb_2fapi_2eproto() {
       sizeof(AdminMergeResponse),
       GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(AdminMergeResponse, _internal_metadata_),
       -1);
-  RangeLookupRequest_descriptor_ = file->message_type(27);
+  RangeLookupRequest_descriptor_ = file->message_type(31);
   static const int RangeLookupRequest_offsets_[4] = {
     GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(RangeLookupRequest, header_),
     GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(RangeLookupRequest, max_ranges_),
@@ -639,7 +715,7 @@ void protobuf_AssignDesc_cockroach_2froachpb_2fapi_2eproto() {
       sizeof(RangeLookupRequest),
       GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(RangeLookupRequest, _internal_metadata_),
       -1);
-  RangeLookupResponse_descriptor_ = file->message_type(28);
+  RangeLookupResponse_descriptor_ = file->message_type(32);
   static const int RangeLookupResponse_offsets_[2] = {
     GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(RangeLookupResponse, header_),
     GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(RangeLookupResponse, ranges_),
@@ -655,7 +731,7 @@ void protobuf_AssignDesc_cockroach_2froachpb_2fapi_2eproto() {
       sizeof(RangeLookupResponse),
       GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(RangeLookupResponse, _internal_metadata_),
       -1);
-  HeartbeatTxnRequest_descriptor_ = file->message_type(29);
+  HeartbeatTxnRequest_descriptor_ = file->message_type(33);
   static const int HeartbeatTxnRequest_offsets_[1] = {
     GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(HeartbeatTxnRequest, header_),
   };
@@ -670,7 +746,7 @@ void protobuf_AssignDesc_cockroach_2froachpb_2fapi_2eproto() {
       sizeof(HeartbeatTxnRequest),
       GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(HeartbeatTxnRequest, _internal_metadata_),
       -1);
-  HeartbeatTxnResponse_descriptor_ = file->message_type(30);
+  HeartbeatTxnResponse_descriptor_ = file->message_type(34);
   static const int HeartbeatTxnResponse_offsets_[1] = {
     GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(HeartbeatTxnResponse, header_),
   };
@@ -685,7 +761,7 @@ void protobuf_AssignDesc_cockroach_2froachpb_2fapi_2eproto() {
       sizeof(HeartbeatTxnResponse),
       GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(HeartbeatTxnResponse, _internal_metadata_),
       -1);
-  GCRequest_descriptor_ = file->message_type(31);
+  GCRequest_descriptor_ = file->message_type(35);
   static const int GCRequest_offsets_[3] = {
     GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(GCRequest, header_),
     GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(GCRequest, gc_meta_),
@@ -718,7 +794,7 @@ void protobuf_AssignDesc_cockroach_2froachpb_2fapi_2eproto() {
       sizeof(GCRequest_GCKey),
       GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(GCRequest_GCKey, _internal_metadata_),
       -1);
-  GCResponse_descriptor_ = file->message_type(32);
+  GCResponse_descriptor_ = file->message_type(36);
   static const int GCResponse_offsets_[1] = {
     GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(GCResponse, header_),
   };
@@ -733,7 +809,7 @@ void protobuf_AssignDesc_cockroach_2froachpb_2fapi_2eproto() {
       sizeof(GCResponse),
       GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(GCResponse, _internal_metadata_),
       -1);
-  PushTxnRequest_descriptor_ = file->message_type(33);
+  PushTxnRequest_descriptor_ = file->message_type(37);
   static const int PushTxnRequest_offsets_[6] = {
     GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(PushTxnRequest, header_),
     GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(PushTxnRequest, pusher_txn_),
@@ -753,7 +829,7 @@ void protobuf_AssignDesc_cockroach_2froachpb_2fapi_2eproto() {
       sizeof(PushTxnRequest),
       GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(PushTxnRequest, _internal_metadata_),
       -1);
-  PushTxnResponse_descriptor_ = file->message_type(34);
+  PushTxnResponse_descriptor_ = file->message_type(38);
   static const int PushTxnResponse_offsets_[2] = {
     GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(PushTxnResponse, header_),
     GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(PushTxnResponse, pushee_txn_),
@@ -769,7 +845,7 @@ void protobuf_AssignDesc_cockroach_2froachpb_2fapi_2eproto() {
       sizeof(PushTxnResponse),
       GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(PushTxnResponse, _internal_metadata_),
       -1);
-  ResolveIntentRequest_descriptor_ = file->message_type(35);
+  ResolveIntentRequest_descriptor_ = file->message_type(39);
   static const int ResolveIntentRequest_offsets_[2] = {
     GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(ResolveIntentRequest, header_),
     GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(ResolveIntentRequest, intent_txn_),
@@ -785,7 +861,7 @@ void protobuf_AssignDesc_cockroach_2froachpb_2fapi_2eproto() {
       sizeof(ResolveIntentRequest),
       GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(ResolveIntentRequest, _internal_metadata_),
       -1);
-  ResolveIntentResponse_descriptor_ = file->message_type(36);
+  ResolveIntentResponse_descriptor_ = file->message_type(40);
   static const int ResolveIntentResponse_offsets_[1] = {
     GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(ResolveIntentResponse, header_),
   };
@@ -800,7 +876,7 @@ void protobuf_AssignDesc_cockroach_2froachpb_2fapi_2eproto() {
       sizeof(ResolveIntentResponse),
       GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(ResolveIntentResponse, _internal_metadata_),
       -1);
-  ResolveIntentRangeRequest_descriptor_ = file->message_type(37);
+  ResolveIntentRangeRequest_descriptor_ = file->message_type(41);
   static const int ResolveIntentRangeRequest_offsets_[2] = {
     GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(ResolveIntentRangeRequest, header_),
     GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(ResolveIntentRangeRequest, intent_txn_),
@@ -816,7 +892,7 @@ void protobuf_AssignDesc_cockroach_2froachpb_2fapi_2eproto() {
       sizeof(ResolveIntentRangeRequest),
       GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(ResolveIntentRangeRequest, _internal_metadata_),
       -1);
-  NoopResponse_descriptor_ = file->message_type(38);
+  NoopResponse_descriptor_ = file->message_type(42);
   static const int NoopResponse_offsets_[1] = {
     GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(NoopResponse, header_),
   };
@@ -831,7 +907,7 @@ void protobuf_AssignDesc_cockroach_2froachpb_2fapi_2eproto() {
       sizeof(NoopResponse),
       GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(NoopResponse, _internal_metadata_),
       -1);
-  NoopRequest_descriptor_ = file->message_type(39);
+  NoopRequest_descriptor_ = file->message_type(43);
   static const int NoopRequest_offsets_[1] = {
     GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(NoopRequest, header_),
   };
@@ -846,7 +922,7 @@ void protobuf_AssignDesc_cockroach_2froachpb_2fapi_2eproto() {
       sizeof(NoopRequest),
       GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(NoopRequest, _internal_metadata_),
       -1);
-  ResolveIntentRangeResponse_descriptor_ = file->message_type(40);
+  ResolveIntentRangeResponse_descriptor_ = file->message_type(44);
   static const int ResolveIntentRangeResponse_offsets_[1] = {
     GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(ResolveIntentRangeResponse, header_),
   };
@@ -861,7 +937,7 @@ void protobuf_AssignDesc_cockroach_2froachpb_2fapi_2eproto() {
       sizeof(ResolveIntentRangeResponse),
       GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(ResolveIntentRangeResponse, _internal_metadata_),
       -1);
-  MergeRequest_descriptor_ = file->message_type(41);
+  MergeRequest_descriptor_ = file->message_type(45);
   static const int MergeRequest_offsets_[2] = {
     GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(MergeRequest, header_),
     GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(MergeRequest, value_),
@@ -877,7 +953,7 @@ void protobuf_AssignDesc_cockroach_2froachpb_2fapi_2eproto() {
       sizeof(MergeRequest),
       GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(MergeRequest, _internal_metadata_),
       -1);
-  MergeResponse_descriptor_ = file->message_type(42);
+  MergeResponse_descriptor_ = file->message_type(46);
   static const int MergeResponse_offsets_[1] = {
     GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(MergeResponse, header_),
   };
@@ -892,7 +968,7 @@ void protobuf_AssignDesc_cockroach_2froachpb_2fapi_2eproto() {
       sizeof(MergeResponse),
       GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(MergeResponse, _internal_metadata_),
       -1);
-  TruncateLogRequest_descriptor_ = file->message_type(43);
+  TruncateLogRequest_descriptor_ = file->message_type(47);
   static const int TruncateLogRequest_offsets_[2] = {
     GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(TruncateLogRequest, header_),
     GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(TruncateLogRequest, index_),
@@ -908,7 +984,7 @@ void protobuf_AssignDesc_cockroach_2froachpb_2fapi_2eproto() {
       sizeof(TruncateLogRequest),
       GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(TruncateLogRequest, _internal_metadata_),
       -1);
-  TruncateLogResponse_descriptor_ = file->message_type(44);
+  TruncateLogResponse_descriptor_ = file->message_type(48);
   static const int TruncateLogResponse_offsets_[1] = {
     GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(TruncateLogResponse, header_),
   };
@@ -923,7 +999,7 @@ void protobuf_AssignDesc_cockroach_2froachpb_2fapi_2eproto() {
       sizeof(TruncateLogResponse),
       GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(TruncateLogResponse, _internal_metadata_),
       -1);
-  LeaderLeaseRequest_descriptor_ = file->message_type(45);
+  LeaderLeaseRequest_descriptor_ = file->message_type(49);
   static const int LeaderLeaseRequest_offsets_[2] = {
     GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(LeaderLeaseRequest, header_),
     GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(LeaderLeaseRequest, lease_),
@@ -939,7 +1015,7 @@ void protobuf_AssignDesc_cockroach_2froachpb_2fapi_2eproto() {
       sizeof(LeaderLeaseRequest),
       GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(LeaderLeaseRequest, _internal_metadata_),
       -1);
-  LeaderLeaseResponse_descriptor_ = file->message_type(46);
+  LeaderLeaseResponse_descriptor_ = file->message_type(50);
   static const int LeaderLeaseResponse_offsets_[1] = {
     GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(LeaderLeaseResponse, header_),
   };
@@ -954,8 +1030,8 @@ void protobuf_AssignDesc_cockroach_2froachpb_2fapi_2eproto() {
       sizeof(LeaderLeaseResponse),
       GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(LeaderLeaseResponse, _internal_metadata_),
       -1);
-  RequestUnion_descriptor_ = file->message_type(47);
-  static const int RequestUnion_offsets_[22] = {
+  RequestUnion_descriptor_ = file->message_type(51);
+  static const int RequestUnion_offsets_[24] = {
     GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(RequestUnion, get_),
     GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(RequestUnion, put_),
     GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(RequestUnion, conditional_put_),
@@ -978,6 +1054,8 @@ void protobuf_AssignDesc_cockroach_2froachpb_2fapi_2eproto() {
     GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(RequestUnion, leader_lease_),
     GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(RequestUnion, reverse_scan_),
     GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(RequestUnion, noop_),
+    GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(RequestUnion, filtered_scan_),
+    GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(RequestUnion, clear_range_),
   };
   RequestUnion_reflection_ =
     ::google::protobuf::internal::GeneratedMessageReflection::NewGeneratedMessageReflection(
@@ -990,8 +1068,8 @@ void protobuf_AssignDesc_cockroach_2froachpb_2fapi_2eproto() {
       sizeof(RequestUnion),
       GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(RequestUnion, _internal_metadata_),
       -1);
-  ResponseUnion_descriptor_ = file->message_type(48);
-  static const int ResponseUnion_offsets_[22] = {
+  ResponseUnion_descriptor_ = file->message_type(52);
+  static const int ResponseUnion_offsets_[24] = {
     GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(ResponseUnion, get_),
     GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(ResponseUnion, put_),
     GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(ResponseUnion, conditional_put_),
@@ -1014,6 +1092,8 @@ void protobuf_AssignDesc_cockroach_2froachpb_2fapi_2eproto() {
     GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(ResponseUnion, leader_lease_),
     GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(ResponseUnion, reverse_scan_),
     GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(ResponseUnion, noop_),
+    GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(ResponseUnion, filtered_scan_),
+    GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(ResponseUnion, clear_range_),
   };
   ResponseUnion_reflection_ =
     ::google::protobuf::internal::GeneratedMessageReflection::NewGeneratedMessageReflection(
@@ -1026,7 +1106,7 @@ void protobuf_AssignDesc_cockroach_2froachpb_2fapi_2eproto() {
       sizeof(ResponseUnion),
       GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(ResponseUnion, _internal_metadata_),
       -1);
-  Header_descriptor_ = file->message_type(49);
+  Header_descriptor_ = file->message_type(53);
   static const int Header_offsets_[7] = {
     GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(Header, timestamp_),
     GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(Header, cmd_id_),
@@ -1047,7 +1127,7 @@ void protobuf_AssignDesc_cockroach_2froachpb_2fapi_2eproto() {
       sizeof(Header),
       GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(Header, _internal_metadata_),
       -1);
-  BatchRequest_descriptor_ = file->message_type(50);
+  BatchRequest_descriptor_ = file->message_type(54);
   static const int BatchRequest_offsets_[2] = {
     GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(BatchRequest, header_),
     GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(BatchRequest, requests_),
@@ -1063,7 +1143,7 @@ void protobuf_AssignDesc_cockroach_2froachpb_2fapi_2eproto() {
       sizeof(BatchRequest),
       GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(BatchRequest, _internal_metadata_),
       -1);
-  BatchResponse_descriptor_ = file->message_type(51);
+  BatchResponse_descriptor_ = file->message_type(55);
   static const int BatchResponse_offsets_[2] = {
     GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(BatchResponse, header_),
     GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(BatchResponse, responses_),
@@ -1140,6 +1220,10 @@ void protobuf_RegisterTypes(const ::std::string&) {
       DeleteRangeRequest_descriptor_, &DeleteRangeRequest::default_instance());
   ::google::protobuf::MessageFactory::InternalRegisterGeneratedMessage(
       DeleteRangeResponse_descriptor_, &DeleteRangeResponse::default_instance());
+  ::google::protobuf::MessageFactory::InternalRegisterGeneratedMessage(
+      ClearRangeRequest_descriptor_, &ClearRangeRequest::default_instance());
+  ::google::protobuf::MessageFactory::InternalRegisterGeneratedMessage(
+      ClearRangeResponse_descriptor_, &ClearRangeResponse::default_instance());
   ::google::protobuf::MessageFactory::InternalRegisterGeneratedMessage(
       ScanRequest_descriptor_, &ScanRequest::default_instance());
   ::google::protobuf::MessageFactory::InternalRegisterGeneratedMessage(
@@ -1148,6 +1232,10 @@ void protobuf_RegisterTypes(const ::std::string&) {
       ReverseScanRequest_descriptor_, &ReverseScanRequest::default_instance());
   ::google::protobuf::MessageFactory::InternalRegisterGeneratedMessage(
       ReverseScanResponse_descriptor_, &ReverseScanResponse::default_instance());
+  ::google::protobuf::MessageFactory::InternalRegisterGeneratedMessage(
+      FilteredScanRequest_descriptor_, &FilteredScanRequest::default_instance());
+  ::google::protobuf::MessageFactory::InternalRegisterGeneratedMessage(
+      FilteredScanResponse_descriptor_, &FilteredScanResponse::default_instance());
   ::google::protobuf::MessageFactory::InternalRegisterGeneratedMessage(
       BeginTransactionRequest_descriptor_, &BeginTransactionRequest::default_instance());
   ::google::protobuf::MessageFactory::InternalRegisterGeneratedMessage(
@@ -1253,6 +1341,10 @@ void protobuf_ShutdownFile_cockroach_2froachpb_2fapi_2eproto() {
   delete DeleteRangeRequest_reflection_;
   delete DeleteRangeResponse::default_instance_;
   delete DeleteRangeResponse_reflection_;
+  delete ClearRangeRequest::default_instance_;
+  delete ClearRangeRequest_reflection_;
+  delete ClearRangeResponse::default_instance_;
+  delete ClearRangeResponse_reflection_;
   delete ScanRequest::default_instance_;
   delete ScanRequest_reflection_;
   delete ScanResponse::default_instance_;
@@ -1261,6 +1353,10 @@ void protobuf_ShutdownFile_cockroach_2froachpb_2fapi_2eproto() {
   delete ReverseScanRequest_reflection_;
   delete ReverseScanResponse::default_instance_;
   delete ReverseScanResponse_reflection_;
+  delete FilteredScanRequest::default_instance_;
+  delete FilteredScanRequest_reflection_;
+  delete FilteredScanResponse::default_instance_;
+  delete FilteredScanResponse_reflection_;
   delete BeginTransactionRequest::default_instance_;
   delete BeginTransactionRequest_reflection_;
   delete BeginTransactionResponse::default_instance_;
@@ -1384,191 +1480,208 @@ void protobuf_AddDesc_cockroach_2froachpb_2fapi_2eproto() {
     "(\003B\004\310\336\037\000\"m\n\023DeleteRangeResponse\022;\n\006heade"
     "r\030\001 \001(\0132!.cockroach.roachpb.ResponseHead"
     "erB\010\310\336\037\000\320\336\037\001\022\031\n\013num_deleted\030\002 \001(\003B\004\310\336\037\000\""
-    "[\n\013ScanRequest\0221\n\006header\030\001 \001(\0132\027.cockroa"
-    "ch.roachpb.SpanB\010\310\336\037\000\320\336\037\001\022\031\n\013max_results"
-    "\030\002 \001(\003B\004\310\336\037\000\"|\n\014ScanResponse\022;\n\006header\030\001"
-    " \001(\0132!.cockroach.roachpb.ResponseHeaderB"
-    "\010\310\336\037\000\320\336\037\001\022/\n\004rows\030\002 \003(\0132\033.cockroach.roac"
-    "hpb.KeyValueB\004\310\336\037\000\"b\n\022ReverseScanRequest"
-    "\0221\n\006header\030\001 \001(\0132\027.cockroach.roachpb.Spa"
-    "nB\010\310\336\037\000\320\336\037\001\022\031\n\013max_results\030\002 \001(\003B\004\310\336\037\000\"\203"
-    "\001\n\023ReverseScanResponse\022;\n\006header\030\001 \001(\0132!"
-    ".cockroach.roachpb.ResponseHeaderB\010\310\336\037\000\320"
-    "\336\037\001\022/\n\004rows\030\002 \003(\0132\033.cockroach.roachpb.Ke"
-    "yValueB\004\310\336\037\000\"L\n\027BeginTransactionRequest\022"
-    "1\n\006header\030\001 \001(\0132\027.cockroach.roachpb.Span"
-    "B\010\310\336\037\000\320\336\037\001\"W\n\030BeginTransactionResponse\022;"
-    "\n\006header\030\001 \001(\0132!.cockroach.roachpb.Respo"
-    "nseHeaderB\010\310\336\037\000\320\336\037\001\"\335\001\n\025EndTransactionRe"
-    "quest\0221\n\006header\030\001 \001(\0132\027.cockroach.roachp"
-    "b.SpanB\010\310\336\037\000\320\336\037\001\022\024\n\006commit\030\002 \001(\010B\004\310\336\037\000\022I"
-    "\n\027internal_commit_trigger\030\003 \001(\0132(.cockro"
-    "ach.roachpb.InternalCommitTrigger\0220\n\007int"
-    "ents\030\004 \003(\0132\031.cockroach.roachpb.IntentB\004\310"
-    "\336\037\000\"\213\001\n\026EndTransactionResponse\022;\n\006header"
-    "\030\001 \001(\0132!.cockroach.roachpb.ResponseHeade"
-    "rB\010\310\336\037\000\320\336\037\001\022\031\n\013commit_wait\030\002 \001(\003B\004\310\336\037\000\022\031"
-    "\n\010resolved\030\003 \003(\014B\007\372\336\037\003Key\"b\n\021AdminSplitR"
+    "F\n\021ClearRangeRequest\0221\n\006header\030\001 \001(\0132\027.c"
+    "ockroach.roachpb.SpanB\010\310\336\037\000\320\336\037\001\"l\n\022Clear"
+    "RangeResponse\022;\n\006header\030\001 \001(\0132!.cockroac"
+    "h.roachpb.ResponseHeaderB\010\310\336\037\000\320\336\037\001\022\031\n\013nu"
+    "m_cleared\030\002 \001(\003B\004\310\336\037\000\"[\n\013ScanRequest\0221\n\006"
+    "header\030\001 \001(\0132\027.cockroach.roachpb.SpanB\010\310"
+    "\336\037\000\320\336\037\001\022\031\n\013max_results\030\002 \001(\003B\004\310\336\037\000\"|\n\014Sc"
+    "anResponse\022;\n\006header\030\001 \001(\0132!.cockroach.r"
+    "oachpb.ResponseHeaderB\010\310\336\037\000\320\336\037\001\022/\n\004rows\030"
+    "\002 \003(\0132\033.cockroach.roachpb.KeyValueB\004\310\336\037\000"
+    "\"b\n\022ReverseScanRequest\0221\n\006header\030\001 \001(\0132\027"
+    ".cockroach.roachpb.SpanB\010\310\336\037\000\320\336\037\001\022\031\n\013max"
+    "_results\030\002 \001(\003B\004\310\336\037\000\"\203\001\n\023ReverseScanResp"
+    "onse\022;\n\006header\030\001 \001(\0132!.cockroach.roachpb"
+    ".ResponseHeaderB\010\310\336\037\000\320\336\037\001\022/\n\004rows\030\002 \003(\0132"
+    "\033.cockroach.roachpb.KeyValueB\004\310\336\037\000\"u\n\023Fi"
+    "lteredScanRequest\0221\n\006header\030\001 \001(\0132\027.cock"
+    "roach.roachpb.SpanB\010\310\336\037\000\320\336\037\001\022\016\n\006filter\030\002"
+    " \001(\014\022\033\n\013max_results\030\003 \001(\003B\004\310\336\037\000"
+    "\"\204\001\n\024FilteredScanResponse\022;\n\006header\030"
+    "\001 \001(\0132!.cockroach.roachpb.ResponseHeader"
+    "B\010\310\336\037\000\320\336\037\001\022/\n\004rows\030\002 \003(\0132\033.cockroach.roa"
+    "chpb.KeyValueB\004\310\336\037\000\"L\n\027BeginTransactionR"
     "equest\0221\n\006header\030\001 \001(\0132\027.cockroach.roach"
-    "pb.SpanB\010\310\336\037\000\320\336\037\001\022\032\n\tsplit_key\030\002 \001(\014B\007\372\336"
-    "\037\003Key\"Q\n\022AdminSplitResponse\022;\n\006header\030\001 "
-    "\001(\0132!.cockroach.roachpb.ResponseHeaderB\010"
-    "\310\336\037\000\320\336\037\001\"F\n\021AdminMergeRequest\0221\n\006header\030"
-    "\001 \001(\0132\027.cockroach.roachpb.SpanB\010\310\336\037\000\320\336\037\001"
-    "\"Q\n\022AdminMergeResponse\022;\n\006header\030\001 \001(\0132!"
-    ".cockroach.roachpb.ResponseHeaderB\010\310\336\037\000\320"
-    "\336\037\001\"\230\001\n\022RangeLookupRequest\0221\n\006header\030\001 \001"
-    "(\0132\027.cockroach.roachpb.SpanB\010\310\336\037\000\320\336\037\001\022\030\n"
-    "\nmax_ranges\030\002 \001(\005B\004\310\336\037\000\022\036\n\020consider_inte"
-    "nts\030\003 \001(\010B\004\310\336\037\000\022\025\n\007reverse\030\004 \001(\010B\004\310\336\037\000\"\214"
-    "\001\n\023RangeLookupResponse\022;\n\006header\030\001 \001(\0132!"
-    ".cockroach.roachpb.ResponseHeaderB\010\310\336\037\000\320"
-    "\336\037\001\0228\n\006ranges\030\002 \003(\0132\".cockroach.roachpb."
-    "RangeDescriptorB\004\310\336\037\000\"H\n\023HeartbeatTxnReq"
-    "uest\0221\n\006header\030\001 \001(\0132\027.cockroach.roachpb"
-    ".SpanB\010\310\336\037\000\320\336\037\001\"S\n\024HeartbeatTxnResponse\022"
-    ";\n\006header\030\001 \001(\0132!.cockroach.roachpb.Resp"
-    "onseHeaderB\010\310\336\037\000\320\336\037\001\"\214\002\n\tGCRequest\0221\n\006he"
-    "ader\030\001 \001(\0132\027.cockroach.roachpb.SpanB\010\310\336\037"
-    "\000\320\336\037\001\022>\n\007gc_meta\030\002 \001(\0132\035.cockroach.roach"
-    "pb.GCMetadataB\016\310\336\037\000\342\336\037\006GCMeta\0226\n\004keys\030\003 "
-    "\003(\0132\".cockroach.roachpb.GCRequest.GCKeyB"
-    "\004\310\336\037\000\032T\n\005GCKey\022\024\n\003key\030\001 \001(\014B\007\372\336\037\003Key\0225\n\t"
-    "timestamp\030\002 \001(\0132\034.cockroach.roachpb.Time"
-    "stampB\004\310\336\037\000\"I\n\nGCResponse\022;\n\006header\030\001 \001("
-    "\0132!.cockroach.roachpb.ResponseHeaderB\010\310\336"
-    "\037\000\320\336\037\001\"\326\002\n\016PushTxnRequest\0221\n\006header\030\001 \001("
-    "\0132\027.cockroach.roachpb.SpanB\010\310\336\037\000\320\336\037\001\0228\n\n"
-    "pusher_txn\030\002 \001(\0132\036.cockroach.roachpb.Tra"
-    "nsactionB\004\310\336\037\000\0228\n\npushee_txn\030\003 \001(\0132\036.coc"
-    "kroach.roachpb.TransactionB\004\310\336\037\000\0223\n\007push"
-    "_to\030\004 \001(\0132\034.cockroach.roachpb.TimestampB"
-    "\004\310\336\037\000\022/\n\003now\030\005 \001(\0132\034.cockroach.roachpb.T"
-    "imestampB\004\310\336\037\000\0227\n\tpush_type\030\006 \001(\0162\036.cock"
-    "roach.roachpb.PushTxnTypeB\004\310\336\037\000\"\202\001\n\017Push"
-    "TxnResponse\022;\n\006header\030\001 \001(\0132!.cockroach."
-    "roachpb.ResponseHeaderB\010\310\336\037\000\320\336\037\001\0222\n\npush"
-    "ee_txn\030\002 \001(\0132\036.cockroach.roachpb.Transac"
-    "tion\"\203\001\n\024ResolveIntentRequest\0221\n\006header\030"
-    "\001 \001(\0132\027.cockroach.roachpb.SpanB\010\310\336\037\000\320\336\037\001"
-    "\0228\n\nintent_txn\030\002 \001(\0132\036.cockroach.roachpb"
-    ".TransactionB\004\310\336\037\000\"T\n\025ResolveIntentRespo"
-    "nse\022;\n\006header\030\001 \001(\0132!.cockroach.roachpb."
-    "ResponseHeaderB\010\310\336\037\000\320\336\037\001\"\210\001\n\031ResolveInte"
-    "ntRangeRequest\0221\n\006header\030\001 \001(\0132\027.cockroa"
-    "ch.roachpb.SpanB\010\310\336\037\000\320\336\037\001\0228\n\nintent_txn\030"
-    "\002 \001(\0132\036.cockroach.roachpb.TransactionB\004\310"
-    "\336\037\000\"K\n\014NoopResponse\022;\n\006header\030\001 \001(\0132!.co"
-    "ckroach.roachpb.ResponseHeaderB\010\310\336\037\000\320\336\037\001"
-    "\"@\n\013NoopRequest\0221\n\006header\030\001 \001(\0132\027.cockro"
-    "ach.roachpb.SpanB\010\310\336\037\000\320\336\037\001\"Y\n\032ResolveInt"
-    "entRangeResponse\022;\n\006header\030\001 \001(\0132!.cockr"
-    "oach.roachpb.ResponseHeaderB\010\310\336\037\000\320\336\037\001\"p\n"
-    "\014MergeRequest\0221\n\006header\030\001 \001(\0132\027.cockroac"
-    "h.roachpb.SpanB\010\310\336\037\000\320\336\037\001\022-\n\005value\030\002 \001(\0132"
-    "\030.cockroach.roachpb.ValueB\004\310\336\037\000\"L\n\rMerge"
-    "Response\022;\n\006header\030\001 \001(\0132!.cockroach.roa"
-    "chpb.ResponseHeaderB\010\310\336\037\000\320\336\037\001\"\\\n\022Truncat"
-    "eLogRequest\0221\n\006header\030\001 \001(\0132\027.cockroach."
-    "roachpb.SpanB\010\310\336\037\000\320\336\037\001\022\023\n\005index\030\002 \001(\004B\004\310"
-    "\336\037\000\"R\n\023TruncateLogResponse\022;\n\006header\030\001 \001"
+    "pb.SpanB\010\310\336\037\000\320\336\037\001\"W\n\030BeginTransactionRes"
+    "ponse\022;\n\006header\030\001 \001(\0132!.cockroach.roachp"
+    "b.ResponseHeaderB\010\310\336\037\000\320\336\037\001\"\335\001\n\025EndTransa"
+    "ctionRequest\0221\n\006header\030\001 \001(\0132\027.cockroach"
+    ".roachpb.SpanB\010\310\336\037\000\320\336\037\001\022\024\n\006commit\030\002 \001(\010B"
+    "\004\310\336\037\000\022I\n\027internal_commit_trigger\030\003 \001(\0132("
+    ".cockroach.roachpb.InternalCommitTrigger"
+    "\0220\n\007intents\030\004 \003(\0132\031.cockroach.roachpb.In"
+    "tentB\004\310\336\037\000\"\213\001\n\026EndTransactionResponse\022;\n"
+    "\006header\030\001 \001(\0132!.cockroach.roachpb.Respon"
+    "seHeaderB\010\310\336\037\000\320\336\037\001\022\031\n\013commit_wait\030\002 \001(\003B"
+    "\004\310\336\037\000\022\031\n\010resolved\030\003 \003(\014B\007\372\336\037\003Key\"b\n\021Admi"
+    "nSplitRequest\0221\n\006header\030\001 \001(\0132\027.cockroac"
+    "h.roachpb.SpanB\010\310\336\037\000\320\336\037\001\022\032\n\tsplit_key\030\002 "
+    "\001(\014B\007\372\336\037\003Key\"Q\n\022AdminSplitResponse\022;\n\006he"
+    "ader\030\001 \001(\0132!.cockroach.roachpb.ResponseH"
+    "eaderB\010\310\336\037\000\320\336\037\001\"F\n\021AdminMergeRequest\0221\n\006"
+    "header\030\001 \001(\0132\027.cockroach.roachpb.SpanB\010\310"
+    "\336\037\000\320\336\037\001\"Q\n\022AdminMergeResponse\022;\n\006header\030"
+    "\001 \001(\0132!.cockroach.roachpb.ResponseHeader"
+    "B\010\310\336\037\000\320\336\037\001\"\230\001\n\022RangeLookupRequest\0221\n\006hea"
+    "der\030\001 \001(\0132\027.cockroach.roachpb.SpanB\010\310\336\037\000"
+    "\320\336\037\001\022\030\n\nmax_ranges\030\002 \001(\005B\004\310\336\037\000\022\036\n\020consid"
+    "er_intents\030\003 \001(\010B\004\310\336\037\000\022\025\n\007reverse\030\004 \001(\010B"
+    "\004\310\336\037\000\"\214\001\n\023RangeLookupResponse\022;\n\006header\030"
+    "\001 \001(\0132!.cockroach.roachpb.ResponseHeader"
+    "B\010\310\336\037\000\320\336\037\001\0228\n\006ranges\030\002 \003(\0132\".cockroach.r"
+    "oachpb.RangeDescriptorB\004\310\336\037\000\"H\n\023Heartbea"
+    "tTxnRequest\0221\n\006header\030\001 \001(\0132\027.cockroach."
+    "roachpb.SpanB\010\310\336\037\000\320\336\037\001\"S\n\024HeartbeatTxnRe"
+    "sponse\022;\n\006header\030\001 \001(\0132!.cockroach.roach"
+    "pb.ResponseHeaderB\010\310\336\037\000\320\336\037\001\"\214\002\n\tGCReques"
+    "t\0221\n\006header\030\001 \001(\0132\027.cockroach.roachpb.Sp"
+    "anB\010\310\336\037\000\320\336\037\001\022>\n\007gc_meta\030\002 \001(\0132\035.cockroac"
+    "h.roachpb.GCMetadataB\016\310\336\037\000\342\336\037\006GCMeta\0226\n\004"
+    "keys\030\003 \003(\0132\".cockroach.roachpb.GCRequest"
+    ".GCKeyB\004\310\336\037\000\032T\n\005GCKey\022\024\n\003key\030\001 \001(\014B\007\372\336\037\003"
+    "Key\0225\n\ttimestamp\030\002 \001(\0132\034.cockroach.roach"
+    "pb.TimestampB\004\310\336\037\000\"I\n\nGCResponse\022;\n\006head"
+    "er\030\001 \001(\0132!.cockroach.roachpb.ResponseHea"
+    "derB\010\310\336\037\000\320\336\037\001\"\326\002\n\016PushTxnRequest\0221\n\006head"
+    "er\030\001 \001(\0132\027.cockroach.roachpb.SpanB\010\310\336\037\000\320"
+    "\336\037\001\0228\n\npusher_txn\030\002 \001(\0132\036.cockroach.roac"
+    "hpb.TransactionB\004\310\336\037\000\0228\n\npushee_txn\030\003 \001("
+    "\0132\036.cockroach.roachpb.TransactionB\004\310\336\037\000\022"
+    "3\n\007push_to\030\004 \001(\0132\034.cockroach.roachpb.Tim"
+    "estampB\004\310\336\037\000\022/\n\003now\030\005 \001(\0132\034.cockroach.ro"
+    "achpb.TimestampB\004\310\336\037\000\0227\n\tpush_type\030\006 \001(\016"
+    "2\036.cockroach.roachpb.PushTxnTypeB\004\310\336\037\000\"\202"
+    "\001\n\017PushTxnResponse\022;\n\006header\030\001 \001(\0132!.coc"
+    "kroach.roachpb.ResponseHeaderB\010\310\336\037\000\320\336\037\001\022"
+    "2\n\npushee_txn\030\002 \001(\0132\036.cockroach.roachpb."
+    "Transaction\"\203\001\n\024ResolveIntentRequest\0221\n\006"
+    "header\030\001 \001(\0132\027.cockroach.roachpb.SpanB\010\310"
+    "\336\037\000\320\336\037\001\0228\n\nintent_txn\030\002 \001(\0132\036.cockroach."
+    "roachpb.TransactionB\004\310\336\037\000\"T\n\025ResolveInte"
+    "ntResponse\022;\n\006header\030\001 \001(\0132!.cockroach.r"
+    "oachpb.ResponseHeaderB\010\310\336\037\000\320\336\037\001\"\210\001\n\031Reso"
+    "lveIntentRangeRequest\0221\n\006header\030\001 \001(\0132\027."
+    "cockroach.roachpb.SpanB\010\310\336\037\000\320\336\037\001\0228\n\ninte"
+    "nt_txn\030\002 \001(\0132\036.cockroach.roachpb.Transac"
+    "tionB\004\310\336\037\000\"K\n\014NoopResponse\022;\n\006header\030\001 \001"
     "(\0132!.cockroach.roachpb.ResponseHeaderB\010\310"
-    "\336\037\000\320\336\037\001\"v\n\022LeaderLeaseRequest\0221\n\006header\030"
-    "\001 \001(\0132\027.cockroach.roachpb.SpanB\010\310\336\037\000\320\336\037\001"
-    "\022-\n\005lease\030\002 \001(\0132\030.cockroach.roachpb.Leas"
-    "eB\004\310\336\037\000\"R\n\023LeaderLeaseResponse\022;\n\006header"
-    "\030\001 \001(\0132!.cockroach.roachpb.ResponseHeade"
-    "rB\010\310\336\037\000\320\336\037\001\"\201\n\n\014RequestUnion\022*\n\003get\030\001 \001("
-    "\0132\035.cockroach.roachpb.GetRequest\022*\n\003put\030"
-    "\002 \001(\0132\035.cockroach.roachpb.PutRequest\022A\n\017"
-    "conditional_put\030\003 \001(\0132(.cockroach.roachp"
-    "b.ConditionalPutRequest\0226\n\tincrement\030\004 \001"
-    "(\0132#.cockroach.roachpb.IncrementRequest\022"
-    "0\n\006delete\030\005 \001(\0132 .cockroach.roachpb.Dele"
-    "teRequest\022;\n\014delete_range\030\006 \001(\0132%.cockro"
-    "ach.roachpb.DeleteRangeRequest\022,\n\004scan\030\007"
-    " \001(\0132\036.cockroach.roachpb.ScanRequest\022E\n\021"
-    "begin_transaction\030\010 \001(\0132*.cockroach.roac"
-    "hpb.BeginTransactionRequest\022A\n\017end_trans"
-    "action\030\t \001(\0132(.cockroach.roachpb.EndTran"
-    "sactionRequest\0229\n\013admin_split\030\n \001(\0132$.co"
-    "ckroach.roachpb.AdminSplitRequest\0229\n\013adm"
-    "in_merge\030\013 \001(\0132$.cockroach.roachpb.Admin"
-    "MergeRequest\022=\n\rheartbeat_txn\030\014 \001(\0132&.co"
-    "ckroach.roachpb.HeartbeatTxnRequest\022(\n\002g"
-    "c\030\r \001(\0132\034.cockroach.roachpb.GCRequest\0223\n"
-    "\010push_txn\030\016 \001(\0132!.cockroach.roachpb.Push"
-    "TxnRequest\022;\n\014range_lookup\030\017 \001(\0132%.cockr"
-    "oach.roachpb.RangeLookupRequest\022\?\n\016resol"
-    "ve_intent\030\020 \001(\0132\'.cockroach.roachpb.Reso"
-    "lveIntentRequest\022J\n\024resolve_intent_range"
-    "\030\021 \001(\0132,.cockroach.roachpb.ResolveIntent"
-    "RangeRequest\022.\n\005merge\030\022 \001(\0132\037.cockroach."
-    "roachpb.MergeRequest\022;\n\014truncate_log\030\023 \001"
-    "(\0132%.cockroach.roachpb.TruncateLogReques"
-    "t\022;\n\014leader_lease\030\024 \001(\0132%.cockroach.roac"
-    "hpb.LeaderLeaseRequest\022;\n\014reverse_scan\030\025"
-    " \001(\0132%.cockroach.roachpb.ReverseScanRequ"
-    "est\022,\n\004noop\030\026 \001(\0132\036.cockroach.roachpb.No"
-    "opRequest:\004\310\240\037\001\"\230\n\n\rResponseUnion\022+\n\003get"
-    "\030\001 \001(\0132\036.cockroach.roachpb.GetResponse\022+"
-    "\n\003put\030\002 \001(\0132\036.cockroach.roachpb.PutRespo"
-    "nse\022B\n\017conditional_put\030\003 \001(\0132).cockroach"
-    ".roachpb.ConditionalPutResponse\0227\n\tincre"
-    "ment\030\004 \001(\0132$.cockroach.roachpb.Increment"
-    "Response\0221\n\006delete\030\005 \001(\0132!.cockroach.roa"
-    "chpb.DeleteResponse\022<\n\014delete_range\030\006 \001("
-    "\0132&.cockroach.roachpb.DeleteRangeRespons"
-    "e\022-\n\004scan\030\007 \001(\0132\037.cockroach.roachpb.Scan"
-    "Response\022F\n\021begin_transaction\030\010 \001(\0132+.co"
-    "ckroach.roachpb.BeginTransactionResponse"
-    "\022B\n\017end_transaction\030\t \001(\0132).cockroach.ro"
-    "achpb.EndTransactionResponse\022:\n\013admin_sp"
-    "lit\030\n \001(\0132%.cockroach.roachpb.AdminSplit"
-    "Response\022:\n\013admin_merge\030\013 \001(\0132%.cockroac"
-    "h.roachpb.AdminMergeResponse\022>\n\rheartbea"
-    "t_txn\030\014 \001(\0132\'.cockroach.roachpb.Heartbea"
-    "tTxnResponse\022)\n\002gc\030\r \001(\0132\035.cockroach.roa"
-    "chpb.GCResponse\0224\n\010push_txn\030\016 \001(\0132\".cock"
-    "roach.roachpb.PushTxnResponse\022<\n\014range_l"
-    "ookup\030\017 \001(\0132&.cockroach.roachpb.RangeLoo"
-    "kupResponse\022@\n\016resolve_intent\030\020 \001(\0132(.co"
-    "ckroach.roachpb.ResolveIntentResponse\022K\n"
-    "\024resolve_intent_range\030\021 \001(\0132-.cockroach."
-    "roachpb.ResolveIntentRangeResponse\022/\n\005me"
-    "rge\030\022 \001(\0132 .cockroach.roachpb.MergeRespo"
-    "nse\022<\n\014truncate_log\030\023 \001(\0132&.cockroach.ro"
-    "achpb.TruncateLogResponse\022<\n\014leader_leas"
-    "e\030\024 \001(\0132&.cockroach.roachpb.LeaderLeaseR"
-    "esponse\022<\n\014reverse_scan\030\025 \001(\0132&.cockroac"
-    "h.roachpb.ReverseScanResponse\022-\n\004noop\030\026 "
-    "\001(\0132\037.cockroach.roachpb.NoopResponse:\004\310\240"
-    "\037\001\"\376\002\n\006Header\0225\n\ttimestamp\030\001 \001(\0132\034.cockr"
-    "oach.roachpb.TimestampB\004\310\336\037\000\022=\n\006cmd_id\030\002"
-    " \001(\0132\036.cockroach.roachpb.ClientCmdIDB\r\310\336"
-    "\037\000\342\336\037\005CmdID\022;\n\007replica\030\005 \001(\0132$.cockroach"
-    ".roachpb.ReplicaDescriptorB\004\310\336\037\000\022,\n\010rang"
-    "e_id\030\006 \001(\003B\032\310\336\037\000\342\336\037\007RangeID\372\336\037\007RangeID\022\030"
-    "\n\ruser_priority\030\007 \001(\005:\0011\022+\n\003txn\030\010 \001(\0132\036."
-    "cockroach.roachpb.Transaction\022F\n\020read_co"
-    "nsistency\030\t \001(\0162&.cockroach.roachpb.Read"
-    "ConsistencyTypeB\004\310\336\037\000:\004\210\240\037\001\"\202\001\n\014BatchReq"
-    "uest\0223\n\006header\030\001 \001(\0132\031.cockroach.roachpb"
-    ".HeaderB\010\310\336\037\000\320\336\037\001\0227\n\010requests\030\002 \003(\0132\037.co"
-    "ckroach.roachpb.RequestUnionB\004\310\336\037\000:\004\230\240\037\000"
-    "\"\253\002\n\rBatchResponse\022A\n\006header\030\001 \001(\0132\'.coc"
-    "kroach.roachpb.BatchResponse.HeaderB\010\310\336\037"
-    "\000\320\336\037\001\0229\n\tresponses\030\002 \003(\0132 .cockroach.roa"
-    "chpb.ResponseUnionB\004\310\336\037\000\032\225\001\n\006Header\022\'\n\005e"
-    "rror\030\001 \001(\0132\030.cockroach.roachpb.Error\0225\n\t"
-    "timestamp\030\002 \001(\0132\034.cockroach.roachpb.Time"
-    "stampB\004\310\336\037\000\022+\n\003txn\030\003 \001(\0132\036.cockroach.roa"
-    "chpb.Transaction:\004\230\240\037\000*L\n\023ReadConsistenc"
-    "yType\022\016\n\nCONSISTENT\020\000\022\r\n\tCONSENSUS\020\001\022\020\n\014"
-    "INCONSISTENT\020\002\032\004\210\243\036\000*G\n\013PushTxnType\022\022\n\016P"
-    "USH_TIMESTAMP\020\000\022\r\n\tABORT_TXN\020\001\022\017\n\013CLEANU"
-    "P_TXN\020\002\032\004\210\243\036\000B\035Z\007roachpb\310\341\036\000\220\343\036\000\310\342\036\001\340\342\036\001"
-    "\320\342\036\001", 8964);
+    "\336\037\000\320\336\037\001\"@\n\013NoopRequest\0221\n\006header\030\001 \001(\0132\027"
+    ".cockroach.roachpb.SpanB\010\310\336\037\000\320\336\037\001\"Y\n\032Res"
+    "olveIntentRangeResponse\022;\n\006header\030\001 \001(\0132"
+    "!.cockroach.roachpb.ResponseHeaderB\010\310\336\037\000"
+    "\320\336\037\001\"p\n\014MergeRequest\0221\n\006header\030\001 \001(\0132\027.c"
+    "ockroach.roachpb.SpanB\010\310\336\037\000\320\336\037\001\022-\n\005value"
+    "\030\002 \001(\0132\030.cockroach.roachpb.ValueB\004\310\336\037\000\"L"
+    "\n\rMergeResponse\022;\n\006header\030\001 \001(\0132!.cockro"
+    "ach.roachpb.ResponseHeaderB\010\310\336\037\000\320\336\037\001\"\\\n\022"
+    "TruncateLogRequest\0221\n\006header\030\001 \001(\0132\027.coc"
+    "kroach.roachpb.SpanB\010\310\336\037\000\320\336\037\001\022\023\n\005index\030\002"
+    " \001(\004B\004\310\336\037\000\"R\n\023TruncateLogResponse\022;\n\006hea"
+    "der\030\001 \001(\0132!.cockroach.roachpb.ResponseHe"
+    "aderB\010\310\336\037\000\320\336\037\001\"v\n\022LeaderLeaseRequest\0221\n\006"
+    "header\030\001 \001(\0132\027.cockroach.roachpb.SpanB\010\310"
+    "\336\037\000\320\336\037\001\022-\n\005lease\030\002 \001(\0132\030.cockroach.roach"
+    "pb.LeaseB\004\310\336\037\000\"R\n\023LeaderLeaseResponse\022;\n"
+    "\006header\030\001 \001(\0132!.cockroach.roachpb.Respon"
+    "seHeaderB\010\310\336\037\000\320\336\037\001\"\373\n\n\014RequestUnion\022*\n\003g"
+    "et\030\001 \001(\0132\035.cockroach.roachpb.GetRequest\022"
+    "*\n\003put\030\002 \001(\0132\035.cockroach.roachpb.PutRequ"
+    "est\022A\n\017conditional_put\030\003 \001(\0132(.cockroach"
+    ".roachpb.ConditionalPutRequest\0226\n\tincrem"
+    "ent\030\004 \001(\0132#.cockroach.roachpb.IncrementR"
+    "equest\0220\n\006delete\030\005 \001(\0132 .cockroach.roach"
+    "pb.DeleteRequest\022;\n\014delete_range\030\006 \001(\0132%"
+    ".cockroach.roachpb.DeleteRangeRequest\022,\n"
+    "\004scan\030\007 \001(\0132\036.cockroach.roachpb.ScanRequ"
+    "est\022E\n\021begin_transaction\030\010 \001(\0132*.cockroa"
+    "ch.roachpb.BeginTransactionRequest\022A\n\017en"
+    "d_transaction\030\t \001(\0132(.cockroach.roachpb."
+    "EndTransactionRequest\0229\n\013admin_split\030\n \001"
+    "(\0132$.cockroach.roachpb.AdminSplitRequest"
+    "\0229\n\013admin_merge\030\013 \001(\0132$.cockroach.roachp"
+    "b.AdminMergeRequest\022=\n\rheartbeat_txn\030\014 \001"
+    "(\0132&.cockroach.roachpb.HeartbeatTxnReque"
+    "st\022(\n\002gc\030\r \001(\0132\034.cockroach.roachpb.GCReq"
+    "uest\0223\n\010push_txn\030\016 \001(\0132!.cockroach.roach"
+    "pb.PushTxnRequest\022;\n\014range_lookup\030\017 \001(\0132"
+    "%.cockroach.roachpb.RangeLookupRequest\022\?"
+    "\n\016resolve_intent\030\020 \001(\0132\'.cockroach.roach"
+    "pb.ResolveIntentRequest\022J\n\024resolve_inten"
+    "t_range\030\021 \001(\0132,.cockroach.roachpb.Resolv"
+    "eIntentRangeRequest\022.\n\005merge\030\022 \001(\0132\037.coc"
+    "kroach.roachpb.MergeRequest\022;\n\014truncate_"
+    "log\030\023 \001(\0132%.cockroach.roachpb.TruncateLo"
+    "gRequest\022;\n\014leader_lease\030\024 \001(\0132%.cockroa"
+    "ch.roachpb.LeaderLeaseRequest\022;\n\014reverse"
+    "_scan\030\025 \001(\0132%.cockroach.roachpb.ReverseS"
+    "canRequest\022,\n\004noop\030\026 \001(\0132\036.cockroach.roa"
+    "chpb.NoopRequest\022=\n\rfiltered_scan\030\027 \001(\0132"
+    "&.cockroach.roachpb.FilteredScanRequest\022"
+    "9\n\013clear_range\030\030 \001(\0132$.cockroach.roachpb"
+    ".ClearRangeRequest:\004\310\240\037\001\"\224\013\n\rResponseUni"
+    "on\022+\n\003get\030\001 \001(\0132\036.cockroach.roachpb.GetR"
+    "esponse\022+\n\003put\030\002 \001(\0132\036.cockroach.roachpb"
+    ".PutResponse\022B\n\017conditional_put\030\003 \001(\0132)."
+    "cockroach.roachpb.ConditionalPutResponse"
+    "\0227\n\tincrement\030\004 \001(\0132$.cockroach.roachpb."
+    "IncrementResponse\0221\n\006delete\030\005 \001(\0132!.cock"
+    "roach.roachpb.DeleteResponse\022<\n\014delete_r"
+    "ange\030\006 \001(\0132&.cockroach.roachpb.DeleteRan"
+    "geResponse\022-\n\004scan\030\007 \001(\0132\037.cockroach.roa"
+    "chpb.ScanResponse\022F\n\021begin_transaction\030\010"
+    " \001(\0132+.cockroach.roachpb.BeginTransactio"
+    "nResponse\022B\n\017end_transaction\030\t \001(\0132).coc"
+    "kroach.roachpb.EndTransactionResponse\022:\n"
+    "\013admin_split\030\n \001(\0132%.cockroach.roachpb.A"
+    "dminSplitResponse\022:\n\013admin_merge\030\013 \001(\0132%"
+    ".cockroach.roachpb.AdminMergeResponse\022>\n"
+    "\rheartbeat_txn\030\014 \001(\0132\'.cockroach.roachpb"
+    ".HeartbeatTxnResponse\022)\n\002gc\030\r \001(\0132\035.cock"
+    "roach.roachpb.GCResponse\0224\n\010push_txn\030\016 \001"
+    "(\0132\".cockroach.roachpb.PushTxnResponse\022<"
+    "\n\014range_lookup\030\017 \001(\0132&.cockroach.roachpb"
+    ".RangeLookupResponse\022@\n\016resolve_intent\030\020"
+    " \001(\0132(.cockroach.roachpb.ResolveIntentRe"
+    "sponse\022K\n\024resolve_intent_range\030\021 \001(\0132-.c"
+    "ockroach.roachpb.ResolveIntentRangeRespo"
+    "nse\022/\n\005merge\030\022 \001(\0132 .cockroach.roachpb.M"
+    "ergeResponse\022<\n\014truncate_log\030\023 \001(\0132&.coc"
+    "kroach.roachpb.TruncateLogResponse\022<\n\014le"
+    "ader_lease\030\024 \001(\0132&.cockroach.roachpb.Lea"
+    "derLeaseResponse\022<\n\014reverse_scan\030\025 \001(\0132&"
+    ".cockroach.roachpb.ReverseScanResponse\022-"
+    "\n\004noop\030\026 \001(\0132\037.cockroach.roachpb.NoopRes"
+    "ponse\022>\n\rfiltered_scan\030\027 \001(\0132\'.cockroach"
+    ".roachpb.FilteredScanResponse\022:\n\013clear_r"
+    "ange\030\030 \001(\0132%.cockroach.roachpb.ClearRang"
+    "eResponse:\004\310\240\037\001\"\376\002\n\006Header\0225\n\ttimestamp\030"
+    "\001 \001(\0132\034.cockroach.roachpb.TimestampB\004\310\336\037"
+    "\000\022=\n\006cmd_id\030\002 \001(\0132\036.cockroach.roachpb.Cl"
+    "ientCmdIDB\r\310\336\037\000\342\336\037\005CmdID\022;\n\007replica\030\005 \001("
+    "\0132$.cockroach.roachpb.ReplicaDescriptorB"
+    "\004\310\336\037\000\022,\n\010range_id\030\006 \001(\003B\032\310\336\037\000\342\336\037\007RangeID"
+    "\372\336\037\007RangeID\022\030\n\ruser_priority\030\007 \001(\005:\0011\022+\n"
+    "\003txn\030\010 \001(\0132\036.cockroach.roachpb.Transacti"
+    "on\022F\n\020read_consistency\030\t \001(\0162&.cockroach"
+    ".roachpb.ReadConsistencyTypeB\004\310\336\037\000:\004\210\240\037\001"
+    "\"\202\001\n\014BatchRequest\0223\n\006header\030\001 \001(\0132\031.cock"
+    "roach.roachpb.HeaderB\010\310\336\037\000\320\336\037\001\0227\n\010reques"
+    "ts\030\002 \003(\0132\037.cockroach.roachpb.RequestUnio"
+    "nB\004\310\336\037\000:\004\230\240\037\000\"\253\002\n\rBatchResponse\022A\n\006heade"
+    "r\030\001 \001(\0132\'.cockroach.roachpb.BatchRespons"
+    "e.HeaderB\010\310\336\037\000\320\336\037\001\0229\n\tresponses\030\002 \003(\0132 ."
+    "cockroach.roachpb.ResponseUnionB\004\310\336\037\000\032\225\001"
+    "\n\006Header\022\'\n\005error\030\001 \001(\0132\030.cockroach.roac"
+    "hpb.Error\0225\n\ttimestamp\030\002 \001(\0132\034.cockroach"
+    ".roachpb.TimestampB\004\310\336\037\000\022+\n\003txn\030\003 \001(\0132\036."
+    "cockroach.roachpb.Transaction:\004\230\240\037\000*L\n\023R"
+    "eadConsistencyType\022\016\n\nCONSISTENT\020\000\022\r\n\tCO"
+    "NSENSUS\020\001\022\020\n\014INCONSISTENT\020\002\032\004\210\243\036\000*G\n\013Pus"
+    "hTxnType\022\022\n\016PUSH_TIMESTAMP\020\000\022\r\n\tABORT_TX"
+    "N\020\001\022\017\n\013CLEANUP_TXN\020\002\032\004\210\243\036\000B\035Z\007roachpb\310\341\036"
+    "\000\220\343\036\000\310\342\036\001\340\342\036\001\320\342\036\001", 9646);
   ::google::protobuf::MessageFactory::InternalRegisterGeneratedFile(
     "cockroach/roachpb/api.proto", &protobuf_RegisterTypes);
   ClientCmdID::default_instance_ = new ClientCmdID();
@@ -1586,10 +1699,14 @@ void protobuf_AddDesc_cockroach_2froachpb_2fapi_2eproto() {
   DeleteResponse::default_instance_ = new DeleteResponse();
   DeleteRangeRequest::default_instance_ = new DeleteRangeRequest();
   DeleteRangeResponse::default_instance_ = new DeleteRangeResponse();
+  ClearRangeRequest::default_instance_ = new ClearRangeRequest();
+  ClearRangeResponse::default_instance_ = new ClearRangeResponse();
   ScanRequest::default_instance_ = new ScanRequest();
   ScanResponse::default_instance_ = new ScanResponse();
   ReverseScanRequest::default_instance_ = new ReverseScanRequest();
   ReverseScanResponse::default_instance_ = new ReverseScanResponse();
+  FilteredScanRequest::default_instance_ = new FilteredScanRequest();
+  FilteredScanResponse::default_instance_ = new FilteredScanResponse();
   BeginTransactionRequest::default_instance_ = new BeginTransactionRequest();
   BeginTransactionResponse::default_instance_ = new BeginTransactionResponse();
   EndTransactionRequest::default_instance_ = new EndTransactionRequest();
@@ -1640,10 +1757,14 @@ void protobuf_AddDesc_cockroach_2froachpb_2fapi_2eproto() {
   DeleteResponse::default_instance_->InitAsDefaultInstance();
   DeleteRangeRequest::default_instance_->InitAsDefaultInstance();
   DeleteRangeResponse::default_instance_->InitAsDefaultInstance();
+  ClearRangeRequest::default_instance_->InitAsDefaultInstance();
+  ClearRangeResponse::default_instance_->InitAsDefaultInstance();
   ScanRequest::default_instance_->InitAsDefaultInstance();
   ScanResponse::default_instance_->InitAsDefaultInstance();
   ReverseScanRequest::default_instance_->InitAsDefaultInstance();
   ReverseScanResponse::default_instance_->InitAsDefaultInstance();
+  FilteredScanRequest::default_instance_->InitAsDefaultInstance();
+  FilteredScanResponse::default_instance_->InitAsDefaultInstance();
   BeginTransactionRequest::default_instance_->InitAsDefaultInstance();
   BeginTransactionResponse::default_instance_->InitAsDefaultInstance();
   EndTransactionRequest::default_instance_->InitAsDefaultInstance();
@@ -6860,77 +6981,72 @@ void DeleteRangeResponse::clear_num_deleted() {
 // ===================================================================
 
 #ifndef _MSC_VER
-const int ScanRequest::kHeaderFieldNumber;
-const int ScanRequest::kMaxResultsFieldNumber;
+const int ClearRangeRequest::kHeaderFieldNumber;
 #endif  // !_MSC_VER
 
-ScanRequest::ScanRequest()
+ClearRangeRequest::ClearRangeRequest()
   : ::google::protobuf::Message(), _internal_metadata_(NULL) {
   SharedCtor();
-  // @@protoc_insertion_point(constructor:cockroach.roachpb.ScanRequest)
+  // @@protoc_insertion_point(constructor:cockroach.roachpb.ClearRangeRequest)
 }
 
-void ScanRequest::InitAsDefaultInstance() {
+void ClearRangeRequest::InitAsDefaultInstance() {
   header_ = const_cast< ::cockroach::roachpb::Span*>(&::cockroach::roachpb::Span::default_instance());
 }
 
-ScanRequest::ScanRequest(const ScanRequest& from)
+ClearRangeRequest::ClearRangeRequest(const ClearRangeRequest& from)
   : ::google::protobuf::Message(),
     _internal_metadata_(NULL) {
   SharedCtor();
   MergeFrom(from);
-  // @@protoc_insertion_point(copy_constructor:cockroach.roachpb.ScanRequest)
+  // @@protoc_insertion_point(copy_constructor:cockroach.roachpb.ClearRangeRequest)
 }
 
-void ScanRequest::SharedCtor() {
+void ClearRangeRequest::SharedCtor() {
   _cached_size_ = 0;
   header_ = NULL;
-  max_results_ = GOOGLE_LONGLONG(0);
   ::memset(_has_bits_, 0, sizeof(_has_bits_));
 }
 
-ScanRequest::~ScanRequest() {
-  // @@protoc_insertion_point(destructor:cockroach.roachpb.ScanRequest)
+ClearRangeRequest::~ClearRangeRequest() {
+  // @@protoc_insertion_point(destructor:cockroach.roachpb.ClearRangeRequest)
   SharedDtor();
 }
 
-void ScanRequest::SharedDtor() {
+void ClearRangeRequest::SharedDtor() {
   if (this != default_instance_) {
     delete header_;
   }
 }
 
-void ScanRequest::SetCachedSize(int size) const {
+void ClearRangeRequest::SetCachedSize(int size) const {
   GOOGLE_SAFE_CONCURRENT_WRITES_BEGIN();
   _cached_size_ = size;
   GOOGLE_SAFE_CONCURRENT_WRITES_END();
 }
-const ::google::protobuf::Descriptor* ScanRequest::descriptor() {
+const ::google::protobuf::Descriptor* ClearRangeRequest::descriptor() {
   protobuf_AssignDescriptorsOnce();
-  return ScanRequest_descriptor_;
+  return ClearRangeRequest_descriptor_;
 }
 
-const ScanRequest& ScanRequest::default_instance() {
+const ClearRangeRequest& ClearRangeRequest::default_instance() {
   if (default_instance_ == NULL) protobuf_AddDesc_cockroach_2froachpb_2fapi_2eproto();
   return *default_instance_;
 }
 
-ScanRequest* ScanRequest::default_instance_ = NULL;
+ClearRangeRequest* ClearRangeRequest::default_instance_ = NULL;
 
-ScanRequest* ScanRequest::New(::google::protobuf::Arena* arena) const {
-  ScanRequest* n = new ScanRequest;
+ClearRangeRequest* ClearRangeRequest::New(::google::protobuf::Arena* arena) const {
+  ClearRangeRequest* n = new ClearRangeRequest;
   if (arena != NULL) {
     arena->Own(n);
   }
   return n;
 }
 
-void ScanRequest::Clear() {
-  if (_has_bits_[0 / 32] & 3u) {
-    if (has_header()) {
-      if (header_ != NULL) header_->::cockroach::roachpb::Span::Clear();
-    }
-    max_results_ = GOOGLE_LONGLONG(0);
+void ClearRangeRequest::Clear() {
+  if (has_header()) {
+    if (header_ != NULL) header_->::cockroach::roachpb::Span::Clear();
   }
   ::memset(_has_bits_, 0, sizeof(_has_bits_));
   if (_internal_metadata_.have_unknown_fields()) {
@@ -6938,11 +7054,11 @@ void ScanRequest::Clear() {
   }
 }
 
-bool ScanRequest::MergePartialFromCodedStream(
+bool ClearRangeRequest::MergePartialFromCodedStream(
     ::google::protobuf::io::CodedInputStream* input) {
 #define DO_(EXPRESSION) if (!(EXPRESSION)) goto failure
   ::google::protobuf::uint32 tag;
-  // @@protoc_insertion_point(parse_start:cockroach.roachpb.ScanRequest)
+  // @@protoc_insertion_point(parse_start:cockroach.roachpb.ClearRangeRequest)
   for (;;) {
     ::std::pair< ::google::protobuf::uint32, bool> p = input->ReadTagWithCutoff(127);
     tag = p.first;
@@ -6956,21 +7072,6 @@ bool ScanRequest::MergePartialFromCodedStream(
         } else {
           goto handle_unusual;
         }
-        if (input->ExpectTag(16)) goto parse_max_results;
-        break;
-      }
-
-      // optional int64 max_results = 2;
-      case 2: {
-        if (tag == 16) {
-         parse_max_results:
-          DO_((::google::protobuf::internal::WireFormatLite::ReadPrimitive<
-                   ::google::protobuf::int64, ::google::protobuf::internal::WireFormatLite::TYPE_INT64>(
-                 input, &max_results_)));
-          set_has_max_results();
-        } else {
-          goto handle_unusual;
-        }
         if (input->ExpectAtEnd()) goto success;
         break;
       }
@@ -6989,38 +7090,33 @@ bool ScanRequest::MergePartialFromCodedStream(
     }
   }
 success:
-  // @@protoc_insertion_point(parse_success:cockroach.roachpb.ScanRequest)
+  // @@protoc_insertion_point(parse_success:cockroach.roachpb.ClearRangeRequest)
   return true;
 failure:
-  // @@protoc_insertion_point(parse_failure:cockroach.roachpb.ScanRequest)
+  // @@protoc_insertion_point(parse_failure:cockroach.roachpb.ClearRangeRequest)
   return false;
 #undef DO_
 }
 
-void ScanRequest::SerializeWithCachedSizes(
+void ClearRangeRequest::SerializeWithCachedSizes(
     ::google::protobuf::io::CodedOutputStream* output) const {
-  // @@protoc_insertion_point(serialize_start:cockroach.roachpb.ScanRequest)
+  // @@protoc_insertion_point(serialize_start:cockroach.roachpb.ClearRangeRequest)
   // optional .cockroach.roachpb.Span header = 1;
   if (has_header()) {
     ::google::protobuf::internal::WireFormatLite::WriteMessageMaybeToArray(
       1, *this->header_, output);
   }
 
-  // optional int64 max_results = 2;
-  if (has_max_results()) {
-    ::google::protobuf::internal::WireFormatLite::WriteInt64(2, this->max_results(), output);
-  }
-
   if (_internal_metadata_.have_unknown_fields()) {
     ::google::protobuf::internal::WireFormat::SerializeUnknownFields(
         unknown_fields(), output);
   }
-  // @@protoc_insertion_point(serialize_end:cockroach.roachpb.ScanRequest)
+  // @@protoc_insertion_point(serialize_end:cockroach.roachpb.ClearRangeRequest)
 }
 
-::google::protobuf::uint8* ScanRequest::SerializeWithCachedSizesToArray(
+::google::protobuf::uint8* ClearRangeRequest::SerializeWithCachedSizesToArray(
     ::google::protobuf::uint8* target) const {
-  // @@protoc_insertion_point(serialize_to_array_start:cockroach.roachpb.ScanRequest)
+  // @@protoc_insertion_point(serialize_to_array_start:cockroach.roachpb.ClearRangeRequest)
   // optional .cockroach.roachpb.Span header = 1;
   if (has_header()) {
     target = ::google::protobuf::internal::WireFormatLite::
@@ -7028,38 +7124,24 @@ void ScanRequest::SerializeWithCachedSizes(
         1, *this->header_, target);
   }
 
-  // optional int64 max_results = 2;
-  if (has_max_results()) {
-    target = ::google::protobuf::internal::WireFormatLite::WriteInt64ToArray(2, this->max_results(), target);
-  }
-
   if (_internal_metadata_.have_unknown_fields()) {
     target = ::google::protobuf::internal::WireFormat::SerializeUnknownFieldsToArray(
         unknown_fields(), target);
   }
-  // @@protoc_insertion_point(serialize_to_array_end:cockroach.roachpb.ScanRequest)
+  // @@protoc_insertion_point(serialize_to_array_end:cockroach.roachpb.ClearRangeRequest)
   return target;
 }
 
-int ScanRequest::ByteSize() const {
+int ClearRangeRequest::ByteSize() const {
   int total_size = 0;
 
-  if (_has_bits_[0 / 32] & 3) {
-    // optional .cockroach.roachpb.Span header = 1;
-    if (has_header()) {
-      total_size += 1 +
-        ::google::protobuf::internal::WireFormatLite::MessageSizeNoVirtual(
-          *this->header_);
-    }
-
-    // optional int64 max_results = 2;
-    if (has_max_results()) {
-      total_size += 1 +
-        ::google::protobuf::internal::WireFormatLite::Int64Size(
-          this->max_results());
-    }
-
+  // optional .cockroach.roachpb.Span header = 1;
+  if (has_header()) {
+    total_size += 1 +
+      ::google::protobuf::internal::WireFormatLite::MessageSizeNoVirtual(
+        *this->header_);
   }
+
   if (_internal_metadata_.have_unknown_fields()) {
     total_size +=
       ::google::protobuf::internal::WireFormat::ComputeUnknownFieldsSize(
@@ -7071,10 +7153,10 @@ int ScanRequest::ByteSize() const {
   return total_size;
 }
 
-void ScanRequest::MergeFrom(const ::google::protobuf::Message& from) {
+void ClearRangeRequest::MergeFrom(const ::google::protobuf::Message& from) {
   if (GOOGLE_PREDICT_FALSE(&from == this)) MergeFromFail(__LINE__);
-  const ScanRequest* source = 
-      ::google::protobuf::internal::DynamicCastToGenerated<const ScanRequest>(
+  const ClearRangeRequest* source = 
+      ::google::protobuf::internal::DynamicCastToGenerated<const ClearRangeRequest>(
           &from);
   if (source == NULL) {
     ::google::protobuf::internal::ReflectionOps::Merge(from, this);
@@ -7083,94 +7165,90 @@ void ScanRequest::MergeFrom(const ::google::protobuf::Message& from) {
   }
 }
 
-void ScanRequest::MergeFrom(const ScanRequest& from) {
+void ClearRangeRequest::MergeFrom(const ClearRangeRequest& from) {
   if (GOOGLE_PREDICT_FALSE(&from == this)) MergeFromFail(__LINE__);
   if (from._has_bits_[0 / 32] & (0xffu << (0 % 32))) {
     if (from.has_header()) {
       mutable_header()->::cockroach::roachpb::Span::MergeFrom(from.header());
     }
-    if (from.has_max_results()) {
-      set_max_results(from.max_results());
-    }
   }
   if (from._internal_metadata_.have_unknown_fields()) {
     mutable_unknown_fields()->MergeFrom(from.unknown_fields());
   }
 }
 
-void ScanRequest::CopyFrom(const ::google::protobuf::Message& from) {
+void ClearRangeRequest::CopyFrom(const ::google::protobuf::Message& from) {
   if (&from == this) return;
   Clear();
   MergeFrom(from);
 }
 
-void ScanRequest::CopyFrom(const ScanRequest& from) {
+void ClearRangeRequest::CopyFrom(const ClearRangeRequest& from) {
   if (&from == this) return;
   Clear();
   MergeFrom(from);
 }
 
-bool ScanRequest::IsInitialized() const {
+bool ClearRangeRequest::IsInitialized() const {
 
   return true;
 }
 
-void ScanRequest::Swap(ScanRequest* other) {
+void ClearRangeRequest::Swap(ClearRangeRequest* other) {
   if (other == this) return;
   InternalSwap(other);
 }
-void ScanRequest::InternalSwap(ScanRequest* other) {
+void ClearRangeRequest::InternalSwap(ClearRangeRequest* other) {
   std::swap(header_, other->header_);
-  std::swap(max_results_, other->max_results_);
   std::swap(_has_bits_[0], other->_has_bits_[0]);
   _internal_metadata_.Swap(&other->_internal_metadata_);
   std::swap(_cached_size_, other->_cached_size_);
 }
 
-::google::protobuf::Metadata ScanRequest::GetMetadata() const {
+::google::protobuf::Metadata ClearRangeRequest::GetMetadata() const {
   protobuf_AssignDescriptorsOnce();
   ::google::protobuf::Metadata metadata;
-  metadata.descriptor = ScanRequest_descriptor_;
-  metadata.reflection = ScanRequest_reflection_;
+  metadata.descriptor = ClearRangeRequest_descriptor_;
+  metadata.reflection = ClearRangeRequest_reflection_;
   return metadata;
 }
 
 #if PROTOBUF_INLINE_NOT_IN_HEADERS
-// ScanRequest
+// ClearRangeRequest
 
 // optional .cockroach.roachpb.Span header = 1;
-bool ScanRequest::has_header() const {
+bool ClearRangeRequest::has_header() const {
   return (_has_bits_[0] & 0x00000001u) != 0;
 }
-void ScanRequest::set_has_header() {
+void ClearRangeRequest::set_has_header() {
   _has_bits_[0] |= 0x00000001u;
 }
-void ScanRequest::clear_has_header() {
+void ClearRangeRequest::clear_has_header() {
   _has_bits_[0] &= ~0x00000001u;
 }
-void ScanRequest::clear_header() {
+void ClearRangeRequest::clear_header() {
   if (header_ != NULL) header_->::cockroach::roachpb::Span::Clear();
   clear_has_header();
 }
- const ::cockroach::roachpb::Span& ScanRequest::header() const {
-  // @@protoc_insertion_point(field_get:cockroach.roachpb.ScanRequest.header)
+ const ::cockroach::roachpb::Span& ClearRangeRequest::header() const {
+  // @@protoc_insertion_point(field_get:cockroach.roachpb.ClearRangeRequest.header)
   return header_ != NULL ? *header_ : *default_instance_->header_;
 }
- ::cockroach::roachpb::Span* ScanRequest::mutable_header() {
+ ::cockroach::roachpb::Span* ClearRangeRequest::mutable_header() {
   set_has_header();
   if (header_ == NULL) {
     header_ = new ::cockroach::roachpb::Span;
   }
-  // @@protoc_insertion_point(field_mutable:cockroach.roachpb.ScanRequest.header)
+  // @@protoc_insertion_point(field_mutable:cockroach.roachpb.ClearRangeRequest.header)
   return header_;
 }
- ::cockroach::roachpb::Span* ScanRequest::release_header() {
+ ::cockroach::roachpb::Span* ClearRangeRequest::release_header() {
   clear_has_header();
   ::cockroach::roachpb::Span* temp = header_;
   header_ = NULL;
   return temp;
 }
- void ScanRequest::set_allocated_header(::cockroach::roachpb::Span* header) {
+ void ClearRangeRequest::set_allocated_header(::cockroach::roachpb::Span* header) {
   delete header_;
   header_ = header;
   if (header) {
@@ -7178,31 +7256,7 @@ void ScanRequest::clear_header() {
   } else {
     clear_has_header();
   }
-  // @@protoc_insertion_point(field_set_allocated:cockroach.roachpb.ScanRequest.header)
-}
-
-// optional int64 max_results = 2;
-bool ScanRequest::has_max_results() const {
-  return (_has_bits_[0] & 0x00000002u) != 0;
-}
-void ScanRequest::set_has_max_results() {
-  _has_bits_[0] |= 0x00000002u;
-}
-void ScanRequest::clear_has_max_results() {
-  _has_bits_[0] &= ~0x00000002u;
-}
-void ScanRequest::clear_max_results() {
-  max_results_ = GOOGLE_LONGLONG(0);
-  clear_has_max_results();
-}
- ::google::protobuf::int64 ScanRequest::max_results() const {
-  // @@protoc_insertion_point(field_get:cockroach.roachpb.ScanRequest.max_results)
-  return max_results_;
-}
- void ScanRequest::set_max_results(::google::protobuf::int64 value) {
-  set_has_max_results();
-  max_results_ = value;
-  // @@protoc_insertion_point(field_set:cockroach.roachpb.ScanRequest.max_results)
+  // @@protoc_insertion_point(field_set_allocated:cockroach.roachpb.ClearRangeRequest.header)
 }
 
 #endif  // PROTOBUF_INLINE_NOT_IN_HEADERS
@@ -7210,86 +7264,89 @@ void ScanRequest::clear_max_results() {
 // ===================================================================
 
 #ifndef _MSC_VER
-const int ScanResponse::kHeaderFieldNumber;
-const int ScanResponse::kRowsFieldNumber;
+const int ClearRangeResponse::kHeaderFieldNumber;
+const int ClearRangeResponse::kNumClearedFieldNumber;
 #endif  // !_MSC_VER
 
-ScanResponse::ScanResponse()
+ClearRangeResponse::ClearRangeResponse()
   : ::google::protobuf::Message(), _internal_metadata_(NULL) {
   SharedCtor();
-  // @@protoc_insertion_point(constructor:cockroach.roachpb.ScanResponse)
+  // @@protoc_insertion_point(constructor:cockroach.roachpb.ClearRangeResponse)
 }
 
-void ScanResponse::InitAsDefaultInstance() {
+void ClearRangeResponse::InitAsDefaultInstance() {
   header_ = const_cast< ::cockroach::roachpb::ResponseHeader*>(&::cockroach::roachpb::ResponseHeader::default_instance());
 }
 
-ScanResponse::ScanResponse(const ScanResponse& from)
+ClearRangeResponse::ClearRangeResponse(const ClearRangeResponse& from)
   : ::google::protobuf::Message(),
     _internal_metadata_(NULL) {
   SharedCtor();
   MergeFrom(from);
-  // @@protoc_insertion_point(copy_constructor:cockroach.roachpb.ScanResponse)
+  // @@protoc_insertion_point(copy_constructor:cockroach.roachpb.ClearRangeResponse)
 }
 
-void ScanResponse::SharedCtor() {
+void ClearRangeResponse::SharedCtor() {
   _cached_size_ = 0;
   header_ = NULL;
+  num_cleared_ = GOOGLE_LONGLONG(0);
   ::memset(_has_bits_, 0, sizeof(_has_bits_));
 }
 
-ScanResponse::~ScanResponse() {
-  // @@protoc_insertion_point(destructor:cockroach.roachpb.ScanResponse)
+ClearRangeResponse::~ClearRangeResponse() {
+  // @@protoc_insertion_point(destructor:cockroach.roachpb.ClearRangeResponse)
   SharedDtor();
 }
 
-void ScanResponse::SharedDtor() {
+void ClearRangeResponse::SharedDtor() {
   if (this != default_instance_) {
     delete header_;
   }
 }
 
-void ScanResponse::SetCachedSize(int size) const {
+void ClearRangeResponse::SetCachedSize(int size) const {
   GOOGLE_SAFE_CONCURRENT_WRITES_BEGIN();
   _cached_size_ = size;
   GOOGLE_SAFE_CONCURRENT_WRITES_END();
 }
-const ::google::protobuf::Descriptor* ScanResponse::descriptor() {
+const ::google::protobuf::Descriptor* ClearRangeResponse::descriptor() {
   protobuf_AssignDescriptorsOnce();
-  return ScanResponse_descriptor_;
+  return ClearRangeResponse_descriptor_;
 }
 
-const ScanResponse& ScanResponse::default_instance() {
+const ClearRangeResponse& ClearRangeResponse::default_instance() {
   if (default_instance_ == NULL) protobuf_AddDesc_cockroach_2froachpb_2fapi_2eproto();
   return *default_instance_;
 }
 
-ScanResponse* ScanResponse::default_instance_ = NULL;
+ClearRangeResponse* ClearRangeResponse::default_instance_ = NULL;
 
-ScanResponse* ScanResponse::New(::google::protobuf::Arena* arena) const {
-  ScanResponse* n = new ScanResponse;
+ClearRangeResponse* ClearRangeResponse::New(::google::protobuf::Arena* arena) const {
+  ClearRangeResponse* n = new ClearRangeResponse;
   if (arena != NULL) {
     arena->Own(n);
   }
   return n;
 }
 
-void ScanResponse::Clear() {
-  if (has_header()) {
-    if (header_ != NULL) header_->::cockroach::roachpb::ResponseHeader::Clear();
+void ClearRangeResponse::Clear() {
+  if (_has_bits_[0 / 32] & 3u) {
+    if (has_header()) {
+      if (header_ != NULL) header_->::cockroach::roachpb::ResponseHeader::Clear();
+    }
+    num_cleared_ = GOOGLE_LONGLONG(0);
   }
-  rows_.Clear();
   ::memset(_has_bits_, 0, sizeof(_has_bits_));
   if (_internal_metadata_.have_unknown_fields()) {
     mutable_unknown_fields()->Clear();
   }
 }
 
-bool ScanResponse::MergePartialFromCodedStream(
+bool ClearRangeResponse::MergePartialFromCodedStream(
     ::google::protobuf::io::CodedInputStream* input) {
 #define DO_(EXPRESSION) if (!(EXPRESSION)) goto failure
   ::google::protobuf::uint32 tag;
-  // @@protoc_insertion_point(parse_start:cockroach.roachpb.ScanResponse)
+  // @@protoc_insertion_point(parse_start:cockroach.roachpb.ClearRangeResponse)
   for (;;) {
     ::std::pair< ::google::protobuf::uint32, bool> p = input->ReadTagWithCutoff(127);
     tag = p.first;
@@ -7303,23 +7360,21 @@ bool ScanResponse::MergePartialFromCodedStream(
         } else {
           goto handle_unusual;
         }
-        if (input->ExpectTag(18)) goto parse_rows;
+        if (input->ExpectTag(16)) goto parse_num_cleared;
         break;
       }
 
-      // repeated .cockroach.roachpb.KeyValue rows = 2;
+      // optional int64 num_cleared = 2;
       case 2: {
-        if (tag == 18) {
-         parse_rows:
-          DO_(input->IncrementRecursionDepth());
-         parse_loop_rows:
-          DO_(::google::protobuf::internal::WireFormatLite::ReadMessageNoVirtualNoRecursionDepth(
-                input, add_rows()));
+        if (tag == 16) {
+         parse_num_cleared:
+          DO_((::google::protobuf::internal::WireFormatLite::ReadPrimitive<
+                   ::google::protobuf::int64, ::google::protobuf::internal::WireFormatLite::TYPE_INT64>(
+                 input, &num_cleared_)));
+          set_has_num_cleared();
         } else {
           goto handle_unusual;
         }
-        if (input->ExpectTag(18)) goto parse_loop_rows;
-        input->UnsafeDecrementRecursionDepth();
         if (input->ExpectAtEnd()) goto success;
         break;
       }
@@ -7338,39 +7393,38 @@ bool ScanResponse::MergePartialFromCodedStream(
     }
   }
 success:
-  // @@protoc_insertion_point(parse_success:cockroach.roachpb.ScanResponse)
+  // @@protoc_insertion_point(parse_success:cockroach.roachpb.ClearRangeResponse)
   return true;
 failure:
-  // @@protoc_insertion_point(parse_failure:cockroach.roachpb.ScanResponse)
+  // @@protoc_insertion_point(parse_failure:cockroach.roachpb.ClearRangeResponse)
   return false;
 #undef DO_
 }
 
-void ScanResponse::SerializeWithCachedSizes(
+void ClearRangeResponse::SerializeWithCachedSizes(
     ::google::protobuf::io::CodedOutputStream* output) const {
-  // @@protoc_insertion_point(serialize_start:cockroach.roachpb.ScanResponse)
+  // @@protoc_insertion_point(serialize_start:cockroach.roachpb.ClearRangeResponse)
   // optional .cockroach.roachpb.ResponseHeader header = 1;
   if (has_header()) {
     ::google::protobuf::internal::WireFormatLite::WriteMessageMaybeToArray(
       1, *this->header_, output);
   }
 
-  // repeated .cockroach.roachpb.KeyValue rows = 2;
-  for (unsigned int i = 0, n = this->rows_size(); i < n; i++) {
-    ::google::protobuf::internal::WireFormatLite::WriteMessageMaybeToArray(
-      2, this->rows(i), output);
+  // optional int64 num_cleared = 2;
+  if (has_num_cleared()) {
+    ::google::protobuf::internal::WireFormatLite::WriteInt64(2, this->num_cleared(), output);
   }
 
   if (_internal_metadata_.have_unknown_fields()) {
     ::google::protobuf::internal::WireFormat::SerializeUnknownFields(
         unknown_fields(), output);
   }
-  // @@protoc_insertion_point(serialize_end:cockroach.roachpb.ScanResponse)
+  // @@protoc_insertion_point(serialize_end:cockroach.roachpb.ClearRangeResponse)
 }
 
-::google::protobuf::uint8* ScanResponse::SerializeWithCachedSizesToArray(
+::google::protobuf::uint8* ClearRangeResponse::SerializeWithCachedSizesToArray(
     ::google::protobuf::uint8* target) const {
-  // @@protoc_insertion_point(serialize_to_array_start:cockroach.roachpb.ScanResponse)
+  // @@protoc_insertion_point(serialize_to_array_start:cockroach.roachpb.ClearRangeResponse)
   // optional .cockroach.roachpb.ResponseHeader header = 1;
   if (has_header()) {
     target = ::google::protobuf::internal::WireFormatLite::
@@ -7378,39 +7432,38 @@ void ScanResponse::SerializeWithCachedSizes(
         1, *this->header_, target);
   }
 
-  // repeated .cockroach.roachpb.KeyValue rows = 2;
-  for (unsigned int i = 0, n = this->rows_size(); i < n; i++) {
-    target = ::google::protobuf::internal::WireFormatLite::
-      WriteMessageNoVirtualToArray(
-        2, this->rows(i), target);
+  // optional int64 num_cleared = 2;
+  if (has_num_cleared()) {
+    target = ::google::protobuf::internal::WireFormatLite::WriteInt64ToArray(2, this->num_cleared(), target);
   }
 
   if (_internal_metadata_.have_unknown_fields()) {
     target = ::google::protobuf::internal::WireFormat::SerializeUnknownFieldsToArray(
         unknown_fields(), target);
   }
-  // @@protoc_insertion_point(serialize_to_array_end:cockroach.roachpb.ScanResponse)
+  // @@protoc_insertion_point(serialize_to_array_end:cockroach.roachpb.ClearRangeResponse)
   return target;
 }
 
-int ScanResponse::ByteSize() const {
+int ClearRangeResponse::ByteSize() const {
   int total_size = 0;
 
-  // optional .cockroach.roachpb.ResponseHeader header = 1;
-  if (has_header()) {
-    total_size += 1 +
-      ::google::protobuf::internal::WireFormatLite::MessageSizeNoVirtual(
-        *this->header_);
-  }
+  if (_has_bits_[0 / 32] & 3) {
+    // optional .cockroach.roachpb.ResponseHeader header = 1;
+    if (has_header()) {
+      total_size += 1 +
+        ::google::protobuf::internal::WireFormatLite::MessageSizeNoVirtual(
+          *this->header_);
+    }
 
-  // repeated .cockroach.roachpb.KeyValue rows = 2;
-  total_size += 1 * this->rows_size();
-  for (int i = 0; i < this->rows_size(); i++) {
-    total_size +=
-      ::google::protobuf::internal::WireFormatLite::MessageSizeNoVirtual(
-        this->rows(i));
-  }
+    // optional int64 num_cleared = 2;
+    if (has_num_cleared()) {
+      total_size += 1 +
+        ::google::protobuf::internal::WireFormatLite::Int64Size(
+          this->num_cleared());
+    }
 
+  }
   if (_internal_metadata_.have_unknown_fields()) {
     total_size +=
       ::google::protobuf::internal::WireFormat::ComputeUnknownFieldsSize(
@@ -7422,10 +7475,10 @@ int ScanResponse::ByteSize() const {
   return total_size;
 }
 
-void ScanResponse::MergeFrom(const ::google::protobuf::Message& from) {
+void ClearRangeResponse::MergeFrom(const ::google::protobuf::Message& from) {
   if (GOOGLE_PREDICT_FALSE(&from == this)) MergeFromFail(__LINE__);
-  const ScanResponse* source = 
-      ::google::protobuf::internal::DynamicCastToGenerated<const ScanResponse>(
+  const ClearRangeResponse* source = 
+      ::google::protobuf::internal::DynamicCastToGenerated<const ClearRangeResponse>(
           &from);
   if (source == NULL) {
     ::google::protobuf::internal::ReflectionOps::Merge(from, this);
@@ -7434,9 +7487,1415 @@ void ScanResponse::MergeFrom(const ::google::protobuf::Message& from) {
   }
 }
 
-void ScanResponse::MergeFrom(const ScanResponse& from) {
+void ClearRangeResponse::MergeFrom(const ClearRangeResponse& from) {
   if (GOOGLE_PREDICT_FALSE(&from == this)) MergeFromFail(__LINE__);
-  rows_.MergeFrom(from.rows_);
+  if (from._has_bits_[0 / 32] & (0xffu << (0 % 32))) {
+    if (from.has_header()) {
+      mutable_header()->::cockroach::roachpb::ResponseHeader::MergeFrom(from.header());
+    }
+    if (from.has_num_cleared()) {
+      set_num_cleared(from.num_cleared());
+    }
+  }
+  if (from._internal_metadata_.have_unknown_fields()) {
+    mutable_unknown_fields()->MergeFrom(from.unknown_fields());
+  }
+}
+
+void ClearRangeResponse::CopyFrom(const ::google::protobuf::Message& from) {
+  if (&from == this) return;
+  Clear();
+  MergeFrom(from);
+}
+
+void ClearRangeResponse::CopyFrom(const ClearRangeResponse& from) {
+  if (&from == this) return;
+  Clear();
+  MergeFrom(from);
+}
+
+bool ClearRangeResponse::IsInitialized() const {
+
+  return true;
+}
+
+void ClearRangeResponse::Swap(ClearRangeResponse* other) {
+  if (other == this) return;
+  InternalSwap(other);
+}
+void ClearRangeResponse::InternalSwap(ClearRangeResponse* other) {
+  std::swap(header_, other->header_);
+  std::swap(num_cleared_, other->num_cleared_);
+  std::swap(_has_bits_[0], other->_has_bits_[0]);
+  _internal_metadata_.Swap(&other->_internal_metadata_);
+  std::swap(_cached_size_, other->_cached_size_);
+}
+
+::google::protobuf::Metadata ClearRangeResponse::GetMetadata() const {
+  protobuf_AssignDescriptorsOnce();
+  ::google::protobuf::Metadata metadata;
+  metadata.descriptor = ClearRangeResponse_descriptor_;
+  metadata.reflection = ClearRangeResponse_reflection_;
+  return metadata;
+}
+
+#if PROTOBUF_INLINE_NOT_IN_HEADERS
+// ClearRangeResponse
+
+// optional .cockroach.roachpb.ResponseHeader header = 1;
+bool ClearRangeResponse::has_header() const {
+  return (_has_bits_[0] & 0x00000001u) != 0;
+}
+void ClearRangeResponse::set_has_header() {
+  _has_bits_[0] |= 0x00000001u;
+}
+void ClearRangeResponse::clear_has_header() {
+  _has_bits_[0] &= ~0x00000001u;
+}
+void ClearRangeResponse::clear_header() {
+  if (header_ != NULL) header_->::cockroach::roachpb::ResponseHeader::Clear();
+  clear_has_header();
+}
+ const ::cockroach::roachpb::ResponseHeader& ClearRangeResponse::header() const {
+  // @@protoc_insertion_point(field_get:cockroach.roachpb.ClearRangeResponse.header)
+  return header_ != NULL ? *header_ : *default_instance_->header_;
+}
+ ::cockroach::roachpb::ResponseHeader* ClearRangeResponse::mutable_header() {
+  set_has_header();
+  if (header_ == NULL) {
+    header_ = new ::cockroach::roachpb::ResponseHeader;
+  }
+  // @@protoc_insertion_point(field_mutable:cockroach.roachpb.ClearRangeResponse.header)
+  return header_;
+}
+ ::cockroach::roachpb::ResponseHeader* ClearRangeResponse::release_header() {
+  clear_has_header();
+  ::cockroach::roachpb::ResponseHeader* temp = header_;
+  header_ = NULL;
+  return temp;
+}
+ void ClearRangeResponse::set_allocated_header(::cockroach::roachpb::ResponseHeader* header) {
+  delete header_;
+  header_ = header;
+  if (header) {
+    set_has_header();
+  } else {
+    clear_has_header();
+  }
+  // @@protoc_insertion_point(field_set_allocated:cockroach.roachpb.ClearRangeResponse.header)
+}
+
+// optional int64 num_cleared = 2;
+bool ClearRangeResponse::has_num_cleared() const {
+  return (_has_bits_[0] & 0x00000002u) != 0;
+}
+void ClearRangeResponse::set_has_num_cleared() {
+  _has_bits_[0] |= 0x00000002u;
+}
+void ClearRangeResponse::clear_has_num_cleared() {
+  _has_bits_[0] &= ~0x00000002u;
+}
+void ClearRangeResponse::clear_num_cleared() {
+  num_cleared_ = GOOGLE_LONGLONG(0);
+  clear_has_num_cleared();
+}
+ ::google::protobuf::int64 ClearRangeResponse::num_cleared() const {
+  // @@protoc_insertion_point(field_get:cockroach.roachpb.ClearRangeResponse.num_cleared)
+  return num_cleared_;
+}
+ void ClearRangeResponse::set_num_cleared(::google::protobuf::int64 value) {
+  set_has_num_cleared();
+  num_cleared_ = value;
+  // @@protoc_insertion_point(field_set:cockroach.roachpb.ClearRangeResponse.num_cleared)
+}
+
+#endif  // PROTOBUF_INLINE_NOT_IN_HEADERS
+
+// ===================================================================
+
+#ifndef _MSC_VER
+const int ScanRequest::kHeaderFieldNumber;
+const int ScanRequest::kMaxResultsFieldNumber;
+#endif  // !_MSC_VER
+
+ScanRequest::ScanRequest()
+  : ::google::protobuf::Message(), _internal_metadata_(NULL) {
+  SharedCtor();
+  // @@protoc_insertion_point(constructor:cockroach.roachpb.ScanRequest)
+}
+
+void ScanRequest::InitAsDefaultInstance() {
+  header_ = const_cast< ::cockroach::roachpb::Span*>(&::cockroach::roachpb::Span::default_instance());
+}
+
+ScanRequest::ScanRequest(const ScanRequest& from)
+  : ::google::protobuf::Message(),
+    _internal_metadata_(NULL) {
+  SharedCtor();
+  MergeFrom(from);
+  // @@protoc_insertion_point(copy_constructor:cockroach.roachpb.ScanRequest)
+}
+
+void ScanRequest::SharedCtor() {
+  _cached_size_ = 0;
+  header_ = NULL;
+  max_results_ = GOOGLE_LONGLONG(0);
+  ::memset(_has_bits_, 0, sizeof(_has_bits_));
+}
+
+ScanRequest::~ScanRequest() {
+  // @@protoc_insertion_point(destructor:cockroach.roachpb.ScanRequest)
+  SharedDtor();
+}
+
+void ScanRequest::SharedDtor() {
+  if (this != default_instance_) {
+    delete header_;
+  }
+}
+
+void ScanRequest::SetCachedSize(int size) const {
+  GOOGLE_SAFE_CONCURRENT_WRITES_BEGIN();
+  _cached_size_ = size;
+  GOOGLE_SAFE_CONCURRENT_WRITES_END();
+}
+const ::google::protobuf::Descriptor* ScanRequest::descriptor() {
+  protobuf_AssignDescriptorsOnce();
+  return ScanRequest_descriptor_;
+}
+
+const ScanRequest& ScanRequest::default_instance() {
+  if (default_instance_ == NULL) protobuf_AddDesc_cockroach_2froachpb_2fapi_2eproto();
+  return *default_instance_;
+}
+
+ScanRequest* ScanRequest::default_instance_ = NULL;
+
+ScanRequest* ScanRequest::New(::google::protobuf::Arena* arena) const {
+  ScanRequest* n = new ScanRequest;
+  if (arena != NULL) {
+    arena->Own(n);
+  }
+  return n;
+}
+
+void ScanRequest::Clear() {
+  if (_has_bits_[0 / 32] & 3u) {
+    if (has_header()) {
+      if (header_ != NULL) header_->::cockroach::roachpb::Span::Clear();
+    }
+    max_results_ = GOOGLE_LONGLONG(0);
+  }
+  ::memset(_has_bits_, 0, sizeof(_has_bits_));
+  if (_internal_metadata_.have_unknown_fields()) {
+    mutable_unknown_fields()->Clear();
+  }
+}
+
+bool ScanRequest::MergePartialFromCodedStream(
+    ::google::protobuf::io::CodedInputStream* input) {
+#define DO_(EXPRESSION) if (!(EXPRESSION)) goto failure
+  ::google::protobuf::uint32 tag;
+  // @@protoc_insertion_point(parse_start:cockroach.roachpb.ScanRequest)
+  for (;;) {
+    ::std::pair< ::google::protobuf::uint32, bool> p = input->ReadTagWithCutoff(127);
+    tag = p.first;
+    if (!p.second) goto handle_unusual;
+    switch (::google::protobuf::internal::WireFormatLite::GetTagFieldNumber(tag)) {
+      // optional .cockroach.roachpb.Span header = 1;
+      case 1: {
+        if (tag == 10) {
+          DO_(::google::protobuf::internal::WireFormatLite::ReadMessageNoVirtual(
+               input, mutable_header()));
+        } else {
+          goto handle_unusual;
+        }
+        if (input->ExpectTag(16)) goto parse_max_results;
+        break;
+      }
+
+      // optional int64 max_results = 2;
+      case 2: {
+        if (tag == 16) {
+         parse_max_results:
+          DO_((::google::protobuf::internal::WireFormatLite::ReadPrimitive<
+                   ::google::protobuf::int64, ::google::protobuf::internal::WireFormatLite::TYPE_INT64>(
+                 input, &max_results_)));
+          set_has_max_results();
+        } else {
+          goto handle_unusual;
+        }
+        if (input->ExpectAtEnd()) goto success;
+        break;
+      }
+
+      default: {
+      handle_unusual:
+        if (tag == 0 ||
+            ::google::protobuf::internal::WireFormatLite::GetTagWireType(tag) ==
+            ::google::protobuf::internal::WireFormatLite::WIRETYPE_END_GROUP) {
+          goto success;
+        }
+        DO_(::google::protobuf::internal::WireFormat::SkipField(
+              input, tag, mutable_unknown_fields()));
+        break;
+      }
+    }
+  }
+success:
+  // @@protoc_insertion_point(parse_success:cockroach.roachpb.ScanRequest)
+  return true;
+failure:
+  // @@protoc_insertion_point(parse_failure:cockroach.roachpb.ScanRequest)
+  return false;
+#undef DO_
+}
+
+void ScanRequest::SerializeWithCachedSizes(
+    ::google::protobuf::io::CodedOutputStream* output) const {
+  // @@protoc_insertion_point(serialize_start:cockroach.roachpb.ScanRequest)
+  // optional .cockroach.roachpb.Span header = 1;
+  if (has_header()) {
+    ::google::protobuf::internal::WireFormatLite::WriteMessageMaybeToArray(
+      1, *this->header_, output);
+  }
+
+  // optional int64 max_results = 2;
+  if (has_max_results()) {
+    ::google::protobuf::internal::WireFormatLite::WriteInt64(2, this->max_results(), output);
+  }
+
+  if (_internal_metadata_.have_unknown_fields()) {
+    ::google::protobuf::internal::WireFormat::SerializeUnknownFields(
+        unknown_fields(), output);
+  }
+  // @@protoc_insertion_point(serialize_end:cockroach.roachpb.ScanRequest)
+}
+
+::google::protobuf::uint8* ScanRequest::SerializeWithCachedSizesToArray(
+    ::google::protobuf::uint8* target) const {
+  // @@protoc_insertion_point(serialize_to_array_start:cockroach.roachpb.ScanRequest)
+  // optional .cockroach.roachpb.Span header = 1;
+  if (has_header()) {
+    target = ::google::protobuf::internal::WireFormatLite::
+      WriteMessageNoVirtualToArray(
+        1, *this->header_, target);
+  }
+
+  // optional int64 max_results = 2;
+  if (has_max_results()) {
+    target = ::google::protobuf::internal::WireFormatLite::WriteInt64ToArray(2, this->max_results(), target);
+  }
+
+  if (_internal_metadata_.have_unknown_fields()) {
+    target = ::google::protobuf::internal::WireFormat::SerializeUnknownFieldsToArray(
+        unknown_fields(), target);
+  }
+  // @@protoc_insertion_point(serialize_to_array_end:cockroach.roachpb.ScanRequest)
+  return target;
+}
+
+int ScanRequest::ByteSize() const {
+  int total_size = 0;
+
+  if (_has_bits_[0 / 32] & 3) {
+    // optional .cockroach.roachpb.Span header = 1;
+    if (has_header()) {
+      total_size += 1 +
+        ::google::protobuf::internal::WireFormatLite::MessageSizeNoVirtual(
+          *this->header_);
+    }
+
+    // optional int64 max_results = 2;
+    if (has_max_results()) {
+      total_size += 1 +
+        ::google::protobuf::internal::WireFormatLite::Int64Size(
+          this->max_results());
+    }
+
+  }
+  if (_internal_metadata_.have_unknown_fields()) {
+    total_size +=
+      ::google::protobuf::internal::WireFormat::ComputeUnknownFieldsSize(
+        unknown_fields());
+  }
+  GOOGLE_SAFE_CONCURRENT_WRITES_BEGIN();
+  _cached_size_ = total_size;
+  GOOGLE_SAFE_CONCURRENT_WRITES_END();
+  return total_size;
+}
+
+void ScanRequest::MergeFrom(const ::google::protobuf::Message& from) {
+  if (GOOGLE_PREDICT_FALSE(&from == this)) MergeFromFail(__LINE__);
+  const ScanRequest* source = 
+      ::google::protobuf::internal::DynamicCastToGenerated<const ScanRequest>(
+          &from);
+  if (source == NULL) {
+    ::google::protobuf::internal::ReflectionOps::Merge(from, this);
+  } else {
+    MergeFrom(*source);
+  }
+}
+
+void ScanRequest::MergeFrom(const ScanRequest& from) {
+  if (GOOGLE_PREDICT_FALSE(&from == this)) MergeFromFail(__LINE__);
+  if (from._has_bits_[0 / 32] & (0xffu << (0 % 32))) {
+    if (from.has_header()) {
+      mutable_header()->::cockroach::roachpb::Span::MergeFrom(from.header());
+    }
+    if (from.has_max_results()) {
+      set_max_results(from.max_results());
+    }
+  }
+  if (from._internal_metadata_.have_unknown_fields()) {
+    mutable_unknown_fields()->MergeFrom(from.unknown_fields());
+  }
+}
+
+void ScanRequest::CopyFrom(const ::google::protobuf::Message& from) {
+  if (&from == this) return;
+  Clear();
+  MergeFrom(from);
+}
+
+void ScanRequest::CopyFrom(const ScanRequest& from) {
+  if (&from == this) return;
+  Clear();
+  MergeFrom(from);
+}
+
+bool ScanRequest::IsInitialized() const {
+
+  return true;
+}
+
+void ScanRequest::Swap(ScanRequest* other) {
+  if (other == this) return;
+  InternalSwap(other);
+}
+void ScanRequest::InternalSwap(ScanRequest* other) {
+  std::swap(header_, other->header_);
+  std::swap(max_results_, other->max_results_);
+  std::swap(_has_bits_[0], other->_has_bits_[0]);
+  _internal_metadata_.Swap(&other->_internal_metadata_);
+  std::swap(_cached_size_, other->_cached_size_);
+}
+
+::google::protobuf::Metadata ScanRequest::GetMetadata() const {
+  protobuf_AssignDescriptorsOnce();
+  ::google::protobuf::Metadata metadata;
+  metadata.descriptor = ScanRequest_descriptor_;
+  metadata.reflection = ScanRequest_reflection_;
+  return metadata;
+}
+
+#if PROTOBUF_INLINE_NOT_IN_HEADERS
+// ScanRequest
+
+// optional .cockroach.roachpb.Span header = 1;
+bool ScanRequest::has_header() const {
+  return (_has_bits_[0] & 0x00000001u) != 0;
+}
+void ScanRequest::set_has_header() {
+  _has_bits_[0] |= 0x00000001u;
+}
+void ScanRequest::clear_has_header() {
+  _has_bits_[0] &= ~0x00000001u;
+}
+void ScanRequest::clear_header() {
+  if (header_ != NULL) header_->::cockroach::roachpb::Span::Clear();
+  clear_has_header();
+}
+ const ::cockroach::roachpb::Span& ScanRequest::header() const {
+  // @@protoc_insertion_point(field_get:cockroach.roachpb.ScanRequest.header)
+  return header_ != NULL ? *header_ : *default_instance_->header_;
+}
+ ::cockroach::roachpb::Span* ScanRequest::mutable_header() {
+  set_has_header();
+  if (header_ == NULL) {
+    header_ = new ::cockroach::roachpb::Span;
+  }
+  // @@protoc_insertion_point(field_mutable:cockroach.roachpb.ScanRequest.header)
+  return header_;
+}
+ ::cockroach::roachpb::Span* ScanRequest::release_header() {
+  clear_has_header();
+  ::cockroach::roachpb::Span* temp = header_;
+  header_ = NULL;
+  return temp;
+}
+ void ScanRequest::set_allocated_header(::cockroach::roachpb::Span* header) {
+  delete header_;
+  header_ = header;
+  if (header) {
+    set_has_header();
+  } else {
+    clear_has_header();
+  }
+  // @@protoc_insertion_point(field_set_allocated:cockroach.roachpb.ScanRequest.header)
+}
+
+// optional int64 max_results = 2;
+bool ScanRequest::has_max_results() const {
+  return (_has_bits_[0] & 0x00000002u) != 0;
+}
+void ScanRequest::set_has_max_results() {
+  _has_bits_[0] |= 0x00000002u;
+}
+void ScanRequest::clear_has_max_results() {
+  _has_bits_[0] &= ~0x00000002u;
+}
+void ScanRequest::clear_max_results() {
+  max_results_ = GOOGLE_LONGLONG(0);
+  clear_has_max_results();
+}
+ ::google::protobuf::int64 ScanRequest::max_results() const {
+  // @@protoc_insertion_point(field_get:cockroach.roachpb.ScanRequest.max_results)
+  return max_results_;
+}
+ void ScanRequest::set_max_results(::google::protobuf::int64 value) {
+  set_has_max_results();
+  max_results_ = value;
+  // @@protoc_insertion_point(field_set:cockroach.roachpb.ScanRequest.max_results)
+}
+
+#endif  // PROTOBUF_INLINE_NOT_IN_HEADERS
+
+// ===================================================================
+
+#ifndef _MSC_VER
+const int ScanResponse::kHeaderFieldNumber;
+const int ScanResponse::kRowsFieldNumber;
+#endif  // !_MSC_VER
+
+ScanResponse::ScanResponse()
+  : ::google::protobuf::Message(), _internal_metadata_(NULL) {
+  SharedCtor();
+  // @@protoc_insertion_point(constructor:cockroach.roachpb.ScanResponse)
+}
+
+void ScanResponse::InitAsDefaultInstance() {
+  header_ = const_cast< ::cockroach::roachpb::ResponseHeader*>(&::cockroach::roachpb::ResponseHeader::default_instance());
+}
+
+ScanResponse::ScanResponse(const ScanResponse& from)
+  : ::google::protobuf::Message(),
+    _internal_metadata_(NULL) {
+  SharedCtor();
+  MergeFrom(from);
+  // @@protoc_insertion_point(copy_constructor:cockroach.roachpb.ScanResponse)
+}
+
+void ScanResponse::SharedCtor() {
+  _cached_size_ = 0;
+  header_ = NULL;
+  ::memset(_has_bits_, 0, sizeof(_has_bits_));
+}
+
+ScanResponse::~ScanResponse() {
+  // @@protoc_insertion_point(destructor:cockroach.roachpb.ScanResponse)
+  SharedDtor();
+}
+
+void ScanResponse::SharedDtor() {
+  if (this != default_instance_) {
+    delete header_;
+  }
+}
+
+void ScanResponse::SetCachedSize(int size) const {
+  GOOGLE_SAFE_CONCURRENT_WRITES_BEGIN();
+  _cached_size_ = size;
+  GOOGLE_SAFE_CONCURRENT_WRITES_END();
+}
+const ::google::protobuf::Descriptor* ScanResponse::descriptor() {
+  protobuf_AssignDescriptorsOnce();
+  return ScanResponse_descriptor_;
+}
+
+const ScanResponse& ScanResponse::default_instance() {
+  if (default_instance_ == NULL) protobuf_AddDesc_cockroach_2froachpb_2fapi_2eproto();
+  return *default_instance_;
+}
+
+ScanResponse* ScanResponse::default_instance_ = NULL;
+
+ScanResponse* ScanResponse::New(::google::protobuf::Arena* arena) const {
+  ScanResponse* n = new ScanResponse;
+  if (arena != NULL) {
+    arena->Own(n);
+  }
+  return n;
+}
+
+void ScanResponse::Clear() {
+  if (has_header()) {
+    if (header_ != NULL) header_->::cockroach::roachpb::ResponseHeader::Clear();
+  }
+  rows_.Clear();
+  ::memset(_has_bits_, 0, sizeof(_has_bits_));
+  if (_internal_metadata_.have_unknown_fields()) {
+    mutable_unknown_fields()->Clear();
+  }
+}
+
+bool ScanResponse::MergePartialFromCodedStream(
+    ::google::protobuf::io::CodedInputStream* input) {
+#define DO_(EXPRESSION) if (!(EXPRESSION)) goto failure
+  ::google::protobuf::uint32 tag;
+  // @@protoc_insertion_point(parse_start:cockroach.roachpb.ScanResponse)
+  for (;;) {
+    ::std::pair< ::google::protobuf::uint32, bool> p = input->ReadTagWithCutoff(127);
+    tag = p.first;
+    if (!p.second) goto handle_unusual;
+    switch (::google::protobuf::internal::WireFormatLite::GetTagFieldNumber(tag)) {
+      // optional .cockroach.roachpb.ResponseHeader header = 1;
+      case 1: {
+        if (tag == 10) {
+          DO_(::google::protobuf::internal::WireFormatLite::ReadMessageNoVirtual(
+               input, mutable_header()));
+        } else {
+          goto handle_unusual;
+        }
+        if (input->ExpectTag(18)) goto parse_rows;
+        break;
+      }
+
+      // repeated .cockroach.roachpb.KeyValue rows = 2;
+      case 2: {
+        if (tag == 18) {
+         parse_rows:
+          DO_(input->IncrementRecursionDepth());
+         parse_loop_rows:
+          DO_(::google::protobuf::internal::WireFormatLite::ReadMessageNoVirtualNoRecursionDepth(
+                input, add_rows()));
+        } else {
+          goto handle_unusual;
+        }
+        if (input->ExpectTag(18)) goto parse_loop_rows;
+        input->UnsafeDecrementRecursionDepth();
+        if (input->ExpectAtEnd()) goto success;
+        break;
+      }
+
+      default: {
+      handle_unusual:
+        if (tag == 0 ||
+            ::google::protobuf::internal::WireFormatLite::GetTagWireType(tag) ==
+            ::google::protobuf::internal::WireFormatLite::WIRETYPE_END_GROUP) {
+          goto success;
+        }
+        DO_(::google::protobuf::internal::WireFormat::SkipField(
+              input, tag, mutable_unknown_fields()));
+        break;
+      }
+    }
+  }
+success:
+  // @@protoc_insertion_point(parse_success:cockroach.roachpb.ScanResponse)
+  return true;
+failure:
+  // @@protoc_insertion_point(parse_failure:cockroach.roachpb.ScanResponse)
+  return false;
+#undef DO_
+}
+
+void ScanResponse::SerializeWithCachedSizes(
+    ::google::protobuf::io::CodedOutputStream* output) const {
+  // @@protoc_insertion_point(serialize_start:cockroach.roachpb.ScanResponse)
+  // optional .cockroach.roachpb.ResponseHeader header = 1;
+  if (has_header()) {
+    ::google::protobuf::internal::WireFormatLite::WriteMessageMaybeToArray(
+      1, *this->header_, output);
+  }
+
+  // repeated .cockroach.roachpb.KeyValue rows = 2;
+  for (unsigned int i = 0, n = this->rows_size(); i < n; i++) {
+    ::google::protobuf::internal::WireFormatLite::WriteMessageMaybeToArray(
+      2, this->rows(i), output);
+  }
+
+  if (_internal_metadata_.have_unknown_fields()) {
+    ::google::protobuf::internal::WireFormat::SerializeUnknownFields(
+        unknown_fields(), output);
+  }
+  // @@protoc_insertion_point(serialize_end:cockroach.roachpb.ScanResponse)
+}
+
+::google::protobuf::uint8* ScanResponse::SerializeWithCachedSizesToArray(
+    ::google::protobuf::uint8* target) const {
+  // @@protoc_insertion_point(serialize_to_array_start:cockroach.roachpb.ScanResponse)
+  // optional .cockroach.roachpb.ResponseHeader header = 1;
+  if (has_header()) {
+    target = ::google::protobuf::internal::WireFormatLite::
+      WriteMessageNoVirtualToArray(
+        1, *this->header_, target);
+  }
+
+  // repeated .cockroach.roachpb.KeyValue rows = 2;
+  for (unsigned int i = 0, n = this->rows_size(); i < n; i++) {
+    target = ::google::protobuf::internal::WireFormatLite::
+      WriteMessageNoVirtualToArray(
+        2, this->rows(i), target);
+  }
+
+  if (_internal_metadata_.have_unknown_fields()) {
+    target = ::google::protobuf::internal::WireFormat::SerializeUnknownFieldsToArray(
+        unknown_fields(), target);
+  }
+  // @@protoc_insertion_point(serialize_to_array_end:cockroach.roachpb.ScanResponse)
+  return target;
+}
+
+int ScanResponse::ByteSize() const {
+  int total_size = 0;
+
+  // optional .cockroach.roachpb.ResponseHeader header = 1;
+  if (has_header()) {
+    total_size += 1 +
+      ::google::protobuf::internal::WireFormatLite::MessageSizeNoVirtual(
+        *this->header_);
+  }
+
+  // repeated .cockroach.roachpb.KeyValue rows = 2;
+  total_size += 1 * this->rows_size();
+  for (int i = 0; i < this->rows_size(); i++) {
+    total_size +=
+      ::google::protobuf::internal::WireFormatLite::MessageSizeNoVirtual(
+        this->rows(i));
+  }
+
+  if (_internal_metadata_.have_unknown_fields()) {
+    total_size +=
+      ::google::protobuf::internal::WireFormat::ComputeUnknownFieldsSize(
+        unknown_fields());
+  }
+  GOOGLE_SAFE_CONCURRENT_WRITES_BEGIN();
+  _cached_size_ = total_size;
+  GOOGLE_SAFE_CONCURRENT_WRITES_END();
+  return total_size;
+}
+
+void ScanResponse::MergeFrom(const ::google::protobuf::Message& from) {
+  if (GOOGLE_PREDICT_FALSE(&from == this)) MergeFromFail(__LINE__);
+  const ScanResponse* source = 
+      ::google::protobuf::internal::DynamicCastToGenerated<const ScanResponse>(
+          &from);
+  if (source == NULL) {
+    ::google::protobuf::internal::ReflectionOps::Merge(from, this);
+  } else {
+    MergeFrom(*source);
+  }
+}
+
+void ScanResponse::MergeFrom(const ScanResponse& from) {
+  if (GOOGLE_PREDICT_FALSE(&from == this)) MergeFromFail(__LINE__);
+  rows_.MergeFrom(from.rows_);
+  if (from._has_bits_[0 / 32] & (0xffu << (0 % 32))) {
+    if (from.has_header()) {
+      mutable_header()->::cockroach::roachpb::ResponseHeader::MergeFrom(from.header());
+    }
+  }
+  if (from._internal_metadata_.have_unknown_fields()) {
+    mutable_unknown_fields()->MergeFrom(from.unknown_fields());
+  }
+}
+
+void ScanResponse::CopyFrom(const ::google::protobuf::Message& from) {
+  if (&from == this) return;
+  Clear();
+  MergeFrom(from);
+}
+
+void ScanResponse::CopyFrom(const ScanResponse& from) {
+  if (&from == this) return;
+  Clear();
+  MergeFrom(from);
+}
+
+bool ScanResponse::IsInitialized() const {
+
+  return true;
+}
+
+void ScanResponse::Swap(ScanResponse* other) {
+  if (other == this) return;
+  InternalSwap(other);
+}
+void ScanResponse::InternalSwap(ScanResponse* other) {
+  std::swap(header_, other->header_);
+  rows_.UnsafeArenaSwap(&other->rows_);
+  std::swap(_has_bits_[0], other->_has_bits_[0]);
+  _internal_metadata_.Swap(&other->_internal_metadata_);
+  std::swap(_cached_size_, other->_cached_size_);
+}
+
+::google::protobuf::Metadata ScanResponse::GetMetadata() const {
+  protobuf_AssignDescriptorsOnce();
+  ::google::protobuf::Metadata metadata;
+  metadata.descriptor = ScanResponse_descriptor_;
+  metadata.reflection = ScanResponse_reflection_;
+  return metadata;
+}
+
+#if PROTOBUF_INLINE_NOT_IN_HEADERS
+// ScanResponse
+
+// optional .cockroach.roachpb.ResponseHeader header = 1;
+bool ScanResponse::has_header() const {
+  return (_has_bits_[0] & 0x00000001u) != 0;
+}
+void ScanResponse::set_has_header() {
+  _has_bits_[0] |= 0x00000001u;
+}
+void ScanResponse::clear_has_header() {
+  _has_bits_[0] &= ~0x00000001u;
+}
+void ScanResponse::clear_header() {
+  if (header_ != NULL) header_->::cockroach::roachpb::ResponseHeader::Clear();
+  clear_has_header();
+}
+ const ::cockroach::roachpb::ResponseHeader& ScanResponse::header() const {
+  // @@protoc_insertion_point(field_get:cockroach.roachpb.ScanResponse.header)
+  return header_ != NULL ? *header_ : *default_instance_->header_;
+}
+ ::cockroach::roachpb::ResponseHeader* ScanResponse::mutable_header() {
+  set_has_header();
+  if (header_ == NULL) {
+    header_ = new ::cockroach::roachpb::ResponseHeader;
+  }
+  // @@protoc_insertion_point(field_mutable:cockroach.roachpb.ScanResponse.header)
+  return header_;
+}
+ ::cockroach::roachpb::ResponseHeader* ScanResponse::release_header() {
+  clear_has_header();
+  ::cockroach::roachpb::ResponseHeader* temp = header_;
+  header_ = NULL;
+  return temp;
+}
+ void ScanResponse::set_allocated_header(::cockroach::roachpb::ResponseHeader* header) {
+  delete header_;
+  header_ = header;
+  if (header) {
+    set_has_header();
+  } else {
+    clear_has_header();
+  }
+  // @@protoc_insertion_point(field_set_allocated:cockroach.roachpb.ScanResponse.header)
+}
+
+// repeated .cockroach.roachpb.KeyValue rows = 2;
+int ScanResponse::rows_size() const {
+  return rows_.size();
+}
+void ScanResponse::clear_rows() {
+  rows_.Clear();
+}
+ const ::cockroach::roachpb::KeyValue& ScanResponse::rows(int index) const {
+  // @@protoc_insertion_point(field_get:cockroach.roachpb.ScanResponse.rows)
+  return rows_.Get(index);
+}
+ ::cockroach::roachpb::KeyValue* ScanResponse::mutable_rows(int index) {
+  // @@protoc_insertion_point(field_mutable:cockroach.roachpb.ScanResponse.rows)
+  return rows_.Mutable(index);
+}
+ ::cockroach::roachpb::KeyValue* ScanResponse::add_rows() {
+  // @@protoc_insertion_point(field_add:cockroach.roachpb.ScanResponse.rows)
+  return rows_.Add();
+}
+ const ::google::protobuf::RepeatedPtrField< ::cockroach::roachpb::KeyValue >&
+ScanResponse::rows() const {
+  // @@protoc_insertion_point(field_list:cockroach.roachpb.ScanResponse.rows)
+  return rows_;
+}
+ ::google::protobuf::RepeatedPtrField< ::cockroach::roachpb::KeyValue >*
+ScanResponse::mutable_rows() {
+  // @@protoc_insertion_point(field_mutable_list:cockroach.roachpb.ScanResponse.rows)
+  return &rows_;
+}
+
+#endif  // PROTOBUF_INLINE_NOT_IN_HEADERS
+
+// ===================================================================
+
+#ifndef _MSC_VER
+const int ReverseScanRequest::kHeaderFieldNumber;
+const int ReverseScanRequest::kMaxResultsFieldNumber;
+#endif  // !_MSC_VER
+
+ReverseScanRequest::ReverseScanRequest()
+  : ::google::protobuf::Message(), _internal_metadata_(NULL) {
+  SharedCtor();
+  // @@protoc_insertion_point(constructor:cockroach.roachpb.ReverseScanRequest)
+}
+
+void ReverseScanRequest::InitAsDefaultInstance() {
+  header_ = const_cast< ::cockroach::roachpb::Span*>(&::cockroach::roachpb::Span::default_instance());
+}
+
+ReverseScanRequest::ReverseScanRequest(const ReverseScanRequest& from)
+  : ::google::protobuf::Message(),
+    _internal_metadata_(NULL) {
+  SharedCtor();
+  MergeFrom(from);
+  // @@protoc_insertion_point(copy_constructor:cockroach.roachpb.ReverseScanRequest)
+}
+
+void ReverseScanRequest::SharedCtor() {
+  _cached_size_ = 0;
+  header_ = NULL;
+  max_results_ = GOOGLE_LONGLONG(0);
+  ::memset(_has_bits_, 0, sizeof(_has_bits_));
+}
+
+ReverseScanRequest::~ReverseScanRequest() {
+  // @@protoc_insertion_point(destructor:cockroach.roachpb.ReverseScanRequest)
+  SharedDtor();
+}
+
+void ReverseScanRequest::SharedDtor() {
+  if (this != default_instance_) {
+    delete header_;
+  }
+}
+
+void ReverseScanRequest::SetCachedSize(int size) const {
+  GOOGLE_SAFE_CONCURRENT_WRITES_BEGIN();
+  _cached_size_ = size;
+  GOOGLE_SAFE_CONCURRENT_WRITES_END();
+}
+const ::google::protobuf::Descriptor* ReverseScanRequest::descriptor() {
+  protobuf_AssignDescriptorsOnce();
+  return ReverseScanRequest_descriptor_;
+}
+
+const ReverseScanRequest& ReverseScanRequest::default_instance() {
+  if (default_instance_ == NULL) protobuf_AddDesc_cockroach_2froachpb_2fapi_2eproto();
+  return *default_instance_;
+}
+
+ReverseScanRequest* ReverseScanRequest::default_instance_ = NULL;
+
+ReverseScanRequest* ReverseScanRequest::New(::google::protobuf::Arena* arena) const {
+  ReverseScanRequest* n = new ReverseScanRequest;
+  if (arena != NULL) {
+    arena->Own(n);
+  }
+  return n;
+}
+
+void ReverseScanRequest::Clear() {
+  if (_has_bits_[0 / 32] & 3u) {
+    if (has_header()) {
+      if (header_ != NULL) header_->::cockroach::roachpb::Span::Clear();
+    }
+    max_results_ = GOOGLE_LONGLONG(0);
+  }
+  ::memset(_has_bits_, 0, sizeof(_has_bits_));
+  if (_internal_metadata_.have_unknown_fields()) {
+    mutable_unknown_fields()->Clear();
+  }
+}
+
+bool ReverseScanRequest::MergePartialFromCodedStream(
+    ::google::protobuf::io::CodedInputStream* input) {
+#define DO_(EXPRESSION) if (!(EXPRESSION)) goto failure
+  ::google::protobuf::uint32 tag;
+  // @@protoc_insertion_point(parse_start:cockroach.roachpb.ReverseScanRequest)
+  for (;;) {
+    ::std::pair< ::google::protobuf::uint32, bool> p = input->ReadTagWithCutoff(127);
+    tag = p.first;
+    if (!p.second) goto handle_unusual;
+    switch (::google::protobuf::internal::WireFormatLite::GetTagFieldNumber(tag)) {
+      // optional .cockroach.roachpb.Span header = 1;
+      case 1: {
+        if (tag == 10) {
+          DO_(::google::protobuf::internal::WireFormatLite::ReadMessageNoVirtual(
+               input, mutable_header()));
+        } else {
+          goto handle_unusual;
+        }
+        if (input->ExpectTag(16)) goto parse_max_results;
+        break;
+      }
+
+      // optional int64 max_results = 2;
+      case 2: {
+        if (tag == 16) {
+         parse_max_results:
+          DO_((::google::protobuf::internal::WireFormatLite::ReadPrimitive<
+                   ::google::protobuf::int64, ::google::protobuf::internal::WireFormatLite::TYPE_INT64>(
+                 input, &max_results_)));
+          set_has_max_results();
+        } else {
+          goto handle_unusual;
+        }
+        if (input->ExpectAtEnd()) goto success;
+        break;
+      }
+
+      default: {
+      handle_unusual:
+        if (tag == 0 ||
+            ::google::protobuf::internal::WireFormatLite::GetTagWireType(tag) ==
+            ::google::protobuf::internal::WireFormatLite::WIRETYPE_END_GROUP) {
+          goto success;
+        }
+        DO_(::google::protobuf::internal::WireFormat::SkipField(
+              input, tag, mutable_unknown_fields()));
+        break;
+      }
+    }
+  }
+success:
+  // @@protoc_insertion_point(parse_success:cockroach.roachpb.ReverseScanRequest)
+  return true;
+failure:
+  // @@protoc_insertion_point(parse_failure:cockroach.roachpb.ReverseScanRequest)
+  return false;
+#undef DO_
+}
+
+void ReverseScanRequest::SerializeWithCachedSizes(
+    ::google::protobuf::io::CodedOutputStream* output) const {
+  // @@protoc_insertion_point(serialize_start:cockroach.roachpb.ReverseScanRequest)
+  // optional .cockroach.roachpb.Span header = 1;
+  if (has_header()) {
+    ::google::protobuf::internal::WireFormatLite::WriteMessageMaybeToArray(
+      1, *this->header_, output);
+  }
+
+  // optional int64 max_results = 2;
+  if (has_max_results()) {
+    ::google::protobuf::internal::WireFormatLite::WriteInt64(2, this->max_results(), output);
+  }
+
+  if (_internal_metadata_.have_unknown_fields()) {
+    ::google::protobuf::internal::WireFormat::SerializeUnknownFields(
+        unknown_fields(), output);
+  }
+  // @@protoc_insertion_point(serialize_end:cockroach.roachpb.ReverseScanRequest)
+}
+
+::google::protobuf::uint8* ReverseScanRequest::SerializeWithCachedSizesToArray(
+    ::google::protobuf::uint8* target) const {
+  // @@protoc_insertion_point(serialize_to_array_start:cockroach.roachpb.ReverseScanRequest)
+  // optional .cockroach.roachpb.Span header = 1;
+  if (has_header()) {
+    target = ::google::protobuf::internal::WireFormatLite::
+      WriteMessageNoVirtualToArray(
+        1, *this->header_, target);
+  }
+
+  // optional int64 max_results = 2;
+  if (has_max_results()) {
+    target = ::google::protobuf::internal::WireFormatLite::WriteInt64ToArray(2, this->max_results(), target);
+  }
+
+  if (_internal_metadata_.have_unknown_fields()) {
+    target = ::google::protobuf::internal::WireFormat::SerializeUnknownFieldsToArray(
+        unknown_fields(), target);
+  }
+  // @@protoc_insertion_point(serialize_to_array_end:cockroach.roachpb.ReverseScanRequest)
+  return target;
+}
+
+int ReverseScanRequest::ByteSize() const {
+  int total_size = 0;
+
+  if (_has_bits_[0 / 32] & 3) {
+    // optional .cockroach.roachpb.Span header = 1;
+    if (has_header()) {
+      total_size += 1 +
+        ::google::protobuf::internal::WireFormatLite::MessageSizeNoVirtual(
+          *this->header_);
+    }
+
+    // optional int64 max_results = 2;
+    if (has_max_results()) {
+      total_size += 1 +
+        ::google::protobuf::internal::WireFormatLite::Int64Size(
+          this->max_results());
+    }
+
+  }
+  if (_internal_metadata_.have_unknown_fields()) {
+    total_size +=
+      ::google::protobuf::internal::WireFormat::ComputeUnknownFieldsSize(
+        unknown_fields());
+  }
+  GOOGLE_SAFE_CONCURRENT_WRITES_BEGIN();
+  _cached_size_ = total_size;
+  GOOGLE_SAFE_CONCURRENT_WRITES_END();
+  return total_size;
+}
+
+void ReverseScanRequest::MergeFrom(const ::google::protobuf::Message& from) {
+  if (GOOGLE_PREDICT_FALSE(&from == this)) MergeFromFail(__LINE__);
+  const ReverseScanRequest* source = 
+      ::google::protobuf::internal::DynamicCastToGenerated<const ReverseScanRequest>(
+          &from);
+  if (source == NULL) {
+    ::google::protobuf::internal::ReflectionOps::Merge(from, this);
+  } else {
+    MergeFrom(*source);
+  }
+}
+
+void ReverseScanRequest::MergeFrom(const ReverseScanRequest& from) {
+  if (GOOGLE_PREDICT_FALSE(&from == this)) MergeFromFail(__LINE__);
+  if (from._has_bits_[0 / 32] & (0xffu << (0 % 32))) {
+    if (from.has_header()) {
+      mutable_header()->::cockroach::roachpb::Span::MergeFrom(from.header());
+    }
+    if (from.has_max_results()) {
+      set_max_results(from.max_results());
+    }
+  }
+  if (from._internal_metadata_.have_unknown_fields()) {
+    mutable_unknown_fields()->MergeFrom(from.unknown_fields());
+  }
+}
+
+void ReverseScanRequest::CopyFrom(const ::google::protobuf::Message& from) {
+  if (&from == this) return;
+  Clear();
+  MergeFrom(from);
+}
+
+void ReverseScanRequest::CopyFrom(const ReverseScanRequest& from) {
+  if (&from == this) return;
+  Clear();
+  MergeFrom(from);
+}
+
+bool ReverseScanRequest::IsInitialized() const {
+
+  return true;
+}
+
+void ReverseScanRequest::Swap(ReverseScanRequest* other) {
+  if (other == this) return;
+  InternalSwap(other);
+}
+void ReverseScanRequest::InternalSwap(ReverseScanRequest* other) {
+  std::swap(header_, other->header_);
+  std::swap(max_results_, other->max_results_);
+  std::swap(_has_bits_[0], other->_has_bits_[0]);
+  _internal_metadata_.Swap(&other->_internal_metadata_);
+  std::swap(_cached_size_, other->_cached_size_);
+}
+
+::google::protobuf::Metadata ReverseScanRequest::GetMetadata() const {
+  protobuf_AssignDescriptorsOnce();
+  ::google::protobuf::Metadata metadata;
+  metadata.descriptor = ReverseScanRequest_descriptor_;
+  metadata.reflection = ReverseScanRequest_reflection_;
+  return metadata;
+}
+
+#if PROTOBUF_INLINE_NOT_IN_HEADERS
+// ReverseScanRequest
+
+// optional .cockroach.roachpb.Span header = 1;
+bool ReverseScanRequest::has_header() const {
+  return (_has_bits_[0] & 0x00000001u) != 0;
+}
+void ReverseScanRequest::set_has_header() {
+  _has_bits_[0] |= 0x00000001u;
+}
+void ReverseScanRequest::clear_has_header() {
+  _has_bits_[0] &= ~0x00000001u;
+}
+void ReverseScanRequest::clear_header() {
+  if (header_ != NULL) header_->::cockroach::roachpb::Span::Clear();
+  clear_has_header();
+}
+ const ::cockroach::roachpb::Span& ReverseScanRequest::header() const {
+  // @@protoc_insertion_point(field_get:cockroach.roachpb.ReverseScanRequest.header)
+  return header_ != NULL ? *header_ : *default_instance_->header_;
+}
+ ::cockroach::roachpb::Span* ReverseScanRequest::mutable_header() {
+  set_has_header();
+  if (header_ == NULL) {
+    header_ = new ::cockroach::roachpb::Span;
+  }
+  // @@protoc_insertion_point(field_mutable:cockroach.roachpb.ReverseScanRequest.header)
+  return header_;
+}
+ ::cockroach::roachpb::Span* ReverseScanRequest::release_header() {
+  clear_has_header();
+  ::cockroach::roachpb::Span* temp = header_;
+  header_ = NULL;
+  return temp;
+}
+ void ReverseScanRequest::set_allocated_header(::cockroach::roachpb::Span* header) {
+  delete header_;
+  header_ = header;
+  if (header) {
+    set_has_header();
+  } else {
+    clear_has_header();
+  }
+  // @@protoc_insertion_point(field_set_allocated:cockroach.roachpb.ReverseScanRequest.header)
+}
+
+// optional int64 max_results = 2;
+bool ReverseScanRequest::has_max_results() const {
+  return (_has_bits_[0] & 0x00000002u) != 0;
+}
+void ReverseScanRequest::set_has_max_results() {
+  _has_bits_[0] |= 0x00000002u;
+}
+void ReverseScanRequest::clear_has_max_results() {
+  _has_bits_[0] &= ~0x00000002u;
+}
+void ReverseScanRequest::clear_max_results() {
+  max_results_ = GOOGLE_LONGLONG(0);
+  clear_has_max_results();
+}
+ ::google::protobuf::int64 ReverseScanRequest::max_results() const {
+  // @@protoc_insertion_point(field_get:cockroach.roachpb.ReverseScanRequest.max_results)
+  return max_results_;
+}
+ void ReverseScanRequest::set_max_results(::google::protobuf::int64 value) {
+  set_has_max_results();
+  max_results_ = value;
+  // @@protoc_insertion_point(field_set:cockroach.roachpb.ReverseScanRequest.max_results)
+}
+
+#endif  // PROTOBUF_INLINE_NOT_IN_HEADERS
+
+// ===================================================================
+
+#ifndef _MSC_VER
+const int ReverseScanResponse::kHeaderFieldNumber;
+const int ReverseScanResponse::kRowsFieldNumber;
+#endif  // !_MSC_VER
+
+ReverseScanResponse::ReverseScanResponse()
+  : ::google::protobuf::Message(), _internal_metadata_(NULL) {
+  SharedCtor();
+  // @@protoc_insertion_point(constructor:cockroach.roachpb.ReverseScanResponse)
+}
+
+void ReverseScanResponse::InitAsDefaultInstance() {
+  header_ = const_cast< ::cockroach::roachpb::ResponseHeader*>(&::cockroach::roachpb::ResponseHeader::default_instance());
+}
+
+ReverseScanResponse::ReverseScanResponse(const ReverseScanResponse& from)
+  : ::google::protobuf::Message(),
+    _internal_metadata_(NULL) {
+  SharedCtor();
+  MergeFrom(from);
+  // @@protoc_insertion_point(copy_constructor:cockroach.roachpb.ReverseScanResponse)
+}
+
+void ReverseScanResponse::SharedCtor() {
+  _cached_size_ = 0;
+  header_ = NULL;
+  ::memset(_has_bits_, 0, sizeof(_has_bits_));
+}
+
+ReverseScanResponse::~ReverseScanResponse() {
+  // @@protoc_insertion_point(destructor:cockroach.roachpb.ReverseScanResponse)
+  SharedDtor();
+}
+
+void ReverseScanResponse::SharedDtor() {
+  if (this != default_instance_) {
+    delete header_;
+  }
+}
+
+void ReverseScanResponse::SetCachedSize(int size) const {
+  GOOGLE_SAFE_CONCURRENT_WRITES_BEGIN();
+  _cached_size_ = size;
+  GOOGLE_SAFE_CONCURRENT_WRITES_END();
+}
+const ::google::protobuf::Descriptor* ReverseScanResponse::descriptor() {
+  protobuf_AssignDescriptorsOnce();
+  return ReverseScanResponse_descriptor_;
+}
+
+const ReverseScanResponse& ReverseScanResponse::default_instance() {
+  if (default_instance_ == NULL) protobuf_AddDesc_cockroach_2froachpb_2fapi_2eproto();
+  return *default_instance_;
+}
+
+ReverseScanResponse* ReverseScanResponse::default_instance_ = NULL;
+
+ReverseScanResponse* ReverseScanResponse::New(::google::protobuf::Arena* arena) const {
+  ReverseScanResponse* n = new ReverseScanResponse;
+  if (arena != NULL) {
+    arena->Own(n);
+  }
+  return n;
+}
+
+void ReverseScanResponse::Clear() {
+  if (has_header()) {
+    if (header_ != NULL) header_->::cockroach::roachpb::ResponseHeader::Clear();
+  }
+  rows_.Clear();
+  ::memset(_has_bits_, 0, sizeof(_has_bits_));
+  if (_internal_metadata_.have_unknown_fields()) {
+    mutable_unknown_fields()->Clear();
+  }
+}
+
+bool ReverseScanResponse::MergePartialFromCodedStream(
+    ::google::protobuf::io::CodedInputStream* input) {
+#define DO_(EXPRESSION) if (!(EXPRESSION)) goto failure
+  ::google::protobuf::uint32 tag;
+  // @@protoc_insertion_point(parse_start:cockroach.roachpb.ReverseScanResponse)
+  for (;;) {
+    ::std::pair< ::google::protobuf::uint32, bool> p = input->ReadTagWithCutoff(127);
+    tag = p.first;
+    if (!p.second) goto handle_unusual;
+    switch (::google::protobuf::internal::WireFormatLite::GetTagFieldNumber(tag)) {
+      // optional .cockroach.roachpb.ResponseHeader header = 1;
+      case 1: {
+        if (tag == 10) {
+          DO_(::google::protobuf::internal::WireFormatLite::ReadMessageNoVirtual(
+               input, mutable_header()));
+        } else {
+          goto handle_unusual;
+        }
+        if (input->ExpectTag(18)) goto parse_rows;
+        break;
+      }
+
+      // repeated .cockroach.roachpb.KeyValue rows = 2;
+      case 2: {
+        if (tag == 18) {
+         parse_rows:
+          DO_(input->IncrementRecursionDepth());
+         parse_loop_rows:
+          DO_(::google::protobuf::internal::WireFormatLite::ReadMessageNoVirtualNoRecursionDepth(
+                input, add_rows()));
+        } else {
+          goto handle_unusual;
+        }
+        if (input->ExpectTag(18)) goto parse_loop_rows;
+        input->UnsafeDecrementRecursionDepth();
+        if (input->ExpectAtEnd()) goto success;
+        break;
+      }
+
+      default: {
+      handle_unusual:
+        if (tag == 0 ||
+            ::google::protobuf::internal::WireFormatLite::GetTagWireType(tag) ==
+            ::google::protobuf::internal::WireFormatLite::WIRETYPE_END_GROUP) {
+          goto success;
+        }
+        DO_(::google::protobuf::internal::WireFormat::SkipField(
+              input, tag, mutable_unknown_fields()));
+        break;
+      }
+    }
+  }
+success:
+  // @@protoc_insertion_point(parse_success:cockroach.roachpb.ReverseScanResponse)
+  return true;
+failure:
+  // @@protoc_insertion_point(parse_failure:cockroach.roachpb.ReverseScanResponse)
+  return false;
+#undef DO_
+}
+
+void ReverseScanResponse::SerializeWithCachedSizes(
+    ::google::protobuf::io::CodedOutputStream* output) const {
+  // @@protoc_insertion_point(serialize_start:cockroach.roachpb.ReverseScanResponse)
+  // optional .cockroach.roachpb.ResponseHeader header = 1;
+  if (has_header()) {
+    ::google::protobuf::internal::WireFormatLite::WriteMessageMaybeToArray(
+      1, *this->header_, output);
+  }
+
+  // repeated .cockroach.roachpb.KeyValue rows = 2;
+  for (unsigned int i = 0, n = this->rows_size(); i < n; i++) {
+    ::google::protobuf::internal::WireFormatLite::WriteMessageMaybeToArray(
+      2, this->rows(i), output);
+  }
+
+  if (_internal_metadata_.have_unknown_fields()) {
+    ::google::protobuf::internal::WireFormat::SerializeUnknownFields(
+        unknown_fields(), output);
+  }
+  // @@protoc_insertion_point(serialize_end:cockroach.roachpb.ReverseScanResponse)
+}
+
+::google::protobuf::uint8* ReverseScanResponse::SerializeWithCachedSizesToArray(
+    ::google::protobuf::uint8* target) const {
+  // @@protoc_insertion_point(serialize_to_array_start:cockroach.roachpb.ReverseScanResponse)
+  // optional .cockroach.roachpb.ResponseHeader header = 1;
+  if (has_header()) {
+    target = ::google::protobuf::internal::WireFormatLite::
+      WriteMessageNoVirtualToArray(
+        1, *this->header_, target);
+  }
+
+  // repeated .cockroach.roachpb.KeyValue rows = 2;
+  for (unsigned int i = 0, n = this->rows_size(); i < n; i++) {
+    target = ::google::protobuf::internal::WireFormatLite::
+      WriteMessageNoVirtualToArray(
+        2, this->rows(i), target);
+  }
+
+  if (_internal_metadata_.have_unknown_fields()) {
+    target = ::google::protobuf::internal::WireFormat::SerializeUnknownFieldsToArray(
+        unknown_fields(), target);
+  }
+  // @@protoc_insertion_point(serialize_to_array_end:cockroach.roachpb.ReverseScanResponse)
+  return target;
+}
+
+int ReverseScanResponse::ByteSize() const {
+  int total_size = 0;
+
+  // optional .cockroach.roachpb.ResponseHeader header = 1;
+  if (has_header()) {
+    total_size += 1 +
+      ::google::protobuf::internal::WireFormatLite::MessageSizeNoVirtual(
+        *this->header_);
+  }
+
+  // repeated .cockroach.roachpb.KeyValue rows = 2;
+  total_size += 1 * this->rows_size();
+  for (int i = 0; i < this->rows_size(); i++) {
+    total_size +=
+      ::google::protobuf::internal::WireFormatLite::MessageSizeNoVirtual(
+        this->rows(i));
+  }
+
+  if (_internal_metadata_.have_unknown_fields()) {
+    total_size +=
+      ::google::protobuf::internal::WireFormat::ComputeUnknownFieldsSize(
+        unknown_fields());
+  }
+  GOOGLE_SAFE_CONCURRENT_WRITES_BEGIN();
+  _cached_size_ = total_size;
+  GOOGLE_SAFE_CONCURRENT_WRITES_END();
+  return total_size;
+}
+
+void ReverseScanResponse::MergeFrom(const ::google::protobuf::Message& from) {
+  if (GOOGLE_PREDICT_FALSE(&from == this)) MergeFromFail(__LINE__);
+  const ReverseScanResponse* source = 
+      ::google::protobuf::internal::DynamicCastToGenerated<const ReverseScanResponse>(
+          &from);
+  if (source == NULL) {
+    ::google::protobuf::internal::ReflectionOps::Merge(from, this);
+  } else {
+    MergeFrom(*source);
+  }
+}
+
+void ReverseScanResponse::MergeFrom(const ReverseScanResponse& from) {
+  if (GOOGLE_PREDICT_FALSE(&from == this)) MergeFromFail(__LINE__);
+  rows_.MergeFrom(from.rows_);
   if (from._has_bits_[0 / 32] & (0xffu << (0 % 32))) {
     if (from.has_header()) {
       mutable_header()->::cockroach::roachpb::ResponseHeader::MergeFrom(from.header());
@@ -7447,28 +8906,28 @@ void ScanResponse::MergeFrom(const ScanResponse& from) {
   }
 }
 
-void ScanResponse::CopyFrom(const ::google::protobuf::Message& from) {
+void ReverseScanResponse::CopyFrom(const ::google::protobuf::Message& from) {
   if (&from == this) return;
   Clear();
   MergeFrom(from);
 }
 
-void ScanResponse::CopyFrom(const ScanResponse& from) {
+void ReverseScanResponse::CopyFrom(const ReverseScanResponse& from) {
   if (&from == this) return;
   Clear();
   MergeFrom(from);
 }
 
-bool ScanResponse::IsInitialized() const {
+bool ReverseScanResponse::IsInitialized() const {
 
   return true;
 }
 
-void ScanResponse::Swap(ScanResponse* other) {
+void ReverseScanResponse::Swap(ReverseScanResponse* other) {
   if (other == this) return;
   InternalSwap(other);
 }
-void ScanResponse::InternalSwap(ScanResponse* other) {
+void ReverseScanResponse::InternalSwap(ReverseScanResponse* other) {
   std::swap(header_, other->header_);
   rows_.UnsafeArenaSwap(&other->rows_);
   std::swap(_has_bits_[0], other->_has_bits_[0]);
@@ -7476,50 +8935,50 @@ void ScanResponse::InternalSwap(ScanResponse* other) {
   std::swap(_cached_size_, other->_cached_size_);
 }
 
-::google::protobuf::Metadata ScanResponse::GetMetadata() const {
+::google::protobuf::Metadata ReverseScanResponse::GetMetadata() const {
   protobuf_AssignDescriptorsOnce();
   ::google::protobuf::Metadata metadata;
-  metadata.descriptor = ScanResponse_descriptor_;
-  metadata.reflection = ScanResponse_reflection_;
+  metadata.descriptor = ReverseScanResponse_descriptor_;
+  metadata.reflection = ReverseScanResponse_reflection_;
   return metadata;
 }
 
 #if PROTOBUF_INLINE_NOT_IN_HEADERS
-// ScanResponse
+// ReverseScanResponse
 
 // optional .cockroach.roachpb.ResponseHeader header = 1;
-bool ScanResponse::has_header() const {
+bool ReverseScanResponse::has_header() const {
   return (_has_bits_[0] & 0x00000001u) != 0;
 }
-void ScanResponse::set_has_header() {
+void ReverseScanResponse::set_has_header() {
   _has_bits_[0] |= 0x00000001u;
 }
-void ScanResponse::clear_has_header() {
+void ReverseScanResponse::clear_has_header() {
   _has_bits_[0] &= ~0x00000001u;
 }
-void ScanResponse::clear_header() {
+void ReverseScanResponse::clear_header() {
   if (header_ != NULL) header_->::cockroach::roachpb::ResponseHeader::Clear();
   clear_has_header();
 }
- const ::cockroach::roachpb::ResponseHeader& ScanResponse::header() const {
-  // @@protoc_insertion_point(field_get:cockroach.roachpb.ScanResponse.header)
+ const ::cockroach::roachpb::ResponseHeader& ReverseScanResponse::header() const {
+  // @@protoc_insertion_point(field_get:cockroach.roachpb.ReverseScanResponse.header)
   return header_ != NULL ? *header_ : *default_instance_->header_;
 }
- ::cockroach::roachpb::ResponseHeader* ScanResponse::mutable_header() {
+ ::cockroach::roachpb::ResponseHeader* ReverseScanResponse::mutable_header() {
   set_has_header();
   if (header_ == NULL) {
     header_ = new ::cockroach::roachpb::ResponseHeader;
   }
-  // @@protoc_insertion_point(field_mutable:cockroach.roachpb.ScanResponse.header)
+  // @@protoc_insertion_point(field_mutable:cockroach.roachpb.ReverseScanResponse.header)
   return header_;
 }
- ::cockroach::roachpb::ResponseHeader* ScanResponse::release_header() {
+ ::cockroach::roachpb::ResponseHeader* ReverseScanResponse::release_header() {
   clear_has_header();
   ::cockroach::roachpb::ResponseHeader* temp = header_;
   header_ = NULL;
   return temp;
 }
- void ScanResponse::set_allocated_header(::cockroach::roachpb::ResponseHeader* header) {
+ void ReverseScanResponse::set_allocated_header(::cockroach::roachpb::ResponseHeader* header) {
   delete header_;
   header_ = header;
   if (header) {
@@ -7527,36 +8986,36 @@ void ScanResponse::clear_header() {
   } else {
     clear_has_header();
   }
-  // @@protoc_insertion_point(field_set_allocated:cockroach.roachpb.ScanResponse.header)
+  // @@protoc_insertion_point(field_set_allocated:cockroach.roachpb.ReverseScanResponse.header)
 }
 
 // repeated .cockroach.roachpb.KeyValue rows = 2;
-int ScanResponse::rows_size() const {
+int ReverseScanResponse::rows_size() const {
   return rows_.size();
 }
-void ScanResponse::clear_rows() {
+void ReverseScanResponse::clear_rows() {
   rows_.Clear();
 }
- const ::cockroach::roachpb::KeyValue& ScanResponse::rows(int index) const {
-  // @@protoc_insertion_point(field_get:cockroach.roachpb.ScanResponse.rows)
+ const ::cockroach::roachpb::KeyValue& ReverseScanResponse::rows(int index) const {
+  // @@protoc_insertion_point(field_get:cockroach.roachpb.ReverseScanResponse.rows)
   return rows_.Get(index);
 }
- ::cockroach::roachpb::KeyValue* ScanResponse::mutable_rows(int index) {
-  // @@protoc_insertion_point(field_mutable:cockroach.roachpb.ScanResponse.rows)
+ ::cockroach::roachpb::KeyValue* ReverseScanResponse::mutable_rows(int index) {
+  // @@protoc_insertion_point(field_mutable:cockroach.roachpb.ReverseScanResponse.rows)
   return rows_.Mutable(index);
 }
- ::cockroach::roachpb::KeyValue* ScanResponse::add_rows() {
-  // @@protoc_insertion_point(field_add:cockroach.roachpb.ScanResponse.rows)
+ ::cockroach::roachpb::KeyValue* ReverseScanResponse::add_rows() {
+  // @@protoc_insertion_point(field_add:cockroach.roachpb.ReverseScanResponse.rows)
   return rows_.Add();
 }
  const ::google::protobuf::RepeatedPtrField< ::cockroach::roachpb::KeyValue >&
-ScanResponse::rows() const {
-  // @@protoc_insertion_point(field_list:cockroach.roachpb.ScanResponse.rows)
+ReverseScanResponse::rows() const {
+  // @@protoc_insertion_point(field_list:cockroach.roachpb.ReverseScanResponse.rows)
   return rows_;
 }
  ::google::protobuf::RepeatedPtrField< ::cockroach::roachpb::KeyValue >*
-ScanResponse::mutable_rows() {
-  // @@protoc_insertion_point(field_mutable_list:cockroach.roachpb.ScanResponse.rows)
+ReverseScanResponse::mutable_rows() {
+  // @@protoc_insertion_point(field_mutable_list:cockroach.roachpb.ReverseScanResponse.rows)
   return &rows_;
 }
 
@@ -7565,76 +9024,83 @@ ScanResponse::mutable_rows() {
 // ===================================================================
 
 #ifndef _MSC_VER
-const int ReverseScanRequest::kHeaderFieldNumber;
-const int ReverseScanRequest::kMaxResultsFieldNumber;
+const int FilteredScanRequest::kHeaderFieldNumber;
+const int FilteredScanRequest::kFilterFieldNumber;
+const int FilteredScanRequest::kMaxResultsFieldNumber;
 #endif  // !_MSC_VER
 
-ReverseScanRequest::ReverseScanRequest()
+FilteredScanRequest::FilteredScanRequest()
   : ::google::protobuf::Message(), _internal_metadata_(NULL) {
   SharedCtor();
-  // @@protoc_insertion_point(constructor:cockroach.roachpb.ReverseScanRequest)
+  // @@protoc_insertion_point(constructor:cockroach.roachpb.FilteredScanRequest)
 }
 
-void ReverseScanRequest::InitAsDefaultInstance() {
+void FilteredScanRequest::InitAsDefaultInstance() {
   header_ = const_cast< ::cockroach::roachpb::Span*>(&::cockroach::roachpb::Span::default_instance());
 }
 
-ReverseScanRequest::ReverseScanRequest(const ReverseScanRequest& from)
+FilteredScanRequest::FilteredScanRequest(const FilteredScanRequest& from)
   : ::google::protobuf::Message(),
     _internal_metadata_(NULL) {
   SharedCtor();
   MergeFrom(from);
-  // @@protoc_insertion_point(copy_constructor:cockroach.roachpb.ReverseScanRequest)
+  // @@protoc_insertion_point(copy_constructor:cockroach.roachpb.FilteredScanRequest)
 }
 
-void ReverseScanRequest::SharedCtor() {
+void FilteredScanRequest::SharedCtor() {
+  ::google::protobuf::internal::GetEmptyString();
   _cached_size_ = 0;
   header_ = NULL;
+  filter_.UnsafeSetDefault(&::google::protobuf::internal::GetEmptyStringAlreadyInited());
   max_results_ = GOOGLE_LONGLONG(0);
   ::memset(_has_bits_, 0, sizeof(_has_bits_));
 }
 
-ReverseScanRequest::~ReverseScanRequest() {
-  // @@protoc_insertion_point(destructor:cockroach.roachpb.ReverseScanRequest)
+FilteredScanRequest::~FilteredScanRequest() {
+  // @@protoc_insertion_point(destructor:cockroach.roachpb.FilteredScanRequest)
   SharedDtor();
 }
 
-void ReverseScanRequest::SharedDtor() {
+void FilteredScanRequest::SharedDtor() {
+  filter_.DestroyNoArena(&::google::protobuf::internal::GetEmptyStringAlreadyInited());
   if (this != default_instance_) {
     delete header_;
   }
 }
 
-void ReverseScanRequest::SetCachedSize(int size) const {
+void FilteredScanRequest::SetCachedSize(int size) const {
   GOOGLE_SAFE_CONCURRENT_WRITES_BEGIN();
   _cached_size_ = size;
   GOOGLE_SAFE_CONCURRENT_WRITES_END();
 }
-const ::google::protobuf::Descriptor* ReverseScanRequest::descriptor() {
+const ::google::protobuf::Descriptor* FilteredScanRequest::descriptor() {
   protobuf_AssignDescriptorsOnce();
-  return ReverseScanRequest_descriptor_;
+  return FilteredScanRequest_descriptor_;
 }
 
-const ReverseScanRequest& ReverseScanRequest::default_instance() {
+const FilteredScanRequest& FilteredScanRequest::default_instance() {
   if (default_instance_ == NULL) protobuf_AddDesc_cockroach_2froachpb_2fapi_2eproto();
   return *default_instance_;
 }
 
-ReverseScanRequest* ReverseScanRequest::default_instance_ = NULL;
+FilteredScanRequest* FilteredScanRequest::default_instance_ = NULL;
 
-ReverseScanRequest* ReverseScanRequest::New(::google::protobuf::Arena* arena) const {
-  ReverseScanRequest* n = new ReverseScanRequest;
+FilteredScanRequest* FilteredScanRequest::New(::google::protobuf::Arena* arena) const {
+  FilteredScanRequest* n = new FilteredScanRequest;
   if (arena != NULL) {
     arena->Own(n);
   }
   return n;
 }
 
-void ReverseScanRequest::Clear() {
-  if (_has_bits_[0 / 32] & 3u) {
+void FilteredScanRequest::Clear() {
+  if (_has_bits_[0 / 32] & 7u) {
     if (has_header()) {
       if (header_ != NULL) header_->::cockroach::roachpb::Span::Clear();
     }
+    if (has_filter()) {
+      filter_.ClearToEmptyNoArena(&::google::protobuf::internal::GetEmptyStringAlreadyInited());
+    }
     max_results_ = GOOGLE_LONGLONG(0);
   }
   ::memset(_has_bits_, 0, sizeof(_has_bits_));
@@ -7643,11 +9109,11 @@ void ReverseScanRequest::Clear() {
   }
 }
 
-bool ReverseScanRequest::MergePartialFromCodedStream(
+bool FilteredScanRequest::MergePartialFromCodedStream(
     ::google::protobuf::io::CodedInputStream* input) {
 #define DO_(EXPRESSION) if (!(EXPRESSION)) goto failure
   ::google::protobuf::uint32 tag;
-  // @@protoc_insertion_point(parse_start:cockroach.roachpb.ReverseScanRequest)
+  // @@protoc_insertion_point(parse_start:cockroach.roachpb.FilteredScanRequest)
   for (;;) {
     ::std::pair< ::google::protobuf::uint32, bool> p = input->ReadTagWithCutoff(127);
     tag = p.first;
@@ -7661,13 +9127,26 @@ bool ReverseScanRequest::MergePartialFromCodedStream(
         } else {
           goto handle_unusual;
         }
-        if (input->ExpectTag(16)) goto parse_max_results;
+        if (input->ExpectTag(18)) goto parse_filter;
         break;
       }
 
-      // optional int64 max_results = 2;
+      // optional bytes filter = 2;
       case 2: {
-        if (tag == 16) {
+        if (tag == 18) {
+         parse_filter:
+          DO_(::google::protobuf::internal::WireFormatLite::ReadBytes(
+                input, this->mutable_filter()));
+        } else {
+          goto handle_unusual;
+        }
+        if (input->ExpectTag(24)) goto parse_max_results;
+        break;
+      }
+
+      // optional int64 max_results = 3;
+      case 3: {
+        if (tag == 24) {
          parse_max_results:
           DO_((::google::protobuf::internal::WireFormatLite::ReadPrimitive<
                    ::google::protobuf::int64, ::google::protobuf::internal::WireFormatLite::TYPE_INT64>(
@@ -7694,38 +9173,44 @@ bool ReverseScanRequest::MergePartialFromCodedStream(
     }
   }
 success:
-  // @@protoc_insertion_point(parse_success:cockroach.roachpb.ReverseScanRequest)
+  // @@protoc_insertion_point(parse_success:cockroach.roachpb.FilteredScanRequest)
   return true;
 failure:
-  // @@protoc_insertion_point(parse_failure:cockroach.roachpb.ReverseScanRequest)
+  // @@protoc_insertion_point(parse_failure:cockroach.roachpb.FilteredScanRequest)
   return false;
 #undef DO_
 }
 
-void ReverseScanRequest::SerializeWithCachedSizes(
+void FilteredScanRequest::SerializeWithCachedSizes(
     ::google::protobuf::io::CodedOutputStream* output) const {
-  // @@protoc_insertion_point(serialize_start:cockroach.roachpb.ReverseScanRequest)
+  // @@protoc_insertion_point(serialize_start:cockroach.roachpb.FilteredScanRequest)
   // optional .cockroach.roachpb.Span header = 1;
   if (has_header()) {
     ::google::protobuf::internal::WireFormatLite::WriteMessageMaybeToArray(
       1, *this->header_, output);
   }
 
-  // optional int64 max_results = 2;
+  // optional bytes filter = 2;
+  if (has_filter()) {
+    ::google::protobuf::internal::WireFormatLite::WriteBytesMaybeAliased(
+      2, this->filter(), output);
+  }
+
+  // optional int64 max_results = 3;
   if (has_max_results()) {
-    ::google::protobuf::internal::WireFormatLite::WriteInt64(2, this->max_results(), output);
+    ::google::protobuf::internal::WireFormatLite::WriteInt64(3, this->max_results(), output);
   }
 
   if (_internal_metadata_.have_unknown_fields()) {
     ::google::protobuf::internal::WireFormat::SerializeUnknownFields(
         unknown_fields(), output);
   }
-  // @@protoc_insertion_point(serialize_end:cockroach.roachpb.ReverseScanRequest)
+  // @@protoc_insertion_point(serialize_end:cockroach.roachpb.FilteredScanRequest)
 }
 
-::google::protobuf::uint8* ReverseScanRequest::SerializeWithCachedSizesToArray(
+::google::protobuf::uint8* FilteredScanRequest::SerializeWithCachedSizesToArray(
     ::google::protobuf::uint8* target) const {
-  // @@protoc_insertion_point(serialize_to_array_start:cockroach.roachpb.ReverseScanRequest)
+  // @@protoc_insertion_point(serialize_to_array_start:cockroach.roachpb.FilteredScanRequest)
   // optional .cockroach.roachpb.Span header = 1;
   if (has_header()) {
     target = ::google::protobuf::internal::WireFormatLite::
@@ -7733,23 +9218,30 @@ void ReverseScanRequest::SerializeWithCachedSizes(
         1, *this->header_, target);
   }
 
-  // optional int64 max_results = 2;
+  // optional bytes filter = 2;
+  if (has_filter()) {
+    target =
+      ::google::protobuf::internal::WireFormatLite::WriteBytesToArray(
+        2, this->filter(), target);
+  }
+
+  // optional int64 max_results = 3;
   if (has_max_results()) {
-    target = ::google::protobuf::internal::WireFormatLite::WriteInt64ToArray(2, this->max_results(), target);
+    target = ::google::protobuf::internal::WireFormatLite::WriteInt64ToArray(3, this->max_results(), target);
   }
 
   if (_internal_metadata_.have_unknown_fields()) {
     target = ::google::protobuf::internal::WireFormat::SerializeUnknownFieldsToArray(
         unknown_fields(), target);
   }
-  // @@protoc_insertion_point(serialize_to_array_end:cockroach.roachpb.ReverseScanRequest)
+  // @@protoc_insertion_point(serialize_to_array_end:cockroach.roachpb.FilteredScanRequest)
   return target;
 }
 
-int ReverseScanRequest::ByteSize() const {
+int FilteredScanRequest::ByteSize() const {
   int total_size = 0;
 
-  if (_has_bits_[0 / 32] & 3) {
+  if (_has_bits_[0 / 32] & 7) {
     // optional .cockroach.roachpb.Span header = 1;
     if (has_header()) {
       total_size += 1 +
@@ -7757,7 +9249,14 @@ int ReverseScanRequest::ByteSize() const {
           *this->header_);
     }
 
-    // optional int64 max_results = 2;
+    // optional bytes filter = 2;
+    if (has_filter()) {
+      total_size += 1 +
+        ::google::protobuf::internal::WireFormatLite::BytesSize(
+          this->filter());
+    }
+
+    // optional int64 max_results = 3;
     if (has_max_results()) {
       total_size += 1 +
         ::google::protobuf::internal::WireFormatLite::Int64Size(
@@ -7776,10 +9275,10 @@ int ReverseScanRequest::ByteSize() const {
   return total_size;
 }
 
-void ReverseScanRequest::MergeFrom(const ::google::protobuf::Message& from) {
+void FilteredScanRequest::MergeFrom(const ::google::protobuf::Message& from) {
   if (GOOGLE_PREDICT_FALSE(&from == this)) MergeFromFail(__LINE__);
-  const ReverseScanRequest* source = 
-      ::google::protobuf::internal::DynamicCastToGenerated<const ReverseScanRequest>(
+  const FilteredScanRequest* source = 
+      ::google::protobuf::internal::DynamicCastToGenerated<const FilteredScanRequest>(
           &from);
   if (source == NULL) {
     ::google::protobuf::internal::ReflectionOps::Merge(from, this);
@@ -7788,12 +9287,16 @@ void ReverseScanRequest::MergeFrom(const ::google::protobuf::Message& from) {
   }
 }
 
-void ReverseScanRequest::MergeFrom(const ReverseScanRequest& from) {
+void FilteredScanRequest::MergeFrom(const FilteredScanRequest& from) {
   if (GOOGLE_PREDICT_FALSE(&from == this)) MergeFromFail(__LINE__);
   if (from._has_bits_[0 / 32] & (0xffu << (0 % 32))) {
     if (from.has_header()) {
       mutable_header()->::cockroach::roachpb::Span::MergeFrom(from.header());
     }
+    if (from.has_filter()) {
+      set_has_filter();
+      filter_.AssignWithDefault(&::google::protobuf::internal::GetEmptyStringAlreadyInited(), from.filter_);
+    }
     if (from.has_max_results()) {
       set_max_results(from.max_results());
     }
@@ -7803,79 +9306,80 @@ void ReverseScanRequest::MergeFrom(const ReverseScanRequest& from) {
   }
 }
 
-void ReverseScanRequest::CopyFrom(const ::google::protobuf::Message& from) {
+void FilteredScanRequest::CopyFrom(const ::google::protobuf::Message& from) {
   if (&from == this) return;
   Clear();
   MergeFrom(from);
 }
 
-void ReverseScanRequest::CopyFrom(const ReverseScanRequest& from) {
+void FilteredScanRequest::CopyFrom(const FilteredScanRequest& from) {
   if (&from == this) return;
   Clear();
   MergeFrom(from);
 }
 
-bool ReverseScanRequest::IsInitialized() const {
+bool FilteredScanRequest::IsInitialized() const {
 
   return true;
 }
 
-void ReverseScanRequest::Swap(ReverseScanRequest* other) {
+void FilteredScanRequest::Swap(FilteredScanRequest* other) {
   if (other == this) return;
   InternalSwap(other);
 }
-void ReverseScanRequest::InternalSwap(ReverseScanRequest* other) {
+void FilteredScanRequest::InternalSwap(FilteredScanRequest* other) {
   std::swap(header_, other->header_);
+  filter_.Swap(&other->filter_);
   std::swap(max_results_, other->max_results_);
   std::swap(_has_bits_[0], other->_has_bits_[0]);
   _internal_metadata_.Swap(&other->_internal_metadata_);
   std::swap(_cached_size_, other->_cached_size_);
 }
 
-::google::protobuf::Metadata ReverseScanRequest::GetMetadata() const {
+::google::protobuf::Metadata FilteredScanRequest::GetMetadata() const {
   protobuf_AssignDescriptorsOnce();
   ::google::protobuf::Metadata metadata;
-  metadata.descriptor = ReverseScanRequest_descriptor_;
-  metadata.reflection = ReverseScanRequest_reflection_;
+  metadata.descriptor = FilteredScanRequest_descriptor_;
+  metadata.reflection = FilteredScanRequest_reflection_;
   return metadata;
 }
 
 #if PROTOBUF_INLINE_NOT_IN_HEADERS
-// ReverseScanRequest
+// FilteredScanRequest
 
 // optional .cockroach.roachpb.Span header = 1;
-bool ReverseScanRequest::has_header() const {
+bool FilteredScanRequest::has_header() const {
   return (_has_bits_[0] & 0x00000001u) != 0;
 }
-void ReverseScanRequest::set_has_header() {
+void FilteredScanRequest::set_has_header() {
   _has_bits_[0] |= 0x00000001u;
 }
-void ReverseScanRequest::clear_has_header() {
+void FilteredScanRequest::clear_has_header() {
   _has_bits_[0] &= ~0x00000001u;
 }
-void ReverseScanRequest::clear_header() {
+void FilteredScanRequest::clear_header() {
   if (header_ != NULL) header_->::cockroach::roachpb::Span::Clear();
   clear_has_header();
 }
- const ::cockroach::roachpb::Span& ReverseScanRequest::header() const {
-  // @@protoc_insertion_point(field_get:cockroach.roachpb.ReverseScanRequest.header)
+ const ::cockroach::roachpb::Span& FilteredScanRequest::header() const {
+  // @@protoc_insertion_point(field_get:cockroach.roachpb.FilteredScanRequest.header)
   return header_ != NULL ? *header_ : *default_instance_->header_;
 }
- ::cockroach::roachpb::Span* ReverseScanRequest::mutable_header() {
+ ::cockroach::roachpb::Span* FilteredScanRequest::mutable_header() {
   set_has_header();
   if (header_ == NULL) {
     header_ = new ::cockroach::roachpb::Span;
   }
-  // @@protoc_insertion_point(field_mutable:cockroach.roachpb.ReverseScanRequest.header)
+  // @@protoc_insertion_point(field_mutable:cockroach.roachpb.FilteredScanRequest.header)
   return header_;
 }
- ::cockroach::roachpb::Span* ReverseScanRequest::release_header() {
+ ::cockroach::roachpb::Span* FilteredScanRequest::release_header() {
   clear_has_header();
   ::cockroach::roachpb::Span* temp = header_;
   header_ = NULL;
   return temp;
 }
- void ReverseScanRequest::set_allocated_header(::cockroach::roachpb::Span* header) {
+ void FilteredScanRequest::set_allocated_header(::cockroach::roachpb::Span* header) {
   delete header_;
   header_ = header;
   if (header) {
@@ -7883,31 +9387,84 @@ void ReverseScanRequest::clear_header() {
   } else {
     clear_has_header();
   }
-  // @@protoc_insertion_point(field_set_allocated:cockroach.roachpb.ReverseScanRequest.header)
+  // @@protoc_insertion_point(field_set_allocated:cockroach.roachpb.FilteredScanRequest.header)
 }
 
-// optional int64 max_results = 2;
-bool ReverseScanRequest::has_max_results() const {
+// optional bytes filter = 2;
+bool FilteredScanRequest::has_filter() const {
   return (_has_bits_[0] & 0x00000002u) != 0;
 }
-void ReverseScanRequest::set_has_max_results() {
+void FilteredScanRequest::set_has_filter() {
   _has_bits_[0] |= 0x00000002u;
 }
-void ReverseScanRequest::clear_has_max_results() {
+void FilteredScanRequest::clear_has_filter() {
   _has_bits_[0] &= ~0x00000002u;
 }
-void ReverseScanRequest::clear_max_results() {
+void FilteredScanRequest::clear_filter() {
+  filter_.ClearToEmptyNoArena(&::google::protobuf::internal::GetEmptyStringAlreadyInited());
+  clear_has_filter();
+}
+ const ::std::string& FilteredScanRequest::filter() const {
+  // @@protoc_insertion_point(field_get:cockroach.roachpb.FilteredScanRequest.filter)
+  return filter_.GetNoArena(&::google::protobuf::internal::GetEmptyStringAlreadyInited());
+}
+ void FilteredScanRequest::set_filter(const ::std::string& value) {
+  set_has_filter();
+  filter_.SetNoArena(&::google::protobuf::internal::GetEmptyStringAlreadyInited(), value);
+  // @@protoc_insertion_point(field_set:cockroach.roachpb.FilteredScanRequest.filter)
+}
+ void FilteredScanRequest::set_filter(const char* value) {
+  set_has_filter();
+  filter_.SetNoArena(&::google::protobuf::internal::GetEmptyStringAlreadyInited(), ::std::string(value));
+  // @@protoc_insertion_point(field_set_char:cockroach.roachpb.FilteredScanRequest.filter)
+}
+ void FilteredScanRequest::set_filter(const void* value, size_t size) {
+  set_has_filter();
+  filter_.SetNoArena(&::google::protobuf::internal::GetEmptyStringAlreadyInited(),
+      ::std::string(reinterpret_cast<const char*>(value), size));
+  // @@protoc_insertion_point(field_set_pointer:cockroach.roachpb.FilteredScanRequest.filter)
+}
+ ::std::string* FilteredScanRequest::mutable_filter() {
+  set_has_filter();
+  // @@protoc_insertion_point(field_mutable:cockroach.roachpb.FilteredScanRequest.filter)
+  return filter_.MutableNoArena(&::google::protobuf::internal::GetEmptyStringAlreadyInited());
+}
+ ::std::string* FilteredScanRequest::release_filter() {
+  clear_has_filter();
+  return filter_.ReleaseNoArena(&::google::protobuf::internal::GetEmptyStringAlreadyInited());
+}
+ void FilteredScanRequest::set_allocated_filter(::std::string* filter) {
+  if (filter != NULL) {
+    set_has_filter();
+  } else {
+    clear_has_filter();
+  }
+  filter_.SetAllocatedNoArena(&::google::protobuf::internal::GetEmptyStringAlreadyInited(), filter);
+  // @@protoc_insertion_point(field_set_allocated:cockroach.roachpb.FilteredScanRequest.filter)
+}
+
+// optional int64 max_results = 3;
+bool FilteredScanRequest::has_max_results() const {
+  return (_has_bits_[0] & 0x00000004u) != 0;
+}
+void FilteredScanRequest::set_has_max_results() {
+  _has_bits_[0] |= 0x00000004u;
+}
+void FilteredScanRequest::clear_has_max_results() {
+  _has_bits_[0] &= ~0x00000004u;
+}
+void FilteredScanRequest::clear_max_results() {
   max_results_ = GOOGLE_LONGLONG(0);
   clear_has_max_results();
 }
- ::google::protobuf::int64 ReverseScanRequest::max_results() const {
-  // @@protoc_insertion_point(field_get:cockroach.roachpb.ReverseScanRequest.max_results)
+ ::google::protobuf::int64 FilteredScanRequest::max_results() const {
+  // @@protoc_insertion_point(field_get:cockroach.roachpb.FilteredScanRequest.max_results)
   return max_results_;
 }
- void ReverseScanRequest::set_max_results(::google::protobuf::int64 value) {
+ void FilteredScanRequest::set_max_results(::google::protobuf::int64 value) {
   set_has_max_results();
   max_results_ = value;
-  // @@protoc_insertion_point(field_set:cockroach.roachpb.ReverseScanRequest.max_results)
+  // @@protoc_insertion_point(field_set:cockroach.roachpb.FilteredScanRequest.max_results)
 }
 
 #endif  // PROTOBUF_INLINE_NOT_IN_HEADERS
@@ -7915,71 +9472,71 @@ void ReverseScanRequest::clear_max_results() {
 // ===================================================================
 
 #ifndef _MSC_VER
-const int ReverseScanResponse::kHeaderFieldNumber;
-const int ReverseScanResponse::kRowsFieldNumber;
+const int FilteredScanResponse::kHeaderFieldNumber;
+const int FilteredScanResponse::kRowsFieldNumber;
 #endif  // !_MSC_VER
 
-ReverseScanResponse::ReverseScanResponse()
+FilteredScanResponse::FilteredScanResponse()
   : ::google::protobuf::Message(), _internal_metadata_(NULL) {
   SharedCtor();
-  // @@protoc_insertion_point(constructor:cockroach.roachpb.ReverseScanResponse)
+  // @@protoc_insertion_point(constructor:cockroach.roachpb.FilteredScanResponse)
 }
 
-void ReverseScanResponse::InitAsDefaultInstance() {
+void FilteredScanResponse::InitAsDefaultInstance() {
   header_ = const_cast< ::cockroach::roachpb::ResponseHeader*>(&::cockroach::roachpb::ResponseHeader::default_instance());
 }
 
-ReverseScanResponse::ReverseScanResponse(const ReverseScanResponse& from)
+FilteredScanResponse::FilteredScanResponse(const FilteredScanResponse& from)
   : ::google::protobuf::Message(),
     _internal_metadata_(NULL) {
   SharedCtor();
   MergeFrom(from);
-  // @@protoc_insertion_point(copy_constructor:cockroach.roachpb.ReverseScanResponse)
+  // @@protoc_insertion_point(copy_constructor:cockroach.roachpb.FilteredScanResponse)
 }
 
-void ReverseScanResponse::SharedCtor() {
+void FilteredScanResponse::SharedCtor() {
   _cached_size_ = 0;
   header_ = NULL;
   ::memset(_has_bits_, 0, sizeof(_has_bits_));
 }
 
-ReverseScanResponse::~ReverseScanResponse() {
-  // @@protoc_insertion_point(destructor:cockroach.roachpb.ReverseScanResponse)
+FilteredScanResponse::~FilteredScanResponse() {
+  // @@protoc_insertion_point(destructor:cockroach.roachpb.FilteredScanResponse)
   SharedDtor();
 }
 
-void ReverseScanResponse::SharedDtor() {
+void FilteredScanResponse::SharedDtor() {
   if (this != default_instance_) {
     delete header_;
   }
 }
 
-void ReverseScanResponse::SetCachedSize(int size) const {
+void FilteredScanResponse::SetCachedSize(int size) const {
   GOOGLE_SAFE_CONCURRENT_WRITES_BEGIN();
   _cached_size_ = size;
   GOOGLE_SAFE_CONCURRENT_WRITES_END();
 }
-const ::google::protobuf::Descriptor* ReverseScanResponse::descriptor() {
+const ::google::protobuf::Descriptor* FilteredScanResponse::descriptor() {
   protobuf_AssignDescriptorsOnce();
-  return ReverseScanResponse_descriptor_;
+  return FilteredScanResponse_descriptor_;
 }
 
-const ReverseScanResponse& ReverseScanResponse::default_instance() {
+const FilteredScanResponse& FilteredScanResponse::default_instance() {
   if (default_instance_ == NULL) protobuf_AddDesc_cockroach_2froachpb_2fapi_2eproto();
   return *default_instance_;
 }
 
-ReverseScanResponse* ReverseScanResponse::default_instance_ = NULL;
+FilteredScanResponse* FilteredScanResponse::default_instance_ = NULL;
 
-ReverseScanResponse* ReverseScanResponse::New(::google::protobuf::Arena* arena) const {
-  ReverseScanResponse* n = new ReverseScanResponse;
+FilteredScanResponse* FilteredScanResponse::New(::google::protobuf::Arena* arena) const {
+  FilteredScanResponse* n = new FilteredScanResponse;
   if (arena != NULL) {
     arena->Own(n);
   }
   return n;
 }
 
-void ReverseScanResponse::Clear() {
+void FilteredScanResponse::Clear() {
   if (has_header()) {
     if (header_ != NULL) header_->::cockroach::roachpb::ResponseHeader::Clear();
   }
@@ -7990,11 +9547,11 @@ void ReverseScanResponse::Clear() {
   }
 }
 
-bool ReverseScanResponse::MergePartialFromCodedStream(
+bool FilteredScanResponse::MergePartialFromCodedStream(
     ::google::protobuf::io::CodedInputStream* input) {
 #define DO_(EXPRESSION) if (!(EXPRESSION)) goto failure
   ::google::protobuf::uint32 tag;
-  // @@protoc_insertion_point(parse_start:cockroach.roachpb.ReverseScanResponse)
+  // @@protoc_insertion_point(parse_start:cockroach.roachpb.FilteredScanResponse)
   for (;;) {
     ::std::pair< ::google::protobuf::uint32, bool> p = input->ReadTagWithCutoff(127);
     tag = p.first;
@@ -8043,17 +9600,17 @@ bool ReverseScanResponse::MergePartialFromCodedStream(
     }
   }
 success:
-  // @@protoc_insertion_point(parse_success:cockroach.roachpb.ReverseScanResponse)
+  // @@protoc_insertion_point(parse_success:cockroach.roachpb.FilteredScanResponse)
   return true;
 failure:
-  // @@protoc_insertion_point(parse_failure:cockroach.roachpb.ReverseScanResponse)
+  // @@protoc_insertion_point(parse_failure:cockroach.roachpb.FilteredScanResponse)
   return false;
 #undef DO_
 }
 
-void ReverseScanResponse::SerializeWithCachedSizes(
+void FilteredScanResponse::SerializeWithCachedSizes(
     ::google::protobuf::io::CodedOutputStream* output) const {
-  // @@protoc_insertion_point(serialize_start:cockroach.roachpb.ReverseScanResponse)
+  // @@protoc_insertion_point(serialize_start:cockroach.roachpb.FilteredScanResponse)
   // optional .cockroach.roachpb.ResponseHeader header = 1;
   if (has_header()) {
     ::google::protobuf::internal::WireFormatLite::WriteMessageMaybeToArray(
@@ -8070,12 +9627,12 @@ void ReverseScanResponse::SerializeWithCachedSizes(
     ::google::protobuf::internal::WireFormat::SerializeUnknownFields(
         unknown_fields(), output);
   }
-  // @@protoc_insertion_point(serialize_end:cockroach.roachpb.ReverseScanResponse)
+  // @@protoc_insertion_point(serialize_end:cockroach.roachpb.FilteredScanResponse)
 }
 
-::google::protobuf::uint8* ReverseScanResponse::SerializeWithCachedSizesToArray(
+::google::protobuf::uint8* FilteredScanResponse::SerializeWithCachedSizesToArray(
     ::google::protobuf::uint8* target) const {
-  // @@protoc_insertion_point(serialize_to_array_start:cockroach.roachpb.ReverseScanResponse)
+  // @@protoc_insertion_point(serialize_to_array_start:cockroach.roachpb.FilteredScanResponse)
   // optional .cockroach.roachpb.ResponseHeader header = 1;
   if (has_header()) {
     target = ::google::protobuf::internal::WireFormatLite::
@@ -8094,11 +9651,11 @@ void ReverseScanResponse::SerializeWithCachedSizes(
     target = ::google::protobuf::internal::WireFormat::SerializeUnknownFieldsToArray(
         unknown_fields(), target);
   }
-  // @@protoc_insertion_point(serialize_to_array_end:cockroach.roachpb.ReverseScanResponse)
+  // @@protoc_insertion_point(serialize_to_array_end:cockroach.roachpb.FilteredScanResponse)
   return target;
 }
 
-int ReverseScanResponse::ByteSize() const {
+int FilteredScanResponse::ByteSize() const {
   int total_size = 0;
 
   // optional .cockroach.roachpb.ResponseHeader header = 1;
@@ -8127,10 +9684,10 @@ int ReverseScanResponse::ByteSize() const {
   return total_size;
 }
 
-void ReverseScanResponse::MergeFrom(const ::google::protobuf::Message& from) {
+void FilteredScanResponse::MergeFrom(const ::google::protobuf::Message& from) {
   if (GOOGLE_PREDICT_FALSE(&from == this)) MergeFromFail(__LINE__);
-  const ReverseScanResponse* source = 
-      ::google::protobuf::internal::DynamicCastToGenerated<const ReverseScanResponse>(
+  const FilteredScanResponse* source = 
+      ::google::protobuf::internal::DynamicCastToGenerated<const FilteredScanResponse>(
           &from);
   if (source == NULL) {
     ::google::protobuf::internal::ReflectionOps::Merge(from, this);
@@ -8139,7 +9696,7 @@ void ReverseScanResponse::MergeFrom(const ::google::protobuf::Message& from) {
   }
 }
 
-void ReverseScanResponse::MergeFrom(const ReverseScanResponse& from) {
+void FilteredScanResponse::MergeFrom(const FilteredScanResponse& from) {
   if (GOOGLE_PREDICT_FALSE(&from == this)) MergeFromFail(__LINE__);
   rows_.MergeFrom(from.rows_);
   if (from._has_bits_[0 / 32] & (0xffu << (0 % 32))) {
@@ -8152,28 +9709,28 @@ void ReverseScanResponse::MergeFrom(const ReverseScanResponse& from) {
   }
 }
 
-void ReverseScanResponse::CopyFrom(const ::google::protobuf::Message& from) {
+void FilteredScanResponse::CopyFrom(const ::google::protobuf::Message& from) {
   if (&from == this) return;
   Clear();
   MergeFrom(from);
 }
 
-void ReverseScanResponse::CopyFrom(const ReverseScanResponse& from) {
+void FilteredScanResponse::CopyFrom(const FilteredScanResponse& from) {
   if (&from == this) return;
   Clear();
   MergeFrom(from);
 }
 
-bool ReverseScanResponse::IsInitialized() const {
+bool FilteredScanResponse::IsInitialized() const {
 
   return true;
 }
 
-void ReverseScanResponse::Swap(ReverseScanResponse* other) {
+void FilteredScanResponse::Swap(FilteredScanResponse* other) {
   if (other == this) return;
   InternalSwap(other);
 }
-void ReverseScanResponse::InternalSwap(ReverseScanResponse* other) {
+void FilteredScanResponse::InternalSwap(FilteredScanResponse* other) {
   std::swap(header_, other->header_);
   rows_.UnsafeArenaSwap(&other->rows_);
   std::swap(_has_bits_[0], other->_has_bits_[0]);
@@ -8181,50 +9738,50 @@ void ReverseScanResponse::InternalSwap(ReverseScanResponse* other) {
   std::swap(_cached_size_, other->_cached_size_);
 }
 
-::google::protobuf::Metadata ReverseScanResponse::GetMetadata() const {
+::google::protobuf::Metadata FilteredScanResponse::GetMetadata() const {
   protobuf_AssignDescriptorsOnce();
   ::google::protobuf::Metadata metadata;
-  metadata.descriptor = ReverseScanResponse_descriptor_;
-  metadata.reflection = ReverseScanResponse_reflection_;
+  metadata.descriptor = FilteredScanResponse_descriptor_;
+  metadata.reflection = FilteredScanResponse_reflection_;
   return metadata;
 }
 
 #if PROTOBUF_INLINE_NOT_IN_HEADERS
-// ReverseScanResponse
+// FilteredScanResponse
 
 // optional .cockroach.roachpb.ResponseHeader header = 1;
-bool ReverseScanResponse::has_header() const {
+bool FilteredScanResponse::has_header() const {
   return (_has_bits_[0] & 0x00000001u) != 0;
 }
-void ReverseScanResponse::set_has_header() {
+void FilteredScanResponse::set_has_header() {
   _has_bits_[0] |= 0x00000001u;
 }
-void ReverseScanResponse::clear_has_header() {
+void FilteredScanResponse::clear_has_header() {
   _has_bits_[0] &= ~0x00000001u;
 }
-void ReverseScanResponse::clear_header() {
+void FilteredScanResponse::clear_header() {
   if (header_ != NULL) header_->::cockroach::roachpb::ResponseHeader::Clear();
   clear_has_header();
 }
- const ::cockroach::roachpb::ResponseHeader& ReverseScanResponse::header() const {
-  // @@protoc_insertion_point(field_get:cockroach.roachpb.ReverseScanResponse.header)
+ const ::cockroach::roachpb::ResponseHeader& FilteredScanResponse::header() const {
+  // @@protoc_insertion_point(field_get:cockroach.roachpb.FilteredScanResponse.header)
   return header_ != NULL ? *header_ : *default_instance_->header_;
 }
- ::cockroach::roachpb::ResponseHeader* ReverseScanResponse::mutable_header() {
+ ::cockroach::roachpb::ResponseHeader* FilteredScanResponse::mutable_header() {
   set_has_header();
   if (header_ == NULL) {
     header_ = new ::cockroach::roachpb::ResponseHeader;
   }
-  // @@protoc_insertion_point(field_mutable:cockroach.roachpb.ReverseScanResponse.header)
+  // @@protoc_insertion_point(field_mutable:cockroach.roachpb.FilteredScanResponse.header)
   return header_;
 }
- ::cockroach::roachpb::ResponseHeader* ReverseScanResponse::release_header() {
+ ::cockroach::roachpb::ResponseHeader* FilteredScanResponse::release_header() {
   clear_has_header();
   ::cockroach::roachpb::ResponseHeader* temp = header_;
   header_ = NULL;
   return temp;
 }
- void ReverseScanResponse::set_allocated_header(::cockroach::roachpb::ResponseHeader* header) {
+ void FilteredScanResponse::set_allocated_header(::cockroach::roachpb::ResponseHeader* header) {
   delete header_;
   header_ = header;
   if (header) {
@@ -8232,36 +9789,36 @@ void ReverseScanResponse::clear_header() {
   } else {
     clear_has_header();
   }
-  // @@protoc_insertion_point(field_set_allocated:cockroach.roachpb.ReverseScanResponse.header)
+  // @@protoc_insertion_point(field_set_allocated:cockroach.roachpb.FilteredScanResponse.header)
 }
 
 // repeated .cockroach.roachpb.KeyValue rows = 2;
-int ReverseScanResponse::rows_size() const {
+int FilteredScanResponse::rows_size() const {
   return rows_.size();
 }
-void ReverseScanResponse::clear_rows() {
+void FilteredScanResponse::clear_rows() {
   rows_.Clear();
 }
- const ::cockroach::roachpb::KeyValue& ReverseScanResponse::rows(int index) const {
-  // @@protoc_insertion_point(field_get:cockroach.roachpb.ReverseScanResponse.rows)
+ const ::cockroach::roachpb::KeyValue& FilteredScanResponse::rows(int index) const {
+  // @@protoc_insertion_point(field_get:cockroach.roachpb.FilteredScanResponse.rows)
   return rows_.Get(index);
 }
- ::cockroach::roachpb::KeyValue* ReverseScanResponse::mutable_rows(int index) {
-  // @@protoc_insertion_point(field_mutable:cockroach.roachpb.ReverseScanResponse.rows)
+ ::cockroach::roachpb::KeyValue* FilteredScanResponse::mutable_rows(int index) {
+  // @@protoc_insertion_point(field_mutable:cockroach.roachpb.FilteredScanResponse.rows)
   return rows_.Mutable(index);
 }
- ::cockroach::roachpb::KeyValue* ReverseScanResponse::add_rows() {
-  // @@protoc_insertion_point(field_add:cockroach.roachpb.ReverseScanResponse.rows)
+ ::cockroach::roachpb::KeyValue* FilteredScanResponse::add_rows() {
+  // @@protoc_insertion_point(field_add:cockroach.roachpb.FilteredScanResponse.rows)
   return rows_.Add();
 }
  const ::google::protobuf::RepeatedPtrField< ::cockroach::roachpb::KeyValue >&
-ReverseScanResponse::rows() const {
-  // @@protoc_insertion_point(field_list:cockroach.roachpb.ReverseScanResponse.rows)
+FilteredScanResponse::rows() const {
+  // @@protoc_insertion_point(field_list:cockroach.roachpb.FilteredScanResponse.rows)
   return rows_;
 }
  ::google::protobuf::RepeatedPtrField< ::cockroach::roachpb::KeyValue >*
-ReverseScanResponse::mutable_rows() {
-  // @@protoc_insertion_point(field_mutable_list:cockroach.roachpb.ReverseScanResponse.rows)
+FilteredScanResponse::mutable_rows() {
+  // @@protoc_insertion_point(field_mutable_list:cockroach.roachpb.FilteredScanResponse.rows)
   return &rows_;
 }
 
@@ -18464,6 +20021,8 @@ const int RequestUnion::kTruncateLogFieldNumber;
 const int RequestUnion::kLeaderLeaseFieldNumber;
 const int RequestUnion::kReverseScanFieldNumber;
 const int RequestUnion::kNoopFieldNumber;
+const int RequestUnion::kFilteredScanFieldNumber;
+const int RequestUnion::kClearRangeFieldNumber;
 #endif  // !_MSC_VER
 
 RequestUnion::RequestUnion()
@@ -18495,6 +20054,8 @@ void RequestUnion::InitAsDefaultInstance() {
   leader_lease_ = const_cast< ::cockroach::roachpb::LeaderLeaseRequest*>(&::cockroach::roachpb::LeaderLeaseRequest::default_instance());
   reverse_scan_ = const_cast< ::cockroach::roachpb::ReverseScanRequest*>(&::cockroach::roachpb::ReverseScanRequest::default_instance());
   noop_ = const_cast< ::cockroach::roachpb::NoopRequest*>(&::cockroach::roachpb::NoopRequest::default_instance());
+  filtered_scan_ = const_cast< ::cockroach::roachpb::FilteredScanRequest*>(&::cockroach::roachpb::FilteredScanRequest::default_instance());
+  clear_range_ = const_cast< ::cockroach::roachpb::ClearRangeRequest*>(&::cockroach::roachpb::ClearRangeRequest::default_instance());
 }
 
 RequestUnion::RequestUnion(const RequestUnion& from)
@@ -18529,6 +20090,8 @@ void RequestUnion::SharedCtor() {
   leader_lease_ = NULL;
   reverse_scan_ = NULL;
   noop_ = NULL;
+  filtered_scan_ = NULL;
+  clear_range_ = NULL;
   ::memset(_has_bits_, 0, sizeof(_has_bits_));
 }
 
@@ -18561,6 +20124,8 @@ void RequestUnion::SharedDtor() {
     delete leader_lease_;
     delete reverse_scan_;
     delete noop_;
+    delete filtered_scan_;
+    delete clear_range_;
   }
 }
 
@@ -18642,7 +20207,7 @@ void RequestUnion::Clear() {
       if (resolve_intent_ != NULL) resolve_intent_->::cockroach::roachpb::ResolveIntentRequest::Clear();
     }
   }
-  if (_has_bits_[16 / 32] & 4128768u) {
+  if (_has_bits_[16 / 32] & 16711680u) {
     if (has_resolve_intent_range()) {
       if (resolve_intent_range_ != NULL) resolve_intent_range_->::cockroach::roachpb::ResolveIntentRangeRequest::Clear();
     }
@@ -18661,6 +20226,12 @@ void RequestUnion::Clear() {
     if (has_noop()) {
       if (noop_ != NULL) noop_->::cockroach::roachpb::NoopRequest::Clear();
     }
+    if (has_filtered_scan()) {
+      if (filtered_scan_ != NULL) filtered_scan_->::cockroach::roachpb::FilteredScanRequest::Clear();
+    }
+    if (has_clear_range()) {
+      if (clear_range_ != NULL) clear_range_->::cockroach::roachpb::ClearRangeRequest::Clear();
+    }
   }
   ::memset(_has_bits_, 0, sizeof(_has_bits_));
   if (_internal_metadata_.have_unknown_fields()) {
@@ -18959,6 +20530,32 @@ bool RequestUnion::MergePartialFromCodedStream(
         } else {
           goto handle_unusual;
         }
+        if (input->ExpectTag(186)) goto parse_filtered_scan;
+        break;
+      }
+
+      // optional .cockroach.roachpb.FilteredScanRequest filtered_scan = 23;
+      case 23: {
+        if (tag == 186) {
+         parse_filtered_scan:
+          DO_(::google::protobuf::internal::WireFormatLite::ReadMessageNoVirtual(
+               input, mutable_filtered_scan()));
+        } else {
+          goto handle_unusual;
+        }
+        if (input->ExpectTag(194)) goto parse_clear_range;
+        break;
+      }
+
+      // optional .cockroach.roachpb.ClearRangeRequest clear_range = 24;
+      case 24: {
+        if (tag == 194) {
+         parse_clear_range:
+          DO_(::google::protobuf::internal::WireFormatLite::ReadMessageNoVirtual(
+               input, mutable_clear_range()));
+        } else {
+          goto handle_unusual;
+        }
         if (input->ExpectAtEnd()) goto success;
         break;
       }
@@ -19120,6 +20717,18 @@ void RequestUnion::SerializeWithCachedSizes(
       22, *this->noop_, output);
   }
 
+  // optional .cockroach.roachpb.FilteredScanRequest filtered_scan = 23;
+  if (has_filtered_scan()) {
+    ::google::protobuf::internal::WireFormatLite::WriteMessageMaybeToArray(
+      23, *this->filtered_scan_, output);
+  }
+
+  // optional .cockroach.roachpb.ClearRangeRequest clear_range = 24;
+  if (has_clear_range()) {
+    ::google::protobuf::internal::WireFormatLite::WriteMessageMaybeToArray(
+      24, *this->clear_range_, output);
+  }
+
   if (_internal_metadata_.have_unknown_fields()) {
     ::google::protobuf::internal::WireFormat::SerializeUnknownFields(
         unknown_fields(), output);
@@ -19284,6 +20893,20 @@ void RequestUnion::SerializeWithCachedSizes(
         22, *this->noop_, target);
   }
 
+  // optional .cockroach.roachpb.FilteredScanRequest filtered_scan = 23;
+  if (has_filtered_scan()) {
+    target = ::google::protobuf::internal::WireFormatLite::
+      WriteMessageNoVirtualToArray(
+        23, *this->filtered_scan_, target);
+  }
+
+  // optional .cockroach.roachpb.ClearRangeRequest clear_range = 24;
+  if (has_clear_range()) {
+    target = ::google::protobuf::internal::WireFormatLite::
+      WriteMessageNoVirtualToArray(
+        24, *this->clear_range_, target);
+  }
+
   if (_internal_metadata_.have_unknown_fields()) {
     target = ::google::protobuf::internal::WireFormat::SerializeUnknownFieldsToArray(
         unknown_fields(), target);
@@ -19411,7 +21034,7 @@ int RequestUnion::ByteSize() const {
     }
 
   }
-  if (_has_bits_[16 / 32] & 4128768) {
+  if (_has_bits_[16 / 32] & 16711680) {
     // optional .cockroach.roachpb.ResolveIntentRangeRequest resolve_intent_range = 17;
     if (has_resolve_intent_range()) {
       total_size += 2 +
@@ -19454,6 +21077,20 @@ int RequestUnion::ByteSize() const {
           *this->noop_);
     }
 
+    // optional .cockroach.roachpb.FilteredScanRequest filtered_scan = 23;
+    if (has_filtered_scan()) {
+      total_size += 2 +
+        ::google::protobuf::internal::WireFormatLite::MessageSizeNoVirtual(
+          *this->filtered_scan_);
+    }
+
+    // optional .cockroach.roachpb.ClearRangeRequest clear_range = 24;
+    if (has_clear_range()) {
+      total_size += 2 +
+        ::google::protobuf::internal::WireFormatLite::MessageSizeNoVirtual(
+          *this->clear_range_);
+    }
+
   }
   if (_internal_metadata_.have_unknown_fields()) {
     total_size +=
@@ -19551,6 +21188,12 @@ void RequestUnion::MergeFrom(const RequestUnion& from) {
     if (from.has_noop()) {
       mutable_noop()->::cockroach::roachpb::NoopRequest::MergeFrom(from.noop());
     }
+    if (from.has_filtered_scan()) {
+      mutable_filtered_scan()->::cockroach::roachpb::FilteredScanRequest::MergeFrom(from.filtered_scan());
+    }
+    if (from.has_clear_range()) {
+      mutable_clear_range()->::cockroach::roachpb::ClearRangeRequest::MergeFrom(from.clear_range());
+    }
   }
   if (from._internal_metadata_.have_unknown_fields()) {
     mutable_unknown_fields()->MergeFrom(from.unknown_fields());
@@ -19601,6 +21244,8 @@ void RequestUnion::InternalSwap(RequestUnion* other) {
   std::swap(leader_lease_, other->leader_lease_);
   std::swap(reverse_scan_, other->reverse_scan_);
   std::swap(noop_, other->noop_);
+  std::swap(filtered_scan_, other->filtered_scan_);
+  std::swap(clear_range_, other->clear_range_);
   std::swap(_has_bits_[0], other->_has_bits_[0]);
   _internal_metadata_.Swap(&other->_internal_metadata_);
   std::swap(_cached_size_, other->_cached_size_);
@@ -20563,6 +22208,92 @@ void RequestUnion::clear_noop() {
   // @@protoc_insertion_point(field_set_allocated:cockroach.roachpb.RequestUnion.noop)
 }
 
+// optional .cockroach.roachpb.FilteredScanRequest filtered_scan = 23;
+bool RequestUnion::has_filtered_scan() const {
+  return (_has_bits_[0] & 0x00400000u) != 0;
+}
+void RequestUnion::set_has_filtered_scan() {
+  _has_bits_[0] |= 0x00400000u;
+}
+void RequestUnion::clear_has_filtered_scan() {
+  _has_bits_[0] &= ~0x00400000u;
+}
+void RequestUnion::clear_filtered_scan() {
+  if (filtered_scan_ != NULL) filtered_scan_->::cockroach::roachpb::FilteredScanRequest::Clear();
+  clear_has_filtered_scan();
+}
+ const ::cockroach::roachpb::FilteredScanRequest& RequestUnion::filtered_scan() const {
+  // @@protoc_insertion_point(field_get:cockroach.roachpb.RequestUnion.filtered_scan)
+  return filtered_scan_ != NULL ? *filtered_scan_ : *default_instance_->filtered_scan_;
+}
+ ::cockroach::roachpb::FilteredScanRequest* RequestUnion::mutable_filtered_scan() {
+  set_has_filtered_scan();
+  if (filtered_scan_ == NULL) {
+    filtered_scan_ = new ::cockroach::roachpb::FilteredScanRequest;
+  }
+  // @@protoc_insertion_point(field_mutable:cockroach.roachpb.RequestUnion.filtered_scan)
+  return filtered_scan_;
+}
+ ::cockroach::roachpb::FilteredScanRequest* RequestUnion::release_filtered_scan() {
+  clear_has_filtered_scan();
+  ::cockroach::roachpb::FilteredScanRequest* temp = filtered_scan_;
+  filtered_scan_ = NULL;
+  return temp;
+}
+ void RequestUnion::set_allocated_filtered_scan(::cockroach::roachpb::FilteredScanRequest* filtered_scan) {
+  delete filtered_scan_;
+  filtered_scan_ = filtered_scan;
+  if (filtered_scan) {
+    set_has_filtered_scan();
+  } else {
+    clear_has_filtered_scan();
+  }
+  // @@protoc_insertion_point(field_set_allocated:cockroach.roachpb.RequestUnion.filtered_scan)
+}
+
+// optional .cockroach.roachpb.ClearRangeRequest clear_range = 24;
+bool RequestUnion::has_clear_range() const {
+  return (_has_bits_[0] & 0x00800000u) != 0;
+}
+void RequestUnion::set_has_clear_range() {
+  _has_bits_[0] |= 0x00800000u;
+}
+void RequestUnion::clear_has_clear_range() {
+  _has_bits_[0] &= ~0x00800000u;
+}
+void RequestUnion::clear_clear_range() {
+  if (clear_range_ != NULL) clear_range_->::cockroach::roachpb::ClearRangeRequest::Clear();
+  clear_has_clear_range();
+}
+ const ::cockroach::roachpb::ClearRangeRequest& RequestUnion::clear_range() const {
+  // @@protoc_insertion_point(field_get:cockroach.roachpb.RequestUnion.clear_range)
+  return clear_range_ != NULL ? *clear_range_ : *default_instance_->clear_range_;
+}
+ ::cockroach::roachpb::ClearRangeRequest* RequestUnion::mutable_clear_range() {
+  set_has_clear_range();
+  if (clear_range_ == NULL) {
+    clear_range_ = new ::cockroach::roachpb::ClearRangeRequest;
+  }
+  // @@protoc_insertion_point(field_mutable:cockroach.roachpb.RequestUnion.clear_range)
+  return clear_range_;
+}
+ ::cockroach::roachpb::ClearRangeRequest* RequestUnion::release_clear_range() {
+  clear_has_clear_range();
+  ::cockroach::roachpb::ClearRangeRequest* temp = clear_range_;
+  clear_range_ = NULL;
+  return temp;
+}
+ void RequestUnion::set_allocated_clear_range(::cockroach::roachpb::ClearRangeRequest* clear_range) {
+  delete clear_range_;
+  clear_range_ = clear_range;
+  if (clear_range) {
+    set_has_clear_range();
+  } else {
+    clear_has_clear_range();
+  }
+  // @@protoc_insertion_point(field_set_allocated:cockroach.roachpb.RequestUnion.clear_range)
+}
+
 #endif  // PROTOBUF_INLINE_NOT_IN_HEADERS
 
 // ===================================================================
@@ -20590,6 +22321,8 @@ const int ResponseUnion::kTruncateLogFieldNumber;
 const int ResponseUnion::kLeaderLeaseFieldNumber;
 const int ResponseUnion::kReverseScanFieldNumber;
 const int ResponseUnion::kNoopFieldNumber;
+const int ResponseUnion::kFilteredScanFieldNumber;
+const int ResponseUnion::kClearRangeFieldNumber;
 #endif  // !_MSC_VER
 
 ResponseUnion::ResponseUnion()
@@ -20621,6 +22354,8 @@ void ResponseUnion::InitAsDefaultInstance() {
   leader_lease_ = const_cast< ::cockroach::roachpb::LeaderLeaseResponse*>(&::cockroach::roachpb::LeaderLeaseResponse::default_instance());
   reverse_scan_ = const_cast< ::cockroach::roachpb::ReverseScanResponse*>(&::cockroach::roachpb::ReverseScanResponse::default_instance());
   noop_ = const_cast< ::cockroach::roachpb::NoopResponse*>(&::cockroach::roachpb::NoopResponse::default_instance());
+  filtered_scan_ = const_cast< ::cockroach::roachpb::FilteredScanResponse*>(&::cockroach::roachpb::FilteredScanResponse::default_instance());
+  clear_range_ = const_cast< ::cockroach::roachpb::ClearRangeResponse*>(&::cockroach::roachpb::ClearRangeResponse::default_instance());
 }
 
 ResponseUnion::ResponseUnion(const ResponseUnion& from)
@@ -20655,6 +22390,8 @@ void ResponseUnion::SharedCtor() {
   leader_lease_ = NULL;
   reverse_scan_ = NULL;
   noop_ = NULL;
+  filtered_scan_ = NULL;
+  clear_range_ = NULL;
   ::memset(_has_bits_, 0, sizeof(_has_bits_));
 }
 
@@ -20687,6 +22424,8 @@ void ResponseUnion::SharedDtor() {
     delete leader_lease_;
     delete reverse_scan_;
     delete noop_;
+    delete filtered_scan_;
+    delete clear_range_;
   }
 }
 
@@ -20768,7 +22507,7 @@ void ResponseUnion::Clear() {
       if (resolve_intent_ != NULL) resolve_intent_->::cockroach::roachpb::ResolveIntentResponse::Clear();
     }
   }
-  if (_has_bits_[16 / 32] & 4128768u) {
+  if (_has_bits_[16 / 32] & 16711680u) {
     if (has_resolve_intent_range()) {
       if (resolve_intent_range_ != NULL) resolve_intent_range_->::cockroach::roachpb::ResolveIntentRangeResponse::Clear();
     }
@@ -20787,6 +22526,12 @@ void ResponseUnion::Clear() {
     if (has_noop()) {
       if (noop_ != NULL) noop_->::cockroach::roachpb::NoopResponse::Clear();
     }
+    if (has_filtered_scan()) {
+      if (filtered_scan_ != NULL) filtered_scan_->::cockroach::roachpb::FilteredScanResponse::Clear();
+    }
+    if (has_clear_range()) {
+      if (clear_range_ != NULL) clear_range_->::cockroach::roachpb::ClearRangeResponse::Clear();
+    }
   }
   ::memset(_has_bits_, 0, sizeof(_has_bits_));
   if (_internal_metadata_.have_unknown_fields()) {
@@ -21085,6 +22830,32 @@ bool ResponseUnion::MergePartialFromCodedStream(
         } else {
           goto handle_unusual;
         }
+        if (input->ExpectTag(186)) goto parse_filtered_scan;
+        break;
+      }
+
+      // optional .cockroach.roachpb.FilteredScanResponse filtered_scan = 23;
+      case 23: {
+        if (tag == 186) {
+         parse_filtered_scan:
+          DO_(::google::protobuf::internal::WireFormatLite::ReadMessageNoVirtual(
+               input, mutable_filtered_scan()));
+        } else {
+          goto handle_unusual;
+        }
+        if (input->ExpectTag(194)) goto parse_clear_range;
+        break;
+      }
+
+      // optional .cockroach.roachpb.ClearRangeResponse clear_range = 24;
+      case 24: {
+        if (tag == 194) {
+         parse_clear_range:
+          DO_(::google::protobuf::internal::WireFormatLite::ReadMessageNoVirtual(
+               input, mutable_clear_range()));
+        } else {
+          goto handle_unusual;
+        }
         if (input->ExpectAtEnd()) goto success;
         break;
       }
@@ -21246,6 +23017,18 @@ void ResponseUnion::SerializeWithCachedSizes(
       22, *this->noop_, output);
   }
 
+  // optional .cockroach.roachpb.FilteredScanResponse filtered_scan = 23;
+  if (has_filtered_scan()) {
+    ::google::protobuf::internal::WireFormatLite::WriteMessageMaybeToArray(
+      23, *this->filtered_scan_, output);
+  }
+
+  // optional .cockroach.roachpb.ClearRangeResponse clear_range = 24;
+  if (has_clear_range()) {
+    ::google::protobuf::internal::WireFormatLite::WriteMessageMaybeToArray(
+      24, *this->clear_range_, output);
+  }
+
   if (_internal_metadata_.have_unknown_fields()) {
     ::google::protobuf::internal::WireFormat::SerializeUnknownFields(
         unknown_fields(), output);
@@ -21410,6 +23193,20 @@ void ResponseUnion::SerializeWithCachedSizes(
         22, *this->noop_, target);
   }
 
+  // optional .cockroach.roachpb.FilteredScanResponse filtered_scan = 23;
+  if (has_filtered_scan()) {
+    target = ::google::protobuf::internal::WireFormatLite::
+      WriteMessageNoVirtualToArray(
+        23, *this->filtered_scan_, target);
+  }
+
+  // optional .cockroach.roachpb.ClearRangeResponse clear_range = 24;
+  if (has_clear_range()) {
+    target = ::google::protobuf::internal::WireFormatLite::
+      WriteMessageNoVirtualToArray(
+        24, *this->clear_range_, target);
+  }
+
   if (_internal_metadata_.have_unknown_fields()) {
     target = ::google::protobuf::internal::WireFormat::SerializeUnknownFieldsToArray(
         unknown_fields(), target);
@@ -21537,7 +23334,7 @@ int ResponseUnion::ByteSize() const {
     }
 
   }
-  if (_has_bits_[16 / 32] & 4128768) {
+  if (_has_bits_[16 / 32] & 16711680) {
     // optional .cockroach.roachpb.ResolveIntentRangeResponse resolve_intent_range = 17;
     if (has_resolve_intent_range()) {
       total_size += 2 +
@@ -21580,6 +23377,20 @@ int ResponseUnion::ByteSize() const {
           *this->noop_);
     }
 
+    // optional .cockroach.roachpb.FilteredScanResponse filtered_scan = 23;
+    if (has_filtered_scan()) {
+      total_size += 2 +
+        ::google::protobuf::internal::WireFormatLite::MessageSizeNoVirtual(
+          *this->filtered_scan_);
+    }
+
+    // optional .cockroach.roachpb.ClearRangeResponse clear_range = 24;
+    if (has_clear_range()) {
+      total_size += 2 +
+        ::google::protobuf::internal::WireFormatLite::MessageSizeNoVirtual(
+          *this->clear_range_);
+    }
+
   }
   if (_internal_metadata_.have_unknown_fields()) {
     total_size +=
@@ -21677,6 +23488,12 @@ void ResponseUnion::MergeFrom(const ResponseUnion& from) {
     if (from.has_noop()) {
       mutable_noop()->::cockroach::roachpb::NoopResponse::MergeFrom(from.noop());
     }
+    if (from.has_filtered_scan()) {
+      mutable_filtered_scan()->::cockroach::roachpb::FilteredScanResponse::MergeFrom(from.filtered_scan());
+    }
+    if (from.has_clear_range()) {
+      mutable_clear_range()->::cockroach::roachpb::ClearRangeResponse::MergeFrom(from.clear_range());
+    }
   }
   if (from._internal_metadata_.have_unknown_fields()) {
     mutable_unknown_fields()->MergeFrom(from.unknown_fields());
@@ -21727,6 +23544,8 @@ void ResponseUnion::InternalSwap(ResponseUnion* other) {
   std::swap(leader_lease_, other->leader_lease_);
   std::swap(reverse_scan_, other->reverse_scan_);
   std::swap(noop_, other->noop_);
+  std::swap(filtered_scan_, other->filtered_scan_);
+  std::swap(clear_range_, other->clear_range_);
   std::swap(_has_bits_[0], other->_has_bits_[0]);
   _internal_metadata_.Swap(&other->_internal_metadata_);
   std::swap(_cached_size_, other->_cached_size_);
@@ -22689,6 +24508,92 @@ void ResponseUnion::clear_noop() {
   // @@protoc_insertion_point(field_set_allocated:cockroach.roachpb.ResponseUnion.noop)
 }
 
+// optional .cockroach.roachpb.FilteredScanResponse filtered_scan = 23;
+bool ResponseUnion::has_filtered_scan() const {
+  return (_has_bits_[0] & 0x00400000u) != 0;
+}
+void ResponseUnion::set_has_filtered_scan() {
+  _has_bits_[0] |= 0x00400000u;
+}
+void ResponseUnion::clear_has_filtered_scan() {
+  _has_bits_[0] &= ~0x00400000u;
+}
+void ResponseUnion::clear_filtered_scan() {
+  if (filtered_scan_ != NULL) filtered_scan_->::cockroach::roachpb::FilteredScanResponse::Clear();
+  clear_has_filtered_scan();
+}
+ const ::cockroach::roachpb::FilteredScanResponse& ResponseUnion::filtered_scan() const {
+  // @@protoc_insertion_point(field_get:cockroach.roachpb.ResponseUnion.filtered_scan)
+  return filtered_scan_ != NULL ? *filtered_scan_ : *default_instance_->filtered_scan_;
+}
+ ::cockroach::roachpb::FilteredScanResponse* ResponseUnion::mutable_filtered_scan() {
+  set_has_filtered_scan();
+  if (filtered_scan_ == NULL) {
+    filtered_scan_ = new ::cockroach::roachpb::FilteredScanResponse;
+  }
+  // @@protoc_insertion_point(field_mutable:cockroach.roachpb.ResponseUnion.filtered_scan)
+  return filtered_scan_;
+}
+ ::cockroach::roachpb::FilteredScanResponse* ResponseUnion::release_filtered_scan() {
+  clear_has_filtered_scan();
+  ::cockroach::roachpb::FilteredScanResponse* temp = filtered_scan_;
+  filtered_scan_ = NULL;
+  return temp;
+}
+ void ResponseUnion::set_allocated_filtered_scan(::cockroach::roachpb::FilteredScanResponse* filtered_scan) {
+  delete filtered_scan_;
+  filtered_scan_ = filtered_scan;
+  if (filtered_scan) {
+    set_has_filtered_scan();
+  } else {
+    clear_has_filtered_scan();
+  }
+  // @@protoc_insertion_point(field_set_allocated:cockroach.roachpb.ResponseUnion.filtered_scan)
+}
+
+// optional .cockroach.roachpb.ClearRangeResponse clear_range = 24;
+bool ResponseUnion::has_clear_range() const {
+  return (_has_bits_[0] & 0x00800000u) != 0;
+}
+void ResponseUnion::set_has_clear_range() {
+  _has_bits_[0] |= 0x00800000u;
+}
+void ResponseUnion::clear_has_clear_range() {
+  _has_bits_[0] &= ~0x00800000u;
+}
+void ResponseUnion::clear_clear_range() {
+  if (clear_range_ != NULL) clear_range_->::cockroach::roachpb::ClearRangeResponse::Clear();
+  clear_has_clear_range();
+}
+ const ::cockroach::roachpb::ClearRangeResponse& ResponseUnion::clear_range() const {
+  // @@protoc_insertion_point(field_get:cockroach.roachpb.ResponseUnion.clear_range)
+  return clear_range_ != NULL ? *clear_range_ : *default_instance_->clear_range_;
+}
+ ::cockroach::roachpb::ClearRangeResponse* ResponseUnion::mutable_clear_range() {
+  set_has_clear_range();
+  if (clear_range_ == NULL) {
+    clear_range_ = new ::cockroach::roachpb::ClearRangeResponse;
+  }
+  // @@protoc_insertion_point(field_mutable:cockroach.roachpb.ResponseUnion.clear_range)
+  return clear_range_;
+}
+ ::cockroach::roachpb::ClearRangeResponse* ResponseUnion::release_clear_range() {
+  clear_has_clear_range();
+  ::cockroach::roachpb::ClearRangeResponse* temp = clear_range_;
+  clear_range_ = NULL;
+  return temp;
+}
+ void ResponseUnion::set_allocated_clear_range(::cockroach::roachpb::ClearRangeResponse* clear_range) {
+  delete clear_range_;
+  clear_range_ = clear_range;
+  if (clear_range) {
+    set_has_clear_range();
+  } else {
+    clear_has_clear_range();
+  }
+  // @@protoc_insertion_point(field_set_allocated:cockroach.roachpb.ResponseUnion.clear_range)
+}
+
 #endif  // PROTOBUF_INLINE_NOT_IN_HEADERS
 
 // ===================================================================
@@ -57,10 +57,14 @@ class DeleteRequest;
 class DeleteResponse;
 class DeleteRangeRequest;
 class DeleteRangeResponse;
+class ClearRangeRequest;
+class ClearRangeResponse;
 class ScanRequest;
 class ScanResponse;
 class ReverseScanRequest;
 class ReverseScanResponse;
+class FilteredScanRequest;
+class FilteredScanResponse;
 class BeginTransactionRequest;
 class BeginTransactionResponse;
 class EndTransactionRequest;
@@ -1630,6 +1634,198 @@ class DeleteRangeResponse : public ::google::protobuf::Message {
 };
 // -------------------------------------------------------------------
 
+class ClearRangeRequest : public ::google::protobuf::Message {
+ public:
+  ClearRangeRequest();
+  virtual ~ClearRangeRequest();
+
+  ClearRangeRequest(const ClearRangeRequest& from);
+
+  inline ClearRangeRequest& operator=(const ClearRangeRequest& from) {
+    CopyFrom(from);
+    return *this;
+  }
+
+  inline const ::google::protobuf::UnknownFieldSet& unknown_fields() const {
+    return _internal_metadata_.unknown_fields();
+  }
+
+  inline ::google::protobuf::UnknownFieldSet* mutable_unknown_fields() {
+    return _internal_metadata_.mutable_unknown_fields();
+  }
+
+  static const ::google::protobuf::Descriptor* descriptor();
+  static const ClearRangeRequest& default_instance();
+
+  void Swap(ClearRangeRequest* other);
+
+  // implements Message ----------------------------------------------
+
+  inline ClearRangeRequest* New() const { return New(NULL); }
+
+  ClearRangeRequest* New(::google::protobuf::Arena* arena) const;
+  void CopyFrom(const ::google::protobuf::Message& from);
+  void MergeFrom(const ::google::protobuf::Message& from);
+  void CopyFrom(const ClearRangeRequest& from);
+  void MergeFrom(const ClearRangeRequest& from);
+  void Clear();
+  bool IsInitialized() const;
+
+  int ByteSize() const;
+  bool MergePartialFromCodedStream(
+      ::google::protobuf::io::CodedInputStream* input);
+  void SerializeWithCachedSizes(
+      ::google::protobuf::io::CodedOutputStream* output) const;
+  ::google::protobuf::uint8* SerializeWithCachedSizesToArray(::google::protobuf::uint8* output) const;
+  int GetCachedSize() const { return _cached_size_; }
+  private:
+  void SharedCtor();
+  void SharedDtor();
+  void SetCachedSize(int size) const;
+  void InternalSwap(ClearRangeRequest* other);
+  private:
+  inline ::google::protobuf::Arena* GetArenaNoVirtual() const {
+    return _internal_metadata_.arena();
+  }
+  inline void* MaybeArenaPtr() const {
+    return _internal_metadata_.raw_arena_ptr();
+  }
+  public:
+
+  ::google::protobuf::Metadata GetMetadata() const;
+
+  // nested types ----------------------------------------------------
+
+  // accessors -------------------------------------------------------
+
+  // optional .cockroach.roachpb.Span header = 1;
+  bool has_header() const;
+  void clear_header();
+  static const int kHeaderFieldNumber = 1;
+  const ::cockroach::roachpb::Span& header() const;
+  ::cockroach::roachpb::Span* mutable_header();
+  ::cockroach::roachpb::Span* release_header();
+  void set_allocated_header(::cockroach::roachpb::Span* header);
+
+  // @@protoc_insertion_point(class_scope:cockroach.roachpb.ClearRangeRequest)
+ private:
+  inline void set_has_header();
+  inline void clear_has_header();
+
+  ::google::protobuf::internal::InternalMetadataWithArena _internal_metadata_;
+  ::google::protobuf::uint32 _has_bits_[1];
+  mutable int _cached_size_;
+  ::cockroach::roachpb::Span* header_;
+  friend void  protobuf_AddDesc_cockroach_2froachpb_2fapi_2eproto();
+  friend void protobuf_AssignDesc_cockroach_2froachpb_2fapi_2eproto();
+  friend void protobuf_ShutdownFile_cockroach_2froachpb_2fapi_2eproto();
+
+  void InitAsDefaultInstance();
+  static ClearRangeRequest* default_instance_;
+};
+// -------------------------------------------------------------------
+
+class ClearRangeResponse : public ::google::protobuf::Message {
+ public:
+  ClearRangeResponse();
+  virtual ~ClearRangeResponse();
+
+  ClearRangeResponse(const ClearRangeResponse& from);
+
+  inline ClearRangeResponse& operator=(const ClearRangeResponse& from) {
+    CopyFrom(from);
+    return *this;
+  }
+
+  inline const ::google::protobuf::UnknownFieldSet& unknown_fields() const {
+    return _internal_metadata_.unknown_fields();
+  }
+
+  inline ::google::protobuf::UnknownFieldSet* mutable_unknown_fields() {
+    return _internal_metadata_.mutable_unknown_fields();
+  }
+
+  static const ::google::protobuf::Descriptor* descriptor();
+  static const ClearRangeResponse& default_instance();
+
+  void Swap(ClearRangeResponse* other);
+
+  // implements Message ----------------------------------------------
+
+  inline ClearRangeResponse* New() const { return New(NULL); }
+
+  ClearRangeResponse* New(::google::protobuf::Arena* arena) const;
+  void CopyFrom(const ::google::protobuf::Message& from);
+  void MergeFrom(const ::google::protobuf::Message& from);
+  void CopyFrom(const ClearRangeResponse& from);
+  void MergeFrom(const ClearRangeResponse& from);
+  void Clear();
+  bool IsInitialized() const;
+
+  int ByteSize() const;
+  bool MergePartialFromCodedStream(
+      ::google::protobuf::io::CodedInputStream* input);
+  void SerializeWithCachedSizes(
+      ::google::protobuf::io::CodedOutputStream* output) const;
+  ::google::protobuf::uint8* SerializeWithCachedSizesToArray(::google::protobuf::uint8* output) const;
+  int GetCachedSize() const { return _cached_size_; }
+  private:
+  void SharedCtor();
+  void SharedDtor();
+  void SetCachedSize(int size) const;
+  void InternalSwap(ClearRangeResponse* other);
+  private:
+  inline ::google::protobuf::Arena* GetArenaNoVirtual() const {
+    return _internal_metadata_.arena();
+  }
+  inline void* MaybeArenaPtr() const {
+    return _internal_metadata_.raw_arena_ptr();
+  }
+  public:
+
+  ::google::protobuf::Metadata GetMetadata() const;
+
+  // nested types ----------------------------------------------------
+
+  // accessors -------------------------------------------------------
+
+  // optional .cockroach.roachpb.ResponseHeader header = 1;
+  bool has_header() const;
+  void clear_header();
+  static const int kHeaderFieldNumber = 1;
+  const ::cockroach::roachpb::ResponseHeader& header() const;
+  ::cockroach::roachpb::ResponseHeader* mutable_header();
+  ::cockroach::roachpb::ResponseHeader* release_header();
+  void set_allocated_header(::cockroach::roachpb::ResponseHeader* header);
+
+  // optional int64 num_cleared = 2;
+  bool has_num_cleared() const;
+  void clear_num_cleared();
+  static const int kNumClearedFieldNumber = 2;
+  ::google::protobuf::int64 num_cleared() const;
+  void set_num_cleared(::google::protobuf::int64 value);
+
+  // @@protoc_insertion_point(class_scope:cockroach.roachpb.ClearRangeResponse)
+ private:
+  inline void set_has_header();
+  inline void clear_has_header();
+  inline void set_has_num_cleared();
+  inline void clear_has_num_cleared();
+
+  ::google::protobuf::internal::InternalMetadataWithArena _internal_metadata_;
+  ::google::protobuf::uint32 _has_bits_[1];
+  mutable int _cached_size_;
+  ::cockroach::roachpb::ResponseHeader* header_;
+  ::google::protobuf::int64 num_cleared_;
+  friend void  protobuf_AddDesc_cockroach_2froachpb_2fapi_2eproto();
+  friend void protobuf_AssignDesc_cockroach_2froachpb_2fapi_2eproto();
+  friend void protobuf_ShutdownFile_cockroach_2froachpb_2fapi_2eproto();
+
+  void InitAsDefaultInstance();
+  static ClearRangeResponse* default_instance_;
+};
+// -------------------------------------------------------------------
+
 class ScanRequest : public ::google::protobuf::Message {
  public:
   ScanRequest();
@@ -2040,6 +2236,226 @@ class ReverseScanResponse : public ::google::protobuf::Message {
 };
 // -------------------------------------------------------------------
 
+class FilteredScanRequest : public ::google::protobuf::Message {
+ public:
+  FilteredScanRequest();
+  virtual ~FilteredScanRequest();
+
+  FilteredScanRequest(const FilteredScanRequest& from);
+
+  inline FilteredScanRequest& operator=(const FilteredScanRequest& from) {
+    CopyFrom(from);
+    return *this;
+  }
+
+  inline const ::google::protobuf::UnknownFieldSet& unknown_fields() const {
+    return _internal_metadata_.unknown_fields();
+  }
+
+  inline ::google::protobuf::UnknownFieldSet* mutable_unknown_fields() {
+    return _internal_metadata_.mutable_unknown_fields();
+  }
+
+  static const ::google::protobuf::Descriptor* descriptor();
+  static const FilteredScanRequest& default_instance();
+
+  void Swap(FilteredScanRequest* other);
+
+  // implements Message ----------------------------------------------
+
+  inline FilteredScanRequest* New() const { return New(NULL); }
+
+  FilteredScanRequest* New(::google::protobuf::Arena* arena) const;
+  void CopyFrom(const ::google::protobuf::Message& from);
+  void MergeFrom(const ::google::protobuf::Message& from);
+  void CopyFrom(const FilteredScanRequest& from);
+  void MergeFrom(const FilteredScanRequest& from);
+  void Clear();
+  bool IsInitialized() const;
+
+  int ByteSize() const;
+  bool MergePartialFromCodedStream(
+      ::google::protobuf::io::CodedInputStream* input);
+  void SerializeWithCachedSizes(
+      ::google::protobuf::io::CodedOutputStream* output) const;
+  ::google::protobuf::uint8* SerializeWithCachedSizesToArray(::google::protobuf::uint8* output) const;
+  int GetCachedSize() const { return _cached_size_; }
+  private:
+  void SharedCtor();
+  void SharedDtor();
+  void SetCachedSize(int size) const;
+  void InternalSwap(FilteredScanRequest* other);
+  private:
+  inline ::google::protobuf::Arena* GetArenaNoVirtual() const {
+    return _internal_metadata_.arena();
+  }
+  inline void* MaybeArenaPtr() const {
+    return _internal_metadata_.raw_arena_ptr();
+  }
+  public:
+
+  ::google::protobuf::Metadata GetMetadata() const;
+
+  // nested types ----------------------------------------------------
+
+  // accessors -------------------------------------------------------
+
+  // optional .cockroach.roachpb.Span header = 1;
+  bool has_header() const;
+  void clear_header();
+  static const int kHeaderFieldNumber = 1;
+  const ::cockroach::roachpb::Span& header() const;
+  ::cockroach::roachpb::Span* mutable_header();
+  ::cockroach::roachpb::Span* release_header();
+  void set_allocated_header(::cockroach::roachpb::Span* header);
+
+  // optional bytes filter = 2;
+  bool has_filter() const;
+  void clear_filter();
+  static const int kFilterFieldNumber = 2;
+  const ::std::string& filter() const;
+  void set_filter(const ::std::string& value);
+  void set_filter(const char* value);
+  void set_filter(const void* value, size_t size);
+  ::std::string* mutable_filter();
+  ::std::string* release_filter();
+  void set_allocated_filter(::std::string* filter);
+
+  // optional int64 max_results = 3;
+  bool has_max_results() const;
+  void clear_max_results();
+  static const int kMaxResultsFieldNumber = 3;
+  ::google::protobuf::int64 max_results() const;
+  void set_max_results(::google::protobuf::int64 value);
+
+  // @@protoc_insertion_point(class_scope:cockroach.roachpb.FilteredScanRequest)
+ private:
+  inline void set_has_header();
+  inline void clear_has_header();
+  inline void set_has_filter();
+  inline void clear_has_filter();
+  inline void set_has_max_results();
+  inline void clear_has_max_results();
+
+  ::google::protobuf::internal::InternalMetadataWithArena _internal_metadata_;
+  ::google::protobuf::uint32 _has_bits_[1];
+  mutable int _cached_size_;
+  ::cockroach::roachpb::Span* header_;
+  ::google::protobuf::internal::ArenaStringPtr filter_;
+  ::google::protobuf::int64 max_results_;
+  friend void  protobuf_AddDesc_cockroach_2froachpb_2fapi_2eproto();
+  friend void protobuf_AssignDesc_cockroach_2froachpb_2fapi_2eproto();
+  friend void protobuf_ShutdownFile_cockroach_2froachpb_2fapi_2eproto();
+
+  void InitAsDefaultInstance();
+  static FilteredScanRequest* default_instance_;
+};
+// -------------------------------------------------------------------
+
+class FilteredScanResponse : public ::google::protobuf::Message {
+ public:
+  FilteredScanResponse();
+  virtual ~FilteredScanResponse();
+
+  FilteredScanResponse(const FilteredScanResponse& from);
+
+  inline FilteredScanResponse& operator=(const FilteredScanResponse& from) {
+    CopyFrom(from);
+    return *this;
+  }
+
+  inline const ::google::protobuf::UnknownFieldSet& unknown_fields() const {
+    return _internal_metadata_.unknown_fields();
+  }
+
+  inline ::google::protobuf::UnknownFieldSet* mutable_unknown_fields() {
+    return _internal_metadata_.mutable_unknown_fields();
+  }
+
+  static const ::google::protobuf::Descriptor* descriptor();
+  static const FilteredScanResponse& default_instance();
+
+  void Swap(FilteredScanResponse* other);
+
+  // implements Message ----------------------------------------------
+
+  inline FilteredScanResponse* New() const { return New(NULL); }
+
+  FilteredScanResponse* New(::google::protobuf::Arena* arena) const;
+  void CopyFrom(const ::google::protobuf::Message& from);
+  void MergeFrom(const ::google::protobuf::Message& from);
+  void CopyFrom(const FilteredScanResponse& from);
+  void MergeFrom(const FilteredScanResponse& from);
+  void Clear();
+  bool IsInitialized() const;
+
+  int ByteSize() const;
+  bool MergePartialFromCodedStream(
+      ::google::protobuf::io::CodedInputStream* input);
+  void SerializeWithCachedSizes(
+      ::google::protobuf::io::CodedOutputStream* output) const;
+  ::google::protobuf::uint8* SerializeWithCachedSizesToArray(::google::protobuf::uint8* output) const;
+  int GetCachedSize() const { return _cached_size_; }
+  private:
+  void SharedCtor();
+  void SharedDtor();
+  void SetCachedSize(int size) const;
+  void InternalSwap(FilteredScanResponse* other);
+  private:
+  inline ::google::protobuf::Arena* GetArenaNoVirtual() const {
+    return _internal_metadata_.arena();
+  }
+  inline void* MaybeArenaPtr() const {
+    return _internal_metadata_.raw_arena_ptr();
+  }
+  public:
+
+  ::google::protobuf::Metadata GetMetadata() const;
+
+  // nested types ----------------------------------------------------
+
+  // accessors -------------------------------------------------------
+
+  // optional .cockroach.roachpb.ResponseHeader header = 1;
+  bool has_header() const;
+  void clear_header();
+  static const int kHeaderFieldNumber = 1;
+  const ::cockroach::roachpb::ResponseHeader& header() const;
+  ::cockroach::roachpb::ResponseHeader* mutable_header();
+  ::cockroach::roachpb::ResponseHeader* release_header();
+  void set_allocated_header(::cockroach::roachpb::ResponseHeader* header);
+
+  // repeated .cockroach.roachpb.KeyValue rows = 2;
+  int rows_size() const;
+  void clear_rows();
+  static const int kRowsFieldNumber = 2;
+  const ::cockroach::roachpb::KeyValue& rows(int index) const;
+  ::cockroach::roachpb::KeyValue* mutable_rows(int index);
+  ::cockroach::roachpb::KeyValue* add_rows();
+  const ::google::protobuf::RepeatedPtrField< ::cockroach::roachpb::KeyValue >&
+      rows() const;
+  ::google::protobuf::RepeatedPtrField< ::cockroach::roachpb::KeyValue >*
+      mutable_rows();
+
+  // @@protoc_insertion_point(class_scope:cockroach.roachpb.FilteredScanResponse)
+ private:
+  inline void set_has_header();
+  inline void clear_has_header();
+
+  ::google::protobuf::internal::InternalMetadataWithArena _internal_metadata_;
+  ::google::protobuf::uint32 _has_bits_[1];
+  mutable int _cached_size_;
+  ::cockroach::roachpb::ResponseHeader* header_;
+  ::google::protobuf::RepeatedPtrField< ::cockroach::roachpb::KeyValue > rows_;
+  friend void  protobuf_AddDesc_cockroach_2froachpb_2fapi_2eproto();
+  friend void protobuf_AssignDesc_cockroach_2froachpb_2fapi_2eproto();
+  friend void protobuf_ShutdownFile_cockroach_2froachpb_2fapi_2eproto();
+
+  void InitAsDefaultInstance();
+  static FilteredScanResponse* default_instance_;
+};
+// -------------------------------------------------------------------
+
 class BeginTransactionRequest : public ::google::protobuf::Message {
  public:
   BeginTransactionRequest();
@@ -5231,6 +5647,24 @@ class RequestUnion : public ::google::protobuf::Message {
   ::cockroach::roachpb::NoopRequest* release_noop();
   void set_allocated_noop(::cockroach::roachpb::NoopRequest* noop);
 
+  // optional .cockroach.roachpb.FilteredScanRequest filtered_scan = 23;
+  bool has_filtered_scan() const;
+  void clear_filtered_scan();
+  static const int kFilteredScanFieldNumber = 23;
+  const ::cockroach::roachpb::FilteredScanRequest& filtered_scan() const;
+  ::cockroach::roachpb::FilteredScanRequest* mutable_filtered_scan();
+  ::cockroach::roachpb::FilteredScanRequest* release_filtered_scan();
+  void set_allocated_filtered_scan(::cockroach::roachpb::FilteredScanRequest* filtered_scan);
+
+  // optional .cockroach.roachpb.ClearRangeRequest clear_range = 24;
+  bool has_clear_range() const;
+  void clear_clear_range();
+  static const int kClearRangeFieldNumber = 24;
+  const ::cockroach::roachpb::ClearRangeRequest& clear_range() const;
+  ::cockroach::roachpb::ClearRangeRequest* mutable_clear_range();
+  ::cockroach::roachpb::ClearRangeRequest* release_clear_range();
+  void set_allocated_clear_range(::cockroach::roachpb::ClearRangeRequest* clear_range);
+
   // @@protoc_insertion_point(class_scope:cockroach.roachpb.RequestUnion)
  private:
   inline void set_has_get();
@@ -5277,6 +5711,10 @@ class RequestUnion : public ::google::protobuf::Message {
   inline void clear_has_reverse_scan();
   inline void set_has_noop();
   inline void clear_has_noop();
+  inline void set_has_filtered_scan();
+  inline void clear_has_filtered_scan();
+  inline void set_has_clear_range();
+  inline void clear_has_clear_range();
 
   ::google::protobuf::internal::InternalMetadataWithArena _internal_metadata_;
   ::google::protobuf::uint32 _has_bits_[1];
@@ -5303,6 +5741,8 @@ class RequestUnion : public ::google::protobuf::Message {
   ::cockroach::roachpb::LeaderLeaseRequest* leader_lease_;
   ::cockroach::roachpb::ReverseScanRequest* reverse_scan_;
   ::cockroach::roachpb::NoopRequest* noop_;
+  ::cockroach::roachpb::FilteredScanRequest* filtered_scan_;
+  ::cockroach::roachpb::ClearRangeRequest* clear_range_;
   friend void  protobuf_AddDesc_cockroach_2froachpb_2fapi_2eproto();
   friend void protobuf_AssignDesc_cockroach_2froachpb_2fapi_2eproto();
   friend void protobuf_ShutdownFile_cockroach_2froachpb_2fapi_2eproto();
@@ -5574,6 +6014,24 @@ class ResponseUnion : public ::google::protobuf::Message {
   ::cockroach::roachpb::NoopResponse* release_noop();
   void set_allocated_noop(::cockroach::roachpb::NoopResponse* noop);
 
+  // optional .cockroach.roachpb.FilteredScanResponse filtered_scan = 23;
+  bool has_filtered_scan() const;
+  void clear_filtered_scan();
+  static const int kFilteredScanFieldNumber = 23;
+  const ::cockroach::roachpb::FilteredScanResponse& filtered_scan() const;
+  ::cockroach::roachpb::FilteredScanResponse* mutable_filtered_scan();
+  ::cockroach::roachpb::FilteredScanResponse* release_filtered_scan();
+  void set_allocated_filtered_scan(::cockroach::roachpb::FilteredScanResponse* filtered_scan);
+
+  // optional .cockroach.roachpb.ClearRangeResponse clear_range = 24;
+  bool has_clear_range() const;
+  void clear_clear_range();
+  static const int kClearRangeFieldNumber = 24;
+  const ::cockroach::roachpb::ClearRangeResponse& clear_range() const;
+  ::cockroach::roachpb::ClearRangeResponse* mutable_clear_range();
+  ::cockroach::roachpb::ClearRangeResponse* release_clear_range();
+  void set_allocated_clear_range(::cockroach::roachpb::ClearRangeResponse* clear_range);
+
   // @@protoc_insertion_point(class_scope:cockroach.roachpb.ResponseUnion)
  private:
   inline void set_has_get();
@@ -5620,6 +6078,10 @@ class ResponseUnion : public ::google::protobuf::Message {
   inline void clear_has_reverse_scan();
   inline void set_has_noop();
   inline void clear_has_noop();
+  inline void set_has_filtered_scan();
+  inline void clear_has_filtered_scan();
+  inline void set_has_clear_range();
+  inline void clear_has_clear_range();
 
   ::google::protobuf::internal::InternalMetadataWithArena _internal_metadata_;
   ::google::protobuf::uint32 _has_bits_[1];
@@ -5646,6 +6108,8 @@ class ResponseUnion : public ::google::protobuf::Message {
   ::cockroach::roachpb::LeaderLeaseResponse* leader_lease_;
   ::cockroach::roachpb::ReverseScanResponse* reverse_scan_;
   ::cockroach::roachpb::NoopResponse* noop_;
+  ::cockroach::roachpb::FilteredScanResponse* filtered_scan_;
+  ::cockroach::roachpb::ClearRangeResponse* clear_range_;
   friend void  protobuf_AddDesc_cockroach_2froachpb_2fapi_2eproto();
   friend void protobuf_AssignDesc_cockroach_2froachpb_2fapi_2eproto();
   friend void protobuf_ShutdownFile_cockroach_2froachpb_2fapi_2eproto();
@@ -7070,54 +7534,196 @@ inline ::cockroach::roachpb::ResponseHeader* DeleteResponse::release_header() {
   header_ = NULL;
   return temp;
 }
-inline void DeleteResponse::set_allocated_header(::cockroach::roachpb::ResponseHeader* header) {
-  delete header_;
-  header_ = header;
-  if (header) {
-    set_has_header();
-  } else {
-    clear_has_header();
-  }
-  // @@protoc_insertion_point(field_set_allocated:cockroach.roachpb.DeleteResponse.header)
+inline void DeleteResponse::set_allocated_header(::cockroach::roachpb::ResponseHeader* header) {
+  delete header_;
+  header_ = header;
+  if (header) {
+    set_has_header();
+  } else {
+    clear_has_header();
+  }
+  // @@protoc_insertion_point(field_set_allocated:cockroach.roachpb.DeleteResponse.header)
+}
+
+// -------------------------------------------------------------------
+
+// DeleteRangeRequest
+
+// optional .cockroach.roachpb.Span header = 1;
+inline bool DeleteRangeRequest::has_header() const {
+  return (_has_bits_[0] & 0x00000001u) != 0;
+}
+inline void DeleteRangeRequest::set_has_header() {
+  _has_bits_[0] |= 0x00000001u;
+}
+inline void DeleteRangeRequest::clear_has_header() {
+  _has_bits_[0] &= ~0x00000001u;
+}
+inline void DeleteRangeRequest::clear_header() {
+  if (header_ != NULL) header_->::cockroach::roachpb::Span::Clear();
+  clear_has_header();
+}
+inline const ::cockroach::roachpb::Span& DeleteRangeRequest::header() const {
+  // @@protoc_insertion_point(field_get:cockroach.roachpb.DeleteRangeRequest.header)
+  return header_ != NULL ? *header_ : *default_instance_->header_;
+}
+inline ::cockroach::roachpb::Span* DeleteRangeRequest::mutable_header() {
+  set_has_header();
+  if (header_ == NULL) {
+    header_ = new ::cockroach::roachpb::Span;
+  }
+  // @@protoc_insertion_point(field_mutable:cockroach.roachpb.DeleteRangeRequest.header)
+  return header_;
+}
+inline ::cockroach::roachpb::Span* DeleteRangeRequest::release_header() {
+  clear_has_header();
+  ::cockroach::roachpb::Span* temp = header_;
+  header_ = NULL;
+  return temp;
+}
+inline void DeleteRangeRequest::set_allocated_header(::cockroach::roachpb::Span* header) {
+  delete header_;
+  header_ = header;
+  if (header) {
+    set_has_header();
+  } else {
+    clear_has_header();
+  }
+  // @@protoc_insertion_point(field_set_allocated:cockroach.roachpb.DeleteRangeRequest.header)
+}
+
+// optional int64 max_entries_to_delete = 2;
+inline bool DeleteRangeRequest::has_max_entries_to_delete() const {
+  return (_has_bits_[0] & 0x00000002u) != 0;
+}
+inline void DeleteRangeRequest::set_has_max_entries_to_delete() {
+  _has_bits_[0] |= 0x00000002u;
+}
+inline void DeleteRangeRequest::clear_has_max_entries_to_delete() {
+  _has_bits_[0] &= ~0x00000002u;
+}
+inline void DeleteRangeRequest::clear_max_entries_to_delete() {
+  max_entries_to_delete_ = GOOGLE_LONGLONG(0);
+  clear_has_max_entries_to_delete();
+}
+inline ::google::protobuf::int64 DeleteRangeRequest::max_entries_to_delete() const {
+  // @@protoc_insertion_point(field_get:cockroach.roachpb.DeleteRangeRequest.max_entries_to_delete)
+  return max_entries_to_delete_;
+}
+inline void DeleteRangeRequest::set_max_entries_to_delete(::google::protobuf::int64 value) {
+  set_has_max_entries_to_delete();
+  max_entries_to_delete_ = value;
+  // @@protoc_insertion_point(field_set:cockroach.roachpb.DeleteRangeRequest.max_entries_to_delete)
+}
+
+// -------------------------------------------------------------------
+
+// DeleteRangeResponse
+
+// optional .cockroach.roachpb.ResponseHeader header = 1;
+inline bool DeleteRangeResponse::has_header() const {
+  return (_has_bits_[0] & 0x00000001u) != 0;
+}
+inline void DeleteRangeResponse::set_has_header() {
+  _has_bits_[0] |= 0x00000001u;
+}
+inline void DeleteRangeResponse::clear_has_header() {
+  _has_bits_[0] &= ~0x00000001u;
+}
+inline void DeleteRangeResponse::clear_header() {
+  if (header_ != NULL) header_->::cockroach::roachpb::ResponseHeader::Clear();
+  clear_has_header();
+}
+inline const ::cockroach::roachpb::ResponseHeader& DeleteRangeResponse::header() const {
+  // @@protoc_insertion_point(field_get:cockroach.roachpb.DeleteRangeResponse.header)
+  return header_ != NULL ? *header_ : *default_instance_->header_;
+}
+inline ::cockroach::roachpb::ResponseHeader* DeleteRangeResponse::mutable_header() {
+  set_has_header();
+  if (header_ == NULL) {
+    header_ = new ::cockroach::roachpb::ResponseHeader;
+  }
+  // @@protoc_insertion_point(field_mutable:cockroach.roachpb.DeleteRangeResponse.header)
+  return header_;
+}
+inline ::cockroach::roachpb::ResponseHeader* DeleteRangeResponse::release_header() {
+  clear_has_header();
+  ::cockroach::roachpb::ResponseHeader* temp = header_;
+  header_ = NULL;
+  return temp;
+}
+inline void DeleteRangeResponse::set_allocated_header(::cockroach::roachpb::ResponseHeader* header) {
+  delete header_;
+  header_ = header;
+  if (header) {
+    set_has_header();
+  } else {
+    clear_has_header();
+  }
+  // @@protoc_insertion_point(field_set_allocated:cockroach.roachpb.DeleteRangeResponse.header)
+}
+
+// optional int64 num_deleted = 2;
+inline bool DeleteRangeResponse::has_num_deleted() const {
+  return (_has_bits_[0] & 0x00000002u) != 0;
+}
+inline void DeleteRangeResponse::set_has_num_deleted() {
+  _has_bits_[0] |= 0x00000002u;
+}
+inline void DeleteRangeResponse::clear_has_num_deleted() {
+  _has_bits_[0] &= ~0x00000002u;
+}
+inline void DeleteRangeResponse::clear_num_deleted() {
+  num_deleted_ = GOOGLE_LONGLONG(0);
+  clear_has_num_deleted();
+}
+inline ::google::protobuf::int64 DeleteRangeResponse::num_deleted() const {
+  // @@protoc_insertion_point(field_get:cockroach.roachpb.DeleteRangeResponse.num_deleted)
+  return num_deleted_;
+}
+inline void DeleteRangeResponse::set_num_deleted(::google::protobuf::int64 value) {
+  set_has_num_deleted();
+  num_deleted_ = value;
+  // @@protoc_insertion_point(field_set:cockroach.roachpb.DeleteRangeResponse.num_deleted)
 }
 
 // -------------------------------------------------------------------
 
-// DeleteRangeRequest
+// ClearRangeRequest
 
 // optional .cockroach.roachpb.Span header = 1;
-inline bool DeleteRangeRequest::has_header() const {
+inline bool ClearRangeRequest::has_header() const {
   return (_has_bits_[0] & 0x00000001u) != 0;
 }
-inline void DeleteRangeRequest::set_has_header() {
+inline void ClearRangeRequest::set_has_header() {
   _has_bits_[0] |= 0x00000001u;
 }
-inline void DeleteRangeRequest::clear_has_header() {
+inline void ClearRangeRequest::clear_has_header() {
   _has_bits_[0] &= ~0x00000001u;
 }
-inline void DeleteRangeRequest::clear_header() {
+inline void ClearRangeRequest::clear_header() {
   if (header_ != NULL) header_->::cockroach::roachpb::Span::Clear();
   clear_has_header();
 }
-inline const ::cockroach::roachpb::Span& DeleteRangeRequest::header() const {
-  // @@protoc_insertion_point(field_get:cockroach.roachpb.DeleteRangeRequest.header)
+inline const ::cockroach::roachpb::Span& ClearRangeRequest::header() const {
+  // @@protoc_insertion_point(field_get:cockroach.roachpb.ClearRangeRequest.header)
   return header_ != NULL ? *header_ : *default_instance_->header_;
 }
-inline ::cockroach::roachpb::Span* DeleteRangeRequest::mutable_header() {
+inline ::cockroach::roachpb::Span* ClearRangeRequest::mutable_header() {
   set_has_header();
   if (header_ == NULL) {
     header_ = new ::cockroach::roachpb::Span;
   }
-  // @@protoc_insertion_point(field_mutable:cockroach.roachpb.DeleteRangeRequest.header)
+  // @@protoc_insertion_point(field_mutable:cockroach.roachpb.ClearRangeRequest.header)
   return header_;
 }
-inline ::cockroach::roachpb::Span* DeleteRangeRequest::release_header() {
+inline ::cockroach::roachpb::Span* ClearRangeRequest::release_header() {
   clear_has_header();
   ::cockroach::roachpb::Span* temp = header_;
   header_ = NULL;
   return temp;
 }
-inline void DeleteRangeRequest::set_allocated_header(::cockroach::roachpb::Span* header) {
+inline void ClearRangeRequest::set_allocated_header(::cockroach::roachpb::Span* header) {
   delete header_;
   header_ = header;
   if (header) {
@@ -7125,70 +7731,46 @@ inline void DeleteRangeRequest::set_allocated_header(::cockroach::roachpb::Span*
   } else {
     clear_has_header();
   }
-  // @@protoc_insertion_point(field_set_allocated:cockroach.roachpb.DeleteRangeRequest.header)
-}
-
-// optional int64 max_entries_to_delete = 2;
-inline bool DeleteRangeRequest::has_max_entries_to_delete() const {
-  return (_has_bits_[0] & 0x00000002u) != 0;
-}
-inline void DeleteRangeRequest::set_has_max_entries_to_delete() {
-  _has_bits_[0] |= 0x00000002u;
-}
-inline void DeleteRangeRequest::clear_has_max_entries_to_delete() {
-  _has_bits_[0] &= ~0x00000002u;
-}
-inline void DeleteRangeRequest::clear_max_entries_to_delete() {
-  max_entries_to_delete_ = GOOGLE_LONGLONG(0);
-  clear_has_max_entries_to_delete();
-}
-inline ::google::protobuf::int64 DeleteRangeRequest::max_entries_to_delete() const {
-  // @@protoc_insertion_point(field_get:cockroach.roachpb.DeleteRangeRequest.max_entries_to_delete)
-  return max_entries_to_delete_;
-}
-inline void DeleteRangeRequest::set_max_entries_to_delete(::google::protobuf::int64 value) {
-  set_has_max_entries_to_delete();
-  max_entries_to_delete_ = value;
-  // @@protoc_insertion_point(field_set:cockroach.roachpb.DeleteRangeRequest.max_entries_to_delete)
+  // @@protoc_insertion_point(field_set_allocated:cockroach.roachpb.ClearRangeRequest.header)
 }
 
 // -------------------------------------------------------------------
 
-// DeleteRangeResponse
+// ClearRangeResponse
 
 // optional .cockroach.roachpb.ResponseHeader header = 1;
-inline bool DeleteRangeResponse::has_header() const {
+inline bool ClearRangeResponse::has_header() const {
   return (_has_bits_[0] & 0x00000001u) != 0;
 }
-inline void DeleteRangeResponse::set_has_header() {
+inline void ClearRangeResponse::set_has_header() {
   _has_bits_[0] |= 0x00000001u;
 }
-inline void DeleteRangeResponse::clear_has_header() {
+inline void ClearRangeResponse::clear_has_header() {
   _has_bits_[0] &= ~0x00000001u;
 }
-inline void DeleteRangeResponse::clear_header() {
+inline void ClearRangeResponse::clear_header() {
   if (header_ != NULL) header_->::cockroach::roachpb::ResponseHeader::Clear();
   clear_has_header();
 }
-inline const ::cockroach::roachpb::ResponseHeader& DeleteRangeResponse::header() const {
-  // @@protoc_insertion_point(field_get:cockroach.roachpb.DeleteRangeResponse.header)
+inline const ::cockroach::roachpb::ResponseHeader& ClearRangeResponse::header() const {
+  // @@protoc_insertion_point(field_get:cockroach.roachpb.ClearRangeResponse.header)
   return header_ != NULL ? *header_ : *default_instance_->header_;
 }
-inline ::cockroach::roachpb::ResponseHeader* DeleteRangeResponse::mutable_header() {
+inline ::cockroach::roachpb::ResponseHeader* ClearRangeResponse::mutable_header() {
   set_has_header();
   if (header_ == NULL) {
     header_ = new ::cockroach::roachpb::ResponseHeader;
   }
-  // @@protoc_insertion_point(field_mutable:cockroach.roachpb.DeleteRangeResponse.header)
+  // @@protoc_insertion_point(field_mutable:cockroach.roachpb.ClearRangeResponse.header)
   return header_;
 }
-inline ::cockroach::roachpb::ResponseHeader* DeleteRangeResponse::release_header() {
+inline ::cockroach::roachpb::ResponseHeader* ClearRangeResponse::release_header() {
   clear_has_header();
   ::cockroach::roachpb::ResponseHeader* temp = header_;
   header_ = NULL;
   return temp;
 }
-inline void DeleteRangeResponse::set_allocated_header(::cockroach::roachpb::ResponseHeader* header) {
+inline void ClearRangeResponse::set_allocated_header(::cockroach::roachpb::ResponseHeader* header) {
   delete header_;
   header_ = header;
   if (header) {
@@ -7196,31 +7778,31 @@ inline void DeleteRangeResponse::set_allocated_header(::cockroach::roachpb::Resp
   } else {
     clear_has_header();
   }
-  // @@protoc_insertion_point(field_set_allocated:cockroach.roachpb.DeleteRangeResponse.header)
+  // @@protoc_insertion_point(field_set_allocated:cockroach.roachpb.ClearRangeResponse.header)
 }
 
-// optional int64 num_deleted = 2;
-inline bool DeleteRangeResponse::has_num_deleted() const {
+// optional int64 num_cleared = 2;
+inline bool ClearRangeResponse::has_num_cleared() const {
   return (_has_bits_[0] & 0x00000002u) != 0;
 }
-inline void DeleteRangeResponse::set_has_num_deleted() {
+inline void ClearRangeResponse::set_has_num_cleared() {
   _has_bits_[0] |= 0x00000002u;
 }
-inline void DeleteRangeResponse::clear_has_num_deleted() {
+inline void ClearRangeResponse::clear_has_num_cleared() {
   _has_bits_[0] &= ~0x00000002u;
 }
-inline void DeleteRangeResponse::clear_num_deleted() {
-  num_deleted_ = GOOGLE_LONGLONG(0);
-  clear_has_num_deleted();
+inline void ClearRangeResponse::clear_num_cleared() {
+  num_cleared_ = GOOGLE_LONGLONG(0);
+  clear_has_num_cleared();
 }
-inline ::google::protobuf::int64 DeleteRangeResponse::num_deleted() const {
-  // @@protoc_insertion_point(field_get:cockroach.roachpb.DeleteRangeResponse.num_deleted)
-  return num_deleted_;
+inline ::google::protobuf::int64 ClearRangeResponse::num_cleared() const {
+  // @@protoc_insertion_point(field_get:cockroach.roachpb.ClearRangeResponse.num_cleared)
+  return num_cleared_;
 }
-inline void DeleteRangeResponse::set_num_deleted(::google::protobuf::int64 value) {
-  set_has_num_deleted();
-  num_deleted_ = value;
-  // @@protoc_insertion_point(field_set:cockroach.roachpb.DeleteRangeResponse.num_deleted)
+inline void ClearRangeResponse::set_num_cleared(::google::protobuf::int64 value) {
+  set_has_num_cleared();
+  num_cleared_ = value;
+  // @@protoc_insertion_point(field_set:cockroach.roachpb.ClearRangeResponse.num_cleared)
 }
 
 // -------------------------------------------------------------------
@@ -7521,6 +8103,207 @@ ReverseScanResponse::mutable_rows() {
 
 // -------------------------------------------------------------------
 
+// FilteredScanRequest
+
+// optional .cockroach.roachpb.Span header = 1;
+inline bool FilteredScanRequest::has_header() const {
+  return (_has_bits_[0] & 0x00000001u) != 0;
+}
+inline void FilteredScanRequest::set_has_header() {
+  _has_bits_[0] |= 0x00000001u;
+}
+inline void FilteredScanRequest::clear_has_header() {
+  _has_bits_[0] &= ~0x00000001u;
+}
+inline void FilteredScanRequest::clear_header() {
+  if (header_ != NULL) header_->::cockroach::roachpb::Span::Clear();
+  clear_has_header();
+}
+inline const ::cockroach::roachpb::Span& FilteredScanRequest::header() const {
+  // @@protoc_insertion_point(field_get:cockroach.roachpb.FilteredScanRequest.header)
+  return header_ != NULL ? *header_ : *default_instance_->header_;
+}
+inline ::cockroach::roachpb::Span* FilteredScanRequest::mutable_header() {
+  set_has_header();
+  if (header_ == NULL) {
+    header_ = new ::cockroach::roachpb::Span;
+  }
+  // @@protoc_insertion_point(field_mutable:cockroach.roachpb.FilteredScanRequest.header)
+  return header_;
+}
+inline ::cockroach::roachpb::Span* FilteredScanRequest::release_header() {
+  clear_has_header();
+  ::cockroach::roachpb::Span* temp = header_;
+  header_ = NULL;
+  return temp;
+}
+inline void FilteredScanRequest::set_allocated_header(::cockroach::roachpb::Span* header) {
+  delete header_;
+  header_ = header;
+  if (header) {
+    set_has_header();
+  } else {
+    clear_has_header();
+  }
+  // @@protoc_insertion_point(field_set_allocated:cockroach.roachpb.FilteredScanRequest.header)
+}
+
+// optional bytes filter = 2;
+inline bool FilteredScanRequest::has_filter() const {
+  return (_has_bits_[0] & 0x00000002u) != 0;
+}
+inline void FilteredScanRequest::set_has_filter() {
+  _has_bits_[0] |= 0x00000002u;
+}
+inline void FilteredScanRequest::clear_has_filter() {
+  _has_bits_[0] &= ~0x00000002u;
+}
+inline void FilteredScanRequest::clear_filter() {
+  filter_.ClearToEmptyNoArena(&::google::protobuf::internal::GetEmptyStringAlreadyInited());
+  clear_has_filter();
+}
+inline const ::std::string& FilteredScanRequest::filter() const {
+  // @@protoc_insertion_point(field_get:cockroach.roachpb.FilteredScanRequest.filter)
+  return filter_.GetNoArena(&::google::protobuf::internal::GetEmptyStringAlreadyInited());
+}
+inline void FilteredScanRequest::set_filter(const ::std::string& value) {
+  set_has_filter();
+  filter_.SetNoArena(&::google::protobuf::internal::GetEmptyStringAlreadyInited(), value);
+  // @@protoc_insertion_point(field_set:cockroach.roachpb.FilteredScanRequest.filter)
+}
+inline void FilteredScanRequest::set_filter(const char* value) {
+  set_has_filter();
+  filter_.SetNoArena(&::google::protobuf::internal::GetEmptyStringAlreadyInited(), ::std::string(value));
+  // @@protoc_insertion_point(field_set_char:cockroach.roachpb.FilteredScanRequest.filter)
+}
+inline void FilteredScanRequest::set_filter(const void* value, size_t size) {
+  set_has_filter();
+  filter_.SetNoArena(&::google::protobuf::internal::GetEmptyStringAlreadyInited(),
+      ::std::string(reinterpret_cast<const char*>(value), size));
+  // @@protoc_insertion_point(field_set_pointer:cockroach.roachpb.FilteredScanRequest.filter)
+}
+inline ::std::string* FilteredScanRequest::mutable_filter() {
+  set_has_filter();
+  // @@protoc_insertion_point(field_mutable:cockroach.roachpb.FilteredScanRequest.filter)
+  return filter_.MutableNoArena(&::google::protobuf::internal::GetEmptyStringAlreadyInited());
+}
+inline ::std::string* FilteredScanRequest::release_filter() {
+  clear_has_filter();
+  return filter_.ReleaseNoArena(&::google::protobuf::internal::GetEmptyStringAlreadyInited());
+}
+inline void FilteredScanRequest::set_allocated_filter(::std::string* filter) {
+  if (filter != NULL) {
+    set_has_filter();
+  } else {
+    clear_has_filter();
+  }
+  filter_.SetAllocatedNoArena(&::google::protobuf::internal::GetEmptyStringAlreadyInited(), filter);
+  // @@protoc_insertion_point(field_set_allocated:cockroach.roachpb.FilteredScanRequest.filter)
+}
+
+// optional int64 max_results = 3;
+inline bool FilteredScanRequest::has_max_results() const {
+  return (_has_bits_[0] & 0x00000004u) != 0;
+}
+inline void FilteredScanRequest::set_has_max_results() {
+  _has_bits_[0] |= 0x00000004u;
+}
+inline void FilteredScanRequest::clear_has_max_results() {
+  _has_bits_[0] &= ~0x00000004u;
+}
+inline void FilteredScanRequest::clear_max_results() {
+  max_results_ = GOOGLE_LONGLONG(0);
+  clear_has_max_results();
+}
+inline ::google::protobuf::int64 FilteredScanRequest::max_results() const {
+  // @@protoc_insertion_point(field_get:cockroach.roachpb.FilteredScanRequest.max_results)
+  return max_results_;
+}
+inline void FilteredScanRequest::set_max_results(::google::protobuf::int64 value) {
+  set_has_max_results();
+  max_results_ = value;
+  // @@protoc_insertion_point(field_set:cockroach.roachpb.FilteredScanRequest.max_results)
+}
+
+// -------------------------------------------------------------------
+
+// FilteredScanResponse
+
+// optional .cockroach.roachpb.ResponseHeader header = 1;
+inline bool FilteredScanResponse::has_header() const {
+  return (_has_bits_[0] & 0x00000001u) != 0;
+}
+inline void FilteredScanResponse::set_has_header() {
+  _has_bits_[0] |= 0x00000001u;
+}
+inline void FilteredScanResponse::clear_has_header() {
+  _has_bits_[0] &= ~0x00000001u;
+}
+inline void FilteredScanResponse::clear_header() {
+  if (header_ != NULL) header_->::cockroach::roachpb::ResponseHeader::Clear();
+  clear_has_header();
+}
+inline const ::cockroach::roachpb::ResponseHeader& FilteredScanResponse::header() const {
+  // @@protoc_insertion_point(field_get:cockroach.roachpb.FilteredScanResponse.header)
+  return header_ != NULL ? *header_ : *default_instance_->header_;
+}
+inline ::cockroach::roachpb::ResponseHeader* FilteredScanResponse::mutable_header() {
+  set_has_header();
+  if (header_ == NULL) {
+    header_ = new ::cockroach::roachpb::ResponseHeader;
+  }
+  // @@protoc_insertion_point(field_mutable:cockroach.roachpb.FilteredScanResponse.header)
+  return header_;
+}
+inline ::cockroach::roachpb::ResponseHeader* FilteredScanResponse::release_header() {
+  clear_has_header();
+  ::cockroach::roachpb::ResponseHeader* temp = header_;
+  header_ = NULL;
+  return temp;
+}
+inline void FilteredScanResponse::set_allocated_header(::cockroach::roachpb::ResponseHeader* header) {
+  delete header_;
+  header_ = header;
+  if (header) {
+    set_has_header();
+  } else {
+    clear_has_header();
+  }
+  // @@protoc_insertion_point(field_set_allocated:cockroach.roachpb.FilteredScanResponse.header)
+}
+
+// repeated .cockroach.roachpb.KeyValue rows = 2;
+inline int FilteredScanResponse::rows_size() const {
+  return rows_.size();
+}
+inline void FilteredScanResponse::clear_rows() {
+  rows_.Clear();
+}
+inline const ::cockroach::roachpb::KeyValue& FilteredScanResponse::rows(int index) const {
+  // @@protoc_insertion_point(field_get:cockroach.roachpb.FilteredScanResponse.rows)
+  return rows_.Get(index);
+}
+inline ::cockroach::roachpb::KeyValue* FilteredScanResponse::mutable_rows(int index) {
+  // @@protoc_insertion_point(field_mutable:cockroach.roachpb.FilteredScanResponse.rows)
+  return rows_.Mutable(index);
+}
+inline ::cockroach::roachpb::KeyValue* FilteredScanResponse::add_rows() {
+  // @@protoc_insertion_point(field_add:cockroach.roachpb.FilteredScanResponse.rows)
+  return rows_.Add();
+}
+inline const ::google::protobuf::RepeatedPtrField< ::cockroach::roachpb::KeyValue >&
+FilteredScanResponse::rows() const {
+  // @@protoc_insertion_point(field_list:cockroach.roachpb.FilteredScanResponse.rows)
+  return rows_;
+}
+inline ::google::protobuf::RepeatedPtrField< ::cockroach::roachpb::KeyValue >*
+FilteredScanResponse::mutable_rows() {
+  // @@protoc_insertion_point(field_mutable_list:cockroach.roachpb.FilteredScanResponse.rows)
+  return &rows_;
+}
+
+// -------------------------------------------------------------------
+
 // BeginTransactionRequest
 
 // optional .cockroach.roachpb.Span header = 1;
@@ -10724,6 +11507,92 @@ inline void RequestUnion::set_allocated_noop(::cockroach::roachpb::NoopRequest*
   // @@protoc_insertion_point(field_set_allocated:cockroach.roachpb.RequestUnion.noop)
 }
 
+// optional .cockroach.roachpb.FilteredScanRequest filtered_scan = 23;
+inline bool RequestUnion::has_filtered_scan() const {
+  return (_has_bits_[0] & 0x00400000u) != 0;
+}
+inline void RequestUnion::set_has_filtered_scan() {
+  _has_bits_[0] |= 0x00400000u;
+}
+inline void RequestUnion::clear_has_filtered_scan() {
+  _has_bits_[0] &= ~0x00400000u;
+}
+inline void RequestUnion::clear_filtered_scan() {
+  if (filtered_scan_ != NULL) filtered_scan_->::cockroach::roachpb::FilteredScanRequest::Clear();
+  clear_has_filtered_scan();
+}
+inline const ::cockroach::roachpb::FilteredScanRequest& RequestUnion::filtered_scan() const {
+  // @@protoc_insertion_point(field_get:cockroach.roachpb.RequestUnion.filtered_scan)
+  return filtered_scan_ != NULL ? *filtered_scan_ : *default_instance_->filtered_scan_;
+}
+inline ::cockroach::roachpb::FilteredScanRequest* RequestUnion::mutable_filtered_scan() {
+  set_has_filtered_scan();
+  if (filtered_scan_ == NULL) {
+    filtered_scan_ = new ::cockroach::roachpb::FilteredScanRequest;
+  }
+  // @@protoc_insertion_point(field_mutable:cockroach.roachpb.RequestUnion.filtered_scan)
+  return filtered_scan_;
+}
+inline ::cockroach::roachpb::FilteredScanRequest* RequestUnion::release_filtered_scan() {
+  clear_has_filtered_scan();
+  ::cockroach::roachpb::FilteredScanRequest* temp = filtered_scan_;
+  filtered_scan_ = NULL;
+  return temp;
+}
+inline void RequestUnion::set_allocated_filtered_scan(::cockroach::roachpb::FilteredScanRequest* filtered_scan) {
+  delete filtered_scan_;
+  filtered_scan_ = filtered_scan;
+  if (filtered_scan) {
+    set_has_filtered_scan();
+  } else {
+    clear_has_filtered_scan();
+  }
+  // @@protoc_insertion_point(field_set_allocated:cockroach.roachpb.RequestUnion.filtered_scan)
+}
+
+// optional .cockroach.roachpb.ClearRangeRequest clear_range = 24;
+inline bool RequestUnion::has_clear_range() const {
+  return (_has_bits_[0] & 0x00800000u) != 0;
+}
+inline void RequestUnion::set_has_clear_range() {
+  _has_bits_[0] |= 0x00800000u;
+}
+inline void RequestUnion::clear_has_clear_range() {
+  _has_bits_[0] &= ~0x00800000u;
+}
+inline void RequestUnion::clear_clear_range() {
+  if (clear_range_ != NULL) clear_range_->::cockroach::roachpb::ClearRangeRequest::Clear();
+  clear_has_clear_range();
+}
+inline const ::cockroach::roachpb::ClearRangeRequest& RequestUnion::clear_range() const {
+  // @@protoc_insertion_point(field_get:cockroach.roachpb.RequestUnion.clear_range)
+  return clear_range_ != NULL ? *clear_range_ : *default_instance_->clear_range_;
+}
+inline ::cockroach::roachpb::ClearRangeRequest* RequestUnion::mutable_clear_range() {
+  set_has_clear_range();
+  if (clear_range_ == NULL) {
+    clear_range_ = new ::cockroach::roachpb::ClearRangeRequest;
+  }
+  // @@protoc_insertion_point(field_mutable:cockroach.roachpb.RequestUnion.clear_range)
+  return clear_range_;
+}
+inline ::cockroach::roachpb::ClearRangeRequest* RequestUnion::release_clear_range() {
+  clear_has_clear_range();
+  ::cockroach::roachpb::ClearRangeRequest* temp = clear_range_;
+  clear_range_ = NULL;
+  return temp;
+}
+inline void RequestUnion::set_allocated_clear_range(::cockroach::roachpb::ClearRangeRequest* clear_range) {
+  delete clear_range_;
+  clear_range_ = clear_range;
+  if (clear_range) {
+    set_has_clear_range();
+  } else {
+    clear_has_clear_range();
+  }
+  // @@protoc_insertion_point(field_set_allocated:cockroach.roachpb.RequestUnion.clear_range)
+}
+
 // -------------------------------------------------------------------
 
 // ResponseUnion
@@ -11674,6 +12543,92 @@ inline void ResponseUnion::set_allocated_noop(::cockroach::roachpb::NoopResponse
   // @@protoc_insertion_point(field_set_allocated:cockroach.roachpb.ResponseUnion.noop)
 }
 
+// optional .cockroach.roachpb.FilteredScanResponse filtered_scan = 23;
+inline bool ResponseUnion::has_filtered_scan() const {
+  return (_has_bits_[0] & 0x00400000u) != 0;
+}
+inline void ResponseUnion::set_has_filtered_scan() {
+  _has_bits_[0] |= 0x00400000u;
+}
+inline void ResponseUnion::clear_has_filtered_scan() {
+  _has_bits_[0] &= ~0x00400000u;
+}
+inline void ResponseUnion::clear_filtered_scan() {
+  if (filtered_scan_ != NULL) filtered_scan_->::cockroach::roachpb::FilteredScanResponse::Clear();
+  clear_has_filtered_scan();
+}
+inline const ::cockroach::roachpb::FilteredScanResponse& ResponseUnion::filtered_scan() const {
+  // @@protoc_insertion_point(field_get:cockroach.roachpb.ResponseUnion.filtered_scan)
+  return filtered_scan_ != NULL ? *filtered_scan_ : *default_instance_->filtered_scan_;
+}
+inline ::cockroach::roachpb::FilteredScanResponse* ResponseUnion::mutable_filtered_scan() {
+  set_has_filtered_scan();
+  if (filtered_scan_ == NULL) {
+    filtered_scan_ = new ::cockroach::roachpb::FilteredScanResponse;
+  }
+  // @@protoc_insertion_point(field_mutable:cockroach.roachpb.ResponseUnion.filtered_scan)
+  return filtered_scan_;
+}
+inline ::cockroach::roachpb::FilteredScanResponse* ResponseUnion::release_filtered_scan() {
+  clear_has_filtered_scan();
+  ::cockroach::roachpb::FilteredScanResponse* temp = filtered_scan_;
+  filtered_scan_ = NULL;
+  return temp;
+}
+inline void ResponseUnion::set_allocated_filtered_scan(::cockroach::roachpb::FilteredScanResponse* filtered_scan) {
+  delete filtered_scan_;
+  filtered_scan_ = filtered_scan;
+  if (filtered_scan) {
+    set_has_filtered_scan();
+  } else {
+    clear_has_filtered_scan();
+  }
+  // @@protoc_insertion_point(field_set_allocated:cockroach.roachpb.ResponseUnion.filtered_scan)
+}
+
+// optional .cockroach.roachpb.ClearRangeResponse clear_range = 24;
+inline bool ResponseUnion::has_clear_range() const {
+  return (_has_bits_[0] & 0x00800000u) != 0;
+}
+inline void ResponseUnion::set_has_clear_range() {
+  _has_bits_[0] |= 0x00800000u;
+}
+inline void ResponseUnion::clear_has_clear_range() {
+  _has_bits_[0] &= ~0x00800000u;
+}
+inline void ResponseUnion::clear_clear_range() {
+  if (clear_range_ != NULL) clear_range_->::cockroach::roachpb::ClearRangeResponse::Clear();
+  clear_has_clear_range();
+}
+inline const ::cockroach::roachpb::ClearRangeResponse& ResponseUnion::clear_range() const {
+  // @@protoc_insertion_point(field_get:cockroach.roachpb.ResponseUnion.clear_range)
+  return clear_range_ != NULL ? *clear_range_ : *default_instance_->clear_range_;
+}
+inline ::cockroach::roachpb::ClearRangeResponse* ResponseUnion::mutable_clear_range() {
+  set_has_clear_range();
+  if (clear_range_ == NULL) {
+    clear_range_ = new ::cockroach::roachpb::ClearRangeResponse;
+  }
+  // @@protoc_insertion_point(field_mutable:cockroach.roachpb.ResponseUnion.clear_range)
+  return clear_range_;
+}
+inline ::cockroach::roachpb::ClearRangeResponse* ResponseUnion::release_clear_range() {
+  clear_has_clear_range();
+  ::cockroach::roachpb::ClearRangeResponse* temp = clear_range_;
+  clear_range_ = NULL;
+  return temp;
+}
+inline void ResponseUnion::set_allocated_clear_range(::cockroach::roachpb::ClearRangeResponse* clear_range) {
+  delete clear_range_;
+  clear_range_ = clear_range;
+  if (clear_range) {
+    set_has_clear_range();
+  } else {
+    clear_has_clear_range();
+  }
+  // @@protoc_insertion_point(field_set_allocated:cockroach.roachpb.ResponseUnion.clear_range)
+}
+
 // -------------------------------------------------------------------
 
 // Header
@@ -12317,6 +13272,14 @@ BatchResponse::mutable_responses() {
 
 // -------------------------------------------------------------------
 
+// -------------------------------------------------------------------
+
+// -------------------------------------------------------------------
+
+// -------------------------------------------------------------------
+
+// -------------------------------------------------------------------
+
 
 // @@protoc_insertion_point(namespace_scope)
 
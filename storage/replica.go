@@ -114,6 +114,7 @@ var tsCacheMethods = [...]bool{
 	roachpb.Increment:          true,
 	roachpb.Scan:               true,
 	roachpb.ReverseScan:        true,
+	roachpb.FilteredScan:       true,
 	roachpb.Delete:             true,
 	roachpb.DeleteRange:        true,
 	roachpb.ResolveIntent:      true,
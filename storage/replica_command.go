@@ -88,6 +88,10 @@ func (r *Replica) executeCmd(batch engine.Engine, ms *engine.MVCCStats, h roachp
 		var resp roachpb.DeleteRangeResponse
 		resp, err = r.DeleteRange(batch, ms, h, *tArgs)
 		reply = &resp
+	case *roachpb.ClearRangeRequest:
+		var resp roachpb.ClearRangeResponse
+		resp, err = r.ClearRange(batch, ms, h, *tArgs)
+		reply = &resp
 	case *roachpb.ScanRequest:
 		var resp roachpb.ScanResponse
 		resp, intents, err = r.Scan(batch, h, *tArgs)
@@ -96,6 +100,10 @@ func (r *Replica) executeCmd(batch engine.Engine, ms *engine.MVCCStats, h roachp
 		var resp roachpb.ReverseScanResponse
 		resp, intents, err = r.ReverseScan(batch, h, *tArgs)
 		reply = &resp
+	case *roachpb.FilteredScanRequest:
+		var resp roachpb.FilteredScanResponse
+		resp, intents, err = r.FilteredScan(batch, h, *tArgs)
+		reply = &resp
 	case *roachpb.BeginTransactionRequest:
 		var resp roachpb.BeginTransactionResponse
 		resp, err = r.BeginTransaction(batch, ms, h, *tArgs)
@@ -230,6 +238,20 @@ func (r *Replica) DeleteRange(batch engine.Engine, ms *engine.MVCCStats, h roach
 	return reply, err
 }
 
+// ClearRange removes all versions of the keys in the range specified by
+// start and end keys without writing MVCC tombstones. It is used to quickly
+// reclaim the space of data which will never be read again.
+func (r *Replica) ClearRange(batch engine.Engine, ms *engine.MVCCStats, h roachpb.Header, args roachpb.ClearRangeRequest) (roachpb.ClearRangeResponse, error) {
+	var reply roachpb.ClearRangeResponse
+
+	if h.Txn != nil {
+		return reply, util.Errorf("cannot execute ClearRange within a transaction")
+	}
+	numCleared, err := engine.MVCCClearRange(batch, ms, args.Key, args.EndKey, h.Timestamp.WallTime)
+	reply.NumCleared = numCleared
+	return reply, err
+}
+
 // Scan scans the key range specified by start key through end key in ascending
 // order up to some maximum number of results.
 func (r *Replica) Scan(batch engine.Engine, h roachpb.Header, args roachpb.ScanRequest) (roachpb.ScanResponse, []roachpb.Intent, error) {
@@ -251,6 +273,56 @@ func (r *Replica) ReverseScan(batch engine.Engine, h roachpb.Header, args roachp
 	return reply, intents, err
 }
 
+// filteredScanBatchSize is the number of key-value pairs FilteredScan reads
+// at a time before passing them to the filter.
+var filteredScanBatchSize int64 = 1000
+
+// FilteredScan scans the key range specified by start key through end key in
+// ascending order and returns up to some maximum number of the rows which
+// pass the filter supplied with the request. The filter is evaluated by the
+// store's ScanFilter hook.
+//
+// The range is read in batches of filteredScanBatchSize key-value pairs so
+// that only the rows passing the filter are held in memory. The filter
+// returns the first and the last row of every batch, which may have been
+// split by the batch boundaries, and the gateway evaluates the filter again
+// for the complete rows.
+func (r *Replica) FilteredScan(batch engine.Engine, h roachpb.Header, args roachpb.FilteredScanRequest) (roachpb.FilteredScanResponse, []roachpb.Intent, error) {
+	var reply roachpb.FilteredScanResponse
+
+	scanFilter := r.store.ctx.ScanFilter
+	if scanFilter == nil {
+		return reply, nil, util.Errorf("filtered scans are not supported by store %d", r.store.StoreID())
+	}
+	var intents []roachpb.Intent
+	key := args.Key
+	for {
+		rows, batchIntents, err := engine.MVCCScan(batch, key, args.EndKey, filteredScanBatchSize, h.Timestamp,
+			h.ReadConsistency == roachpb.CONSISTENT, h.Txn)
+		intents = append(intents, batchIntents...)
+		if err != nil {
+			return reply, intents, err
+		}
+		if len(rows) == 0 {
+			break
+		}
+		filtered, err := scanFilter(args.Filter, rows)
+		if err != nil {
+			return reply, intents, err
+		}
+		reply.Rows = append(reply.Rows, filtered...)
+		if args.MaxResults > 0 && int64(len(reply.Rows)) >= args.MaxResults {
+			reply.Rows = reply.Rows[:args.MaxResults]
+			break
+		}
+		if int64(len(rows)) < filteredScanBatchSize {
+			break
+		}
+		key = rows[len(rows)-1].Key.Next()
+	}
+	return reply, intents, nil
+}
+
 func verifyTransaction(h roachpb.Header, args roachpb.Request) error {
 	if h.Txn == nil {
 		return util.Errorf("no transaction specified to HeartbeatTxn")
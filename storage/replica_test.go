@@ -2355,6 +2355,76 @@ func TestRangeResolveIntentRange(t *testing.T) {
 	}
 }
 
+// TestReplicaFilteredScan verifies that FilteredScan passes the scanned
+// key-value pairs to the filter in batches and honors MaxResults.
+func TestReplicaFilteredScan(t *testing.T) {
+	defer leaktest.AfterTest(t)
+	defer func(size int64) { filteredScanBatchSize = size }(filteredScanBatchSize)
+	filteredScanBatchSize = 3
+	tc := testContext{}
+	tc.Start(t)
+	defer tc.Stop()
+
+	// The filter returns the key-value pairs whose value is the filter.
+	var batches int
+	tc.store.ctx.ScanFilter = func(filter []byte, kvs []roachpb.KeyValue) ([]roachpb.KeyValue, error) {
+		batches++
+		if int64(len(kvs)) > filteredScanBatchSize {
+			return nil, util.Errorf("expected at most %d key-value pairs, got %d", filteredScanBatchSize, len(kvs))
+		}
+		var result []roachpb.KeyValue
+		for _, kv := range kvs {
+			v, err := kv.Value.GetBytes()
+			if err != nil {
+				return nil, err
+			}
+			if bytes.Equal(v, filter) {
+				result = append(result, kv)
+			}
+		}
+		return result, nil
+	}
+
+	for i := 0; i < 10; i++ {
+		value := []byte("even")
+		if i%2 == 1 {
+			value = []byte("odd")
+		}
+		pArgs := putArgs(roachpb.Key(fmt.Sprintf("k%d", i)), value)
+		if _, err := client.SendWrapped(tc.Sender(), tc.rng.context(), &pArgs); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	testCases := []struct {
+		maxResults int64
+		expected   []string
+		batches    int
+	}{
+		{0, []string{"k1", "k3", "k5", "k7", "k9"}, 4},
+		{2, []string{"k1", "k3"}, 2},
+		{5, []string{"k1", "k3", "k5", "k7", "k9"}, 4},
+	}
+	for i, c := range testCases {
+		batches = 0
+		args := roachpb.NewFilteredScan(roachpb.Key("k"), roachpb.Key("l"), c.maxResults, []byte("odd"))
+		reply, err := client.SendWrapped(tc.Sender(), tc.rng.context(), args)
+		if err != nil {
+			t.Fatalf("%d: %s", i, err)
+		}
+		var found []string
+		for _, kv := range reply.(*roachpb.FilteredScanResponse).Rows {
+			found = append(found, string(kv.Key))
+		}
+		if !reflect.DeepEqual(found, c.expected) {
+			t.Errorf("%d: expected %v, got %v", i, c.expected, found)
+		}
+		if batches != c.batches {
+			t.Errorf("%d: expected %d batches, got %d", i, c.batches, batches)
+		}
+	}
+}
+
 func verifyRangeStats(eng engine.Engine, rangeID roachpb.RangeID, expMS engine.MVCCStats, t *testing.T) {
 	var ms engine.MVCCStats
 	if err := engine.MVCCGetRangeStats(eng, rangeID, &ms); err != nil {
@@ -314,8 +314,19 @@ type StoreContext struct {
 
 	// Tracer is a request tracer.
 	Tracer *tracer.Tracer
+
+	// ScanFilter evaluates the filters supplied with FilteredScan requests.
+	// FilteredScan requests fail if it is nil.
+	ScanFilter ScanFilterFunc
 }
 
+// ScanFilterFunc is called by FilteredScan with the filter supplied in the
+// request and the key-value pairs read from the range. It returns the subset
+// of the key-value pairs which pass the filter, in their original order. The
+// storage layer treats the filter as opaque; its interpretation is left to
+// the layer which constructed the request.
+type ScanFilterFunc func(filter []byte, kvs []roachpb.KeyValue) ([]roachpb.KeyValue, error)
+
 // Valid returns true if the StoreContext is populated correctly.
 // We don't check for Gossip and DB since some of our tests pass
 // that as nil.
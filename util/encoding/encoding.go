@@ -25,6 +25,7 @@ import (
 	"unsafe"
 
 	"github.com/cockroachdb/cockroach/util"
+	"github.com/cockroachdb/cockroach/util/uuid"
 )
 
 // EncodeUint32 encodes the uint32 value using a big-endian 8 byte
@@ -302,6 +303,8 @@ const (
 	escapedFF   byte = 0x00
 	// The prefix that is added to every encoded byte/string.
 	bytesMarker byte = 0x31
+	// The prefix of byte/string values encoded in decreasing order.
+	bytesDescMarker byte = ^bytesMarker
 )
 
 type escapes struct {
@@ -314,7 +317,7 @@ type escapes struct {
 
 var (
 	ascendingEscapes  = escapes{escape, escapedTerm, escaped00, escapedFF, bytesMarker}
-	descendingEscapes = escapes{^escape, ^escapedTerm, ^escaped00, ^escapedFF, ^bytesMarker}
+	descendingEscapes = escapes{^escape, ^escapedTerm, ^escaped00, ^escapedFF, bytesDescMarker}
 )
 
 // EncodeBytes encodes the []byte value using an escape-based
@@ -473,6 +476,9 @@ func DecodeStringDecreasing(b []byte, r []byte) ([]byte, string, error) {
 const (
 	encodedNull    byte = 0x00
 	encodedNotNull byte = 0x01
+	// The NULL of a value encoded in decreasing order sorts after all
+	// the other values.
+	encodedNullDesc byte = 0xff
 )
 
 // EncodeNull encodes a NULL value. The encodes bytes are appended to the
@@ -483,6 +489,15 @@ func EncodeNull(b []byte) []byte {
 	return append(b, encodedNull)
 }
 
+// EncodeNullDecreasing encodes a NULL value which sorts after all the values
+// encoded by the decreasing encodings, mirroring EncodeNull which sorts
+// before all the values encoded by the increasing encodings. The encoded
+// bytes are appended to the supplied buffer and the final buffer is
+// returned.
+func EncodeNullDecreasing(b []byte) []byte {
+	return append(b, encodedNullDesc)
+}
+
 // EncodeNotNull encodes a value that is larger than the NULL marker encoded by
 // EncodeNull but less than any encoded value returned by EncodeVarint,
 // EncodeFloat, EncodeBytes or EncodeString.
@@ -496,7 +511,9 @@ func EncodeNotNull(b []byte) []byte {
 // returned unchanged and false is returned for the second result. Since the
 // NULL value encoding is guaranteed to never occur as the prefix for the
 // EncodeVarint, EncodeFloat, EncodeBytes and EncodeString encodings, it is
-// safe to call DecodeIfNull on their encoded values.
+// safe to call DecodeIfNull on their encoded values. DecodeIfNull also
+// decodes the NULL value encoded by EncodeNullDecreasing, which is likewise
+// never a prefix of the decreasing encodings.
 func DecodeIfNull(b []byte) ([]byte, bool) {
 	if PeekType(b) == Null {
 		return b[1:], true
@@ -519,7 +536,8 @@ func DecodeIfNotNull(b []byte) ([]byte, bool) {
 }
 
 const (
-	timeMarker byte = 0x32
+	timeMarker     byte = 0x32
+	timeDescMarker byte = ^timeMarker
 )
 
 // EncodeTime encodes a time value, appends it to the supplied buffer,
@@ -555,8 +573,93 @@ func DecodeTime(b []byte) ([]byte, time.Time, error) {
 	return b, time.Unix(sec, nsec), nil
 }
 
+// EncodeTimeDecreasing encodes a time value so that it sorts in reverse
+// order, from latest to earliest, appends it to the supplied buffer and
+// returns the final buffer.
+func EncodeTimeDecreasing(b []byte, t time.Time) []byte {
+	b = append(b, timeDescMarker)
+	b = EncodeVarintDecreasing(b, t.Unix())
+	b = EncodeVarintDecreasing(b, int64(t.Nanosecond()))
+	return b
+}
+
+// DecodeTimeDecreasing decodes a time.Time value which was encoded using
+// EncodeTimeDecreasing. The remainder of the input buffer and the decoded
+// time.Time are returned.
+func DecodeTimeDecreasing(b []byte) ([]byte, time.Time, error) {
+	if PeekType(b) != TimeDesc {
+		return nil, time.Time{}, util.Errorf("did not find marker")
+	}
+	b = b[1:]
+	b, sec, err := DecodeVarintDecreasing(b)
+	if err != nil {
+		return b, time.Time{}, err
+	}
+	b, nsec, err := DecodeVarintDecreasing(b)
+	if err != nil {
+		return b, time.Time{}, err
+	}
+	return b, time.Unix(sec, nsec), nil
+}
+
+const (
+	uuidMarker     byte = 0x33
+	uuidDescMarker byte = ^uuidMarker
+)
+
+// EncodeUUID encodes a UUID value, appends it to the supplied buffer and
+// returns the final buffer. The 16 bytes of the UUID follow a marker byte
+// unchanged, so the encoding preserves the byte-wise order of UUIDs.
+func EncodeUUID(b []byte, u uuid.UUID) []byte {
+	b = append(b, uuidMarker)
+	return append(b, u...)
+}
+
+// DecodeUUID decodes a UUID value which was encoded using EncodeUUID. The
+// remainder of the input buffer and the decoded UUID are returned.
+func DecodeUUID(b []byte) ([]byte, uuid.UUID, error) {
+	if PeekType(b) != UUID {
+		return nil, nil, util.Errorf("did not find marker")
+	}
+	b = b[1:]
+	if len(b) < uuid.UUIDSize {
+		return nil, nil, util.Errorf("insufficient bytes to decode uuid value: %d", len(b))
+	}
+	u, err := uuid.FromBytes(b[:uuid.UUIDSize])
+	return b[uuid.UUIDSize:], u, err
+}
+
+// EncodeUUIDDecreasing encodes a UUID value so that it sorts in reverse
+// order, appends it to the supplied buffer and returns the final buffer.
+func EncodeUUIDDecreasing(b []byte, u uuid.UUID) []byte {
+	b = append(b, uuidDescMarker)
+	n := len(b)
+	b = append(b, u...)
+	onesComplement(b[n:])
+	return b
+}
+
+// DecodeUUIDDecreasing decodes a UUID value which was encoded using
+// EncodeUUIDDecreasing. The remainder of the input buffer and the decoded
+// UUID are returned.
+func DecodeUUIDDecreasing(b []byte) ([]byte, uuid.UUID, error) {
+	if PeekType(b) != UUIDDesc {
+		return nil, nil, util.Errorf("did not find marker")
+	}
+	b = b[1:]
+	if len(b) < uuid.UUIDSize {
+		return nil, nil, util.Errorf("insufficient bytes to decode uuid value: %d", len(b))
+	}
+	r := append([]byte(nil), b[:uuid.UUIDSize]...)
+	onesComplement(r)
+	u, err := uuid.FromBytes(r)
+	return b[uuid.UUIDSize:], u, err
+}
+
 // Type represents the type of a value encoded by
-// Encode{Null,NotNull,Varint,Uvarint,Float,Bytes}.
+// Encode{Null,NotNull,Varint,Uvarint,Float,Bytes,Time,UUID} and their
+// decreasing variants. The decreasing variants of the integer encodings are
+// indistinguishable from the increasing ones and have type Int.
 type Type int
 
 // Type values.
@@ -568,6 +671,23 @@ const (
 	Float
 	Bytes
 	Time
+	UUID
+	FloatDesc
+	BytesDesc
+	TimeDesc
+	UUIDDesc
+)
+
+// Direction is the direction in which values are encoded, which determines
+// the order in which the encoded values sort.
+type Direction int
+
+// Direction values.
+const (
+	// Ascending selects the Encode* functions.
+	Ascending Direction = iota
+	// Descending selects the Encode*Decreasing functions.
+	Descending
 )
 
 // PeekType peeks at the type of the value encoded at the start of b.
@@ -575,7 +695,7 @@ func PeekType(b []byte) Type {
 	if len(b) >= 1 {
 		m := b[0]
 		switch {
-		case m == encodedNull:
+		case m == encodedNull, m == encodedNullDesc:
 			return Null
 		case m == encodedNotNull:
 			return NotNull
@@ -583,10 +703,20 @@ func PeekType(b []byte) Type {
 			return Bytes
 		case m == timeMarker:
 			return Time
+		case m == uuidMarker:
+			return UUID
 		case m >= intMin && m <= intMax:
 			return Int
 		case m >= floatNaN && m <= floatInfinity:
 			return Float
+		case m == bytesDescMarker:
+			return BytesDesc
+		case m == timeDescMarker:
+			return TimeDesc
+		case m == uuidDescMarker:
+			return UUIDDesc
+		case m >= ^byte(floatInfinity) && m <= ^byte(floatNaN):
+			return FloatDesc
 		}
 	}
 	return Unknown
@@ -25,6 +25,7 @@ import (
 	"time"
 
 	"github.com/cockroachdb/cockroach/util/randutil"
+	"github.com/cockroachdb/cockroach/util/uuid"
 )
 
 func testBasicEncodeDecode32(encFunc func([]byte, uint32) []byte,
@@ -604,6 +605,24 @@ func TestEncodeDecodeNull(t *testing.T) {
 	} else if hello != string(remaining) {
 		t.Fatalf("expected %q, but found %q", hello, remaining)
 	}
+
+	buf = EncodeNullDecreasing(nil)
+	if remaining, isNull := DecodeIfNull(append(buf, hello...)); !isNull {
+		t.Fatalf("expected isNull=true, but found isNull=%v", isNull)
+	} else if hello != string(remaining) {
+		t.Fatalf("expected %q, but found %q", hello, remaining)
+	}
+	for _, enc := range [][]byte{
+		EncodeVarintDecreasing(nil, math.MaxInt64),
+		EncodeFloatDecreasing(nil, math.Inf(-1)),
+		EncodeStringDecreasing(nil, ""),
+		EncodeTimeDecreasing(nil, time.Unix(0, 0)),
+		EncodeUUIDDecreasing(nil, make(uuid.UUID, uuid.UUIDSize)),
+	} {
+		if bytes.Compare(enc, buf) >= 0 {
+			t.Errorf("expected %q to sort before the decreasing NULL", enc)
+		}
+	}
 }
 
 func TestEncodeDecodeTime(t *testing.T) {
@@ -676,6 +695,94 @@ func TestEncodeDecodeTime(t *testing.T) {
 	}
 }
 
+func TestEncodeDecodeTimeDecreasing(t *testing.T) {
+	testCases := []time.Time{
+		time.Unix(-1000, 0),
+		time.Unix(-1, 999999999),
+		time.Unix(0, 0),
+		time.Unix(0, 1),
+		time.Unix(1, 0),
+		time.Unix(1000000000, 500),
+	}
+	var lastEncoded []byte
+	for i, tm := range testCases {
+		enc := EncodeTimeDecreasing(nil, tm)
+		if i > 0 && bytes.Compare(lastEncoded, enc) <= 0 {
+			t.Errorf("%d: %s: expected descending order", i, tm)
+		}
+		rem, decoded, err := DecodeTimeDecreasing(append(enc, 'x'))
+		if err != nil {
+			t.Fatal(err)
+		}
+		if !decoded.Equal(tm) || string(rem) != "x" {
+			t.Errorf("%d: expected %s, but found %s (remaining %q)", i, tm, decoded, rem)
+		}
+		lastEncoded = enc
+	}
+}
+
+func TestEncodeDecodeUUID(t *testing.T) {
+	testCases := []string{
+		"00000000-0000-0000-0000-000000000000",
+		"00000000-0000-0000-0000-000000000001",
+		"00ff0000-0000-0000-0000-000000000000",
+		"0f000000-0000-0000-0000-000000000000",
+		"d7aa0f5e-e42d-46d8-bdf7-16e4f9be5ebe",
+		"ffffffff-ffff-ffff-ffff-ffffffffffff",
+	}
+	var lastEncoded []byte
+	for i, s := range testCases {
+		u, err := uuid.FromString(s)
+		if err != nil {
+			t.Fatal(err)
+		}
+		enc := EncodeUUID(nil, u)
+		if i > 0 && bytes.Compare(lastEncoded, enc) >= 0 {
+			t.Errorf("%d: %s: expected ascending order", i, s)
+		}
+		rem, decoded, err := DecodeUUID(append(enc, 'x'))
+		if err != nil {
+			t.Fatal(err)
+		}
+		if !bytes.Equal(u, decoded) || string(rem) != "x" {
+			t.Errorf("%d: expected %s, but found %s (remaining %q)", i, u, decoded, rem)
+		}
+		lastEncoded = enc
+	}
+
+	if _, _, err := DecodeUUID(EncodeUUID(nil, uuid.NewUUID4())[:10]); err == nil {
+		t.Errorf("expected error decoding truncated uuid")
+	}
+}
+
+func TestEncodeDecodeUUIDDecreasing(t *testing.T) {
+	testCases := []string{
+		"00000000-0000-0000-0000-000000000000",
+		"00000000-0000-0000-0000-000000000001",
+		"0f000000-0000-0000-0000-000000000000",
+		"ffffffff-ffff-ffff-ffff-ffffffffffff",
+	}
+	var lastEncoded []byte
+	for i, s := range testCases {
+		u, err := uuid.FromString(s)
+		if err != nil {
+			t.Fatal(err)
+		}
+		enc := EncodeUUIDDecreasing(nil, u)
+		if i > 0 && bytes.Compare(lastEncoded, enc) <= 0 {
+			t.Errorf("%d: %s: expected descending order", i, s)
+		}
+		rem, decoded, err := DecodeUUIDDecreasing(append(enc, 'x'))
+		if err != nil {
+			t.Fatal(err)
+		}
+		if !bytes.Equal(u, decoded) || string(rem) != "x" {
+			t.Errorf("%d: expected %s, but found %s (remaining %q)", i, u, decoded, rem)
+		}
+		lastEncoded = enc
+	}
+}
+
 func TestPeekType(t *testing.T) {
 	testCases := []struct {
 		enc []byte
@@ -688,6 +795,15 @@ func TestPeekType(t *testing.T) {
 		{EncodeFloat(nil, 0), Float},
 		{EncodeBytes(nil, []byte("")), Bytes},
 		{EncodeTime(nil, time.Now()), Time},
+		{EncodeUUID(nil, uuid.NewUUID4()), UUID},
+		{EncodeNullDecreasing(nil), Null},
+		{EncodeVarintDecreasing(nil, 0), Int},
+		{EncodeFloatDecreasing(nil, 0), FloatDesc},
+		{EncodeFloatDecreasing(nil, -1), FloatDesc},
+		{EncodeFloatDecreasing(nil, math.Inf(1)), FloatDesc},
+		{EncodeBytesDecreasing(nil, []byte("")), BytesDesc},
+		{EncodeTimeDecreasing(nil, time.Now()), TimeDesc},
+		{EncodeUUIDDecreasing(nil, uuid.NewUUID4()), UUIDDesc},
 	}
 	for i, c := range testCases {
 		typ := PeekType(c.enc)
@@ -139,6 +139,42 @@ func DecodeFloat(buf []byte, tmp []byte) ([]byte, float64, error) {
 	}
 }
 
+// EncodeFloatDecreasing returns the resulting byte slice with the encoded
+// float64 appended to b. The encoding is the ones complement of the
+// EncodeFloat encoding so that it sorts in reverse order, from largest to
+// smallest.
+func EncodeFloatDecreasing(b []byte, f float64) []byte {
+	n := len(b)
+	b = EncodeFloat(b, f)
+	onesComplement(b[n:])
+	return b
+}
+
+// DecodeFloatDecreasing returns the remaining byte slice after decoding and
+// the decoded float64 from buf, which was encoded using
+// EncodeFloatDecreasing.
+func DecodeFloatDecreasing(buf []byte, tmp []byte) ([]byte, float64, error) {
+	if len(buf) == 0 {
+		return nil, 0, util.Errorf("insufficient bytes to decode float value")
+	}
+	// NaN, the infinities and zero are encoded in a single byte. All the other
+	// values are followed by the (complemented) terminator.
+	n := 1
+	switch ^buf[0] {
+	case floatNaN, floatNegativeInfinity, floatZero, floatInfinity:
+	default:
+		idx := bytes.IndexByte(buf, ^byte(floatTerminator))
+		if idx == -1 {
+			return nil, 0, util.Errorf("did not find terminator")
+		}
+		n = idx + 1
+	}
+	enc := append([]byte(nil), buf[:n]...)
+	onesComplement(enc)
+	_, f, err := DecodeFloat(enc, tmp)
+	return buf[n:], f, err
+}
+
 // floatMandE computes and returns the mantissa M and exponent E for f.
 //
 // The mantissa is a base-100 representation of the value. The exponent E
@@ -189,3 +189,47 @@ func BenchmarkDecodeFloat(b *testing.B) {
 		_, _, _ = DecodeFloat(vals[i%len(vals)], buf)
 	}
 }
+
+func TestEncodeFloatDecreasing(t *testing.T) {
+	testCases := []float64{
+		math.NaN(),
+		math.Inf(-1),
+		-math.MaxFloat64,
+		-10000.0,
+		-99.0,
+		-0.00123,
+		-math.SmallestNonzeroFloat64,
+		0,
+		math.SmallestNonzeroFloat64,
+		0.123,
+		1.0,
+		99.0001,
+		12345,
+		math.MaxFloat64,
+		math.Inf(1),
+	}
+
+	var lastEncoded []byte
+	for i, v := range testCases {
+		enc := EncodeFloatDecreasing(nil, v)
+		if i > 0 && bytes.Compare(lastEncoded, enc) <= 0 {
+			t.Errorf("%v: expected [% x] to be greater than [% x]", v, lastEncoded, enc)
+		}
+		rem, dec, err := DecodeFloatDecreasing(append(enc, 'x'), nil)
+		if err != nil {
+			t.Error(err)
+			continue
+		}
+		if string(rem) != "x" {
+			t.Errorf("%v: expected remaining \"x\", but found %q", v, rem)
+		}
+		if math.IsNaN(v) {
+			if !math.IsNaN(dec) {
+				t.Errorf("%v: decoded %v", v, dec)
+			}
+		} else if dec != v {
+			t.Errorf("%v: decoded %v", v, dec)
+		}
+		lastEncoded = enc
+	}
+}
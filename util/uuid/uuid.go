@@ -21,8 +21,10 @@ package uuid
 
 import (
 	"crypto/rand"
+	"encoding/hex"
 	"fmt"
 	"io"
+	"strings"
 )
 
 const (
@@ -58,6 +60,40 @@ func (u UUID) String() string {
 	return fmt.Sprintf("%08x-%04x-%04x-%04x-%012x", b[:4], b[4:6], b[6:8], b[8:10], b[10:])
 }
 
+// FromString parses the hex form xxxxxxxx-xxxx-xxxx-xxxx-xxxxxxxxxxxx, as
+// returned by String. Upper case hex digits, surrounding braces and a missing
+// hyphen separator are also accepted.
+func FromString(s string) (UUID, error) {
+	t := s
+	if strings.HasPrefix(t, "{") && strings.HasSuffix(t, "}") {
+		t = t[1 : len(t)-1]
+	}
+	if len(t) == 2*UUIDSize+4 {
+		for _, i := range []int{8, 13, 18, 23} {
+			if t[i] != '-' {
+				return nil, fmt.Errorf("invalid UUID: %q", s)
+			}
+		}
+		t = t[:8] + t[9:13] + t[14:18] + t[19:23] + t[24:]
+	}
+	if len(t) != 2*UUIDSize {
+		return nil, fmt.Errorf("invalid UUID: %q", s)
+	}
+	u, err := hex.DecodeString(t)
+	if err != nil {
+		return nil, fmt.Errorf("invalid UUID: %q", s)
+	}
+	return UUID(u), nil
+}
+
+// FromBytes returns the UUID held by a 16 byte slice.
+func FromBytes(b []byte) (UUID, error) {
+	if len(b) != UUIDSize {
+		return nil, fmt.Errorf("invalid UUID: expected %d bytes, but found %d", UUIDSize, len(b))
+	}
+	return UUID(append([]byte(nil), b...)), nil
+}
+
 // Short formats the UUID using only the first four bytes for brevity.
 func (u UUID) Short() string {
 	if len(u) != UUIDSize {
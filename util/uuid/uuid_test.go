@@ -40,3 +40,45 @@ func TestUUIDString(t *testing.T) {
 		t.Errorf("expected txn %s; got %s", expStr, str)
 	}
 }
+
+func TestUUIDFromString(t *testing.T) {
+	expStr := "d7aa0f5e-e42d-46d8-bdf7-16e4f9be5ebe"
+	for _, s := range []string{
+		expStr,
+		"D7AA0F5E-E42D-46D8-BDF7-16E4F9BE5EBE",
+		"{d7aa0f5e-e42d-46d8-bdf7-16e4f9be5ebe}",
+		"d7aa0f5ee42d46d8bdf716e4f9be5ebe",
+	} {
+		u, err := uuid.FromString(s)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if str := u.String(); str != expStr {
+			t.Errorf("%s: expected %s; got %s", s, expStr, str)
+		}
+	}
+
+	for _, s := range []string{
+		"",
+		"d7aa0f5e-e42d-46d8-bdf7-16e4f9be5eb",
+		"d7aa0f5e-e42d-46d8-bdf7-16e4f9be5ebe0",
+		"d7aa0f5e+e42d-46d8-bdf7-16e4f9be5ebe",
+		"g7aa0f5e-e42d-46d8-bdf7-16e4f9be5ebe",
+	} {
+		if _, err := uuid.FromString(s); err == nil {
+			t.Errorf("%q: expected error", s)
+		}
+	}
+}
+
+func TestUUIDFromBytes(t *testing.T) {
+	u := uuid.NewUUID4()
+	if v, err := uuid.FromBytes(u); err != nil {
+		t.Fatal(err)
+	} else if !bytes.Equal(u, v) {
+		t.Errorf("expected %s; got %s", u, v)
+	}
+	if _, err := uuid.FromBytes(u[1:]); err == nil {
+		t.Errorf("expected error")
+	}
+}